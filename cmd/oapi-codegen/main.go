@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -8,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/pb33f/libopenapi"
@@ -22,7 +24,10 @@ func main() {
 	configPath := flag.String("config", "", "path to configuration file")
 	flagPackage := flag.String("package", "", "Go package name for generated code")
 	flagOutput := flag.String("output", "", "output file path (default: <spec-basename>.gen.go)")
-	flagGenerateRuntime := flag.String("generate-runtime", "", "generate runtime sub-packages (types, params, helpers) under the output directory; value is the base import path (no spec required)")
+	flagChangelog := flag.String("changelog", "", "write a Markdown changelog of exported API changes between the existing output file and the newly generated code to this path")
+	flagCurrentVersion := flag.String("current-version", "", "with -changelog, the current version of the generated module (e.g. v1.2.3), used to suggest the next version")
+	flagFormat := flag.String("format", "text", "format of warnings and errors: text, printed to stderr, or json, a JSON array of diagnostics with their spec file, line and column printed to stdout")
+	flagGenerateRuntime := flag.String("generate-runtime", "", "generate runtime sub-packages (types, params, helpers, jsonpointer) under the output directory; value is the base import path (no spec required)")
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: %s [options] <spec-path-or-url>\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Arguments:\n")
@@ -32,6 +37,16 @@ func main() {
 	}
 	flag.Parse()
 
+	switch *flagFormat {
+	case "text":
+	case "json":
+		// Keep stdout for the diagnostics.
+		status = os.Stderr
+	default:
+		fmt.Fprintf(os.Stderr, "unknown -format %q: use text or json\n", *flagFormat)
+		os.Exit(1)
+	}
+
 	// --generate-runtime mode: produce three runtime sub-packages and exit.
 	if *flagGenerateRuntime != "" {
 		rt, err := codegen.GenerateRuntime(*flagGenerateRuntime)
@@ -49,8 +64,10 @@ func main() {
 			dir, file, code string
 		}{
 			{"types", "types.gen.go", rt.Types},
+			{"types", "types_jsonv2.gen.go", rt.TypesJSONv2},
 			{"params", "params.gen.go", rt.Params},
 			{"helpers", "helpers.gen.go", rt.Helpers},
+			{"jsonpointer", "jsonpointer.gen.go", rt.JSONPointer},
 		}
 		for _, sp := range subPkgs {
 			dir := filepath.Join(outputDir, sp.dir)
@@ -63,7 +80,7 @@ func main() {
 				fmt.Fprintf(os.Stderr, "error writing %s: %v\n", path, err)
 				os.Exit(1)
 			}
-			fmt.Printf("Generated %s\n", path)
+			fmt.Fprintf(status, "Generated %s\n", path)
 		}
 		return
 	}
@@ -134,19 +151,193 @@ func main() {
 	}
 
 	// Generate code
-	code, err := codegen.Generate(doc, specData, cfg)
+	code, diagnostics, err := codegen.GenerateWithDiagnostics(doc, specData, cfg)
+	for i, d := range diagnostics {
+		if d.Line > 0 && d.File == "" {
+			diagnostics[i].File = specPath
+		}
+	}
+	if err := printDiagnostics(*flagFormat, diagnostics); err != nil {
+		fmt.Fprintf(os.Stderr, "error printing diagnostics: %v\n", err)
+		os.Exit(1)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "error generating code: %v\n", err)
+		if *flagFormat == "text" {
+			fmt.Fprintf(os.Stderr, "error generating code: %v\n", err)
+		}
 		os.Exit(1)
 	}
 
-	// Write output
-	if err := os.WriteFile(cfg.Output, []byte(code), 0644); err != nil {
+	// Compare against the previous output before overwriting it
+	if *flagChangelog != "" {
+		if err := writeChangelog(*flagChangelog, cfg.Output, code, *flagCurrentVersion); err != nil {
+			fmt.Fprintf(os.Stderr, "error generating changelog: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Write output, splitting schema types into chunk files if it's too large
+	maxFileSize := cfg.OutputOptions.MaxFileSize
+	if maxFileSize == 0 {
+		maxFileSize = codegen.DefaultMaxFileSize
+	}
+	if err := writeOutput(cfg.Output, code, maxFileSize); err != nil {
 		fmt.Fprintf(os.Stderr, "error writing output: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("Generated %s\n", cfg.Output)
+	if cfg.Generation.ExampleApp != nil {
+		app, err := codegen.GenerateExampleApp(doc, cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error generating example app: %v\n", err)
+			os.Exit(1)
+		}
+		if err := writeExampleApp(cfg.Generation.ExampleApp.OutputDir(), app); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing example app: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// status is where progress, such as the files written, is printed: stdout,
+// unless it's taken by JSON diagnostics.
+var status io.Writer = os.Stdout
+
+// printDiagnostics prints the diagnostics of a generation run in format:
+// text, one per line on stderr, or json, an array on stdout, empty when
+// there are none.
+func printDiagnostics(format string, diagnostics []codegen.Diagnostic) error {
+	if format == "json" {
+		if diagnostics == nil {
+			diagnostics = []codegen.Diagnostic{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(diagnostics)
+	}
+	for _, d := range diagnostics {
+		// The error itself is printed by the caller.
+		if d.Severity == codegen.SeverityWarning {
+			fmt.Fprintln(os.Stderr, d)
+		}
+	}
+	return nil
+}
+
+// writeExampleApp writes the files of the example application into dir,
+// leaving those already there alone, as they're meant to be edited.
+func writeExampleApp(dir string, app *codegen.ExampleAppOutput) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(app.Files))
+	for name := range app.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			fmt.Fprintf(status, "Skipped %s, which exists\n", path)
+			continue
+		}
+		if err := os.WriteFile(path, []byte(app.Files[name]), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(status, "Generated %s\n", path)
+	}
+	return nil
+}
+
+// writeOutput writes the generated code to output, moving schema types into
+// chunk files next to it when the code is larger than maxFileSize. Chunk files
+// left over from a previous, larger generation run are removed.
+func writeOutput(output, code string, maxFileSize int) error {
+	main, chunks, err := codegen.SplitOutput(code, maxFileSize)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, []byte(main), 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(status, "Generated %s\n", output)
+
+	for i, chunk := range chunks {
+		path := codegen.ChunkFileName(output, i+1)
+		if err := os.WriteFile(path, []byte(chunk), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(status, "Generated %s\n", path)
+	}
+
+	for _, path := range existingChunkFiles(output)[len(chunks):] {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// existingChunkFiles returns the generated chunk files of output which are on
+// disk, in order.
+func existingChunkFiles(output string) []string {
+	var paths []string
+	for n := 1; ; n++ {
+		path := codegen.ChunkFileName(output, n)
+		data, err := os.ReadFile(path)
+		if err != nil || !strings.HasPrefix(string(data), generatedHeader) {
+			return paths
+		}
+		paths = append(paths, path)
+	}
+}
+
+// generatedHeader starts every file written by oapi-codegen.
+const generatedHeader = "// Code generated by oapi-codegen"
+
+// writeChangelog compares the previously generated file at previousPath, along
+// with its chunk files, with newCode and writes a Markdown changelog to
+// changelogPath. A missing previous file is treated as an empty API. The
+// suggested version bump is printed, along with the next version when
+// currentVersion is set.
+func writeChangelog(changelogPath, previousPath, newCode, currentVersion string) error {
+	previous, err := os.ReadFile(previousPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("reading previous output: %w", err)
+	}
+	if len(previous) == 0 {
+		previous = []byte("package previous\n")
+	}
+	previousFiles := []string{string(previous)}
+	for _, path := range existingChunkFiles(previousPath) {
+		chunk, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading previous output: %w", err)
+		}
+		previousFiles = append(previousFiles, string(chunk))
+	}
+
+	changes, err := codegen.DiffAPIFiles(previousFiles, []string{newCode})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(changelogPath, []byte(codegen.FormatChangelog(changes)), 0644); err != nil {
+		return fmt.Errorf("writing changelog: %w", err)
+	}
+	fmt.Fprintf(status, "Generated %s\n", changelogPath)
+
+	bump := codegen.SuggestVersionBump(changes)
+	if currentVersion == "" {
+		fmt.Fprintf(status, "Suggested version bump: %s\n", bump)
+		return nil
+	}
+	next, err := codegen.NextVersion(currentVersion, bump)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(status, "Suggested version bump: %s (%s -> %s)\n", bump, currentVersion, next)
+	return nil
 }
 
 // loadSpec loads an OpenAPI spec from a file path or URL.
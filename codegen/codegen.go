@@ -8,6 +8,7 @@ import (
 	"github.com/pb33f/libopenapi"
 
 	impl "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/apidiff"
 )
 
 // Configuration is the top-level configuration for code generation.
@@ -31,6 +32,25 @@ type NameMangling = impl.NameMangling
 // NameSubstitutions allows direct overrides of generated names.
 type NameSubstitutions = impl.NameSubstitutions
 
+// NameCollisions configures how colliding type names are told apart.
+type NameCollisions = impl.NameCollisions
+
+// Name collision strategies.
+const (
+	NameCollisionSection     = impl.NameCollisionSection
+	NameCollisionOperationID = impl.NameCollisionOperationID
+	NameCollisionError       = impl.NameCollisionError
+)
+
+// InlineSchemas configures the types of schemas defined inline in others.
+type InlineSchemas = impl.InlineSchemas
+
+// Inline schema promotion policies.
+const (
+	InlinePromoteAlways     = impl.InlinePromoteAlways
+	InlinePromoteWhenNeeded = impl.InlinePromoteWhenNeeded
+)
+
 // StructTagsConfig configures how struct tags are generated for fields.
 type StructTagsConfig = impl.StructTagsConfig
 
@@ -40,15 +60,135 @@ type RuntimePackageConfig = impl.RuntimePackageConfig
 // RuntimeOutput holds the generated code for each runtime sub-package.
 type RuntimeOutput = impl.RuntimeOutput
 
+// ExampleAppConfig configures the example application.
+type ExampleAppConfig = impl.ExampleAppConfig
+
+// ExampleAppOutput holds the files of the example application.
+type ExampleAppOutput = impl.ExampleAppOutput
+
+// SpecIndex is the resolved libopenapi model of a spec, together with the
+// schema and operation descriptors generation derives from it.
+type SpecIndex = impl.SpecIndex
+
+// SchemaDescriptor describes a schema which gets a type.
+type SchemaDescriptor = impl.SchemaDescriptor
+
+// SchemaPath is where a schema or operation appears in the document.
+type SchemaPath = impl.SchemaPath
+
+// OperationDescriptor describes an operation of a path, webhook or callback.
+type OperationDescriptor = impl.OperationDescriptor
+
+// Diagnostic is a warning or error of a generation run, located in the spec.
+type Diagnostic = impl.Diagnostic
+
+// Severity is how serious a Diagnostic is: "warning" or "error".
+type Severity = impl.Severity
+
+// Severities of diagnostics.
+const (
+	SeverityWarning = impl.SeverityWarning
+	SeverityError   = impl.SeverityError
+)
+
+// APIChange describes a single difference between two generated APIs.
+type APIChange = apidiff.Change
+
+// VersionBump is a semantic version increment: "major", "minor" or "patch".
+type VersionBump = apidiff.VersionBump
+
+// Semantic version increments returned by SuggestVersionBump.
+const (
+	BumpMajor = apidiff.BumpMajor
+	BumpMinor = apidiff.BumpMinor
+	BumpPatch = apidiff.BumpPatch
+)
+
 // Generate produces Go code from the parsed OpenAPI document.
 // specData is the raw spec bytes used to embed the spec in the generated code.
 func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (string, error) {
 	return impl.Generate(doc, specData, cfg)
 }
 
+// GenerateWithDiagnostics is like Generate, and also returns the warnings of
+// the run, and its error if it fails, as diagnostics with the file, line and
+// column of the part of the spec they're about, rather than logging them.
+func GenerateWithDiagnostics(doc libopenapi.Document, specData []byte, cfg Configuration) (string, []Diagnostic, error) {
+	return impl.GenerateWithDiagnostics(doc, specData, cfg)
+}
+
+// Inspect returns the SpecIndex of the parsed OpenAPI document as Generate
+// sees it under cfg, for custom checks, such as naming policies or security
+// audits, run alongside generation.
+func Inspect(doc libopenapi.Document, cfg Configuration) (*SpecIndex, error) {
+	return impl.Inspect(doc, cfg)
+}
+
 // GenerateRuntime produces standalone Go source files for each of the three
 // runtime sub-packages (types, params, helpers). baseImportPath is the base
 // import path for the runtime module (e.g., "github.com/org/project/runtime").
 func GenerateRuntime(baseImportPath string) (*RuntimeOutput, error) {
 	return impl.GenerateRuntime(baseImportPath)
 }
+
+// GenerateExampleApp produces the example application of the package Generate
+// produces under cfg, which must set generation.example-app: a main.go serving
+// a stub ServerInterface, and a main_test.go calling it through the client.
+func GenerateExampleApp(doc libopenapi.Document, cfg Configuration) (*ExampleAppOutput, error) {
+	return impl.GenerateExampleApp(doc, cfg)
+}
+
+// DefaultMaxFileSize is the default size, in bytes, above which SplitOutput
+// splits generated code.
+const DefaultMaxFileSize = impl.DefaultMaxFileSize
+
+// SplitOutput splits generated code larger than maxSize into the main file and
+// chunk files holding schema type declarations, partitioned by type name.
+// Code no larger than maxSize is returned unchanged with no chunks.
+func SplitOutput(code string, maxSize int) (main string, chunks []string, err error) {
+	return impl.SplitOutput(code, maxSize)
+}
+
+// ChunkFileName returns the name of the n-th (1-based) chunk file for an
+// output file, e.g. "types.gen.go" -> "types_1.gen.go".
+func ChunkFileName(output string, n int) string {
+	return impl.ChunkFileName(output, n)
+}
+
+// DiffAPI compares the exported API of two generated Go source files, such as
+// the output of a previous generation run and the current one.
+func DiffAPI(oldSource, newSource string) ([]APIChange, error) {
+	return apidiff.DiffSources(oldSource, newSource)
+}
+
+// DiffAPIFiles is like DiffAPI, for generated code which is split across
+// several files, such as an output file and its chunk files.
+func DiffAPIFiles(oldSources, newSources []string) ([]APIChange, error) {
+	return apidiff.DiffFiles(oldSources, newSources)
+}
+
+// Changelog compares the exported API of two generated Go source files and
+// returns a Markdown changelog of added, removed, renamed and changed types,
+// fields, methods, functions and enum values.
+func Changelog(oldSource, newSource string) (string, error) {
+	return apidiff.Changelog(oldSource, newSource)
+}
+
+// FormatChangelog renders API changes returned by DiffAPI as a Markdown
+// changelog.
+func FormatChangelog(changes []APIChange) string {
+	return apidiff.FormatChangelog(changes)
+}
+
+// SuggestVersionBump recommends the next semantic version increment for the
+// generated module: major for breaking changes, minor for additions only, and
+// patch when the exported API is unchanged.
+func SuggestVersionBump(changes []APIChange) VersionBump {
+	return apidiff.SuggestBump(changes)
+}
+
+// NextVersion applies bump to a semantic version such as "v1.2.3". A major
+// bump of a v0 version increments the minor version instead.
+func NextVersion(current string, bump VersionBump) (string, error) {
+	return apidiff.NextVersion(current, bump)
+}
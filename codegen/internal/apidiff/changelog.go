@@ -0,0 +1,71 @@
+package apidiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatChangelog renders changes as a Markdown changelog, with breaking
+// changes listed before additions.
+func FormatChangelog(changes []Change) string {
+	if len(changes) == 0 {
+		return "No API changes.\n"
+	}
+
+	var breaking, other []Change
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		} else {
+			other = append(other, c)
+		}
+	}
+
+	var b strings.Builder
+	writeSection(&b, "Breaking changes", breaking)
+	writeSection(&b, "Additions", other)
+	return b.String()
+}
+
+func writeSection(b *strings.Builder, title string, changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("### " + title + "\n\n")
+	for _, c := range changes {
+		b.WriteString("- " + c.String() + "\n")
+	}
+}
+
+// Changelog compares two generated Go source files and returns a Markdown
+// changelog describing how the exported API changed.
+func Changelog(oldSource, newSource string) (string, error) {
+	changes, err := DiffSources(oldSource, newSource)
+	if err != nil {
+		return "", err
+	}
+	return FormatChangelog(changes), nil
+}
+
+// DiffSources extracts the API surface of two generated Go source files and
+// returns the changes between them.
+func DiffSources(oldSource, newSource string) ([]Change, error) {
+	return DiffFiles([]string{oldSource}, []string{newSource})
+}
+
+// DiffFiles is like DiffSources, for generated code which is split across
+// several files of the same package.
+func DiffFiles(oldSources, newSources []string) ([]Change, error) {
+	oldSurface, err := ExtractFiles(oldSources...)
+	if err != nil {
+		return nil, fmt.Errorf("parsing previous source: %w", err)
+	}
+	newSurface, err := ExtractFiles(newSources...)
+	if err != nil {
+		return nil, fmt.Errorf("parsing new source: %w", err)
+	}
+	return Diff(oldSurface, newSurface), nil
+}
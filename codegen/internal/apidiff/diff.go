@@ -0,0 +1,333 @@
+package apidiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeKind classifies a change between two API surfaces.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeRenamed ChangeKind = "renamed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// Category names the kind of API element a change applies to.
+type Category string
+
+const (
+	CategoryType      Category = "type"
+	CategoryField     Category = "field"
+	CategoryMethod    Category = "method"
+	CategoryFunction  Category = "function"
+	CategoryEnumValue Category = "enum value"
+	CategoryConstant  Category = "constant"
+	CategoryVariable  Category = "variable"
+)
+
+// Change is a single difference between two API surfaces.
+type Change struct {
+	Kind     ChangeKind
+	Category Category
+	Name     string // Qualified name in the old surface, e.g. "Pet.Name"; new name for additions
+	NewName  string // New qualified name, set for renames
+	Old      string // Old type or signature, set for changes
+	New      string // New type or signature, set for changes
+	Breaking bool   // Existing code using the old API may fail to compile
+}
+
+// String renders the change as a changelog sentence.
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("Added %s `%s`", c.Category, c.Name)
+	case ChangeRemoved:
+		return fmt.Sprintf("Removed %s `%s`", c.Category, c.Name)
+	case ChangeRenamed:
+		return fmt.Sprintf("Renamed %s `%s` to `%s`", c.Category, c.Name, c.NewName)
+	default:
+		return fmt.Sprintf("Changed %s `%s` from `%s` to `%s`", c.Category, c.Name, c.Old, c.New)
+	}
+}
+
+// Diff returns the changes needed to go from the old surface to the new one,
+// in a deterministic order.
+func Diff(old, new *Surface) []Change {
+	var changes []Change
+
+	changes = append(changes, diffTypes(old.Types, new.Types)...)
+	changes = append(changes, diffSignatures(CategoryFunction, "", old.Funcs, new.Funcs, false)...)
+	changes = append(changes, diffValues(old, new)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return kindOrder(changes[i].Kind) < kindOrder(changes[j].Kind)
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// HasBreakingChanges reports whether any change is breaking.
+func HasBreakingChanges(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+func kindOrder(k ChangeKind) int {
+	switch k {
+	case ChangeRemoved:
+		return 0
+	case ChangeRenamed:
+		return 1
+	case ChangeChanged:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func diffTypes(old, new map[string]*Type) []Change {
+	var changes []Change
+
+	removed, added := missingKeys(old, new), missingKeys(new, old)
+
+	// A removed and an added type with the same shape is a rename.
+	renames := matchUnique(removed, added, func(o, n string) bool {
+		return typeShape(old[o]) == typeShape(new[n])
+	})
+	for o, n := range renames {
+		changes = append(changes, Change{Kind: ChangeRenamed, Category: CategoryType, Name: o, NewName: n, Breaking: true})
+	}
+	for _, name := range removed {
+		if _, ok := renames[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Category: CategoryType, Name: name, Breaking: true})
+		}
+	}
+	renamedTo := invert(renames)
+	for _, name := range added {
+		if _, ok := renamedTo[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeAdded, Category: CategoryType, Name: name})
+		}
+	}
+
+	for _, name := range sortedKeys(old) {
+		ot, nt := old[name], new[name]
+		if nt == nil {
+			continue
+		}
+		switch {
+		case ot.IsStruct && nt.IsStruct:
+			changes = append(changes, diffFields(name, ot.Fields, nt.Fields)...)
+		case ot.IsIface && nt.IsIface:
+		case ot.Underlying != nt.Underlying || ot.IsAlias != nt.IsAlias:
+			changes = append(changes, Change{Kind: ChangeChanged, Category: CategoryType, Name: name,
+				Old: typeDecl(ot), New: typeDecl(nt), Breaking: true})
+		}
+		// Adding a method to an interface breaks its implementers.
+		changes = append(changes, diffSignatures(CategoryMethod, name+".", ot.Methods, nt.Methods, nt.IsIface)...)
+	}
+
+	return changes
+}
+
+func diffFields(typeName string, old, new map[string]*Field) []Change {
+	var changes []Change
+
+	removed, added := missingKeys(old, new), missingKeys(new, old)
+
+	// A field with the same type and wire name but a different Go name is a rename.
+	renames := matchUnique(removed, added, func(o, n string) bool {
+		return old[o].Type == new[n].Type && old[o].JSONName != "" && old[o].JSONName == new[n].JSONName
+	})
+	for o, n := range renames {
+		changes = append(changes, Change{Kind: ChangeRenamed, Category: CategoryField,
+			Name: typeName + "." + o, NewName: typeName + "." + n, Breaking: true})
+	}
+	for _, name := range removed {
+		if _, ok := renames[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Category: CategoryField, Name: typeName + "." + name, Breaking: true})
+		}
+	}
+	renamedTo := invert(renames)
+	for _, name := range added {
+		if _, ok := renamedTo[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeAdded, Category: CategoryField, Name: typeName + "." + name})
+		}
+	}
+
+	for _, name := range sortedKeys(old) {
+		if nf, ok := new[name]; ok && old[name].Type != nf.Type {
+			changes = append(changes, Change{Kind: ChangeChanged, Category: CategoryField, Name: typeName + "." + name,
+				Old: old[name].Type, New: nf.Type, Breaking: true})
+		}
+	}
+
+	return changes
+}
+
+// diffSignatures compares named signatures (functions or methods). prefix is
+// prepended to names in the resulting changes. When additionsBreak is set,
+// additions are reported as breaking.
+func diffSignatures(category Category, prefix string, old, new map[string]string, additionsBreak bool) []Change {
+	var changes []Change
+
+	removed, added := missingKeys(old, new), missingKeys(new, old)
+
+	renames := matchUnique(removed, added, func(o, n string) bool {
+		return old[o] == new[n]
+	})
+	for o, n := range renames {
+		changes = append(changes, Change{Kind: ChangeRenamed, Category: category, Name: prefix + o, NewName: prefix + n, Breaking: true})
+	}
+	for _, name := range removed {
+		if _, ok := renames[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Category: category, Name: prefix + name, Breaking: true})
+		}
+	}
+	renamedTo := invert(renames)
+	for _, name := range added {
+		if _, ok := renamedTo[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeAdded, Category: category, Name: prefix + name, Breaking: additionsBreak})
+		}
+	}
+
+	for _, name := range sortedKeys(old) {
+		if ns, ok := new[name]; ok && old[name] != ns {
+			changes = append(changes, Change{Kind: ChangeChanged, Category: category, Name: prefix + name,
+				Old: old[name], New: ns, Breaking: true})
+		}
+	}
+
+	return changes
+}
+
+func diffValues(oldSurface, newSurface *Surface) []Change {
+	var changes []Change
+	old, new := oldSurface.Values, newSurface.Values
+
+	removed, added := missingKeys(old, new), missingKeys(new, old)
+
+	// A constant with the same type and value under a new name is a rename.
+	renames := matchUnique(removed, added, func(o, n string) bool {
+		ov, nv := old[o], new[n]
+		return ov.IsConst && nv.IsConst && ov.Value != "" && ov.Type == nv.Type && ov.Value == nv.Value
+	})
+	for o, n := range renames {
+		changes = append(changes, Change{Kind: ChangeRenamed, Category: valueCategory(old[o], oldSurface), Name: o, NewName: n, Breaking: true})
+	}
+	for _, name := range removed {
+		if _, ok := renames[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Category: valueCategory(old[name], oldSurface), Name: name, Breaking: true})
+		}
+	}
+	renamedTo := invert(renames)
+	for _, name := range added {
+		if _, ok := renamedTo[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeAdded, Category: valueCategory(new[name], newSurface), Name: name})
+		}
+	}
+
+	for _, name := range sortedKeys(old) {
+		ov, nv := old[name], new[name]
+		if nv == nil || ov.Type == nv.Type {
+			continue
+		}
+		changes = append(changes, Change{Kind: ChangeChanged, Category: valueCategory(ov, oldSurface), Name: name,
+			Old: ov.Type, New: nv.Type, Breaking: true})
+	}
+
+	return changes
+}
+
+// valueCategory classifies constants whose type is declared in the surface
+// as enum values.
+func valueCategory(v *Value, s *Surface) Category {
+	if !v.IsConst {
+		return CategoryVariable
+	}
+	if _, ok := s.Types[v.Type]; ok {
+		return CategoryEnumValue
+	}
+	return CategoryConstant
+}
+
+// typeShape returns a name-independent description of a type, used to detect
+// renamed types.
+func typeShape(t *Type) string {
+	if !t.IsStruct {
+		return fmt.Sprintf("%v %s", t.IsAlias, t.Underlying)
+	}
+	fields := make([]string, 0, len(t.Fields))
+	for _, name := range sortedKeys(t.Fields) {
+		f := t.Fields[name]
+		fields = append(fields, name+" "+f.Type+" "+f.JSONName)
+	}
+	return "struct{" + strings.Join(fields, "; ") + "}"
+}
+
+func typeDecl(t *Type) string {
+	if t.IsAlias {
+		return "= " + t.Underlying
+	}
+	return t.Underlying
+}
+
+// matchUnique pairs removed and added names for which match returns true,
+// skipping any name that has more than one candidate.
+func matchUnique(removed, added []string, match func(o, n string) bool) map[string]string {
+	result := make(map[string]string)
+	candidates := make(map[string][]string)
+	reverse := make(map[string]int)
+	for _, o := range removed {
+		for _, n := range added {
+			if match(o, n) {
+				candidates[o] = append(candidates[o], n)
+				reverse[n]++
+			}
+		}
+	}
+	for o, ns := range candidates {
+		if len(ns) == 1 && reverse[ns[0]] == 1 {
+			result[o] = ns[0]
+		}
+	}
+	return result
+}
+
+func invert(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// missingKeys returns the sorted keys of a that are not present in b.
+func missingKeys[V any](a, b map[string]V) []string {
+	var result []string
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			result = append(result, k)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
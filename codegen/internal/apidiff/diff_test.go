@@ -0,0 +1,147 @@
+package apidiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const oldSource = `package api
+
+type Pet struct {
+	Name string ` + "`json:\"name\"`" + `
+	Tag  *string ` + "`json:\"tag,omitempty\"`" + `
+	Age  int ` + "`json:\"age\"`" + `
+}
+
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+type Owner struct {
+	ID int ` + "`json:\"id\"`" + `
+}
+
+type ClientInterface interface {
+	FindPets(limit int) error
+}
+
+type Client struct{}
+
+func (c *Client) FindPets(limit int) error { return nil }
+
+func NewClient(server string) (*Client, error) { return nil, nil }
+
+func helper() {}
+`
+
+const newSource = `package api
+
+type Pet struct {
+	Name  string ` + "`json:\"name\"`" + `
+	Label *string ` + "`json:\"tag,omitempty\"`" + `
+	Age   int64 ` + "`json:\"age\"`" + `
+	Color string ` + "`json:\"color\"`" + `
+}
+
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusGone      PetStatus = "sold"
+	PetStatusPending   PetStatus = "pending"
+)
+
+type PetOwner struct {
+	ID int ` + "`json:\"id\"`" + `
+}
+
+type ClientInterface interface {
+	FindPets(limit int) error
+	AddPet(name string) error
+}
+
+type Client struct{}
+
+func (c *Client) FindPets(limit int) error { return nil }
+
+func (c *Client) AddPet(name string) error { return nil }
+
+func NewClient(server string, opts ...string) (*Client, error) { return nil, nil }
+`
+
+func TestDiffSources(t *testing.T) {
+	changes, err := DiffSources(oldSource, newSource)
+	require.NoError(t, err)
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+
+	assert.Equal(t, []string{
+		"Renamed type `Owner` to `PetOwner`",
+		"Renamed field `Pet.Tag` to `Pet.Label`",
+		"Renamed enum value `PetStatusSold` to `PetStatusGone`",
+		"Changed function `NewClient` from `func(server string) (*Client, error)` to `func(server string, opts ...string) (*Client, error)`",
+		"Changed field `Pet.Age` from `int` to `int64`",
+		"Added method `Client.AddPet`",
+		"Added method `ClientInterface.AddPet`",
+		"Added field `Pet.Color`",
+		"Added enum value `PetStatusPending`",
+	}, got)
+}
+
+func TestDiffSources_InterfaceAdditionsAreBreaking(t *testing.T) {
+	changes, err := DiffSources(oldSource, newSource)
+	require.NoError(t, err)
+
+	for _, c := range changes {
+		switch c.Name {
+		case "ClientInterface.AddPet":
+			assert.True(t, c.Breaking)
+		case "Client.AddPet", "Pet.Color":
+			assert.False(t, c.Breaking)
+		}
+	}
+	assert.True(t, HasBreakingChanges(changes))
+}
+
+func TestDiffSources_NoChanges(t *testing.T) {
+	changes, err := DiffSources(oldSource, oldSource)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Equal(t, "No API changes.\n", FormatChangelog(changes))
+}
+
+func TestChangelog(t *testing.T) {
+	changelog, err := Changelog(oldSource, newSource)
+	require.NoError(t, err)
+
+	assert.Contains(t, changelog, "### Breaking changes\n\n- Renamed type `Owner` to `PetOwner`\n")
+	assert.Contains(t, changelog, "\n### Additions\n\n- Added method `Client.AddPet`\n")
+}
+
+func TestExtract_IgnoresUnexported(t *testing.T) {
+	s, err := Extract(oldSource)
+	require.NoError(t, err)
+
+	assert.NotContains(t, s.Funcs, "helper")
+	assert.Contains(t, s.Funcs, "NewClient")
+	assert.Equal(t, "PetStatus", s.Values["PetStatusSold"].Type)
+}
+
+func TestDiffFiles_SplitSource(t *testing.T) {
+	// The same API split across two files has no changes.
+	main := "package api\n\ntype Pet struct{}\n"
+	chunk := "package api\n\nfunc (p *Pet) Name() string { return \"\" }\n"
+	whole := "package api\n\ntype Pet struct{}\n\nfunc (p *Pet) Name() string { return \"\" }\n"
+
+	changes, err := DiffFiles([]string{main, chunk}, []string{whole})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
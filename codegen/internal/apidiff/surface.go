@@ -0,0 +1,228 @@
+// Package apidiff compares the exported API surface of two generated Go
+// source files. It is used to produce changelogs between generation runs.
+package apidiff
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Surface is the exported API of a generated Go file.
+type Surface struct {
+	Types  map[string]*Type  // Exported types by name
+	Funcs  map[string]string // Exported package-level functions: name -> signature
+	Values map[string]*Value // Exported constants and variables by name
+}
+
+// Type describes an exported type declaration.
+type Type struct {
+	Name       string
+	Underlying string            // Rendered type expression, e.g. "struct{...}" or "string"
+	IsAlias    bool              // Declared with "type X = Y"
+	IsStruct   bool              // Fields are populated
+	Fields     map[string]*Field // Exported struct fields by name
+	IsIface    bool              // Interface methods are populated in Methods
+	Methods    map[string]string // Exported methods (or interface methods): name -> signature
+}
+
+// Field describes an exported struct field.
+type Field struct {
+	Name     string
+	Type     string
+	JSONName string // Name from the json struct tag, used for rename detection
+}
+
+// Value describes an exported constant or variable.
+type Value struct {
+	Name    string
+	IsConst bool
+	Type    string // Declared type name, empty when untyped
+	Value   string // Rendered initializer, empty when not set
+}
+
+// Extract parses a Go source file and returns its exported API surface.
+func Extract(src string) (*Surface, error) {
+	return ExtractFiles(src)
+}
+
+// ExtractFiles parses the Go source files of a single package and returns
+// their combined exported API surface.
+func ExtractFiles(srcs ...string) (*Surface, error) {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(srcs))
+	for i, src := range srcs {
+		f, err := parser.ParseFile(fset, fmt.Sprintf("generated_%d.go", i), src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	s := &Surface{
+		Types:  make(map[string]*Type),
+		Funcs:  make(map[string]string),
+		Values: make(map[string]*Value),
+	}
+
+	// Types first, so methods can be attached in the second pass.
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			switch gd.Tok {
+			case token.TYPE:
+				for _, spec := range gd.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.IsExported() {
+						s.Types[ts.Name.Name] = extractType(ts)
+					}
+				}
+			case token.CONST, token.VAR:
+				s.extractValues(gd)
+			}
+		}
+	}
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || !fd.Name.IsExported() {
+				continue
+			}
+			sig := types.ExprString(fd.Type)
+			if fd.Recv == nil || len(fd.Recv.List) == 0 {
+				s.Funcs[fd.Name.Name] = sig
+				continue
+			}
+			recv := receiverTypeName(fd.Recv.List[0].Type)
+			if t, ok := s.Types[recv]; ok {
+				if t.Methods == nil {
+					t.Methods = make(map[string]string)
+				}
+				t.Methods[fd.Name.Name] = sig
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func extractType(ts *ast.TypeSpec) *Type {
+	t := &Type{
+		Name:       ts.Name.Name,
+		Underlying: types.ExprString(ts.Type),
+		IsAlias:    ts.Assign.IsValid(),
+	}
+	if ts.TypeParams != nil {
+		t.Underlying = typeParamsString(ts.TypeParams) + " " + t.Underlying
+	}
+
+	switch st := ts.Type.(type) {
+	case *ast.StructType:
+		t.IsStruct = true
+		t.Fields = make(map[string]*Field)
+		for _, field := range st.Fields.List {
+			typ := types.ExprString(field.Type)
+			jsonName := jsonTagName(field.Tag)
+			if len(field.Names) == 0 {
+				name := receiverTypeName(field.Type)
+				if ast.IsExported(name) {
+					t.Fields[name] = &Field{Name: name, Type: typ, JSONName: jsonName}
+				}
+				continue
+			}
+			for _, n := range field.Names {
+				if n.IsExported() {
+					t.Fields[n.Name] = &Field{Name: n.Name, Type: typ, JSONName: jsonName}
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		t.IsIface = true
+		t.Methods = make(map[string]string)
+		for _, m := range st.Methods.List {
+			for _, n := range m.Names {
+				if n.IsExported() {
+					t.Methods[n.Name] = types.ExprString(m.Type)
+				}
+			}
+		}
+	}
+	return t
+}
+
+func (s *Surface) extractValues(gd *ast.GenDecl) {
+	// Constants in a group inherit the type of the previous spec when omitted.
+	var lastType string
+	for _, spec := range gd.Specs {
+		vs, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		typ := ""
+		if vs.Type != nil {
+			typ = types.ExprString(vs.Type)
+		} else if gd.Tok == token.CONST && len(vs.Values) == 0 {
+			typ = lastType
+		}
+		lastType = typ
+		for i, n := range vs.Names {
+			if !n.IsExported() {
+				continue
+			}
+			v := &Value{Name: n.Name, IsConst: gd.Tok == token.CONST, Type: typ}
+			if i < len(vs.Values) {
+				v.Value = types.ExprString(vs.Values[i])
+			}
+			s.Values[n.Name] = v
+		}
+	}
+}
+
+// typeParamsString renders a type parameter list, e.g. "[T any]".
+func typeParamsString(fl *ast.FieldList) string {
+	parts := make([]string, 0, len(fl.List))
+	for _, f := range fl.List {
+		names := make([]string, 0, len(f.Names))
+		for _, n := range f.Names {
+			names = append(names, n.Name)
+		}
+		parts = append(parts, strings.Join(names, ", ")+" "+types.ExprString(f.Type))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(t.X)
+	}
+	return ""
+}
+
+func jsonTagName(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+	raw, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(reflect.StructTag(raw).Get("json"), ",")
+	return name
+}
@@ -0,0 +1,158 @@
+package apidiff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// VersionBump is a semantic version increment.
+type VersionBump string
+
+const (
+	BumpPatch VersionBump = "patch"
+	BumpMinor VersionBump = "minor"
+	BumpMajor VersionBump = "major"
+)
+
+// SuggestBump recommends the semantic version increment for a set of API
+// changes: major when any change is breaking, minor when the API only grew,
+// and patch when the exported API is unchanged.
+func SuggestBump(changes []Change) VersionBump {
+	switch {
+	case HasBreakingChanges(changes):
+		return BumpMajor
+	case len(changes) > 0:
+		return BumpMinor
+	default:
+		return BumpPatch
+	}
+}
+
+// NextVersion applies bump to a semantic version such as "v1.2.3", dropping
+// any pre-release or build suffix. The leading "v" is optional and preserved.
+//
+// A pre-release version already precedes its release, so releasing it
+// satisfies any bump up to the level it was cut at: "v2.0.0-rc.1" becomes
+// "v2.0.0" whatever the bump, and "v1.3.0-rc.1" becomes "v1.3.0" for a minor
+// or patch bump.
+//
+// Following Go module conventions, a major bump of a v0 version increments
+// the minor version instead, since v0 makes no compatibility promise and
+// moving to v1 is a deliberate decision. Note that moving beyond v1 also
+// requires a "/vN" suffix on the module path.
+func NextVersion(current string, bump VersionBump) (string, error) {
+	prefix := ""
+	version := current
+	if strings.HasPrefix(version, "v") {
+		prefix, version = "v", version[1:]
+	}
+
+	version, build, hasBuild := strings.Cut(version, "+")
+	if hasBuild && !validIdentifiers(build, false) {
+		return "", fmt.Errorf("invalid semantic version %q", current)
+	}
+	version, preRelease, hasPreRelease := strings.Cut(version, "-")
+	if hasPreRelease && !validIdentifiers(preRelease, true) {
+		return "", fmt.Errorf("invalid semantic version %q", current)
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid semantic version %q", current)
+	}
+	var nums [3]int
+	for i, p := range parts {
+		if !validNumber(p) {
+			return "", fmt.Errorf("invalid semantic version %q", current)
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return "", fmt.Errorf("invalid semantic version %q", current)
+		}
+		nums[i] = n
+	}
+	major, minor, patch := nums[0], nums[1], nums[2]
+
+	if bump == BumpMajor && major == 0 {
+		bump = BumpMinor
+	}
+	var rank int
+	switch bump {
+	case BumpPatch:
+		rank = 0
+	case BumpMinor:
+		rank = 1
+	case BumpMajor:
+		rank = 2
+	default:
+		return "", fmt.Errorf("unknown version bump %q", bump)
+	}
+
+	if hasPreRelease {
+		// The level the pre-release was cut at, ranked like bumps.
+		level := 0
+		switch {
+		case minor == 0 && patch == 0:
+			level = 2
+		case patch == 0:
+			level = 1
+		}
+		if major == 0 && level == 2 {
+			level = 1
+		}
+		if rank <= level {
+			return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+		}
+	}
+
+	switch bump {
+	case BumpMajor:
+		major, minor, patch = major+1, 0, 0
+	case BumpMinor:
+		minor, patch = minor+1, 0
+	case BumpPatch:
+		patch++
+	}
+
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+}
+
+// validNumber reports whether s is a numeric identifier: digits without a
+// leading zero, unless it is "0" itself.
+func validNumber(s string) bool {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validIdentifiers reports whether s is a dot-separated list of non-empty
+// alphanumeric identifiers, as used by pre-release and build suffixes.
+// Numeric pre-release identifiers must not have leading zeros.
+func validIdentifiers(s string, preRelease bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for _, r := range id {
+			switch {
+			case r >= '0' && r <= '9':
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if preRelease && numeric && !validNumber(id) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package apidiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestBump(t *testing.T) {
+	changes, err := DiffSources(oldSource, newSource)
+	require.NoError(t, err)
+	assert.Equal(t, BumpMajor, SuggestBump(changes))
+
+	additive, err := DiffSources(oldSource, oldSource+"\ntype Toy struct{}\n")
+	require.NoError(t, err)
+	assert.Equal(t, BumpMinor, SuggestBump(additive))
+
+	assert.Equal(t, BumpPatch, SuggestBump(nil))
+}
+
+func TestNextVersion(t *testing.T) {
+	tests := map[string]struct {
+		current string
+		bump    VersionBump
+		want    string
+	}{
+		"patch":                {current: "v1.2.3", bump: BumpPatch, want: "v1.2.4"},
+		"minor":                {current: "v1.2.3", bump: BumpMinor, want: "v1.3.0"},
+		"major":                {current: "v1.2.3", bump: BumpMajor, want: "v2.0.0"},
+		"major on v0 is minor": {current: "v0.4.1", bump: BumpMajor, want: "v0.5.0"},
+		"no v prefix":          {current: "1.2.3", bump: BumpMinor, want: "1.3.0"},
+		"build is dropped":     {current: "v1.2.3+build.5", bump: BumpPatch, want: "v1.2.4"},
+
+		"major pre-release released by patch": {current: "v2.0.0-rc.1", bump: BumpPatch, want: "v2.0.0"},
+		"major pre-release released by minor": {current: "v2.0.0-rc.1", bump: BumpMinor, want: "v2.0.0"},
+		"major pre-release released by major": {current: "v2.0.0-rc.1+build", bump: BumpMajor, want: "v2.0.0"},
+		"minor pre-release released by patch": {current: "v1.3.0-beta", bump: BumpPatch, want: "v1.3.0"},
+		"minor pre-release released by minor": {current: "v1.3.0-beta", bump: BumpMinor, want: "v1.3.0"},
+		"minor pre-release bumped by major":   {current: "v1.3.0-beta", bump: BumpMajor, want: "v2.0.0"},
+		"patch pre-release released by patch": {current: "v1.2.3-rc.1", bump: BumpPatch, want: "v1.2.3"},
+		"patch pre-release bumped by minor":   {current: "v1.2.3-rc.1", bump: BumpMinor, want: "v1.3.0"},
+		"v0 pre-release released by major":    {current: "v0.5.0-rc.1", bump: BumpMajor, want: "v0.5.0"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NextVersion(tc.current, tc.bump)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNextVersion_Invalid(t *testing.T) {
+	invalid := []string{
+		"", "v1", "v1.2", "v1.x.3", "v1.2.-3",
+		"01.2.3", "v1.02.3", "v1.2.03",
+		"v1.2.3-", "v1.2.3-rc..1", "v1.2.3-rc.01", "v1.2.3-rc_1", "v1.2.3+", "v1.2.3+a..b",
+	}
+	for _, v := range invalid {
+		_, err := NextVersion(v, BumpPatch)
+		assert.Error(t, err, v)
+	}
+
+	_, err := NextVersion("v1.2.3", VersionBump("huge"))
+	assert.Error(t, err)
+}
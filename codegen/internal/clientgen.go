@@ -3,10 +3,15 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"mime"
+	"slices"
+	"sort"
 	"strings"
 	"text/template"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 
 	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/templates"
 )
@@ -64,15 +69,48 @@ func senderTemplateEntries() []templateEntry {
 // SenderTemplateData is the unified template data for client and initiator templates.
 // Templates use {{if .IsClient}} to branch on the few points where they diverge.
 type SenderTemplateData struct {
-	IsClient    bool                   // true for client, false for initiator
-	Prefix      string                 // "" for client, "Webhook"/"Callback" for initiator
-	PrefixLower string                 // "" for client, "webhook"/"callback" for initiator
-	TypeName    string                 // "Client" or "WebhookInitiator"
-	Receiver    string                 // "c" or "p"
-	OptionType  string                 // "ClientOption" or "WebhookInitiatorOption"
-	ErrorType   string                 // "ClientHttpError" or "WebhookHttpError"
-	SimpleType  string                 // "SimpleClient" or "SimpleWebhookInitiator"
-	Operations  []*OperationDescriptor // Operations to generate for
+	IsClient         bool                   // true for client, false for initiator
+	Prefix           string                 // "" for client, "Webhook"/"Callback" for initiator
+	PrefixLower      string                 // "" for client, "webhook"/"callback" for initiator
+	TypeName         string                 // "Client" or "WebhookInitiator"
+	Receiver         string                 // "c" or "p"
+	OptionType       string                 // "ClientOption" or "WebhookInitiatorOption"
+	ErrorType        string                 // "ClientHttpError" or "WebhookHttpError"
+	SimpleType       string                 // "SimpleClient" or "SimpleWebhookInitiator"
+	Operations       []*OperationDescriptor // Operations to generate for
+	UserAgent        string                 // Default User-Agent header, client only
+	Redactions       DebugRedactions        // Values redacted from debug dumps, client only
+	HideMethods      bool                   // Unexport the methods returning *http.Response, client only
+	HideBuilders     bool                   // Unexport the request builders, client only
+	StreamChannels   bool                   // Generate channel adapters of streaming operations, client only
+	RateLimits       RateLimitHeadersConfig // Rate limit headers read by WithRateLimiting, client only
+	ResumableStreams bool                   // Generate resumable handles of event streams, client only
+	OIDCSchemes      []OIDCScheme           // OpenID Connect security schemes, client only
+	APIKeySchemes    []APIKeyScheme         // API key security schemes, client only
+}
+
+// OIDCScheme is a security scheme of type openIdConnect.
+type OIDCScheme struct {
+	Name   string // Name of the scheme in components/securitySchemes
+	GoName string // Go identifier derived from Name
+	URL    string // openIdConnectUrl of the provider's discovery document
+}
+
+// APIKeyScheme is a security scheme of type apiKey.
+type APIKeyScheme struct {
+	Name      string // Name of the scheme in components/securitySchemes
+	GoName    string // Go identifier derived from Name
+	In        string // Location of the key: header, query or cookie
+	ParamName string // Name of the header, query parameter or cookie
+}
+
+// DebugRedactions lists the header, query parameter and body field names
+// which the generated client's debug dumper redacts, in addition to its
+// built-in credential headers.
+type DebugRedactions struct {
+	Headers     []string
+	QueryParams []string
+	Fields      []string
 }
 
 // sharedServerTemplateEntries converts SharedServerTemplates map to a slice of templateEntry.
@@ -86,10 +124,21 @@ func sharedServerTemplateEntries() []templateEntry {
 
 // ClientGenerator generates client code from operation descriptors.
 type ClientGenerator struct {
-	tmpl           *template.Template
-	schemaIndex    map[string]*SchemaDescriptor
-	generateSimple bool
-	modelsPackage  *ModelsPackage
+	tmpl             *template.Template
+	schemaIndex      map[string]*SchemaDescriptor
+	generateSimple   bool
+	generateMock     bool
+	tagClients       bool
+	skipRaw          bool
+	skipBuilders     bool
+	streamChannels   bool
+	modelsPackage    *ModelsPackage
+	userAgent        string
+	redactions       DebugRedactions
+	rateLimits       RateLimitHeadersConfig
+	resumableStreams bool
+	oidcSchemes      []OIDCScheme
+	apiKeySchemes    []APIKeyScheme
 }
 
 // NewClientGenerator creates a new client generator.
@@ -110,11 +159,225 @@ func NewClientGenerator(schemaIndex map[string]*SchemaDescriptor, generateSimple
 	}, nil
 }
 
+// SetUserAgent sets the default User-Agent sent by the generated client.
+func (g *ClientGenerator) SetUserAgent(userAgent string) {
+	g.userAgent = userAgent
+}
+
+// SetDebugRedactions sets the values redacted by the generated client's debug
+// dumper.
+func (g *ClientGenerator) SetDebugRedactions(redactions DebugRedactions) {
+	g.redactions = redactions
+}
+
+// SetGenerateMock enables generation of MockClient, and MockSimpleClient when
+// the simple client is generated too.
+func (g *ClientGenerator) SetGenerateMock(generateMock bool) {
+	g.generateMock = generateMock
+}
+
+// SetGenerateTagClients enables generation of an interface and sub-client
+// per operation tag.
+func (g *ClientGenerator) SetGenerateTagClients(tagClients bool) {
+	g.tagClients = tagClients
+}
+
+// SetClientLayers leaves the raw client methods and the request builders out
+// of the public API. Hidden layers are unexported rather than dropped, since
+// the layers above them call into them.
+func (g *ClientGenerator) SetClientLayers(skipRaw, skipBuilders bool) {
+	g.skipRaw = skipRaw
+	g.skipBuilders = skipBuilders
+}
+
+// SetGenerateStreamChannels enables generation of channel adapters for
+// operations streaming their request or response items.
+func (g *ClientGenerator) SetGenerateStreamChannels(streamChannels bool) {
+	g.streamChannels = streamChannels
+}
+
+// SetGenerateResumableStreams enables generation of a handle per operation
+// streaming server-sent events, which resumes the stream when interrupted.
+func (g *ClientGenerator) SetGenerateResumableStreams(resumableStreams bool) {
+	g.resumableStreams = resumableStreams
+}
+
+// SetRateLimitHeaders sets the response headers the generated client's rate
+// limiter reads.
+func (g *ClientGenerator) SetRateLimitHeaders(headers RateLimitHeadersConfig) {
+	g.rateLimits = headers
+}
+
+// SetOIDCSchemes sets the OpenID Connect security schemes the generated
+// client gets options for.
+func (g *ClientGenerator) SetOIDCSchemes(schemes []OIDCScheme) {
+	g.oidcSchemes = schemes
+}
+
+// gatherOIDCSchemes collects the security schemes of type openIdConnect
+// declaring an openIdConnectUrl, in spec order.
+func gatherOIDCSchemes(doc *v3.Document) []OIDCScheme {
+	var schemes []OIDCScheme
+	if doc.Components == nil || doc.Components.SecuritySchemes == nil {
+		return nil
+	}
+	for pair := doc.Components.SecuritySchemes.First(); pair != nil; pair = pair.Next() {
+		scheme := pair.Value()
+		if scheme == nil || scheme.Type != "openIdConnect" || scheme.OpenIdConnectUrl == "" {
+			continue
+		}
+		schemes = append(schemes, OIDCScheme{
+			Name:   pair.Key(),
+			GoName: ToGoIdentifier(pair.Key()),
+			URL:    scheme.OpenIdConnectUrl,
+		})
+	}
+	return schemes
+}
+
+// SetAPIKeySchemes sets the API key security schemes the generated client
+// gets options for.
+func (g *ClientGenerator) SetAPIKeySchemes(schemes []APIKeyScheme) {
+	g.apiKeySchemes = schemes
+}
+
+// gatherAPIKeySchemes collects the security schemes of type apiKey sent in a
+// header, query parameter or cookie, in spec order.
+func gatherAPIKeySchemes(doc *v3.Document) []APIKeyScheme {
+	var schemes []APIKeyScheme
+	if doc.Components == nil || doc.Components.SecuritySchemes == nil {
+		return nil
+	}
+	for pair := doc.Components.SecuritySchemes.First(); pair != nil; pair = pair.Next() {
+		scheme := pair.Value()
+		if scheme == nil || scheme.Type != "apiKey" || scheme.Name == "" {
+			continue
+		}
+		switch scheme.In {
+		case "header", "query", "cookie":
+		default:
+			continue
+		}
+		schemes = append(schemes, APIKeyScheme{
+			Name:      pair.Key(),
+			GoName:    ToGoIdentifier(pair.Key()),
+			In:        scheme.In,
+			ParamName: scheme.Name,
+		})
+	}
+	return schemes
+}
+
+// gatherRateLimitHeaders fills in the rate limit headers left unset in
+// configured: with the first response headers of ops named like
+// X-RateLimit-Remaining and X-RateLimit-Reset, ignoring case and dashes, or
+// else with those names.
+func gatherRateLimitHeaders(ops []*OperationDescriptor, configured RateLimitHeadersConfig) RateLimitHeadersConfig {
+	headers := configured
+	for _, op := range ops {
+		for _, resp := range op.Responses {
+			for _, h := range resp.Headers {
+				name := strings.ToLower(strings.ReplaceAll(h.Name, "-", ""))
+				if headers.Remaining == "" && strings.HasSuffix(name, "ratelimitremaining") {
+					headers.Remaining = h.Name
+				}
+				if headers.Reset == "" && strings.HasSuffix(name, "ratelimitreset") {
+					headers.Reset = h.Name
+				}
+			}
+		}
+	}
+	if headers.Remaining == "" {
+		headers.Remaining = "X-RateLimit-Remaining"
+	}
+	if headers.Reset == "" {
+		headers.Reset = "X-RateLimit-Reset"
+	}
+	return headers
+}
+
+// gatherDebugRedactions collects the names of values to redact from debug
+// dumps: properties marked with x-oapi-codegen-sensitive or with format
+// "password", and the headers and query parameters carrying API keys.
+func gatherDebugRedactions(doc *v3.Document, schemas []*SchemaDescriptor) DebugRedactions {
+	var redactions DebugRedactions
+
+	fields := make(map[string]bool)
+	for _, desc := range schemas {
+		if desc.IsReference() || desc.Schema == nil || desc.Schema.Properties == nil {
+			continue
+		}
+		for pair := desc.Schema.Properties.First(); pair != nil; pair = pair.Next() {
+			if isSensitiveSchema(pair.Value().Schema(), desc.Path.Append("properties", pair.Key()).String()) {
+				fields[pair.Key()] = true
+			}
+		}
+	}
+	for name := range fields {
+		redactions.Fields = append(redactions.Fields, name)
+	}
+	sort.Strings(redactions.Fields)
+
+	if doc.Components != nil && doc.Components.SecuritySchemes != nil {
+		for pair := doc.Components.SecuritySchemes.First(); pair != nil; pair = pair.Next() {
+			scheme := pair.Value()
+			if scheme == nil || scheme.Type != "apiKey" || scheme.Name == "" {
+				continue
+			}
+			switch scheme.In {
+			case "header":
+				redactions.Headers = append(redactions.Headers, scheme.Name)
+			case "query":
+				redactions.QueryParams = append(redactions.QueryParams, scheme.Name)
+			}
+		}
+	}
+
+	return redactions
+}
+
+// isSensitiveSchema reports whether values of a property schema are redacted
+// from debug dumps. Extensions of a $ref target apply to the property.
+func isSensitiveSchema(schema *base.Schema, path string) bool {
+	if schema == nil {
+		return false
+	}
+	if schema.Extensions != nil {
+		if ext, err := ParseExtensions(schema.Extensions, path); err == nil && ext.Sensitive != nil {
+			return *ext.Sensitive
+		}
+	}
+	return schema.Format == "password"
+}
+
+// defaultUserAgent builds a User-Agent from the spec title and version, e.g.
+// "Swagger-Petstore/1.0.0".
+func defaultUserAgent(info *base.Info) string {
+	product := "oapi-codegen-client"
+	if info == nil {
+		return product
+	}
+	if title := strings.Join(strings.Fields(info.Title), "-"); title != "" {
+		product = title
+	}
+	if version := strings.Join(strings.Fields(info.Version), "-"); version != "" {
+		product += "/" + version
+	}
+	return product
+}
+
 // clientFuncs returns template functions specific to client generation.
 func clientFuncs(schemaIndex map[string]*SchemaDescriptor, modelsPackage *ModelsPackage, typeMapping TypeMapping) template.FuncMap {
 	return template.FuncMap{
 		"pathFmt":                        pathFmt,
 		"isSimpleOperation":              isSimpleOperation,
+		"isDownloadOperation":            isDownloadOperation,
+		"isStreamOperation":              isStreamOperation,
+		"streamResponseContent":          streamResponseContent,
+		"streamRequestBody":              streamRequestBody,
+		"hasCacheableOperations":         hasCacheableOperations,
+		"hasLROOperations":               hasLROOperations,
+		"hasOperationServers":            hasOperationServers,
 		"simpleOperationSuccessResponse": simpleOperationSuccessResponse,
 		"errorResponseForOperation":      errorResponseForOperation,
 		"defaultTypedBody": func(op *OperationDescriptor) *RequestBodyDescriptor {
@@ -184,8 +447,134 @@ func isSimpleOperation(op *OperationDescriptor) bool {
 		return false
 	}
 
-	// The single content type must be JSON
-	return success.Contents[0].IsJSON
+	// The single content type must be JSON, and not a stream of JSON items
+	return success.Contents[0].IsJSON && !success.Contents[0].IsSequential()
+}
+
+// isDownloadOperation returns true if an operation's single success response
+// has a single binary content type, either application/octet-stream or a
+// string schema of format binary. SimpleClient streams such responses to an
+// io.Writer instead of buffering them.
+func isDownloadOperation(op *OperationDescriptor) bool {
+	if len(op.Responses) == 0 || (op.HasBody && !op.HasTypedBody()) {
+		return false
+	}
+	var success *ResponseDescriptor
+	for _, r := range op.Responses {
+		if strings.HasPrefix(r.StatusCode, "2") {
+			if success != nil {
+				return false
+			}
+			success = r
+		}
+	}
+	if success == nil || len(success.Contents) != 1 {
+		return false
+	}
+	return isBinaryContent(success.Contents[0])
+}
+
+// streamResponseContent returns the content of an operation's single
+// success response if it streams items described by an itemSchema, such as
+// server-sent events or JSON Lines, and nil otherwise. SimpleClient returns
+// an iterator over the items of such responses.
+func streamResponseContent(op *OperationDescriptor) *ResponseContentDescriptor {
+	if len(op.Responses) == 0 || (op.HasBody && !op.HasTypedBody()) {
+		return nil
+	}
+	var success *ResponseDescriptor
+	for _, r := range op.Responses {
+		if strings.HasPrefix(r.StatusCode, "2") {
+			if success != nil {
+				return nil
+			}
+			success = r
+		}
+	}
+	if success == nil || len(success.Contents) != 1 {
+		return nil
+	}
+	if content := success.Contents[0]; content.IsSequential() && content.ItemType != "" {
+		return content
+	}
+	return nil
+}
+
+// isStreamOperation returns true if SimpleClient returns an iterator over
+// the items of the operation's response.
+func isStreamOperation(op *OperationDescriptor) bool {
+	return streamResponseContent(op) != nil
+}
+
+// streamRequestBody returns the first request body of an operation which
+// streams items described by an itemSchema, and nil if there is none. Client
+// gets methods sending such bodies from an iterator or channel.
+func streamRequestBody(op *OperationDescriptor) *RequestBodyDescriptor {
+	for _, body := range op.Bodies {
+		if IsSequentialMediaType(body.ContentType) && body.ItemType != "" {
+			return body
+		}
+	}
+	return nil
+}
+
+// isBinaryContent returns true if a response content holds opaque bytes.
+func isBinaryContent(content *ResponseContentDescriptor) bool {
+	if content.IsJSON {
+		return false
+	}
+	if mediaType, _, err := mime.ParseMediaType(content.ContentType); err == nil && mediaType == "application/octet-stream" {
+		return true
+	}
+	if content.Schema == nil || content.Schema.Schema == nil {
+		return false
+	}
+	schema := content.Schema.Schema
+	return slices.Contains(schema.Type, "string") && schema.Format == "binary"
+}
+
+// hasCacheableOperations returns true if any operation is marked cacheable,
+// in which case the client carries a response cache.
+func hasCacheableOperations(ops []*OperationDescriptor) bool {
+	for _, op := range ops {
+		if op.Cacheable {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLROOperations returns true if any operation is long-running, in which
+// case the client carries polling intervals.
+func hasLROOperations(ops []*OperationDescriptor) bool {
+	for _, op := range ops {
+		if op.LRO != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasStreamRequestBodies returns true if any operation sends a streaming
+// request body.
+func hasStreamRequestBodies(ops []*OperationDescriptor) bool {
+	for _, op := range ops {
+		if streamRequestBody(op) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOperationServers returns true if any operation declares its own servers,
+// or its path does.
+func hasOperationServers(ops []*OperationDescriptor) bool {
+	for _, op := range ops {
+		if op.Server != "" {
+			return true
+		}
+	}
+	return false
 }
 
 // simpleOperationSuccessResponse returns the single success response for a simple operation.
@@ -219,6 +608,15 @@ func errorResponseForOperation(op *OperationDescriptor) *ResponseDescriptor {
 	return nil
 }
 
+// responseTypeName returns the name of the type of a schema in responses:
+// its response variant, if it has one, or else its own.
+func responseTypeName(desc *SchemaDescriptor) string {
+	if desc.ResponseVariant != "" {
+		return desc.ResponseVariant
+	}
+	return desc.ShortName
+}
+
 // goTypeForContent returns the Go type for a response content descriptor.
 // If modelsPackage is set, type names are prefixed with the package name.
 func goTypeForContent(content *ResponseContentDescriptor, schemaIndex map[string]*SchemaDescriptor, modelsPackage *ModelsPackage, typeMapping TypeMapping) string {
@@ -231,7 +629,7 @@ func goTypeForContent(content *ResponseContentDescriptor, schemaIndex map[string
 	// If the schema has a reference, look it up
 	if content.Schema.Ref != "" {
 		if target, ok := schemaIndex[content.Schema.Ref]; ok {
-			return pkgPrefix + target.ShortName
+			return pkgPrefix + responseTypeName(target)
 		}
 	}
 
@@ -241,7 +639,7 @@ func goTypeForContent(content *ResponseContentDescriptor, schemaIndex map[string
 		if itemProxy != nil && itemProxy.IsReference() {
 			ref := itemProxy.GetReference()
 			if target, ok := schemaIndex[ref]; ok {
-				return "[]" + pkgPrefix + target.ShortName
+				return "[]" + pkgPrefix + responseTypeName(target)
 			}
 		}
 	}
@@ -286,7 +684,7 @@ func resolveSchemaType(schema *base.Schema, tm TypeMapping) string {
 		case "string":
 			return resolveFormatType(tm.String, schema.Format)
 		case "integer":
-			return resolveFormatType(tm.Integer, schema.Format)
+			return tm.integerSpec(schema).Type
 		case "number":
 			return resolveFormatType(tm.Number, schema.Format)
 		case "boolean":
@@ -312,9 +710,18 @@ func resolveFormatType(fm FormatMapping, format string) string {
 }
 
 // GenerateBase generates the base client types and helpers.
-func (g *ClientGenerator) GenerateBase() (string, error) {
+func (g *ClientGenerator) GenerateBase(data SenderTemplateData) (string, error) {
 	var buf bytes.Buffer
-	if err := g.tmpl.ExecuteTemplate(&buf, "base", nil); err != nil {
+	if err := g.tmpl.ExecuteTemplate(&buf, "base", data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateRequestOptions generates the per-call RequestOption type and helpers.
+func (g *ClientGenerator) GenerateRequestOptions(data SenderTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "sender_request_options", data); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -356,6 +763,126 @@ func (g *ClientGenerator) GenerateSimple(data SenderTemplateData) (string, error
 	return buf.String(), nil
 }
 
+// GenerateMock generates MockClient, unless the raw client is hidden, and
+// MockSimpleClient if the simple client is generated.
+func (g *ClientGenerator) GenerateMock(data SenderTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if !g.skipRaw {
+		if err := g.tmpl.ExecuteTemplate(&buf, "mock", data); err != nil {
+			return "", err
+		}
+	}
+	if g.generateSimple {
+		buf.WriteString("\n")
+		if err := g.tmpl.ExecuteTemplate(&buf, "mock_simple", data); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+// GenerateLRO generates the WaitFor helpers of long-running operations.
+func (g *ClientGenerator) GenerateLRO(data SenderTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "lro", data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateStreams generates the methods sending streaming request bodies from
+// an iterator or channel.
+func (g *ClientGenerator) GenerateStreams(data SenderTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "streams", data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// tagClientsTemplateData is the input of the tag_clients template.
+type tagClientsTemplateData struct {
+	Sender SenderTemplateData
+	Tags   []tagClient
+}
+
+// tagClient is the sub-client of the operations sharing a tag.
+type tagClient struct {
+	Tag        string                 // Tag as written in the spec
+	Name       string                 // Go name of the accessor: "Pets"
+	Operations []*OperationDescriptor // Operations with the tag, in spec order
+}
+
+// gatherTagClients groups operations by tag, sorted by tag name. Operations
+// with several tags belong to each of their sub-clients, and untagged
+// operations to none.
+func gatherTagClients(ops []*OperationDescriptor) ([]tagClient, error) {
+	byTag := make(map[string]*tagClient)
+	for _, op := range ops {
+		if op.Spec == nil {
+			continue
+		}
+		for _, tag := range op.Spec.Tags {
+			tc, ok := byTag[tag]
+			if !ok {
+				tc = &tagClient{Tag: tag, Name: ToGoIdentifier(tag)}
+				byTag[tag] = tc
+			}
+			if !slices.Contains(tc.Operations, op) {
+				tc.Operations = append(tc.Operations, op)
+			}
+		}
+	}
+
+	// Accessors are Client methods, so they mustn't collide with its other
+	// methods and fields, or with each other.
+	taken := map[string]string{
+		"Server": "a field", "Client": "a field", "RequestEditors": "a field",
+		"UserAgent": "a field", "DefaultHeaders": "a field", "DefaultQueryParams": "a field",
+		"DialContext": "a field",
+		"ResponseCache": "a field", "Debug": "a field", "FaultInjector": "a field",
+		"Breaker": "a field", "Clock": "a field", "PollInterval": "a field",
+		"MaxPollInterval": "a field", "ForceServer": "a field", "PriorityQueue": "a field",
+		"RateLimiter": "a field",
+	}
+	// Tag clients require the raw client, so method names are exported.
+	var exported SenderTemplateData
+	for _, op := range ops {
+		taken[op.GoOperationID] = "operation " + op.OperationID
+		taken[senderMethodName(exported, op)] = "operation " + op.OperationID
+		for _, body := range op.Bodies {
+			if body.GenerateTyped {
+				taken[senderTypedMethodName(exported, op, body)] = "operation " + op.OperationID
+			}
+		}
+	}
+
+	tags := slices.Sorted(maps.Keys(byTag))
+	result := make([]tagClient, 0, len(tags))
+	for _, tag := range tags {
+		tc := byTag[tag]
+		if other, ok := taken[tc.Name]; ok {
+			return nil, fmt.Errorf("tag-clients: accessor %s of tag %q conflicts with %s", tc.Name, tag, other)
+		}
+		taken[tc.Name] = fmt.Sprintf("the accessor of tag %q", tag)
+		result = append(result, *tc)
+	}
+	return result, nil
+}
+
+// GenerateTagClients generates the per-tag interfaces and sub-clients.
+func (g *ClientGenerator) GenerateTagClients(data SenderTemplateData) (string, error) {
+	tags, err := gatherTagClients(data.Operations)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "tag_clients", tagClientsTemplateData{Sender: data, Tags: tags}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // GenerateParamTypes generates the parameter struct types.
 func (g *ClientGenerator) GenerateParamTypes(ops []*OperationDescriptor) (string, error) {
 	var buf bytes.Buffer
@@ -383,11 +910,16 @@ func generateRequestBodyTypes(ops []*OperationDescriptor, schemaIndex map[string
 			}
 			// Get the underlying type for this request body
 			var targetType string
-			if body.Schema != nil {
+			if body.IsBinary {
+				targetType = "io.Reader"
+			} else if body.Schema != nil {
 				if body.Schema.Ref != "" {
 					// Reference to a component schema
 					if target, ok := schemaIndex[body.Schema.Ref]; ok {
 						targetType = pkgPrefix + target.ShortName
+						if target.RequestVariant != "" {
+							targetType = pkgPrefix + target.RequestVariant
+						}
 					}
 				} else if body.Schema.ShortName != "" {
 					targetType = pkgPrefix + body.Schema.ShortName
@@ -409,15 +941,33 @@ func (g *ClientGenerator) GenerateClient(ops []*OperationDescriptor) (string, er
 	var buf bytes.Buffer
 
 	data := SenderTemplateData{
-		IsClient:   true,
-		Prefix:     "",
-		PrefixLower: "",
-		TypeName:   "Client",
-		Receiver:   "c",
-		OptionType: "ClientOption",
-		ErrorType:  "ClientHttpError",
-		SimpleType: "SimpleClient",
-		Operations: ops,
+		IsClient:         true,
+		Prefix:           "",
+		PrefixLower:      "",
+		TypeName:         "Client",
+		Receiver:         "c",
+		OptionType:       "ClientOption",
+		ErrorType:        "ClientHttpError",
+		SimpleType:       "SimpleClient",
+		Operations:       ops,
+		UserAgent:        g.userAgent,
+		Redactions:       g.redactions,
+		HideMethods:      g.skipRaw,
+		HideBuilders:     g.skipBuilders,
+		StreamChannels:   g.streamChannels,
+		RateLimits:       g.rateLimits,
+		ResumableStreams: g.resumableStreams,
+		OIDCSchemes:      g.oidcSchemes,
+		APIKeySchemes:    g.apiKeySchemes,
+	}
+
+	// Without the raw client, only the operations SimpleClient calls need
+	// methods and request builders.
+	rawData := data
+	if g.skipRaw {
+		rawData.Operations = slices.DeleteFunc(slices.Clone(ops), func(op *OperationDescriptor) bool {
+			return !isSimpleOperation(op) && !isDownloadOperation(op) && !isStreamOperation(op)
+		})
 	}
 
 	// Generate request body type aliases first
@@ -425,21 +975,41 @@ func (g *ClientGenerator) GenerateClient(ops []*OperationDescriptor) (string, er
 	buf.WriteString(bodyTypes)
 
 	// Generate base client
-	base, err := g.GenerateBase()
+	base, err := g.GenerateBase(data)
 	if err != nil {
 		return "", fmt.Errorf("generating base client: %w", err)
 	}
 	buf.WriteString(base)
 	buf.WriteString("\n")
 
-	// Generate interface
-	iface, err := g.GenerateInterface(data)
+	// Generate per-call request options
+	requestOptions, err := g.GenerateRequestOptions(data)
 	if err != nil {
-		return "", fmt.Errorf("generating client interface: %w", err)
+		return "", fmt.Errorf("generating client request options: %w", err)
 	}
-	buf.WriteString(iface)
+	buf.WriteString(requestOptions)
 	buf.WriteString("\n")
 
+	// Generate interface, unless the raw client is hidden
+	if !g.skipRaw {
+		iface, err := g.GenerateInterface(data)
+		if err != nil {
+			return "", fmt.Errorf("generating client interface: %w", err)
+		}
+		buf.WriteString(iface)
+		buf.WriteString("\n")
+	}
+
+	// Generate per-tag interfaces and sub-clients if requested
+	if g.tagClients {
+		tagClients, err := g.GenerateTagClients(data)
+		if err != nil {
+			return "", fmt.Errorf("generating tag clients: %w", err)
+		}
+		buf.WriteString(tagClients)
+		buf.WriteString("\n")
+	}
+
 	// Generate param types
 	paramTypes, err := g.GenerateParamTypes(ops)
 	if err != nil {
@@ -449,15 +1019,35 @@ func (g *ClientGenerator) GenerateClient(ops []*OperationDescriptor) (string, er
 	buf.WriteString("\n")
 
 	// Generate methods
-	methods, err := g.GenerateMethods(data)
+	methods, err := g.GenerateMethods(rawData)
 	if err != nil {
 		return "", fmt.Errorf("generating client methods: %w", err)
 	}
 	buf.WriteString(methods)
 	buf.WriteString("\n")
 
+	// Generate polling helpers of long-running operations
+	if hasLROOperations(ops) {
+		lro, err := g.GenerateLRO(data)
+		if err != nil {
+			return "", fmt.Errorf("generating long-running operation helpers: %w", err)
+		}
+		buf.WriteString(lro)
+		buf.WriteString("\n")
+	}
+
+	// Generate streaming request body methods, which build on the raw client
+	if !g.skipRaw && hasStreamRequestBodies(ops) {
+		streams, err := g.GenerateStreams(data)
+		if err != nil {
+			return "", fmt.Errorf("generating streaming request methods: %w", err)
+		}
+		buf.WriteString(streams)
+		buf.WriteString("\n")
+	}
+
 	// Generate request builders
-	builders, err := g.GenerateRequestBuilders(data)
+	builders, err := g.GenerateRequestBuilders(rawData)
 	if err != nil {
 		return "", fmt.Errorf("generating request builders: %w", err)
 	}
@@ -473,5 +1063,15 @@ func (g *ClientGenerator) GenerateClient(ops []*OperationDescriptor) (string, er
 		buf.WriteString(simple)
 	}
 
+	// Generate mocks if requested
+	if g.generateMock {
+		mock, err := g.GenerateMock(data)
+		if err != nil {
+			return "", fmt.Errorf("generating mock client: %w", err)
+		}
+		buf.WriteString("\n")
+		buf.WriteString(mock)
+	}
+
 	return buf.String(), nil
 }
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,7 +32,7 @@ func TestClientGenerator(t *testing.T) {
 	// Compute names for schemas
 	converter := NewNameConverter(NameMangling{}, NameSubstitutions{})
 	contentTypeNamer := NewContentTypeShortNamer(DefaultContentTypeShortNames())
-	ComputeSchemaNames(schemas, converter, contentTypeNamer)
+	require.NoError(t, ComputeSchemaNames(schemas, converter, contentTypeNamer, SchemaNamingOptions{}))
 
 	// Build schema index - key by Path.String() for component schemas
 	schemaIndex := make(map[string]*SchemaDescriptor)
@@ -101,7 +104,7 @@ func TestClientGenerator_FormEncoded(t *testing.T) {
 
 	converter := NewNameConverter(NameMangling{}, NameSubstitutions{})
 	contentTypeNamer := NewContentTypeShortNamer(DefaultContentTypeShortNames())
-	ComputeSchemaNames(schemas, converter, contentTypeNamer)
+	require.NoError(t, ComputeSchemaNames(schemas, converter, contentTypeNamer, SchemaNamingOptions{}))
 
 	schemaIndex := make(map[string]*SchemaDescriptor)
 	for _, s := range schemas {
@@ -246,3 +249,39 @@ func TestPathFmt(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultUserAgent(t *testing.T) {
+	assert.Equal(t, "Swagger-Petstore/1.0.0", defaultUserAgent(&base.Info{Title: "Swagger Petstore", Version: "1.0.0"}))
+	assert.Equal(t, "Petstore", defaultUserAgent(&base.Info{Title: "Petstore"}))
+	assert.Equal(t, "oapi-codegen-client/2.0", defaultUserAgent(&base.Info{Version: "2.0"}))
+	assert.Equal(t, "oapi-codegen-client", defaultUserAgent(nil))
+}
+
+func TestStatusErrorName(t *testing.T) {
+	assert.Equal(t, "NotFoundError", statusErrorName("404"))
+	assert.Equal(t, "InternalServerError", statusErrorName("500"))
+	assert.Equal(t, "RequestURITooLongError", statusErrorName("414"))
+	assert.Equal(t, "NonAuthoritativeInformationError", statusErrorName("203"))
+	assert.Equal(t, "499Error", statusErrorName("499"))
+}
+
+func TestGatherTagClients(t *testing.T) {
+	op := func(id string, tags ...string) *OperationDescriptor {
+		return &OperationDescriptor{OperationID: id, GoOperationID: ToGoIdentifier(id), Spec: &v3.Operation{Tags: tags}}
+	}
+	listPets := op("listPets", "pets")
+	deletePet := op("deletePet", "pets", "admin")
+	health := op("health")
+
+	tags, err := gatherTagClients([]*OperationDescriptor{listPets, deletePet, health})
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "Admin", tags[0].Name)
+	assert.Equal(t, []*OperationDescriptor{deletePet}, tags[0].Operations)
+	assert.Equal(t, "Pets", tags[1].Name)
+	assert.Equal(t, []*OperationDescriptor{listPets, deletePet}, tags[1].Operations)
+
+	// An accessor can't shadow an operation's method.
+	_, err = gatherTagClients([]*OperationDescriptor{op("pets", "other"), op("listPets", "pets")})
+	assert.EqualError(t, err, `tag-clients: accessor Pets of tag "pets" conflicts with operation pets`)
+}
@@ -2,12 +2,16 @@
 package codegen
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
 	"golang.org/x/tools/imports"
 
 	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/dce"
@@ -16,49 +20,34 @@ import (
 	runtime "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime"
 )
 
-// Generate produces Go code from the parsed OpenAPI document.
-// specData is the raw spec bytes used to embed the spec in the generated code.
-func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (string, error) {
-	cfg.ApplyDefaults()
-
-	// Build the V3 model once — all gather functions share this single build.
-	model, err := doc.BuildV3Model()
-	if err != nil {
-		return "", fmt.Errorf("building v3 model: %w", err)
-	}
-	if model == nil {
-		return "", fmt.Errorf("failed to build v3 model")
+// configureRuntimePrefixes sets the package prefixes of the runtime
+// sub-packages on ctx when cfg uses an external runtime package, and returns
+// them. They're all empty when the runtime is embedded.
+func configureRuntimePrefixes(ctx *CodegenContext, cfg Configuration) RuntimePrefixes {
+	if cfg.Generation.RuntimePackage == nil {
+		return RuntimePrefixes{}
 	}
-	v3Doc := &model.Model
-
-	// Create a single CodegenContext that all generators share.
-	ctx := NewCodegenContext()
-
-	// Configure runtime package prefixes if an external runtime is specified.
-	var runtimePrefixes RuntimePrefixes
-	if cfg.Generation.RuntimePackage != nil {
-		runtimePrefixes = RuntimePrefixes{
-			Params:  "oapiCodegenParamsPkg.",
-			Types:   "oapiCodegenTypesPkg.",
-			Helpers: "oapiCodegenHelpersPkg.",
-		}
-		ctx.SetRuntimePrefixes(runtimePrefixes.Params, runtimePrefixes.Types, runtimePrefixes.Helpers)
+	rp := RuntimePrefixes{
+		Params:      "oapiCodegenParamsPkg.",
+		Types:       "oapiCodegenTypesPkg.",
+		Helpers:     "oapiCodegenHelpersPkg.",
+		JSONPointer: "oapiCodegenJSONPointerPkg.",
 	}
+	ctx.SetRuntimePrefixes(rp.Params, rp.Types, rp.Helpers)
+	return rp
+}
 
-	// Create content type matcher for filtering request/response bodies
-	contentTypeMatcher := NewContentTypeMatcher(cfg.ContentTypes)
-
-	// Create content type short namer for friendly type names
-	contentTypeNamer := NewContentTypeShortNamer(cfg.ContentTypeShortNames)
-
+// gatherNamedSchemas gathers the schemas of v3Doc which need types under cfg,
+// and computes their names.
+func gatherNamedSchemas(v3Doc *v3.Document, ctx *CodegenContext, cfg Configuration, contentTypeMatcher *ContentTypeMatcher, converter *NameConverter) ([]*SchemaDescriptor, error) {
 	// Pass 1: Gather all schemas that need types.
 	// Operation filters (include/exclude tags, operation IDs) are applied during
 	// gathering so that schemas from excluded operations are never collected.
-	schemas, err := GatherSchemasWithOptions(v3Doc, contentTypeMatcher, cfg.OutputOptions, GatherOptions{
+	schemas, err := gatherSchemas(v3Doc, ctx, contentTypeMatcher, cfg.OutputOptions, GatherOptions{
 		SkipEnumViaOneOf: cfg.Generation.SkipEnumViaOneOf,
 	})
 	if err != nil {
-		return "", fmt.Errorf("gathering schemas: %w", err)
+		return nil, fmt.Errorf("gathering schemas: %w", err)
 	}
 
 	// Filter explicitly excluded schemas
@@ -70,8 +59,130 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 	}
 
 	// Pass 2: Compute names for all schemas
+	inlineNameTemplate, err := parseInlineNameTemplate(cfg.InlineSchemas.NameTemplate)
+	if err != nil {
+		return nil, err
+	}
+	markAnonymousSchemas(schemas, cfg.InlineSchemas, cfg.OutputOptions.PreferSchemaTitles, cfg.NameCollisions.Pinned)
+	err = ComputeSchemaNames(schemas, converter, NewContentTypeShortNamer(cfg.ContentTypeShortNames), SchemaNamingOptions{
+		PreferTitles:       cfg.OutputOptions.PreferSchemaTitles,
+		Collisions:         cfg.NameCollisions,
+		InlineNameTemplate: inlineNameTemplate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	warnUnknownPins(ctx, schemas, cfg.NameCollisions.Pinned)
+	return schemas, nil
+}
+
+// warnUnknownPins warns about the names pinned for schemas which have no
+// types, such as those of excluded operations or misspelled pointers.
+func warnUnknownPins(ctx *CodegenContext, schemas []*SchemaDescriptor, pinned map[string]string) {
+	known := make(map[string]bool, len(schemas))
+	for _, s := range schemas {
+		known[jsonPointer(s.Path)] = true
+	}
+	pointers := make([]string, 0, len(pinned))
+	for pointer := range pinned {
+		if !known[pointer] {
+			pointers = append(pointers, pointer)
+		}
+	}
+	sort.Strings(pointers)
+	for _, pointer := range pointers {
+		ctx.Warn(nil, "ignoring name-collisions name %s pinned for %s, which has no type", pinned[pointer], pointer)
+	}
+}
+
+// buildV3Model builds the V3 model of doc. libopenapi reports circular
+// references it can't resolve, such as a required property referencing its
+// own schema, as errors, but still builds the model; those are ignored, as
+// the types generated for such schemas break the cycle with pointers.
+func buildV3Model(doc libopenapi.Document) (*v3.Document, error) {
+	model, err := doc.BuildV3Model()
+	if err != nil {
+		for _, e := range utils.UnwrapErrors(err) {
+			var refErr *index.ResolvingError
+			if !errors.As(e, &refErr) || refErr.CircularReference == nil {
+				return nil, fmt.Errorf("building v3 model: %w", err)
+			}
+		}
+	}
+	if model == nil {
+		return nil, fmt.Errorf("failed to build v3 model")
+	}
+	return &model.Model, nil
+}
+
+// Generate produces Go code from the parsed OpenAPI document.
+// specData is the raw spec bytes used to embed the spec in the generated code.
+// Warnings are logged with slog; GenerateWithDiagnostics returns them.
+func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (string, error) {
+	return generate(doc, specData, cfg, NewCodegenContext())
+}
+
+// generate is Generate, sharing ctx with all generators.
+func generate(doc libopenapi.Document, specData []byte, cfg Configuration, ctx *CodegenContext) (string, error) {
+	if _, err := NameManglingPreset(cfg.NameMangling.Preset); err != nil {
+		return "", err
+	}
+	switch cfg.OutputOptions.OptionalParameters {
+	case "", OptionalParamsPointer, OptionalParamsNullable, OptionalParamsValue:
+	default:
+		return "", fmt.Errorf("unknown optional-parameters %q: want %q, %q or %q", cfg.OutputOptions.OptionalParameters,
+			OptionalParamsPointer, OptionalParamsNullable, OptionalParamsValue)
+	}
+	switch cfg.OutputOptions.OptionalProperties {
+	case "", OptionalPropsPointer, OptionalPropsValue, OptionalPropsOmitZero, OptionalPropsNullable, OptionalPropsOptional:
+	default:
+		return "", fmt.Errorf("unknown optional-properties %q: want %q, %q, %q, %q or %q", cfg.OutputOptions.OptionalProperties,
+			OptionalPropsPointer, OptionalPropsValue, OptionalPropsOmitZero, OptionalPropsNullable, OptionalPropsOptional)
+	}
+	switch cfg.StructTags.OmitZero {
+	case "", OmitZeroReplace, OmitZeroAdd:
+	default:
+		return "", fmt.Errorf("unknown struct-tags omitzero %q: want %q or %q", cfg.StructTags.OmitZero,
+			OmitZeroReplace, OmitZeroAdd)
+	}
+	switch cfg.NameCollisions.Strategy {
+	case "", NameCollisionSection, NameCollisionOperationID, NameCollisionError:
+	default:
+		return "", fmt.Errorf("unknown name-collisions strategy %q: want %q, %q or %q", cfg.NameCollisions.Strategy,
+			NameCollisionSection, NameCollisionOperationID, NameCollisionError)
+	}
+	switch cfg.InlineSchemas.Promote {
+	case "", InlinePromoteAlways, InlinePromoteWhenNeeded:
+	default:
+		return "", fmt.Errorf("unknown inline-schemas promote %q: want %q or %q", cfg.InlineSchemas.Promote,
+			InlinePromoteAlways, InlinePromoteWhenNeeded)
+	}
+	switch cfg.Generation.PreciseNumbers {
+	case "", PreciseNumbersJSONNumber, PreciseNumbersBig:
+	default:
+		return "", fmt.Errorf("unknown precise-numbers %q: want %q or %q", cfg.Generation.PreciseNumbers,
+			PreciseNumbersJSONNumber, PreciseNumbersBig)
+	}
+	cfg.ApplyDefaults()
+
+	// Build the V3 model once — all gather functions share this single build.
+	v3Doc, err := buildV3Model(doc)
+	if err != nil {
+		return "", err
+	}
+
+	// Configure runtime package prefixes if an external runtime is specified.
+	runtimePrefixes := configureRuntimePrefixes(ctx, cfg)
+
+	// Create content type matcher for filtering request/response bodies
+	contentTypeMatcher := NewContentTypeMatcher(cfg.ContentTypes)
+
+	// Passes 1 and 2: Gather all schemas that need types, and name them.
 	converter := NewNameConverter(cfg.NameMangling, cfg.NameSubstitutions)
-	ComputeSchemaNames(schemas, converter, contentTypeNamer)
+	schemas, err := gatherNamedSchemas(v3Doc, ctx, cfg, contentTypeMatcher, converter)
+	if err != nil {
+		return "", err
+	}
 
 	// Build schema index for type resolution
 	schemaIndex := make(map[string]*SchemaDescriptor)
@@ -86,11 +197,25 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 	}
 	tagGenerator := NewStructTagGenerator(cfg.StructTags)
 	gen := NewTypeGenerator(cfg.TypeMapping, converter, importResolver, tagGenerator, ctx)
+	gen.nullableOmitZero = cfg.Generation.NullableOmitZero
+	gen.optionalProperties = cfg.OutputOptions.OptionalProperties
+	if gen.optionalProperties == "" && cfg.Generation.OptionalFields {
+		gen.optionalProperties = OptionalPropsOptional
+	}
+	gen.strictEnums = cfg.Generation.StrictEnums
+	gen.uniqueItemSets = cfg.Generation.UniqueItemSets
+	gen.jsonV2 = cfg.Generation.JSONv2
 	gen.IndexSchemas(schemas)
 
 	// Enum pre-pass: collect EnumInfo for all enum schemas, run collision detection
 	gen.resolveEnumNames(schemas, cfg.OutputOptions.AlwaysPrefixEnumValues)
 
+	// Variants of schemas with readOnly or writeOnly properties, named
+	// before generation so request bodies and responses can use them
+	if cfg.Generation.RequestResponseVariants {
+		resolveRequestResponseVariants(ctx, schemas)
+	}
+
 	output := NewOutput(cfg.PackageName)
 
 	// ── Phase 1: Generate all code sections ──
@@ -106,6 +231,12 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 
 		// Embed the raw OpenAPI spec if specData was provided
 		if len(specData) > 0 {
+			if cfg.OutputOptions.EmbeddedSpec.IsSet() {
+				specData, err = trimSpec(specData, cfg.OutputOptions.EmbeddedSpec)
+				if err != nil {
+					return "", fmt.Errorf("trimming embedded spec: %w", err)
+				}
+			}
 			embeddedCode, err := generateEmbeddedSpec(specData)
 			if err != nil {
 				return "", fmt.Errorf("generating embedded spec: %w", err)
@@ -128,6 +259,16 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 			return "", fmt.Errorf("gathering operations: %w", err)
 		}
 		ops = FilterOperations(ops, cfg.OutputOptions)
+		resolveInlineParamTypes(ops, schemaIndex)
+		resolveObjectParamFields(ops, schemaIndex, gen, cfg.TypeMapping)
+		resolveOptionalParams(ops, cfg.OutputOptions.OptionalParameters, ctx)
+	}
+
+	if cfg.Generation.SkipRawClient && !cfg.Generation.SimpleClient {
+		return "", fmt.Errorf("skip-raw-client requires simple-client to be set")
+	}
+	if cfg.Generation.SkipRawClient && cfg.OutputOptions.TagClients {
+		return "", fmt.Errorf("tag-clients can't be used with skip-raw-client")
 	}
 
 	// Generate client code if requested
@@ -136,6 +277,16 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 		if err != nil {
 			return "", fmt.Errorf("creating client generator: %w", err)
 		}
+		clientGen.SetUserAgent(defaultUserAgent(v3Doc.Info))
+		clientGen.SetGenerateMock(cfg.Generation.MockClient)
+		clientGen.SetGenerateTagClients(cfg.OutputOptions.TagClients)
+		clientGen.SetGenerateStreamChannels(cfg.Generation.StreamChannels)
+		clientGen.SetGenerateResumableStreams(cfg.Generation.ResumableStreams)
+		clientGen.SetClientLayers(cfg.Generation.SkipRawClient, cfg.Generation.SkipRequestBuilders)
+		clientGen.SetDebugRedactions(gatherDebugRedactions(v3Doc, schemas))
+		clientGen.SetRateLimitHeaders(gatherRateLimitHeaders(ops, cfg.Generation.RateLimitHeaders))
+		clientGen.SetOIDCSchemes(gatherOIDCSchemes(v3Doc))
+		clientGen.SetAPIKeySchemes(gatherAPIKeySchemes(v3Doc))
 
 		clientCode, err := clientGen.GenerateClient(ops)
 		if err != nil {
@@ -161,15 +312,37 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 	// Track whether shared error types have been generated to avoid duplication.
 	generatedErrors := false
 
+	if cfg.Generation.RequestLogging && cfg.Generation.Server == "" {
+		return "", fmt.Errorf("request-logging requires server to be set")
+	}
+	if cfg.Generation.ValidatingTestServer && cfg.Generation.Server == "" {
+		return "", fmt.Errorf("validating-test-server requires server to be set")
+	}
+	// The embedded runtime declares NewValidatingTestServer itself.
+	if cfg.Generation.ValidatingTestServer && cfg.Generation.RuntimePackage == nil {
+		return "", fmt.Errorf("validating-test-server requires runtime-package to be set")
+	}
+
 	// Generate server code for path operations if a server framework is set.
 	if cfg.Generation.Server != "" {
 
 		if len(ops) > 0 {
-			serverGen, err := NewServerGenerator(cfg.Generation.Server, runtimePrefixes)
+			serverGen, err := NewServerGenerator(cfg.Generation.Server, runtimePrefixes, cfg.Generation.RequestLogging)
 			if err != nil {
 				return "", fmt.Errorf("creating server generator: %w", err)
 			}
 
+			serverGen.SetSkipParamTypes(cfg.Generation.Client)
+			serverGen.SetOIDCSchemes(gatherOIDCSchemes(v3Doc))
+			serverGen.SetBearerSchemes(gatherBearerSchemes(v3Doc))
+			if cfg.Generation.ValidatingTestServer {
+				responseSpec, err := responseValidationSpec(specData)
+				if err != nil {
+					return "", fmt.Errorf("generating validating test server: %w", err)
+				}
+				serverGen.SetResponseValidationSpec(responseSpec)
+			}
+
 			serverCode, err := serverGen.GenerateServer(ops)
 			if err != nil {
 				return "", fmt.Errorf("generating server code: %w", err)
@@ -185,6 +358,9 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 			for _, st := range serverTemplates {
 				ctx.AddTemplateImports(st.Imports)
 			}
+			for _, st := range templates.SharedServerTemplates {
+				ctx.AddTemplateImports(st.Imports)
+			}
 		}
 	}
 
@@ -195,6 +371,9 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 		if err != nil {
 			return "", fmt.Errorf("gathering webhook operations: %w", err)
 		}
+		resolveInlineParamTypes(webhookOps, schemaIndex)
+		resolveObjectParamFields(webhookOps, schemaIndex, gen, cfg.TypeMapping)
+		resolveOptionalParams(webhookOps, cfg.OutputOptions.OptionalParameters, ctx)
 	}
 
 	// Gather callback operations once — reused by initiator and receiver.
@@ -204,6 +383,9 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 		if err != nil {
 			return "", fmt.Errorf("gathering callback operations: %w", err)
 		}
+		resolveInlineParamTypes(callbackOps, schemaIndex)
+		resolveObjectParamFields(callbackOps, schemaIndex, gen, cfg.TypeMapping)
+		resolveOptionalParams(callbackOps, cfg.OutputOptions.OptionalParameters, ctx)
 	}
 
 	// Generate webhook initiator code if requested
@@ -334,6 +516,12 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 			}
 			output.AddType(paramTypes)
 
+			subscriptions, err := receiverGen.GenerateCallbackSubscriptions(callbackOps)
+			if err != nil {
+				return "", err
+			}
+			output.AddType(subscriptions)
+
 			if !generatedErrors {
 				errors, err := receiverGen.GenerateErrors()
 				if err != nil {
@@ -360,20 +548,31 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 
 	if cfg.Generation.RuntimePackage != nil {
 		// Runtime package is configured — don't embed helpers, import them.
-		// Always add all three sub-package imports; the Go compiler and goimports
+		// Always add all sub-package imports; the Go compiler and goimports
 		// will strip any that end up unused.
 		ctx.AddImportAlias(cfg.Generation.RuntimePackage.TypesImport(), "oapiCodegenTypesPkg")
 		ctx.AddImportAlias(cfg.Generation.RuntimePackage.ParamsImport(), "oapiCodegenParamsPkg")
 		ctx.AddImportAlias(cfg.Generation.RuntimePackage.HelpersImport(), "oapiCodegenHelpersPkg")
+		ctx.AddImportAlias(cfg.Generation.RuntimePackage.JSONPointerImport(), "oapiCodegenJSONPointerPkg")
 	} else {
 		// Inline mode: emit all runtime code, DCE will remove unused declarations.
-		runtimeCode, runtimeImports, err := runtimeextract.ExtractAllInline(runtime.SourceFS)
+		var constraints []string
+		if cfg.Generation.JSONv2 {
+			constraints = append(constraints, runtimeextract.JSONv2Constraint)
+		}
+		runtimeCode, runtimeImports, err := runtimeextract.ExtractAllInline(runtime.SourceFS, constraints...)
 		if err != nil {
 			return "", fmt.Errorf("extracting runtime code: %w", err)
 		}
 		output.AddType(runtimeCode)
 		for _, imp := range runtimeImports {
 			if imp.Alias != "" {
+				// The generated code's own import of the path, such as
+				// github.com/google/uuid from a type mapping, wins: the
+				// runtime code using the alias is then unused.
+				if _, ok := ctx.Imports()[imp.Path]; ok {
+					continue
+				}
 				ctx.AddImportAlias(imp.Path, imp.Alias)
 			} else {
 				ctx.AddImport(imp.Path)
@@ -411,6 +610,11 @@ func Generate(doc libopenapi.Document, specData []byte, cfg Configuration) (stri
 func generateType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 	kind := GetSchemaKind(desc)
 
+	// Anonymous structs are declared in the fields holding them
+	if desc.Anonymous {
+		return ""
+	}
+
 	// If schema has TypeOverride extension, generate a type alias to the external type
 	// instead of generating the full type definition
 	if desc.Extensions != nil && desc.Extensions.TypeOverride != nil {
@@ -427,6 +631,12 @@ func generateType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 			code = generateExternalRefAlias(gen, desc)
 			break
 		}
+		// Component schemas that are $ref to another get an alias, as
+		// schemas referencing them use their name.
+		if desc.IsTopLevelComponentSchema() && desc.ShortName != "" {
+			code = generateRefAlias(gen, desc)
+			break
+		}
 		return ""
 
 	case KindStruct:
@@ -447,6 +657,9 @@ func generateType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 	case KindOneOf:
 		code = generateOneOfType(gen, desc)
 
+	case KindTuple:
+		code = generateTupleType(gen, desc)
+
 	case KindAlias:
 		code = generateTypeAlias(gen, desc)
 
@@ -471,45 +684,72 @@ func schemaPathComment(path SchemaPath) string {
 func generateStructType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 	fields := gen.GenerateStructFields(desc)
 	doc := extractDescription(desc.Schema)
+	code := generateStructCode(gen, desc, desc.ShortName, fields, doc)
+	if desc.RequestVariant != "" || desc.ResponseVariant != "" {
+		code += generateRequestResponseVariants(gen, desc, fields)
+	}
+	return code
+}
 
+// generateStructCode generates the struct named name with the given fields,
+// and its methods, for an object schema.
+func generateStructCode(gen *TypeGenerator, desc *SchemaDescriptor, name string, fields []StructField, doc string) string {
 	// Check if we need additionalProperties handling
 	if gen.HasAdditionalProperties(desc) {
 		gen.AddJSONImport()
 		gen.AddImport("fmt")
 
 		addPropsType := gen.AdditionalPropertiesType(desc)
-		structCode := GenerateStructWithAdditionalProps(desc.ShortName, fields, addPropsType, doc, gen.TagGenerator())
+		structCode := GenerateStructWithAdditionalProps(name, fields, addPropsType, doc, gen.TagGenerator())
+
+		if gen.jsonV2 {
+			gen.addJSONv2Imports()
+			gen.AddImport("maps")
+			gen.AddImport("slices")
+		}
 
-		addPropsCode, err := GenerateAdditionalPropertiesCode(desc.ShortName, fields, addPropsType)
+		addPropsCode, err := GenerateAdditionalPropertiesCode(name, fields, addPropsType, gen.jsonV2)
 		if err != nil {
-			return fmt.Sprintf("// ERROR generating additional properties for %s: %v\n", desc.ShortName, err)
+			return fmt.Sprintf("// ERROR generating additional properties for %s: %v\n", name, err)
 		}
 
 		code := structCode + "\n" + addPropsCode
 
-		applyDefaults, needsReflect, err := GenerateApplyDefaultsCode(desc.ShortName, fields)
+		applyDefaults, needsReflect, err := GenerateApplyDefaultsCode(name, fields)
 		if err != nil {
-			return fmt.Sprintf("// ERROR generating ApplyDefaults for %s: %v\n", desc.ShortName, err)
+			return fmt.Sprintf("// ERROR generating ApplyDefaults for %s: %v\n", name, err)
 		}
 		code += "\n" + applyDefaults
 		if needsReflect {
 			gen.AddImport("reflect")
 		}
 
+		constructor, err := GenerateConstructorCode(name, fields)
+		if err != nil {
+			return fmt.Sprintf("// ERROR generating constructor for %s: %v\n", name, err)
+		}
+		code += constructor
+
 		return code
 	}
 
-	code := GenerateStruct(desc.ShortName, fields, doc, gen.TagGenerator())
+	code := GenerateStruct(name, fields, doc, gen.TagGenerator())
 
-	applyDefaults, needsReflect, err := GenerateApplyDefaultsCode(desc.ShortName, fields)
+	applyDefaults, needsReflect, err := GenerateApplyDefaultsCode(name, fields)
 	if err != nil {
-		return fmt.Sprintf("// ERROR generating ApplyDefaults for %s: %v\n", desc.ShortName, err)
+		return fmt.Sprintf("// ERROR generating ApplyDefaults for %s: %v\n", name, err)
 	}
 	code += "\n" + applyDefaults
 	if needsReflect {
 		gen.AddImport("reflect")
 	}
 
+	constructor, err := GenerateConstructorCode(name, fields)
+	if err != nil {
+		return fmt.Sprintf("// ERROR generating constructor for %s: %v\n", name, err)
+	}
+	code += constructor
+
 	return code
 }
 
@@ -533,6 +773,12 @@ func generateEnumType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 		computeEnumConstantNames([]*EnumInfo{info}, gen.converter)
 	}
 
+	if len(info.Values) > 0 {
+		gen.AddImport("fmt")
+	}
+	if info.Const || info.Strict {
+		gen.AddJSONImports()
+	}
 	return GenerateEnumFromInfo(info)
 }
 
@@ -547,6 +793,28 @@ func generateExternalRefAlias(gen *TypeGenerator, desc *SchemaDescriptor) string
 	return GenerateTypeAlias(desc.ShortName, goType, "")
 }
 
+// generateRefAlias generates a type alias for a component schema which is a
+// $ref to another, such as Pet2: {$ref: Pet}. A chain of such references
+// leading back to the schema has no type to alias, so it's any.
+func generateRefAlias(gen *TypeGenerator, desc *SchemaDescriptor) string {
+	target, ok := gen.schemaIndex[desc.Ref]
+	if !ok || target.ShortName == "" {
+		return ""
+	}
+	seen := map[string]bool{desc.Path.String(): true}
+	for next := target; next.Ref != "" && !next.IsExternalReference(); {
+		if seen[next.Ref] {
+			gen.ctx.Warn(desc.Path, "%s only references itself, through %s; generating it as any", desc.ShortName, target.ShortName)
+			return GenerateTypeAlias(desc.ShortName, "any", "")
+		}
+		seen[next.Ref] = true
+		if next, ok = gen.schemaIndex[next.Ref]; !ok {
+			break
+		}
+	}
+	return GenerateTypeAlias(desc.ShortName, target.ShortName, extractDescription(desc.Schema))
+}
+
 // generateTypeAlias generates a simple type alias.
 func generateTypeAlias(gen *TypeGenerator, desc *SchemaDescriptor) string {
 	goType := gen.GoTypeExpr(desc)
@@ -610,6 +878,7 @@ func generateAllOfType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 	mergedFields := make(map[string]StructField) // keyed by JSONName
 	var fieldOrder []string                       // preserve order
 	var unionFields []StructField
+	var unionAccessors []unionFieldAccessors
 
 	// First, collect fields from properties defined directly on the schema
 	// (Issue 2102: properties at same level as allOf were being ignored)
@@ -676,6 +945,10 @@ func generateAllOfType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 				Type:     "*" + member.unionType,
 				JSONName: "-", // will use json:"-"
 			})
+			unionAccessors = append(unionAccessors, unionFieldAccessors{
+				field:   member.unionType,
+				members: allOfUnionMembers(gen, member.unionDesc),
+			})
 			continue
 		}
 
@@ -718,6 +991,7 @@ func generateAllOfType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 		// Has union members - need custom marshal/unmarshal
 		gen.AddJSONImport()
 		code = generateAllOfStructWithUnions(desc.ShortName, finalFields, unionFields, doc, gen.TagGenerator())
+		code += generateUnionFieldAccessors(desc.ShortName, unionAccessors)
 	} else {
 		// Simple case - just flattened fields
 		code = GenerateStruct(desc.ShortName, finalFields, doc, gen.TagGenerator())
@@ -733,6 +1007,16 @@ func generateAllOfType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 		gen.AddImport("reflect")
 	}
 
+	constructor, err := GenerateConstructorCode(desc.ShortName, finalFields)
+	if err != nil {
+		return fmt.Sprintf("// ERROR generating constructor for %s: %v\n", desc.ShortName, err)
+	}
+	code += constructor
+
+	if len(unionFields) == 0 && (desc.RequestVariant != "" || desc.ResponseVariant != "") {
+		code += generateRequestResponseVariants(gen, desc, finalFields)
+	}
+
 	return code
 }
 
@@ -839,7 +1123,19 @@ func generateAllOfStructWithUnions(name string, fields []StructField, unionField
 			b.Line("result[%q] = s.%s", f.JSONName, f.Name)
 			b.Dedent()
 			b.Line("}")
-		} else if strings.HasPrefix(f.Type, "[]") || strings.HasPrefix(f.Type, "map[") {
+		} else if f.Optional {
+			b.Line("if s.%s.IsSet() {", f.Name)
+			b.Indent()
+			b.Line("result[%q] = s.%s", f.JSONName, f.Name)
+			b.Dedent()
+			b.Line("}")
+		} else if isSetType(f.Type) {
+			b.Line("if !s.%s.IsZero() {", f.Name)
+			b.Indent()
+			b.Line("result[%q] = s.%s", f.JSONName, f.Name)
+			b.Dedent()
+			b.Line("}")
+		} else if isCollectionType(f.Type) {
 			// Slices and maps - only include if not nil
 			b.Line("if s.%s != nil {", f.Name)
 			b.Indent()
@@ -940,6 +1236,86 @@ func generateAllOfStructWithUnions(name string, fields []StructField, unionField
 	return b.String()
 }
 
+// unionFieldAccessors holds the members of a union field of an allOf struct.
+type unionFieldAccessors struct {
+	field   string // field name, which is also the union type name
+	members []UnionMember
+}
+
+// allOfUnionMembers returns the members of a oneOf/anyOf allOf member, following
+// a $ref to the union schema. It returns nil when no union type is generated.
+func allOfUnionMembers(gen *TypeGenerator, desc *SchemaDescriptor) []UnionMember {
+	if desc != nil && desc.Ref != "" {
+		desc = gen.schemaIndex[desc.Ref]
+	}
+	if desc == nil || desc.Schema == nil {
+		return nil
+	}
+	switch GetSchemaKind(desc) {
+	case KindAnyOf:
+		return collectUnionMembers(gen, desc, desc.AnyOf, desc.Schema.AnyOf, "anyOf")
+	case KindOneOf:
+		return collectUnionMembers(gen, desc, desc.OneOf, desc.Schema.OneOf, "oneOf")
+	default:
+		return nil
+	}
+}
+
+// generateUnionFieldAccessors promotes the As/From/Merge methods of the union
+// fields of an allOf struct, so callers needn't check the fields for nil. From
+// and Merge allocate the field when it is unset. A method name shared by the
+// members of two union fields is only reachable through the fields.
+func generateUnionFieldAccessors(name string, fields []unionFieldAccessors) string {
+	counts := make(map[string]int)
+	for _, f := range fields {
+		for _, m := range f.members {
+			counts[m.MethodName]++
+		}
+	}
+
+	b := NewCodeBuilder()
+	for _, f := range fields {
+		for _, m := range f.members {
+			if counts[m.MethodName] > 1 {
+				continue
+			}
+
+			b.BlankLine()
+			b.Line("// As%s returns the %s union data inside the %s as a %s.", m.MethodName, f.field, name, m.TypeName)
+			b.Line("func (s %s) As%s() (%s, error) {", name, m.MethodName, m.TypeName)
+			b.Indent()
+			b.Line("var union %s", f.field)
+			b.Line("if s.%s != nil {", f.field)
+			b.Indent()
+			b.Line("union = *s.%s", f.field)
+			b.Dedent()
+			b.Line("}")
+			b.Line("return union.As%s()", m.MethodName)
+			b.Dedent()
+			b.Line("}")
+
+			for _, op := range []struct{ method, doc string }{
+				{"From", "overwrites the %s union data inside the %s as the provided %s."},
+				{"Merge", "performs a merge with the %s union data inside the %s, using the provided %s."},
+			} {
+				b.BlankLine()
+				b.Line("// %s%s "+op.doc, op.method, m.MethodName, f.field, name, m.TypeName)
+				b.Line("func (s *%s) %s%s(v %s) error {", name, op.method, m.MethodName, m.TypeName)
+				b.Indent()
+				b.Line("if s.%s == nil {", f.field)
+				b.Indent()
+				b.Line("s.%s = new(%s)", f.field, f.field)
+				b.Dedent()
+				b.Line("}")
+				b.Line("return s.%s.%s%s(v)", f.field, op.method, m.MethodName)
+				b.Dedent()
+				b.Line("}")
+			}
+		}
+	}
+	return b.String()
+}
+
 // generateUnionTypeCommon is the shared implementation for anyOf and oneOf type generation.
 func generateUnionTypeCommon(gen *TypeGenerator, desc *SchemaDescriptor, isOneOf bool) string {
 	var members []UnionMember
@@ -970,10 +1346,15 @@ func generateUnionTypeCommon(gen *TypeGenerator, desc *SchemaDescriptor, isOneOf
 		HelperPrefix:  gen.helperPrefix(),
 		TagGen:        gen.tagGenerator,
 		Converter:     gen.converter,
+		JSONv2:        gen.jsonV2,
+	}
+	if gen.jsonV2 {
+		gen.addJSONv2Imports()
 	}
 
 	if desc.Discriminator != nil {
 		gen.AddImport("errors")
+		gen.AddImport("fmt")
 	}
 	if len(fixedFields) > 0 {
 		gen.AddImport("fmt")
@@ -1003,6 +1384,7 @@ func generateOneOfType(gen *TypeGenerator, desc *SchemaDescriptor) string {
 // - Object types with properties
 // - Union types (oneOf/anyOf)
 // - AllOf types (merged structs)
+// - Tuples (prefixItems)
 // This is false for:
 // - Primitive types (string, integer, boolean, number)
 // - Enum types (without object properties)
@@ -1028,6 +1410,11 @@ func schemaHasApplyDefaults(schema *base.Schema) bool {
 		return true
 	}
 
+	// Has prefixItems -> tuple struct with ApplyDefaults
+	if len(schema.PrefixItems) > 0 {
+		return true
+	}
+
 	return false
 }
 
@@ -1061,6 +1448,8 @@ func collectUnionMembers(gen *TypeGenerator, parentDesc *SchemaDescriptor, membe
 		var methodName string
 		var hasApplyDefaults bool
 		var discValues []string
+		var definesMethods bool
+		var required []string
 
 		if proxy.IsReference() {
 			ref := proxy.GetReference()
@@ -1069,6 +1458,8 @@ func collectUnionMembers(gen *TypeGenerator, parentDesc *SchemaDescriptor, membe
 				methodName = target.ShortName
 				hasApplyDefaults = schemaHasApplyDefaults(target.Schema)
 				discValues = refToDiscValues[ref]
+				definesMethods = definesMethodSet(target)
+				required = requiredProperties(target.Schema)
 			} else {
 				continue
 			}
@@ -1089,6 +1480,8 @@ func collectUnionMembers(gen *TypeGenerator, parentDesc *SchemaDescriptor, membe
 				memberType = desc.ShortName
 				methodName = desc.ShortName
 				hasApplyDefaults = schemaHasApplyDefaults(desc.Schema)
+				definesMethods = definesMethodSet(desc)
+				required = requiredProperties(desc.Schema)
 			} else {
 				// This is a primitive type that doesn't have a named type
 				goType := gen.goTypeForSchema(schema, nil)
@@ -1104,8 +1497,50 @@ func collectUnionMembers(gen *TypeGenerator, parentDesc *SchemaDescriptor, membe
 			Index:               i,
 			HasApplyDefaults:    hasApplyDefaults,
 			DiscriminatorValues: discValues,
+			DefinesMethods:      definesMethods,
+			RequiredProperties:  required,
 		})
 	}
 
 	return members
 }
+
+// requiredProperties returns the properties an object schema requires,
+// including those its allOf members require.
+func requiredProperties(schema *base.Schema) []string {
+	var required []string
+	seen := make(map[string]bool)
+	visited := make(map[*base.Schema]bool)
+	var collect func(s *base.Schema)
+	collect = func(s *base.Schema) {
+		if s == nil || visited[s] {
+			return
+		}
+		visited[s] = true
+		for _, name := range s.Required {
+			if !seen[name] {
+				seen[name] = true
+				required = append(required, name)
+			}
+		}
+		for _, proxy := range s.AllOf {
+			collect(proxy.Schema())
+		}
+	}
+	collect(schema)
+	return required
+}
+
+// definesMethodSet returns true if the schema generates a named struct type in
+// this package, which methods can be declared on, rather than an alias.
+func definesMethodSet(desc *SchemaDescriptor) bool {
+	if desc.Extensions != nil && desc.Extensions.TypeOverride != nil {
+		return false
+	}
+	switch GetSchemaKind(desc) {
+	case KindStruct, KindAllOf, KindAnyOf, KindOneOf, KindTuple:
+		return true
+	default:
+		return false
+	}
+}
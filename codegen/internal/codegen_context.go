@@ -3,23 +3,28 @@ package codegen
 import (
 	"text/template"
 
+	"go.yaml.in/yaml/v4"
+
 	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/templates"
 )
 
-// RuntimePrefixes holds the package-qualifier prefixes for the three runtime sub-packages.
+// RuntimePrefixes holds the package-qualifier prefixes for the runtime sub-packages.
 // When embedded (no runtime), all fields are empty strings.
 type RuntimePrefixes struct {
-	Params  string // "params." or ""
-	Types   string // "types." or ""
-	Helpers string // "helpers." or ""
+	Params      string // "params." or ""
+	Types       string // "types." or ""
+	Helpers     string // "helpers." or ""
+	JSONPointer string // "jsonpointer." or ""
 }
 
 // FuncMap returns a template.FuncMap that exposes runtime prefix accessors to templates.
 func (rp RuntimePrefixes) FuncMap() template.FuncMap {
 	return template.FuncMap{
-		"runtimeParamsPrefix":  func() string { return rp.Params },
-		"runtimeTypesPrefix":   func() string { return rp.Types },
-		"runtimeHelpersPrefix": func() string { return rp.Helpers },
+		"runtimeParamsPrefix":      func() string { return rp.Params },
+		"runtimeTypesPrefix":       func() string { return rp.Types },
+		"runtimeHelpersPrefix":     func() string { return rp.Helpers },
+		"runtimeJSONPointerPrefix": func() string { return rp.JSONPointer },
+		"hasRuntimePackage":        func() bool { return rp.Helpers != "" },
 	}
 }
 
@@ -33,6 +38,12 @@ type CodegenContext struct {
 	runtimeParamsPrefix  string // "params." or ""
 	runtimeTypesPrefix   string // "types." or ""
 	runtimeHelpersPrefix string // "helpers." or ""
+
+	// Diagnostics, recorded by Warn once CollectDiagnostics is called.
+	collectDiagnostics bool
+	diagnostics        []Diagnostic
+	specRoot           *yaml.Node
+	specFile           string
 }
 
 // NewCodegenContext creates a new CodegenContext.
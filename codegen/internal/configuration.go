@@ -4,6 +4,9 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -24,12 +27,17 @@ type Configuration struct {
 	NameMangling NameMangling `yaml:"name-mangling,omitempty"`
 	// NameSubstitutions allows direct overrides of generated names
 	NameSubstitutions NameSubstitutions `yaml:"name-substitutions,omitempty"`
+	// NameCollisions configures how colliding type names are told apart
+	NameCollisions NameCollisions `yaml:"name-collisions,omitempty"`
+	// InlineSchemas configures the types of schemas defined inline in others
+	InlineSchemas InlineSchemas `yaml:"inline-schemas,omitempty"`
 	// ImportMapping maps external spec file paths to Go package import paths.
 	// The value is either a bare import path or "alias importpath".
 	// Examples:
 	//   "../common/api.yaml": "github.com/org/project/common"         # alias auto-generated via hash
 	//   "../common/api.yaml": "common github.com/org/project/common"  # explicit alias "common"
 	// Use "-" as the value to indicate types should be in the current package.
+	// Keys match the file part of a $ref by location, with its path cleaned.
 	ImportMapping map[string]string `yaml:"import-mapping,omitempty"`
 	// ContentTypes is a list of regexp patterns for media types to generate models for.
 	// Only request/response bodies with matching content types will have types generated.
@@ -54,6 +62,8 @@ type OutputOptions struct {
 	IncludeOperationIDs []string `yaml:"include-operation-ids,omitempty"`
 	// ExcludeOperationIDs excludes operations with one of these operation IDs. Ignored when empty.
 	ExcludeOperationIDs []string `yaml:"exclude-operation-ids,omitempty"`
+	// ExcludeDeprecated excludes operations marked deprecated in the spec.
+	ExcludeDeprecated bool `yaml:"exclude-deprecated,omitempty"`
 	// ExcludeSchemas excludes schemas with the given names from generation. Ignored when empty.
 	ExcludeSchemas []string `yaml:"exclude-schemas,omitempty"`
 	// PruneUnreferencedSchemas removes component schemas that are not $ref'd by any other
@@ -64,6 +74,58 @@ type OutputOptions struct {
 	// regardless of whether cross-enum collisions are detected.
 	// When false (default), enum constants are only prefixed when needed to avoid collisions.
 	AlwaysPrefixEnumValues bool `yaml:"always-prefix-enum-values,omitempty"`
+	// PreferSchemaTitles names component and inline schemas after their
+	// title, when they have one, rather than their component key or the
+	// path they're defined at. x-oapi-codegen-type-name-override still
+	// wins, and titles shared by several schemas are disambiguated like
+	// other colliding names.
+	PreferSchemaTitles bool `yaml:"prefer-schema-titles,omitempty"`
+	// MaxFileSize is the size in bytes above which schema type declarations are
+	// split out of the output file into numbered chunk files (types_1.gen.go,
+	// types_2.gen.go, ...). Defaults to DefaultMaxFileSize (1MB); a negative
+	// value disables splitting.
+	MaxFileSize int `yaml:"max-file-size,omitempty"`
+	// TagClients generates, in addition to the flat ClientInterface, an
+	// interface and sub-client per operation tag, such as PetsClientInterface
+	// and PetsClient, which Client.Pets() returns.
+	TagClients bool `yaml:"tag-clients,omitempty"`
+	// OptionalParameters is how the fields of Params structs hold optional
+	// query, header and cookie parameters: "pointer", the default, as *T, nil
+	// when absent; "nullable" as Nullable[T], unspecified when absent; or
+	// "value" as T, with a Has<Param> bool set when present.
+	OptionalParameters string `yaml:"optional-parameters,omitempty"`
+	// OptionalProperties is how the fields of structs hold optional,
+	// non-nullable properties: "pointer", the default, as *T, nil when
+	// absent; "value" as T, tagged omitempty; "omitzero" as T, tagged
+	// omitzero; "nullable" as Nullable[T], unspecified when absent; or
+	// "optional" as Optional[T], as the optional-fields generation option
+	// does. Schemas and properties override it with the
+	// x-oapi-codegen-optional-properties extension.
+	OptionalProperties string `yaml:"optional-properties,omitempty"`
+	// EmbeddedSpec trims the spec embedded in the output, which
+	// GetOpenAPISpecJSON returns, to shrink binaries built from
+	// documentation-heavy specs.
+	EmbeddedSpec EmbeddedSpecOptions `yaml:"embedded-spec,omitempty"`
+}
+
+// EmbeddedSpecOptions selects what to remove from the embedded spec. When
+// any is set, the spec is re-encoded as JSON. The trimmed spec stays valid
+// for request validation middleware: response descriptions, which are
+// required, are emptied rather than removed.
+type EmbeddedSpecOptions struct {
+	// StripDescriptions removes description fields.
+	StripDescriptions bool `yaml:"strip-descriptions,omitempty"`
+	// StripExamples removes example and examples fields.
+	StripExamples bool `yaml:"strip-examples,omitempty"`
+	// StripExtensions removes vendor extensions, the x- fields.
+	StripExtensions bool `yaml:"strip-extensions,omitempty"`
+	// Minify encodes the spec as JSON without whitespace.
+	Minify bool `yaml:"minify,omitempty"`
+}
+
+// IsSet reports whether the embedded spec is trimmed at all.
+func (o EmbeddedSpecOptions) IsSet() bool {
+	return o.StripDescriptions || o.StripExamples || o.StripExtensions || o.Minify
 }
 
 // ModelsPackage specifies an external package containing the model types.
@@ -105,6 +167,25 @@ type GenerationOptions struct {
 	// Empty string (default) means no server code is generated.
 	Server string `yaml:"server,omitempty"`
 
+	// RequestLogging enables generation of RequestLoggingMiddleware for the
+	// server, which logs requests with log/slog by operationId and route
+	// template, sampled per operationId. Requires Server to be set.
+	RequestLogging bool `yaml:"request-logging,omitempty"`
+
+	// ValidatingTestServer enables generation of NewValidatingTestServer,
+	// which starts an httptest.Server for tests failing them when a response
+	// doesn't match the status codes, content types, headers and schemas
+	// declared for its operation. Requires Server and RuntimePackage to be
+	// set.
+	ValidatingTestServer bool `yaml:"validating-test-server,omitempty"`
+
+	// ExampleApp enables generation of an example application alongside the
+	// package: a main.go serving a stub ServerInterface with the server
+	// framework, and a main_test.go calling it through the client, and the
+	// validating test server. Requires Server, Client and
+	// ValidatingTestServer to also be enabled.
+	ExampleApp *ExampleAppConfig `yaml:"example-app,omitempty"`
+
 	// Client enables generation of the HTTP client.
 	// When true, generates a base Client that returns *http.Response.
 	Client bool `yaml:"client,omitempty"`
@@ -115,6 +196,46 @@ type GenerationOptions struct {
 	// Requires Client to also be enabled.
 	SimpleClient bool `yaml:"simple-client,omitempty"`
 
+	// MockClient enables generation of MockClient, which implements
+	// ClientInterface with a function field per operation for stubbing the
+	// client in tests. When SimpleClient is also enabled, MockSimpleClient is
+	// generated too. Requires Client to also be enabled.
+	MockClient bool `yaml:"mock-client,omitempty"`
+
+	// StreamChannels enables generation of channel adapters for operations
+	// streaming items, described by the itemSchema of a sequential media type
+	// such as text/event-stream or application/jsonl, alongside their
+	// iterators: a <Operation>Chan method of SimpleClient receiving the items
+	// of a response, and a <Operation>WithItemChan method of Client sending a
+	// request body. Requires Client to also be enabled.
+	StreamChannels bool `yaml:"stream-channels,omitempty"`
+
+	// ResumableStreams enables generation of a <Operation>Events method of
+	// SimpleClient for operations streaming server-sent events, returning a
+	// handle which tracks the ID of the last event received and reconnects
+	// with it in the Last-Event-ID header when the stream is interrupted.
+	// Requires SimpleClient to also be enabled.
+	ResumableStreams bool `yaml:"resumable-streams,omitempty"`
+
+	// SkipRawClient leaves the raw client layer out of the public API:
+	// ClientInterface and MockClient aren't generated, and the methods of
+	// Client returning *http.Response are unexported, and only generated for
+	// the operations SimpleClient calls. Client itself remains, as it holds
+	// the configuration SimpleClient sends requests with. Requires
+	// SimpleClient to also be enabled.
+	SkipRawClient bool `yaml:"skip-raw-client,omitempty"`
+
+	// SkipRequestBuilders unexports the New<Operation>Request functions
+	// which build the *http.Request of each operation.
+	SkipRequestBuilders bool `yaml:"skip-request-builders,omitempty"`
+
+	// RateLimitHeaders names the response headers the WithRateLimiting
+	// option of generated clients reads rate limits from. Names left empty
+	// are taken from the response headers declared in the spec, falling back
+	// to X-RateLimit-Remaining and X-RateLimit-Reset. Requires the runtime
+	// package.
+	RateLimitHeaders RateLimitHeadersConfig `yaml:"rate-limit-headers,omitempty"`
+
 	// WebhookInitiator enables generation of webhook initiator code (sends webhook requests).
 	// Generates a framework-agnostic client that takes the full target URL per-call.
 	WebhookInitiator bool `yaml:"webhook-initiator,omitempty"`
@@ -150,6 +271,56 @@ type GenerationOptions struct {
 	// as Go enums with named constants. When true, they fall through to the
 	// standard union-type generator.
 	SkipEnumViaOneOf bool `yaml:"skip-enum-via-oneof,omitempty"`
+
+	// StrictEnums adds an UnmarshalJSON method to enum types, which rejects
+	// values outside the enum rather than accepting any string or integer.
+	StrictEnums bool `yaml:"strict-enums,omitempty"`
+
+	// NullableOmitZero adds omitzero to the json tags of optional Nullable
+	// fields, so encoding/json (Go 1.24+) omits them while unspecified, and
+	// writes null when they're explicitly null.
+	NullableOmitZero bool `yaml:"nullable-omitzero,omitempty"`
+
+	// OptionalFields wraps optional, non-nullable fields in Optional[T]
+	// instead of pointers, tagged omitzero, so encoding/json (Go 1.24+)
+	// omits them when absent. Fields of form-encoded bodies aren't
+	// supported. The optional-properties output option, when set, takes
+	// precedence.
+	OptionalFields bool `yaml:"optional-fields,omitempty"`
+
+	// RequestResponseVariants generates, for component schemas with readOnly
+	// or writeOnly properties, a <Name>Request variant without the readOnly
+	// properties and a <Name>Response variant without the writeOnly ones.
+	// Request bodies and client responses referencing the schema use them.
+	RequestResponseVariants bool `yaml:"request-response-variants,omitempty"`
+
+	// UniqueItemSets generates Set[T] of the runtime types package, an ordered
+	// set which rejects duplicates when unmarshaled, instead of []T for
+	// arrays with uniqueItems: true, when T is comparable: a string, number,
+	// boolean or enum type. Parameters and form-encoded bodies keep slices.
+	UniqueItemSets bool `yaml:"unique-item-sets,omitempty"`
+
+	// PreciseNumbers maps integer and number schemas to types which keep all
+	// of their digits, rather than int and float types, which lose those of
+	// large 64-bit IDs: "json-number" for encoding/json's Number, or "big" for
+	// BigInt of the runtime types package, backed by math/big, for integers
+	// and DecimalNumber for numbers. Formats mapped to types other than
+	// builtin integers and floats keep them. Empty, the default, keeps the
+	// type mapping.
+	PreciseNumbers string `yaml:"precise-numbers,omitempty"`
+
+	// PreciseNumbersUnformatted limits PreciseNumbers to schemas without a
+	// format, so that those declaring one, such as int32, keep its type.
+	PreciseNumbersUnformatted bool `yaml:"precise-numbers-unformatted,omitempty"`
+
+	// JSONv2 adds the MarshalJSONTo and UnmarshalJSONFrom methods of
+	// encoding/json/v2 to union, tuple and additionalProperties types, which
+	// pass the options of the caller on to their fields, and tags optional
+	// Nullable fields omitzero without omitempty, as encoding/json/v2 omits
+	// explicit nulls with omitempty. The embedded runtime then includes the
+	// encoding/json/v2 methods of Date and Nullable, which the runtime package
+	// builds with Go 1.27. Generated code then needs Go 1.27.
+	JSONv2 bool `yaml:"json-v2,omitempty"`
 }
 
 // ServerType constants for supported server frameworks.
@@ -185,8 +356,11 @@ func DefaultContentTypeShortNames() map[string][]string {
 
 // ApplyDefaults merges user configuration on top of default values.
 func (c *Configuration) ApplyDefaults() {
-	c.TypeMapping = DefaultTypeMapping.Merge(c.TypeMapping)
-	c.NameMangling = DefaultNameMangling().Merge(c.NameMangling)
+	c.TypeMapping = DefaultTypeMapping.Merge(c.TypeMapping).
+		withPreciseNumbers(c.Generation.PreciseNumbers, c.Generation.PreciseNumbersUnformatted)
+	// An unknown preset is reported by Generate; fall back to the defaults.
+	base, _ := NameManglingPreset(c.NameMangling.Preset)
+	c.NameMangling = base.Merge(c.NameMangling)
 	if len(c.ContentTypes) == 0 {
 		c.ContentTypes = DefaultContentTypes()
 	}
@@ -194,6 +368,9 @@ func (c *Configuration) ApplyDefaults() {
 		c.ContentTypeShortNames = DefaultContentTypeShortNames()
 	}
 	c.StructTags = DefaultStructTagsConfig().Merge(c.StructTags)
+	if c.OutputOptions.MaxFileSize == 0 {
+		c.OutputOptions.MaxFileSize = DefaultMaxFileSize
+	}
 }
 
 // ContentTypeMatcher checks if content types match configured patterns.
@@ -290,13 +467,44 @@ func deriveContentTypeShortName(contentType string) string {
 	return result.String()
 }
 
+// RateLimitHeadersConfig names the response headers a server reports its
+// rate limits in.
+type RateLimitHeadersConfig struct {
+	// Remaining holds the number of requests left in the current window.
+	Remaining string `yaml:"remaining,omitempty"`
+	// Reset holds when the window resets, in seconds from now or as a Unix
+	// timestamp.
+	Reset string `yaml:"reset,omitempty"`
+}
+
+// ExampleAppConfig configures the example application.
+type ExampleAppConfig struct {
+	// Path is the import path of the generated package, which the example
+	// application imports (e.g., "github.com/org/project/api").
+	Path string `yaml:"path"`
+	// Output is the directory the files of the example application, main.go,
+	// server.go and main_test.go, are written to. Files already there are
+	// left alone, so they can be edited. Defaults to "example".
+	Output string `yaml:"output,omitempty"`
+}
+
+// OutputDir returns the directory the example application is written to.
+func (e *ExampleAppConfig) OutputDir() string {
+	if e == nil || e.Output == "" {
+		return DefaultExampleAppOutput
+	}
+	return e.Output
+}
+
 // RuntimePackageConfig specifies an external package containing runtime helpers
 // (Date, Nullable, param style/bind functions, MarshalForm, etc.).
-// The runtime is split into three sub-packages: types, params, and helpers.
+// The runtime is split into sub-packages: types, params, helpers, and
+// jsonpointer.
 type RuntimePackageConfig struct {
 	// Path is the base import path for the runtime package
 	// (e.g., "github.com/org/project/runtime").
-	// Sub-packages are at Path/types, Path/params, and Path/helpers.
+	// Sub-packages are at Path/types, Path/params, Path/helpers, and
+	// Path/jsonpointer.
 	Path string `yaml:"path"`
 }
 
@@ -324,6 +532,14 @@ func (r *RuntimePackageConfig) HelpersImport() string {
 	return r.Path + "/helpers"
 }
 
+// JSONPointerImport returns the import path for the jsonpointer sub-package.
+func (r *RuntimePackageConfig) JSONPointerImport() string {
+	if r == nil || r.Path == "" {
+		return ""
+	}
+	return r.Path + "/jsonpointer"
+}
+
 // ExternalImport represents an external package import with its alias.
 type ExternalImport struct {
 	Alias string // Short alias for use in generated code (e.g., "ext_a1b2c3")
@@ -332,41 +548,56 @@ type ExternalImport struct {
 
 // ImportResolver resolves external references to Go package imports.
 type ImportResolver struct {
-	mapping map[string]ExternalImport // spec file path -> import info
+	mapping map[string]ExternalImport // spec file location -> import info
 }
 
 // NewImportResolver creates an ImportResolver from the configuration's import mapping.
 // Each mapping value is either a bare import path (alias is auto-generated via hash)
 // or "alias importpath" (explicit alias). The special value "-" means current package.
+// Spec file paths are matched by location, so "common.yaml" and "./common.yaml"
+// are the same file; mapping one file to two packages is an error.
 func NewImportResolver(importMapping map[string]string) (*ImportResolver, error) {
 	resolver := &ImportResolver{
 		mapping: make(map[string]ExternalImport),
 	}
 
-	for specPath, value := range importMapping {
-		if value == "-" {
-			// "-" means current package, no import needed
-			resolver.mapping[specPath] = ExternalImport{Alias: "", Path: ""}
-			continue
+	specPaths := make([]string, 0, len(importMapping))
+	for specPath := range importMapping {
+		specPaths = append(specPaths, specPath)
+	}
+	sort.Strings(specPaths)
+	mappedFrom := make(map[string]string, len(specPaths))
+
+	for _, specPath := range specPaths {
+		value := importMapping[specPath]
+		// "-" means current package, no import needed
+		var imp ExternalImport
+		if value != "-" {
+			parts := strings.Fields(value)
+			switch len(parts) {
+			case 1:
+				// Bare import path — auto-generate alias via hash
+				imp = ExternalImport{
+					Alias: hashImportAlias(parts[0]),
+					Path:  parts[0],
+				}
+			case 2:
+				// "alias importpath"
+				imp = ExternalImport{
+					Alias: parts[0],
+					Path:  parts[1],
+				}
+			default:
+				return nil, fmt.Errorf("invalid import-mapping value for %q: expected \"importpath\" or \"alias importpath\", got %q", specPath, value)
+			}
 		}
 
-		parts := strings.Fields(value)
-		switch len(parts) {
-		case 1:
-			// Bare import path — auto-generate alias via hash
-			resolver.mapping[specPath] = ExternalImport{
-				Alias: hashImportAlias(parts[0]),
-				Path:  parts[0],
-			}
-		case 2:
-			// "alias importpath"
-			resolver.mapping[specPath] = ExternalImport{
-				Alias: parts[0],
-				Path:  parts[1],
-			}
-		default:
-			return nil, fmt.Errorf("invalid import-mapping value for %q: expected \"importpath\" or \"alias importpath\", got %q", specPath, value)
+		location := specLocation(specPath)
+		if prev, ok := resolver.mapping[location]; ok && prev != imp {
+			return nil, fmt.Errorf("import-mapping maps %q and %q, the same file, to different packages", mappedFrom[location], specPath)
 		}
+		resolver.mapping[location] = imp
+		mappedFrom[location] = specPath
 	}
 
 	return resolver, nil
@@ -375,12 +606,25 @@ func NewImportResolver(importMapping map[string]string) (*ImportResolver, error)
 // Resolve looks up an external spec file path and returns its import info.
 // Returns nil if the path is not in the mapping.
 func (r *ImportResolver) Resolve(specPath string) *ExternalImport {
-	if imp, ok := r.mapping[specPath]; ok {
+	if imp, ok := r.mapping[specLocation(specPath)]; ok {
 		return &imp
 	}
 	return nil
 }
 
+// specLocation returns the location of a spec file, as the file part of an
+// external $ref or an import-mapping key names it, with its path cleaned:
+// "./common/../api.yaml" is "api.yaml". URLs keep their scheme and host.
+func specLocation(specPath string) string {
+	if u, err := url.Parse(specPath); err == nil && u.Scheme != "" && u.Host != "" {
+		if u.Path != "" {
+			u.Path = path.Clean(u.Path)
+		}
+		return u.String()
+	}
+	return path.Clean(filepath.ToSlash(specPath))
+}
+
 // AllImports returns all external imports sorted by alias.
 func (r *ImportResolver) AllImports() []ExternalImport {
 	var imports []ExternalImport
@@ -1,8 +1,13 @@
 package codegen
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
 	"gopkg.in/yaml.v3"
 )
 
@@ -137,6 +142,228 @@ func TestServerTypeConstants(t *testing.T) {
 	}
 }
 
+func TestTypeMapping_URIOptOut(t *testing.T) {
+	var cfg Configuration
+	err := yaml.Unmarshal([]byte(`
+type-mapping:
+  string:
+    formats:
+      uri:
+        type: string
+`), &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	merged := DefaultTypeMapping.Merge(cfg.TypeMapping)
+	if got := merged.String.Formats["uri"].Type; got != "string" {
+		t.Errorf("uri type = %q, want %q", got, "string")
+	}
+	if got := merged.String.Formats["uri-reference"].Type; got != "URI" {
+		t.Errorf("uri-reference type = %q, want %q", got, "URI")
+	}
+}
+
+func TestTypeMapping_UUIDBackends(t *testing.T) {
+	const spec = `
+openapi: "3.0.3"
+info:
+  title: UUID API
+  version: "1.0"
+paths:
+  /things/{id}:
+    get:
+      operationId: getThing
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            format: uuid
+      responses:
+        "204":
+          description: No content
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        id:
+          type: string
+          format: uuid
+`
+	tests := []struct {
+		name       string
+		mapping    string
+		runtimePkg bool
+		want       []string
+		notWant    []string
+	}{
+		{
+			name:    "default",
+			want:    []string{"ID *UUID", "type UUID = googleuuid.UUID", `googleuuid "github.com/google/uuid"`},
+			notWant: []string{`"github.com/gofrs/uuid"`},
+		},
+		{
+			name:    "google",
+			mapping: "{type: uuid.UUID, import: github.com/google/uuid}",
+			want:    []string{"ID *uuid.UUID", "id uuid.UUID", `"github.com/google/uuid"`},
+			notWant: []string{"googleuuid"},
+		},
+		{
+			name:    "gofrs",
+			mapping: "{type: uuid.UUID, import: github.com/gofrs/uuid}",
+			want:    []string{"ID *uuid.UUID", "id uuid.UUID", `"github.com/gofrs/uuid"`},
+			notWant: []string{`"github.com/google/uuid"`},
+		},
+		{
+			name:    "string",
+			mapping: "{type: UUIDString}",
+			want:    []string{"ID *UUIDString", "type UUIDString string"},
+			notWant: []string{`"github.com/google/uuid"`},
+		},
+		{
+			name:       "string from runtime package",
+			mapping:    "{type: UUIDString}",
+			runtimePkg: true,
+			want:       []string{"ID *oapiCodegenTypesPkg.UUIDString", "id oapiCodegenTypesPkg.UUIDString"},
+			notWant:    []string{"type UUIDString"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := libopenapi.NewDocument([]byte(spec))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfgYAML := "package: output\ngeneration:\n  client: true\n"
+			if tt.runtimePkg {
+				cfgYAML += "  runtime-package:\n    path: github.com/oapi-codegen/oapi-codegen-exp/runtime\n"
+			}
+			if tt.mapping != "" {
+				cfgYAML += "type-mapping:\n  string:\n    formats:\n      uuid: " + tt.mapping + "\n"
+			}
+			var cfg Configuration
+			if err := yaml.Unmarshal([]byte(cfgYAML), &cfg); err != nil {
+				t.Fatal(err)
+			}
+
+			code, err := Generate(doc, []byte(spec), cfg)
+			if err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(code, want) {
+					t.Errorf("expected generated code to contain %q", want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(code, notWant) {
+					t.Errorf("expected generated code not to contain %q", notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestTypeMapping_StrictEmail(t *testing.T) {
+	const spec = `
+openapi: "3.0.3"
+info:
+  title: Email API
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    User:
+      type: object
+      required: [email]
+      properties:
+        email:
+          type: string
+          format: email
+`
+	for _, runtimePkg := range []bool{false, true} {
+		doc, err := libopenapi.NewDocument([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfgYAML := "package: output\ntype-mapping:\n  string:\n    formats:\n      email: {type: StrictEmail}\n"
+		want := []string{"Email StrictEmail `", "type StrictEmail string", "mail.ParseAddress"}
+		if runtimePkg {
+			cfgYAML += "generation:\n  runtime-package:\n    path: github.com/oapi-codegen/oapi-codegen-exp/runtime\n"
+			want = []string{"Email oapiCodegenTypesPkg.StrictEmail `"}
+		}
+		var cfg Configuration
+		if err := yaml.Unmarshal([]byte(cfgYAML), &cfg); err != nil {
+			t.Fatal(err)
+		}
+		code, err := Generate(doc, []byte(spec), cfg)
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		for _, w := range want {
+			if !strings.Contains(code, w) {
+				t.Errorf("runtime package %v: expected generated code to contain %q", runtimePkg, w)
+			}
+		}
+	}
+}
+
+func TestTypeMapping_DateTime(t *testing.T) {
+	const spec = `
+openapi: "3.0.3"
+info:
+  title: Event API
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    Event:
+      type: object
+      required: [at]
+      properties:
+        at:
+          type: string
+          format: date-time
+        since:
+          type: string
+          format: date-time
+          default: "2024-01-01T00:00:00Z"
+`
+	for _, runtimePkg := range []bool{false, true} {
+		doc, err := libopenapi.NewDocument([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfgYAML := "package: output\ntype-mapping:\n  string:\n    formats:\n      date-time: {type: DateTimeMillis}\n"
+		want := []string{"*DateTimeMillis `form:\"since,omitempty\"", "type DateTimeMillis struct", `MustParseDateTimeMillis("2024-01-01T00:00:00Z")`}
+		if runtimePkg {
+			cfgYAML += "generation:\n  runtime-package:\n    path: github.com/oapi-codegen/oapi-codegen-exp/runtime\n"
+			want = []string{"*oapiCodegenTypesPkg.DateTimeMillis `form:\"since,omitempty\"", `oapiCodegenTypesPkg.MustParseDateTimeMillis("2024-01-01T00:00:00Z")`}
+		}
+		var cfg Configuration
+		if err := yaml.Unmarshal([]byte(cfgYAML), &cfg); err != nil {
+			t.Fatal(err)
+		}
+		code, err := Generate(doc, []byte(spec), cfg)
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		for _, w := range want {
+			if !strings.Contains(code, w) {
+				t.Errorf("runtime package %v: expected generated code to contain %q", runtimePkg, w)
+			}
+		}
+		if strings.Contains(code, "time.Time `") {
+			t.Errorf("runtime package %v: expected no time.Time fields", runtimePkg)
+		}
+	}
+}
+
 // contains is a simple helper for string containment check
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsAt(s, substr))
@@ -150,3 +377,164 @@ func containsAt(s, substr string) bool {
 	}
 	return false
 }
+
+func TestTypeMapping_PreciseNumbers(t *testing.T) {
+	var cfg Configuration
+	err := yaml.Unmarshal([]byte(`
+generation:
+  precise-numbers: big
+type-mapping:
+  integer:
+    formats:
+      snowflake:
+        type: Snowflake
+        import: example.com/ids
+`), &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ApplyDefaults()
+
+	tm := cfg.TypeMapping
+	for name, got := range map[string]string{
+		"integer":        tm.Integer.Default.Type,
+		"integer int32":  tm.Integer.Formats["int32"].Type,
+		"integer uint64": tm.Integer.Formats["uint64"].Type,
+		"number":         tm.Number.Default.Type,
+		"number double":  tm.Number.Formats["double"].Type,
+	} {
+		if want := map[bool]string{true: "BigInt", false: "DecimalNumber"}[strings.HasPrefix(name, "integer")]; got != want {
+			t.Errorf("%s type = %q, want %q", name, got, want)
+		}
+	}
+	if got := tm.Integer.Formats["snowflake"].Type; got != "Snowflake" {
+		t.Errorf("snowflake type = %q, want %q", got, "Snowflake")
+	}
+	if got := DefaultTypeMapping.Integer.Formats["int32"].Type; got != "int32" {
+		t.Errorf("default int32 type = %q, want %q", got, "int32")
+	}
+
+	unformatted := DefaultTypeMapping.withPreciseNumbers(PreciseNumbersJSONNumber, true)
+	if got := unformatted.Integer.Default.Type; got != "json.Number" {
+		t.Errorf("integer type = %q, want %q", got, "json.Number")
+	}
+	if got := unformatted.Number.Formats["float"].Type; got != "float32" {
+		t.Errorf("number float type = %q, want %q", got, "float32")
+	}
+}
+
+func TestImportResolver_Locations(t *testing.T) {
+	resolver, err := NewImportResolver(map[string]string{
+		"./common/api.yaml":                       "common github.com/org/project/common",
+		"https://example.com/specs/./shared.yaml": "github.com/org/shared",
+		"local.yaml":                              "-",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for specPath, want := range map[string]*ExternalImport{
+		"common/api.yaml":                       {Alias: "common", Path: "github.com/org/project/common"},
+		"./common/api.yaml":                     {Alias: "common", Path: "github.com/org/project/common"},
+		"other/../common/api.yaml":              {Alias: "common", Path: "github.com/org/project/common"},
+		"https://example.com/specs/shared.yaml": {Alias: hashImportAlias("github.com/org/shared"), Path: "github.com/org/shared"},
+		"./local.yaml":                          {},
+		"../common/api.yaml":                    nil,
+		"https://example.org/specs/shared.yaml": nil,
+	} {
+		got := resolver.Resolve(specPath)
+		if (got == nil) != (want == nil) || got != nil && *got != *want {
+			t.Errorf("Resolve(%q) = %v, want %v", specPath, got, want)
+		}
+	}
+
+	// The same file may be mapped twice to one package, but not to two
+	if _, err := NewImportResolver(map[string]string{
+		"common.yaml":   "github.com/org/common",
+		"./common.yaml": "github.com/org/common",
+	}); err != nil {
+		t.Errorf("mapping a file twice to one package: %v", err)
+	}
+	_, err = NewImportResolver(map[string]string{
+		"common.yaml":   "github.com/org/common",
+		"./common.yaml": "github.com/org/other",
+	})
+	want := `import-mapping maps "./common.yaml" and "common.yaml", the same file, to different packages`
+	if err == nil || err.Error() != want {
+		t.Errorf("mapping a file to two packages: got error %v, want %q", err, want)
+	}
+}
+
+// TestImportResolver_NestedReferrer verifies that an external $ref in a file
+// the root spec references, relative to that file, matches the import-mapping
+// key of its target, relative to the root spec.
+func TestImportResolver_NestedReferrer(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"api.yaml": `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          $ref: "./responses/pets.yaml#/components/responses/Pets"
+`,
+		"responses/pets.yaml": `components:
+  responses:
+    Pets:
+      description: The pets
+      content:
+        application/json:
+          schema:
+            type: array
+            items:
+              $ref: "../common/types.yaml#/components/schemas/Pet"
+`,
+		"common/types.yaml": `components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`,
+	} {
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	spec, err := os.ReadFile(filepath.Join(dir, "api.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	docConfig := datamodel.NewDocumentConfiguration()
+	docConfig.BasePath = dir
+	docConfig.AllowFileReferences = true
+	doc, err := libopenapi.NewDocumentWithConfiguration(spec, docConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, diagnostics, err := GenerateWithDiagnostics(doc, spec, Configuration{
+		PackageName:   "api",
+		Generation:    GenerationOptions{Client: true},
+		ImportMapping: map[string]string{"./common/types.yaml": "common github.com/org/common"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range diagnostics {
+		t.Errorf("unexpected diagnostic: %s", d.Message)
+	}
+	if !strings.Contains(code, "[]common.Pet") {
+		t.Errorf("the response isn't typed as []common.Pet:\n%s", code)
+	}
+}
@@ -18,7 +18,9 @@ const (
 
 // EliminateDeadCode parses a generated Go source file, identifies runtime
 // declarations (between the oapi-runtime markers), and removes any that are
-// not reachable from non-runtime code.
+// not reachable from non-runtime code. Runtime declarations sharing a name
+// with a non-runtime declaration, such as a schema type named after an unused
+// helper, are removed too, since references to the name resolve to the latter.
 //
 // The output is re-printed via go/printer, so callers should run goimports
 // afterward to normalize formatting.
@@ -73,6 +75,31 @@ func EliminateDeadCode(src string) (string, error) {
 		return src, nil
 	}
 
+	// Drop runtime declarations shadowed by root declarations.
+	declared := make(map[string]bool)
+	for _, d := range roots {
+		switch dd := d.(type) {
+		case *ast.GenDecl:
+			for _, name := range genDeclNames(dd) {
+				declared[name] = true
+			}
+		case *ast.FuncDecl:
+			if dd.Recv == nil {
+				declared[dd.Name.Name] = true
+			}
+		}
+	}
+	var shadowed []ast.Decl
+	unshadowed := candidates[:0]
+	for _, c := range candidates {
+		if isReachable(c.names, declared) {
+			shadowed = append(shadowed, c.decl)
+		} else {
+			unshadowed = append(unshadowed, c)
+		}
+	}
+	candidates = unshadowed
+
 	// Seed reachable set from root declarations.
 	reachable := make(map[string]bool)
 	for _, d := range roots {
@@ -96,7 +123,7 @@ func EliminateDeadCode(src string) (string, error) {
 
 	// Keep only reachable declarations; collect positions of eliminated ones.
 	kept := append([]ast.Decl{}, roots...)
-	var eliminated []ast.Decl
+	eliminated := shadowed
 	for _, c := range candidates {
 		if isReachable(c.names, reachable) {
 			kept = append(kept, c.decl)
@@ -202,8 +229,14 @@ func linesOverlap(cgStart, cgEnd int, spans []lineSpan) bool {
 
 func collectIdents(node ast.Node, idents map[string]bool) {
 	ast.Inspect(node, func(n ast.Node) bool {
-		if id, ok := n.(*ast.Ident); ok {
-			idents[id.Name] = true
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			// The selected name is a field, a method or a member of an
+			// imported package, never a top-level declaration of this file.
+			collectIdents(n.X, idents)
+			return false
+		case *ast.Ident:
+			idents[n.Name] = true
 		}
 		return true
 	})
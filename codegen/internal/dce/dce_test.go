@@ -184,3 +184,61 @@ func unused() {}
 		}
 	}
 }
+
+func TestEliminateDeadCode_ShadowedByRoot(t *testing.T) {
+	src := `package gen
+
+// Fault is a schema type sharing its name with a runtime helper.
+type Fault struct {
+	Code string
+}
+
+func usedFunc(f Fault) {
+	helperFunc()
+}
+
+// --- oapi-runtime begin ---
+
+// helperFunc is used by usedFunc.
+func helperFunc() {}
+
+// Fault is the runtime helper.
+type Fault struct {
+	Latency int
+}
+
+// Describe is a method of the runtime Fault.
+func (f Fault) Describe() string { return "" }
+
+// --- oapi-runtime end ---
+`
+
+	result, err := EliminateDeadCode(src)
+	require.NoError(t, err)
+
+	assert.Contains(t, result, "helperFunc")
+	assert.Contains(t, result, "Code string")
+	assert.NotContains(t, result, "Latency")
+	assert.NotContains(t, result, "Describe")
+	assert.NotContains(t, result, "the runtime helper")
+}
+
+func TestEliminateDeadCode_IgnoresSelectedNames(t *testing.T) {
+	src := `package gen
+
+import "time"
+
+var timeout time.Duration
+
+// --- oapi-runtime begin ---
+
+// Duration is an ISO 8601 duration.
+type Duration time.Duration
+
+// --- oapi-runtime end ---
+`
+
+	result, err := EliminateDeadCode(src)
+	require.NoError(t, err)
+	assert.NotContains(t, result, "ISO 8601")
+}
@@ -0,0 +1,185 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi"
+	"go.yaml.in/yaml/v4"
+)
+
+// Severity is how serious a Diagnostic is.
+type Severity string
+
+// Severities of diagnostics.
+const (
+	SeverityWarning Severity = "warning" // Generation went on, maybe not as the spec meant
+	SeverityError   Severity = "error"   // Generation failed
+)
+
+// Diagnostic is a warning or error of a generation run, located in the spec
+// it comes from, for IDEs and CI to point at.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	// Pointer is the JSON pointer of the part of the spec the diagnostic is
+	// about, such as "#/components/schemas/Pet", when it's about one.
+	Pointer string `json:"pointer,omitempty"`
+	// File, Line and Column locate Pointer in the spec, once it's resolved.
+	// Line and Column start at 1 and are 0 when unknown. File is the
+	// SpecFilePath of the document configuration, which may be empty.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// String formats d as compilers do: "openapi.yaml:12:7: warning: message".
+func (d Diagnostic) String() string {
+	var s string
+	if d.File != "" {
+		s = d.File + ":"
+	}
+	if d.Line > 0 {
+		s += strconv.Itoa(d.Line) + ":" + strconv.Itoa(d.Column) + ":"
+	}
+	if s != "" {
+		s += " "
+	}
+	s += string(d.Severity) + ": " + d.Message
+	if d.Pointer != "" && d.Line == 0 {
+		s += " (" + d.Pointer + ")"
+	}
+	return s
+}
+
+// specError is an error about a part of the spec, which GenerateWithDiagnostics
+// turns into an error Diagnostic located at it.
+type specError struct {
+	path SchemaPath
+	err  error
+}
+
+func (e *specError) Error() string { return e.err.Error() }
+func (e *specError) Unwrap() error { return e.err }
+
+// errorAt returns err, recording that it's about the part of the spec at path.
+func errorAt(path SchemaPath, err error) error {
+	return &specError{path: path, err: err}
+}
+
+// CollectDiagnostics makes Warn record diagnostics, located in doc and
+// returned by Diagnostics, rather than log them.
+func (c *CodegenContext) CollectDiagnostics(doc libopenapi.Document) {
+	c.collectDiagnostics = true
+	if info := doc.GetSpecInfo(); info != nil {
+		c.specRoot = info.RootNode
+	}
+	if docConfig := doc.GetConfiguration(); docConfig != nil {
+		c.specFile = docConfig.SpecFilePath
+	}
+}
+
+// Warn reports a warning about the part of the spec at path. It's logged with
+// slog unless CollectDiagnostics was called.
+func (c *CodegenContext) Warn(path SchemaPath, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if c == nil || !c.collectDiagnostics {
+		slog.Warn(message, "path", path.String())
+		return
+	}
+	c.diagnostics = append(c.diagnostics, c.diagnostic(SeverityWarning, path, message))
+}
+
+// Diagnostics returns the diagnostics recorded since CollectDiagnostics was
+// called.
+func (c *CodegenContext) Diagnostics() []Diagnostic {
+	return c.diagnostics
+}
+
+// diagnostic returns a Diagnostic about the part of the spec at path, located
+// in the spec when path resolves in it.
+func (c *CodegenContext) diagnostic(severity Severity, path SchemaPath, message string) Diagnostic {
+	d := Diagnostic{Severity: severity, Message: message}
+	if path == nil {
+		return d
+	}
+	d.Pointer = jsonPointer(path)
+	if node := resolvePointerNode(c.specRoot, path); node != nil {
+		d.File, d.Line, d.Column = c.specFile, node.Line, node.Column
+	}
+	return d
+}
+
+// GenerateWithDiagnostics is like Generate, and also returns the warnings of
+// the run, and its error if it fails, as diagnostics located in the spec.
+func GenerateWithDiagnostics(doc libopenapi.Document, specData []byte, cfg Configuration) (string, []Diagnostic, error) {
+	ctx := NewCodegenContext()
+	ctx.CollectDiagnostics(doc)
+	code, err := generate(doc, specData, cfg, ctx)
+	diagnostics := ctx.Diagnostics()
+	if err != nil {
+		var path SchemaPath
+		var se *specError
+		if errors.As(err, &se) {
+			path = se.path
+		}
+		diagnostics = append(diagnostics, ctx.diagnostic(SeverityError, path, err.Error()))
+	}
+	return code, diagnostics, err
+}
+
+// jsonPointer returns path as a JSON pointer fragment, escaping "~" and "/"
+// in its elements, as in "#/paths/~1pets~1{id}/get".
+func jsonPointer(path SchemaPath) string {
+	escaped := make([]string, len(path))
+	for i, element := range path {
+		escaped[i] = strings.NewReplacer("~", "~0", "/", "~1").Replace(element)
+	}
+	return "#/" + strings.Join(escaped, "/")
+}
+
+// resolvePointerNode returns the node of root at path, or the key of that
+// node when it's a mapping value, so that diagnostics point at the name of
+// what they're about. It returns nil when path doesn't resolve, such as when
+// it leads into another file.
+func resolvePointerNode(root *yaml.Node, path SchemaPath) *yaml.Node {
+	if root == nil {
+		return nil
+	}
+	node, located := root, root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node, located = node.Content[0], node.Content[0]
+	}
+	for _, element := range path {
+		for node.Kind == yaml.AliasNode && node.Alias != nil {
+			node = node.Alias
+		}
+		switch node.Kind {
+		case yaml.MappingNode:
+			var next *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == element {
+					located, next = node.Content[i], node.Content[i+1]
+					break
+				}
+			}
+			if next == nil {
+				return nil
+			}
+			node = next
+		case yaml.SequenceNode:
+			n, err := strconv.Atoi(element)
+			if err != nil || n < 0 || n >= len(node.Content) {
+				return nil
+			}
+			node = node.Content[n]
+			located = node
+		default:
+			return nil
+		}
+	}
+	return located
+}
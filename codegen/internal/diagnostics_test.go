@@ -0,0 +1,113 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWithDiagnostics_Warnings(t *testing.T) {
+	spec := `openapi: "3.0.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: ok
+  /users:
+    get:
+      operationId: GetPet
+      responses:
+        "200":
+          description: ok
+components:
+  schemas:
+    Pet:
+      type: object
+      x-oapi-codegen-type-override: 5
+      properties:
+        id:
+          type: string
+        ID:
+          type: string
+`
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+
+	_, diagnostics, err := GenerateWithDiagnostics(doc, []byte(spec), Configuration{
+		PackageName: "api",
+		Generation:  GenerationOptions{Client: true},
+	})
+	require.NoError(t, err)
+
+	type located struct {
+		Pointer      string
+		Line, Column int
+	}
+	got := make(map[located]string)
+	for _, d := range diagnostics {
+		assert.Equal(t, SeverityWarning, d.Severity)
+		got[located{d.Pointer, d.Line, d.Column}] = d.Message
+	}
+	assert.Equal(t, map[located]string{
+		{"#/paths/~1pets~1{id}/get", 7, 5}:                `renamed operation "getPet" to GetPet0, as its Go name collides with another`,
+		{"#/paths/~1users/get", 19, 5}:                    `renamed operation "GetPet" to GetPet1, as its Go name collides with another`,
+		{"#/components/schemas/Pet", 26, 5}:               "ignoring extensions: parsing x-oapi-codegen-type-override: expected string, got int",
+		{"#/components/schemas/Pet/properties/id", 30, 9}: `renamed field "id" to ID0, as its Go name collides with another`,
+		{"#/components/schemas/Pet/properties/ID", 32, 9}: `renamed field "ID" to ID1, as its Go name collides with another`,
+	}, got)
+}
+
+func TestGenerateWithDiagnostics_Error(t *testing.T) {
+	spec := `openapi: "3.0.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      x-oapi-codegen-cacheable: true
+      responses:
+        "200":
+          description: ok
+`
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+
+	_, diagnostics, err := GenerateWithDiagnostics(doc, []byte(spec), Configuration{
+		PackageName: "api",
+		Generation:  GenerationOptions{Client: true},
+	})
+	require.Error(t, err)
+	require.Len(t, diagnostics, 1)
+	d := diagnostics[0]
+	assert.Equal(t, SeverityError, d.Severity)
+	assert.Equal(t, err.Error(), d.Message)
+	assert.Equal(t, "#/paths/~1pets/post", d.Pointer)
+	assert.Equal(t, 7, d.Line)
+	assert.Equal(t, 5, d.Column)
+}
+
+func TestDiagnostic_String(t *testing.T) {
+	assert.Equal(t, "openapi.yaml:12:7: warning: renamed",
+		Diagnostic{Severity: SeverityWarning, Message: "renamed", Pointer: "#/paths", File: "openapi.yaml", Line: 12, Column: 7}.String())
+	assert.Equal(t, "12:7: warning: renamed",
+		Diagnostic{Severity: SeverityWarning, Message: "renamed", Line: 12, Column: 7}.String())
+	assert.Equal(t, "error: failed (#/components/schemas/Pet)",
+		Diagnostic{Severity: SeverityError, Message: "failed", Pointer: "#/components/schemas/Pet"}.String())
+	assert.Equal(t, "error: failed",
+		Diagnostic{Severity: SeverityError, Message: "failed"}.String())
+}
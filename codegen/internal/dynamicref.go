@@ -0,0 +1,262 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+)
+
+// dynamicRefResolver resolves the $dynamicRefs of JSON Schema 2020-12, which
+// libopenapi leaves as schemas of their own, so that generic schemas such as
+// a list envelope get concrete types where a schema binds their anchors:
+//
+//	PagedList:
+//	  properties:
+//	    items: {type: array, items: {$dynamicRef: '#item'}}
+//	  $defs:
+//	    item: {$dynamicAnchor: item}
+//	PetList:
+//	  $ref: '#/components/schemas/PagedList'
+//	  $defs:
+//	    item: {$dynamicAnchor: item, $ref: '#/components/schemas/Pet'}
+//
+// The $dynamicRefs are replaced by the schema of the outermost $dynamicAnchor
+// in scope, expanding the $refs leading to them, so PetList holds []Pet.
+type dynamicRefResolver struct {
+	components map[string]*yaml.Node // Nodes of the component schemas, by $ref
+	reaches    map[string]bool       // Whether a component leads to a $dynamicRef
+}
+
+// newDynamicRefResolver indexes the component schemas of a document, whose
+// $refs are expanded where they lead to $dynamicRefs.
+func newDynamicRefResolver(schemas *orderedmap.Map[string, *base.SchemaProxy]) *dynamicRefResolver {
+	r := &dynamicRefResolver{
+		components: make(map[string]*yaml.Node),
+		reaches:    make(map[string]bool),
+	}
+	for pair := schemas.First(); pair != nil; pair = pair.Next() {
+		if node := pair.Value().GetValueNode(); node != nil {
+			r.components[jsonPointer(SchemaPath{"components", "schemas", pair.Key()})] = node
+		}
+	}
+	return r
+}
+
+// resolve returns proxy with its $dynamicRefs resolved, where it declares
+// $dynamicAnchors, or proxy itself. Anchors declared by the schema itself,
+// rather than in its $defs, bind to it, if it is a component schema at path.
+func (r *dynamicRefResolver) resolve(proxy *base.SchemaProxy, path SchemaPath) (*base.SchemaProxy, error) {
+	if r == nil || proxy.IsReference() || proxy.GoLow() == nil {
+		return proxy, nil
+	}
+	node := proxy.GetValueNode()
+	self := ""
+	if len(path) == 3 && path[0] == "components" && path[1] == "schemas" {
+		self = jsonPointer(path)
+	}
+	scope := dynamicAnchors(node, self, nil)
+	if len(scope) == 0 {
+		return proxy, nil
+	}
+	expanding := map[string]bool{self: true}
+	resolved, changed := r.resolveNode(node, scope, expanding)
+	if !changed {
+		return proxy, nil
+	}
+
+	// Build the schema of the resolved node as libopenapi would have.
+	lowProxy := proxy.GoLow()
+	built := &lowbase.SchemaProxy{}
+	if err := built.Build(lowProxy.GetContext(), lowProxy.GetKeyNode(), resolved, lowProxy.GetIndex()); err != nil {
+		return proxy, err
+	}
+	return base.NewSchemaProxy(&low.NodeReference[*lowbase.SchemaProxy]{
+		Value:     built,
+		KeyNode:   lowProxy.GetKeyNode(),
+		ValueNode: resolved,
+	}), nil
+}
+
+// resolveNode returns a copy of node with the $dynamicRefs bound in scope
+// replaced, and whether there were any. The nodes of node are left as they are.
+func (r *dynamicRefResolver) resolveNode(node *yaml.Node, scope map[string]*yaml.Node, expanding map[string]bool) (*yaml.Node, bool) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		scope = dynamicAnchors(node, "", scope)
+		if dynamicRef := mappingNodeValue(node, "$dynamicRef"); dynamicRef != nil {
+			name, ok := strings.CutPrefix(dynamicRef.Value, "#")
+			if bound := scope[name]; ok && bound != nil {
+				return withoutNodeKeys(bound, "$dynamicAnchor", "$defs"), true
+			}
+			return node, false
+		}
+		if ref := mappingNodeValue(node, "$ref"); ref != nil {
+			target := r.components[ref.Value]
+			if target == nil || expanding[ref.Value] || !r.reachesDynamicRef(ref.Value) {
+				return node, false
+			}
+			// Expand the $ref, within which anchors the target declares on
+			// itself bind to it unless bound already
+			nested := make(map[string]bool, len(expanding)+1)
+			for k := range expanding {
+				nested[k] = true
+			}
+			nested[ref.Value] = true
+			resolved, _ := r.resolveNode(target, dynamicAnchors(target, ref.Value, scope), nested)
+			return resolved, true
+		}
+		var content []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value != "$defs" {
+				if resolved, changed := r.resolveNode(value, scope, expanding); changed {
+					if content == nil {
+						content = append([]*yaml.Node(nil), node.Content...)
+					}
+					content[i+1] = resolved
+				}
+			}
+		}
+		if content == nil {
+			return node, false
+		}
+		copied := *node
+		copied.Content = content
+		return &copied, true
+	case yaml.SequenceNode:
+		var content []*yaml.Node
+		for i, item := range node.Content {
+			if resolved, changed := r.resolveNode(item, scope, expanding); changed {
+				if content == nil {
+					content = append([]*yaml.Node(nil), node.Content...)
+				}
+				content[i] = resolved
+			}
+		}
+		if content == nil {
+			return node, false
+		}
+		copied := *node
+		copied.Content = content
+		return &copied, true
+	}
+	return node, false
+}
+
+// reachesDynamicRef reports whether the component schema at ref holds a
+// $dynamicRef, directly or through the components it references.
+func (r *dynamicRefResolver) reachesDynamicRef(ref string) bool {
+	if reaches, ok := r.reaches[ref]; ok {
+		return reaches
+	}
+	r.reaches[ref] = false // A cycle doesn't reach one by itself
+	var walk func(node *yaml.Node) bool
+	walk = func(node *yaml.Node) bool {
+		switch node.Kind {
+		case yaml.MappingNode:
+			if mappingNodeValue(node, "$dynamicRef") != nil {
+				return true
+			}
+			if target := mappingNodeValue(node, "$ref"); target != nil && r.components[target.Value] != nil {
+				return r.reachesDynamicRef(target.Value)
+			}
+			for i := 1; i < len(node.Content); i += 2 {
+				if node.Content[i-1].Value != "$defs" && walk(node.Content[i]) {
+					return true
+				}
+			}
+		case yaml.SequenceNode:
+			for _, item := range node.Content {
+				if walk(item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	r.reaches[ref] = walk(r.components[ref])
+	return r.reaches[ref]
+}
+
+// dynamicAnchors returns scope with the $dynamicAnchors node declares in its
+// $defs, or in those of its inline allOf members, where libopenapi moves the
+// siblings of a $ref. Anchors bound in scope already are kept, as the
+// outermost binding wins. Anchors declared on node itself bind to self, its
+// $ref, unless it's empty. scope is copied when there are anchors to add.
+func dynamicAnchors(node *yaml.Node, self string, scope map[string]*yaml.Node) map[string]*yaml.Node {
+	added := false
+	bind := func(name string, schema *yaml.Node) {
+		if _, ok := scope[name]; ok {
+			return
+		}
+		if !added {
+			copied := make(map[string]*yaml.Node, len(scope)+1)
+			for k, v := range scope {
+				copied[k] = v
+			}
+			scope, added = copied, true
+		}
+		scope[name] = schema
+	}
+	var collect func(node *yaml.Node)
+	collect = func(node *yaml.Node) {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return
+		}
+		if anchor := mappingNodeValue(node, "$dynamicAnchor"); anchor != nil && self != "" {
+			bind(anchor.Value, &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: "$ref"},
+				{Kind: yaml.ScalarNode, Tag: "!!str", Value: self},
+			}})
+		}
+		if defs := mappingNodeValue(node, "$defs"); defs != nil && defs.Kind == yaml.MappingNode {
+			for i := 1; i < len(defs.Content); i += 2 {
+				if anchor := mappingNodeValue(defs.Content[i], "$dynamicAnchor"); anchor != nil {
+					bind(anchor.Value, defs.Content[i])
+				}
+			}
+		}
+		if allOf := mappingNodeValue(node, "allOf"); allOf != nil && allOf.Kind == yaml.SequenceNode {
+			for _, member := range allOf.Content {
+				if mappingNodeValue(member, "$ref") == nil {
+					collect(member)
+				}
+			}
+		}
+	}
+	collect(node)
+	return scope
+}
+
+// mappingNodeValue returns the value of key in a mapping node, or nil.
+func mappingNodeValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// withoutNodeKeys returns a copy of a mapping node without keys.
+func withoutNodeKeys(node *yaml.Node, keys ...string) *yaml.Node {
+	copied := *node
+	copied.Content = nil
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		drop := false
+		for _, key := range keys {
+			drop = drop || node.Content[i].Value == key
+		}
+		if !drop {
+			copied.Content = append(copied.Content, node.Content[i], node.Content[i+1])
+		}
+	}
+	return &copied
+}
@@ -22,6 +22,12 @@ type EnumInfo struct {
 	Doc string
 	// SchemaPath is the key used to look up this EnumInfo (schema path string).
 	SchemaPath string
+	// Const indicates the enum holds the single value of a const keyword,
+	// which UnmarshalJSON then requires.
+	Const bool
+	// Strict indicates UnmarshalJSON rejects values outside the enum
+	// (generation.strict-enums).
+	Strict bool
 
 	// PrefixTypeName indicates whether constant names should be prefixed with the type name.
 	// Set by resolveEnumCollisions when collisions are detected.
@@ -0,0 +1,265 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/pb33f/libopenapi"
+	"golang.org/x/tools/imports"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/templates"
+)
+
+// DefaultExampleAppOutput is the directory the example application is written
+// to when ExampleAppConfig.Output is empty.
+const DefaultExampleAppOutput = "example"
+
+// ExampleAppOutput holds the files of the example application, keyed by file
+// name: main.go, server.go and main_test.go.
+type ExampleAppOutput struct {
+	Files map[string]string
+}
+
+// exampleAppData is the input of the example application templates.
+type exampleAppData struct {
+	Command    string // Name of the command, the base of its directory
+	Package    string // Name the generated package is imported as
+	Path       string // Import path of the generated package
+	Server     string // Server framework
+	Imports    []string
+	Operations []exampleOperation
+}
+
+// exampleOperation is an operation of the example application, with the
+// types of its parameters qualified for package main.
+type exampleOperation struct {
+	GoOperationID string
+	Method        string
+	Path          string
+	HandlerParams string // Parameters of the handler after the framework's own
+	ClientMethod  string // Method of Client sending the request
+	ClientArgs    string // Arguments of ClientMethod after the context
+}
+
+// GenerateExampleApp generates the example application of the package
+// Generate produces for doc under cfg: a main.go serving a stub
+// ServerInterface with the configured server framework, and a main_test.go
+// calling it through the generated client, and the responses validating
+// test server.
+func GenerateExampleApp(doc libopenapi.Document, cfg Configuration) (*ExampleAppOutput, error) {
+	app := cfg.Generation.ExampleApp
+	if app == nil || app.Path == "" {
+		return nil, fmt.Errorf("example-app requires its path, the import path of the generated package, to be set")
+	}
+	if cfg.Generation.Server == "" || !cfg.Generation.Client || !cfg.Generation.ValidatingTestServer {
+		return nil, fmt.Errorf("example-app requires server, client and validating-test-server to be set")
+	}
+	if cfg.Generation.SkipRawClient {
+		return nil, fmt.Errorf("example-app can't be used with skip-raw-client")
+	}
+	if _, err := NameManglingPreset(cfg.NameMangling.Preset); err != nil {
+		return nil, err
+	}
+	cfg.ApplyDefaults()
+
+	v3Doc, err := buildV3Model(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := NewCodegenContext()
+	configureRuntimePrefixes(ctx, cfg)
+	contentTypeMatcher := NewContentTypeMatcher(cfg.ContentTypes)
+	converter := NewNameConverter(cfg.NameMangling, cfg.NameSubstitutions)
+	schemas, err := gatherNamedSchemas(v3Doc, ctx, cfg, contentTypeMatcher, converter)
+	if err != nil {
+		return nil, err
+	}
+	schemaIndex := make(map[string]*SchemaDescriptor, len(schemas))
+	for _, s := range schemas {
+		schemaIndex[s.Path.String()] = s
+	}
+	ops, err := GatherOperations(v3Doc, ctx, contentTypeMatcher, cfg.TypeMapping)
+	if err != nil {
+		return nil, fmt.Errorf("gathering operations: %w", err)
+	}
+	ops = FilterOperations(ops, cfg.OutputOptions)
+	resolveInlineParamTypes(ops, schemaIndex)
+
+	data := exampleAppData{
+		Command: path.Base(app.OutputDir()),
+		Package: cfg.PackageName,
+		Path:    app.Path,
+		Server:  cfg.Generation.Server,
+	}
+	usedPackages := make(map[string]bool)
+	for _, op := range ops {
+		eop, err := newExampleOperation(op, cfg.PackageName, usedPackages)
+		if err != nil {
+			return nil, err
+		}
+		data.Operations = append(data.Operations, eop)
+	}
+
+	// The types of parameters may refer to packages the generated package
+	// imports, such as time or the runtime types package.
+	if rp := cfg.Generation.RuntimePackage; rp != nil {
+		ctx.AddImportAlias(rp.TypesImport(), "oapiCodegenTypesPkg")
+	}
+	if mp := cfg.Generation.ModelsPackage; mp != nil && mp.Path != "" {
+		ctx.AddImportAlias(mp.Path, mp.Alias)
+	}
+	for importPath, alias := range ctx.Imports() {
+		name := alias
+		if name == "" {
+			name = importName(importPath)
+		}
+		if !usedPackages[name] {
+			continue
+		}
+		spec := strconv.Quote(importPath)
+		if alias != "" {
+			spec = alias + " " + spec
+		}
+		data.Imports = append(data.Imports, spec)
+	}
+	sort.Strings(data.Imports)
+
+	tmpl := template.New("example").Funcs(templates.Funcs())
+	out := &ExampleAppOutput{Files: make(map[string]string)}
+	for _, file := range []string{"main.go", "server.go", "main_test.go"} {
+		content, err := templates.TemplateFS.ReadFile("files/example/" + file + ".tmpl")
+		if err != nil {
+			return nil, fmt.Errorf("reading template %s: %w", file, err)
+		}
+		t, err := tmpl.New(file).Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("parsing template %s: %w", file, err)
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("executing template %s: %w", file, err)
+		}
+		formatted, err := imports.Process(file, buf.Bytes(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", file, err)
+		}
+		out.Files[file] = string(formatted)
+	}
+	return out, nil
+}
+
+// newExampleOperation describes op for the example application, qualifying
+// the types of its parameters with pkg. The packages they refer to are added
+// to usedPackages.
+func newExampleOperation(op *OperationDescriptor, pkg string, usedPackages map[string]bool) (exampleOperation, error) {
+	eop := exampleOperation{
+		GoOperationID: op.GoOperationID,
+		Method:        op.Method,
+		Path:          op.Path,
+		ClientMethod:  op.GoOperationID,
+	}
+
+	var params, args strings.Builder
+	for _, p := range op.PathParams {
+		typeDecl, err := qualifyType(p.TypeDecl, pkg, usedPackages)
+		if err != nil {
+			return eop, fmt.Errorf("operation %s: parameter %s: %w", op.OperationID, p.Name, err)
+		}
+		fmt.Fprintf(&params, ", %s %s", p.GoVariableName(), typeDecl)
+		fmt.Fprintf(&args, ", %s", exampleArgument(p, typeDecl))
+	}
+	if op.HasParams {
+		fmt.Fprintf(&params, ", params %s.%s", pkg, op.ParamsTypeName)
+		args.WriteString(", nil")
+	}
+	if op.HasBody {
+		eop.ClientMethod += "WithBody"
+		fmt.Fprintf(&args, ", %q, http.NoBody", op.Bodies[0].ContentType)
+	}
+	eop.HandlerParams = params.String()
+	eop.ClientArgs = args.String()
+	return eop, nil
+}
+
+// exampleArgument returns an argument for path parameter p of type typeDecl
+// which routes: the first value of its enum, a placeholder for a plain
+// string, which can't be empty, or else the zero value.
+func exampleArgument(p *ParameterDescriptor, typeDecl string) string {
+	if p.Schema != nil && p.Schema.Schema != nil && len(p.Schema.Schema.Enum) > 0 {
+		value := p.Schema.Schema.Enum[0].Value
+		if p.Schema.Schema.Enum[0].Tag == "!!str" {
+			value = strconv.Quote(value)
+		}
+		return typeDecl + "(" + value + ")"
+	}
+	if typeDecl == "string" {
+		return `"example"`
+	}
+	return "zero[" + typeDecl + "]()"
+}
+
+// qualifyType qualifies the exported identifiers of typeDecl, a Go type
+// declared in the generated package, with pkg: "[]*Pet" becomes "[]*api.Pet".
+// Identifiers already qualified, such as time.Time, are left alone, and
+// their packages added to usedPackages.
+func qualifyType(typeDecl, pkg string, usedPackages map[string]bool) (string, error) {
+	expr, err := parser.ParseExpr(typeDecl)
+	if err != nil {
+		return "", fmt.Errorf("parsing type %q: %w", typeDecl, err)
+	}
+	var qualify func(e ast.Expr) ast.Expr
+	qualify = func(e ast.Expr) ast.Expr {
+		switch e := e.(type) {
+		case *ast.Ident:
+			if r := []rune(e.Name); len(r) > 0 && unicode.IsUpper(r[0]) {
+				return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: e}
+			}
+		case *ast.SelectorExpr:
+			if x, ok := e.X.(*ast.Ident); ok {
+				usedPackages[x.Name] = true
+			}
+		case *ast.StarExpr:
+			e.X = qualify(e.X)
+		case *ast.ArrayType:
+			e.Elt = qualify(e.Elt)
+		case *ast.MapType:
+			e.Key = qualify(e.Key)
+			e.Value = qualify(e.Value)
+		case *ast.IndexExpr:
+			e.X = qualify(e.X)
+			e.Index = qualify(e.Index)
+		case *ast.IndexListExpr:
+			e.X = qualify(e.X)
+			for i := range e.Indices {
+				e.Indices[i] = qualify(e.Indices[i])
+			}
+		}
+		return e
+	}
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), qualify(expr)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// importName returns the name a package is imported as by default: the last
+// element of its path, skipping a major version suffix such as /v5.
+func importName(importPath string) string {
+	name := path.Base(importPath)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
@@ -0,0 +1,79 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQualifyType(t *testing.T) {
+	tests := []struct {
+		typeDecl string
+		want     string
+		packages []string
+	}{
+		{"string", "string", nil},
+		{"Pet", "api.Pet", nil},
+		{"[]*Pet", "[]*api.Pet", nil},
+		{"map[string]Pet", "map[string]api.Pet", nil},
+		{"time.Time", "time.Time", []string{"time"}},
+		{"oapiCodegenTypesPkg.Nullable[Pet]", "oapiCodegenTypesPkg.Nullable[api.Pet]", []string{"oapiCodegenTypesPkg"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeDecl, func(t *testing.T) {
+			used := make(map[string]bool)
+			got, err := qualifyType(tt.typeDecl, "api", used)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			for _, pkg := range tt.packages {
+				assert.True(t, used[pkg], pkg)
+			}
+			assert.Len(t, used, len(tt.packages))
+		})
+	}
+}
+
+func TestImportName(t *testing.T) {
+	assert.Equal(t, "time", importName("time"))
+	assert.Equal(t, "chi", importName("github.com/go-chi/chi/v5"))
+	assert.Equal(t, "uuid", importName("github.com/google/uuid"))
+}
+
+func TestGenerateExampleApp_Requirements(t *testing.T) {
+	spec := `openapi: "3.0.0"
+info:
+  title: Test
+  version: "1.0"
+paths: {}
+`
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		gen  GenerationOptions
+		want string
+	}{
+		{"no path", GenerationOptions{
+			Server: ServerTypeStdHTTP, Client: true, ValidatingTestServer: true,
+			ExampleApp: &ExampleAppConfig{},
+		}, "requires its path"},
+		{"no client", GenerationOptions{
+			Server: ServerTypeStdHTTP, ValidatingTestServer: true,
+			ExampleApp: &ExampleAppConfig{Path: "example.com/api"},
+		}, "requires server, client and validating-test-server"},
+		{"skip raw client", GenerationOptions{
+			Server: ServerTypeStdHTTP, Client: true, ValidatingTestServer: true, SkipRawClient: true,
+			ExampleApp: &ExampleAppConfig{Path: "example.com/api"},
+		}, "skip-raw-client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GenerateExampleApp(doc, Configuration{PackageName: "api", Generation: tt.gen})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -15,7 +15,8 @@ const (
 	// Format: "TypeName" or "TypeName;import/path" or "TypeName;alias import/path"
 	ExtTypeOverride = "x-oapi-codegen-type-override"
 
-	// ExtNameOverride overrides the generated field name.
+	// ExtNameOverride overrides the generated field name of a property, or
+	// the type name of any other schema.
 	ExtNameOverride = "x-oapi-codegen-name-override"
 
 	// ExtTypeNameOverride overrides the generated type name.
@@ -41,6 +42,45 @@ const (
 
 	// ExtOrder controls field ordering in generated structs.
 	ExtOrder = "x-oapi-codegen-order"
+
+	// ExtSensitive marks a property whose value is redacted from the generated
+	// client's debug dumps.
+	ExtSensitive = "x-oapi-codegen-sensitive"
+
+	// ExtOptionalProperties overrides the optional-properties output option
+	// for the optional properties of a schema, or for a single property.
+	ExtOptionalProperties = "x-oapi-codegen-optional-properties"
+)
+
+// Operation-level extension names
+const (
+	// ExtCacheable marks a GET operation as cacheable by the generated client's
+	// response cache.
+	ExtCacheable = "x-oapi-codegen-cacheable"
+
+	// ExtLRO marks an operation answering 202 Accepted as long-running, and
+	// names the operation returning the status resource its Location or
+	// Operation-Location header points at.
+	ExtLRO = "x-oapi-codegen-lro"
+)
+
+// Parameter-level extension names
+const (
+	// ExtIdempotencyKey marks a header parameter as an idempotency key, which
+	// generated clients populate with a UUID when the caller leaves it unset.
+	ExtIdempotencyKey = "x-oapi-codegen-idempotency-key"
+
+	// ExtStyle overrides the style of a parameter, for servers expecting
+	// another serialization than the spec declares.
+	ExtStyle = "x-oapi-codegen-style"
+
+	// ExtExplode overrides the explode of a parameter, like ExtStyle.
+	ExtExplode = "x-oapi-codegen-explode"
+
+	// ExtTimeFormat sets the layout of a date or date-time parameter, a Go
+	// time layout such as "20060102", or "unix" or "unixmilli" for seconds or
+	// milliseconds since the Unix epoch, in place of RFC 3339.
+	ExtTimeFormat = "x-oapi-codegen-time-format"
 )
 
 // Legacy extension names for backwards compatibility
@@ -69,7 +109,7 @@ type TypeOverride struct {
 // Extensions holds parsed extension values for a schema or property.
 type Extensions struct {
 	TypeOverride        *TypeOverride // External type to use
-	NameOverride        string        // Override field name
+	NameOverride        string        // Override field name, or type name
 	TypeNameOverride    string        // Override generated type name
 	SkipOptionalPointer *bool         // Skip pointer for optional fields
 	JSONIgnore          *bool         // Exclude from JSON
@@ -78,6 +118,8 @@ type Extensions struct {
 	EnumVarNames        []string      // Override enum constant names
 	DeprecatedReason    string        // Deprecation reason
 	Order               *int          // Field ordering
+	Sensitive           *bool         // Redact from debug dumps
+	OptionalProperties  string        // How optional properties are held
 }
 
 // ParseExtensions extracts extension values from a schema's extensions map.
@@ -183,6 +225,25 @@ func ParseExtensions(extensions *orderedmap.Map[string, *yaml.Node], path string
 			}
 			ext.Order = &i
 
+		case ExtSensitive:
+			b, err := asBool(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.Sensitive = &b
+
+		case ExtOptionalProperties:
+			s, err := asString(val, key)
+			if err != nil {
+				return nil, err
+			}
+			switch s {
+			case OptionalPropsPointer, OptionalPropsValue, OptionalPropsOmitZero, OptionalPropsNullable, OptionalPropsOptional:
+			default:
+				return nil, fmt.Errorf("parsing %s: unknown value %q", key, s)
+			}
+			ext.OptionalProperties = s
+
 		default:
 			// Unknown extension - ignore
 		}
@@ -196,6 +257,135 @@ func ParseExtensions(extensions *orderedmap.Map[string, *yaml.Node], path string
 	return ext, nil
 }
 
+// OperationExtensions holds parsed extension values for an operation.
+type OperationExtensions struct {
+	Cacheable        *bool         // Responses may be served from the client response cache
+	LRO              *LROExtension // Long-running operation, polled until done
+	DeprecatedReason string        // Deprecation reason
+}
+
+// LROExtension describes how to poll a long-running operation.
+type LROExtension struct {
+	StatusOperation string   // operationId of the status resource's GET operation
+	StatusField     string   // JSON property of the status resource holding its state
+	TerminalStates  []string // States which end polling, compared case-insensitively
+}
+
+// Defaults of LROExtension, for the Azure and Google style of status resource.
+var (
+	defaultLROStatusField    = "status"
+	defaultLROTerminalStates = []string{"succeeded", "failed", "canceled"}
+)
+
+// ParseOperationExtensions extracts extension values from an operation's
+// extensions map. Unknown extensions are ignored.
+func ParseOperationExtensions(extensions *orderedmap.Map[string, *yaml.Node]) (*OperationExtensions, error) {
+	ext := &OperationExtensions{}
+	if extensions == nil {
+		return ext, nil
+	}
+
+	for pair := extensions.First(); pair != nil; pair = pair.Next() {
+		key := pair.Key()
+		node := pair.Value()
+		if node == nil {
+			continue
+		}
+
+		val := decodeYAMLNode(node)
+
+		switch key {
+		case ExtCacheable:
+			b, err := asBool(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.Cacheable = &b
+
+		case ExtLRO:
+			lro, err := asLRO(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.LRO = lro
+
+		case ExtDeprecatedReason, legacyExtDeprecatedReason:
+			s, err := asString(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.DeprecatedReason = s
+
+		default:
+			// Unknown extension - ignore
+		}
+	}
+
+	return ext, nil
+}
+
+// ParameterExtensions holds parsed extension values for a parameter.
+type ParameterExtensions struct {
+	IdempotencyKey *bool   // Header is auto-populated with a UUID when unset
+	Style          *string // Style used in place of the spec's
+	Explode        *bool   // Explode used in place of the spec's
+	TimeFormat     *string // Layout of a date or date-time parameter
+}
+
+// ParseParameterExtensions extracts extension values from a parameter's
+// extensions map. Unknown extensions are ignored.
+func ParseParameterExtensions(extensions *orderedmap.Map[string, *yaml.Node]) (*ParameterExtensions, error) {
+	ext := &ParameterExtensions{}
+	if extensions == nil {
+		return ext, nil
+	}
+
+	for pair := extensions.First(); pair != nil; pair = pair.Next() {
+		key := pair.Key()
+		node := pair.Value()
+		if node == nil {
+			continue
+		}
+
+		val := decodeYAMLNode(node)
+
+		switch key {
+		case ExtIdempotencyKey:
+			b, err := asBool(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.IdempotencyKey = &b
+
+		case ExtStyle:
+			str, err := asString(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.Style = &str
+
+		case ExtExplode:
+			b, err := asBool(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.Explode = &b
+
+		case ExtTimeFormat:
+			str, err := asString(val, key)
+			if err != nil {
+				return nil, err
+			}
+			ext.TimeFormat = &str
+
+		default:
+			// Unknown extension - ignore
+		}
+	}
+
+	return ext, nil
+}
+
 // hasExtension checks if an extension exists by either the new or legacy name.
 // This is used to check for extensions before fully parsing them.
 func hasExtension(extensions *orderedmap.Map[string, *yaml.Node], newName, legacyName string) bool {
@@ -313,6 +503,12 @@ func mergeExtensions(dst, src *Extensions) {
 	if src.Order != nil {
 		dst.Order = src.Order
 	}
+	if src.Sensitive != nil {
+		dst.Sensitive = src.Sensitive
+	}
+	if src.OptionalProperties != "" {
+		dst.OptionalProperties = src.OptionalProperties
+	}
 }
 
 // Type conversion helpers that include the extension name in error messages
@@ -355,6 +551,42 @@ func asInt(val any, extName string) (int, error) {
 	}
 }
 
+// asLRO parses the object of x-oapi-codegen-lro, filling in the defaults of
+// its optional properties.
+func asLRO(val any, extName string) (*LROExtension, error) {
+	obj, ok := val.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("parsing %s: expected object, got %T", extName, val)
+	}
+	lro := &LROExtension{}
+	var err error
+	for key, v := range obj {
+		switch key {
+		case "status-operation":
+			lro.StatusOperation, err = asString(v, extName+"."+key)
+		case "status-field":
+			lro.StatusField, err = asString(v, extName+"."+key)
+		case "terminal-states":
+			lro.TerminalStates, err = asStringSlice(v, extName+"."+key)
+		default:
+			err = fmt.Errorf("parsing %s: unknown property %q", extName, key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if lro.StatusOperation == "" {
+		return nil, fmt.Errorf("parsing %s: status-operation is required", extName)
+	}
+	if lro.StatusField == "" {
+		lro.StatusField = defaultLROStatusField
+	}
+	if len(lro.TerminalStates) == 0 {
+		lro.TerminalStates = defaultLROTerminalStates
+	}
+	return lro, nil
+}
+
 func asStringSlice(val any, extName string) ([]string, error) {
 	if val == nil {
 		return nil, nil
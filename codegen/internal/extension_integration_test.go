@@ -153,6 +153,77 @@ components:
 	}
 }
 
+func TestDeprecationIntegration(t *testing.T) {
+	spec := `
+openapi: "3.1.0"
+info:
+  title: Deprecation Test API
+  version: "1.0"
+paths:
+  /animals:
+    get:
+      operationId: listAnimals
+      summary: List animals
+      deprecated: true
+      x-deprecated-reason: Use ListPets instead.
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/OldPet'
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: OK
+components:
+  schemas:
+    OldPet:
+      type: object
+      description: A pet of the old API.
+      deprecated: true
+      properties:
+        name:
+          type: string
+          deprecated: true
+`
+
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	if err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	cfg := Configuration{
+		PackageName: "testpkg",
+		Generation: GenerationOptions{
+			Client: true,
+			Server: ServerTypeStdHTTP,
+		},
+	}
+	code, err := Generate(doc, []byte(spec), cfg)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"// A pet of the old API.\n//\n// Deprecated: the OpenAPI spec marks this as deprecated.\ntype OldPet struct",
+		"\t// Deprecated: the OpenAPI spec marks this as deprecated.\n\tName *string",
+		"// List animals\n//\n// Deprecated: Use ListPets instead.\nfunc (c *Client) ListAnimals(",
+		"\t//\n\t// Deprecated: Use ListPets instead.\n\tListAnimals(ctx context.Context",
+		"\t//\n\t// Deprecated: Use ListPets instead.\n\tListAnimals(w http.ResponseWriter",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected %q in generated code", want)
+		}
+	}
+	if strings.Contains(code, "// Deprecated: Use ListPets instead.\nfunc (c *Client) ListPets(") {
+		t.Error("Expected ListPets not to be deprecated")
+	}
+}
+
 func TestLegacyExtensionIntegration(t *testing.T) {
 	spec := `
 openapi: "3.1.0"
@@ -201,3 +272,203 @@ components:
 		t.Error("Expected Username from legacy x-go-name")
 	}
 }
+
+func TestParameterTypeOverrideIntegration(t *testing.T) {
+	spec := `
+openapi: "3.0.3"
+info:
+  title: Parameter Type Override Test API
+  version: "1.0"
+paths:
+  /items/{sku}:
+    get:
+      operationId: getItem
+      parameters:
+        - name: sku
+          in: path
+          required: true
+          schema:
+            type: string
+            x-go-type: money.SKU
+            x-go-type-import:
+              path: example.com/money
+      responses:
+        "204":
+          description: No content
+`
+
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	if err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	cfg := Configuration{
+		PackageName: "output",
+		Generation:  GenerationOptions{Client: true},
+	}
+
+	code, err := Generate(doc, nil, cfg)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// The path parameter is typed as the alias to the external type, which
+	// may style and bind itself, rather than as a string.
+	if !strings.Contains(code, "= money.SKU") {
+		t.Error("Expected type alias to money.SKU from x-go-type")
+	}
+	if !strings.Contains(code, "sku GetItemsSkuParameter") {
+		t.Error("Expected sku parameter typed as GetItemsSkuParameter")
+	}
+}
+
+func TestParameterStyleOverrideIntegration(t *testing.T) {
+	spec := `
+openapi: "3.0.3"
+info:
+  title: Parameter Style Override Test API
+  version: "1.0"
+paths:
+  /items:
+    get:
+      operationId: listItems
+      parameters:
+        - name: ids
+          in: query
+          x-oapi-codegen-explode: false
+          schema:
+            type: array
+            items:
+              type: integer
+        - name: tags
+          in: query
+          explode: false
+          x-oapi-codegen-style: pipeDelimited
+          schema:
+            type: array
+            items:
+              type: string
+        - name: color
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        "204":
+          description: No content
+`
+
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	if err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	cfg := Configuration{
+		PackageName: "output",
+		Generation:  GenerationOptions{Client: true, Server: ServerTypeStdHTTP},
+	}
+
+	code, err := Generate(doc, nil, cfg)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// The overridden parameters are styled and bound the way the extensions
+	// say, the others the way the spec does.
+	for _, want := range []string{
+		`StyleParameter("ids", *params.Ids, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false`,
+		`BindQueryParameter("ids", r.URL.Query(), &params.Ids, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false`,
+		`StyleParameter("tags", *params.Tags, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false`,
+		`BindQueryParameter("tags", r.URL.Query(), &params.Tags, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false`,
+		`StyleParameter("color", *params.Color, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("Expected generated code to contain %s", want)
+		}
+	}
+}
+
+func TestParameterStyleOverrideIntegration_Invalid(t *testing.T) {
+	spec := `
+openapi: "3.0.3"
+info:
+  title: Parameter Style Override Test API
+  version: "1.0"
+paths:
+  /items/{id}:
+    get:
+      operationId: getItem
+      parameters:
+        - name: id
+          in: path
+          required: true
+          x-oapi-codegen-style: form
+          schema:
+            type: string
+      responses:
+        "204":
+          description: No content
+`
+
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	if err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	cfg := Configuration{
+		PackageName: "output",
+		Generation:  GenerationOptions{Client: true},
+	}
+
+	if _, err := Generate(doc, nil, cfg); err == nil || !strings.Contains(err.Error(), ExtStyle) {
+		t.Errorf("Generate() error = %v, want an error about %s", err, ExtStyle)
+	}
+}
+
+func TestParameterTimeFormatIntegration_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not a date": `
+          x-oapi-codegen-time-format: "20060102"
+          schema:
+            type: string`,
+		"no layout components": `
+          x-oapi-codegen-time-format: "yyyyMMdd"
+          schema:
+            type: string
+            format: date`,
+	}
+	for name, param := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := `
+openapi: "3.0.3"
+info:
+  title: Parameter Time Format Test API
+  version: "1.0"
+paths:
+  /reports:
+    get:
+      operationId: getReport
+      parameters:
+        - name: day
+          in: query` + param + `
+      responses:
+        "204":
+          description: No content
+`
+			doc, err := libopenapi.NewDocument([]byte(spec))
+			if err != nil {
+				t.Fatalf("Failed to parse spec: %v", err)
+			}
+
+			cfg := Configuration{
+				PackageName: "output",
+				Generation:  GenerationOptions{Client: true},
+			}
+
+			if _, err := Generate(doc, nil, cfg); err == nil || !strings.Contains(err.Error(), ExtTimeFormat) {
+				t.Errorf("Generate() error = %v, want an error about %s", err, ExtTimeFormat)
+			}
+		})
+	}
+}
@@ -81,6 +81,13 @@ func TestParseExtensions(t *testing.T) {
 	}
 	extensions.Set(ExtOmitEmpty, omitEmptyNode)
 
+	// Add sensitive extension
+	sensitiveNode := &yaml.Node{}
+	if err := sensitiveNode.Encode(true); err != nil {
+		t.Fatalf("Failed to encode sensitiveNode: %v", err)
+	}
+	extensions.Set(ExtSensitive, sensitiveNode)
+
 	ext, err := ParseExtensions(extensions, "#/test/path")
 	if err != nil {
 		t.Fatalf("ParseExtensions() error = %v", err)
@@ -106,6 +113,29 @@ func TestParseExtensions(t *testing.T) {
 	if ext.OmitEmpty == nil || *ext.OmitEmpty != true {
 		t.Errorf("OmitEmpty = %v, want true", ext.OmitEmpty)
 	}
+
+	// Check sensitive
+	if ext.Sensitive == nil || *ext.Sensitive != true {
+		t.Errorf("Sensitive = %v, want true", ext.Sensitive)
+	}
+}
+
+func TestParseExtensionsOptionalProperties(t *testing.T) {
+	extensions := orderedmap.New[string, *yaml.Node]()
+	extensions.Set(ExtOptionalProperties, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "omitzero"})
+
+	ext, err := ParseExtensions(extensions, "#/test/path")
+	if err != nil {
+		t.Fatalf("ParseExtensions() error = %v", err)
+	}
+	if ext.OptionalProperties != OptionalPropsOmitZero {
+		t.Errorf("OptionalProperties = %q, want %q", ext.OptionalProperties, OptionalPropsOmitZero)
+	}
+
+	extensions.Set(ExtOptionalProperties, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "reference"})
+	if _, err := ParseExtensions(extensions, "#/test/path"); err == nil {
+		t.Error("ParseExtensions() accepted an unknown optional-properties value")
+	}
 }
 
 func TestParseExtensionsLegacy(t *testing.T) {
@@ -178,3 +208,122 @@ func TestParseExtensionsEnumVarNames(t *testing.T) {
 		}
 	}
 }
+
+func TestParseOperationExtensions(t *testing.T) {
+	extensions := orderedmap.New[string, *yaml.Node]()
+
+	cacheableNode := &yaml.Node{}
+	if err := cacheableNode.Encode(true); err != nil {
+		t.Fatalf("Failed to encode cacheableNode: %v", err)
+	}
+	extensions.Set(ExtCacheable, cacheableNode)
+
+	ext, err := ParseOperationExtensions(extensions)
+	if err != nil {
+		t.Fatalf("ParseOperationExtensions() error = %v", err)
+	}
+	if ext.Cacheable == nil || !*ext.Cacheable {
+		t.Errorf("Cacheable = %v, want true", ext.Cacheable)
+	}
+
+	invalidNode := &yaml.Node{}
+	invalidNode.SetString("yes please")
+	extensions.Set(ExtCacheable, invalidNode)
+
+	if _, err := ParseOperationExtensions(extensions); err == nil {
+		t.Error("ParseOperationExtensions() expected error for non-bool value")
+	}
+}
+
+func TestParseOperationExtensions_LRO(t *testing.T) {
+	extensions := orderedmap.New[string, *yaml.Node]()
+
+	lroNode := &yaml.Node{}
+	if err := lroNode.Encode(map[string]any{"status-operation": "getJob"}); err != nil {
+		t.Fatalf("Failed to encode lroNode: %v", err)
+	}
+	extensions.Set(ExtLRO, lroNode)
+
+	ext, err := ParseOperationExtensions(extensions)
+	if err != nil {
+		t.Fatalf("ParseOperationExtensions() error = %v", err)
+	}
+	if ext.LRO == nil {
+		t.Fatal("LRO = nil, want parsed extension")
+	}
+	if ext.LRO.StatusOperation != "getJob" {
+		t.Errorf("StatusOperation = %q, want %q", ext.LRO.StatusOperation, "getJob")
+	}
+	if ext.LRO.StatusField != "status" {
+		t.Errorf("StatusField = %q, want default %q", ext.LRO.StatusField, "status")
+	}
+	if len(ext.LRO.TerminalStates) != 3 {
+		t.Errorf("TerminalStates = %v, want defaults", ext.LRO.TerminalStates)
+	}
+
+	missingNode := &yaml.Node{}
+	if err := missingNode.Encode(map[string]any{"status-field": "state"}); err != nil {
+		t.Fatalf("Failed to encode missingNode: %v", err)
+	}
+	extensions.Set(ExtLRO, missingNode)
+
+	if _, err := ParseOperationExtensions(extensions); err == nil {
+		t.Error("ParseOperationExtensions() expected error without status-operation")
+	}
+}
+
+func TestParseParameterExtensions(t *testing.T) {
+	ext, err := ParseParameterExtensions(nil)
+	if err != nil {
+		t.Fatalf("ParseParameterExtensions(nil) error = %v", err)
+	}
+	if ext.IdempotencyKey != nil {
+		t.Errorf("IdempotencyKey = %v, want nil", ext.IdempotencyKey)
+	}
+
+	extensions := orderedmap.New[string, *yaml.Node]()
+
+	keyNode := &yaml.Node{}
+	if err := keyNode.Encode(true); err != nil {
+		t.Fatalf("Failed to encode keyNode: %v", err)
+	}
+	extensions.Set(ExtIdempotencyKey, keyNode)
+
+	ext, err = ParseParameterExtensions(extensions)
+	if err != nil {
+		t.Fatalf("ParseParameterExtensions() error = %v", err)
+	}
+	if ext.IdempotencyKey == nil || !*ext.IdempotencyKey {
+		t.Errorf("IdempotencyKey = %v, want true", ext.IdempotencyKey)
+	}
+}
+
+func TestParseParameterExtensions_StyleOverride(t *testing.T) {
+	extensions := orderedmap.New[string, *yaml.Node]()
+	extensions.Set(ExtStyle, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "pipeDelimited"})
+	extensions.Set(ExtExplode, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"})
+
+	ext, err := ParseParameterExtensions(extensions)
+	if err != nil {
+		t.Fatalf("ParseParameterExtensions() error = %v", err)
+	}
+	if ext.Style == nil || *ext.Style != "pipeDelimited" {
+		t.Errorf("Style = %v, want pipeDelimited", ext.Style)
+	}
+	if ext.Explode == nil || *ext.Explode {
+		t.Errorf("Explode = %v, want false", ext.Explode)
+	}
+}
+
+func TestParseParameterExtensions_TimeFormat(t *testing.T) {
+	extensions := orderedmap.New[string, *yaml.Node]()
+	extensions.Set(ExtTimeFormat, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "20060102"})
+
+	ext, err := ParseParameterExtensions(extensions)
+	if err != nil {
+		t.Fatalf("ParseParameterExtensions() error = %v", err)
+	}
+	if ext.TimeFormat == nil || *ext.TimeFormat != "20060102" {
+		t.Errorf("TimeFormat = %v, want 20060102", ext.TimeFormat)
+	}
+}
@@ -36,10 +36,23 @@ func FilterOperationsByOperationID(ops []*OperationDescriptor, opts OutputOption
 	return ops
 }
 
-// FilterOperations applies all operation filters (tags, operation IDs) from OutputOptions.
+// FilterDeprecatedOperations removes operations marked deprecated when
+// ExcludeDeprecated is set.
+func FilterDeprecatedOperations(ops []*OperationDescriptor, opts OutputOptions) []*OperationDescriptor {
+	if !opts.ExcludeDeprecated {
+		return ops
+	}
+	return filterOps(ops, func(op *OperationDescriptor) bool {
+		return !op.Deprecated
+	})
+}
+
+// FilterOperations applies all operation filters (tags, operation IDs,
+// deprecation) from OutputOptions.
 func FilterOperations(ops []*OperationDescriptor, opts OutputOptions) []*OperationDescriptor {
 	ops = FilterOperationsByTag(ops, opts)
 	ops = FilterOperationsByOperationID(ops, opts)
+	ops = FilterDeprecatedOperations(ops, opts)
 	return ops
 }
 
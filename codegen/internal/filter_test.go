@@ -55,6 +55,7 @@ paths:
                 $ref: '#/components/schemas/Settings'
     put:
       operationId: updateSettings
+      deprecated: true
       tags:
         - admin
       responses:
@@ -192,6 +193,19 @@ func TestFilterOperations_Combined(t *testing.T) {
 	assert.Len(t, filtered, 1)
 }
 
+func TestFilterDeprecatedOperations(t *testing.T) {
+	ops := gatherTestOps(t)
+
+	filtered := FilterDeprecatedOperations(ops, OutputOptions{})
+	assert.Len(t, filtered, 4)
+
+	filtered = FilterOperations(ops, OutputOptions{
+		IncludeTags:       []string{"admin"},
+		ExcludeDeprecated: true,
+	})
+	assert.Equal(t, []string{"getSettings"}, opIDs(filtered))
+}
+
 func TestFilterSchemasByName(t *testing.T) {
 	doc, err := libopenapi.NewDocument([]byte(filterTestSpec))
 	require.NoError(t, err)
@@ -395,3 +409,25 @@ func TestFilterIntegration_ServerWithIncludeTags(t *testing.T) {
 	assert.NotContains(t, code, "ListUsers(w http.ResponseWriter")
 	assert.NotContains(t, code, "GetSettings(w http.ResponseWriter")
 }
+
+func TestFilterIntegration_GenerateWithExcludeDeprecated(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(filterTestSpec))
+	require.NoError(t, err)
+
+	cfg := Configuration{
+		PackageName: "testpkg",
+		Generation: GenerationOptions{
+			Client: true,
+			Server: ServerTypeStdHTTP,
+		},
+		OutputOptions: OutputOptions{
+			ExcludeDeprecated: true,
+		},
+	}
+
+	code, err := Generate(doc, []byte(filterTestSpec), cfg)
+	require.NoError(t, err)
+	assert.Contains(t, code, "GetSettings(ctx context.Context")
+	assert.Contains(t, code, "GetSettings(w http.ResponseWriter")
+	assert.NotContains(t, code, "UpdateSettings")
+}
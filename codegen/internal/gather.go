@@ -2,7 +2,6 @@ package codegen
 
 import (
 	"fmt"
-	"log/slog"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -27,11 +26,18 @@ type GatherOptions struct {
 // GatherSchemasWithOptions is the same as GatherSchemas but accepts
 // non-output-filter toggles such as SkipEnumViaOneOf.
 func GatherSchemasWithOptions(doc *v3.Document, contentTypeMatcher *ContentTypeMatcher, outputOpts OutputOptions, gatherOpts GatherOptions) ([]*SchemaDescriptor, error) {
+	return gatherSchemas(doc, nil, contentTypeMatcher, outputOpts, gatherOpts)
+}
+
+// gatherSchemas is GatherSchemasWithOptions, reporting warnings to ctx, which
+// logs them when nil.
+func gatherSchemas(doc *v3.Document, ctx *CodegenContext, contentTypeMatcher *ContentTypeMatcher, outputOpts OutputOptions, gatherOpts GatherOptions) ([]*SchemaDescriptor, error) {
 	if doc == nil {
 		return nil, fmt.Errorf("nil v3 document")
 	}
 
 	g := &gatherer{
+		ctx:                ctx,
 		schemas:            make([]*SchemaDescriptor, 0),
 		contentTypeMatcher: contentTypeMatcher,
 		outputOpts:         outputOpts,
@@ -43,10 +49,12 @@ func GatherSchemasWithOptions(doc *v3.Document, contentTypeMatcher *ContentTypeM
 }
 
 type gatherer struct {
+	ctx                *CodegenContext // Where warnings are reported
 	schemas            []*SchemaDescriptor
 	contentTypeMatcher *ContentTypeMatcher
 	outputOpts         OutputOptions
 	gatherOpts         GatherOptions
+	dynamicRefs        *dynamicRefResolver // Resolves $dynamicRefs, nil without components
 	// Context for the current operation being gathered (for nicer naming)
 	currentOperationID string
 	currentContentType string
@@ -55,6 +63,7 @@ type gatherer struct {
 func (g *gatherer) gatherFromDocument(doc *v3.Document) {
 	// Gather from components/schemas
 	if doc.Components != nil && doc.Components.Schemas != nil {
+		g.dynamicRefs = newDynamicRefResolver(doc.Components.Schemas)
 		for pair := doc.Components.Schemas.First(); pair != nil; pair = pair.Next() {
 			name := pair.Key()
 			schemaProxy := pair.Value()
@@ -117,12 +126,16 @@ func (g *gatherer) gatherFromPathItem(pathItem *v3.PathItem, basePath SchemaPath
 }
 
 // shouldSkipOperation returns true if the operation should be excluded based on
-// the configured tag and operation ID filters.
+// the configured tag, operation ID and deprecation filters.
 func (g *gatherer) shouldSkipOperation(op *v3.Operation) bool {
 	if op == nil {
 		return true
 	}
 
+	if g.outputOpts.ExcludeDeprecated && op.Deprecated != nil && *op.Deprecated {
+		return true
+	}
+
 	// Apply exclude tags first
 	if len(g.outputOpts.ExcludeTags) > 0 {
 		for _, tag := range op.Tags {
@@ -325,11 +338,18 @@ func (g *gatherer) gatherFromSchemaProxy(proxy *base.SchemaProxy, path SchemaPat
 		return nil
 	}
 
+	// Schemas binding $dynamicAnchors get the types they bind
+	if resolved, err := g.dynamicRefs.resolve(proxy, path); err != nil {
+		g.ctx.Warn(path, "ignoring $dynamicRef: %v", err)
+	} else {
+		proxy = resolved
+	}
+
 	// Check if this is a reference
 	isRef := proxy.IsReference()
 	ref := ""
 	if isRef {
-		ref = proxy.GetReference()
+		ref = proxyRef(proxy)
 	}
 
 	// Get the resolved schema
@@ -376,9 +396,7 @@ func (g *gatherer) gatherFromSchemaProxy(proxy *base.SchemaProxy, path SchemaPat
 		if !isRef && schema != nil && schema.Extensions != nil {
 			ext, err := ParseExtensions(schema.Extensions, path.String())
 			if err != nil {
-				slog.Warn("failed to parse extensions",
-					"path", path.String(),
-					"error", err)
+				g.ctx.Warn(path, "ignoring extensions: %v", err)
 			} else {
 				desc.Extensions = ext
 			}
@@ -427,9 +445,7 @@ func (g *gatherer) gatherSchemaDescriptorOnly(proxy *base.SchemaProxy, path Sche
 	if schema.Extensions != nil {
 		ext, err := ParseExtensions(schema.Extensions, path.String())
 		if err != nil {
-			slog.Warn("failed to parse extensions",
-				"path", path.String(),
-				"error", err)
+			g.ctx.Warn(path, "ignoring extensions: %v", err)
 		} else {
 			desc.Extensions = ext
 		}
@@ -456,10 +472,14 @@ func needsGeneratedType(schema *base.Schema) bool {
 		return true
 	}
 
-	// Enums always need a generated type
+	// Enums, and const values held as single-constant enums, always need a
+	// generated type
 	if len(schema.Enum) > 0 {
 		return true
 	}
+	if _, _, ok := constValue(schema); ok {
+		return true
+	}
 
 	// Objects need a generated type
 	if schema.Properties != nil && schema.Properties.Len() > 0 {
@@ -479,6 +499,11 @@ func needsGeneratedType(schema *base.Schema) bool {
 		return true
 	}
 
+	// Tuples need a generated struct
+	if len(schema.PrefixItems) > 0 {
+		return true
+	}
+
 	// Arrays with complex items need generated types for the array type itself
 	// But we handle items separately in gatherFromSchema
 	if schema.Items != nil && schema.Items.A != nil {
@@ -700,7 +725,10 @@ func (g *gatherer) gatherFromSchema(schema *base.Schema, basePath SchemaPath, pa
 
 	// PrefixItems (3.1 tuple validation)
 	for i, proxy := range schema.PrefixItems {
-		g.gatherFromSchemaProxy(proxy, basePath.Append("prefixItems", fmt.Sprintf("%d", i)), parent)
+		itemDesc := g.gatherFromSchemaProxy(proxy, basePath.Append("prefixItems", fmt.Sprintf("%d", i)), parent)
+		if parent != nil {
+			parent.PrefixItems = append(parent.PrefixItems, itemDesc)
+		}
 	}
 
 	// Contains (3.1)
@@ -2,11 +2,20 @@ package codegen
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"mime"
+	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
 )
 
 // GatherOperations traverses an OpenAPI document and collects all operations.
@@ -53,9 +62,9 @@ func (g *operationGatherer) gatherFromDocument(doc *v3.Document) ([]*OperationDe
 		}
 
 		// Gather path-level parameters (shared by all operations on this path)
-		globalParams, err := g.gatherParameters(pathItem.Parameters)
+		globalParams, err := g.gatherParameters(SchemaPath{"paths", pathStr, "parameters"}, pathItem.Parameters)
 		if err != nil {
-			return nil, fmt.Errorf("error gathering path-level parameters for %s: %w", pathStr, err)
+			return nil, errorAt(SchemaPath{"paths", pathStr}, fmt.Errorf("error gathering path-level parameters for %s: %w", pathStr, err))
 		}
 
 		// Process each operation on this path
@@ -77,18 +86,201 @@ func (g *operationGatherer) gatherFromDocument(doc *v3.Document) ([]*OperationDe
 				continue
 			}
 
-			opDesc, err := g.gatherOperation(method, pathStr, op, globalParams)
+			specPath := SchemaPath{"paths", pathStr, method}
+			opDesc, err := g.gatherOperation(specPath, method, pathStr, op, globalParams)
 			if err != nil {
-				return nil, fmt.Errorf("error gathering operation %s %s: %w", method, pathStr, err)
+				return nil, errorAt(specPath, fmt.Errorf("error gathering operation %s %s: %w", method, pathStr, err))
 			}
+			opDesc.Server = operationServer(op.Servers, pathItem.Servers)
+			opDesc.SpecPath = specPath
 			operations = append(operations, opDesc)
 		}
 	}
 
+	disambiguateOperations(g.ctx, operations)
+
+	if err := resolveLROs(operations); err != nil {
+		return nil, err
+	}
+
 	return operations, nil
 }
 
-func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operation, globalParams []*ParameterDescriptor) (*OperationDescriptor, error) {
+// operationServer returns the URL of the first of an operation's servers, or
+// else of its path's, with its variables substituted by their defaults. The
+// URL ends with a slash, so that operation paths resolve below it.
+func operationServer(opServers, pathServers []*v3.Server) string {
+	servers := opServers
+	if len(servers) == 0 {
+		servers = pathServers
+	}
+	if len(servers) == 0 || servers[0] == nil {
+		return ""
+	}
+	server := servers[0].URL
+	if servers[0].Variables != nil {
+		for pair := servers[0].Variables.First(); pair != nil; pair = pair.Next() {
+			if v := pair.Value(); v != nil {
+				server = strings.ReplaceAll(server, "{"+pair.Key()+"}", v.Default)
+			}
+		}
+	}
+	if !strings.HasSuffix(server, "/") {
+		server += "/"
+	}
+	return server
+}
+
+// resolveInlineParamTypes types the parameters with inline object schemas,
+// such as deepObject filters, inline x-go-type overrides and JSON-encoded
+// parameters as the types generated for their schemas, found by path in
+// schemaIndex, rather than as generic maps or primitives.
+func resolveInlineParamTypes(ops []*OperationDescriptor, schemaIndex map[string]*SchemaDescriptor) {
+	for _, op := range ops {
+		for _, params := range [][]*ParameterDescriptor{op.PathParams, op.QueryParams, op.HeaderParams, op.CookieParams} {
+			for _, p := range params {
+				if p.Schema == nil || p.Schema.Path == nil || p.Schema.Ref != "" {
+					continue
+				}
+				// Styled arrays of inline objects, such as header lists, take
+				// the type generated for their items.
+				if items := p.Schema.Schema.Items; p.IsStyled && items != nil && items.A != nil &&
+					!items.A.IsReference() && items.A.Schema() != nil && slices.Contains(items.A.Schema().Type, "object") {
+					if target, ok := schemaIndex[p.Schema.Path.Append("items").String()]; ok && target.ShortName != "" {
+						p.TypeDecl = strings.Replace(p.TypeDecl, "[]map[string]any", "[]"+target.ShortName, 1)
+					}
+					continue
+				}
+				// JSON values take any type generated for their schema, styled
+				// ones only those of objects and the external types set with
+				// x-go-type, which may style and bind themselves.
+				if !p.IsJSON && !slices.Contains(p.Schema.Schema.Type, "object") &&
+					!hasExtension(p.Schema.Schema.Extensions, ExtTypeOverride, legacyExtGoType) {
+					continue
+				}
+				if target, ok := schemaIndex[p.Schema.Path.String()]; ok && target.ShortName != "" {
+					p.TypeDecl = target.ShortName
+				}
+			}
+		}
+	}
+}
+
+// resolveObjectParamFields lists the properties of the object query
+// parameters exploded in the form style, typed as the structs generated for
+// their schemas, which clients then style and servers bind field by field.
+// Objects with properties other than primitives and arrays of them, with
+// additional properties, or with properties whose Go names collide keep
+// being bound by reflection.
+func resolveObjectParamFields(ops []*OperationDescriptor, schemaIndex map[string]*SchemaDescriptor, gen *TypeGenerator, typeMapping TypeMapping) {
+	g := &operationGatherer{ctx: gen.ctx, typeMapping: typeMapping}
+	for _, op := range ops {
+		for _, p := range op.QueryParams {
+			if !p.IsStyled || p.Style != "form" || !p.Explode || p.AllowEmptyValue || p.Schema == nil || p.Schema.Path == nil {
+				continue
+			}
+			key := p.Schema.Path.String()
+			if p.Schema.Ref != "" {
+				key = p.Schema.Ref
+			}
+			target, ok := schemaIndex[key]
+			if !ok || target.ShortName == "" || target.ShortName != p.TypeDecl {
+				continue
+			}
+			p.Fields = g.objectParamFields(gen, target)
+		}
+	}
+}
+
+// resolveOptionalParams sets how the Params structs of ops hold their
+// optional parameters, the optional-parameters output option.
+func resolveOptionalParams(ops []*OperationDescriptor, optionalAs string, ctx *CodegenContext) {
+	for _, op := range ops {
+		for _, p := range op.Params() {
+			p.OptionalAs = optionalAs
+			p.nullableType = ctx.RuntimeTypesPrefix() + "Nullable"
+		}
+	}
+}
+
+// objectParamFields returns the fields of the struct generated for desc,
+// styled as exploded query keys, or nil if any of them can't be.
+func (g *operationGatherer) objectParamFields(gen *TypeGenerator, desc *SchemaDescriptor) []*ParamField {
+	schema := desc.Schema
+	if schema == nil || schema.Properties == nil || schema.Properties.Len() == 0 ||
+		schema.AdditionalProperties != nil || len(schema.AllOf) > 0 || len(schema.AnyOf) > 0 || len(schema.OneOf) > 0 ||
+		hasExtension(schema.Extensions, ExtTypeOverride, legacyExtGoType) {
+		return nil
+	}
+	// Colliding names are told apart, with a warning, when the struct is
+	// generated; generating its fields again here would repeat it.
+	var names []string
+	for pair := schema.Properties.First(); pair != nil; pair = pair.Next() {
+		names = append(names, gen.converter.ToPropertyName(pair.Key()))
+	}
+	if !slices.Equal(deduplicateNames(names), names) {
+		return nil
+	}
+
+	var fields []*ParamField
+	for _, f := range gen.GenerateStructFields(desc) {
+		if f.JSONIgnore {
+			continue
+		}
+		prop := schema.Properties.GetOrZero(f.JSONName).Schema()
+		if prop == nil {
+			return nil
+		}
+		field := &ParamField{Name: f.JSONName, GoName: f.Name, Required: f.Required, Pointer: f.Pointer}
+		if elem, ok := strings.CutPrefix(f.Type, "[]"); ok {
+			items := prop.Items
+			if items == nil || items.A == nil || items.A.Schema() == nil || strings.HasPrefix(elem, "[]") ||
+				slices.Contains(items.A.Schema().Type, "object") {
+				return nil
+			}
+		} else {
+			field.Parser, field.Formatter = g.primitiveAdapters(strings.TrimPrefix(f.Type, "*"), prop)
+			if field.Parser == "" || f.Nullable || f.Optional {
+				return nil
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// resolveLROs links the operations marked with x-oapi-codegen-lro to the
+// operations of their status resources.
+func resolveLROs(operations []*OperationDescriptor) error {
+	byID := make(map[string]*OperationDescriptor, len(operations))
+	for _, op := range operations {
+		byID[op.OperationID] = op
+	}
+	for _, op := range operations {
+		if op.Extensions == nil || op.Extensions.LRO == nil {
+			continue
+		}
+		ext := op.Extensions.LRO
+		if !slices.ContainsFunc(op.Responses, func(r *ResponseDescriptor) bool { return r.StatusCode == "202" }) {
+			return fmt.Errorf("operation %s: %s requires a 202 response", op.OperationID, ExtLRO)
+		}
+		status, ok := byID[ext.StatusOperation]
+		if !ok {
+			return fmt.Errorf("operation %s: %s status-operation %q not found", op.OperationID, ExtLRO, ext.StatusOperation)
+		}
+		if status.Method != "GET" || !isSimpleOperation(status) {
+			return fmt.Errorf("operation %s: %s status-operation %q must be a GET operation with a single JSON success response", op.OperationID, ExtLRO, ext.StatusOperation)
+		}
+		op.LRO = &LRODescriptor{
+			StatusOperation: status,
+			StatusField:     ext.StatusField,
+			TerminalStates:  ext.TerminalStates,
+		}
+	}
+	return nil
+}
+
+func (g *operationGatherer) gatherOperation(specPath SchemaPath, method, path string, op *v3.Operation, globalParams []*ParameterDescriptor) (*OperationDescriptor, error) {
 	// Determine operation ID
 	operationID := op.OperationId
 	if operationID == "" {
@@ -97,13 +289,14 @@ func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operatio
 	goOperationID := ToGoIdentifier(operationID)
 
 	// Gather operation-level parameters
-	localParams, err := g.gatherParameters(op.Parameters)
+	localParams, err := g.gatherParameters(specPath.Append("parameters"), op.Parameters)
 	if err != nil {
 		return nil, fmt.Errorf("error gathering parameters: %w", err)
 	}
 
 	// Combine global and local parameters (local overrides global)
 	allParams := combineParameters(globalParams, localParams)
+	allParams = disambiguateParams(g.ctx, specPath, allParams)
 
 	// Sort path params to match order in path
 	pathParams := filterParamsByLocation(allParams, "path")
@@ -127,9 +320,21 @@ func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operatio
 	// Gather security requirements
 	security := g.gatherSecurity(op.Security)
 
+	// Parse operation-level extensions
+	extensions, err := ParseOperationExtensions(op.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing extensions: %w", err)
+	}
+
+	cacheable := extensions.Cacheable != nil && *extensions.Cacheable
+	if cacheable && !strings.EqualFold(method, "get") {
+		return nil, fmt.Errorf("%s is only supported on GET operations", ExtCacheable)
+	}
+
 	queryParams := filterParamsByLocation(allParams, "query")
 	headerParams := filterParamsByLocation(allParams, "header")
 	cookieParams := filterParamsByLocation(allParams, "cookie")
+	declareQueryKeys(queryParams)
 
 	hasParams := len(queryParams)+len(headerParams)+len(cookieParams) > 0
 
@@ -140,6 +345,7 @@ func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operatio
 		Path:          path,
 		Summary:       op.Summary,
 		Description:   op.Description,
+		Deprecated:    op.Deprecated != nil && *op.Deprecated,
 
 		PathParams:   pathParams,
 		QueryParams:  queryParams,
@@ -150,9 +356,12 @@ func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operatio
 		Responses: responses,
 		Security:  security,
 
+		Extensions: extensions,
+
 		HasBody:        len(bodies) > 0,
 		HasParams:      hasParams,
 		ParamsTypeName: goOperationID + "Params",
+		Cacheable:      cacheable,
 
 		Spec: op,
 	}
@@ -160,15 +369,44 @@ func (g *operationGatherer) gatherOperation(method, path string, op *v3.Operatio
 	return desc, nil
 }
 
-func (g *operationGatherer) gatherParameters(params []*v3.Parameter) ([]*ParameterDescriptor, error) {
+// declareQueryKeys sets the DeclaredKeys of the exploded map parameters in
+// params: the names of the others, and the properties of those which are
+// objects exploded in the form style, whose keys they are.
+func declareQueryKeys(params []*ParameterDescriptor) {
+	for _, p := range params {
+		if p.MapParser == "" || !p.Explode {
+			continue
+		}
+		var keys []string
+		for _, other := range params {
+			if other == p {
+				continue
+			}
+			keys = append(keys, other.Name)
+			if !other.IsStyled || other.Style != "form" || !other.Explode || other.Schema == nil {
+				continue
+			}
+			if props := other.Schema.Schema.Properties; props != nil {
+				for pair := props.First(); pair != nil; pair = pair.Next() {
+					keys = append(keys, pair.Key())
+				}
+			}
+		}
+		slices.Sort(keys)
+		p.DeclaredKeys = slices.Compact(keys)
+	}
+}
+
+// gatherParameters gathers params, found at basePath in the spec.
+func (g *operationGatherer) gatherParameters(basePath SchemaPath, params []*v3.Parameter) ([]*ParameterDescriptor, error) {
 	var result []*ParameterDescriptor
 
-	for _, param := range params {
+	for i, param := range params {
 		if param == nil {
 			continue
 		}
 
-		desc, err := g.gatherParameter(param)
+		desc, err := g.gatherParameter(basePath.Append(fmt.Sprintf("%d", i)), param)
 		if err != nil {
 			return nil, fmt.Errorf("error gathering parameter %s: %w", param.Name, err)
 		}
@@ -178,7 +416,7 @@ func (g *operationGatherer) gatherParameters(params []*v3.Parameter) ([]*Paramet
 	return result, nil
 }
 
-func (g *operationGatherer) gatherParameter(param *v3.Parameter) (*ParameterDescriptor, error) {
+func (g *operationGatherer) gatherParameter(specPath SchemaPath, param *v3.Parameter) (*ParameterDescriptor, error) {
 	// Determine style and explode (with defaults based on location)
 	style := param.Style
 	if style == "" {
@@ -190,18 +428,46 @@ func (g *operationGatherer) gatherParameter(param *v3.Parameter) (*ParameterDesc
 		explode = *param.Explode
 	}
 
+	extensions, err := ParseParameterExtensions(param.Extensions)
+	if err != nil {
+		return nil, err
+	}
+
 	// Determine encoding mode
 	isStyled := param.Schema != nil
+
+	// The serialization can be overridden for servers deviating from the
+	// spec, on the client and the server alike.
+	if extensions.Style != nil || extensions.Explode != nil {
+		if !isStyled {
+			return nil, fmt.Errorf("%s and %s are only supported on parameters with a schema", ExtStyle, ExtExplode)
+		}
+		if extensions.Style != nil {
+			style = *extensions.Style
+		}
+		if extensions.Explode != nil {
+			explode = *extensions.Explode
+		}
+		if err := ValidateParamStyle(style, param.In); err != nil {
+			return nil, fmt.Errorf("%s: %w", ExtStyle, err)
+		}
+	}
 	isJSON := false
 	isPassThrough := false
 
+	schemaProxy, schemaPath := param.Schema, specPath.Append("schema")
 	if param.Content != nil && param.Content.Len() > 0 {
-		// Parameter uses content encoding
+		// Parameter uses content encoding. JSON values are typed after the
+		// schema of their media type, others are passed through as strings.
 		isStyled = false
+		schemaProxy = nil
 		for pair := param.Content.First(); pair != nil; pair = pair.Next() {
 			contentType := pair.Key()
 			if IsMediaTypeJSON(contentType) {
 				isJSON = true
+				if pair.Value() != nil {
+					schemaProxy, schemaPath = pair.Value().Schema, specPath.Append("content", contentType, "schema")
+				}
 				break
 			}
 		}
@@ -213,34 +479,78 @@ func (g *operationGatherer) gatherParameter(param *v3.Parameter) (*ParameterDesc
 	// Get type declaration from schema
 	typeDecl := "string" // Default
 	var schemaDesc *SchemaDescriptor
-	if param.Schema != nil {
-		schema := param.Schema.Schema()
-		if schema != nil {
-			schemaDesc = &SchemaDescriptor{
-				Schema: schema,
-			}
-			// If the schema is a $ref to a named type (e.g. "#/components/schemas/Object"),
-			// use the referenced type name directly instead of resolving to a generic type.
-			if ref := param.Schema.GetReference(); ref != "" {
-				if idx := strings.LastIndex(ref, "/"); idx >= 0 {
-					typeDecl = ToCamelCase(ref[idx+1:])
-				} else {
-					typeDecl = g.resolveType(schema)
-				}
-			} else {
-				typeDecl = g.resolveType(schema)
-			}
-		}
+	if schemaDesc = schemaProxyToDescriptor(schemaProxy); schemaDesc != nil {
+		schemaDesc.Path = schemaPath
+		// If the schema is a $ref to a named type (e.g. "#/components/schemas/Object"),
+		// use the referenced type name directly instead of resolving to a generic type.
+		typeDecl = g.proxyType(schemaProxy)
 	}
 
 	goName := ToCamelCase(param.Name)
 
+	// Idempotency keys are detected by header name, or flagged explicitly.
+	// Any string schema qualifies, whatever its format (e.g. uuid).
+	isStringHeader := param.In == "header" && schemaDesc != nil && slices.Contains(schemaDesc.Schema.Type, "string")
+	isIdempotencyKey := isStringHeader && strings.EqualFold(param.Name, "Idempotency-Key")
+	if extensions.IdempotencyKey != nil {
+		if *extensions.IdempotencyKey && !isStringHeader {
+			return nil, fmt.Errorf("%s is only supported on string header parameters", ExtIdempotencyKey)
+		}
+		isIdempotencyKey = *extensions.IdempotencyKey
+	}
+
 	// Handle *bool for Required
 	required := false
 	if param.Required != nil {
 		required = *param.Required
 	}
 
+	// Optional query parameters allowing empty values tell them apart from
+	// absent ones with a Nullable. Objects are styled as several keys, which
+	// can't be empty.
+	allowEmptyValue := param.AllowEmptyValue && param.In == "query" && !required && isStyled &&
+		style != "deepObject" && schemaDesc != nil && schemaDesc.Schema != nil &&
+		!slices.Contains(schemaDesc.Schema.Type, "object")
+
+	// Primitive parameters are styled and bound by the generic functions of
+	// the runtime, instantiated for their type, rather than by reflection.
+	// Optional ones take the default of their schema when they're absent.
+	// The constraints of their schemas are checked once they're bound.
+	var parser, formatter, defaultValue, constraints string
+	if isStyled && schemaDesc != nil {
+		parser, formatter = g.primitiveAdapters(typeDecl, schemaDesc.Schema)
+		if parser != "" && !required && schemaDesc.Schema.Default != nil {
+			defaultValue = paramDefault(schemaDesc.Schema.Default.Value, typeDecl)
+		}
+		if parser != "" {
+			constraints = g.paramConstraints(schemaPath, typeDecl, schemaDesc.Schema)
+		}
+	}
+	// Dates and date-times with a layout of their own are styled and bound in
+	// it, whatever type they're mapped to.
+	if extensions.TimeFormat != nil {
+		if !isStyled || allowEmptyValue {
+			return nil, fmt.Errorf("%s is only supported on parameters with a schema, without allowEmptyValue", ExtTimeFormat)
+		}
+		parser, formatter, err = g.timeLayoutAdapters(*extensions.TimeFormat, typeDecl, schemaDesc.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ExtTimeFormat, err)
+		}
+		defaultValue, constraints = "", ""
+	}
+	if allowEmptyValue {
+		typeDecl = g.ctx.RuntimeTypesPrefix() + "Nullable[" + typeDecl + "]"
+		parser, formatter, constraints = "", "", ""
+	}
+
+	// Free-form objects of primitive values, such as label selectors, are
+	// styled and bound as maps, their entries as the keys of the parameter.
+	var mapParser, mapFormatter string
+	if isStyled && param.In == "query" && schemaDesc != nil &&
+		(style == "form" || style == "spaceDelimited" || style == "pipeDelimited") {
+		mapParser, mapFormatter = g.mapAdapters(schemaDesc.Schema)
+	}
+
 	desc := &ParameterDescriptor{
 		Name:     param.Name,
 		GoName:   goName,
@@ -257,6 +567,20 @@ func (g *operationGatherer) gatherParameter(param *v3.Parameter) (*ParameterDesc
 		IsPassThrough: isPassThrough,
 		IsJSON:        isJSON,
 
+		AllowEmptyValue: allowEmptyValue,
+
+		Parser:    parser,
+		Formatter: formatter,
+		Default:   defaultValue,
+
+		Constraints: constraints,
+
+		MapParser:    mapParser,
+		MapFormatter: mapFormatter,
+
+		IsIdempotencyKey: isIdempotencyKey,
+		Extensions:       extensions,
+
 		Spec: param,
 	}
 
@@ -304,6 +628,21 @@ func (g *operationGatherer) gatherRequestBodies(operationID string, bodyRef *v3.
 			schemaDesc = schemaProxyToDescriptor(mediaType.Schema)
 		}
 
+		generateTyped := false
+		if g.contentTypeMatcher != nil {
+			generateTyped = g.contentTypeMatcher.Matches(contentType)
+		}
+		// Binary bodies are sent from an io.Reader as they are.
+		isBinary := !generateTyped && isBinaryRequestBody(contentType, mediaType.Schema)
+		if isBinary {
+			generateTyped = true
+			// Binary bodies of several media types, such as image/png and
+			// image/jpeg, are told apart by their media type.
+			if nameTag == "" && len(contentTypes) > 1 {
+				nameTag = MediaTypeToCamelCase(contentType)
+			}
+		}
+
 		funcSuffix := ""
 		if !isDefault && nameTag != "" {
 			funcSuffix = "With" + nameTag + "Body"
@@ -320,11 +659,6 @@ func (g *operationGatherer) gatherRequestBodies(operationID string, bodyRef *v3.
 			bodyRequired = *bodyRef.Required
 		}
 
-		generateTyped := false
-		if g.contentTypeMatcher != nil {
-			generateTyped = g.contentTypeMatcher.Matches(contentType)
-		}
-
 		desc := &RequestBodyDescriptor{
 			ContentType: contentType,
 			Required:    bodyRequired,
@@ -335,8 +669,13 @@ func (g *operationGatherer) gatherRequestBodies(operationID string, bodyRef *v3.
 			FuncSuffix:    funcSuffix,
 			IsDefault:     isDefault,
 			IsFormEncoded: contentType == "application/x-www-form-urlencoded",
+			IsMultipart:   strings.HasPrefix(contentType, "multipart/"),
+			IsBinary:      isBinary,
 			GenerateTyped: generateTyped,
 		}
+		if mediaType.ItemSchema != nil {
+			desc.ItemType = g.proxyType(mediaType.ItemSchema)
+		}
 
 		// Gather encoding options for form data
 		if mediaType.Encoding != nil && mediaType.Encoding.Len() > 0 {
@@ -345,6 +684,7 @@ func (g *operationGatherer) gatherRequestBodies(operationID string, bodyRef *v3.
 				enc := pair.Value()
 				desc.Encoding[pair.Key()] = RequestBodyEncoding{
 					ContentType: enc.ContentType,
+					Headers:     encodingHeaderDefaults(enc.Headers),
 					Style:       enc.Style,
 					Explode:     enc.Explode,
 				}
@@ -357,6 +697,50 @@ func (g *operationGatherer) gatherRequestBodies(operationID string, bodyRef *v3.
 	return bodies, nil
 }
 
+// isBinaryRequestBody returns true if a request body of contentType holds
+// opaque bytes: it's application/octet-stream, or its schema is a string of
+// format binary, and its content type names a single media type, which
+// requests can be sent with.
+func isBinaryRequestBody(contentType string, schema *base.SchemaProxy) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || strings.Contains(mediaType, "*") || IsMediaTypeJSON(mediaType) ||
+		strings.HasPrefix(mediaType, "multipart/") || mediaType == "application/x-www-form-urlencoded" {
+		return false
+	}
+	if mediaType == "application/octet-stream" {
+		return true
+	}
+	if schema == nil || schema.Schema() == nil {
+		return false
+	}
+	s := schema.Schema()
+	return slices.Contains(s.Type, "string") && s.Format == "binary"
+}
+
+// encodingHeaderDefaults returns the headers of a multipart encoding whose
+// schema declares a default value, which is sent with every part.
+func encodingHeaderDefaults(headers *orderedmap.Map[string, *v3.Header]) map[string]string {
+	var result map[string]string
+	if headers == nil {
+		return nil
+	}
+	for pair := headers.First(); pair != nil; pair = pair.Next() {
+		header := pair.Value()
+		if header == nil || header.Schema == nil {
+			continue
+		}
+		schema := header.Schema.Schema()
+		if schema == nil || schema.Default == nil || schema.Default.Kind != yaml.ScalarNode {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]string)
+		}
+		result[pair.Key()] = schema.Default.Value
+	}
+	return result
+}
+
 func (g *operationGatherer) gatherResponses(operationID string, responses *v3.Responses) ([]*ResponseDescriptor, error) {
 	if responses == nil {
 		return nil, nil
@@ -428,12 +812,16 @@ func (g *operationGatherer) gatherResponse(operationID, statusCode string, resp
 
 			nameTag := ComputeBodyNameTag(contentType)
 
-			contents = append(contents, &ResponseContentDescriptor{
+			content := &ResponseContentDescriptor{
 				ContentType: contentType,
 				Schema:      schemaDesc,
 				NameTag:     nameTag,
 				IsJSON:      IsMediaTypeJSON(contentType),
-			})
+			}
+			if mediaType.ItemSchema != nil {
+				content.ItemType = g.proxyType(mediaType.ItemSchema)
+			}
+			contents = append(contents, content)
 		}
 	}
 
@@ -537,6 +925,44 @@ func combineParameters(global, local []*ParameterDescriptor) []*ParameterDescrip
 	return result
 }
 
+// disambiguateParams numbers apart the parameters of an operation whose Go
+// names collide, such as "id" and "Id", reporting renamings at where, the
+// operation in the spec. Renamed parameters are copied, as path-level ones are
+// shared by the operations of the path.
+func disambiguateParams(ctx *CodegenContext, where SchemaPath, params []*ParameterDescriptor) []*ParameterDescriptor {
+	names := make([]string, len(params))
+	originals := make([]string, len(params))
+	for i, p := range params {
+		names[i], originals[i] = p.GoName, p.Name
+	}
+	result := make([]*ParameterDescriptor, len(params))
+	for i, name := range disambiguateNames(ctx, "parameter", func(int) SchemaPath { return where }, originals, names) {
+		result[i] = params[i]
+		if name != params[i].GoName {
+			renamed := *params[i]
+			renamed.GoName = name
+			result[i] = &renamed
+		}
+	}
+	return result
+}
+
+// disambiguateOperations numbers apart the operations whose Go names collide,
+// such as "getUser" and "GetUser".
+func disambiguateOperations(ctx *CodegenContext, ops []*OperationDescriptor) {
+	names := make([]string, len(ops))
+	originals := make([]string, len(ops))
+	for i, op := range ops {
+		names[i], originals[i] = op.GoOperationID, op.OperationID
+	}
+	for i, name := range disambiguateNames(ctx, "operation", func(i int) SchemaPath { return ops[i].SpecPath }, originals, names) {
+		if name != ops[i].GoOperationID {
+			ops[i].GoOperationID = name
+			ops[i].ParamsTypeName = name + "Params"
+		}
+	}
+}
+
 func filterParamsByLocation(params []*ParameterDescriptor, location string) []*ParameterDescriptor {
 	var result []*ParameterDescriptor
 	for _, p := range params {
@@ -634,7 +1060,7 @@ func (g *operationGatherer) gatherWebhooks(doc *v3.Document) ([]*OperationDescri
 		}
 
 		// Gather path-level parameters
-		globalParams, err := g.gatherParameters(pathItem.Parameters)
+		globalParams, err := g.gatherParameters(SchemaPath{"webhooks", webhookName, "parameters"}, pathItem.Parameters)
 		if err != nil {
 			return nil, fmt.Errorf("error gathering parameters for webhook %s: %w", webhookName, err)
 		}
@@ -657,9 +1083,10 @@ func (g *operationGatherer) gatherWebhooks(doc *v3.Document) ([]*OperationDescri
 			}
 
 			// For webhooks, Path is empty (no URL path in the spec)
-			opDesc, err := g.gatherOperation(method, "", op, globalParams)
+			specPath := SchemaPath{"webhooks", webhookName, method}
+			opDesc, err := g.gatherOperation(specPath, method, "", op, globalParams)
 			if err != nil {
-				return nil, fmt.Errorf("error gathering webhook operation %s %s: %w", method, webhookName, err)
+				return nil, errorAt(specPath, fmt.Errorf("error gathering webhook operation %s %s: %w", method, webhookName, err))
 			}
 
 			// Override operation ID if not set - use webhook name + method
@@ -671,11 +1098,13 @@ func (g *operationGatherer) gatherWebhooks(doc *v3.Document) ([]*OperationDescri
 
 			opDesc.Source = OperationSourceWebhook
 			opDesc.WebhookName = webhookName
+			opDesc.SpecPath = specPath
 
 			operations = append(operations, opDesc)
 		}
 	}
 
+	disambiguateOperations(g.ctx, operations)
 	return operations, nil
 }
 
@@ -766,9 +1195,10 @@ func (g *operationGatherer) gatherCallbacks(doc *v3.Document) ([]*OperationDescr
 
 						// URL expression is stored as path but params are not extracted
 						// (expressions are runtime-evaluated)
-						opDesc, err := g.gatherOperation(cbMethod, expression, cbOp, nil)
+						specPath := SchemaPath{"paths", pathStr, method, "callbacks", callbackName, expression, cbMethod}
+						opDesc, err := g.gatherOperation(specPath, cbMethod, expression, cbOp, nil)
 						if err != nil {
-							return nil, fmt.Errorf("error gathering callback operation %s %s %s: %w", cbMethod, callbackName, expression, err)
+							return nil, errorAt(specPath, fmt.Errorf("error gathering callback operation %s %s %s: %w", cbMethod, callbackName, expression, err))
 						}
 
 						// Override operation ID if not set
@@ -784,6 +1214,7 @@ func (g *operationGatherer) gatherCallbacks(doc *v3.Document) ([]*OperationDescr
 						opDesc.Source = OperationSourceCallback
 						opDesc.CallbackName = callbackName
 						opDesc.ParentOpID = parentOpID
+						opDesc.SpecPath = specPath
 
 						operations = append(operations, opDesc)
 					}
@@ -792,6 +1223,7 @@ func (g *operationGatherer) gatherCallbacks(doc *v3.Document) ([]*OperationDescr
 		}
 	}
 
+	disambiguateOperations(g.ctx, operations)
 	return operations, nil
 }
 
@@ -813,12 +1245,23 @@ func schemaProxyToDescriptor(proxy *base.SchemaProxy) *SchemaDescriptor {
 
 	// Capture reference if this is a reference schema
 	if proxy.IsReference() {
-		desc.Ref = proxy.GetReference()
+		desc.Ref = proxyRef(proxy)
 	}
 
 	return desc
 }
 
+// proxyType returns the Go type of the schema of proxy: the type named after
+// the schema it references, if any, or else the type resolved from it.
+func (g *operationGatherer) proxyType(proxy *base.SchemaProxy) string {
+	if ref := proxy.GetReference(); ref != "" {
+		if idx := strings.LastIndex(ref, "/"); idx >= 0 {
+			return ToCamelCase(ref[idx+1:])
+		}
+	}
+	return g.resolveType(proxy.Schema())
+}
+
 // resolveType converts a schema to a Go type string using the configured TypeMapping.
 // This ensures parameter types are consistent with the type generator's output.
 func (g *operationGatherer) resolveType(schema *base.Schema) string {
@@ -829,8 +1272,8 @@ func (g *operationGatherer) resolveType(schema *base.Schema) string {
 	// Check for array
 	if schema.Items != nil && schema.Items.A != nil {
 		itemType := "any"
-		if itemSchema := schema.Items.A.Schema(); itemSchema != nil {
-			itemType = g.resolveType(itemSchema)
+		if schema.Items.A.Schema() != nil {
+			itemType = g.proxyType(schema.Items.A)
 		}
 		return "[]" + itemType
 	}
@@ -841,7 +1284,7 @@ func (g *operationGatherer) resolveType(schema *base.Schema) string {
 		case "string":
 			return g.resolveSpec(g.typeMapping.String, schema.Format)
 		case "integer":
-			return g.resolveSpec(g.typeMapping.Integer, schema.Format)
+			return g.resolveSpecEntry(g.typeMapping.integerSpec(schema))
 		case "number":
 			return g.resolveSpec(g.typeMapping.Number, schema.Format)
 		case "boolean":
@@ -850,6 +1293,9 @@ func (g *operationGatherer) resolveType(schema *base.Schema) string {
 			// Handled above
 			return "[]any"
 		case "object":
+			if ap := mapValueSchema(schema); ap != nil {
+				return "map[string]" + g.proxyType(ap)
+			}
 			return "map[string]any"
 		}
 	}
@@ -859,13 +1305,326 @@ func (g *operationGatherer) resolveType(schema *base.Schema) string {
 
 // resolveSpec looks up a SimpleTypeSpec from a FormatMapping and applies runtime prefix if needed.
 func (g *operationGatherer) resolveSpec(fm FormatMapping, format string) string {
-	spec := fm.Default
+	return g.resolveSpecEntry(formatSpec(fm, format))
+}
+
+// formatSpec returns the SimpleTypeSpec of format in fm, or its default.
+func formatSpec(fm FormatMapping, format string) SimpleTypeSpec {
 	if format != "" {
-		if formatSpec, ok := fm.Formats[format]; ok {
-			spec = formatSpec
+		if spec, ok := fm.Formats[format]; ok {
+			return spec
 		}
 	}
-	return g.resolveSpecEntry(spec)
+	return fm.Default
+}
+
+// primitiveAdapterNames maps the builtin Go types of primitive parameters to
+// the generic parser and formatter of the runtime params package for them.
+var primitiveAdapterNames = map[string][2]string{
+	"string":  {"ParseString", "FormatString"},
+	"bool":    {"ParseBool", "FormatBool"},
+	"int":     {"ParseInt", "FormatInt"},
+	"int8":    {"ParseInt", "FormatInt"},
+	"int16":   {"ParseInt", "FormatInt"},
+	"int32":   {"ParseInt", "FormatInt"},
+	"int64":   {"ParseInt", "FormatInt"},
+	"uint":    {"ParseUint", "FormatUint"},
+	"uint8":   {"ParseUint", "FormatUint"},
+	"uint16":  {"ParseUint", "FormatUint"},
+	"uint32":  {"ParseUint", "FormatUint"},
+	"uint64":  {"ParseUint", "FormatUint"},
+	"float32": {"ParseFloat", "FormatFloat32"},
+	"float64": {"ParseFloat", "FormatFloat64"},
+}
+
+// primitiveAdapters returns the parser and formatter which bind and style a
+// parameter of type typeDecl, whose schema is schema, without reflection, such
+// as ParseInt[int32] and FormatInt[int32]. typeDecl is a builtin type, or a
+// named type, such as an enum, defined as one. Other types, including those
+// set with x-go-type, which may bind themselves, get empty strings.
+func (g *operationGatherer) primitiveAdapters(typeDecl string, schema *base.Schema) (parser, formatter string) {
+	names, ok := primitiveAdapterNames[g.primitiveType(schema)]
+	if !ok {
+		return "", ""
+	}
+	var prefix string
+	if g.ctx != nil {
+		prefix = g.ctx.RuntimeParamsPrefix()
+	}
+	return prefix + names[0] + "[" + typeDecl + "]", prefix + names[1] + "[" + typeDecl + "]"
+}
+
+// primitiveType returns the builtin Go type schema is mapped to, such as
+// "int32", or "" when it's mapped to another type, or isn't a primitive.
+func (g *operationGatherer) primitiveType(schema *base.Schema) string {
+	if schema == nil || len(schema.Type) != 1 || (schema.Nullable != nil && *schema.Nullable) ||
+		hasExtension(schema.Extensions, ExtTypeOverride, legacyExtGoType) {
+		return ""
+	}
+	var spec SimpleTypeSpec
+	switch schema.Type[0] {
+	case "string":
+		spec = formatSpec(g.typeMapping.String, schema.Format)
+	case "integer":
+		spec = g.typeMapping.integerSpec(schema)
+	case "number":
+		spec = formatSpec(g.typeMapping.Number, schema.Format)
+	case "boolean":
+		spec = g.typeMapping.Boolean.Default
+	}
+	if _, ok := primitiveAdapterNames[spec.Type]; !ok || spec.Import != "" || spec.Template != "" {
+		return ""
+	}
+	return spec.Type
+}
+
+// mapValueSchema returns the additionalProperties of schema, an object of
+// them only, such as a label selector, or nil for other schemas.
+func mapValueSchema(schema *base.Schema) *base.SchemaProxy {
+	if schema == nil || !slices.Contains(schema.Type, "object") ||
+		(schema.Properties != nil && schema.Properties.Len() > 0) ||
+		schema.AdditionalProperties == nil || !schema.AdditionalProperties.IsA() ||
+		len(schema.AllOf) > 0 || len(schema.AnyOf) > 0 || len(schema.OneOf) > 0 ||
+		hasExtension(schema.Extensions, ExtTypeOverride, legacyExtGoType) {
+		return nil
+	}
+	if ap := schema.AdditionalProperties.A; ap != nil && ap.Schema() != nil {
+		return ap
+	}
+	return nil
+}
+
+// mapAdapters returns the parser and formatter of the values of a parameter
+// whose schema only declares additionalProperties of a primitive type, such
+// as ParseString[string] and FormatString[string], or empty strings when it
+// declares anything else.
+func (g *operationGatherer) mapAdapters(schema *base.Schema) (parser, formatter string) {
+	ap := mapValueSchema(schema)
+	if ap == nil {
+		return "", ""
+	}
+	return g.primitiveAdapters(g.proxyType(ap), ap.Schema())
+}
+
+// timeLayoutReference is the instant the layouts of x-oapi-codegen-time-format
+// are checked with: each component of the reference time of the time package
+// differs from its own.
+var timeLayoutReference = time.Date(2024, time.February, 29, 13, 45, 30, 0, time.UTC)
+
+// timeLayoutAdapters returns the parser and formatter of a date or date-time
+// parameter of type typeDecl, whose schema is schema, in layout, a Go time
+// layout or "unix" or "unixmilli": ParseTimeLayout("20060102") for time.Time
+// and ParseWrappedTimeLayout[Date]("20060102") for the runtime types
+// embedding it. Other schemas and types, and layouts which don't parse what
+// they format, are errors.
+func (g *operationGatherer) timeLayoutAdapters(layout, typeDecl string, schema *base.Schema) (parser, formatter string, err error) {
+	if schema == nil || !slices.Equal(schema.Type, []string{"string"}) || (schema.Format != "date" && schema.Format != "date-time") ||
+		hasExtension(schema.Extensions, ExtTypeOverride, legacyExtGoType) {
+		return "", "", fmt.Errorf("only supported on parameters with a date or date-time schema")
+	}
+	if layout != "unix" && layout != "unixmilli" {
+		formatted := timeLayoutReference.Format(layout)
+		if _, err := time.Parse(layout, formatted); err != nil || formatted == layout {
+			return "", "", fmt.Errorf("invalid time layout %q", layout)
+		}
+	}
+
+	var prefix string
+	if g.ctx != nil {
+		prefix = g.ctx.RuntimeParamsPrefix()
+	}
+	spec := formatSpec(g.typeMapping.String, schema.Format)
+	switch {
+	case spec.Type == "time.Time" && spec.Template == "":
+		return prefix + "ParseTimeLayout(" + strconv.Quote(layout) + ")", prefix + "FormatTimeLayout(" + strconv.Quote(layout) + ")", nil
+	case spec.Template == "date.tmpl" || spec.Template == "date_time.tmpl":
+		return prefix + "ParseWrappedTimeLayout[" + typeDecl + "](" + strconv.Quote(layout) + ")",
+			prefix + "FormatWrappedTimeLayout[" + typeDecl + "](" + strconv.Quote(layout) + ")", nil
+	}
+	return "", "", fmt.Errorf("%s is mapped to %s, not time.Time or a runtime date type", schema.Format, spec.Type)
+}
+
+// paramConstraints returns the constraints of schema, that of a primitive
+// parameter of type typeDecl at path, as the arguments of ValidateParameter
+// after the value: "ParamMinimum[int32](1, false)". Bounds are written from
+// their literals in the spec, and kept in the range of the type, as
+// numberBound does. Patterns the regexp package can't compile are left out,
+// with a warning.
+func (g *operationGatherer) paramConstraints(path SchemaPath, typeDecl string, schema *base.Schema) string {
+	var prefix string
+	if g.ctx != nil {
+		prefix = g.ctx.RuntimeParamsPrefix()
+	}
+	goType := g.primitiveType(schema)
+	isNumber := strings.HasPrefix(goType, "int") || strings.HasPrefix(goType, "uint") || strings.HasPrefix(goType, "float")
+
+	var constraints []string
+	add := func(name string, args ...string) {
+		constraints = append(constraints, prefix+name+"["+typeDecl+"]("+strings.Join(args, ", ")+")")
+	}
+	var enum []string
+	for _, node := range schema.Enum {
+		if node == nil || node.Tag == "!!null" {
+			continue
+		}
+		enum = append(enum, formatDefaultValue(node.Value, typeDecl))
+	}
+	if len(enum) > 0 {
+		add("ParamEnum", enum...)
+	}
+	if isNumber {
+		var low lowSchemaBounds
+		if l := schema.GoLow(); l != nil {
+			low = lowSchemaBounds{l.Minimum.ValueNode, l.ExclusiveMinimum.ValueNode, l.Maximum.ValueNode, l.ExclusiveMaximum.ValueNode}
+		}
+		bound := func(name string, value float64, node *yaml.Node, exclusive bool) {
+			if literal, exclusive, ok := numberBound(goType, value, node, exclusive, name == "ParamMinimum"); ok {
+				add(name, literal, strconv.FormatBool(exclusive))
+			}
+		}
+		// OpenAPI 3.0 flags the bounds as exclusive, 3.1 declares exclusive
+		// bounds of their own.
+		if min := schema.Minimum; min != nil {
+			bound("ParamMinimum", *min, low.minimum, schema.ExclusiveMinimum != nil && schema.ExclusiveMinimum.IsA() && schema.ExclusiveMinimum.A)
+		}
+		if min := schema.ExclusiveMinimum; min != nil && min.IsB() {
+			bound("ParamMinimum", min.B, low.exclusiveMinimum, true)
+		}
+		if max := schema.Maximum; max != nil {
+			bound("ParamMaximum", *max, low.maximum, schema.ExclusiveMaximum != nil && schema.ExclusiveMaximum.IsA() && schema.ExclusiveMaximum.A)
+		}
+		if max := schema.ExclusiveMaximum; max != nil && max.IsB() {
+			bound("ParamMaximum", max.B, low.exclusiveMaximum, true)
+		}
+	}
+	if goType == "string" {
+		if schema.MinLength != nil {
+			add("ParamMinLength", strconv.FormatInt(*schema.MinLength, 10))
+		}
+		if schema.MaxLength != nil {
+			add("ParamMaxLength", strconv.FormatInt(*schema.MaxLength, 10))
+		}
+		if schema.Pattern != "" {
+			if _, err := regexp.Compile(schema.Pattern); err != nil {
+				if g.ctx != nil {
+					g.ctx.Warn(path.Append("pattern"), "pattern %q isn't checked: %v", schema.Pattern, err)
+				}
+			} else {
+				add("ParamPattern", strconv.Quote(schema.Pattern))
+			}
+		}
+	}
+	return strings.Join(constraints, ", ")
+}
+
+// lowSchemaBounds holds the nodes of the bounds of a schema, whose literals
+// are their exact values.
+type lowSchemaBounds struct {
+	minimum, exclusiveMinimum, maximum, exclusiveMaximum *yaml.Node
+}
+
+// numberBound returns the Go literal of value, a minimum when isMinimum, or
+// else a maximum, of a parameter of type goType, and whether it's exclusive.
+// Integer bounds are written from node, the literal of the bound in the spec,
+// rather than from value, which may have lost digits; fractional ones are
+// rounded into the values they allow, and become inclusive. Bounds which
+// every value of the type meets are dropped, with ok false, and those which
+// none does are clamped to the type's range, exclusive, so that the literal
+// is always representable. int and uint are taken to have 64 bits.
+func numberBound(goType string, value float64, node *yaml.Node, exclusive, isMinimum bool) (literal string, isExclusive, ok bool) {
+	v := exactNumber(value, node)
+	var lo, hi *big.Rat
+	bits, signed, isInteger := integerBits(goType)
+	if isInteger {
+		if v != nil && !v.IsInt() {
+			q := new(big.Int).Div(v.Num(), v.Denom()) // Rounds down, as Denom is positive
+			if isMinimum {
+				q.Add(q, big.NewInt(1))
+			}
+			v, exclusive = new(big.Rat).SetInt(q), false
+		}
+		// The range is [0, 2^bits-1] if unsigned, or [-2^(bits-1), 2^(bits-1)-1].
+		size := new(big.Int).Lsh(big.NewInt(1), bits)
+		min := new(big.Int)
+		if signed {
+			min.Rsh(size, 1).Neg(min)
+		}
+		max := new(big.Int).Add(min, size)
+		lo, hi = new(big.Rat).SetInt(min), new(big.Rat).SetInt(max.Sub(max, big.NewInt(1)))
+	} else {
+		limit := math.MaxFloat64
+		if goType == "float32" {
+			limit = math.MaxFloat32
+		}
+		lo, hi = new(big.Rat).SetFloat64(-limit), new(big.Rat).SetFloat64(limit)
+	}
+
+	switch {
+	case v == nil:
+		// The bound overflowed float64.
+		if math.IsNaN(value) || (value < 0) == isMinimum {
+			return "", false, false
+		}
+		v, exclusive = hi, true
+		if !isMinimum {
+			v = lo
+		}
+	case isMinimum && v.Cmp(lo) < 0, !isMinimum && v.Cmp(hi) > 0:
+		return "", false, false
+	case isMinimum && v.Cmp(hi) > 0:
+		v, exclusive = hi, true
+	case !isMinimum && v.Cmp(lo) < 0:
+		v, exclusive = lo, true
+	}
+	if isInteger {
+		return v.Num().String(), exclusive, true
+	}
+	f, _ := v.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64), exclusive, true
+}
+
+// exactNumber returns the exact value of a number of the spec, value, from
+// its literal in node when there's one, or nil when it isn't finite.
+func exactNumber(value float64, node *yaml.Node) *big.Rat {
+	if node != nil && node.Kind == yaml.ScalarNode {
+		if v, ok := new(big.Rat).SetString(node.Value); ok {
+			return v
+		}
+	}
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil
+	}
+	return new(big.Rat).SetFloat64(value)
+}
+
+// integerBits returns the size of goType, if it's an integer type, and
+// whether it's signed.
+func integerBits(goType string) (bits uint, signed, ok bool) {
+	name, signed := strings.CutPrefix(goType, "int")
+	if !signed {
+		if name, ok = strings.CutPrefix(goType, "uint"); !ok {
+			return 0, false, false
+		}
+	}
+	if name == "" {
+		return 64, signed, true
+	}
+	n, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, false, false
+	}
+	return uint(n), signed, true
+}
+
+// paramDefault returns the Go expression of value, the default of a primitive
+// parameter of type typeDecl, converted to that type unless the literal
+// already has it: "int32(20)", or "asc" for a string.
+func paramDefault(value any, typeDecl string) string {
+	literal := formatDefaultValue(value, typeDecl)
+	if literal == "" || typeDecl == "string" || typeDecl == "bool" {
+		return literal
+	}
+	return typeDecl + "(" + literal + ")"
 }
 
 // resolveSpecEntry returns the Go type for a SimpleTypeSpec, applying the runtime
@@ -34,11 +34,147 @@ var goKeywords = map[string]bool{
 	"var":         true,
 }
 
+// Go predeclared identifiers, which can be shadowed but then break any
+// generated code using them in the same scope
+var goPredeclared = map[string]bool{
+	"any":        true,
+	"append":     true,
+	"bool":       true,
+	"byte":       true,
+	"cap":        true,
+	"clear":      true,
+	"close":      true,
+	"comparable": true,
+	"complex":    true,
+	"complex64":  true,
+	"complex128": true,
+	"copy":       true,
+	"delete":     true,
+	"error":      true,
+	"false":      true,
+	"float32":    true,
+	"float64":    true,
+	"imag":       true,
+	"int":        true,
+	"int8":       true,
+	"int16":      true,
+	"int32":      true,
+	"int64":      true,
+	"iota":       true,
+	"len":        true,
+	"make":       true,
+	"max":        true,
+	"min":        true,
+	"new":        true,
+	"nil":        true,
+	"panic":      true,
+	"print":      true,
+	"println":    true,
+	"real":       true,
+	"recover":    true,
+	"rune":       true,
+	"string":     true,
+	"true":       true,
+	"uint":       true,
+	"uint8":      true,
+	"uint16":     true,
+	"uint32":     true,
+	"uint64":     true,
+	"uintptr":    true,
+}
+
+// Names the generated operation code declares in the scopes holding
+// parameter variables: imported packages, function arguments and locals.
+var generatedScopeNames = map[string]bool{
+	// Imported packages
+	"bytes":   true,
+	"chi":     true,
+	"context": true,
+	"echo":    true,
+	"errors":  true,
+	"fiber":   true,
+	"fmt":     true,
+	"gin":     true,
+	"http":    true,
+	"io":      true,
+	"iris":    true,
+	"json":    true,
+	"mux":     true,
+	"strconv": true,
+	"strings": true,
+	"time":    true,
+	"url":     true,
+
+	// Arguments and locals
+	"body":          true,
+	"buf":           true,
+	"c":             true,
+	"cancel":        true,
+	"contentType":   true,
+	"cookie":        true,
+	"ctx":           true,
+	"decoded":       true,
+	"e":             true,
+	"err":           true,
+	"errBody":       true,
+	"errHandler":    true,
+	"found":         true,
+	"handler":       true,
+	"headers":       true,
+	"middleware":    true,
+	"n":             true,
+	"op":            true,
+	"operationPath": true,
+	"opts":          true,
+	"p":             true,
+	"params":        true,
+	"paramValue":    true,
+	"pathParams":    true,
+	"query":         true,
+	"queryFrag":     true,
+	"queryParams":   true,
+	"queryURL":      true,
+	"queryValues":   true,
+	"r":             true,
+	"rawBody":       true,
+	"req":           true,
+	"reqURL":        true,
+	"resp":          true,
+	"respBody":      true,
+	"result":        true,
+	"server":        true,
+	"serverURL":     true,
+	"si":            true,
+	"siw":           true,
+	"status":        true,
+	"typedBody":     true,
+	"value":         true,
+	"valueList":     true,
+	"values":        true,
+	"w":             true,
+	"wrapper":       true,
+}
+
 // IsGoKeyword returns true if s is a Go keyword.
 func IsGoKeyword(s string) bool {
 	return goKeywords[s]
 }
 
+// IsGoPredeclared returns true if s is a Go predeclared identifier, such as
+// a builtin type, constant or function.
+func IsGoPredeclared(s string) bool {
+	return goPredeclared[s]
+}
+
+// isReservedVariableName returns true if s can't name a parameter variable in
+// generated code: a keyword, a predeclared identifier, or a name the
+// generated code already declares alongside it, including the numbered
+// pathParam0, pathParam1... of the request builders.
+func isReservedVariableName(s string) bool {
+	return goKeywords[s] || goPredeclared[s] || generatedScopeNames[s] ||
+		strings.HasPrefix(s, "pathParam")
+}
+
 // ToCamelCase converts a string to CamelCase (PascalCase).
 // It treats hyphens, underscores, spaces, and other non-alphanumeric characters as word separators.
 // Example: "user-name" -> "UserName", "user_id" -> "UserId"
@@ -127,3 +127,30 @@ func TestToGoIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestGoVariableName(t *testing.T) {
+	tests := []struct {
+		goName   string
+		expected string
+	}{
+		{"PetID", "petID"},
+		{"Type", "pType"},
+		{"Range", "pRange"},
+		{"String", "pString"},
+		{"Error", "pError"},
+		{"Ctx", "pCtx"},
+		{"Err", "pErr"},
+		{"R", "pR"},
+		{"Params", "pParams"},
+		{"PathParam0", "pPathParam0"},
+		{"Http", "pHttp"},
+		{"Kind", "kind"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.goName, func(t *testing.T) {
+			p := &ParameterDescriptor{GoName: tc.goName}
+			assert.Equal(t, tc.expected, p.GoVariableName())
+		})
+	}
+}
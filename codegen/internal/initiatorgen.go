@@ -73,6 +73,15 @@ func (g *InitiatorGenerator) GenerateBase(ops []*OperationDescriptor) (string, e
 	return buf.String(), nil
 }
 
+// GenerateRequestOptions generates the per-call RequestOption type and helpers.
+func (g *InitiatorGenerator) GenerateRequestOptions(data SenderTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "sender_request_options", data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // GenerateInterface generates the InitiatorInterface.
 func (g *InitiatorGenerator) GenerateInterface(data SenderTemplateData) (string, error) {
 	var buf bytes.Buffer
@@ -141,6 +150,14 @@ func (g *InitiatorGenerator) GenerateInitiator(ops []*OperationDescriptor) (stri
 	buf.WriteString(base)
 	buf.WriteString("\n")
 
+	// Generate per-call request options
+	requestOptions, err := g.GenerateRequestOptions(data)
+	if err != nil {
+		return "", fmt.Errorf("generating initiator request options: %w", err)
+	}
+	buf.WriteString(requestOptions)
+	buf.WriteString("\n")
+
 	// Generate interface
 	iface, err := g.GenerateInterface(data)
 	if err != nil {
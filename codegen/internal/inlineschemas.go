@@ -0,0 +1,182 @@
+package codegen
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// InlineSchemas configures the types of schemas defined inline in others,
+// such as the object of a property, or the items of an array.
+type InlineSchemas struct {
+	// Promote is which inline object schemas become named types, one of the
+	// InlinePromote constants. Empty means InlinePromoteAlways.
+	Promote string `yaml:"promote,omitempty"`
+	// NameTemplate is a text/template naming the types of inline schemas,
+	// in place of the names derived from their paths, executed with
+	// inlineNameData, such as "{{.Parent}}{{.Key}}". Titles with
+	// prefer-schema-titles, name overrides and pinned names still win.
+	NameTemplate string `yaml:"name-template,omitempty"`
+}
+
+// Inline schema promotion policies.
+const (
+	// InlinePromoteAlways gives every inline object schema a named type.
+	InlinePromoteAlways = "always"
+	// InlinePromoteWhenNeeded gives inline object schemas of properties and
+	// array items named types only when they need one: for methods, such as
+	// those of additionalProperties and defaults, or when they're named by
+	// a title, an extension or a pinned name. The others are anonymous
+	// structs in the fields holding them.
+	InlinePromoteWhenNeeded = "when-needed"
+)
+
+// inlineNameData is the data the inline-schemas name template is executed
+// with, for a schema defined in another.
+type inlineNameData struct {
+	Name        string // Name the schema gets by default, such as "CatOwnerAddress"
+	Parent      string // Name of the schema it's defined in, such as "CatOwner"
+	Key         string // Where it's defined in its parent: a property, "Item", "Value" or "OneOf0"
+	OperationID string // operationId of the operation it's in, as a Go name, or ""
+	Path        string // JSON pointer of the schema
+}
+
+// parseInlineNameTemplate parses the inline-schemas name template, or returns
+// nil when there's none.
+func parseInlineNameTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("name-template").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing inline-schemas name-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// inlineSchemaName executes tmpl for s, a schema defined in parent, whose
+// name is parentName, and name, the name s gets by default.
+func inlineSchemaName(tmpl *template.Template, s *SchemaDescriptor, name, parentName string, converter *NameConverter) (string, error) {
+	data := inlineNameData{
+		Name:   name,
+		Parent: parentName,
+		Key:    inlineSchemaKey(s, converter),
+		Path:   jsonPointer(s.Path),
+	}
+	if s.OperationID != "" {
+		data.OperationID = converter.ToTypeName(s.OperationID)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", errorAt(s.Path, fmt.Errorf("executing inline-schemas name-template: %w", err))
+	}
+	result := strings.TrimSpace(b.String())
+	if !token.IsIdentifier(result) {
+		return "", errorAt(s.Path, fmt.Errorf("inline-schemas name-template names %s %q, which isn't a Go identifier", data.Path, result))
+	}
+	return result, nil
+}
+
+// inlineSchemaKey returns where s is defined in its parent as a Go name: the
+// name of its property, "Item" for array items, "Value" for
+// additionalProperties, or the kind and position of a composition member or
+// tuple item, such as "OneOf0".
+func inlineSchemaKey(s *SchemaDescriptor, converter *NameConverter) string {
+	rel := s.Path
+	if p := s.Parent.Path; len(p) < len(s.Path) && slices.Equal(p, s.Path[:len(p)]) {
+		rel = s.Path[len(p):]
+	}
+	switch {
+	case len(rel) == 2 && rel[0] == "properties":
+		return converter.ToTypeName(rel[1])
+	case len(rel) == 1 && rel[0] == "items":
+		return "Item"
+	case len(rel) == 1 && rel[0] == "additionalProperties":
+		return "Value"
+	case len(rel) == 2 && (rel[0] == "allOf" || rel[0] == "anyOf" || rel[0] == "oneOf" || rel[0] == "prefixItems"):
+		return converter.ToTypeName(rel[0]) + rel[1]
+	}
+	return converter.ToTypeName(strings.Join(rel, "_"))
+}
+
+// markAnonymousSchemas marks the inline object schemas which are anonymous
+// structs with InlinePromoteWhenNeeded: those of properties and array items
+// which need no type of their own. Schemas with pinned names are promoted.
+func markAnonymousSchemas(schemas []*SchemaDescriptor, inline InlineSchemas, preferTitles bool, pinned map[string]string) {
+	if inline.Promote != InlinePromoteWhenNeeded {
+		return
+	}
+	for _, s := range schemas {
+		if _, ok := pinned[jsonPointer(s.Path)]; ok {
+			continue
+		}
+		if preferTitles && schemaTitle(s) != "" {
+			continue
+		}
+		s.Anonymous = isAnonymousStruct(s)
+	}
+}
+
+// isAnonymousStruct reports whether s is the inline object schema of a
+// property or array items, which needs no type of its own: no methods, as
+// additionalProperties, defaults and consts need, and no name from an
+// extension.
+func isAnonymousStruct(s *SchemaDescriptor) bool {
+	n := len(s.Path)
+	inline := n >= 2 && s.Path[n-2] == "properties" || n >= 1 && s.Path[n-1] == "items"
+	if s.Parent == nil || !inline || GetSchemaKind(s) != KindStruct {
+		return false
+	}
+	if s.Extensions != nil && (s.Extensions.TypeOverride != nil || s.Extensions.TypeNameOverride != "") {
+		return false
+	}
+	return s.Schema.AdditionalProperties == nil && s.Discriminator == nil && !needsApplyDefaults(s.Schema)
+}
+
+// needsApplyDefaults reports whether schema, or one defined inline in it,
+// has a default or a const, which the ApplyDefaults methods and constructors
+// of named types set.
+func needsApplyDefaults(schema *base.Schema) bool {
+	if schema == nil {
+		return false
+	}
+	if _, _, ok := constValue(schema); ok || schema.Default != nil {
+		return true
+	}
+	var inline []*base.SchemaProxy
+	if schema.Properties != nil {
+		for pair := schema.Properties.First(); pair != nil; pair = pair.Next() {
+			inline = append(inline, pair.Value())
+		}
+	}
+	if schema.Items != nil && schema.Items.A != nil {
+		inline = append(inline, schema.Items.A)
+	}
+	for _, proxy := range inline {
+		if !proxy.IsReference() && needsApplyDefaults(proxy.Schema()) {
+			return true
+		}
+	}
+	return false
+}
+
+// anonymousStructType returns the anonymous struct type of an inline object
+// schema marked Anonymous.
+func (g *TypeGenerator) anonymousStructType(desc *SchemaDescriptor) string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, f := range g.GenerateStructFields(desc) {
+		if f.Doc != "" {
+			for _, line := range strings.Split(f.Doc, "\n") {
+				b.WriteString("// " + strings.TrimRight(line, " \t") + "\n")
+			}
+		}
+		b.WriteString(f.Name + " " + f.Type + " " + generateFieldTag(f, g.TagGenerator()) + "\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
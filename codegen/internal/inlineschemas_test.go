@@ -0,0 +1,86 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const inlineSpec = `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        owner:
+          type: object
+          properties:
+            name:
+              type: string
+        tags:
+          type: array
+          items:
+            type: object
+            title: Tag
+            properties:
+              label:
+                type: string
+`
+
+func TestInlineSchemas_NameTemplate(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(inlineSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(doc, nil, Configuration{
+		PackageName:   "api",
+		InlineSchemas: InlineSchemas{NameTemplate: "{{.Key}}Of{{.Parent}}"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "Owner *OwnerOfPet")
+	assert.Contains(t, code, "type ItemOfTagsOfPet struct")
+
+	_, diagnostics, err := GenerateWithDiagnostics(doc, []byte(inlineSpec), Configuration{
+		PackageName:   "api",
+		InlineSchemas: InlineSchemas{NameTemplate: "{{.Parent}}-{{.Key}}"},
+	})
+	require.Error(t, err)
+	assert.Equal(t, `inline-schemas name-template names #/components/schemas/Pet/properties/owner "Pet-Owner", which isn't a Go identifier`, err.Error())
+	d := diagnostics[len(diagnostics)-1]
+	assert.Equal(t, "#/components/schemas/Pet/properties/owner", d.Pointer)
+	assert.Equal(t, 11, d.Line)
+
+	_, err = Generate(doc, nil, Configuration{
+		PackageName:   "api",
+		InlineSchemas: InlineSchemas{NameTemplate: "{{.Parent"},
+	})
+	assert.ErrorContains(t, err, "parsing inline-schemas name-template")
+}
+
+func TestInlineSchemas_PromoteWhenNeeded(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(inlineSpec))
+	require.NoError(t, err)
+
+	// Titled items are promoted with prefer-schema-titles
+	code, err := Generate(doc, nil, Configuration{
+		PackageName:   "api",
+		OutputOptions: OutputOptions{PreferSchemaTitles: true},
+		InlineSchemas: InlineSchemas{Promote: InlinePromoteWhenNeeded},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "Owner *struct {")
+	assert.NotContains(t, code, "type PetOwner ")
+	assert.Contains(t, code, "Tags []Tag")
+	assert.Contains(t, code, "type Tag struct")
+
+	_, err = Generate(doc, nil, Configuration{
+		PackageName:   "api",
+		InlineSchemas: InlineSchemas{Promote: "never"},
+	})
+	assert.EqualError(t, err, `unknown inline-schemas promote "never": want "always" or "when-needed"`)
+}
@@ -0,0 +1,77 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/pb33f/libopenapi"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// SpecIndex is the resolved libopenapi model of a spec, together with the
+// descriptors generation derives from it, for custom checks, such as naming
+// policies or security audits, run in the same process as generation.
+type SpecIndex struct {
+	// Model is the resolved v3 model of the spec.
+	Model *v3.Document
+
+	// Schemas holds the schemas which get types, keyed by the path where they
+	// appear in the document, such as #/components/schemas/Pet.
+	Schemas map[string]*SchemaDescriptor
+
+	// Operations holds the operations of paths, webhooks and callbacks,
+	// keyed by the path where they appear in the document, such as
+	// #/paths//pets/get or #/webhooks/newPet/post.
+	Operations map[string]*OperationDescriptor
+}
+
+// Inspect builds the SpecIndex of doc as Generate sees it under cfg: schemas
+// are filtered, pruned and named, and operations filtered, as they would be
+// for generation. Webhooks and callbacks are always included.
+func Inspect(doc libopenapi.Document, cfg Configuration) (*SpecIndex, error) {
+	if _, err := NameManglingPreset(cfg.NameMangling.Preset); err != nil {
+		return nil, err
+	}
+	cfg.ApplyDefaults()
+
+	v3Doc, err := buildV3Model(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := NewCodegenContext()
+	configureRuntimePrefixes(ctx, cfg)
+	contentTypeMatcher := NewContentTypeMatcher(cfg.ContentTypes)
+	converter := NewNameConverter(cfg.NameMangling, cfg.NameSubstitutions)
+
+	schemas, err := gatherNamedSchemas(v3Doc, ctx, cfg, contentTypeMatcher, converter)
+	if err != nil {
+		return nil, err
+	}
+	index := &SpecIndex{
+		Model:      v3Doc,
+		Schemas:    make(map[string]*SchemaDescriptor, len(schemas)),
+		Operations: make(map[string]*OperationDescriptor),
+	}
+	for _, s := range schemas {
+		index.Schemas[s.Path.String()] = s
+	}
+
+	ops, err := GatherOperations(v3Doc, ctx, contentTypeMatcher, cfg.TypeMapping)
+	if err != nil {
+		return nil, fmt.Errorf("gathering operations: %w", err)
+	}
+	webhookOps, err := GatherWebhookOperations(v3Doc, ctx, contentTypeMatcher, cfg.TypeMapping)
+	if err != nil {
+		return nil, fmt.Errorf("gathering webhook operations: %w", err)
+	}
+	callbackOps, err := GatherCallbackOperations(v3Doc, ctx, contentTypeMatcher, cfg.TypeMapping)
+	if err != nil {
+		return nil, fmt.Errorf("gathering callback operations: %w", err)
+	}
+	for _, set := range [][]*OperationDescriptor{FilterOperations(ops, cfg.OutputOptions), webhookOps, callbackOps} {
+		for _, op := range set {
+			index.Operations[op.SpecPath.String()] = op
+		}
+	}
+	return index, nil
+}
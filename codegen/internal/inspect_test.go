@@ -0,0 +1,96 @@
+package codegen
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const inspectSpec = `openapi: "3.1.0"
+info:
+  title: Inspect
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets]
+      responses:
+        "200":
+          description: The pets.
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      operationId: addPet
+      tags: [admin]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "201":
+          description: Added.
+      callbacks:
+        added:
+          '{$request.body#/callbackUrl}':
+            post:
+              operationId: petAdded
+              responses:
+                "200":
+                  description: Received.
+webhooks:
+  newPet:
+    post:
+      operationId: newPet
+      responses:
+        "200":
+          description: Received.
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    Internal:
+      type: string
+`
+
+func TestInspect(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(inspectSpec))
+	require.NoError(t, err)
+
+	index, err := Inspect(doc, Configuration{
+		PackageName:   "api",
+		OutputOptions: OutputOptions{ExcludeSchemas: []string{"Internal"}, ExcludeTags: []string{"admin"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Inspect", index.Model.Info.Title)
+
+	pet := index.Schemas["#/components/schemas/Pet"]
+	require.NotNil(t, pet)
+	assert.Equal(t, "Pet", pet.ShortName)
+	assert.NotContains(t, index.Schemas, "#/components/schemas/Internal")
+
+	var keys []string
+	for key := range index.Operations {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, []string{
+		"#/paths//pets/get",
+		"#/paths//pets/post/callbacks/added/{$request.body#/callbackUrl}/post",
+		"#/webhooks/newPet/post",
+	}, keys)
+	assert.Equal(t, "listPets", index.Operations["#/paths//pets/get"].OperationID)
+	assert.Equal(t, OperationSourceCallback, index.Operations[keys[1]].Source)
+	assert.Equal(t, "addPet", index.Operations[keys[1]].ParentOpID)
+}
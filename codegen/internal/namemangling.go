@@ -1,12 +1,23 @@
 package codegen
 
 import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 // NameMangling configures how OpenAPI names are converted to valid Go identifiers.
 type NameMangling struct {
+	// Preset selects the defaults the other fields are merged over, tuned for
+	// a common spec dialect: "aws", "google" or "kubernetes". Empty means
+	// DefaultNameMangling.
+	Preset string `yaml:"preset,omitempty"`
+
 	// CharacterSubstitutions maps characters to their word replacements.
 	// Used when these characters appear at the start of a name.
 	// Example: '$' -> "DollarSign", '-' -> "Minus"
@@ -28,6 +39,11 @@ type NameMangling struct {
 	// Initialisms is a list of words that should be all-uppercase.
 	// Example: ["ID", "HTTP", "URL"] means "userId" becomes "UserID"
 	Initialisms []string `yaml:"initialisms,omitempty"`
+
+	// TrimPrefixes lists prefixes removed from type names before they're
+	// converted, the longest matching one first.
+	// Example: ["io.k8s.api."] means "io.k8s.api.core.v1.Pod" becomes "CoreV1Pod"
+	TrimPrefixes []string `yaml:"trim-prefixes,omitempty"`
 }
 
 // DefaultNameMangling returns sensible defaults for name mangling.
@@ -80,6 +96,59 @@ func DefaultNameMangling() NameMangling {
 	}
 }
 
+// nameManglingPresets holds the extra initialisms and trimmed prefixes of each
+// preset, applied over DefaultNameMangling.
+var nameManglingPresets = map[string]NameMangling{
+	// AWS specs use PascalCase names full of service acronyms, such as
+	// "KmsKeyArn" or "VpcId".
+	"aws": {
+		Initialisms: []string{
+			"ACM", "AMI", "ARN", "AWS", "AZ", "CIDR", "EBS", "ECR", "ECS",
+			"EFS", "EKS", "ELB", "IAM", "KMS", "MFA", "SES", "SNS", "SQS",
+			"SSL", "SSM", "STS", "VPC",
+		},
+	},
+	// Google specs use snake_case properties and Cloud acronyms, such as
+	// "kms_key_name" or "gcs_bucket".
+	"google": {
+		Initialisms: []string{
+			"CIDR", "GCE", "GCP", "GCS", "GKE", "IAM", "KMS", "SSL", "VPC",
+		},
+	},
+	// Kubernetes specs name schemas by group, version and kind, such as
+	// "io.k8s.api.apps.v1.Deployment", which become "AppsV1Deployment".
+	"kubernetes": {
+		Initialisms: []string{
+			"CIDR", "CSI", "FC", "FQDN", "ISCSI", "NFS", "RBD", "WWN",
+		},
+		TrimPrefixes: []string{
+			"io.k8s.api.",
+			"io.k8s.apimachinery.pkg.apis.",
+			"io.k8s.apimachinery.pkg.",
+			"io.k8s.apiextensions-apiserver.pkg.apis.",
+			"io.k8s.kube-aggregator.pkg.apis.",
+		},
+	},
+}
+
+// NameManglingPreset returns the defaults of the preset name, or
+// DefaultNameMangling for the empty name.
+func NameManglingPreset(name string) (NameMangling, error) {
+	result := DefaultNameMangling()
+	if name == "" {
+		return result, nil
+	}
+	preset, ok := nameManglingPresets[name]
+	if !ok {
+		names := slices.Sorted(maps.Keys(nameManglingPresets))
+		return result, fmt.Errorf("unknown name-mangling preset %q, expected one of %s", name, strings.Join(names, ", "))
+	}
+	result.Preset = name
+	result.Initialisms = append(result.Initialisms, preset.Initialisms...)
+	result.TrimPrefixes = preset.TrimPrefixes
+	return result, nil
+}
+
 // Merge returns a new NameMangling with user values overlaid on defaults.
 // Non-zero user values override defaults.
 func (n NameMangling) Merge(user NameMangling) NameMangling {
@@ -114,6 +183,9 @@ func (n NameMangling) Merge(user NameMangling) NameMangling {
 	if len(user.Initialisms) > 0 {
 		result.Initialisms = user.Initialisms
 	}
+	if len(user.TrimPrefixes) > 0 {
+		result.TrimPrefixes = user.TrimPrefixes
+	}
 
 	return result
 }
@@ -142,6 +214,11 @@ func NewNameConverter(mangling NameMangling, substitutions NameSubstitutions) *N
 	for _, init := range mangling.Initialisms {
 		initialismSet[strings.ToUpper(init)] = true
 	}
+	// Longest first, so the most specific prefix is trimmed.
+	mangling.TrimPrefixes = slices.Clone(mangling.TrimPrefixes)
+	slices.SortStableFunc(mangling.TrimPrefixes, func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
 	return &NameConverter{
 		mangling:      mangling,
 		substitutions: substitutions,
@@ -155,7 +232,7 @@ func (c *NameConverter) ToTypeName(name string) string {
 	if sub, ok := c.substitutions.TypeNames[name]; ok {
 		return sub
 	}
-	return c.toGoIdentifier(name, true)
+	return c.toGoIdentifier(c.trimPrefix(name), true)
 }
 
 // ToTypeNamePart converts a name to a type name component that will be joined with others.
@@ -229,6 +306,8 @@ func (c *NameConverter) toGoIdentifier(name string, exported bool) string {
 		return "Empty"
 	}
 
+	name = transliterate(name)
+
 	// Build the identifier with prefix handling
 	var result strings.Builder
 	prefix := c.getPrefix(name)
@@ -244,6 +323,15 @@ func (c *NameConverter) toGoIdentifier(name string, exported bool) string {
 			continue
 		}
 
+		if r > unicode.MaxASCII {
+			// Left over by transliterate, such as CJK or emoji: spell out
+			// the code point, which keeps distinct names distinct.
+			fmt.Fprintf(&result, "U%04X", r)
+			capitalizeNext = true
+			prevWasDigit = false
+			continue
+		}
+
 		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
 			// Skip invalid characters (already handled by prefix if at start)
 			capitalizeNext = true
@@ -284,12 +372,14 @@ func (c *NameConverter) toGoIdentifierPart(name string) string {
 		return ""
 	}
 
+	name = transliterate(name)
+
 	// Build the identifier without numeric prefix (but still handle special characters at start)
 	var result strings.Builder
 
 	// Only add prefix for non-digit special characters at the start
 	firstRune := []rune(name)[0]
-	if !unicode.IsLetter(firstRune) && !unicode.IsDigit(firstRune) {
+	if firstRune <= unicode.MaxASCII && !unicode.IsLetter(firstRune) && !unicode.IsDigit(firstRune) {
 		firstChar := string(firstRune)
 		if sub, ok := c.mangling.CharacterSubstitutions[firstChar]; ok {
 			result.WriteString(sub)
@@ -308,6 +398,15 @@ func (c *NameConverter) toGoIdentifierPart(name string) string {
 			continue
 		}
 
+		if r > unicode.MaxASCII {
+			// Left over by transliterate, such as CJK or emoji: spell out
+			// the code point, which keeps distinct names distinct.
+			fmt.Fprintf(&result, "U%04X", r)
+			capitalizeNext = true
+			prevWasDigit = false
+			continue
+		}
+
 		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
 			// Skip invalid characters (already handled by prefix if at start)
 			capitalizeNext = true
@@ -338,6 +437,57 @@ func (c *NameConverter) toGoIdentifierPart(name string) string {
 	return id
 }
 
+// trimPrefix removes the first of TrimPrefixes name starts with, unless
+// nothing would be left.
+func (c *NameConverter) trimPrefix(name string) string {
+	for _, prefix := range c.mangling.TrimPrefixes {
+		if trimmed, ok := strings.CutPrefix(name, prefix); ok && trimmed != "" {
+			return trimmed
+		}
+	}
+	return name
+}
+
+// transliterations holds the ASCII spelling of letters which don't decompose
+// into an ASCII letter and combining marks.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE", 'ø': "o", 'Ø': "O",
+	'đ': "d", 'Đ': "D", 'ł': "l", 'Ł': "L", 'þ': "th", 'Þ': "TH", 'ð': "d",
+	'Ð': "D", 'ı': "i",
+}
+
+// transliterate spells the Latin letters of name in ASCII, dropping their
+// accents, so "Größe" becomes "Grosse". Other non-ASCII runes are kept, for
+// the caller to spell out.
+func transliterate(name string) string {
+	ascii := true
+	for i := 0; i < len(name); i++ {
+		if name[i] > unicode.MaxASCII {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return name
+	}
+
+	var b strings.Builder
+	latin := false
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case latin && unicode.Is(unicode.Mn, r):
+			// Accents on Latin letters, such as the one of é, are dropped.
+		case transliterations[r] != "":
+			b.WriteString(transliterations[r])
+			latin = true
+		default:
+			b.WriteRune(r)
+			latin = r <= unicode.MaxASCII
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
 // getPrefix returns the prefix needed for names starting with invalid characters.
 func (c *NameConverter) getPrefix(name string) string {
 	if name == "" {
@@ -351,8 +501,9 @@ func (c *NameConverter) getPrefix(name string) string {
 		return c.mangling.NumericPrefix
 	}
 
-	// Check if starts with letter (valid, no prefix needed)
-	if unicode.IsLetter(firstRune) {
+	// Check if starts with letter (valid, no prefix needed), or with a rune
+	// spelled out as its code point
+	if unicode.IsLetter(firstRune) || firstRune > unicode.MaxASCII {
 		return ""
 	}
 
@@ -247,3 +247,66 @@ func TestRemoveCharacterSubstitution(t *testing.T) {
 	_, exists := merged.CharacterSubstitutions["$"]
 	assert.False(t, exists)
 }
+
+func TestNameManglingPreset(t *testing.T) {
+	tests := []struct {
+		preset   string
+		input    string
+		expected string
+	}{
+		{"", "VpcId", "VpcID"},
+		{"aws", "VpcId", "VPCID"},
+		{"aws", "KmsKeyArn", "KMSKeyARN"},
+		{"google", "kms_key_name", "KMSKeyName"},
+		{"google", "gcs_bucket", "GCSBucket"},
+		{"", "io.k8s.api.apps.v1.Deployment", "IoK8SAPIAppsV1Deployment"},
+		{"kubernetes", "io.k8s.api.apps.v1.Deployment", "AppsV1Deployment"},
+		{"kubernetes", "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta", "MetaV1ObjectMeta"},
+		{"kubernetes", "io.k8s.apimachinery.pkg.api.resource.Quantity", "APIResourceQuantity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.preset+"/"+tt.input, func(t *testing.T) {
+			cfg := Configuration{NameMangling: NameMangling{Preset: tt.preset}}
+			cfg.ApplyDefaults()
+			c := NewNameConverter(cfg.NameMangling, NameSubstitutions{})
+			assert.Equal(t, tt.expected, c.ToTypeName(tt.input))
+		})
+	}
+
+	_, err := NameManglingPreset("azure")
+	assert.ErrorContains(t, err, `unknown name-mangling preset "azure"`)
+}
+
+func TestNameManglingPresetOverride(t *testing.T) {
+	// User values replace the preset's, like they replace the defaults.
+	cfg := Configuration{NameMangling: NameMangling{Preset: "kubernetes", TrimPrefixes: []string{"io.k8s."}}}
+	cfg.ApplyDefaults()
+	c := NewNameConverter(cfg.NameMangling, NameSubstitutions{})
+	assert.Equal(t, "APICoreV1Pod", c.ToTypeName("io.k8s.api.core.v1.Pod"))
+	assert.Equal(t, "CSIDriver", c.ToTypeName("CsiDriver"))
+}
+
+func TestNonASCIINames(t *testing.T) {
+	c := NewNameConverter(DefaultNameMangling(), NameSubstitutions{})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"café", "Cafe"},
+		{"Größe", "Grosse"},
+		{"naïve_user", "NaiveUser"},
+		{"名前", "U540DU524D"},
+		{"🚀", "U1F680"},
+		{"🚀launch", "U1F680Launch"},
+		{"rocket🚀", "RocketU1F680"},
+		{"Ελλάδα", "U0395U03BBU03BBU03ACU03B4U03B1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, c.ToPropertyName(tt.input))
+			assert.Equal(t, tt.expected, c.ToTypeNamePart(tt.input))
+		})
+	}
+}
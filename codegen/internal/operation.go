@@ -1,6 +1,9 @@
 package codegen
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -23,6 +26,7 @@ type OperationDescriptor struct {
 	Path          string // Original path: /users/{id}
 	Summary       string // For generating comments
 	Description   string // Longer description
+	Deprecated    bool   // Marked deprecated in the spec
 
 	// Source indicates where this operation was defined (path, webhook, or callback)
 	Source       OperationSource
@@ -30,6 +34,10 @@ type OperationDescriptor struct {
 	CallbackName string // Callback key (for Source=callback)
 	ParentOpID   string // Parent operation ID (for Source=callback)
 
+	// SpecPath is where the operation appears in the document, such as
+	// #/paths//pets/get, in the form of the paths of schemas.
+	SpecPath SchemaPath
+
 	PathParams   []*ParameterDescriptor
 	QueryParams  []*ParameterDescriptor
 	HeaderParams []*ParameterDescriptor
@@ -40,15 +48,51 @@ type OperationDescriptor struct {
 
 	Security []SecurityRequirement
 
+	// Extensions holds parsed x-oapi-codegen-* operation extensions
+	Extensions *OperationExtensions
+
 	// Precomputed for templates
 	HasBody        bool   // Has at least one request body
 	HasParams      bool   // Has non-path params (needs Params struct)
 	ParamsTypeName string // "{OperationID}Params"
+	Cacheable      bool   // GET operation whose responses may be cached by the client
+
+	// LRO is set for long-running operations marked with x-oapi-codegen-lro
+	LRO *LRODescriptor
+
+	// Server is the first server declared by the operation, or else by its
+	// path, with variables substituted by their defaults. Empty when neither
+	// declares servers, in which case the client's Server is used.
+	Server string
 
 	// Reference to the underlying spec
 	Spec *v3.Operation
 }
 
+// EventStream returns the text/event-stream content of the success response
+// of the operation, if it declares an itemSchema. Servers get an EventWriter
+// for it.
+func (o *OperationDescriptor) EventStream() *ResponseContentDescriptor {
+	for _, r := range o.Responses {
+		if !strings.HasPrefix(r.StatusCode, "2") {
+			continue
+		}
+		for _, c := range r.Contents {
+			if c.IsEventStream() && c.ItemType != "" {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// LRODescriptor describes how the client polls a long-running operation.
+type LRODescriptor struct {
+	StatusOperation *OperationDescriptor // GET operation of the status resource
+	StatusField     string               // JSON property holding the state
+	TerminalStates  []string             // States which end polling
+}
+
 // Params returns all non-path parameters (query, header, cookie).
 // These are bundled into a Params struct.
 func (o *OperationDescriptor) Params() []*ParameterDescriptor {
@@ -82,6 +126,21 @@ func (o *OperationDescriptor) SummaryAsComment() string {
 	return strings.Join(parts, "\n")
 }
 
+// DeprecationComment returns the Deprecated paragraph documenting the methods
+// of a deprecated operation, or one with a deprecation reason, as Go comment
+// lines after a blank one. It's "" for other operations.
+func (o *OperationDescriptor) DeprecationComment() string {
+	var reason string
+	if o.Extensions != nil {
+		reason = o.Extensions.DeprecatedReason
+	}
+	notice := withDeprecation("", o.Deprecated, reason)
+	if notice == "" {
+		return ""
+	}
+	return "//\n// " + notice
+}
+
 // DefaultBody returns the default request body (typically application/json), or nil.
 func (o *OperationDescriptor) DefaultBody() *RequestBodyDescriptor {
 	for _, b := range o.Bodies {
@@ -116,6 +175,21 @@ func (o *OperationDescriptor) HasTypedBody() bool {
 	return o.DefaultTypedBody() != nil
 }
 
+// ParamDefaults returns the query, header and cookie parameters with a
+// default which ApplyDefaults of the Params struct sets when they're absent:
+// those whose field is a pointer or a Nullable.
+func (o *OperationDescriptor) ParamDefaults() []*ParameterDescriptor {
+	var params []*ParameterDescriptor
+	for _, list := range [][]*ParameterDescriptor{o.QueryParams, o.HeaderParams, o.CookieParams} {
+		for _, p := range list {
+			if p.Default != "" && (p.IsOptional() || p.AllowEmptyValue) {
+				params = append(params, p)
+			}
+		}
+	}
+	return params
+}
+
 // ParameterDescriptor describes a parameter in any location.
 type ParameterDescriptor struct {
 	Name     string // Original name from spec (e.g., "user_id")
@@ -136,14 +210,82 @@ type ParameterDescriptor struct {
 	IsPassThrough bool // No styling, just pass the string through
 	IsJSON        bool // Parameter uses JSON content encoding
 
+	// AllowEmptyValue marks an optional styled query parameter with
+	// allowEmptyValue: true. Its field is a Nullable, which is null when the
+	// parameter is sent with an empty value and unspecified when it's absent.
+	AllowEmptyValue bool
+
+	// Parser and Formatter bind and style a primitive styled parameter
+	// without reflection: the generic functions of the runtime instantiated
+	// for its type, such as "ParseInt[int32]" and "FormatInt[int32]". They're
+	// empty for other parameters.
+	Parser    string
+	Formatter string
+
+	// Default is the Go expression of the default declared by the schema of
+	// an optional primitive parameter, such as "int32(20)", which the server
+	// sets when the parameter is absent. Empty when there's none.
+	Default string
+
+	// Constraints are the checks of the enum, bounds, lengths and pattern
+	// declared by the schema of a primitive parameter, the arguments of the
+	// runtime's ValidateParameter after its value, such as
+	// "ParamMinimum[int32](1, false)". Empty when it declares none.
+	Constraints string
+
+	// Fields are the properties of an object query parameter exploded in the
+	// form style, whose struct clients style and servers bind field by field,
+	// each property as the query key named after it. Empty for other
+	// parameters, and for objects with properties which can't be styled that
+	// way, such as nested objects.
+	Fields []*ParamField
+
+	// MapParser and MapFormatter bind and style the values of a query
+	// parameter whose schema only declares primitive additionalProperties,
+	// such as a label selector, a map keyed by the entries of the parameter:
+	// "ParseString[string]" for map[string]string. Empty for other parameters.
+	// DeclaredKeys are the query keys of the other parameters of the
+	// operation, which exploded maps leave out.
+	MapParser    string
+	MapFormatter string
+	DeclaredKeys []string
+
+	// OptionalAs is how the Params struct holds the parameter when it's
+	// optional, one of the OptionalParams constants, pointers when empty.
+	// nullableType is the Nullable type of the runtime, qualified.
+	OptionalAs   string
+	nullableType string
+
+	// IsIdempotencyKey marks a string header which clients populate with a
+	// UUID when the caller leaves it unset
+	IsIdempotencyKey bool
+
+	Extensions *ParameterExtensions
+
 	Spec *v3.Parameter
 }
 
+// ParamField is a property of an exploded object query parameter.
+type ParamField struct {
+	Name     string // Property name, its query key
+	GoName   string // Name of its field in the struct of the parameter
+	Required bool
+	Pointer  bool // Its field is a pointer, nil when it's absent
+
+	// Parser and Formatter bind and style a primitive property without
+	// reflection, such as "ParseInt[int32]" and "FormatInt[int32]". Empty
+	// for arrays, which are bound and styled by reflection, like exploded
+	// array parameters.
+	Parser    string
+	Formatter string
+}
+
 // GoVariableName returns a Go-safe variable name for this parameter.
-// Used for local variables in generated code.
+// Used for local variables in generated code, so names which are keywords,
+// predeclared identifiers or already declared there get a "p" prefix.
 func (p *ParameterDescriptor) GoVariableName() string {
 	name := LowercaseFirstCharacter(p.GoName)
-	if IsGoKeyword(name) {
+	if isReservedVariableName(name) {
 		name = "p" + p.GoName
 	}
 	// Handle leading digits
@@ -153,10 +295,25 @@ func (p *ParameterDescriptor) GoVariableName() string {
 	return name
 }
 
-// HasOptionalPointer returns true if this parameter should be a pointer
-// (optional parameters that aren't required).
-func (p *ParameterDescriptor) HasOptionalPointer() bool {
-	if p.Required {
+// IsDeepObject returns true if this is a styled query parameter with style
+// deepObject, which binds and styles through the deepObject helpers.
+func (p *ParameterDescriptor) IsDeepObject() bool {
+	return p.IsStyled && p.Location == "query" && p.Style == "deepObject"
+}
+
+// Representations of the fields of optional parameters in Params structs, the
+// values of the optional-parameters output option.
+const (
+	OptionalParamsPointer  = "pointer"  // *T, nil when absent; the default
+	OptionalParamsNullable = "nullable" // Nullable[T], unspecified when absent
+	OptionalParamsValue    = "value"    // T, with a Has<Param> bool beside it
+)
+
+// IsOptional returns true if the field of this parameter tells whether it's
+// set: optional parameters, but for those allowing empty values, which are
+// always Nullable, and those whose schema skips the optional pointer.
+func (p *ParameterDescriptor) IsOptional() bool {
+	if p.Required || p.AllowEmptyValue {
 		return false
 	}
 	// Check if schema has skip-optional-pointer extension
@@ -167,6 +324,105 @@ func (p *ParameterDescriptor) HasOptionalPointer() bool {
 	return true
 }
 
+// HasOptionalPointer returns true if this parameter should be a pointer
+// (optional parameters that aren't required).
+func (p *ParameterDescriptor) HasOptionalPointer() bool {
+	return p.IsOptional() && (p.OptionalAs == "" || p.OptionalAs == OptionalParamsPointer)
+}
+
+// FieldType returns the Go type of the field of this parameter in the Params
+// struct: TypeDecl, or the pointer or Nullable of it optional ones take.
+func (p *ParameterDescriptor) FieldType() string {
+	switch {
+	case p.HasOptionalPointer():
+		return "*" + p.TypeDecl
+	case p.IsOptional() && p.OptionalAs == OptionalParamsNullable:
+		return p.nullableType + "[" + p.TypeDecl + "]"
+	}
+	return p.TypeDecl
+}
+
+// HasFlag returns the name of the bool field flagging that this parameter is
+// set, beside the field of its value, for OptionalParamsValue, or "".
+func (p *ParameterDescriptor) HasFlag() string {
+	if p.IsOptional() && p.OptionalAs == OptionalParamsValue {
+		return "Has" + p.GoName
+	}
+	return ""
+}
+
+// FieldIsSet returns the Go expression reporting whether the field of this
+// optional parameter in the Params struct recv holds a value, such as
+// "params.Limit != nil".
+func (p *ParameterDescriptor) FieldIsSet(recv string) string {
+	field := recv + "." + p.GoName
+	switch {
+	case p.HasOptionalPointer():
+		return field + " != nil"
+	case p.HasFlag() != "":
+		return recv + "." + p.HasFlag()
+	}
+	return field + ".IsSpecified() && !" + field + ".IsNull()"
+}
+
+// FieldIsUnset returns the negation of FieldIsSet.
+func (p *ParameterDescriptor) FieldIsUnset(recv string) string {
+	field := recv + "." + p.GoName
+	switch {
+	case p.HasOptionalPointer():
+		return field + " == nil"
+	case p.HasFlag() != "":
+		return "!" + recv + "." + p.HasFlag()
+	}
+	return "!" + field + ".IsSpecified() || " + field + ".IsNull()"
+}
+
+// FieldValue returns the Go expression of the value of this parameter in the
+// Params struct recv, once it's known to be set: "*params.Limit".
+func (p *ParameterDescriptor) FieldValue(recv string) string {
+	field := recv + "." + p.GoName
+	switch {
+	case p.HasOptionalPointer():
+		return "*" + field
+	case p.IsOptional() && p.OptionalAs == OptionalParamsNullable:
+		return field + ".MustGet()"
+	}
+	return field
+}
+
+// SetField returns the Go statement setting this parameter in the Params
+// struct recv to value, an addressable expression of type TypeDecl:
+// "params.Limit = &value".
+func (p *ParameterDescriptor) SetField(recv, value string) string {
+	field := recv + "." + p.GoName
+	switch {
+	case p.HasOptionalPointer():
+		return field + " = &" + value
+	case p.HasFlag() != "":
+		return field + ", " + recv + "." + p.HasFlag() + " = " + value + ", true"
+	case p.IsOptional() && p.OptionalAs == OptionalParamsNullable:
+		return field + ".Set(" + value + ")"
+	}
+	return field + " = " + value
+}
+
+// BindsToPointer returns true if this styled query parameter is bound into a
+// pointer variable, named after it, before SetField stores it in its field,
+// which the binding functions can't set: optional parameters which aren't
+// pointers, but for exploded objects, bound by a method of the Params struct.
+func (p *ParameterDescriptor) BindsToPointer() bool {
+	return p.IsStyled && p.IsOptional() && !p.HasOptionalPointer() && len(p.Fields) == 0
+}
+
+// BindTarget returns the variable the styled query parameter is bound into:
+// its field in the Params struct recv, or the pointer BindsToPointer declares.
+func (p *ParameterDescriptor) BindTarget(recv string) string {
+	if p.BindsToPointer() {
+		return p.GoVariableName()
+	}
+	return recv + "." + p.GoName
+}
+
 // SchemaType returns the first OpenAPI type string for this parameter's schema
 // (e.g., "string", "integer", "array", "object"), or empty string if unavailable.
 func (p *ParameterDescriptor) SchemaType() string {
@@ -212,7 +468,10 @@ type RequestBodyDescriptor struct {
 	FuncSuffix string // "", "WithJSONBody", "WithFormBody" (empty for default)
 	IsDefault     bool // Is this the default body type?
 	IsFormEncoded bool // Is this application/x-www-form-urlencoded?
+	IsMultipart   bool // Is this a multipart/* body?
+	IsBinary      bool // Is this opaque bytes, sent from an io.Reader?
 	GenerateTyped bool // Generate typed methods for this body (based on content-types config)
+	ItemType   string // Go type of the itemSchema of a sequential media type, if any
 
 	// Encoding options for form data
 	Encoding map[string]RequestBodyEncoding
@@ -221,10 +480,51 @@ type RequestBodyDescriptor struct {
 // RequestBodyEncoding describes encoding options for a form field.
 type RequestBodyEncoding struct {
 	ContentType string
+	Headers     map[string]string // Part headers with a default value in their schema
 	Style       string
 	Explode     *bool
 }
 
+// EncodingLiteral renders the body's encoding options as a Go map literal of
+// the runtime FormEncoding type, or "nil" when none are declared. Explode is
+// resolved to its OpenAPI default when unset, and omitted for multipart bodies
+// where it has no effect.
+func (b *RequestBodyDescriptor) EncodingLiteral(helpersPrefix string) string {
+	if len(b.Encoding) == 0 {
+		return "nil"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "map[string]%sFormEncoding{\n", helpersPrefix)
+	for _, name := range slices.Sorted(maps.Keys(b.Encoding)) {
+		enc := b.Encoding[name]
+		var fields []string
+		if enc.ContentType != "" {
+			fields = append(fields, fmt.Sprintf("ContentType: %q", enc.ContentType))
+		}
+		if len(enc.Headers) > 0 {
+			var headers []string
+			for _, h := range slices.Sorted(maps.Keys(enc.Headers)) {
+				headers = append(headers, fmt.Sprintf("%q: %q", h, enc.Headers[h]))
+			}
+			fields = append(fields, "Headers: map[string]string{"+strings.Join(headers, ", ")+"}")
+		}
+		if enc.Style != "" {
+			fields = append(fields, fmt.Sprintf("Style: %q", enc.Style))
+		}
+		explode := enc.Style == "" || enc.Style == "form"
+		if enc.Explode != nil {
+			explode = *enc.Explode
+		}
+		if explode && !b.IsMultipart {
+			fields = append(fields, "Explode: true")
+		}
+		fmt.Fprintf(&sb, "%q: {%s},\n", name, strings.Join(fields, ", "))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
 // ResponseDescriptor describes a response for a status code.
 type ResponseDescriptor struct {
 	StatusCode  string // "200", "404", "default", "2XX"
@@ -257,6 +557,35 @@ type ResponseContentDescriptor struct {
 	Schema      *SchemaDescriptor
 	NameTag     string // "JSON", "XML", etc.
 	IsJSON      bool
+	ItemType    string // Go type of the itemSchema of a sequential media type, if any
+}
+
+// IsEventStream returns true for text/event-stream content.
+func (c *ResponseContentDescriptor) IsEventStream() bool {
+	return baseMediaType(c.ContentType) == "text/event-stream"
+}
+
+// IsSequential returns true for content streaming a sequence of items.
+func (c *ResponseContentDescriptor) IsSequential() bool {
+	return IsSequentialMediaType(c.ContentType)
+}
+
+// IsSequentialMediaType returns true if the content type streams a sequence
+// of items, described by the itemSchema of its media type: server-sent
+// events, JSON Lines or JSON text sequences.
+func IsSequentialMediaType(contentType string) bool {
+	switch baseMediaType(contentType) {
+	case "text/event-stream", "application/jsonl", "application/x-ndjson", "application/json-seq":
+		return true
+	}
+	return false
+}
+
+// baseMediaType returns the media type of a content type, lowercased and
+// without parameters.
+func baseMediaType(contentType string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.ToLower(strings.TrimSpace(mediaType))
 }
 
 // ResponseHeaderDescriptor describes a response header.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -13,9 +14,10 @@ import (
 
 // Output collects generated Go code and formats it.
 type Output struct {
-	packageName string
-	imports     map[string]string // path -> alias
-	types       []string          // type definitions in order
+	packageName     string
+	buildConstraint string            // //go:build expression, if any
+	imports         map[string]string // path -> alias
+	types           []string          // type definitions in order
 }
 
 // NewOutput creates a new output collector.
@@ -41,6 +43,12 @@ func (o *Output) AddImports(imports map[string]string) {
 	}
 }
 
+// SetBuildConstraint sets the //go:build expression of the file; an empty
+// one leaves the file unconstrained.
+func (o *Output) SetBuildConstraint(expr string) {
+	o.buildConstraint = expr
+}
+
 // AddType adds a type definition to the output.
 func (o *Output) AddType(code string) {
 	if code != "" {
@@ -55,6 +63,10 @@ func (o *Output) String() string {
 	// Generated code header (tells linters to skip this file)
 	buf.WriteString("// Code generated by oapi-codegen; DO NOT EDIT.\n\n")
 
+	if o.buildConstraint != "" {
+		fmt.Fprintf(&buf, "//go:build %s\n\n", o.buildConstraint)
+	}
+
 	// Package declaration
 	fmt.Fprintf(&buf, "package %s\n\n", o.packageName)
 
@@ -187,6 +199,7 @@ func generateFieldTag(f StructField, tagGen *StructTagGenerator) string {
 	info := StructTagInfo{
 		FieldName:  f.JSONName,
 		IsOptional: !f.Required,
+		Validate:   validateTag(f),
 	}
 
 	// All tags through the same template engine
@@ -196,11 +209,17 @@ func generateFieldTag(f StructField, tagGen *StructTagGenerator) string {
 	if f.JSONIgnore {
 		tags["json"] = "-"
 		tags["form"] = "-"
+		if _, ok := tags["yaml"]; ok {
+			tags["yaml"] = "-"
+		}
 	} else {
 		// OmitEmpty extension override: if extensions explicitly set OmitEmpty
 		// differently from the schema default (!f.Required), adjust tags.
 		if f.OmitEmpty != !f.Required {
-			applyOmitEmptyOverride(tags, f.JSONName, f.OmitEmpty, "json", "form")
+			applyOmitEmptyOverride(tags, f.JSONName, f.OmitEmpty, "json", "form", "yaml")
+		}
+		if f.JSONOmitZeroOnly {
+			applyOmitEmptyOverride(tags, f.JSONName, false, "json")
 		}
 		// OmitZero (json-specific)
 		if f.OmitZero {
@@ -299,11 +318,153 @@ func GenerateEnumFromInfo(info *EnumInfo) string {
 
 		b.Dedent()
 		b.Line(")")
+
+		indices := uniqueEnumValues(info)
+		generateEnumValues(b, info, indices)
+		if isIntegerType(info.BaseType) {
+			generateIntegerEnumMethods(b, info, indices)
+		} else {
+			generateStringEnumParse(b, info, indices)
+		}
+		if info.Const || info.Strict {
+			generateStrictEnumUnmarshal(b, info, indices)
+		}
 	}
 
 	return b.String()
 }
 
+// uniqueEnumValues returns the indices of the enum values not repeating an
+// earlier one, so that values repeated in the spec map to the first constant
+// holding them.
+func uniqueEnumValues(info *EnumInfo) []int {
+	seen := make(map[string]bool, len(info.Values))
+	var indices []int
+	for i, v := range info.Values {
+		if !seen[v] {
+			seen[v] = true
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// generateEnumValues writes the Values method of an enum, listing its
+// constants.
+func generateEnumValues(b *CodeBuilder, info *EnumInfo, indices []int) {
+	b.BlankLine()
+	b.Line("// Values returns the %s constants, in the order of the spec.", info.TypeName)
+	b.Line("func (%s) Values() []%s {", info.TypeName, info.TypeName)
+	b.Indent()
+	b.Line("return []%s{%s}", info.TypeName, enumCaseList(info, indices))
+	b.Dedent()
+	b.Line("}")
+}
+
+// generateStringEnumParse writes the Parse function of a string enum.
+func generateStringEnumParse(b *CodeBuilder, info *EnumInfo, indices []int) {
+	b.BlankLine()
+	b.Line("// Parse%s returns the %s constant whose value is s. Other strings", info.TypeName, info.TypeName)
+	b.Line("// return an error.")
+	b.Line("func Parse%s(s string) (%s, error) {", info.TypeName, info.TypeName)
+	b.Indent()
+	b.Line("switch v := %s(s); v {", info.TypeName)
+	b.Line("case %s:", enumCaseList(info, indices))
+	b.Indent()
+	b.Line("return v, nil")
+	b.Dedent()
+	b.Line("}")
+	b.Line("return \"\", fmt.Errorf(\"invalid %s value %%q\", s)", info.TypeName)
+	b.Dedent()
+	b.Line("}")
+}
+
+// generateStrictEnumUnmarshal writes an UnmarshalJSON method rejecting values
+// outside the enum, for strict enums and the types of const schemas.
+func generateStrictEnumUnmarshal(b *CodeBuilder, info *EnumInfo, indices []int) {
+	format := "%d"
+	if info.BaseType == "string" {
+		format = "%q"
+	}
+
+	b.BlankLine()
+	if len(indices) == 1 {
+		b.Line("// UnmarshalJSON implements json.Unmarshaler, rejecting values other")
+		b.Line("// than %s.", info.finalConstName(indices[0]))
+	} else {
+		b.Line("// UnmarshalJSON implements json.Unmarshaler, rejecting values not in")
+		b.Line("// the enum.")
+	}
+	b.Line("func (e *%s) UnmarshalJSON(data []byte) error {", info.TypeName)
+	b.Indent()
+	b.Line("var v %s", info.BaseType)
+	b.Line("if err := json.Unmarshal(data, &v); err != nil {")
+	b.Indent()
+	b.Line("return err")
+	b.Dedent()
+	b.Line("}")
+	b.Line("switch %s(v) {", info.TypeName)
+	b.Line("case %s:", enumCaseList(info, indices))
+	b.Indent()
+	b.Line("*e = %s(v)", info.TypeName)
+	b.Line("return nil")
+	b.Dedent()
+	b.Line("}")
+	b.Line("return fmt.Errorf(\"invalid %s value %s\", v)", info.TypeName, format)
+	b.Dedent()
+	b.Line("}")
+}
+
+// enumCaseList returns the constants of an enum, comma-separated as in a
+// switch case or a slice literal.
+func enumCaseList(info *EnumInfo, indices []int) string {
+	names := make([]string, len(indices))
+	for i, idx := range indices {
+		names[i] = info.finalConstName(idx)
+	}
+	return strings.Join(names, ", ")
+}
+
+// generateIntegerEnumMethods writes String and Parse functions for an integer
+// enum, so its values read as their constant names rather than bare numbers.
+func generateIntegerEnumMethods(b *CodeBuilder, info *EnumInfo, indices []int) {
+	b.BlankLine()
+	b.Line("// String returns the name of the %s constant equal to e, or the", info.TypeName)
+	b.Line("// type and number for values not in the enum.")
+	b.Line("func (e %s) String() string {", info.TypeName)
+	b.Indent()
+	b.Line("switch e {")
+	for _, i := range indices {
+		name := info.finalConstName(i)
+		b.Line("case %s:", name)
+		b.Indent()
+		b.Line("return %q", name)
+		b.Dedent()
+	}
+	b.Line("}")
+	b.Line("return fmt.Sprintf(\"%s(%%d)\", int(e))", info.TypeName)
+	b.Dedent()
+	b.Line("}")
+
+	b.BlankLine()
+	b.Line("// Parse%s returns the %s constant named s, as String writes it,", info.TypeName, info.TypeName)
+	b.Line("// or whose value s is in decimal. Other strings return an error.")
+	b.Line("func Parse%s(s string) (%s, error) {", info.TypeName, info.TypeName)
+	b.Indent()
+	b.Line("switch s {")
+	for _, i := range indices {
+		name := info.finalConstName(i)
+		b.Line("case %q, %q:", name, info.Values[i])
+		b.Indent()
+		b.Line("return %s, nil", name)
+		b.Dedent()
+	}
+	b.Line("}")
+	b.Line("return 0, fmt.Errorf(\"invalid %s value %%q\", s)", info.TypeName)
+	b.Dedent()
+	b.Line("}")
+}
+
 // UnionMember represents a member of a union type (anyOf/oneOf).
 type UnionMember struct {
 	TypeName            string   // Go type name (e.g., "Cat")
@@ -311,6 +472,8 @@ type UnionMember struct {
 	Index               int      // Position in anyOf/oneOf array
 	HasApplyDefaults    bool     // Whether this type has an ApplyDefaults method
 	DiscriminatorValues []string // Discriminator mapping keys for this variant (empty if unmapped)
+	DefinesMethods      bool     // Whether this type is a named struct in this package
+	RequiredProperties  []string // Properties the member's schema requires
 }
 
 // UnionTypeConfig holds all information needed to generate a union type.
@@ -324,6 +487,7 @@ type UnionTypeConfig struct {
 	TagGen        *StructTagGenerator
 	HelperPrefix  string         // e.g., "oapiCodegenHelpersPkg." or "" for embedded
 	Converter     *NameConverter // for converting JSON property names to Go field names
+	JSONv2        bool           // Whether to add the methods of encoding/json/v2
 }
 
 // hasFixedField returns true if the given JSON field name is among the fixed fields.
@@ -344,6 +508,7 @@ type unionTemplateFixedField struct {
 	Tag      string // Full struct tag string
 	Doc      string // Doc comment
 	Required bool   // Whether the field is required
+	Optional bool   // Whether the field is an Optional[T] in place of a pointer
 }
 
 // unionTemplateMember is a pre-computed member for the union template.
@@ -351,6 +516,7 @@ type unionTemplateMember struct {
 	TypeName             string // Go type name
 	MethodName           string // Suffix for As/From/Merge
 	DiscriminatorAutoSet string // Pre-computed auto-set line (e.g., `v.AuthType = "none"`) or empty
+	RequiredProperties   string // Quoted required property names (e.g., `"id", "name"`) or empty
 }
 
 // unionTemplateDiscEntry is a discriminator value → method mapping for ValueByDiscriminator.
@@ -364,10 +530,14 @@ type unionTemplateData struct {
 	TypeName             string
 	Doc                  string
 	HelperPrefix         string
+	AnyOf                bool // Whether to record the members the data matches
 	FixedFields          []unionTemplateFixedField
 	Members              []unionTemplateMember
 	Discriminator        *DiscriminatorInfo
 	DiscriminatorEntries []unionTemplateDiscEntry
+	Variant              string                // Name of the variant interface, or empty
+	VariantMembers       []unionTemplateMember // Distinct members implementing Variant
+	JSONv2               bool
 }
 
 // GenerateUnionCode generates all code for a union type using the union template.
@@ -398,6 +568,20 @@ func GenerateUnionCode(cfg UnionTypeConfig) (string, error) {
 		}
 	}
 
+	// Matches of an anyOf
+	if data.AnyOf {
+		if err := tmpl.ExecuteTemplate(&buf, "union_any_of", data); err != nil {
+			return "", fmt.Errorf("executing union_any_of: %w", err)
+		}
+	}
+
+	// Variant interface, implemented by the members
+	if data.Variant != "" {
+		if err := tmpl.ExecuteTemplate(&buf, "union_variant", data); err != nil {
+			return "", fmt.Errorf("executing union_variant: %w", err)
+		}
+	}
+
 	// Marshal/Unmarshal
 	if len(data.FixedFields) > 0 {
 		if err := tmpl.ExecuteTemplate(&buf, "union_marshal_fixed_fields", data); err != nil {
@@ -409,6 +593,17 @@ func GenerateUnionCode(cfg UnionTypeConfig) (string, error) {
 		}
 	}
 
+	// encoding/json/v2 Marshal/Unmarshal
+	if data.JSONv2 {
+		name := "union_marshal_jsonv2_simple"
+		if len(data.FixedFields) > 0 {
+			name = "union_marshal_jsonv2_fixed_fields"
+		}
+		if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", fmt.Errorf("executing %s: %w", name, err)
+		}
+	}
+
 	// ApplyDefaults
 	if err := tmpl.ExecuteTemplate(&buf, "union_apply_defaults", data); err != nil {
 		return "", fmt.Errorf("executing union_apply_defaults: %w", err)
@@ -429,6 +624,7 @@ func buildUnionTemplateData(cfg UnionTypeConfig) unionTemplateData {
 			Tag:      generateFieldTag(f, cfg.TagGen),
 			Doc:      f.Doc,
 			Required: f.Required,
+			Optional: f.Optional,
 		})
 	}
 
@@ -440,6 +636,13 @@ func buildUnionTemplateData(cfg UnionTypeConfig) unionTemplateData {
 			TypeName:   m.TypeName,
 			MethodName: m.MethodName,
 		}
+		if len(m.RequiredProperties) > 0 {
+			quoted := make([]string, len(m.RequiredProperties))
+			for i, name := range m.RequiredProperties {
+				quoted[i] = strconv.Quote(name)
+			}
+			tm.RequiredProperties = strings.Join(quoted, ", ")
+		}
 		if allMapped && len(m.DiscriminatorValues) > 0 {
 			tm.DiscriminatorAutoSet = computeDiscriminatorAutoSet(cfg, m)
 		}
@@ -454,17 +657,47 @@ func buildUnionTemplateData(cfg UnionTypeConfig) unionTemplateData {
 		}
 	}
 
+	// A discriminator which maps to every member, each a struct declared
+	// here, lets the members implement a sealed interface.
+	var variant string
+	var variantMembers []unionTemplateMember
+	if allMapped && allMembersDefineMethods(cfg) {
+		variant = cfg.TypeName + "Variant"
+		seen := make(map[string]bool)
+		for _, m := range members {
+			if !seen[m.TypeName] {
+				seen[m.TypeName] = true
+				variantMembers = append(variantMembers, m)
+			}
+		}
+	}
+
 	return unionTemplateData{
 		TypeName:             cfg.TypeName,
 		Doc:                  cfg.Doc,
 		HelperPrefix:         cfg.HelperPrefix,
+		AnyOf:                !cfg.IsOneOf,
 		FixedFields:          fixedFields,
 		Members:              members,
 		Discriminator:        cfg.Discriminator,
 		DiscriminatorEntries: entries,
+		Variant:              variant,
+		VariantMembers:       variantMembers,
+		JSONv2:               cfg.JSONv2,
 	}
 }
 
+// allMembersDefineMethods returns true if every union member is a named struct
+// type declared in the generated package.
+func allMembersDefineMethods(cfg UnionTypeConfig) bool {
+	for _, m := range cfg.Members {
+		if !m.DefinesMethods {
+			return false
+		}
+	}
+	return true
+}
+
 // allMembersMapped returns true if every union member has at least one discriminator mapping value.
 func allMembersMapped(cfg UnionTypeConfig) bool {
 	if cfg.Discriminator == nil {
@@ -3,7 +3,9 @@ package codegen
 import (
 	"testing"
 
+	"github.com/pb33f/libopenapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDefaultParamStyle(t *testing.T) {
@@ -89,3 +91,135 @@ func TestValidateParamStyle(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestParameterFieldOptionalAs(t *testing.T) {
+	tests := []struct {
+		optionalAs string
+		fieldType  string
+		isSet      string
+		value      string
+		set        string
+	}{
+		{"", "*int32", "params.Limit != nil", "*params.Limit", "params.Limit = &v"},
+		{OptionalParamsPointer, "*int32", "params.Limit != nil", "*params.Limit", "params.Limit = &v"},
+		{OptionalParamsNullable, "types.Nullable[int32]", "params.Limit.IsSpecified() && !params.Limit.IsNull()", "params.Limit.MustGet()", "params.Limit.Set(v)"},
+		{OptionalParamsValue, "int32", "params.HasLimit", "params.Limit", "params.Limit, params.HasLimit = v, true"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.optionalAs, func(t *testing.T) {
+			p := &ParameterDescriptor{GoName: "Limit", TypeDecl: "int32", IsStyled: true, OptionalAs: tc.optionalAs, nullableType: "types.Nullable"}
+			assert.Equal(t, tc.fieldType, p.FieldType())
+			assert.Equal(t, tc.isSet, p.FieldIsSet("params"))
+			assert.Equal(t, tc.value, p.FieldValue("params"))
+			assert.Equal(t, tc.set, p.SetField("params", "v"))
+			assert.Equal(t, tc.optionalAs == OptionalParamsNullable || tc.optionalAs == OptionalParamsValue, p.BindsToPointer())
+		})
+	}
+
+	// Required parameters are held as values, whatever the option.
+	p := &ParameterDescriptor{GoName: "Limit", TypeDecl: "int32", Required: true, OptionalAs: OptionalParamsNullable}
+	assert.Equal(t, "int32", p.FieldType())
+	assert.Equal(t, "params.Limit = v", p.SetField("params", "v"))
+	assert.Empty(t, p.HasFlag())
+}
+
+// gatherParams gathers the operations of spec, returning the query parameters
+// of its first one and the warnings reported.
+func gatherParams(t *testing.T, spec string) ([]*ParameterDescriptor, []Diagnostic) {
+	t.Helper()
+	doc, err := libopenapi.NewDocument([]byte(spec))
+	require.NoError(t, err)
+	model, err := doc.BuildV3Model()
+	require.NoError(t, err)
+	ctx := NewCodegenContext()
+	ctx.CollectDiagnostics(doc)
+	ops, err := GatherOperations(&model.Model, ctx, NewContentTypeMatcher(DefaultContentTypes()), DefaultTypeMapping)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	return ops[0].QueryParams, ctx.Diagnostics()
+}
+
+func TestParamConstraints_IntegerBounds(t *testing.T) {
+	tests := []struct {
+		format string
+		bounds string
+		want   string
+	}{
+		// Bounds every value of the type meets are dropped.
+		{"int8", "minimum: -200, maximum: 200", ""},
+		{"int16", "minimum: -40000, maximum: 40000", ""},
+		{"int32", "minimum: -3000000000, maximum: 3000000000", ""},
+		{"int64", "minimum: -9223372036854775809, exclusiveMaximum: 9223372036854775808", ""},
+		{"uint8", "minimum: -1, maximum: 300", ""},
+		{"uint16", "minimum: -1, maximum: 70000", ""},
+		{"uint32", "minimum: -1, maximum: 5000000000", ""},
+		{"uint64", "minimum: -1, maximum: 18446744073709551616", ""},
+		// Bounds no value meets are clamped to the range, exclusive.
+		{"int8", "minimum: 200", "ParamMinimum[int8](127, true)"},
+		{"int16", "maximum: -40000", "ParamMaximum[int16](-32768, true)"},
+		{"int32", "minimum: 3000000000", "ParamMinimum[int32](2147483647, true)"},
+		{"int64", "maximum: -9223372036854775809", "ParamMaximum[int64](-9223372036854775808, true)"},
+		{"uint8", "minimum: 300", "ParamMinimum[uint8](255, true)"},
+		{"uint16", "maximum: -1", "ParamMaximum[uint16](0, true)"},
+		{"uint32", "minimum: 5000000000", "ParamMinimum[uint32](4294967295, true)"},
+		{"uint64", "maximum: -1", "ParamMaximum[uint64](0, true)"},
+		// The bounds of the range are written exactly, not through float64.
+		{"int64", "minimum: -9223372036854775808, maximum: 9223372036854775807",
+			"ParamMinimum[int64](-9223372036854775808, false), ParamMaximum[int64](9223372036854775807, false)"},
+		{"uint64", "exclusiveMaximum: 18446744073709551615", "ParamMaximum[uint64](18446744073709551615, true)"},
+		// Fractional bounds are rounded into the values they allow.
+		{"int32", "exclusiveMinimum: 1.5, maximum: -0.5", "ParamMinimum[int32](2, false), ParamMaximum[int32](-1, false)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format+" "+tc.bounds, func(t *testing.T) {
+			params, _ := gatherParams(t, `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /items:
+    get:
+      operationId: getItems
+      parameters:
+        - name: limit
+          in: query
+          schema: {type: integer, format: `+tc.format+`, `+tc.bounds+`}
+      responses:
+        "200":
+          description: ok
+`)
+			require.Len(t, params, 1)
+			assert.Equal(t, tc.want, params[0].Constraints)
+		})
+	}
+}
+
+func TestParamConstraints_UncompilablePattern(t *testing.T) {
+	params, diagnostics := gatherParams(t, `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /items:
+    get:
+      operationId: getItems
+      parameters:
+        - name: code
+          in: query
+          schema:
+            type: string
+            maxLength: 6
+            pattern: '^\p{Lu}(?=x)$'
+      responses:
+        "200":
+          description: ok
+`)
+	require.Len(t, params, 1)
+	assert.Equal(t, "ParamMaxLength[string](6)", params[0].Constraints)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+	assert.Equal(t, "#/paths/~1items/get/parameters/0/schema/pattern", diagnostics[0].Pointer)
+	assert.Contains(t, diagnostics[0].Message, `pattern "^\\p{Lu}(?=x)$" isn't checked`)
+}
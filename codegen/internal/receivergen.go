@@ -3,6 +3,8 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -16,6 +18,14 @@ type ReceiverTemplateData struct {
 	Operations  []*OperationDescriptor // Operations to generate for
 }
 
+// CallbackSubscriptionData is passed to the callback_subscriptions template.
+type CallbackSubscriptionData struct {
+	Op         *OperationDescriptor
+	Expression string // Runtime expression of the callback URL: {$request.body#/callbackUrl}
+	BodyType   string // Go type of the JSON request body, if any
+	Status     int    // Status callbacks are answered with
+}
+
 // ReceiverGenerator generates receiver code from operation descriptors.
 // It is parameterized by prefix to support both webhooks and callbacks.
 type ReceiverGenerator struct {
@@ -116,3 +126,42 @@ func (g *ReceiverGenerator) GenerateErrors() (string, error) {
 	}
 	return buf.String(), nil
 }
+
+// callbackURLExpression matches callback URLs which are a single runtime
+// expression, so the client supplies the whole URL.
+var callbackURLExpression = regexp.MustCompile(`^\{\$[^{}]+\}$`)
+
+// GenerateCallbackSubscriptions generates CallbackSubscriptions for the
+// callbacks whose URL the client supplies, for server types whose receiver
+// handlers are net/http handlers. It returns "" otherwise.
+func (g *ReceiverGenerator) GenerateCallbackSubscriptions(ops []*OperationDescriptor) (string, error) {
+	if g.tmpl.Lookup("callback_subscriptions") == nil {
+		return "", nil
+	}
+	var data []CallbackSubscriptionData
+	for _, op := range ops {
+		if !callbackURLExpression.MatchString(op.Path) {
+			continue
+		}
+		d := CallbackSubscriptionData{Op: op, Expression: op.Path, Status: 200}
+		if body := op.DefaultTypedBody(); body != nil && IsMediaTypeJSON(body.ContentType) {
+			d.BodyType = body.GoTypeName
+		}
+		for _, r := range op.Responses {
+			if status, err := strconv.Atoi(r.StatusCode); err == nil && status >= 200 && status < 300 {
+				d.Status = status
+				break
+			}
+		}
+		data = append(data, d)
+	}
+	if len(data) == 0 {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "callback_subscriptions", data); err != nil {
+		return "", fmt.Errorf("generating callback subscriptions: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,109 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// componentRoot returns the ref of the component schema containing the schema
+// at path, or "" if it isn't in one.
+func componentRoot(path SchemaPath) string {
+	if len(path) < 3 || path[0] != "components" || path[1] != "schemas" {
+		return ""
+	}
+	return path[:3].String()
+}
+
+// reachesSchema reports whether the schema at ref leads back to the component
+// schema root through $refs, directly or through other schemas. A field of
+// root holding such a schema by value would make root's type recursive, so
+// it's held by pointer instead.
+func (g *TypeGenerator) reachesSchema(ref, root string) bool {
+	if root == "" {
+		return false
+	}
+	visited := make(map[string]bool)
+	var reaches func(ref string) bool
+	reaches = func(ref string) bool {
+		if ref == root {
+			return true
+		}
+		if visited[ref] {
+			return false
+		}
+		visited[ref] = true
+		for _, next := range g.schemaRefs(ref) {
+			if reaches(next) {
+				return true
+			}
+		}
+		return false
+	}
+	return reaches(ref)
+}
+
+// schemaRefs returns the internal $refs the schema at ref holds, in its
+// properties, items, compositions and so on, without following them. They're
+// collected once per schema.
+func (g *TypeGenerator) schemaRefs(ref string) []string {
+	if refs, ok := g.refGraph[ref]; ok {
+		return refs
+	}
+	var refs []string
+	desc, ok := g.schemaIndex[ref]
+	if !ok {
+		return nil
+	}
+	seen := make(map[string]bool)
+	add := func(ref string) {
+		if strings.HasPrefix(ref, "#/") && !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	if desc.Ref != "" {
+		add(desc.Ref)
+	} else {
+		collectSchemaRefs(desc.Schema, add, make(map[*base.Schema]bool))
+	}
+	g.refGraph[ref] = refs
+	return refs
+}
+
+// collectSchemaRefs calls add with the $ref of each reference within schema,
+// descending into inline schemas but not into the references.
+func collectSchemaRefs(schema *base.Schema, add func(string), visited map[*base.Schema]bool) {
+	if schema == nil || visited[schema] {
+		return
+	}
+	visited[schema] = true
+
+	visit := func(proxy *base.SchemaProxy) {
+		if proxy == nil {
+			return
+		}
+		if proxy.IsReference() {
+			add(proxy.GetReference())
+			return
+		}
+		collectSchemaRefs(proxy.Schema(), add, visited)
+	}
+	if schema.Properties != nil {
+		for pair := schema.Properties.First(); pair != nil; pair = pair.Next() {
+			visit(pair.Value())
+		}
+	}
+	if schema.Items != nil {
+		visit(schema.Items.A)
+	}
+	if schema.AdditionalProperties != nil {
+		visit(schema.AdditionalProperties.A)
+	}
+	for _, parts := range [][]*base.SchemaProxy{schema.PrefixItems, schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, proxy := range parts {
+			visit(proxy)
+		}
+	}
+	visit(schema.Not)
+}
@@ -5,6 +5,49 @@ import (
 	"strings"
 )
 
+// disambiguateNames numbers apart the Go names which collide, such as those of
+// "id" and "ID", as deduplicateNames does, and reports each renaming to ctx
+// with the original name it was generated from. kind says what is named, and
+// where the part of the spec declaring the i-th name.
+func disambiguateNames(ctx *CodegenContext, kind string, where func(i int) SchemaPath, originals, names []string) []string {
+	result := deduplicateNames(names)
+	for i, name := range result {
+		if name != names[i] {
+			ctx.Warn(where(i), "renamed %s %q to %s, as its Go name collides with another", kind, originals[i], name)
+		}
+	}
+	return result
+}
+
+// NameCollisions configures how schemas whose generated type names collide
+// are told apart.
+type NameCollisions struct {
+	// Strategy is how colliding names are renamed, one of the NameCollision
+	// constants. Empty means NameCollisionSection.
+	Strategy string `yaml:"strategy,omitempty"`
+	// Pinned maps the JSON pointers of schemas, such as
+	// "#/paths/~1pets/get/responses/200/content/application~1json/schema",
+	// to type names they keep whatever they collide with; the schemas they
+	// collide with are renamed instead.
+	Pinned map[string]string `yaml:"pinned,omitempty"`
+}
+
+// Name collision strategies.
+const (
+	// NameCollisionSection suffixes colliding names with the section of the
+	// spec their schemas are in, such as Request or Response, leaving a
+	// single component schema its name, then tells them apart by content
+	// type, status code and position.
+	NameCollisionSection = "section"
+	// NameCollisionOperationID suffixes the colliding names of schemas in
+	// operations with their operationId first, then goes on as
+	// NameCollisionSection.
+	NameCollisionOperationID = "operation-id"
+	// NameCollisionError fails generation on colliding names, which pinning
+	// names resolves.
+	NameCollisionError = "error"
+)
+
 // schemaContextSuffix maps a SchemaContext to a disambiguation suffix.
 func schemaContextSuffix(ctx SchemaContext) string {
 	switch ctx {
@@ -55,71 +98,114 @@ var disambiguationStrategies = []disambiguationStrategy{
 }
 
 // collisionStrategies is the ordered list of group-level strategies tried by
-// resolveCollisions for each conflicting bucket.
-var collisionStrategies = []collisionGroupStrategy{
-	strategyContextSuffix,
-	strategyPerSchemaDisambiguate,
-	strategyNumericFallback,
+// resolveCollisions for each conflicting bucket, by NameCollision strategy.
+var collisionStrategies = map[string][]collisionGroupStrategy{
+	NameCollisionSection: {
+		strategyContextSuffix,
+		strategyPerSchemaDisambiguate,
+		strategyNumericFallback,
+	},
+	NameCollisionOperationID: {
+		strategyOperationIDSuffix,
+		strategyContextSuffix,
+		strategyPerSchemaDisambiguate,
+		strategyNumericFallback,
+	},
 }
 
 // resolveCollisions detects name collisions and makes them unique.
-// Reference schemas are excluded from collision detection because they don't
-// generate types — their names are only used for type resolution lookups.
+// Reference and anonymous schemas are excluded from collision detection
+// because they don't generate types — the names of references are only used
+// for type resolution lookups.
 //
 // Resolution proceeds by trying one strategy at a time across all conflicting
 // buckets, then re-bucketing. When a strategy makes no progress (no name
 // changes across any bucket), the next strategy in the list is tried. The
-// strategy list (collisionStrategies) is:
+// strategy list (collisionStrategies) of NameCollisionSection is:
 //  1. Context suffix — append a suffix derived from the schema's location.
 //  2. Per-schema disambiguation — content type, status code, param index,
 //     composition type, with numeric fallback per schema.
 //  3. Numeric fallback — unconditionally append i+1 to every member.
-func resolveCollisions(schemas []*SchemaDescriptor, candidates map[*SchemaDescriptor]string, converter *NameConverter) {
+//
+// NameCollisionOperationID tries an operationId suffix first. Schemas pinned
+// by collisions keep their names, the others of their buckets being renamed;
+// it's an error for pinned names to collide, and for any name to collide with
+// NameCollisionError.
+func resolveCollisions(schemas []*SchemaDescriptor, candidates map[*SchemaDescriptor]string, converter *NameConverter, collisions NameCollisions) error {
 	// Filter out reference schemas — they don't generate types so their
 	// short names can safely shadow non-ref names without causing a collision.
 	var nonRefSchemas []*SchemaDescriptor
+	pinned := make(map[*SchemaDescriptor]bool)
 	for _, s := range schemas {
-		if s.Ref == "" {
+		if s.Ref == "" && !s.Anonymous {
 			nonRefSchemas = append(nonRefSchemas, s)
+			if _, ok := collisions.Pinned[jsonPointer(s.Path)]; ok {
+				pinned[s] = true
+			}
 		}
 	}
 
+	strategy := collisions.Strategy
+	if strategy == "" {
+		strategy = NameCollisionSection
+	}
+	strategies := collisionStrategies[strategy]
+
 	maxIterations := 10 // Prevent infinite loops
 	strategyIdx := 0
 
 	for range maxIterations {
-		// Group non-ref schemas by candidate name
+		// Group non-ref schemas by candidate name, in the order of the
+		// schemas so that errors are reported deterministically
 		byName := make(map[string][]*SchemaDescriptor)
+		var names []string
 		for _, s := range nonRefSchemas {
 			name := candidates[s]
+			if byName[name] == nil {
+				names = append(names, name)
+			}
 			byName[name] = append(byName[name], s)
 		}
 
 		// Check if there are any collisions
 		hasCollisions := false
-		for _, group := range byName {
-			if len(group) > 1 {
-				hasCollisions = true
-				break
+		for _, name := range names {
+			group := byName[name]
+			if len(group) <= 1 {
+				continue
+			}
+			hasCollisions = true
+			movable := unpinnedSchemas(group, pinned)
+			if strategy == NameCollisionError || len(movable) == 0 {
+				// Report it at a schema whose name may be pinned
+				s, other := group[1], group[0]
+				if len(movable) > 0 && movable[0] == group[0] {
+					s, other = group[0], group[1]
+				} else if len(movable) > 0 {
+					s = movable[0]
+				}
+				return errorAt(s.Path, fmt.Errorf("type name %s of %s collides with that of %s", name, jsonPointer(s.Path), jsonPointer(other.Path)))
 			}
 		}
 
 		if !hasCollisions {
-			return // All names are unique
+			return nil // All names are unique
 		}
 
-		if strategyIdx >= len(collisionStrategies) {
-			return // Exhausted all strategies
+		if strategyIdx >= len(strategies) {
+			return nil // Exhausted all strategies
 		}
 
-		// Apply the current strategy to all conflicting buckets.
-		strategy := collisionStrategies[strategyIdx]
+		// Apply the current strategy to all conflicting buckets, renaming
+		// the schemas which aren't pinned.
+		apply := strategies[strategyIdx]
 		anyChanged := false
-		for _, group := range byName {
+		for _, name := range names {
+			group := byName[name]
 			if len(group) <= 1 {
 				continue // No collision
 			}
-			if strategy(group, candidates, converter) {
+			if apply(unpinnedSchemas(group, pinned), candidates, converter) {
 				anyChanged = true
 			}
 		}
@@ -131,6 +217,18 @@ func resolveCollisions(schemas []*SchemaDescriptor, candidates map[*SchemaDescri
 			strategyIdx++
 		}
 	}
+	return nil
+}
+
+// unpinnedSchemas returns the schemas of group which aren't pinned.
+func unpinnedSchemas(group []*SchemaDescriptor, pinned map[*SchemaDescriptor]bool) []*SchemaDescriptor {
+	var unpinned []*SchemaDescriptor
+	for _, s := range group {
+		if !pinned[s] {
+			unpinned = append(unpinned, s)
+		}
+	}
+	return unpinned
 }
 
 // strategyContextSuffix attempts to disambiguate colliding schemas by
@@ -174,6 +272,25 @@ func strategyContextSuffix(group []*SchemaDescriptor, candidates map[*SchemaDesc
 	return changed
 }
 
+// strategyOperationIDSuffix disambiguates colliding schemas of operations by
+// appending the operationId of their operation, unless their names hold it
+// already, as those of request and response bodies do. Other schemas are
+// left to later strategies.
+func strategyOperationIDSuffix(group []*SchemaDescriptor, candidates map[*SchemaDescriptor]string, converter *NameConverter) bool {
+	changed := false
+	for _, s := range group {
+		if s.OperationID == "" {
+			continue
+		}
+		suffix := converter.ToTypeName(s.OperationID)
+		if name := candidates[s]; !strings.Contains(name, suffix) {
+			candidates[s] = name + suffix
+			changed = true
+		}
+	}
+	return changed
+}
+
 // strategyPerSchemaDisambiguate tries per-schema sub-strategies
 // (disambiguationStrategies) in order for each member of the group. If no
 // sub-strategy matches a given schema, it falls back to a numeric suffix
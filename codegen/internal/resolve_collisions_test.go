@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const collidingSpec = `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  name:
+                    type: string
+components:
+  schemas:
+    ListPetsJSONResponse:
+      type: object
+      properties:
+        total:
+          type: integer
+`
+
+const listPetsResponse = "#/paths/~1pets/get/responses/200/content/application~1json/schema"
+
+func TestNameCollisions_Error(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(collidingSpec))
+	require.NoError(t, err)
+
+	_, diagnostics, err := GenerateWithDiagnostics(doc, []byte(collidingSpec), Configuration{
+		PackageName:    "api",
+		NameCollisions: NameCollisions{Strategy: NameCollisionError},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "type name ListPetsJSONResponse of #/components/schemas/ListPetsJSONResponse collides with that of "+listPetsResponse)
+	require.NotEmpty(t, diagnostics)
+	d := diagnostics[len(diagnostics)-1]
+	assert.Equal(t, SeverityError, d.Severity)
+	assert.Equal(t, "#/components/schemas/ListPetsJSONResponse", d.Pointer)
+	assert.Equal(t, 21, d.Line)
+
+	// Pinning either name resolves it
+	code, err := Generate(doc, nil, Configuration{
+		PackageName: "api",
+		NameCollisions: NameCollisions{
+			Strategy: NameCollisionError,
+			Pinned:   map[string]string{listPetsResponse: "PetPage"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type ListPetsJSONResponse struct")
+	assert.Contains(t, code, "type PetPage struct")
+}
+
+func TestNameCollisions_Pinned(t *testing.T) {
+	doc, err := libopenapi.NewDocument([]byte(collidingSpec))
+	require.NoError(t, err)
+
+	// The schema the pinned name collides with is renamed
+	code, diagnostics, err := GenerateWithDiagnostics(doc, []byte(collidingSpec), Configuration{
+		PackageName: "api",
+		NameCollisions: NameCollisions{Pinned: map[string]string{
+			listPetsResponse:              "ListPetsJSONResponse",
+			"#/components/schemas/Absent": "Absent",
+		}},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type ListPetsJSONResponse struct {\n\tName *string")
+	assert.NotContains(t, code, "type ListPetsJSONResponse struct {\n\tTotal")
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, "ignoring name-collisions name Absent pinned for #/components/schemas/Absent, which has no type", diagnostics[0].Message)
+
+	// Pinned names can't collide
+	_, err = Generate(doc, nil, Configuration{
+		PackageName: "api",
+		NameCollisions: NameCollisions{Pinned: map[string]string{
+			listPetsResponse: "Pets",
+			"#/components/schemas/ListPetsJSONResponse": "Pets",
+		}},
+	})
+	assert.ErrorContains(t, err, "type name Pets of")
+
+	_, err = Generate(doc, nil, Configuration{
+		PackageName:    "api",
+		NameCollisions: NameCollisions{Strategy: "suffix"},
+	})
+	assert.EqualError(t, err, `unknown name-collisions strategy "suffix": want "section", "operation-id" or "error"`)
+}
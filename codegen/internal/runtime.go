@@ -10,12 +10,14 @@ import (
 
 // RuntimeOutput holds the generated Go source code for each runtime sub-package.
 type RuntimeOutput struct {
-	Params  string // params sub-package (style/bind functions, helpers)
-	Types   string // types sub-package (Date, Email, UUID, File, Nullable)
-	Helpers string // helpers sub-package (MarshalForm)
+	Params      string // params sub-package (style/bind functions, helpers)
+	Types       string // types sub-package (Base64Bytes, BigInt, Date, DateTime, DateTimeMillis, Time, Decimal, Duration, Email, StrictEmail, UUID, UUIDString, URI, IPv4, IPv6, File, Nullable, Optional, Set)
+	TypesJSONv2 string // types sub-package methods for encoding/json/v2, built with Go 1.27 and GOEXPERIMENT=jsonv2 (Date, Nullable)
+	Helpers     string // helpers sub-package (MarshalForm, MarshalDeepObject)
+	JSONPointer string // jsonpointer sub-package (DecodeJSON, FormatPointer)
 }
 
-// GenerateRuntime produces standalone Go source files for each of the three
+// GenerateRuntime produces standalone Go source files for each of the
 // runtime sub-packages. baseImportPath is the base import path for the runtime
 // module (e.g., "github.com/org/project/runtime"). The params sub-package
 // imports the types sub-package for Date references.
@@ -24,37 +26,51 @@ func GenerateRuntime(baseImportPath string) (*RuntimeOutput, error) {
 		return nil, fmt.Errorf("base import path is required")
 	}
 
-	typesCode, err := generateRuntimePackage("types", "types", baseImportPath)
+	typesCode, err := generateRuntimePackage("types", "types", baseImportPath, "")
 	if err != nil {
 		return nil, fmt.Errorf("generating runtime types: %w", err)
 	}
 
-	paramsCode, err := generateRuntimePackage("params", "params", baseImportPath)
+	typesJSONv2Code, err := generateRuntimePackage("types", "types", baseImportPath, runtimeextract.JSONv2Constraint)
+	if err != nil {
+		return nil, fmt.Errorf("generating runtime types for encoding/json/v2: %w", err)
+	}
+
+	paramsCode, err := generateRuntimePackage("params", "params", baseImportPath, "")
 	if err != nil {
 		return nil, fmt.Errorf("generating runtime params: %w", err)
 	}
 
-	helpersCode, err := generateRuntimePackage("helpers", "helpers", baseImportPath)
+	helpersCode, err := generateRuntimePackage("helpers", "helpers", baseImportPath, "")
 	if err != nil {
 		return nil, fmt.Errorf("generating runtime helpers: %w", err)
 	}
 
+	jsonPointerCode, err := generateRuntimePackage("jsonpointer", "jsonpointer", baseImportPath, "")
+	if err != nil {
+		return nil, fmt.Errorf("generating runtime jsonpointer: %w", err)
+	}
+
 	return &RuntimeOutput{
-		Params:  paramsCode,
-		Types:   typesCode,
-		Helpers: helpersCode,
+		Params:      paramsCode,
+		Types:       typesCode,
+		TypesJSONv2: typesJSONv2Code,
+		Helpers:     helpersCode,
+		JSONPointer: jsonPointerCode,
 	}, nil
 }
 
 // generateRuntimePackage produces a standalone Go source file for one runtime
-// sub-package by extracting annotated code from the embedded runtime sources.
-func generateRuntimePackage(dir, packageName, baseImportPath string) (string, error) {
-	code, imports, err := runtimeextract.ExtractPackage(runtime.SourceFS, dir)
+// sub-package by extracting annotated code from the embedded runtime sources,
+// those with the build constraint given, which the file then has too.
+func generateRuntimePackage(dir, packageName, baseImportPath, constraint string) (string, error) {
+	code, imports, err := runtimeextract.ExtractPackage(runtime.SourceFS, dir, constraint)
 	if err != nil {
 		return "", fmt.Errorf("extracting %s: %w", dir, err)
 	}
 
 	output := NewOutput(packageName)
+	output.SetBuildConstraint(constraint)
 	output.AddType(code)
 
 	for _, imp := range imports {
@@ -8,5 +8,5 @@ import "embed"
 // users importing the public runtime sub-packages don't pay the cost
 // of embedding the source files.
 //
-//go:embed types/*.go params/*.go helpers/*.go
+//go:embed types/*.go params/*.go helpers/*.go jsonpointer/*.go
 var SourceFS embed.FS
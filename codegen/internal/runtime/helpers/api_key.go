@@ -0,0 +1,143 @@
+package helpers
+
+//oapi-runtime:function helpers/APIKeyProvider
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// APIKeyProvider provides the API key of each request, so that keys can be
+// rotated without recreating clients. It must be safe for concurrent use.
+type APIKeyProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticAPIKey is an APIKeyProvider of a key which never changes.
+type StaticAPIKey string
+
+// APIKey returns k.
+func (k StaticAPIKey) APIKey(context.Context) (string, error) {
+	return string(k), nil
+}
+
+// APIKeyFunc adapts a function to an APIKeyProvider.
+type APIKeyFunc func(ctx context.Context) (string, error)
+
+// APIKey returns f(ctx).
+func (f APIKeyFunc) APIKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// SetAPIKey sets key as the API key called name of req, in its "header",
+// "query" or "cookie", as the in field of apiKey security schemes says.
+func SetAPIKey(req *http.Request, in, name, key string) error {
+	switch in {
+	case "header":
+		req.Header.Set(name, key)
+	case "query":
+		query := req.URL.Query()
+		query.Set(name, key)
+		req.URL.RawQuery = query.Encode()
+	case "cookie":
+		req.AddCookie(&http.Cookie{Name: name, Value: key})
+	default:
+		return fmt.Errorf("api key %s: unsupported location %q", name, in)
+	}
+	return nil
+}
+
+// RefreshingAPIKey is an APIKeyProvider of keys which expire. It caches the
+// key Fetch returns, and fetches the next one in the background ahead of its
+// expiry, so that requests don't wait for rotations. Refreshes start
+// RefreshAhead plus a random part of Jitter before expiry, spreading those of
+// many clients sharing a key service. Requests wait for a key only when
+// there's none yet, or it has expired. It is safe for concurrent use.
+type RefreshingAPIKey struct {
+	// Fetch returns a new key and its expiry; a zero expiry never expires.
+	Fetch func(ctx context.Context) (key string, expiresAt time.Time, err error)
+	// RefreshAhead is how long before expiry refreshes start.
+	RefreshAhead time.Duration
+	// Jitter is the most refreshes start earlier still, at random.
+	Jitter time.Duration
+	// Clock tells when keys expire. Defaults to SystemClock.
+	Clock Clock
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it for deterministic tests.
+	Rand func() float64
+
+	mu         sync.Mutex
+	key        string
+	expiresAt  time.Time
+	refreshAt  time.Time
+	refreshing bool
+}
+
+// NewRefreshingAPIKey returns a RefreshingAPIKey of the keys fetch returns,
+// refreshing them a minute before expiry, with up to 30 seconds of jitter.
+func NewRefreshingAPIKey(fetch func(ctx context.Context) (string, time.Time, error)) *RefreshingAPIKey {
+	return &RefreshingAPIKey{Fetch: fetch, RefreshAhead: time.Minute, Jitter: 30 * time.Second}
+}
+
+// APIKey returns the current key, fetching one when there's none or it has
+// expired, and starting a background refresh when it's due.
+func (k *RefreshingAPIKey) APIKey(ctx context.Context) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := clockOrSystem(k.Clock).Now()
+	if k.key != "" && (k.expiresAt.IsZero() || now.Before(k.expiresAt)) {
+		if !k.refreshing && !k.refreshAt.IsZero() && !now.Before(k.refreshAt) {
+			k.refreshing = true
+			go k.refresh(context.WithoutCancel(ctx))
+		}
+		return k.key, nil
+	}
+
+	key, expiresAt, err := k.Fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetching api key: %w", err)
+	}
+	k.store(now, key, expiresAt)
+	return key, nil
+}
+
+// refresh fetches the next key in the background. A failed refresh is
+// retried halfway to the expiry of the current key.
+func (k *RefreshingAPIKey) refresh(ctx context.Context) {
+	key, expiresAt, err := k.Fetch(ctx)
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.refreshing = false
+	now := clockOrSystem(k.Clock).Now()
+	if err != nil {
+		k.refreshAt = now.Add(k.expiresAt.Sub(now) / 2)
+		return
+	}
+	k.store(now, key, expiresAt)
+}
+
+// store caches key, fetched at now, scheduling its refresh no earlier than
+// halfway to expiry, for keys living less than RefreshAhead. k.mu must be
+// held.
+func (k *RefreshingAPIKey) store(now time.Time, key string, expiresAt time.Time) {
+	k.key, k.expiresAt, k.refreshAt = key, expiresAt, time.Time{}
+	if expiresAt.IsZero() {
+		return
+	}
+	ahead := k.RefreshAhead
+	if k.Jitter > 0 {
+		random := k.Rand
+		if random == nil {
+			random = rand.Float64
+		}
+		ahead += time.Duration(random() * float64(k.Jitter))
+	}
+	k.refreshAt = expiresAt.Add(-ahead)
+	if halfway := now.Add(expiresAt.Sub(now) / 2); k.refreshAt.Before(halfway) {
+		k.refreshAt = halfway
+	}
+}
@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAPIKey(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/pets?limit=1", nil)
+	require.NoError(t, err)
+
+	require.NoError(t, SetAPIKey(req, "header", "X-API-Key", "one"))
+	require.NoError(t, SetAPIKey(req, "query", "api_key", "two"))
+	require.NoError(t, SetAPIKey(req, "cookie", "session", "three"))
+	assert.Equal(t, "one", req.Header.Get("X-API-Key"))
+	assert.Equal(t, "api_key=two&limit=1", req.URL.RawQuery)
+	cookie, err := req.Cookie("session")
+	require.NoError(t, err)
+	assert.Equal(t, "three", cookie.Value)
+
+	assert.ErrorContains(t, SetAPIKey(req, "body", "key", "four"), `unsupported location "body"`)
+}
+
+func TestAPIKeyProviders(t *testing.T) {
+	key, err := StaticAPIKey("static").APIKey(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "static", key)
+
+	key, err = APIKeyFunc(func(ctx context.Context) (string, error) { return "func", nil }).APIKey(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "func", key)
+}
+
+// testKeyService issues keys living ten minutes, numbered in order.
+type testKeyService struct {
+	clock    *FakeClock
+	mu       sync.Mutex
+	keys     int
+	attempts int
+	err      error
+}
+
+func (s *testKeyService) fetch(ctx context.Context) (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.err != nil {
+		return "", time.Time{}, s.err
+	}
+	s.keys++
+	return fmt.Sprintf("key-%d", s.keys), s.clock.Now().Add(10 * time.Minute), nil
+}
+
+func (s *testKeyService) attempted() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.attempts
+}
+
+func (s *testKeyService) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func TestRefreshingAPIKey(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	service := &testKeyService{clock: clock}
+	provider := NewRefreshingAPIKey(service.fetch)
+	provider.Clock = clock
+	provider.Rand = func() float64 { return 0.5 }
+	ctx := context.Background()
+	requireKey := func(want string) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			key, err := provider.APIKey(ctx)
+			return err == nil && key == want
+		}, time.Second, time.Millisecond)
+	}
+
+	requireKey("key-1")
+	// Refreshes start 75 seconds before expiry: a minute, plus half of the
+	// 30 seconds of jitter.
+	clock.Advance(10*time.Minute - 76*time.Second)
+	requireKey("key-1")
+	clock.Advance(time.Second)
+	key, err := provider.APIKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key, "the current key is used while refreshing")
+	requireKey("key-2")
+
+	// A failed refresh is retried halfway to expiry.
+	service.setErr(errors.New("unavailable"))
+	clock.Advance(10*time.Minute - 75*time.Second)
+	requireKey("key-2")
+	require.Eventually(t, func() bool { return service.attempted() == 3 }, time.Second, time.Millisecond)
+	service.setErr(nil)
+	requireKey("key-2")
+	clock.Advance(40 * time.Second)
+	requireKey("key-3")
+
+	// Expired keys are replaced before requests proceed.
+	clock.Advance(time.Hour)
+	key, err = provider.APIKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "key-4", key)
+
+	service.setErr(errors.New("unavailable"))
+	clock.Advance(time.Hour)
+	_, err = provider.APIKey(ctx)
+	assert.ErrorContains(t, err, "fetching api key: unavailable")
+}
@@ -0,0 +1,25 @@
+package helpers
+
+//oapi-runtime:function helpers/Breaker
+
+import "net/http"
+
+// Breaker is a circuit breaker consulted around every call of a generated
+// client, keyed by operationId, so that policies such as those of gobreaker
+// can be applied per operation.
+type Breaker interface {
+	// Allow is called once the request has been built, just before it is
+	// sent. A non-nil error, such as one reporting an open circuit, fails the
+	// call without sending it.
+	Allow(operationID string) error
+
+	// Record is called with the outcome of each call which was allowed.
+	Record(operationID string, success bool)
+}
+
+// BreakerSuccess reports whether a call counts as a success for a Breaker:
+// the request was sent and the server didn't respond with a 5xx status.
+// Client errors are the caller's fault and don't trip the breaker.
+func BreakerSuccess(resp *http.Response, err error) bool {
+	return err == nil && resp.StatusCode < http.StatusInternalServerError
+}
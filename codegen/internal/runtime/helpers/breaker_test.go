@@ -0,0 +1,16 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakerSuccess(t *testing.T) {
+	assert.True(t, BreakerSuccess(&http.Response{StatusCode: http.StatusOK}, nil))
+	assert.True(t, BreakerSuccess(&http.Response{StatusCode: http.StatusNotFound}, nil))
+	assert.False(t, BreakerSuccess(&http.Response{StatusCode: http.StatusBadGateway}, nil))
+	assert.False(t, BreakerSuccess(nil, errors.New("connection refused")))
+}
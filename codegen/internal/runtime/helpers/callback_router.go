@@ -0,0 +1,181 @@
+package helpers
+
+//oapi-runtime:function helpers/CallbackRouter
+
+import (
+	"context"
+	crand "crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrUnknownCallback is returned when a callback arrives at a URL no
+	// subscription holds, such as one which was closed.
+	ErrUnknownCallback = errors.New("no subscription for callback")
+	// ErrCallbackSubscriptionClosed is returned by Next once a subscription
+	// is closed.
+	ErrCallbackSubscriptionClosed = errors.New("callback subscription closed")
+)
+
+// callbackBuffer is the number of callbacks a subscription holds before
+// deliveries wait for Next.
+const callbackBuffer = 16
+
+// CallbackRouter correlates callbacks with the requests which asked for
+// them. Each subscription gets a callback URL of its own, below the base URL
+// of the router, to send as the callback URL of its request; the callbacks
+// which arrive at it are delivered to the subscription. It is safe for
+// concurrent use.
+type CallbackRouter struct {
+	base *url.URL
+
+	mu            sync.Mutex
+	subscriptions map[string]callbackSubscriber
+}
+
+// callbackSubscriber is the untyped side of a CallbackSubscription.
+type callbackSubscriber interface {
+	name() string
+	deliver(ctx context.Context, value any) error
+}
+
+// NewCallbackRouter returns a router minting callback URLs below baseURL,
+// the absolute URL the callback server is reachable at, such as
+// https://client.example.com/callbacks.
+func NewCallbackRouter(baseURL string) (*CallbackRouter, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing callback base URL: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("callback base URL %q isn't absolute", baseURL)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	base.RawPath, base.RawQuery, base.Fragment = "", "", ""
+	return &CallbackRouter{base: base, subscriptions: map[string]callbackSubscriber{}}, nil
+}
+
+// Route returns the name of the callback the subscription at path, the path
+// of an incoming request, receives.
+func (r *CallbackRouter) Route(path string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.subscriptions[path]
+	if !ok {
+		return "", false
+	}
+	return sub.name(), true
+}
+
+// Deliver hands value, decoded from the callback req, to the subscription
+// at its path. It waits while the subscription's buffer is full, until the
+// request is canceled. It returns ErrUnknownCallback if no subscription is
+// at the path.
+func (r *CallbackRouter) Deliver(req *http.Request, value any) error {
+	r.mu.Lock()
+	sub, ok := r.subscriptions[req.URL.Path]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, req.URL.Path)
+	}
+	return sub.deliver(req.Context(), value)
+}
+
+// subscribe registers sub at a new path for the callback name, returning
+// its URL and path.
+func (r *CallbackRouter) subscribe(name string, sub callbackSubscriber) (string, string) {
+	u := *r.base
+	u.Path = r.base.Path + "/" + url.PathEscape(name) + "/" + crand.Text()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions[u.Path] = sub
+	return u.String(), u.Path
+}
+
+func (r *CallbackRouter) unsubscribe(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscriptions, path)
+}
+
+// CallbackSubscription receives the callbacks of type T which one request
+// asked for. Send URL as the callback URL of the request, then read the
+// callbacks with Next, and Close the subscription once no more are
+// expected.
+type CallbackSubscription[T any] struct {
+	// URL is the callback URL of the subscription.
+	URL string
+
+	router    *CallbackRouter
+	path      string
+	callback  string
+	callbacks chan T
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// SubscribeCallback returns a new subscription of router to the callback
+// name.
+func SubscribeCallback[T any](router *CallbackRouter, name string) *CallbackSubscription[T] {
+	s := &CallbackSubscription[T]{
+		router:    router,
+		callback:  name,
+		callbacks: make(chan T, callbackBuffer),
+		done:      make(chan struct{}),
+	}
+	s.URL, s.path = router.subscribe(name, s)
+	return s
+}
+
+// Next returns the next callback received, waiting for one until ctx is
+// done or the subscription is closed.
+func (s *CallbackSubscription[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	default:
+	}
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	case <-s.done:
+		return zero, ErrCallbackSubscriptionClosed
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Close stops the subscription: callbacks arriving at its URL are rejected
+// with ErrUnknownCallback, and Next returns ErrCallbackSubscriptionClosed.
+func (s *CallbackSubscription[T]) Close() {
+	s.closeOnce.Do(func() {
+		s.router.unsubscribe(s.path)
+		close(s.done)
+	})
+}
+
+func (s *CallbackSubscription[T]) name() string {
+	return s.callback
+}
+
+func (s *CallbackSubscription[T]) deliver(ctx context.Context, value any) error {
+	v, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("callback %s: unexpected %T", s.callback, value)
+	}
+	select {
+	case s.callbacks <- v:
+		return nil
+	case <-s.done:
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, s.path)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
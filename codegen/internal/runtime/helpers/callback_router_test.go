@@ -0,0 +1,76 @@
+package helpers
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCallbackRouter(t *testing.T) {
+	for _, base := range []string{"", "/callbacks", "client.example.com", "://"} {
+		_, err := NewCallbackRouter(base)
+		assert.Error(t, err, base)
+	}
+}
+
+func TestCallbackSubscription(t *testing.T) {
+	router, err := NewCallbackRouter("https://client.example.com/callbacks/?x=1")
+	require.NoError(t, err)
+	first := SubscribeCallback[string](router, "Done")
+	second := SubscribeCallback[string](router, "Done")
+	assert.NotEqual(t, first.URL, second.URL)
+	assert.True(t, strings.HasPrefix(first.URL, "https://client.example.com/callbacks/Done/"), first.URL)
+
+	u, err := url.Parse(first.URL)
+	require.NoError(t, err)
+	name, ok := router.Route(u.Path)
+	assert.True(t, ok)
+	assert.Equal(t, "Done", name)
+	_, ok = router.Route("/callbacks/Done/unknown")
+	assert.False(t, ok)
+
+	req := httptest.NewRequest("POST", first.URL, nil)
+	require.NoError(t, router.Deliver(req, "one"))
+	require.NoError(t, router.Deliver(req, "two"))
+	assert.Error(t, router.Deliver(req, 3), "values of another type are rejected")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, want := range []string{"one", "two"} {
+		got, err := first.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	short, cancelShort := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancelShort()
+	_, err = second.Next(short)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	first.Close()
+	first.Close()
+	_, err = first.Next(ctx)
+	assert.ErrorIs(t, err, ErrCallbackSubscriptionClosed)
+	assert.ErrorIs(t, router.Deliver(req, "three"), ErrUnknownCallback)
+}
+
+func TestCallbackSubscriptionFullBuffer(t *testing.T) {
+	router, err := NewCallbackRouter("http://localhost:8080")
+	require.NoError(t, err)
+	sub := SubscribeCallback[int](router, "Tick")
+	req := httptest.NewRequest("POST", sub.URL, nil)
+	for i := range callbackBuffer {
+		require.NoError(t, router.Deliver(req, i))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, router.Deliver(req.WithContext(ctx), callbackBuffer), context.DeadlineExceeded,
+		"deliveries wait for Next until the request is canceled")
+}
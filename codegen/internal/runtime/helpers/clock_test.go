@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	assert.Equal(t, start, clock.Now())
+
+	later := clock.After(2 * time.Second)
+	sooner := clock.After(time.Second)
+	assert.Equal(t, 2, clock.Waiters())
+
+	clock.Advance(time.Second)
+	assert.Equal(t, start.Add(time.Second), <-sooner)
+	select {
+	case <-later:
+		t.Fatal("later fired early")
+	default:
+	}
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, start.Add(time.Hour+time.Second), <-later)
+	assert.Zero(t, clock.Waiters())
+
+	// Non-positive durations have already elapsed.
+	assert.Equal(t, clock.Now(), <-clock.After(0))
+}
+
+func TestClockOrSystem(t *testing.T) {
+	assert.Equal(t, SystemClock, clockOrSystem(nil))
+	clock := NewFakeClock(time.Time{})
+	assert.Equal(t, Clock(clock), clockOrSystem(clock))
+}
+
+func TestContextWithDeadline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	ctx, cancel := ContextWithDeadline(context.Background(), clock, start.Add(time.Minute))
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, start.Add(time.Minute), deadline)
+
+	clock.Advance(30 * time.Second)
+	assert.NoError(t, ctx.Err())
+
+	clock.Advance(30 * time.Second)
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	assert.ErrorIs(t, context.Cause(ctx), context.DeadlineExceeded)
+}
+
+func TestContextWithDeadline_Cancel(t *testing.T) {
+	clock := NewFakeClock(time.Time{})
+	ctx, cancel := ContextWithDeadline(context.Background(), clock, time.Time{}.Add(time.Minute))
+	cancel()
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestContextWithDeadline_SystemClock(t *testing.T) {
+	ctx, cancel := ContextWithDeadline(context.Background(), nil, time.Now().Add(-time.Second))
+	defer cancel()
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
@@ -0,0 +1,384 @@
+package helpers
+
+//oapi-runtime:function helpers/Coverage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// coverageMethods are the methods of operations, in the order they're
+// reported.
+var coverageMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace", "query"}
+
+// Coverage records which operations, response statuses and schema properties
+// traffic exercises, to report the surface of a spec which contract tests
+// leave untested. Record requests through its Middleware on servers, or the
+// doer Wrap returns on clients, then call Report. It is safe for concurrent
+// use.
+type Coverage struct {
+	// BasePath is removed from request paths before they're matched against
+	// the spec, for APIs served below a prefix, such as /v1.
+	BasePath string
+
+	spec *ResponseValidator
+	mu   sync.Mutex
+	hits map[string]int
+}
+
+// NewCoverage returns a Coverage of spec, the JSON of an OpenAPI document,
+// such as GetOpenAPISpecJSON returns for JSON specs.
+func NewCoverage(spec []byte) (*Coverage, error) {
+	v, err := NewResponseValidator(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Coverage{spec: v, hits: map[string]int{}}, nil
+}
+
+// CoverageItem is an operation, response or schema property of the spec, with
+// the number of times traffic exercised it.
+type CoverageItem struct {
+	// Name identifies the item: GET /pets/{id} for operations,
+	// GET /pets/{id} 404 for responses, and the JSON pointer of the property
+	// in the spec, such as #/components/schemas/Pet/properties/name, for
+	// properties.
+	Name string
+	Hits int
+}
+
+// CoverageReport lists the operations, responses and properties of request
+// and response bodies a spec declares, with the traffic each saw.
+type CoverageReport struct {
+	Operations []CoverageItem
+	Responses  []CoverageItem
+	Properties []CoverageItem
+}
+
+// Record records a request, with its body, and the response with status,
+// header and body written for it. Requests matching no operation of the spec
+// are ignored. Bodies are only inspected when they're JSON.
+func (c *Coverage) Record(r *http.Request, requestBody []byte, status int, header http.Header, responseBody []byte) {
+	path := strings.TrimPrefix(r.URL.Path, c.BasePath)
+	route, operation := c.spec.match(r.Method, path)
+	if operation == nil {
+		return
+	}
+	method := strings.ToLower(r.Method)
+	name := strings.ToUpper(method) + " " + route.template
+	operationPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + method
+	hits := []string{name}
+
+	if declaration, declarationPath := c.spec.resolve(operation["requestBody"], operationPath+"/requestBody"); declaration != nil {
+		hits = c.coverBody(hits, declaration, declarationPath, r.Header.Get("Content-Type"), requestBody)
+	}
+	responses, _ := operation["responses"].(map[string]any)
+	if key, ok := responseKey(responses, status); ok {
+		hits = append(hits, name+" "+key)
+		response, responsePath := c.spec.resolve(responses[key], operationPath+"/responses/"+escapeSchemaPointer(key))
+		hits = c.coverBody(hits, response, responsePath, header.Get("Content-Type"), responseBody)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, hit := range hits {
+		c.hits[hit]++
+	}
+}
+
+// coverBody appends to hits the properties body exercises, when its
+// contentType is a JSON media type declared by the request body or response
+// declaration, at path.
+func (c *Coverage) coverBody(hits []string, declaration any, path, contentType string, body []byte) []string {
+	object, _ := declaration.(map[string]any)
+	content, _ := object["content"].(map[string]any)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || len(body) == 0 || !isJSONMediaType(mediaType) {
+		return hits
+	}
+	key, ok := mediaTypeKey(content, mediaType)
+	if !ok {
+		return hits
+	}
+	media, _ := content[key].(map[string]any)
+	schema, ok := media["schema"]
+	if !ok {
+		return hits
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return hits
+	}
+	return c.cover(hits, value, schema, path+"/content/"+escapeSchemaPointer(key)+"/schema", 0)
+}
+
+// cover appends to hits the properties of schema, at schemaPath, which value
+// holds, descending into the subschemas value matches.
+func (c *Coverage) cover(hits []string, value, schema any, schemaPath string, depth int) []string {
+	s, ok := schema.(map[string]any)
+	if !ok || depth > maxSchemaDepth {
+		return hits
+	}
+	if ref, ok := s["$ref"].(string); ok {
+		if target, ok := c.spec.lookup(ref); ok {
+			hits = c.cover(hits, value, target, ref, depth+1)
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		properties, _ := s["properties"].(map[string]any)
+		additional, _ := s["additionalProperties"].(map[string]any)
+		for _, name := range sortedSchemaKeys(value) {
+			if sub, ok := properties[name]; ok {
+				propertyPath := schemaPath + "/properties/" + escapeSchemaPointer(name)
+				hits = append(hits, propertyPath)
+				hits = c.cover(hits, value[name], sub, propertyPath, depth+1)
+			} else if additional != nil {
+				hits = c.cover(hits, value[name], additional, schemaPath+"/additionalProperties", depth+1)
+			}
+		}
+	case []any:
+		prefix, _ := s["prefixItems"].([]any)
+		for i, item := range value {
+			if i < len(prefix) {
+				hits = c.cover(hits, item, prefix[i], schemaPath+"/prefixItems/"+strconv.Itoa(i), depth+1)
+			} else if items, ok := s["items"]; ok {
+				hits = c.cover(hits, item, items, schemaPath+"/items", depth+1)
+			}
+		}
+	}
+
+	if all, ok := s["allOf"].([]any); ok {
+		for i, sub := range all {
+			hits = c.cover(hits, value, sub, schemaPath+"/allOf/"+strconv.Itoa(i), depth+1)
+		}
+	}
+	for _, keyword := range []string{"anyOf", "oneOf"} {
+		branches, ok := s[keyword].([]any)
+		if !ok {
+			continue
+		}
+		if keyword == "oneOf" {
+			if i, ok := c.spec.discriminatedBranch(value, s, branches); ok {
+				hits = c.cover(hits, value, branches[i], schemaPath+"/oneOf/"+strconv.Itoa(i), depth+1)
+				continue
+			}
+		}
+		// Only the branches value matches are exercised.
+		for i, sub := range branches {
+			branchPath := schemaPath + "/" + keyword + "/" + strconv.Itoa(i)
+			if c.spec.check(value, sub, branchPath, "", depth+1) == nil {
+				hits = c.cover(hits, value, sub, branchPath, depth+1)
+			}
+		}
+	}
+	return hits
+}
+
+// Report lists everything the spec declares with the traffic recorded so far.
+func (c *Coverage) Report() CoverageReport {
+	var report CoverageReport
+	properties := map[string]bool{}
+	for _, route := range c.spec.routesByTemplate() {
+		for _, method := range coverageMethods {
+			operation, ok := route.operations[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			name := strings.ToUpper(method) + " " + route.template
+			operationPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + method
+			report.Operations = append(report.Operations, CoverageItem{Name: name})
+
+			requestBody, requestPath := c.spec.resolve(operation["requestBody"], operationPath+"/requestBody")
+			c.declaredProperties(properties, requestBody, requestPath)
+			responses, _ := operation["responses"].(map[string]any)
+			for _, key := range sortedSchemaKeys(responses) {
+				report.Responses = append(report.Responses, CoverageItem{Name: name + " " + key})
+				response, responsePath := c.spec.resolve(responses[key], operationPath+"/responses/"+escapeSchemaPointer(key))
+				c.declaredProperties(properties, response, responsePath)
+			}
+		}
+	}
+	for path := range properties {
+		report.Properties = append(report.Properties, CoverageItem{Name: path})
+	}
+	sort.Slice(report.Properties, func(i, j int) bool { return report.Properties[i].Name < report.Properties[j].Name })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, items := range [][]CoverageItem{report.Operations, report.Responses, report.Properties} {
+		for i := range items {
+			items[i].Hits = c.hits[items[i].Name]
+		}
+	}
+	return report
+}
+
+// declaredProperties adds to properties those of the JSON bodies of the
+// request body or response declaration at path.
+func (c *Coverage) declaredProperties(properties map[string]bool, declaration any, path string) {
+	object, _ := declaration.(map[string]any)
+	content, _ := object["content"].(map[string]any)
+	for _, key := range sortedSchemaKeys(content) {
+		media, _ := content[key].(map[string]any)
+		if schema, ok := media["schema"]; ok && isJSONMediaType(key) {
+			c.schemaProperties(properties, schema, path+"/content/"+escapeSchemaPointer(key)+"/schema", map[string]bool{})
+		}
+	}
+}
+
+// schemaProperties adds to properties those of schema, at schemaPath, and of
+// its subschemas, following each reference once.
+func (c *Coverage) schemaProperties(properties map[string]bool, schema any, schemaPath string, followed map[string]bool) {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return
+	}
+	if ref, ok := s["$ref"].(string); ok && !followed[ref] {
+		followed[ref] = true
+		if target, ok := c.spec.lookup(ref); ok {
+			c.schemaProperties(properties, target, ref, followed)
+		}
+	}
+	sub, _ := s["properties"].(map[string]any)
+	for _, name := range sortedSchemaKeys(sub) {
+		propertyPath := schemaPath + "/properties/" + escapeSchemaPointer(name)
+		properties[propertyPath] = true
+		c.schemaProperties(properties, sub[name], propertyPath, followed)
+	}
+	for _, keyword := range []string{"items", "additionalProperties"} {
+		c.schemaProperties(properties, s[keyword], schemaPath+"/"+keyword, followed)
+	}
+	for _, keyword := range []string{"prefixItems", "allOf", "anyOf", "oneOf"} {
+		branches, _ := s[keyword].([]any)
+		for i, branch := range branches {
+			c.schemaProperties(properties, branch, schemaPath+"/"+keyword+"/"+strconv.Itoa(i), followed)
+		}
+	}
+}
+
+// routesByTemplate returns the routes of the spec in template order.
+func (v *ResponseValidator) routesByTemplate() []responseRoute {
+	routes := append([]responseRoute(nil), v.routes...)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].template < routes[j].template })
+	return routes
+}
+
+// Untested returns the report of the items traffic didn't exercise.
+func (r CoverageReport) Untested() CoverageReport {
+	return CoverageReport{
+		Operations: untestedItems(r.Operations),
+		Responses:  untestedItems(r.Responses),
+		Properties: untestedItems(r.Properties),
+	}
+}
+
+func untestedItems(items []CoverageItem) []CoverageItem {
+	var untested []CoverageItem
+	for _, item := range items {
+		if item.Hits == 0 {
+			untested = append(untested, item)
+		}
+	}
+	return untested
+}
+
+// String summarizes the report: the share of each kind of item exercised,
+// followed by those which weren't.
+func (r CoverageReport) String() string {
+	var b strings.Builder
+	sections := []struct {
+		name  string
+		items []CoverageItem
+	}{
+		{"operations", r.Operations},
+		{"responses", r.Responses},
+		{"properties", r.Properties},
+	}
+	for _, section := range sections {
+		tested := len(section.items) - len(untestedItems(section.items))
+		percent := 100.0
+		if len(section.items) > 0 {
+			percent = 100 * float64(tested) / float64(len(section.items))
+		}
+		fmt.Fprintf(&b, "%s: %d/%d (%.1f%%)\n", section.name, tested, len(section.items), percent)
+	}
+	for _, section := range sections {
+		untested := untestedItems(section.items)
+		if len(untested) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\nuntested %s:\n", section.name)
+		for _, item := range untested {
+			fmt.Fprintf(&b, "  %s\n", item.Name)
+		}
+	}
+	return b.String()
+}
+
+// Middleware records the requests next handles, and its responses, for
+// servers built on net/http. Bodies are copied as they're read and written,
+// so it is meant for tests.
+func (c *Coverage) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody bytes.Buffer
+		if r.Body != nil {
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.TeeReader(r.Body, &requestBody), r.Body}
+		}
+		rec := &responseCapture{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		status, header := rec.status, rec.header
+		if status == 0 {
+			status, header = http.StatusOK, w.Header()
+		}
+		c.Record(r, requestBody.Bytes(), status, header, rec.body.Bytes())
+	})
+}
+
+// Wrap returns a doer which sends requests through doer, recording them and
+// their responses, for clients. Response bodies are read in full before
+// they're returned.
+func (c *Coverage) Wrap(doer HTTPDoer) HTTPDoer {
+	return &coverageDoer{coverage: c, doer: doer}
+}
+
+type coverageDoer struct {
+	coverage *Coverage
+	doer     HTTPDoer
+}
+
+func (cd *coverageDoer) Do(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(body)
+			_ = body.Close()
+		}
+	}
+	resp, err := cd.doer.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	cd.coverage.Record(req, requestBody, resp.StatusCode, resp.Header, responseBody)
+	return resp, nil
+}
@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCoverageSpec = `{
+  "paths": {
+    "/pets": {
+      "get": {"responses": {
+        "200": {"content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}}}}},
+        "default": {"$ref": "#/components/responses/Error"}
+      }},
+      "post": {
+        "requestBody": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/NewPet"}}}},
+        "responses": {"201": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}}}
+      }
+    },
+    "/pets/{id}": {
+      "get": {"responses": {
+        "200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}},
+        "404": {"description": ""}
+      }}
+    }
+  },
+  "components": {
+    "schemas": {
+      "NewPet": {"type": "object", "properties": {"name": {"type": "string"}, "tag": {"type": "string"}}},
+      "Pet": {"allOf": [
+        {"$ref": "#/components/schemas/NewPet"},
+        {"type": "object", "properties": {"id": {"type": "integer"}, "owner": {"$ref": "#/components/schemas/Owner"}}}
+      ]},
+      "Owner": {"type": "object", "properties": {"name": {"type": "string"}, "parent": {"$ref": "#/components/schemas/Owner"}}},
+      "Error": {"type": "object", "properties": {"message": {"type": "string"}}}
+    },
+    "responses": {
+      "Error": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    }
+  }
+}`
+
+func coverageHits(items []CoverageItem) map[string]int {
+	hits := map[string]int{}
+	for _, item := range items {
+		hits[item.Name] = item.Hits
+	}
+	return hits
+}
+
+func TestCoverageMiddleware(t *testing.T) {
+	coverage, err := NewCoverage([]byte(testCoverageSpec))
+	require.NoError(t, err)
+	handler := coverage.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(append(body[:len(body)-1], `,"id":1}`...))
+		case r.URL.Path == "/pets":
+			_, _ = io.WriteString(w, `[{"id":1,"name":"Tom","owner":{"name":"Ann"}}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/pets", nil),
+		httptest.NewRequest(http.MethodGet, "/pets", nil),
+		httptest.NewRequest(http.MethodPost, "/pets", strings.NewReader(`{"name":"Rex"}`)),
+		httptest.NewRequest(http.MethodGet, "/pets/7", nil),
+		httptest.NewRequest(http.MethodGet, "/owners", nil),
+	} {
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	report := coverage.Report()
+	assert.Equal(t, map[string]int{"GET /pets": 2, "POST /pets": 1, "GET /pets/{id}": 1}, coverageHits(report.Operations))
+	assert.Equal(t, map[string]int{
+		"GET /pets 200":      2,
+		"GET /pets default":  0,
+		"POST /pets 201":     1,
+		"GET /pets/{id} 200": 0,
+		"GET /pets/{id} 404": 1,
+	}, coverageHits(report.Responses))
+	assert.Equal(t, map[string]int{
+		"#/components/schemas/Error/properties/message":     0,
+		"#/components/schemas/NewPet/properties/name":       4,
+		"#/components/schemas/NewPet/properties/tag":        0,
+		"#/components/schemas/Owner/properties/name":        2,
+		"#/components/schemas/Owner/properties/parent":      0,
+		"#/components/schemas/Pet/allOf/1/properties/id":    3,
+		"#/components/schemas/Pet/allOf/1/properties/owner": 2,
+	}, coverageHits(report.Properties))
+
+	untested := report.Untested()
+	assert.Len(t, untested.Operations, 0)
+	assert.Len(t, untested.Responses, 2)
+	assert.Len(t, untested.Properties, 3)
+	assert.Equal(t, `operations: 3/3 (100.0%)
+responses: 3/5 (60.0%)
+properties: 4/7 (57.1%)
+
+untested responses:
+  GET /pets default
+  GET /pets/{id} 200
+
+untested properties:
+  #/components/schemas/Error/properties/message
+  #/components/schemas/NewPet/properties/tag
+  #/components/schemas/Owner/properties/parent
+`, report.String())
+}
+
+func TestCoverageWrap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(w, `{"message":"down"}`)
+	}))
+	defer server.Close()
+
+	coverage, err := NewCoverage([]byte(testCoverageSpec))
+	require.NoError(t, err)
+	coverage.BasePath = "/v1"
+	doer := coverage.Wrap(http.DefaultClient)
+
+	resp, err := doer.Do(httptest.NewRequest(http.MethodGet, server.URL+"/v1/pets", nil).WithContext(t.Context()))
+	require.Error(t, err, "server requests can't be sent")
+	assert.Nil(t, resp)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/v1/pets", nil)
+	require.NoError(t, err)
+	resp, err = doer.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message":"down"}`, string(body), "the body is still readable")
+
+	report := coverage.Report()
+	assert.Equal(t, 1, coverageHits(report.Responses)["GET /pets default"])
+	assert.Equal(t, 1, coverageHits(report.Properties)["#/components/schemas/Error/properties/message"])
+}
+
+func TestCoverageOneOf(t *testing.T) {
+	coverage, err := NewCoverage([]byte(testValidationSpec))
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/animals", nil)
+	coverage.Record(req, nil, http.StatusOK, jsonHeader(), []byte(`{"type":"dog","good":true}`))
+
+	hits := coverageHits(coverage.Report().Properties)
+	assert.Equal(t, 1, hits["#/components/schemas/Dog/properties/good"])
+	assert.Equal(t, 1, hits["#/components/schemas/Dog/properties/type"])
+	assert.Equal(t, 0, hits["#/components/schemas/Cat/properties/type"], "only the discriminated branch is covered")
+}
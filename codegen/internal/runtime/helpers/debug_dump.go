@@ -0,0 +1,313 @@
+package helpers
+
+//oapi-runtime:function helpers/DebugDumper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// redactedValue replaces redacted header, query parameter and field values.
+const redactedValue = "[REDACTED]"
+
+// maxDumpBody is the number of bytes of each body a DebugDumper captures.
+// Longer bodies are passed through untouched, but dumped truncated.
+const maxDumpBody = 64 << 10
+
+// DebugRedactions lists the values a DebugDumper redacts, in addition to the
+// Authorization, Proxy-Authorization, Cookie and Set-Cookie headers.
+type DebugRedactions struct {
+	Headers     []string // Header names, matched case-insensitively
+	QueryParams []string // Query parameter names
+	Fields      []string // JSON property and form field names, at any depth
+}
+
+// DebugDumper writes the method, URL, headers and body of HTTP requests and
+// their responses while it is enabled, with credentials and sensitive fields
+// redacted. It can be enabled and disabled at any time, including while
+// requests are in flight.
+//
+// Bodies are never buffered in full: up to 64KiB of each is captured as it
+// streams through, so a response is dumped once its body has been read to
+// the end or closed.
+type DebugDumper struct {
+	// Clock times requests. Defaults to the clock passed to WrapWithClock, or
+	// SystemClock; set it before use.
+	Clock Clock
+
+	enabled atomic.Bool
+
+	mu sync.Mutex // Serializes writes to w
+	w  io.Writer
+
+	headers map[string]bool
+	query   map[string]bool
+	fields  map[string]bool
+}
+
+// NewDebugDumper returns a disabled DebugDumper which writes to w.
+func NewDebugDumper(w io.Writer, redactions DebugRedactions) *DebugDumper {
+	d := &DebugDumper{
+		w: w,
+		headers: map[string]bool{
+			"Authorization":       true,
+			"Proxy-Authorization": true,
+			"Cookie":              true,
+			"Set-Cookie":          true,
+		},
+		query:  make(map[string]bool),
+		fields: make(map[string]bool),
+	}
+	for _, h := range redactions.Headers {
+		d.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, q := range redactions.QueryParams {
+		d.query[q] = true
+	}
+	for _, f := range redactions.Fields {
+		d.fields[f] = true
+	}
+	return d
+}
+
+// SetEnabled turns dumping on or off.
+func (d *DebugDumper) SetEnabled(enabled bool) {
+	d.enabled.Store(enabled)
+}
+
+// Enabled reports whether dumping is on.
+func (d *DebugDumper) Enabled() bool {
+	return d.enabled.Load()
+}
+
+// Wrap returns a doer which dumps each request sent through doer, and its
+// response, while d is enabled.
+func (d *DebugDumper) Wrap(doer HTTPDoer) HTTPDoer {
+	return d.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing requests with clock unless d has a Clock of
+// its own.
+func (d *DebugDumper) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	return &debugDoer{dumper: d, doer: doer, clock: clock}
+}
+
+type debugDoer struct {
+	dumper *DebugDumper
+	doer   HTTPDoer
+	clock  Clock
+}
+
+func (dd *debugDoer) Do(req *http.Request) (*http.Response, error) {
+	d := dd.dumper
+	if !d.Enabled() {
+		return dd.doer.Do(req)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--> %s %s\n", req.Method, d.redactURL(req.URL))
+	body, truncated, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	d.writeMessage(&buf, req.Header, body, truncated)
+
+	clock := d.Clock
+	if clock == nil {
+		clock = clockOrSystem(dd.clock)
+	}
+	start := clock.Now()
+	resp, err := dd.doer.Do(req)
+	if err != nil {
+		fmt.Fprintf(&buf, "<-- error after %s: %v\n", clock.Now().Sub(start).Round(time.Millisecond), err)
+		d.write(buf.Bytes())
+		return nil, err
+	}
+
+	fmt.Fprintf(&buf, "<-- %s (%s)\n", resp.Status, clock.Now().Sub(start).Round(time.Millisecond))
+	if resp.Body == nil || resp.Body == http.NoBody {
+		d.writeMessage(&buf, resp.Header, nil, false)
+		d.write(buf.Bytes())
+		return resp, nil
+	}
+	resp.Body = &dumpingBody{
+		ReadCloser: resp.Body,
+		done: func(body []byte, truncated bool, err error) {
+			if err != nil {
+				fmt.Fprintf(&buf, "<-- error reading body: %v\n", err)
+			}
+			d.writeMessage(&buf, resp.Header, body, truncated)
+			d.write(buf.Bytes())
+		},
+	}
+	return resp, nil
+}
+
+func (d *DebugDumper) write(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(p)
+}
+
+// peekRequestBody returns up to maxDumpBody bytes of the request body, and
+// whether there was more, leaving the whole body in place to be sent.
+func peekRequestBody(req *http.Request) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, false, nil
+	}
+	head, err := io.ReadAll(io.LimitReader(req.Body, maxDumpBody+1))
+	if err != nil {
+		_ = req.Body.Close()
+		return nil, false, err
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), req.Body), req.Body}
+	if len(head) > maxDumpBody {
+		return head[:maxDumpBody], true, nil
+	}
+	return head, false, nil
+}
+
+// dumpingBody captures up to maxDumpBody bytes of a response body as it is
+// read, and calls done with them once it has been read to the end or closed.
+type dumpingBody struct {
+	io.ReadCloser
+	head      bytes.Buffer
+	truncated bool
+	once      sync.Once
+	done      func(body []byte, truncated bool, err error)
+}
+
+func (b *dumpingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if room := maxDumpBody - b.head.Len(); room < n {
+		b.head.Write(p[:room])
+		b.truncated = true
+	} else {
+		b.head.Write(p[:n])
+	}
+	switch {
+	case err == io.EOF:
+		b.finish(nil)
+	case err != nil:
+		b.finish(err)
+	}
+	return n, err
+}
+
+func (b *dumpingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.finish(nil)
+	return err
+}
+
+func (b *dumpingBody) finish(err error) {
+	b.once.Do(func() { b.done(b.head.Bytes(), b.truncated, err) })
+}
+
+func (d *DebugDumper) writeMessage(buf *bytes.Buffer, header http.Header, body []byte, truncated bool) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			if d.headers[http.CanonicalHeaderKey(k)] {
+				v = redactedValue
+			}
+			fmt.Fprintf(buf, "%s: %s\n", k, v)
+		}
+	}
+	if len(body) > 0 {
+		buf.WriteString("\n")
+		buf.WriteString(d.redactBody(header.Get("Content-Type"), body, truncated))
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+}
+
+func (d *DebugDumper) redactURL(u *url.URL) string {
+	if len(d.query) == 0 || u.RawQuery == "" {
+		return u.String()
+	}
+	query := u.Query()
+	for name := range query {
+		if d.query[name] {
+			query[name] = []string{redactedValue}
+		}
+	}
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactBody renders a body for the dump, redacting sensitive fields of JSON
+// and form bodies. Bodies which aren't text are summarized by their size, as
+// are truncated JSON and form bodies, which can't be redacted reliably.
+func (d *DebugDumper) redactBody(contentType string, body []byte, truncated bool) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	isJSON := mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+	isText := strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "xml") || mediaType == ""
+	switch {
+	case truncated && isText:
+		return string(body) + "... [truncated]"
+	case truncated:
+		return fmt.Sprintf("[more than %d bytes of %s]", len(body), mediaType)
+	case isJSON:
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return string(body)
+		}
+		redacted, err := json.Marshal(d.redactJSON(value))
+		if err != nil {
+			return string(body)
+		}
+		return string(redacted)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		for name := range values {
+			if d.fields[name] {
+				values[name] = []string{redactedValue}
+			}
+		}
+		return values.Encode()
+	case isText:
+		return string(body)
+	default:
+		return fmt.Sprintf("[%d bytes of %s]", len(body), mediaType)
+	}
+}
+
+func (d *DebugDumper) redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if d.fields[k] {
+				v[k] = redactedValue
+			} else {
+				v[k] = d.redactJSON(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = d.redactJSON(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,166 @@
+package helpers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+// echoDoer responds with the request body and content type.
+var echoDoer = doerFunc(func(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type": {req.Header.Get("Content-Type")},
+			"Set-Cookie":   {"session=abc"},
+		},
+		Body: io.NopCloser(bytes.NewReader(body)),
+	}, nil
+})
+
+func TestDebugDumper(t *testing.T) {
+	var out bytes.Buffer
+	dumper := NewDebugDumper(&out, DebugRedactions{
+		Headers:     []string{"x-api-key"},
+		QueryParams: []string{"token"},
+		Fields:      []string{"password"},
+	})
+	dumper.SetEnabled(true)
+	doer := dumper.Wrap(echoDoer)
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/users?token=secret&page=2",
+		strings.NewReader(`{"name":"ada","password":"hunter2","nested":[{"password":"x"}]}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("X-Api-Key", "secret")
+	req.Header.Set("X-Trace", "on")
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+
+	// The response is dumped once its body has been read.
+	assert.NotContains(t, out.String(), "<-- 200 OK")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "hunter2")
+
+	dump := out.String()
+	assert.NotContains(t, dump, "secret")
+	assert.NotContains(t, dump, "hunter2")
+	assert.NotContains(t, dump, "session=abc")
+	assert.Contains(t, dump, "--> POST https://example.com/users?page=2&token=%5BREDACTED%5D\n")
+	assert.Contains(t, dump, "Authorization: [REDACTED]\n")
+	assert.Contains(t, dump, "X-Api-Key: [REDACTED]\n")
+	assert.Contains(t, dump, "X-Trace: on\n")
+	assert.Contains(t, dump, `{"name":"ada","nested":[{"password":"[REDACTED]"}],"password":"[REDACTED]"}`)
+	assert.Contains(t, dump, "<-- 200 OK (")
+	assert.Contains(t, dump, "Set-Cookie: [REDACTED]\n")
+}
+
+func TestDebugDumper_RedactBody(t *testing.T) {
+	dumper := NewDebugDumper(io.Discard, DebugRedactions{Fields: []string{"pin"}})
+
+	assert.Equal(t, "name=a&pin=%5BREDACTED%5D", dumper.redactBody("application/x-www-form-urlencoded", []byte("pin=1234&name=a"), false))
+	assert.Equal(t, "plain pin=1234", dumper.redactBody("text/plain; charset=utf-8", []byte("plain pin=1234"), false))
+	assert.Equal(t, "[3 bytes of image/png]", dumper.redactBody("image/png", []byte{1, 2, 3}, false))
+	assert.Equal(t, `{"pin":"[REDACTED]"}`, dumper.redactBody("application/problem+json", []byte(`{"pin":1234}`), false))
+
+	// Truncated bodies which could hold sensitive fields aren't shown.
+	assert.Equal(t, "plain... [truncated]", dumper.redactBody("text/plain", []byte("plain"), true))
+	assert.Equal(t, "[more than 9 bytes of application/json]", dumper.redactBody("application/json", []byte(`{"pin":12`), true))
+}
+
+func TestDebugDumper_LargeBodiesStream(t *testing.T) {
+	var out bytes.Buffer
+	dumper := NewDebugDumper(&out, DebugRedactions{})
+	dumper.SetEnabled(true)
+	doer := dumper.Wrap(echoDoer)
+
+	payload := strings.Repeat("x", maxDumpBody+10)
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(payload))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+
+	// The whole body still goes through, but only its head is dumped.
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+	require.NoError(t, resp.Body.Close())
+
+	dump := out.String()
+	assert.Equal(t, 2, strings.Count(dump, "... [truncated]"))
+	assert.Less(t, len(dump), 3*maxDumpBody)
+}
+
+func TestDebugDumper_DumpedOnClose(t *testing.T) {
+	var out bytes.Buffer
+	dumper := NewDebugDumper(&out, DebugRedactions{})
+	dumper.SetEnabled(true)
+	doer := dumper.Wrap(echoDoer)
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader("unread"))
+	require.NoError(t, err)
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, 1, strings.Count(out.String(), "<-- 200 OK"))
+}
+
+func TestDebugDumper_Disabled(t *testing.T) {
+	var out bytes.Buffer
+	dumper := NewDebugDumper(&out, DebugRedactions{})
+	doer := dumper.Wrap(echoDoer)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+	require.NoError(t, err)
+
+	_, err = doer.Do(req)
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+
+	// Toggling takes effect on the next request.
+	dumper.SetEnabled(true)
+	assert.True(t, dumper.Enabled())
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Contains(t, out.String(), "--> GET https://example.com/\n")
+}
+
+func TestDebugDumper_Error(t *testing.T) {
+	var out bytes.Buffer
+	dumper := NewDebugDumper(&out, DebugRedactions{})
+	dumper.SetEnabled(true)
+	failed := errors.New("connection refused")
+	doer := dumper.Wrap(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, failed
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+	require.NoError(t, err)
+
+	_, err = doer.Do(req)
+	assert.ErrorIs(t, err, failed)
+	assert.Contains(t, out.String(), "connection refused")
+}
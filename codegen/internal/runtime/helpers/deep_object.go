@@ -0,0 +1,26 @@
+package helpers
+
+//oapi-runtime:function helpers/MarshalDeepObject
+
+import (
+	"net/url"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/params"
+)
+
+// MarshalDeepObject serializes i, a struct, map or slice, as the deepObject
+// query parameter paramName, such as
+// "filter[status]=open&filter[created][gte]=2024-01-01". Objects nest in
+// brackets, named by their json tags, and array items are indexed from 0. It
+// is the serialization generated clients send deepObject parameters with.
+func MarshalDeepObject(i any, paramName string) (string, error) {
+	return params.StyleDeepObjectParam(paramName, i)
+}
+
+// UnmarshalDeepObject binds the deepObject query parameter paramName from
+// values to dst, a pointer to a struct, map or slice, the way generated
+// servers bind deepObject parameters. A missing parameter leaves dst
+// unchanged.
+func UnmarshalDeepObject(dst any, paramName string, values url.Values) error {
+	return params.BindDeepObjectParam(paramName, values, dst, false)
+}
@@ -0,0 +1,43 @@
+package helpers
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalDeepObject_Roundtrip(t *testing.T) {
+	type created struct {
+		Gte string `json:"gte"`
+	}
+	type filter struct {
+		Status  string   `json:"status"`
+		Tags    []string `json:"tags"`
+		Created created  `json:"created"`
+	}
+
+	original := filter{Status: "open", Tags: []string{"a", "b"}, Created: created{Gte: "2024-01-01"}}
+	encoded, err := MarshalDeepObject(original, "filter")
+	require.NoError(t, err)
+	assert.Equal(t, "filter[created][gte]=2024-01-01&filter[status]=open&filter[tags][0]=a&filter[tags][1]=b", encoded)
+
+	values, err := url.ParseQuery(encoded)
+	require.NoError(t, err)
+	var decoded filter
+	require.NoError(t, UnmarshalDeepObject(&decoded, "filter", values))
+	assert.Equal(t, original, decoded)
+}
+
+func TestUnmarshalDeepObject_Map(t *testing.T) {
+	var labels map[string]string
+	require.NoError(t, UnmarshalDeepObject(&labels, "labels", url.Values{"labels[app]": {"web"}}))
+	assert.Equal(t, map[string]string{"app": "web"}, labels)
+}
+
+func TestUnmarshalDeepObject_Missing(t *testing.T) {
+	labels := map[string]string{"app": "web"}
+	require.NoError(t, UnmarshalDeepObject(&labels, "labels", url.Values{}))
+	assert.Equal(t, map[string]string{"app": "web"}, labels)
+}
@@ -0,0 +1,244 @@
+package helpers
+
+//oapi-runtime:function helpers/EventStream
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"sync"
+	"time"
+)
+
+const (
+	defaultReconnectDelay       = time.Second
+	defaultMaxReconnectDelay    = 30 * time.Second
+	defaultMaxReconnectAttempts = 5
+)
+
+// Reconnection describes an attempt of an EventStream to resume an
+// interrupted stream.
+type Reconnection struct {
+	// Attempt counts the reconnections since an event was last received,
+	// starting from 1.
+	Attempt int
+	// LastEventID is sent in the Last-Event-ID header of the new request.
+	LastEventID string
+	// Delay is how long the stream waits before reconnecting.
+	Delay time.Duration
+	// Err is what interrupted the stream, or nil if the server ended it.
+	Err error
+}
+
+// EventStream reads the events of a text/event-stream response whose data
+// is of type T, and resumes the stream when it is interrupted: it tracks the
+// ID of the last event received, and reconnects with it in the Last-Event-ID
+// header, so the server can pick up where it left off. Reconnections back
+// off exponentially, from the delay set by the server with a retry field, or
+// InitialDelay, up to MaxDelay. Set its fields before reading events.
+type EventStream[T any] struct {
+	// InitialDelay is the delay before reconnecting, unless the server sets
+	// one. Defaults to one second.
+	InitialDelay time.Duration
+	// MaxDelay bounds the delay between reconnections. Defaults to thirty
+	// seconds.
+	MaxDelay time.Duration
+	// MaxAttempts bounds the reconnections in a row which receive no event,
+	// after which the stream ends. Defaults to 5; negative means no limit.
+	MaxAttempts int
+	// OnReconnect, if set, is called before each reconnection.
+	OnReconnect func(Reconnection)
+	// Clock times the delays. Defaults to SystemClock.
+	Clock Clock
+
+	ctx       context.Context
+	reconnect func(ctx context.Context, lastEventID string) (io.ReadCloser, error)
+
+	mu            sync.Mutex
+	body          io.ReadCloser
+	lastEventID   string
+	reconnections int
+	closed        bool
+}
+
+// NewEventStream returns an EventStream reading body, which reconnects by
+// calling reconnect with the ID of the last event received. It stops when
+// ctx is done.
+func NewEventStream[T any](ctx context.Context, body io.ReadCloser, reconnect func(ctx context.Context, lastEventID string) (io.ReadCloser, error)) *EventStream[T] {
+	return &EventStream[T]{ctx: ctx, body: body, reconnect: reconnect}
+}
+
+// LastEventID returns the ID of the last event received.
+func (s *EventStream[T]) LastEventID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastEventID
+}
+
+// Reconnections returns the number of times the stream has reconnected.
+func (s *EventStream[T]) Reconnections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reconnections
+}
+
+// Close ends the stream, closing the current response body. Events closes
+// it too when its loop ends, so Close is only needed to stop the stream from
+// another goroutine, or when events are never read.
+func (s *EventStream[T]) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.body == nil {
+		return nil
+	}
+	return s.body.Close()
+}
+
+// Events returns an iterator over the events of the stream, carrying data
+// decoded from JSON, except strings, which are taken as is. Events without
+// data aren't yielded. The iterator yields an error at most once, as its
+// last value: when data can't be decoded, when ctx is done, or when
+// reconnecting fails MaxAttempts times in a row. It can only be ranged over
+// once.
+func (s *EventStream[T]) Events() iter.Seq2[Event[T], error] {
+	return func(yield func(Event[T], error) bool) {
+		defer s.Close()
+		clock := clockOrSystem(s.Clock)
+		delay := s.InitialDelay
+		if delay <= 0 {
+			delay = defaultReconnectDelay
+		}
+		maxAttempts := s.MaxAttempts
+		if maxAttempts == 0 {
+			maxAttempts = defaultMaxReconnectAttempts
+		}
+		attempt := 0
+		for {
+			received, stop, err := s.read(yield, &delay)
+			if stop || s.isClosed() {
+				return
+			}
+			if received {
+				attempt = 0
+			}
+
+			// Reconnect, backing off while attempts fail.
+			for {
+				if ctxErr := s.ctx.Err(); ctxErr != nil {
+					yield(Event[T]{}, ctxErr)
+					return
+				}
+				attempt++
+				if maxAttempts >= 0 && attempt > maxAttempts {
+					if err != nil {
+						yield(Event[T]{}, err)
+					}
+					return
+				}
+				wait := s.backoff(delay, attempt)
+				if s.OnReconnect != nil {
+					s.OnReconnect(Reconnection{Attempt: attempt, LastEventID: s.LastEventID(), Delay: wait, Err: err})
+				}
+				select {
+				case <-clock.After(wait):
+				case <-s.ctx.Done():
+					yield(Event[T]{}, s.ctx.Err())
+					return
+				}
+
+				var body io.ReadCloser
+				body, err = s.reconnect(s.ctx, s.LastEventID())
+				if err == nil {
+					if !s.setBody(body) {
+						return
+					}
+					break
+				}
+			}
+		}
+	}
+}
+
+// backoff returns the delay before reconnection attempt, doubling delay for
+// each failed attempt up to MaxDelay.
+func (s *EventStream[T]) backoff(delay time.Duration, attempt int) time.Duration {
+	maxDelay := s.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxReconnectDelay
+	}
+	for range attempt - 1 {
+		if delay >= maxDelay {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// isClosed reports whether Close was called.
+func (s *EventStream[T]) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// setBody replaces the body of a reconnected stream, and reports whether the
+// stream is still open.
+func (s *EventStream[T]) setBody(body io.ReadCloser) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		_ = body.Close()
+		return false
+	}
+	s.body = body
+	s.reconnections++
+	return true
+}
+
+// read yields the events of the current body until it ends, updating the
+// last event ID and the reconnection delay. It reports whether any event
+// was received, whether to stop, because the consumer did or data couldn't
+// be decoded, and what interrupted the stream.
+func (s *EventStream[T]) read(yield func(Event[T], error) bool, delay *time.Duration) (received, stop bool, err error) {
+	s.mu.Lock()
+	body := s.body
+	s.mu.Unlock()
+	defer body.Close()
+
+	events := bufio.NewReader(body)
+	for {
+		event, err := readEvent(events)
+		if errors.Is(err, io.EOF) {
+			return received, false, nil
+		}
+		if err != nil {
+			return received, false, err
+		}
+		if event.retry > 0 {
+			*delay = event.retry
+		}
+		s.mu.Lock()
+		if event.hasID {
+			s.lastEventID = event.id
+		}
+		// Events carry the last event ID, even if they have no id field.
+		id := s.lastEventID
+		s.mu.Unlock()
+		if !event.hasData {
+			continue
+		}
+		received = true
+		out := Event[T]{ID: id, Name: event.name, Retry: event.retry}
+		if err := decodeEventData(event.data, &out.Data); err != nil {
+			yield(out, err)
+			return received, true, nil
+		}
+		if !yield(out, nil) {
+			return received, true, nil
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingBody returns its contents, then err instead of io.EOF.
+type failingBody struct {
+	io.Reader
+	err error
+}
+
+func (b *failingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if errors.Is(err, io.EOF) {
+		err = b.err
+	}
+	return n, err
+}
+
+func (b *failingBody) Close() error { return nil }
+
+func TestEventStream_Resume(t *testing.T) {
+	interrupted := errors.New("connection reset")
+	unavailable := errors.New("unavailable")
+	var lastEventIDs []string
+	stream := NewEventStream[testEvent](context.Background(),
+		&failingBody{Reader: strings.NewReader("id: 1\ndata: {\"message\":\"one\"}\n\ndata: {\"message\":\"two\"}\n\n"), err: interrupted},
+		func(ctx context.Context, lastEventID string) (io.ReadCloser, error) {
+			lastEventIDs = append(lastEventIDs, lastEventID)
+			if len(lastEventIDs) == 2 {
+				return io.NopCloser(strings.NewReader("retry: 2000\nid: 3\ndata: {\"message\":\"three\"}\n\n")), nil
+			}
+			return nil, unavailable
+		})
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	stream.Clock = clock
+	stream.MaxAttempts = 2
+
+	var mu sync.Mutex
+	var reconnections []Reconnection
+	stream.OnReconnect = func(r Reconnection) {
+		mu.Lock()
+		defer mu.Unlock()
+		reconnections = append(reconnections, r)
+		go func() {
+			assert.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+			clock.Advance(r.Delay)
+		}()
+	}
+
+	var got []Event[testEvent]
+	var err error
+	for event, eventErr := range stream.Events() {
+		if eventErr != nil {
+			err = eventErr
+			break
+		}
+		got = append(got, event)
+	}
+
+	// Events without an id field carry the last event ID.
+	assert.Equal(t, []Event[testEvent]{
+		{ID: "1", Data: testEvent{Message: "one"}},
+		{ID: "1", Data: testEvent{Message: "two"}},
+		{ID: "3", Retry: 2 * time.Second, Data: testEvent{Message: "three"}},
+	}, got)
+	assert.Equal(t, "3", stream.LastEventID())
+	assert.Equal(t, 1, stream.Reconnections())
+	assert.Equal(t, []string{"1", "1", "3", "3"}, lastEventIDs)
+
+	// Failed attempts back off from the delay set by the server, until
+	// MaxAttempts fail in a row.
+	assert.ErrorIs(t, err, unavailable)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []Reconnection{
+		{Attempt: 1, LastEventID: "1", Delay: time.Second, Err: interrupted},
+		{Attempt: 2, LastEventID: "1", Delay: 2 * time.Second, Err: unavailable},
+		{Attempt: 1, LastEventID: "3", Delay: 2 * time.Second},
+		{Attempt: 2, LastEventID: "3", Delay: 4 * time.Second, Err: unavailable},
+	}, reconnections)
+}
+
+func TestEventStream_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := NewEventStream[string](ctx, io.NopCloser(strings.NewReader("data: first\n\n")),
+		func(ctx context.Context, lastEventID string) (io.ReadCloser, error) {
+			t.Fatal("reconnected after the context was done")
+			return nil, nil
+		})
+
+	var got []string
+	var err error
+	for event, eventErr := range stream.Events() {
+		if eventErr != nil {
+			err = eventErr
+			break
+		}
+		got = append(got, event.Data)
+		cancel()
+	}
+	assert.Equal(t, []string{"first"}, got)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestEventStream_DecodeError(t *testing.T) {
+	stream := NewEventStream[testEvent](context.Background(), io.NopCloser(strings.NewReader("data: not json\n\n")), nil)
+
+	var errs []error
+	for _, err := range stream.Events() {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.Error(t, errs[0])
+}
@@ -0,0 +1,170 @@
+package helpers
+
+//oapi-runtime:function helpers/EventWriter
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event is a server-sent event carrying Data. ID, Name and Retry are optional.
+type Event[T any] struct {
+	// ID sets the last event ID, which the client sends back in the
+	// Last-Event-ID header when it reconnects.
+	ID string
+	// Name is the event type, written as the event field. Clients dispatch
+	// unnamed events as "message".
+	Name string
+	// Retry asks the client to wait this long before reconnecting.
+	Retry time.Duration
+	Data  T
+}
+
+// EventWriter writes a text/event-stream response whose events carry data
+// of type T. Data is encoded as JSON, except strings, which are written as
+// is. Each event is flushed as soon as it is written. It is safe for
+// concurrent use, so a Heartbeat can run alongside the handler sending
+// events.
+type EventWriter[T any] struct {
+	// Clock spaces heartbeats. Defaults to SystemClock.
+	Clock Clock
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewEventWriter returns an EventWriter writing to w. When w is an
+// http.ResponseWriter, the headers of an event stream are set on it, so it
+// must be called before the response is written. Writers which aren't, such
+// as the *bufio.Writer of a fiber stream, are flushed if they can be.
+func NewEventWriter[T any](w io.Writer) *EventWriter[T] {
+	if rw, ok := w.(http.ResponseWriter); ok {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+	}
+	return &EventWriter[T]{w: w}
+}
+
+// Send writes an unnamed event carrying data.
+func (e *EventWriter[T]) Send(data T) error {
+	return e.SendEvent(Event[T]{Data: data})
+}
+
+// SendEvent writes event.
+func (e *EventWriter[T]) SendEvent(event Event[T]) error {
+	var data string
+	if s, ok := any(event.Data).(string); ok {
+		data = s
+	} else {
+		buf, err := json.Marshal(event.Data)
+		if err != nil {
+			return fmt.Errorf("encoding event data: %w", err)
+		}
+		data = string(buf)
+	}
+
+	var b strings.Builder
+	if err := writeEventField(&b, "id", event.ID); err != nil {
+		return err
+	}
+	if err := writeEventField(&b, "event", event.Name); err != nil {
+		return err
+	}
+	if event.Retry > 0 {
+		b.WriteString("retry: " + strconv.FormatInt(event.Retry.Milliseconds(), 10) + "\n")
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	return e.write(b.String())
+}
+
+// writeEventField writes a single-line field, unless value is empty.
+func writeEventField(b *strings.Builder, name, value string) error {
+	if value == "" {
+		return nil
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("event %s %q spans several lines", name, value)
+	}
+	b.WriteString(name + ": " + value + "\n")
+	return nil
+}
+
+// Retry asks the client to wait d before reconnecting, without sending an
+// event.
+func (e *EventWriter[T]) Retry(d time.Duration) error {
+	return e.write("retry: " + strconv.FormatInt(d.Milliseconds(), 10) + "\n\n")
+}
+
+// Comment writes a comment, which clients ignore. An empty comment is a
+// heartbeat, keeping proxies from closing an idle stream.
+func (e *EventWriter[T]) Comment(text string) error {
+	var b strings.Builder
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		b.WriteString(":" + line + "\n")
+	}
+	b.WriteString("\n")
+	return e.write(b.String())
+}
+
+// Heartbeat writes an empty comment every interval, until ctx is done or a
+// write fails. It is meant to run in its own goroutine, with the context of
+// the request:
+//
+//	go events.Heartbeat(r.Context(), 15*time.Second)
+func (e *EventWriter[T]) Heartbeat(ctx context.Context, interval time.Duration) error {
+	clock := clockOrSystem(e.Clock)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(interval):
+		}
+		if err := e.Comment(""); err != nil {
+			return err
+		}
+	}
+}
+
+// Flush sends what has been written so far to the client.
+func (e *EventWriter[T]) Flush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.flush()
+}
+
+func (e *EventWriter[T]) write(s string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := io.WriteString(e.w, s); err != nil {
+		return err
+	}
+	return e.flush()
+}
+
+func (e *EventWriter[T]) flush() error {
+	switch w := e.w.(type) {
+	case http.ResponseWriter:
+		err := http.NewResponseController(w).Flush()
+		if errors.Is(err, http.ErrNotSupported) {
+			return nil
+		}
+		return err
+	case *bufio.Writer:
+		return w.Flush()
+	case http.Flusher:
+		w.Flush()
+	}
+	return nil
+}
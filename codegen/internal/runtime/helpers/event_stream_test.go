@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	Message string `json:"message"`
+}
+
+func TestEventWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	events := NewEventWriter[testEvent](rec)
+
+	require.NoError(t, events.Send(testEvent{Message: "hello"}))
+	require.NoError(t, events.SendEvent(Event[testEvent]{
+		ID:    "2",
+		Name:  "update",
+		Retry: 3 * time.Second,
+		Data:  testEvent{Message: "world"},
+	}))
+	require.NoError(t, events.Retry(time.Second))
+	require.NoError(t, events.Comment("still there"))
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	assert.True(t, rec.Flushed)
+	assert.Equal(t, "data: {\"message\":\"hello\"}\n\n"+
+		"id: 2\nevent: update\nretry: 3000\ndata: {\"message\":\"world\"}\n\n"+
+		"retry: 1000\n\n"+
+		":still there\n\n", rec.Body.String())
+}
+
+func TestEventWriter_StringData(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	events := NewEventWriter[string](w)
+
+	require.NoError(t, events.Send("first\nsecond"))
+	// Writers which can be flushed are, after each event.
+	assert.Equal(t, "data: first\ndata: second\n\n", buf.String())
+
+	assert.Error(t, events.SendEvent(Event[string]{Name: "bad\nname", Data: "x"}))
+}
+
+func TestEventWriter_Heartbeat(t *testing.T) {
+	rec := httptest.NewRecorder()
+	events := NewEventWriter[testEvent](rec)
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	events.Clock = clock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- events.Heartbeat(ctx, 15*time.Second) }()
+
+	for range 2 {
+		require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+		clock.Advance(15 * time.Second)
+	}
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	events.mu.Lock()
+	defer events.mu.Unlock()
+	assert.Equal(t, ":\n\n:\n\n", rec.Body.String())
+}
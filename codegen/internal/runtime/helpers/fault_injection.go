@@ -0,0 +1,154 @@
+package helpers
+
+//oapi-runtime:function helpers/FaultInjector
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrInjectedFault is returned for requests failed by a FaultInjector, unless
+// the Fault sets its own error.
+var ErrInjectedFault = errors.New("injected fault")
+
+// Fault describes the failures a FaultInjector injects into the requests of an
+// operation. Rates are probabilities between 0 and 1, rolled independently for
+// every request.
+type Fault struct {
+	// Latency is added before each request is sent, or fails.
+	Latency time.Duration
+
+	// ErrorRate is the rate of requests which fail with Error without being
+	// sent.
+	ErrorRate float64
+	// Error defaults to ErrInjectedFault.
+	Error error
+
+	// StatusRate is the rate of requests which get an empty response with
+	// StatusCode without being sent.
+	StatusRate float64
+	// StatusCode defaults to 503 Service Unavailable.
+	StatusCode int
+
+	// MalformedRate is the rate of responses whose body is replaced with
+	// MalformedBody.
+	MalformedRate float64
+	// MalformedBody defaults to a truncated JSON document.
+	MalformedBody []byte
+}
+
+// FaultInjector injects latency, errors, error statuses and malformed response
+// bodies into requests, per operationId, to test how code using a generated
+// client copes with an unreliable service. The operationId of a request is
+// read from its context, see ContextWithOperationID.
+type FaultInjector struct {
+	// Operations holds the faults for each operationId.
+	Operations map[string]Fault
+	// Default applies to operations not listed in Operations, and to requests
+	// without an operationId.
+	Default Fault
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it to make injected faults deterministic.
+	Rand func() float64
+	// Clock times the injected latency. Defaults to the clock passed to
+	// WrapWithClock, or SystemClock.
+	Clock Clock
+}
+
+// Wrap returns a doer which sends requests through doer, injecting faults.
+func (f *FaultInjector) Wrap(doer HTTPDoer) HTTPDoer {
+	return f.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing injected latency with clock unless f has a
+// Clock of its own. It lets an injector shared by several clients follow each
+// client's clock.
+func (f *FaultInjector) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	return &faultDoer{injector: f, doer: doer, clock: clock}
+}
+
+func (f *FaultInjector) fault(req *http.Request) Fault {
+	if operationID, ok := OperationIDFromContext(req.Context()); ok {
+		if fault, ok := f.Operations[operationID]; ok {
+			return fault
+		}
+	}
+	return f.Default
+}
+
+func (f *FaultInjector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	random := f.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < rate
+}
+
+type faultDoer struct {
+	injector *FaultInjector
+	doer     HTTPDoer
+	clock    Clock
+}
+
+func (fd *faultDoer) Do(req *http.Request) (*http.Response, error) {
+	f := fd.injector
+	fault := f.fault(req)
+
+	if fault.Latency > 0 {
+		clock := f.Clock
+		if clock == nil {
+			clock = fd.clock
+		}
+		select {
+		case <-clockOrSystem(clock).After(fault.Latency):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if f.roll(fault.ErrorRate) {
+		if fault.Error != nil {
+			return nil, fault.Error
+		}
+		return nil, ErrInjectedFault
+	}
+
+	if f.roll(fault.StatusRate) {
+		statusCode := fault.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusServiceUnavailable
+		}
+		return &http.Response{
+			Status:     strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+			StatusCode: statusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+
+	resp, err := fd.doer.Do(req)
+	if err != nil || !f.roll(fault.MalformedRate) {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	body := fault.MalformedBody
+	if body == nil {
+		body = []byte(`{"malformed": [tru`)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
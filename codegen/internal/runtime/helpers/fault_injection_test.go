@@ -0,0 +1,158 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// okDoer responds 200 with a JSON body and counts the requests it gets.
+type okDoer struct{ calls int }
+
+func (d *okDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"ok":true}`)),
+	}, nil
+}
+
+func newOperationRequest(t *testing.T, ctx context.Context, operationID string) *http.Request {
+	t.Helper()
+	if operationID != "" {
+		ctx = ContextWithOperationID(ctx, operationID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/", nil)
+	require.NoError(t, err)
+	return req
+}
+
+func TestOperationIDFromContext(t *testing.T) {
+	_, ok := OperationIDFromContext(context.Background())
+	assert.False(t, ok)
+
+	operationID, ok := OperationIDFromContext(ContextWithOperationID(context.Background(), "findPets"))
+	assert.True(t, ok)
+	assert.Equal(t, "findPets", operationID)
+}
+
+func TestFaultInjector_PerOperation(t *testing.T) {
+	failed := errors.New("boom")
+	injector := &FaultInjector{
+		Operations: map[string]Fault{
+			"findPets":  {ErrorRate: 1, Error: failed},
+			"addPet":    {StatusRate: 1, StatusCode: http.StatusTooManyRequests},
+			"deletePet": {MalformedRate: 1},
+		},
+	}
+	inner := &okDoer{}
+	doer := injector.Wrap(inner)
+
+	_, err := doer.Do(newOperationRequest(t, context.Background(), "findPets"))
+	assert.ErrorIs(t, err, failed)
+
+	resp, err := doer.Do(newOperationRequest(t, context.Background(), "addPet"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "429 Too Many Requests", resp.Status)
+
+	assert.Equal(t, 0, inner.calls, "failed requests are not sent")
+
+	resp, err = doer.Do(newOperationRequest(t, context.Background(), "deletePet"))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"malformed": [tru`, string(body))
+
+	// Operations without faults, and requests without an operationId, are
+	// passed through.
+	for _, operationID := range []string{"getPet", ""} {
+		resp, err = doer.Do(newOperationRequest(t, context.Background(), operationID))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, 3, inner.calls)
+}
+
+func TestFaultInjector_Default(t *testing.T) {
+	injector := &FaultInjector{
+		Default: Fault{ErrorRate: 1},
+		Operations: map[string]Fault{
+			"healthy": {},
+		},
+	}
+	doer := injector.Wrap(&okDoer{})
+
+	_, err := doer.Do(newOperationRequest(t, context.Background(), "anything"))
+	assert.ErrorIs(t, err, ErrInjectedFault)
+
+	_, err = doer.Do(newOperationRequest(t, context.Background(), "healthy"))
+	assert.NoError(t, err)
+}
+
+func TestFaultInjector_Rates(t *testing.T) {
+	rolls := []float64{0.1, 0.6}
+	injector := &FaultInjector{
+		Default: Fault{ErrorRate: 0.5},
+		Rand: func() float64 {
+			r := rolls[0]
+			rolls = rolls[1:]
+			return r
+		},
+	}
+	doer := injector.Wrap(&okDoer{})
+
+	_, err := doer.Do(newOperationRequest(t, context.Background(), ""))
+	assert.ErrorIs(t, err, ErrInjectedFault)
+	_, err = doer.Do(newOperationRequest(t, context.Background(), ""))
+	assert.NoError(t, err)
+}
+
+func TestFaultInjector_Latency(t *testing.T) {
+	injector := &FaultInjector{Default: Fault{Latency: 20 * time.Millisecond}}
+	doer := injector.Wrap(&okDoer{})
+
+	start := time.Now()
+	_, err := doer.Do(newOperationRequest(t, context.Background(), ""))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	// Latency is cut short by the request's context.
+	injector.Default.Latency = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = doer.Do(newOperationRequest(t, ctx, ""))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFaultInjector_LatencyWithFakeClock(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	injector := &FaultInjector{Default: Fault{Latency: time.Minute}}
+	doer := injector.WrapWithClock(&okDoer{}, clock)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := doer.Do(newOperationRequest(t, context.Background(), ""))
+		done <- err
+	}()
+
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("request sent before the latency elapsed")
+	default:
+	}
+	clock.Advance(time.Minute)
+	assert.NoError(t, <-done)
+
+	// The injector itself is left alone, so it can be shared.
+	assert.Nil(t, injector.Clock)
+}
@@ -0,0 +1,705 @@
+package helpers
+
+//oapi-runtime:function helpers/FormEncoding
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FormEncoding describes how a property of an application/x-www-form-urlencoded
+// or multipart request body is serialized. It mirrors the OpenAPI Encoding
+// Object.
+type FormEncoding struct {
+	// ContentType of the property. For multipart bodies this is the part's
+	// Content-Type. A JSON content type sends the property as JSON text.
+	ContentType string
+	// Headers are additional headers sent with a multipart part.
+	Headers map[string]string
+	// Style is the serialization of a url-encoded property: "form" (the
+	// default), "spaceDelimited", "pipeDelimited" or "deepObject".
+	Style string
+	// Explode controls whether arrays and objects produce a value per item.
+	// OpenAPI defaults it to true for the form style, and false otherwise.
+	Explode bool
+}
+
+func (e FormEncoding) style() string {
+	if e.Style == "" {
+		return "form"
+	}
+	return e.Style
+}
+
+func (e FormEncoding) isJSON() bool {
+	return strings.Contains(e.ContentType, "json")
+}
+
+// partContentType returns the first declared content type, or def when none
+// is declared.
+func (e FormEncoding) partContentType(def string) string {
+	if e.ContentType == "" {
+		return def
+	}
+	ct, _, _ := strings.Cut(e.ContentType, ",")
+	return strings.TrimSpace(ct)
+}
+
+func (e FormEncoding) delimiter() string {
+	switch e.style() {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	default:
+		return ","
+	}
+}
+
+// formFile is implemented by file types, such as types.File, which are sent
+// as multipart file parts.
+type formFile interface {
+	Filename() string
+	Reader() (io.ReadCloser, error)
+}
+
+// typedFormFile is implemented by file types which carry their own
+// Content-Type, sent in place of the one declared by the encoding.
+type typedFormFile interface {
+	ContentType() string
+}
+
+// multipartFile is implemented by file types which can be bound from a
+// multipart file part.
+type multipartFile interface {
+	InitFromMultipart(header *multipart.FileHeader)
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	multipartFileType   = reflect.TypeFor[multipartFile]()
+)
+
+// formField is a set top-level property of a form body.
+type formField struct {
+	name  string
+	value reflect.Value
+}
+
+// formFields returns the set properties of a struct, named by their json tags.
+func formFields(body interface{}) ([]formField, error) {
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("form data body should be a struct")
+	}
+	t := v.Type()
+	var fields []formField
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		name, omitEmpty, ok := formFieldName(t.Field(i))
+		if !ok || !field.CanInterface() {
+			continue
+		}
+		if omitEmpty && field.IsZero() {
+			continue
+		}
+		if (field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface) && field.IsNil() {
+			continue
+		}
+		fields = append(fields, formField{name: name, value: field})
+	}
+	return fields, nil
+}
+
+// formFieldName returns the property name of a struct field from its json
+// tag. ok is false for fields which are not serialized.
+func formFieldName(f reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	tag := f.Tag.Get("json")
+	if !f.IsExported() || tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, strings.Contains(opts, "omitempty"), true
+}
+
+// MarshalFormEncoded marshals a struct into url.Values like MarshalForm, but
+// serializes the properties listed in encodings using their declared style,
+// explode and content type.
+func MarshalFormEncoded(body interface{}, encodings map[string]FormEncoding) (url.Values, error) {
+	fields, err := formFields(body)
+	if err != nil {
+		return nil, err
+	}
+	result := make(url.Values)
+	for _, f := range fields {
+		enc, ok := encodings[f.name]
+		if !ok {
+			marshalFormImpl(f.value, result, f.name)
+			continue
+		}
+		if err := encodeFormField(result, f.name, f.value, enc); err != nil {
+			return nil, fmt.Errorf("encoding form field %s: %w", f.name, err)
+		}
+	}
+	return result, nil
+}
+
+func encodeFormField(result url.Values, name string, v reflect.Value, enc FormEncoding) error {
+	if enc.isJSON() {
+		buf, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		result.Add(name, string(buf))
+		return nil
+	}
+
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch {
+	case isFormArray(v.Type()):
+		items := make([]string, v.Len())
+		for i := range items {
+			s, err := formatFormValue(v.Index(i))
+			if err != nil {
+				return err
+			}
+			items[i] = s
+		}
+		if enc.style() == "form" && enc.Explode {
+			result[name] = append(result[name], items...)
+		} else {
+			result.Add(name, strings.Join(items, enc.delimiter()))
+		}
+	case isFormObject(v.Type()):
+		keys, values, err := formObjectProperties(v)
+		if err != nil {
+			return err
+		}
+		switch {
+		case enc.style() == "deepObject":
+			for i, key := range keys {
+				result.Add(name+"["+key+"]", values[i])
+			}
+		case enc.Explode:
+			for i, key := range keys {
+				result.Add(key, values[i])
+			}
+		default:
+			pairs := make([]string, 0, 2*len(keys))
+			for i, key := range keys {
+				pairs = append(pairs, key, values[i])
+			}
+			result.Add(name, strings.Join(pairs, ","))
+		}
+	default:
+		s, err := formatFormValue(v)
+		if err != nil {
+			return err
+		}
+		result.Add(name, s)
+	}
+	return nil
+}
+
+// MarshalMultipart writes a struct as a multipart body of the given media
+// type, such as multipart/form-data or multipart/mixed, with a part per
+// property named by its json tag. Files are sent as file parts, objects as
+// JSON and arrays as one part per item. encodings may override the content
+// type of a part and add headers to it. It returns the body and its
+// Content-Type, which is mediaType with the boundary added. An empty
+// mediaType means multipart/form-data.
+func MarshalMultipart(body interface{}, mediaType string, encodings map[string]FormEncoding) (*bytes.Buffer, string, error) {
+	if mediaType == "" {
+		mediaType = "multipart/form-data"
+	}
+	mediaType, params, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing multipart media type: %w", err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, "", fmt.Errorf("%s is not a multipart media type", mediaType)
+	}
+	fields, err := formFields(body)
+	if err != nil {
+		return nil, "", err
+	}
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	isFormData := mediaType == "multipart/form-data"
+	for _, f := range fields {
+		if err := writeMultipartField(w, isFormData, f.name, f.value, encodings[f.name]); err != nil {
+			return nil, "", fmt.Errorf("writing multipart field %s: %w", f.name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	params["boundary"] = w.Boundary()
+	return &buf, mime.FormatMediaType(mediaType, params), nil
+}
+
+func writeMultipartField(w *multipart.Writer, isFormData bool, name string, v reflect.Value, enc FormEncoding) error {
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	if file, ok := v.Interface().(formFile); ok {
+		r, err := file.Reader()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = r.Close() }()
+		filename := file.Filename()
+		if filename == "" {
+			filename = name
+		}
+		contentType := enc.partContentType("application/octet-stream")
+		if typed, ok := file.(typedFormFile); ok && typed.ContentType() != "" {
+			contentType = typed.ContentType()
+		}
+		return writeMultipartPart(w, isFormData, name, filename, contentType, enc.Headers, r)
+	}
+
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return writeMultipartPart(w, isFormData, name, name, enc.partContentType("application/octet-stream"), enc.Headers, bytes.NewReader(v.Bytes()))
+	case isFormArray(v.Type()) && !enc.isJSON():
+		for i := 0; i < v.Len(); i++ {
+			if err := writeMultipartField(w, isFormData, name, v.Index(i), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	case enc.isJSON() || (enc.ContentType == "" && isFormObject(v.Type())):
+		buf, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		return writeMultipartPart(w, isFormData, name, "", enc.partContentType("application/json"), enc.Headers, bytes.NewReader(buf))
+	default:
+		s, err := formatFormValue(v)
+		if err != nil {
+			return err
+		}
+		return writeMultipartPart(w, isFormData, name, "", enc.partContentType(""), enc.Headers, strings.NewReader(s))
+	}
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// writeMultipartPart writes a part named name. Parts of multipart/form-data
+// bodies carry a form-data disposition; parts of other multipart types are
+// inline, or attachments when they carry a file.
+func writeMultipartPart(w *multipart.Writer, isFormData bool, name, filename, contentType string, headers map[string]string, content io.Reader) error {
+	h := make(textproto.MIMEHeader)
+	for k, v := range headers {
+		// The part's Content-Type is described by the encoding's contentType
+		if !strings.EqualFold(k, "Content-Type") {
+			h.Set(k, v)
+		}
+	}
+	dispositionType := "form-data"
+	if !isFormData {
+		dispositionType = "inline"
+		if filename != "" {
+			dispositionType = "attachment"
+		}
+	}
+	disposition := fmt.Sprintf(`%s; name="%s"`, dispositionType, quoteEscaper.Replace(name))
+	if filename != "" {
+		disposition += fmt.Sprintf(`; filename="%s"`, quoteEscaper.Replace(filename))
+	}
+	h.Set("Content-Disposition", disposition)
+	if contentType != "" {
+		h.Set("Content-Type", contentType)
+	}
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, content)
+	return err
+}
+
+// BindFormValues decodes url-encoded form values into dst, which must be a
+// pointer to a struct whose json tags name the form properties. Properties
+// listed in encodings are parsed using their declared style, explode and
+// content type.
+func BindFormValues(values url.Values, dst interface{}, encodings map[string]FormEncoding) error {
+	return bindForm(values, nil, dst, encodings, false)
+}
+
+// BindMultipartForm decodes a parsed multipart form into dst, which must be a
+// pointer to a struct whose json tags name the parts. File parts are bound to
+// file types such as types.File, and object properties are decoded from JSON
+// unless encodings declares another content type.
+func BindMultipartForm(form *multipart.Form, dst interface{}, encodings map[string]FormEncoding) error {
+	if form == nil {
+		return errors.New("multipart form is nil")
+	}
+	return bindForm(form.Value, form.File, dst, encodings, true)
+}
+
+func bindForm(values url.Values, files map[string][]*multipart.FileHeader, dst interface{}, encodings map[string]FormEncoding, isMultipart bool) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("form data destination should be a pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, ok := formFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		enc, declared := encodings[name]
+		if !declared && !isMultipart {
+			// Properties without an encoding are bound in the format written
+			// by MarshalForm: "name[0]" for arrays and "name[key]" for objects.
+			enc = FormEncoding{Style: "deepObject", Explode: true}
+		}
+		if err := bindFormField(v.Field(i), name, values, files, enc, isMultipart); err != nil {
+			return fmt.Errorf("binding form field %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func bindFormField(field reflect.Value, name string, values url.Values, files map[string][]*multipart.FileHeader, enc FormEncoding, isMultipart bool) error {
+	typ := field.Type()
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	// File parts bound to file types
+	if headers := files[name]; len(headers) > 0 {
+		switch {
+		case reflect.PointerTo(typ).Implements(multipartFileType):
+			allocFormValue(field).Addr().Interface().(multipartFile).InitFromMultipart(headers[0])
+			return nil
+		case typ.Kind() == reflect.Slice && reflect.PointerTo(typ.Elem()).Implements(multipartFileType):
+			target := allocFormValue(field)
+			target.Set(reflect.MakeSlice(typ, len(headers), len(headers)))
+			for i, header := range headers {
+				target.Index(i).Addr().Interface().(multipartFile).InitFromMultipart(header)
+			}
+			return nil
+		}
+	}
+
+	raw, err := rawFormValues(name, values, files)
+	if err != nil {
+		return err
+	}
+
+	if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+		if len(raw) == 0 {
+			return nil
+		}
+		allocFormValue(field).SetBytes([]byte(raw[0]))
+		return nil
+	}
+
+	if enc.isJSON() || (isMultipart && enc.ContentType == "" && isFormObject(typ)) {
+		if len(raw) == 0 {
+			return nil
+		}
+		return json.Unmarshal([]byte(raw[0]), allocFormValue(field).Addr().Interface())
+	}
+
+	switch {
+	case isFormArray(typ):
+		items := raw
+		switch {
+		case isMultipart:
+		case enc.style() == "deepObject":
+			items = indexedFormValues(name, values)
+		case !(enc.style() == "form" && enc.Explode) && len(raw) > 0:
+			items = strings.Split(raw[0], enc.delimiter())
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		jsonItems := isMultipart && enc.ContentType == "" && isFormObject(typ.Elem())
+		target := allocFormValue(field)
+		target.Set(reflect.MakeSlice(typ, len(items), len(items)))
+		for i, item := range items {
+			var err error
+			if jsonItems {
+				err = json.Unmarshal([]byte(item), target.Index(i).Addr().Interface())
+			} else {
+				err = setFormScalar(target.Index(i), item)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case isFormObject(typ) && !isMultipart:
+		props, err := formObjectValues(name, typ, values, raw, enc)
+		if err != nil || len(props) == 0 {
+			return err
+		}
+		return setFormObject(allocFormValue(field), props)
+	default:
+		if len(raw) == 0 {
+			return nil
+		}
+		return setFormScalar(allocFormValue(field), raw[0])
+	}
+}
+
+// rawFormValues returns the values of a property, reading the content of file
+// parts which are not bound to a file type.
+func rawFormValues(name string, values url.Values, files map[string][]*multipart.FileHeader) ([]string, error) {
+	raw := values[name]
+	for _, header := range files[name] {
+		f, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, string(data))
+	}
+	return raw, nil
+}
+
+// indexedFormValues returns the values of "name[0]", "name[1]", and so on,
+// falling back to repeated "name" values.
+func indexedFormValues(name string, values url.Values) []string {
+	var items []string
+	for i := 0; ; i++ {
+		vals := values[name+"["+strconv.Itoa(i)+"]"]
+		if len(vals) == 0 {
+			break
+		}
+		items = append(items, vals[0])
+	}
+	if len(items) == 0 {
+		return values[name]
+	}
+	return items
+}
+
+// formObjectValues collects the properties of an object serialized with the
+// given style into a map.
+func formObjectValues(name string, typ reflect.Type, values url.Values, raw []string, enc FormEncoding) (map[string]string, error) {
+	props := make(map[string]string)
+	switch {
+	case enc.style() == "deepObject":
+		prefix := name + "["
+		for key, vals := range values {
+			if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, "]") && len(vals) > 0 {
+				props[key[len(prefix):len(key)-1]] = vals[0]
+			}
+		}
+	case enc.Explode:
+		if typ.Kind() != reflect.Struct {
+			return nil, errors.New("exploded form style is only supported for objects with fixed properties")
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			if prop, _, ok := formFieldName(typ.Field(i)); ok {
+				if vals := values[prop]; len(vals) > 0 {
+					props[prop] = vals[0]
+				}
+			}
+		}
+	default:
+		if len(raw) == 0 {
+			return nil, nil
+		}
+		parts := strings.Split(raw[0], ",")
+		if len(parts)%2 != 0 {
+			return nil, fmt.Errorf("invalid object value %q", raw[0])
+		}
+		for i := 0; i < len(parts); i += 2 {
+			props[parts[i]] = parts[i+1]
+		}
+	}
+	return props, nil
+}
+
+func setFormObject(v reflect.Value, props map[string]string) error {
+	if v.Kind() == reflect.Map {
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for key, s := range props {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setFormScalar(elem, s); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		return nil
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		prop, _, ok := formFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		if s, ok := props[prop]; ok {
+			if err := setFormScalar(allocFormValue(v.Field(i)), s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setFormScalar parses s into v according to its kind.
+func setFormScalar(v reflect.Value, s string) error {
+	v = allocFormValue(v)
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		// Types such as dates unmarshal from a JSON string
+		if err := json.Unmarshal([]byte(s), v.Addr().Interface()); err != nil {
+			return json.Unmarshal([]byte(strconv.Quote(s)), v.Addr().Interface())
+		}
+	}
+	return nil
+}
+
+// formatFormValue renders a single value as form text.
+func formatFormValue(v reflect.Value) (string, error) {
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return "", nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if isFormObject(v.Type()) || isFormArray(v.Type()) {
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// formObjectProperties returns the set properties of a struct or map in a
+// deterministic order, with their values rendered as form text.
+func formObjectProperties(v reflect.Value) (keys []string, values []string, err error) {
+	if v.Kind() == reflect.Map {
+		byKey := make(map[string]reflect.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			byKey[key] = iter.Value()
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			s, err := formatFormValue(byKey[key])
+			if err != nil {
+				return nil, nil, err
+			}
+			values = append(values, s)
+		}
+		return keys, values, nil
+	}
+
+	fields, err := formFields(v.Interface())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range fields {
+		s, err := formatFormValue(f.value)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, f.name)
+		values = append(values, s)
+	}
+	return keys, values, nil
+}
+
+func indirectFormValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// allocFormValue follows pointers from v, allocating nil ones.
+func allocFormValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isFormArray(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() != reflect.Uint8
+}
+
+func isFormObject(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(multipartFileType) {
+		return false
+	}
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Map
+}
@@ -0,0 +1,300 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testFile is a minimal stand-in for types.File.
+type testFile struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+func (f testFile) Filename() string { return f.name }
+
+func (f testFile) ContentType() string { return f.contentType }
+
+func (f testFile) Reader() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(f.data)), nil
+}
+
+func (f *testFile) InitFromMultipart(header *multipart.FileHeader) {
+	r, _ := header.Open()
+	f.data, _ = io.ReadAll(r)
+	f.name = header.Filename
+}
+
+type formAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type formBody struct {
+	Name    string            `json:"name"`
+	Tags    []string          `json:"tags,omitempty"`
+	Colors  []string          `json:"colors,omitempty"`
+	Address *formAddress      `json:"address,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Count   *int              `json:"count,omitempty"`
+}
+
+func TestMarshalFormEncoded(t *testing.T) {
+	count := 3
+	body := formBody{
+		Name:    "fido",
+		Tags:    []string{"a", "b"},
+		Colors:  []string{"red", "blue"},
+		Address: &formAddress{City: "Paris", Zip: "75001"},
+		Count:   &count,
+	}
+
+	tests := map[string]struct {
+		encodings map[string]FormEncoding
+		want      url.Values
+	}{
+		"no encodings uses MarshalForm": {
+			want: url.Values{
+				"name": {"fido"}, "tags[0]": {"a"}, "tags[1]": {"b"}, "colors[0]": {"red"}, "colors[1]": {"blue"},
+				"address[city]": {"Paris"}, "address[zip]": {"75001"}, "count": {"3"},
+			},
+		},
+		"styles": {
+			encodings: map[string]FormEncoding{
+				"tags":    {Explode: true},
+				"colors":  {Style: "pipeDelimited"},
+				"address": {ContentType: "application/json"},
+				"count":   {},
+			},
+			want: url.Values{
+				"name": {"fido"}, "tags": {"a", "b"}, "colors": {"red|blue"},
+				"address": {`{"city":"Paris","zip":"75001"}`}, "count": {"3"},
+			},
+		},
+		"deepObject": {
+			encodings: map[string]FormEncoding{"address": {Style: "deepObject", Explode: true}},
+			want: url.Values{
+				"name": {"fido"}, "tags[0]": {"a"}, "tags[1]": {"b"}, "colors[0]": {"red"}, "colors[1]": {"blue"},
+				"address[city]": {"Paris"}, "address[zip]": {"75001"}, "count": {"3"},
+			},
+		},
+		"form without explode": {
+			encodings: map[string]FormEncoding{
+				"tags":    {},
+				"address": {},
+			},
+			want: url.Values{
+				"name": {"fido"}, "tags": {"a,b"}, "colors[0]": {"red"}, "colors[1]": {"blue"},
+				"address": {"city,Paris,zip,75001"}, "count": {"3"},
+			},
+		},
+		"exploded form object": {
+			encodings: map[string]FormEncoding{"address": {Explode: true}},
+			want: url.Values{
+				"name": {"fido"}, "tags[0]": {"a"}, "tags[1]": {"b"}, "colors[0]": {"red"}, "colors[1]": {"blue"},
+				"city": {"Paris"}, "zip": {"75001"}, "count": {"3"},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := MarshalFormEncoded(body, tc.encodings)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestBindFormValues_RoundTrip(t *testing.T) {
+	count := 3
+	body := formBody{
+		Name:    "fido",
+		Tags:    []string{"a", "b"},
+		Colors:  []string{"red", "blue"},
+		Address: &formAddress{City: "Paris"},
+		Labels:  map[string]string{"x": "1"},
+		Count:   &count,
+	}
+	encodings := map[string]FormEncoding{
+		"tags":    {Explode: true},
+		"colors":  {Style: "spaceDelimited"},
+		"address": {},
+		"labels":  {Style: "deepObject"},
+	}
+
+	values, err := MarshalFormEncoded(body, encodings)
+	require.NoError(t, err)
+
+	var got formBody
+	require.NoError(t, BindFormValues(values, &got, encodings))
+	assert.Equal(t, body, got)
+}
+
+type multipartBody struct {
+	Title    string       `json:"title"`
+	Meta     formAddress  `json:"meta"`
+	Photo    testFile     `json:"photo"`
+	Extras   []testFile   `json:"extras,omitempty"`
+	Keywords []string     `json:"keywords,omitempty"`
+	Raw      []byte       `json:"raw,omitempty"`
+	Note     *formAddress `json:"note,omitempty"`
+}
+
+func TestMarshalMultipart(t *testing.T) {
+	body := multipartBody{
+		Title:    "holiday",
+		Meta:     formAddress{City: "Rome"},
+		Photo:    testFile{name: "a.png", data: []byte("png-data")},
+		Keywords: []string{"sun", "sea"},
+	}
+	encodings := map[string]FormEncoding{
+		"photo": {ContentType: "image/png, image/jpeg", Headers: map[string]string{"X-Rate-Limit": "10"}},
+	}
+
+	buf, contentType, err := MarshalMultipart(body, "multipart/form-data", encodings)
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	r := multipart.NewReader(buf, params["boundary"])
+	type part struct {
+		name, filename, contentType, rateLimit, body string
+	}
+	var parts []part
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(p)
+		require.NoError(t, err)
+		parts = append(parts, part{p.FormName(), p.FileName(), p.Header.Get("Content-Type"), p.Header.Get("X-Rate-Limit"), string(data)})
+	}
+
+	assert.Equal(t, []part{
+		{name: "title", body: "holiday"},
+		{name: "meta", contentType: "application/json", body: `{"city":"Rome"}`},
+		{name: "photo", filename: "a.png", contentType: "image/png", rateLimit: "10", body: "png-data"},
+		{name: "keywords", body: "sun"},
+		{name: "keywords", body: "sea"},
+	}, parts)
+}
+
+func TestMarshalMultipart_FileContentType(t *testing.T) {
+	body := multipartBody{
+		Title:  "holiday",
+		Photo:  testFile{name: "a.gif", contentType: "image/gif", data: []byte("gif-data")},
+		Extras: []testFile{{name: "b.bin", data: []byte("b")}},
+	}
+	encodings := map[string]FormEncoding{
+		"photo": {ContentType: "image/png, image/jpeg"},
+	}
+
+	buf, contentType, err := MarshalMultipart(body, "", encodings)
+	require.NoError(t, err)
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	form, err := multipart.NewReader(buf, params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+
+	// The file's own content type wins over the declared one
+	require.Len(t, form.File["photo"], 1)
+	assert.Equal(t, "a.gif", form.File["photo"][0].Filename)
+	assert.Equal(t, "image/gif", form.File["photo"][0].Header.Get("Content-Type"))
+	require.Len(t, form.File["extras"], 1)
+	assert.Equal(t, "application/octet-stream", form.File["extras"][0].Header.Get("Content-Type"))
+}
+
+func TestMarshalMultipart_Mixed(t *testing.T) {
+	body := multipartBody{
+		Title: "holiday",
+		Photo: testFile{name: "a.png", data: []byte("png-data")},
+	}
+
+	buf, contentType, err := MarshalMultipart(body, "multipart/mixed", nil)
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(buf, params["boundary"])
+	var dispositions []string
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		dispositions = append(dispositions, p.Header.Get("Content-Disposition"))
+	}
+	assert.Equal(t, []string{
+		`inline; name="title"`,
+		`inline; name="meta"`,
+		`attachment; name="photo"; filename="a.png"`,
+	}, dispositions)
+}
+
+func TestMarshalMultipart_InvalidMediaType(t *testing.T) {
+	_, _, err := MarshalMultipart(multipartBody{}, "application/json", nil)
+	assert.Error(t, err)
+}
+
+func TestBindMultipartForm_RoundTrip(t *testing.T) {
+	body := multipartBody{
+		Title:    "holiday",
+		Meta:     formAddress{City: "Rome", Zip: "00100"},
+		Photo:    testFile{name: "a.png", data: []byte("png-data")},
+		Extras:   []testFile{{name: "b.txt", data: []byte("b")}, {name: "c.txt", data: []byte("c")}},
+		Keywords: []string{"sun", "sea"},
+		Raw:      []byte("raw-bytes"),
+		Note:     &formAddress{City: "Milan"},
+	}
+
+	buf, contentType, err := MarshalMultipart(body, "", nil)
+	require.NoError(t, err)
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	form, err := multipart.NewReader(buf, params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+
+	var got multipartBody
+	require.NoError(t, BindMultipartForm(form, &got, nil))
+	assert.Equal(t, body, got)
+}
+
+func TestBindFormValues_MarshalForm(t *testing.T) {
+	count := 3
+	body := formBody{
+		Name:    "fido",
+		Tags:    []string{"a", "b"},
+		Address: &formAddress{City: "Paris", Zip: "75001"},
+		Count:   &count,
+	}
+
+	values, err := MarshalForm(body)
+	require.NoError(t, err)
+
+	var got formBody
+	require.NoError(t, BindFormValues(values, &got, nil))
+	assert.Equal(t, body, got)
+}
+
+func TestBindForm_InvalidDestination(t *testing.T) {
+	var notStruct string
+	assert.Error(t, BindFormValues(url.Values{}, &notStruct, nil))
+	assert.Error(t, BindFormValues(url.Values{}, formBody{}, nil))
+	assert.Error(t, BindMultipartForm(nil, &formBody{}, nil))
+}
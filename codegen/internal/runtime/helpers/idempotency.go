@@ -0,0 +1,31 @@
+package helpers
+
+//oapi-runtime:function helpers/NewIdempotencyKey
+
+import (
+	crand "crypto/rand"
+	"fmt"
+)
+
+// nilUUID is the nil UUID, as a uuid-typed parameter left at its zero value
+// is serialized.
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+// NewIdempotencyKey returns a random (version 4) UUID for use as the value of
+// an idempotency key header, such as Idempotency-Key. It is generated here
+// rather than with a UUID library, so generated code stays free to map
+// format: uuid to any of them.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = crand.Read(b[:]) // never returns an error
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// IdempotencyKeyUnset reports whether the caller left an idempotency key
+// header unset: it is empty, or holds the nil UUID which a required
+// uuid-typed parameter left at its zero value is serialized as.
+func IdempotencyKeyUnset(value string) bool {
+	return value == "" || value == nilUUID
+}
@@ -0,0 +1,26 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	key := NewIdempotencyKey()
+
+	parsed, err := uuid.Parse(key)
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), parsed.Version())
+	assert.Equal(t, uuid.RFC4122, parsed.Variant())
+	assert.NotEqual(t, key, NewIdempotencyKey())
+}
+
+func TestIdempotencyKeyUnset(t *testing.T) {
+	assert.True(t, IdempotencyKeyUnset(""))
+	assert.True(t, IdempotencyKeyUnset("00000000-0000-0000-0000-000000000000"))
+	assert.False(t, IdempotencyKeyUnset("payment-42"))
+	assert.False(t, IdempotencyKeyUnset(NewIdempotencyKey()))
+}
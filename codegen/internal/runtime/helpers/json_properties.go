@@ -0,0 +1,21 @@
+package helpers
+
+//oapi-runtime:function helpers/HasJSONProperties
+
+import "encoding/json"
+
+// HasJSONProperties reports whether data is a JSON object holding every one
+// of the named properties. anyOf unions use it to tell the members a value
+// matches apart, since decoding an object into a struct ignores what's missing.
+func HasJSONProperties(data json.RawMessage, names ...string) bool {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return false
+	}
+	for _, name := range names {
+		if _, ok := object[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
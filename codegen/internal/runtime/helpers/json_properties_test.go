@@ -0,0 +1,18 @@
+package helpers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasJSONProperties(t *testing.T) {
+	data := json.RawMessage(`{"id":1,"name":null}`)
+	assert.True(t, HasJSONProperties(data))
+	assert.True(t, HasJSONProperties(data, "id", "name"))
+	assert.False(t, HasJSONProperties(data, "id", "tags"))
+	assert.False(t, HasJSONProperties(json.RawMessage(`[1]`), "id"))
+	assert.False(t, HasJSONProperties(json.RawMessage(`null`)))
+	assert.False(t, HasJSONProperties(nil))
+}
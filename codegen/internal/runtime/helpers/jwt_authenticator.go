@@ -0,0 +1,357 @@
+package helpers
+
+//oapi-runtime:function helpers/JWTAuthenticator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultJWTLeeway is how far clocks may drift between the issuer and the
+// server before token times are rejected.
+const defaultJWTLeeway = time.Minute
+
+// ErrInvalidJWT is wrapped by the errors of a JWTAuthenticator rejecting a
+// request.
+var ErrInvalidJWT = errors.New("jwt: invalid token")
+
+// JWTHeader is the JOSE header of a JWT.
+type JWTHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// JWTKeyFunc returns the key verifying the signature of a JWT with header: a
+// public key of the RS*, PS*, ES* or EdDSA algorithms, or the []byte secret
+// of the HS* algorithms.
+type JWTKeyFunc func(ctx context.Context, header JWTHeader) (any, error)
+
+// JWTClaims are the claims of a JWT.
+type JWTClaims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+	ID        string
+	// Raw holds every claim of the token, as decoded from JSON.
+	Raw map[string]any
+
+	payload []byte
+}
+
+// Scopes returns the space-separated scopes of the token's scope claim.
+func (c *JWTClaims) Scopes() []string {
+	scope, _ := c.Raw["scope"].(string)
+	return strings.Fields(scope)
+}
+
+// Decode unmarshals the claims into v, typically a struct of the custom
+// claims of an issuer.
+func (c *JWTClaims) Decode(v any) error {
+	return json.Unmarshal(c.payload, v)
+}
+
+// JWTClaimsFromContext returns the claims stored in ctx under key by a
+// JWTAuthenticator's Middleware.
+func JWTClaimsFromContext(ctx context.Context, key any) (*JWTClaims, bool) {
+	claims, ok := ctx.Value(key).(*JWTClaims)
+	return claims, ok
+}
+
+// JWTAuthenticator parses the JWT bearer tokens of incoming requests, and
+// rejects those which expired or aren't valid yet. It verifies their
+// signatures with the keys of KeyFunc. It is safe for concurrent use.
+type JWTAuthenticator struct {
+	// KeyFunc returns the keys verifying token signatures. Nil skips
+	// verification, for tokens verified upstream, e.g. by an API gateway.
+	KeyFunc JWTKeyFunc
+	// Issuer must be the token's issuer; empty skips the check.
+	Issuer string
+	// Audience must be one of the token's audiences; empty skips the check.
+	Audience string
+	// ContextKey is the key Middleware stores claims under.
+	ContextKey any
+	// Clock tells whether tokens have expired. Defaults to SystemClock.
+	Clock Clock
+	// Leeway is the clock drift allowed when checking token times, a minute
+	// by default.
+	Leeway time.Duration
+}
+
+// Authenticate parses the bearer token in the Authorization header of r, and
+// returns its claims. Its errors wrap ErrInvalidJWT.
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*JWTClaims, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, fmt.Errorf("%w: no bearer token", ErrInvalidJWT)
+	}
+	return a.Parse(r.Context(), token)
+}
+
+// Parse parses token, a compact JWT, and returns its claims.
+func (a *JWTAuthenticator) Parse(ctx context.Context, token string) (*JWTClaims, error) {
+	jwt, err := decodeJWT(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+	if a.KeyFunc != nil {
+		key, err := a.KeyFunc(ctx, jwt.header)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key: %w", ErrInvalidJWT, err)
+		}
+		if err := jwt.verify(key); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+		}
+	}
+	claims, err := jwt.claims()
+	if err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidJWT, err)
+	}
+	if a.Issuer != "" && claims.Issuer != a.Issuer {
+		return nil, fmt.Errorf("%w: issued by %q, not %q", ErrInvalidJWT, claims.Issuer, a.Issuer)
+	}
+	if a.Audience != "" && !slices.Contains(claims.Audience, a.Audience) {
+		return nil, fmt.Errorf("%w: not issued for %q", ErrInvalidJWT, a.Audience)
+	}
+	if err := claims.checkTimes(clockOrSystem(a.Clock).Now(), a.Leeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+	return claims, nil
+}
+
+// Middleware rejects requests without a valid bearer token with 401
+// Unauthorized, and passes the others to next with the token's claims in
+// their context, under ContextKey.
+func (a *JWTAuthenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := a.Authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), a.ContextKey, claims)))
+	})
+}
+
+// bearerToken returns the bearer token in the Authorization header of r.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+// decodedJWT is a compact JWT split into its parts.
+type decodedJWT struct {
+	header    JWTHeader
+	signed    []byte // The encoded header and payload, as signed
+	payload   []byte
+	signature []byte
+}
+
+func decodeJWT(token string) (*decodedJWT, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("not a JWT")
+	}
+	jwt := &decodedJWT{signed: []byte(parts[0] + "." + parts[1])}
+	if err := decodeJWTPart(parts[0], &jwt.header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	var err error
+	if jwt.payload, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+	if jwt.signature, err = base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	return jwt, nil
+}
+
+func (t *decodedJWT) verify(key any) error {
+	return verifyJWTSignature(t.header.Alg, key, t.signed, t.signature)
+}
+
+func (t *decodedJWT) claims() (*JWTClaims, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(t.payload, &raw); err != nil {
+		return nil, err
+	}
+	claims := &JWTClaims{Raw: raw, payload: t.payload}
+	claims.Issuer, _ = raw["iss"].(string)
+	claims.Subject, _ = raw["sub"].(string)
+	claims.ID, _ = raw["jti"].(string)
+	switch aud := raw["aud"].(type) {
+	case string:
+		claims.Audience = []string{aud}
+	case []any:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				claims.Audience = append(claims.Audience, s)
+			}
+		}
+	}
+	claims.ExpiresAt = jwtTime(raw["exp"])
+	claims.NotBefore = jwtTime(raw["nbf"])
+	claims.IssuedAt = jwtTime(raw["iat"])
+	return claims, nil
+}
+
+// checkTimes rejects claims which expired or aren't valid yet at now, give or
+// take leeway, a minute when not positive. Tokens without an expiry never
+// expire.
+func (c *JWTClaims) checkTimes(now time.Time, leeway time.Duration) error {
+	if leeway <= 0 {
+		leeway = defaultJWTLeeway
+	}
+	if !c.ExpiresAt.IsZero() && !now.Add(-leeway).Before(c.ExpiresAt) {
+		return errors.New("expired")
+	}
+	if !c.NotBefore.IsZero() && now.Add(leeway).Before(c.NotBefore) {
+		return errors.New("not valid yet")
+	}
+	return nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jwtTime converts a NumericDate claim, in seconds since the epoch, to a
+// time; anything else is the zero time.
+func jwtTime(v any) time.Time {
+	seconds, ok := v.(float64)
+	if !ok {
+		return time.Time{}
+	}
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))).UTC()
+}
+
+// jwtHashes are the hashes of the JWS algorithms verifyJWTSignature accepts.
+var jwtHashes = map[string]crypto.Hash{
+	"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// verifyJWTSignature checks the signature of a JWT signed with alg, which
+// must suit key: HS*, RS*, PS* and ES* of SHA-256, -384 and -512, or EdDSA.
+// The "none" algorithm is never accepted.
+func verifyJWTSignature(alg string, key any, signed, signature []byte) error {
+	if alg == "EdDSA" {
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok || !ed25519.Verify(edKey, signed, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	hashFunc, ok := jwtHashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	digest := jwtDigest(hashFunc, signed)
+
+	switch alg[:2] {
+	case "HS":
+		secret, ok := key.([]byte)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		mac := hmac.New(jwtHashFunc(hashFunc), secret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "RS", "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		if alg[0] == 'R' {
+			err := rsa.VerifyPKCS1v15(rsaKey, hashFunc, digest, signature)
+			if err != nil {
+				return errors.New("invalid signature")
+			}
+			return nil
+		}
+		if err := rsa.VerifyPSS(rsaKey, hashFunc, digest, signature, nil); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+// jwtDigest returns the hashFunc digest of data.
+func jwtDigest(hashFunc crypto.Hash, data []byte) []byte {
+	switch hashFunc {
+	case crypto.SHA384:
+		digest := sha512.Sum384(data)
+		return digest[:]
+	case crypto.SHA512:
+		digest := sha512.Sum512(data)
+		return digest[:]
+	default:
+		digest := sha256.Sum256(data)
+		return digest[:]
+	}
+}
+
+// jwtHashFunc returns the constructor of hashFunc, for HMACs.
+func jwtHashFunc(hashFunc crypto.Hash) func() hash.Hash {
+	switch hashFunc {
+	case crypto.SHA384:
+		return sha512.New384
+	case crypto.SHA512:
+		return sha512.New
+	default:
+		return sha256.New
+	}
+}
@@ -0,0 +1,138 @@
+package helpers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signTestHS256JWT signs claims with secret as an HS256 JWT.
+func signTestHS256JWT(t *testing.T, secret string, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthenticator_Parse(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rsaKey := newTestRSAKey(t)
+	auth := &JWTAuthenticator{
+		KeyFunc: func(ctx context.Context, header JWTHeader) (any, error) {
+			switch header.Kid {
+			case "rsa":
+				return rsaKey.Public(), nil
+			case "":
+				return []byte("s3cret"), nil
+			}
+			return nil, errors.New("unknown key")
+		},
+		Issuer:   "https://issuer.example.com",
+		Audience: "pets-api",
+		Clock:    NewFakeClock(now),
+	}
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":  "https://issuer.example.com",
+			"sub":  "alice",
+			"aud":  "pets-api",
+			"exp":  now.Add(time.Hour).Unix(),
+			"jti":  "token-1",
+			"role": "admin",
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+	ctx := context.Background()
+
+	parsed, err := auth.Parse(ctx, signTestHS256JWT(t, "s3cret", claims(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", parsed.Subject)
+	assert.Equal(t, []string{"pets-api"}, parsed.Audience)
+	assert.Equal(t, "token-1", parsed.ID)
+	assert.Equal(t, now.Add(time.Hour), parsed.ExpiresAt)
+
+	var custom struct {
+		Role string `json:"role"`
+	}
+	require.NoError(t, parsed.Decode(&custom))
+	assert.Equal(t, "admin", custom.Role)
+
+	parsed, err = auth.Parse(ctx, signTestJWT(t, "RS256", "rsa", rsaKey, claims(nil)))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", parsed.Subject)
+
+	for name, tc := range map[string]struct {
+		token string
+		err   string
+	}{
+		"wrong secret":   {signTestHS256JWT(t, "guess", claims(nil)), "invalid signature"},
+		"unknown key":    {signTestJWT(t, "RS256", "missing", rsaKey, claims(nil)), "key: unknown key"},
+		"wrong issuer":   {signTestHS256JWT(t, "s3cret", claims(map[string]any{"iss": "https://other"})), `issued by "https://other"`},
+		"wrong audience": {signTestHS256JWT(t, "s3cret", claims(map[string]any{"aud": "other"})), `not issued for "pets-api"`},
+		"expired":        {signTestHS256JWT(t, "s3cret", claims(map[string]any{"exp": now.Add(-2 * time.Minute).Unix()})), "expired"},
+		"not yet valid":  {signTestHS256JWT(t, "s3cret", claims(map[string]any{"nbf": now.Add(2 * time.Minute).Unix()})), "not valid yet"},
+		"not a JWT":      {"opaque-token", "not a JWT"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := auth.Parse(ctx, tc.token)
+			assert.ErrorIs(t, err, ErrInvalidJWT)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestJWTAuthenticator_WithoutKeyFunc(t *testing.T) {
+	auth := &JWTAuthenticator{}
+
+	// Signatures aren't verified, but token times still are.
+	parsed, err := auth.Parse(context.Background(), signTestHS256JWT(t, "any", map[string]any{"sub": "alice"}))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", parsed.Subject)
+
+	_, err = auth.Parse(context.Background(), signTestHS256JWT(t, "any", map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}))
+	assert.ErrorContains(t, err, "expired")
+}
+
+func TestJWTAuthenticator_Middleware(t *testing.T) {
+	type claimsKey struct{}
+	auth := &JWTAuthenticator{
+		KeyFunc: func(ctx context.Context, header JWTHeader) (any, error) {
+			return []byte("s3cret"), nil
+		},
+		ContextKey: claimsKey{},
+	}
+	handler := auth.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := JWTClaimsFromContext(r.Context(), claimsKey{})
+		require.True(t, ok)
+		_, _ = w.Write([]byte(claims.Subject))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+signTestHS256JWT(t, "s3cret", map[string]any{"sub": "alice"}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "alice", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, `Bearer error="invalid_token"`, rec.Header().Get("WWW-Authenticate"))
+}
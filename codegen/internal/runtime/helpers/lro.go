@@ -0,0 +1,151 @@
+package helpers
+
+//oapi-runtime:function helpers/OperationPoller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default intervals of an OperationPoller.
+const (
+	DefaultPollInterval    = time.Second
+	DefaultMaxPollInterval = 30 * time.Second
+)
+
+// OperationLocation returns the URL of the status resource of a long-running
+// operation, from the Operation-Location header of resp, or its Location
+// header, resolved against the URL of the request.
+func OperationLocation(resp *http.Response) (*url.URL, error) {
+	location := resp.Header.Get("Operation-Location")
+	if location == "" {
+		location = resp.Header.Get("Location")
+	}
+	if location == "" {
+		return nil, errors.New("response has no Operation-Location or Location header")
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing operation location: %w", err)
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		u = resp.Request.URL.ResolveReference(u)
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("operation location %q is relative", location)
+	}
+	return u, nil
+}
+
+// OperationPoller polls the status resource of a long-running operation
+// until its state is terminal. Polls are spaced with exponential backoff,
+// starting at Interval and doubling up to MaxInterval, unless the status
+// resource asks for a delay with a Retry-After header.
+type OperationPoller struct {
+	// Clock waits between polls. Defaults to SystemClock.
+	Clock Clock
+	// Interval is the delay before the first poll. Defaults to
+	// DefaultPollInterval.
+	Interval time.Duration
+	// MaxInterval caps the delay between polls. Defaults to
+	// DefaultMaxPollInterval.
+	MaxInterval time.Duration
+	// StatusField is the JSON property of the status resource holding its
+	// state.
+	StatusField string
+	// TerminalStates end polling. They are compared case-insensitively.
+	TerminalStates []string
+}
+
+// Poll calls fetch until the status resource it returns is in a terminal
+// state, decoding the last one into v. It fails if ctx is done, or if fetch
+// fails or returns an unsuccessful status.
+func (p *OperationPoller) Poll(ctx context.Context, fetch func(ctx context.Context) (*http.Response, error), v any) error {
+	clock := clockOrSystem(p.Clock)
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxPollInterval
+	}
+
+	delay := interval
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(delay):
+		}
+
+		resp, err := fetch(ctx)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("polling operation status: HTTP %d", resp.StatusCode)
+		}
+		done, err := p.terminal(body)
+		if err != nil {
+			return err
+		}
+		if done {
+			return json.Unmarshal(body, v)
+		}
+
+		delay = min(delay*2, maxInterval)
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), clock.Now()); ok {
+			delay = retryAfter
+		}
+	}
+}
+
+// terminal reports whether the state of the status resource body is
+// terminal.
+func (p *OperationPoller) terminal(body []byte) (bool, error) {
+	var resource map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return false, fmt.Errorf("decoding operation status: %w", err)
+	}
+	var state string
+	if raw, ok := resource[p.StatusField]; ok {
+		if err := json.Unmarshal(raw, &state); err != nil {
+			return false, fmt.Errorf("decoding operation status %s: %w", p.StatusField, err)
+		}
+	}
+	for _, terminal := range p.TerminalStates {
+		if strings.EqualFold(state, terminal) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseRetryAfter parses a Retry-After header, either a number of seconds or
+// an HTTP date, into a delay from now.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLocation(t *testing.T) {
+	request := &http.Request{URL: &url.URL{Scheme: "https", Host: "api.example.com", Path: "/v1/jobs"}}
+
+	resp := &http.Response{Header: http.Header{"Location": {"/v1/jobs/1"}}, Request: request}
+	u, err := OperationLocation(resp)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/jobs/1", u.String())
+
+	// Operation-Location takes precedence over Location.
+	resp.Header.Set("Operation-Location", "https://status.example.com/operations/1")
+	u, err = OperationLocation(resp)
+	require.NoError(t, err)
+	assert.Equal(t, "https://status.example.com/operations/1", u.String())
+
+	_, err = OperationLocation(&http.Response{Header: http.Header{}, Request: request})
+	assert.Error(t, err)
+
+	_, err = OperationLocation(&http.Response{Header: http.Header{"Location": {"/v1/jobs/1"}}})
+	assert.Error(t, err)
+}
+
+// statusResponse returns a 200 response with the JSON body and headers.
+func statusResponse(body string, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestOperationPoller_Poll(t *testing.T) {
+	responses := []*http.Response{
+		statusResponse(`{"state":"running"}`, nil),
+		statusResponse(`{"state":"running"}`, http.Header{"Retry-After": {"7"}}),
+		statusResponse(`{"state":"running"}`, nil),
+		statusResponse(`{"state":"DONE","value":3}`, nil),
+	}
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	poller := &OperationPoller{
+		Clock:          clock,
+		Interval:       2 * time.Second,
+		MaxInterval:    3 * time.Second,
+		StatusField:    "state",
+		TerminalStates: []string{"done", "error"},
+	}
+
+	var result struct {
+		Value int `json:"value"`
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.Poll(context.Background(), func(ctx context.Context) (*http.Response, error) {
+			resp := responses[0]
+			responses = responses[1:]
+			return resp, nil
+		}, &result)
+	}()
+
+	// The first poll waits for the interval, and then the delay doubles up
+	// to the maximum, except when Retry-After asks for another delay.
+	for _, delay := range []time.Duration{2 * time.Second, 3 * time.Second, 7 * time.Second, 3 * time.Second} {
+		require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+		clock.Advance(delay)
+	}
+	require.NoError(t, <-done)
+	assert.Equal(t, 3, result.Value)
+	assert.Empty(t, responses)
+}
+
+func TestOperationPoller_ErrorStatus(t *testing.T) {
+	poller := &OperationPoller{Interval: time.Nanosecond, StatusField: "status", TerminalStates: []string{"done"}}
+	err := poller.Poll(context.Background(), func(ctx context.Context) (*http.Response, error) {
+		resp := statusResponse(`{}`, nil)
+		resp.StatusCode = http.StatusInternalServerError
+		return resp, nil
+	}, new(any))
+	assert.EqualError(t, err, "polling operation status: HTTP 500")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("120", now)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, ok = parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, d)
+
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+}
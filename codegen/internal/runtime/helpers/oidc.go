@@ -0,0 +1,483 @@
+package helpers
+
+//oapi-runtime:function helpers/OIDCProvider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// oidcDiscoveryPath is the path of the discovery document under an issuer.
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+// defaultOIDCKeysMaxAge is how long an OIDCProvider keeps the keys it
+// fetched, and defaultOIDCKeysMinRefresh how long it waits between fetches
+// for keys it doesn't know.
+const (
+	defaultOIDCKeysMaxAge     = time.Hour
+	defaultOIDCKeysMinRefresh = time.Minute
+)
+
+// defaultOIDCTokenExpirySkew is how long before its expiry a token is
+// replaced, so that it doesn't expire in flight.
+const defaultOIDCTokenExpirySkew = 30 * time.Second
+
+// ErrOIDCUnknownKey is returned when a token is signed with a key which isn't
+// in the provider's key set, even once refetched.
+var ErrOIDCUnknownKey = errors.New("oidc: unknown signing key")
+
+// OIDCConfiguration is the discovery document of an OpenID Connect
+// provider, the fields of which the runtime uses.
+type OIDCConfiguration struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI               string   `json:"jwks_uri"`
+	ScopesSupported       []string `json:"scopes_supported,omitempty"`
+	GrantTypesSupported   []string `json:"grant_types_supported,omitempty"`
+	SigningAlgsSupported  []string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
+
+// OIDCProvider is an OpenID Connect provider, found through the discovery
+// document at the openIdConnectUrl of a security scheme. It fetches the
+// document once, and caches the provider's signing keys, refetching them
+// when they grow old or a token is signed with a key it doesn't know. It is
+// safe for concurrent use, and is meant to be shared by the clients and
+// authenticators of a provider.
+type OIDCProvider struct {
+	// URL is the discovery document's URL, ending in
+	// /.well-known/openid-configuration.
+	URL string
+	// Doer fetches the discovery document, keys and tokens. Defaults to
+	// http.DefaultClient.
+	Doer HTTPDoer
+	// Clock times the key cache. Defaults to SystemClock.
+	Clock Clock
+	// KeysMaxAge is how long fetched keys are used before being refetched,
+	// an hour by default. KeysMinRefresh is the least time between fetches
+	// for unknown keys, a minute by default, so that tokens signed with
+	// made-up keys can't make the provider flood the key endpoint.
+	KeysMaxAge     time.Duration
+	KeysMinRefresh time.Duration
+
+	mu        sync.Mutex
+	config    *OIDCConfiguration
+	keys      map[string]crypto.PublicKey // Keyed by key ID
+	fetchedAt time.Time
+}
+
+// NewOIDCProvider returns an OIDCProvider discovered at url.
+func NewOIDCProvider(url string) *OIDCProvider {
+	return &OIDCProvider{URL: url}
+}
+
+func (p *OIDCProvider) doer() HTTPDoer {
+	if p.Doer == nil {
+		return http.DefaultClient
+	}
+	return p.Doer
+}
+
+// Configuration returns the provider's discovery document, fetching it on
+// first use. The issuer it declares must be the one it was discovered at.
+func (p *OIDCProvider) Configuration(ctx context.Context) (*OIDCConfiguration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.configuration(ctx)
+}
+
+func (p *OIDCProvider) configuration(ctx context.Context) (*OIDCConfiguration, error) {
+	if p.config != nil {
+		return p.config, nil
+	}
+	var config OIDCConfiguration
+	if err := p.getJSON(ctx, p.URL, &config); err != nil {
+		return nil, fmt.Errorf("oidc: discovering provider: %w", err)
+	}
+	if issuer := strings.TrimSuffix(p.URL, oidcDiscoveryPath); strings.HasSuffix(p.URL, oidcDiscoveryPath) && strings.TrimSuffix(config.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("oidc: provider at %s declares issuer %q", p.URL, config.Issuer)
+	}
+	if config.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc: provider at %s declares no jwks_uri", p.URL)
+	}
+	p.config = &config
+	return p.config, nil
+}
+
+// Key returns the public key with id kid, fetching the provider's key set
+// when it has grown old or doesn't hold kid. An empty kid matches the only
+// key of a set of one.
+func (p *OIDCProvider) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := clockOrSystem(p.Clock).Now()
+	maxAge, minRefresh := p.KeysMaxAge, p.KeysMinRefresh
+	if maxAge <= 0 {
+		maxAge = defaultOIDCKeysMaxAge
+	}
+	if minRefresh <= 0 {
+		minRefresh = defaultOIDCKeysMinRefresh
+	}
+
+	if p.keys == nil || now.Sub(p.fetchedAt) >= maxAge {
+		if err := p.fetchKeys(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	// The provider may have rotated its keys since they were fetched.
+	if now.Sub(p.fetchedAt) >= minRefresh {
+		if err := p.fetchKeys(ctx, now); err != nil {
+			return nil, err
+		}
+		if key, ok := p.lookupKey(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrOIDCUnknownKey, kid)
+}
+
+func (p *OIDCProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// fetchKeys replaces the cached keys with the provider's key set. Keys of
+// unsupported types, or meant for encryption, are skipped.
+func (p *OIDCProvider) fetchKeys(ctx context.Context, now time.Time) error {
+	config, err := p.configuration(ctx)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := p.getJSON(ctx, config.JWKSURI, &set); err != nil {
+		return fmt.Errorf("oidc: fetching keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, raw := range set.Keys {
+		var jwk oidcJWK
+		if err := json.Unmarshal(raw, &jwk); err != nil || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	p.keys, p.fetchedAt = keys, now
+	return nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.doer().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// oidcJWK is a JSON Web Key, as served in a provider's key set.
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey decodes an RSA, EC or Ed25519 public key.
+func (k oidcJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("EC point not on curve %s", k.Crv)
+		}
+		return key, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || k.Crv != "Ed25519" || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported OKP key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// OIDCToken is an access token issued by an OpenID Connect provider.
+type OIDCToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	// ExpiresIn is the lifetime of the token in seconds, as returned by the
+	// token endpoint, and Expiry when it expires; zero never expires.
+	ExpiresIn int       `json:"expires_in,omitempty"`
+	Expiry    time.Time `json:"-"`
+}
+
+// OIDCFlow obtains access tokens from a provider, such as with the client
+// credentials grant of ClientCredentialsFlow. Interactive flows, such as the
+// authorization code grant, can be plugged in by implementing it.
+type OIDCFlow interface {
+	Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error)
+}
+
+// OIDCFlowFunc adapts a function to an OIDCFlow.
+type OIDCFlowFunc func(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error)
+
+func (f OIDCFlowFunc) Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error) {
+	return f(ctx, provider)
+}
+
+// ClientCredentialsFlow obtains tokens with the OAuth 2.0 client credentials
+// grant, authenticating to the provider's token endpoint with HTTP Basic
+// authentication.
+type ClientCredentialsFlow struct {
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// Audience, when set, is sent as the audience parameter, which some
+	// providers require to issue tokens for an API.
+	Audience string
+}
+
+func (f ClientCredentialsFlow) Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error) {
+	config, err := provider.Configuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.TokenEndpoint == "" {
+		return nil, fmt.Errorf("oidc: provider at %s declares no token_endpoint", provider.URL)
+	}
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(f.Scopes) > 0 {
+		form.Set("scope", strings.Join(f.Scopes, " "))
+	}
+	if f.Audience != "" {
+		form.Set("audience", f.Audience)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(f.ClientID), url.QueryEscape(f.ClientSecret))
+	return RequestOIDCToken(provider, req)
+}
+
+// RequestOIDCToken sends req, a request to the provider's token endpoint, and
+// decodes the token in its response, timing its expiry with the provider's
+// clock. It lets OIDCFlow implementations share the handling of responses.
+func RequestOIDCToken(provider *OIDCProvider, req *http.Request) (*OIDCToken, error) {
+	resp, err := provider.doer().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: requesting token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: requesting token: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &oauthErr) == nil && oauthErr.Error != "" {
+			return nil, fmt.Errorf("oidc: requesting token: %s: %s %s", resp.Status, oauthErr.Error, oauthErr.ErrorDescription)
+		}
+		return nil, fmt.Errorf("oidc: requesting token: %s", resp.Status)
+	}
+	var token OIDCToken
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("oidc: decoding token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oidc: token response has no access_token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = clockOrSystem(provider.Clock).Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// OIDCTokenSource caches the tokens a flow obtains from a provider, and
+// authenticates requests with them. It is safe for concurrent use.
+type OIDCTokenSource struct {
+	Provider *OIDCProvider
+	Flow     OIDCFlow
+	// Clock tells when tokens expire. Defaults to the clock passed to
+	// WrapWithClock, or SystemClock.
+	Clock Clock
+	// ExpirySkew is how long before their expiry tokens are replaced, 30
+	// seconds by default.
+	ExpirySkew time.Duration
+
+	mu    sync.Mutex
+	token *OIDCToken
+}
+
+// NewOIDCTokenSource returns an OIDCTokenSource obtaining tokens from
+// provider with flow.
+func NewOIDCTokenSource(provider *OIDCProvider, flow OIDCFlow) *OIDCTokenSource {
+	return &OIDCTokenSource{Provider: provider, Flow: flow}
+}
+
+// Token returns the cached token, obtaining a new one when there is none or
+// it is about to expire.
+func (s *OIDCTokenSource) Token(ctx context.Context) (*OIDCToken, error) {
+	return s.tokenAt(ctx, clockOrSystem(s.Clock))
+}
+
+func (s *OIDCTokenSource) tokenAt(ctx context.Context, clock Clock) (*OIDCToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	skew := s.ExpirySkew
+	if skew <= 0 {
+		skew = defaultOIDCTokenExpirySkew
+	}
+	if s.token != nil && (s.token.Expiry.IsZero() || clock.Now().Add(skew).Before(s.token.Expiry)) {
+		return s.token, nil
+	}
+	token, err := s.Flow.Token(ctx, s.Provider)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// Invalidate drops the cached token, if it is still token, so that the next
+// request obtains a new one.
+func (s *OIDCTokenSource) Invalidate(token *OIDCToken) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = nil
+	}
+}
+
+// Wrap returns a doer which sends requests through doer with the source's
+// token as a bearer token. A request rejected with 401 Unauthorized is sent
+// once more with a new token, if its body can be replayed.
+func (s *OIDCTokenSource) Wrap(doer HTTPDoer) HTTPDoer {
+	return s.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, telling when tokens expire with clock unless s has
+// a Clock of its own.
+func (s *OIDCTokenSource) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	if s.Clock != nil {
+		clock = s.Clock
+	}
+	return &oidcDoer{source: s, doer: doer, clock: clockOrSystem(clock)}
+}
+
+type oidcDoer struct {
+	source *OIDCTokenSource
+	doer   HTTPDoer
+	clock  Clock
+}
+
+func (d *oidcDoer) Do(req *http.Request) (*http.Response, error) {
+	token, err := d.source.tokenAt(req.Context(), d.clock)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(withBearerToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	// The token may have been revoked: retry once with a new one.
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	d.source.Invalidate(token)
+	token, err = d.source.tokenAt(req.Context(), d.clock)
+	if err != nil {
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	return d.doer.Do(withBearerToken(retry, token))
+}
+
+// withBearerToken returns a copy of req authenticated with token.
+func withBearerToken(req *http.Request, token *OIDCToken) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return req
+}
@@ -0,0 +1,128 @@
+package helpers
+
+//oapi-runtime:function helpers/OIDCAuthenticator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// ErrOIDCInvalidToken is wrapped by the errors of an OIDCAuthenticator
+// rejecting a request.
+var ErrOIDCInvalidToken = errors.New("oidc: invalid token")
+
+// OIDCClaims are the claims of a verified token.
+type OIDCClaims = JWTClaims
+
+type oidcClaimsContextKey struct{}
+
+// ContextWithOIDCClaims returns a copy of ctx holding claims.
+func ContextWithOIDCClaims(ctx context.Context, claims *OIDCClaims) context.Context {
+	return context.WithValue(ctx, oidcClaimsContextKey{}, claims)
+}
+
+// OIDCClaimsFromContext returns the claims stored in ctx by an
+// OIDCAuthenticator's Middleware.
+func OIDCClaimsFromContext(ctx context.Context) (*OIDCClaims, bool) {
+	claims, ok := ctx.Value(oidcClaimsContextKey{}).(*OIDCClaims)
+	return claims, ok
+}
+
+// OIDCAuthenticator validates the bearer tokens of incoming requests: JWTs
+// signed with a key of the provider's key set, issued by the provider, for
+// the audience, and neither expired nor not yet valid. It is safe for
+// concurrent use.
+type OIDCAuthenticator struct {
+	Provider *OIDCProvider
+	// Audience must be one of the token's audiences; empty skips the check.
+	Audience string
+	// Clock tells whether tokens have expired. Defaults to the provider's
+	// clock, or SystemClock.
+	Clock Clock
+	// Leeway is the clock drift allowed when checking token times, a minute
+	// by default.
+	Leeway time.Duration
+}
+
+// NewOIDCAuthenticator returns an OIDCAuthenticator accepting tokens issued
+// by provider for audience.
+func NewOIDCAuthenticator(provider *OIDCProvider, audience string) *OIDCAuthenticator {
+	return &OIDCAuthenticator{Provider: provider, Audience: audience}
+}
+
+// Authenticate verifies the bearer token in the Authorization header of r,
+// and returns its claims. Its errors wrap ErrOIDCInvalidToken, unless the
+// provider couldn't be reached.
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) (*OIDCClaims, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, fmt.Errorf("%w: no bearer token", ErrOIDCInvalidToken)
+	}
+	return a.Verify(r.Context(), token)
+}
+
+// Verify verifies token, a compact JWT, and returns its claims.
+func (a *OIDCAuthenticator) Verify(ctx context.Context, token string) (*OIDCClaims, error) {
+	jwt, err := decodeJWT(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	key, err := a.Provider.Key(ctx, jwt.header.Kid)
+	if errors.Is(err, ErrOIDCUnknownKey) {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	} else if err != nil {
+		return nil, err
+	}
+	if err := jwt.verify(key); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	claims, err := jwt.claims()
+	if err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrOIDCInvalidToken, err)
+	}
+
+	config, err := a.Provider.Configuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Issuer != config.Issuer {
+		return nil, fmt.Errorf("%w: issued by %q, not %q", ErrOIDCInvalidToken, claims.Issuer, config.Issuer)
+	}
+	if a.Audience != "" && !slices.Contains(claims.Audience, a.Audience) {
+		return nil, fmt.Errorf("%w: not issued for %q", ErrOIDCInvalidToken, a.Audience)
+	}
+	clock := a.Clock
+	if clock == nil {
+		clock = clockOrSystem(a.Provider.Clock)
+	}
+	if claims.ExpiresAt.IsZero() {
+		return nil, fmt.Errorf("%w: expired", ErrOIDCInvalidToken)
+	}
+	if err := claims.checkTimes(clock.Now(), a.Leeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	return claims, nil
+}
+
+// Middleware rejects requests without a valid bearer token with 401
+// Unauthorized, and passes the others to next with the token's claims in
+// their context. Requests are rejected with 503 Service Unavailable when the
+// provider can't be reached.
+func (a *OIDCAuthenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := a.Authenticate(r)
+		switch {
+		case errors.Is(err, ErrOIDCInvalidToken):
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		default:
+			next.ServeHTTP(w, r.WithContext(ContextWithOIDCClaims(r.Context(), claims)))
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package helpers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signTestJWT signs claims with key as a JWT of alg, naming the key kid.
+func signTestJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(k, []byte(signed))
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, jwtDigest(jwtHashes[alg], []byte(signed)))
+		require.NoError(t, err)
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature = append(r.FillBytes(make([]byte, size)), s.FillBytes(make([]byte, size))...)
+	case *rsa.PrivateKey:
+		digest := jwtDigest(jwtHashes[alg], []byte(signed))
+		if alg[0] == 'P' {
+			signature, err = rsa.SignPSS(rand.Reader, k, jwtHashes[alg], digest, nil)
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, k, jwtHashes[alg], digest)
+		}
+		require.NoError(t, err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestOIDCAuthenticator_Verify(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	rsaKey, ecKey := newTestRSAKey(t), newTestECKey(t)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	p.addKey("rsa", rsaKey)
+	p.addKey("ec", ecKey)
+	p.addKey("ed", edKey)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(now)
+	auth := NewOIDCAuthenticator(&OIDCProvider{URL: p.discoveryURL(), Clock: clock}, "pets-api")
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":   p.URL,
+			"sub":   "alice",
+			"aud":   []string{"pets-api", "other"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"iat":   now.Unix(),
+			"scope": "pets:read pets:write",
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		alg, kid string
+		key      crypto.Signer
+	}{
+		{"RS256", "rsa", rsaKey},
+		{"RS512", "rsa", rsaKey},
+		{"PS256", "rsa", rsaKey},
+		{"ES256", "ec", ecKey},
+		{"EdDSA", "ed", edKey},
+	} {
+		t.Run(tc.alg, func(t *testing.T) {
+			verified, err := auth.Verify(ctx, signTestJWT(t, tc.alg, tc.kid, tc.key, claims(nil)))
+			require.NoError(t, err)
+			assert.Equal(t, "alice", verified.Subject)
+			assert.Equal(t, []string{"pets-api", "other"}, verified.Audience)
+			assert.Equal(t, now.Add(time.Hour), verified.ExpiresAt)
+			assert.Equal(t, []string{"pets:read", "pets:write"}, verified.Scopes())
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		token string
+		err   string
+	}{
+		"wrong issuer":   {signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"iss": "https://other"})), `issued by "https://other"`},
+		"wrong audience": {signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"aud": "other"})), `not issued for "pets-api"`},
+		"expired":        {signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"exp": now.Add(-2 * time.Minute).Unix()})), "expired"},
+		"no expiry":      {signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"exp": nil})), "expired"},
+		"not yet valid":  {signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{"nbf": now.Add(2 * time.Minute).Unix()})), "not valid yet"},
+		"wrong key":      {signTestJWT(t, "RS256", "rsa", newTestRSAKey(t), claims(nil)), "invalid signature"},
+		"wrong alg":      {signTestJWT(t, "ES256", "rsa", ecKey, claims(nil)), "doesn't suit the key"},
+		"unknown key":    {signTestJWT(t, "RS256", "missing", rsaKey, claims(nil)), "unknown signing key"},
+		"alg none":       {signTestJWT(t, "none", "rsa", rsaKey, claims(nil)), `unsupported algorithm "none"`},
+		"not a JWT":      {"opaque-token", "not a JWT"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := auth.Verify(ctx, tc.token)
+			assert.ErrorIs(t, err, ErrOIDCInvalidToken)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	// Tokens within the leeway of their times are accepted.
+	_, err = auth.Verify(ctx, signTestJWT(t, "RS256", "rsa", rsaKey, claims(map[string]any{
+		"exp": now.Add(-30 * time.Second).Unix(),
+		"nbf": now.Add(30 * time.Second).Unix(),
+	})))
+	assert.NoError(t, err)
+}
+
+func TestOIDCAuthenticator_Middleware(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	key := newTestRSAKey(t)
+	p.addKey("rsa", key)
+	auth := NewOIDCAuthenticator(NewOIDCProvider(p.discoveryURL()), "")
+
+	handler := auth.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := OIDCClaimsFromContext(r.Context())
+		require.True(t, ok)
+		_, _ = w.Write([]byte(claims.Subject))
+	}))
+
+	token := signTestJWT(t, "RS256", "rsa", key, map[string]any{"iss": p.URL, "sub": "alice", "exp": time.Now().Add(time.Hour).Unix()})
+	req := httptest.NewRequest(http.MethodGet, "/pets", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "alice", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, `Bearer error="invalid_token"`, rec.Header().Get("WWW-Authenticate"))
+}
+
+func TestOIDCAuthenticator_ProviderUnreachable(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	key := newTestRSAKey(t)
+	url := p.discoveryURL()
+	p.Close()
+	auth := NewOIDCAuthenticator(NewOIDCProvider(url), "")
+
+	req := httptest.NewRequest(http.MethodGet, "/pets", nil)
+	req.Header.Set("Authorization", "Bearer "+signTestJWT(t, "RS256", "rsa", key, map[string]any{}))
+	rec := httptest.NewRecorder()
+	auth.Middleware(http.NotFoundHandler()).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
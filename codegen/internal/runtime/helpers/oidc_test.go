@@ -0,0 +1,245 @@
+package helpers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testOIDCProvider serves the discovery document, key set and token
+// endpoint of an OpenID Connect provider.
+type testOIDCProvider struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	keys       []map[string]string
+	tokens     []string // Access tokens issued, in order
+	expiresIn  int
+	keyFetches atomic.Int32
+	issuer     string // Overrides the issuer of the discovery document
+}
+
+func newTestOIDCProvider(t *testing.T) *testOIDCProvider {
+	p := &testOIDCProvider{expiresIn: 3600}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := p.issuer
+		if issuer == "" {
+			issuer = p.URL
+		}
+		_ = json.NewEncoder(w).Encode(OIDCConfiguration{
+			Issuer:        issuer,
+			TokenEndpoint: p.URL + "/token",
+			JWKSURI:       p.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		p.keyFetches.Add(1)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": p.keys})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "s3cret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		p.mu.Lock()
+		token := "token-" + string(rune('a'+len(p.tokens)))
+		p.tokens = append(p.tokens, token+" "+r.FormValue("scope"))
+		expiresIn := p.expiresIn
+		p.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": token, "token_type": "Bearer", "expires_in": expiresIn})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *testOIDCProvider) discoveryURL() string {
+	return p.URL + "/.well-known/openid-configuration"
+}
+
+// addKey publishes the public key of signer under kid.
+func (p *testOIDCProvider) addKey(kid string, signer crypto.Signer) {
+	jwk := map[string]string{"kid": kid, "use": "sig"}
+	switch key := signer.Public().(type) {
+	case *rsa.PublicKey:
+		jwk["kty"] = "RSA"
+		jwk["n"] = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk["e"] = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		jwk["kty"], jwk["crv"] = "EC", key.Curve.Params().Name
+		jwk["x"] = base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, size)))
+		jwk["y"] = base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk["kty"], jwk["crv"] = "OKP", "Ed25519"
+		jwk["x"] = base64.RawURLEncoding.EncodeToString(key)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = append(p.keys, jwk)
+}
+
+func (p *testOIDCProvider) issuedTokens() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.tokens...)
+}
+
+func newTestRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestECKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func TestOIDCProvider_Discovery(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	provider := NewOIDCProvider(p.discoveryURL())
+
+	config, err := provider.Configuration(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, p.URL, config.Issuer)
+	assert.Equal(t, p.URL+"/token", config.TokenEndpoint)
+	assert.Equal(t, p.URL+"/keys", config.JWKSURI)
+}
+
+func TestOIDCProvider_DiscoveryIssuerMismatch(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	p.issuer = "https://evil.example.com"
+	provider := NewOIDCProvider(p.discoveryURL())
+
+	_, err := provider.Configuration(context.Background())
+	assert.ErrorContains(t, err, `declares issuer "https://evil.example.com"`)
+}
+
+func TestOIDCProvider_KeyCache(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	p.addKey("one", newTestRSAKey(t))
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	provider := &OIDCProvider{URL: p.discoveryURL(), Clock: clock}
+	ctx := context.Background()
+
+	_, err := provider.Key(ctx, "one")
+	require.NoError(t, err)
+	_, err = provider.Key(ctx, "one")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, p.keyFetches.Load(), "known keys are cached")
+
+	// Unknown keys are refetched at most once a minute.
+	p.addKey("two", newTestECKey(t))
+	_, err = provider.Key(ctx, "two")
+	assert.ErrorIs(t, err, ErrOIDCUnknownKey)
+	assert.EqualValues(t, 1, p.keyFetches.Load())
+
+	clock.Advance(time.Minute)
+	key, err := provider.Key(ctx, "two")
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PublicKey{}, key)
+	assert.EqualValues(t, 2, p.keyFetches.Load())
+
+	// Keys grow old after an hour.
+	clock.Advance(time.Hour)
+	_, err = provider.Key(ctx, "one")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, p.keyFetches.Load())
+}
+
+func TestOIDCTokenSource_ClientCredentials(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	var authorizations []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer api.Close()
+
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	provider := &OIDCProvider{URL: p.discoveryURL(), Clock: clock}
+	source := NewOIDCTokenSource(provider, ClientCredentialsFlow{ClientID: "client", ClientSecret: "s3cret", Scopes: []string{"read", "write"}})
+	doer := source.WrapWithClock(http.DefaultClient, clock)
+
+	send := func() {
+		req, err := http.NewRequest(http.MethodGet, api.URL, nil)
+		require.NoError(t, err)
+		resp, err := doer.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	send()
+	send()
+	// The token is replaced 30 seconds before it expires.
+	clock.Advance(time.Hour - 30*time.Second)
+	send()
+
+	assert.Equal(t, []string{"Bearer token-a", "Bearer token-a", "Bearer token-b"}, authorizations)
+	assert.Equal(t, []string{"token-a read write", "token-b read write"}, p.issuedTokens())
+}
+
+func TestOIDCTokenSource_RetriesUnauthorized(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	var bodies []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, r.Header.Get("Authorization")+": "+string(body))
+		if r.Header.Get("Authorization") == "Bearer token-a" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer api.Close()
+
+	source := NewOIDCTokenSource(NewOIDCProvider(p.discoveryURL()), ClientCredentialsFlow{ClientID: "client", ClientSecret: "s3cret"})
+	req, err := http.NewRequest(http.MethodPost, api.URL, strings.NewReader("hello"))
+	require.NoError(t, err)
+	resp, err := source.Wrap(http.DefaultClient).Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-a: hello", "Bearer token-b: hello"}, bodies)
+}
+
+func TestOIDCTokenSource_FlowError(t *testing.T) {
+	p := newTestOIDCProvider(t)
+	source := NewOIDCTokenSource(NewOIDCProvider(p.discoveryURL()), ClientCredentialsFlow{ClientID: "client", ClientSecret: "wrong"})
+
+	_, err := source.Token(context.Background())
+	assert.ErrorContains(t, err, "401 Unauthorized: invalid_client")
+}
+
+func TestOIDCFlowFunc(t *testing.T) {
+	flow := OIDCFlowFunc(func(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error) {
+		return &OIDCToken{AccessToken: "static"}, nil
+	})
+	source := NewOIDCTokenSource(NewOIDCProvider("http://unused"), flow)
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "static", token.AccessToken)
+}
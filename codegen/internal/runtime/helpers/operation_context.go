@@ -0,0 +1,20 @@
+package helpers
+
+//oapi-runtime:function helpers/OperationID
+
+import "context"
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// OperationIDFromContext returns the operationId set by ContextWithOperationID.
+func OperationIDFromContext(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDKey{}).(string)
+	return operationID, ok
+}
@@ -0,0 +1,171 @@
+package helpers
+
+//oapi-runtime:function helpers/PriorityQueue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Priority orders requests waiting in a PriorityQueue. The zero value is
+// PriorityNormal.
+type Priority int
+
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+type priorityKey struct{}
+
+// ContextWithPriority returns a copy of ctx carrying the priority of the
+// request made with it, overriding the priority of its operation.
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityQueue bounds the number of requests in flight, and hands the slots
+// freed under contention to the waiting requests of highest priority, in the
+// order they arrived. A request holds its slot until its response body is
+// closed. Low priority requests wait for as long as higher priority ones keep
+// arriving. It is safe for concurrent use.
+type PriorityQueue struct {
+	// OperationPriorities sets the priority of requests per operationId.
+	// Requests of other operations are PriorityNormal, unless their context
+	// carries a priority.
+	OperationPriorities map[string]Priority
+
+	mu      sync.Mutex
+	limit   int
+	active  int
+	waiting [3][]chan struct{} // Indexed by priority, highest first
+}
+
+// NewPriorityQueue returns a PriorityQueue letting maxConcurrent requests be
+// in flight at once.
+func NewPriorityQueue(maxConcurrent int, operationPriorities map[string]Priority) *PriorityQueue {
+	return &PriorityQueue{
+		OperationPriorities: operationPriorities,
+		limit:               max(maxConcurrent, 1),
+	}
+}
+
+// priorityIndex returns the index of the waiting list of priority.
+func priorityIndex(priority Priority) int {
+	return int(PriorityHigh - min(max(priority, PriorityLow), PriorityHigh))
+}
+
+// Acquire waits for a slot, and fails if ctx is done first. Each successful
+// call must be followed by a call to Release.
+func (q *PriorityQueue) Acquire(ctx context.Context, priority Priority) error {
+	q.mu.Lock()
+	if q.active < q.limit {
+		q.active++
+		q.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	i := priorityIndex(priority)
+	q.waiting[i] = append(q.waiting[i], ready)
+	q.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	select {
+	case <-ready:
+		// The slot was handed over as ctx was done: pass it on.
+		q.mu.Unlock()
+		q.Release()
+		return ctx.Err()
+	default:
+	}
+	for j, w := range q.waiting[i] {
+		if w == ready {
+			q.waiting[i] = append(q.waiting[i][:j], q.waiting[i][j+1:]...)
+			break
+		}
+	}
+	q.mu.Unlock()
+	return ctx.Err()
+}
+
+// Release frees a slot taken by Acquire, handing it to the waiting request
+// of highest priority, if any.
+func (q *PriorityQueue) Release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, waiting := range q.waiting {
+		if len(waiting) > 0 {
+			close(waiting[0])
+			q.waiting[i] = waiting[1:]
+			return
+		}
+	}
+	q.active--
+}
+
+// Waiting returns the number of requests waiting for a slot.
+func (q *PriorityQueue) Waiting() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, waiting := range q.waiting {
+		n += len(waiting)
+	}
+	return n
+}
+
+// priority returns the priority of the request made with ctx.
+func (q *PriorityQueue) priority(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	if operationID, ok := OperationIDFromContext(ctx); ok {
+		return q.OperationPriorities[operationID]
+	}
+	return PriorityNormal
+}
+
+// Wrap returns an HTTPDoer which sends requests through doer once the queue
+// has a slot for them.
+func (q *PriorityQueue) Wrap(doer HTTPDoer) HTTPDoer {
+	return &queuedDoer{queue: q, doer: doer}
+}
+
+type queuedDoer struct {
+	queue *PriorityQueue
+	doer  HTTPDoer
+}
+
+func (d *queuedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.queue.Acquire(req.Context(), d.queue.priority(req.Context())); err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(req)
+	if err != nil {
+		d.queue.Release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: sync.OnceFunc(d.queue.Release)}
+	return resp, nil
+}
+
+// releasingBody releases a queue slot when it is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
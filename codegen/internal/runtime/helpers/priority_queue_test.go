@@ -0,0 +1,97 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityQueue_Order(t *testing.T) {
+	q := NewPriorityQueue(1, nil)
+	ctx := context.Background()
+	require.NoError(t, q.Acquire(ctx, PriorityNormal))
+
+	var mu sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	enqueue := func(name string, priority Priority) {
+		want := q.Waiting() + 1
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, q.Acquire(ctx, priority))
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			q.Release()
+		}()
+		// Wait for the request to queue up, so arrival order is known.
+		require.Eventually(t, func() bool { return q.Waiting() == want }, time.Second, time.Millisecond)
+	}
+	enqueue("low", PriorityLow)
+	enqueue("normal 1", PriorityNormal)
+	enqueue("high", PriorityHigh)
+	enqueue("normal 2", PriorityNormal)
+
+	q.Release()
+	wg.Wait()
+	assert.Equal(t, []string{"high", "normal 1", "normal 2", "low"}, order)
+	assert.Zero(t, q.active)
+}
+
+func TestPriorityQueue_ContextDone(t *testing.T) {
+	q := NewPriorityQueue(1, nil)
+	require.NoError(t, q.Acquire(context.Background(), PriorityNormal))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, q.Acquire(ctx, PriorityHigh), context.Canceled)
+
+	// The abandoned request doesn't hold on to the slot.
+	q.Release()
+	require.NoError(t, q.Acquire(context.Background(), PriorityLow))
+}
+
+// blockingDoer holds requests until release is closed.
+type blockingDoer struct {
+	started chan string
+	release chan struct{}
+}
+
+func (d *blockingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.started <- req.URL.Path
+	<-d.release
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestPriorityQueue_Wrap(t *testing.T) {
+	q := NewPriorityQueue(1, map[string]Priority{"search": PriorityLow})
+	doer := &blockingDoer{started: make(chan string, 3), release: make(chan struct{})}
+	wrapped := q.Wrap(doer)
+
+	send := func(path string, ctx context.Context) {
+		go func() {
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com"+path, nil)
+			resp, err := wrapped.Do(req)
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	send("/first", context.Background())
+	assert.Equal(t, "/first", <-doer.started)
+
+	send("/search", ContextWithOperationID(context.Background(), "search"))
+	require.Eventually(t, func() bool { return q.Waiting() == 1 }, time.Second, time.Millisecond)
+	send("/urgent", ContextWithPriority(ContextWithOperationID(context.Background(), "search"), PriorityHigh))
+	require.Eventually(t, func() bool { return q.Waiting() == 2 }, time.Second, time.Millisecond)
+
+	close(doer.release)
+	assert.Equal(t, "/urgent", <-doer.started)
+	assert.Equal(t, "/search", <-doer.started)
+}
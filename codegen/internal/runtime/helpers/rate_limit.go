@@ -0,0 +1,189 @@
+package helpers
+
+//oapi-runtime:function helpers/RateLimiter
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitHeaders names the response headers a server reports its rate
+// limits in.
+type RateLimitHeaders struct {
+	// Remaining holds the number of requests left in the current window.
+	Remaining string
+	// Reset holds when the window resets, either as a number of seconds from
+	// now or as a Unix timestamp.
+	Reset string
+}
+
+// DefaultRateLimitHeaders are the most common rate limit headers.
+var DefaultRateLimitHeaders = RateLimitHeaders{
+	Remaining: "X-RateLimit-Remaining",
+	Reset:     "X-RateLimit-Reset",
+}
+
+// RateLimitScope sets which requests share a rate limit.
+type RateLimitScope int
+
+const (
+	// RateLimitPerClient holds back every request once any is limited.
+	RateLimitPerClient RateLimitScope = iota
+	// RateLimitPerOperation only holds back requests of the operation which
+	// was limited.
+	RateLimitPerOperation
+)
+
+// defaultRateLimitDelay is how long requests are held back after a 429
+// response which doesn't say when to retry.
+const defaultRateLimitDelay = time.Second
+
+// unixTimestampThreshold tells Unix timestamps from numbers of seconds in
+// reset headers: no window lasts over 30 years.
+const unixTimestampThreshold = 1_000_000_000
+
+// RateLimiter delays requests to stay within the rate limits reported by the
+// server. Once a response says no requests are left in the current window,
+// or is a 429 Too Many Requests, later requests wait for the window to reset,
+// or for the delay of a Retry-After header. The limited response itself is
+// returned as is. It is safe for concurrent use, and can be shared by the
+// clients of an API.
+type RateLimiter struct {
+	Headers RateLimitHeaders
+	Scope   RateLimitScope
+	// Clock times the delays. Defaults to the clock passed to WrapWithClock,
+	// or SystemClock.
+	Clock Clock
+
+	mu    sync.Mutex
+	until map[string]time.Time // Keyed by operationId, or "" per client
+}
+
+// NewRateLimiter returns a RateLimiter reading headers, whose limits apply to
+// scope.
+func NewRateLimiter(headers RateLimitHeaders, scope RateLimitScope) *RateLimiter {
+	return &RateLimiter{Headers: headers, Scope: scope}
+}
+
+// key returns the key of the limit applying to the request made with ctx.
+func (l *RateLimiter) key(ctx context.Context) string {
+	if l.Scope != RateLimitPerOperation {
+		return ""
+	}
+	operationID, _ := OperationIDFromContext(ctx)
+	return operationID
+}
+
+// Until returns when requests of operationID can be sent again, which is in
+// the past unless they are held back.
+func (l *RateLimiter) Until(operationID string) time.Time {
+	if l.Scope != RateLimitPerOperation {
+		operationID = ""
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.until[operationID]
+}
+
+// Wait waits until the request made with ctx may be sent, timing the delay
+// with clock, or SystemClock if nil. It fails if ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context, clock Clock) error {
+	clock = clockOrSystem(clock)
+	delay := l.Until(l.key(ctx)).Sub(clock.Now())
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Observe reads the rate limit reported by resp, a response to the request
+// made with ctx, holding back later requests if it is exhausted.
+func (l *RateLimiter) Observe(ctx context.Context, resp *http.Response, now time.Time) {
+	var delay time.Duration
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		var ok bool
+		if delay, ok = parseRetryAfter(resp.Header.Get("Retry-After"), now); !ok {
+			if delay, ok = parseRateLimitReset(resp.Header.Get(l.Headers.Reset), now); !ok {
+				delay = defaultRateLimitDelay
+			}
+		}
+	case l.Headers.Remaining != "" && strings.TrimSpace(resp.Header.Get(l.Headers.Remaining)) == "0":
+		var ok bool
+		if delay, ok = parseRateLimitReset(resp.Header.Get(l.Headers.Reset), now); !ok {
+			return
+		}
+	default:
+		return
+	}
+
+	key := l.key(ctx)
+	until := now.Add(delay)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.until[key]) {
+		if l.until == nil {
+			l.until = make(map[string]time.Time)
+		}
+		l.until[key] = until
+	}
+}
+
+// parseRateLimitReset parses a reset header, either a number of seconds or a
+// Unix timestamp, into a delay from now.
+func parseRateLimitReset(header string, now time.Time) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	if n >= unixTimestampThreshold {
+		return max(time.Unix(n, 0).Sub(now), 0), true
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+// Wrap returns a doer which sends requests through doer once the rate limit
+// allows it.
+func (l *RateLimiter) Wrap(doer HTTPDoer) HTTPDoer {
+	return l.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing delays with clock unless l has a Clock of its
+// own. It lets a limiter shared by several clients follow each client's
+// clock.
+func (l *RateLimiter) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	if l.Clock != nil {
+		clock = l.Clock
+	}
+	return &rateLimitedDoer{limiter: l, doer: doer, clock: clockOrSystem(clock)}
+}
+
+type rateLimitedDoer struct {
+	limiter *RateLimiter
+	doer    HTTPDoer
+	clock   Clock
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.limiter.Wait(req.Context(), d.clock); err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(req)
+	if err == nil {
+		d.limiter.Observe(req.Context(), resp, d.clock.Now())
+	}
+	return resp, err
+}
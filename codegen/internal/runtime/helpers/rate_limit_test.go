@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// limitedDoer answers each request with the next of responses.
+type limitedDoer struct {
+	responses []*http.Response
+	sent      int
+}
+
+func (d *limitedDoer) Do(req *http.Request) (*http.Response, error) {
+	resp := d.responses[d.sent]
+	d.sent++
+	return resp, nil
+}
+
+func rateLimitResponse(statusCode int, headers ...string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: http.NoBody}
+	for i := 0; i < len(headers); i += 2 {
+		resp.Header.Set(headers[i], headers[i+1])
+	}
+	return resp
+}
+
+func rateLimitRequest(ctx context.Context, operationID string) *http.Request {
+	req, _ := http.NewRequestWithContext(ContextWithOperationID(ctx, operationID), http.MethodGet, "http://example.com", nil)
+	return req
+}
+
+func TestRateLimiter_RemainingExhausted(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	doer := &limitedDoer{responses: []*http.Response{
+		rateLimitResponse(http.StatusOK, "X-RateLimit-Remaining", "1", "X-RateLimit-Reset", "30"),
+		rateLimitResponse(http.StatusOK, "X-RateLimit-Remaining", "0", "X-RateLimit-Reset", "30"),
+		rateLimitResponse(http.StatusOK),
+	}}
+	limiter := NewRateLimiter(DefaultRateLimitHeaders, RateLimitPerClient)
+	wrapped := limiter.WrapWithClock(doer, clock)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := wrapped.Do(rateLimitRequest(ctx, "listPets"))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, clock.Now().Add(30*time.Second), limiter.Until("listPets"))
+
+	done := make(chan error)
+	go func() {
+		_, err := wrapped.Do(rateLimitRequest(ctx, "getPet"))
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, 2, doer.sent)
+	clock.Advance(30 * time.Second)
+	require.NoError(t, <-done)
+	assert.Equal(t, 3, doer.sent)
+}
+
+func TestRateLimiter_TooManyRequests(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+	limiter := NewRateLimiter(RateLimitHeaders{Reset: "RateLimit-Reset"}, RateLimitPerOperation)
+	ctx := context.Background()
+
+	limiter.Observe(ContextWithOperationID(ctx, "retryAfter"), rateLimitResponse(http.StatusTooManyRequests, "Retry-After", "5"), now)
+	limiter.Observe(ContextWithOperationID(ctx, "reset"), rateLimitResponse(http.StatusTooManyRequests, "RateLimit-Reset", reset), now)
+	limiter.Observe(ContextWithOperationID(ctx, "bare"), rateLimitResponse(http.StatusTooManyRequests), now)
+
+	assert.Equal(t, now.Add(5*time.Second), limiter.Until("retryAfter"))
+	assert.Equal(t, now.Add(time.Minute), limiter.Until("reset"))
+	assert.Equal(t, now.Add(defaultRateLimitDelay), limiter.Until("bare"))
+	assert.True(t, limiter.Until("other").IsZero())
+}
+
+func TestRateLimiter_ContextDone(t *testing.T) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter := NewRateLimiter(DefaultRateLimitHeaders, RateLimitPerClient)
+	limiter.Observe(context.Background(), rateLimitResponse(http.StatusTooManyRequests, "Retry-After", "60"), clock.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx, clock), context.Canceled)
+}
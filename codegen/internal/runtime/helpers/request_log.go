@@ -0,0 +1,148 @@
+package helpers
+
+//oapi-runtime:function helpers/RequestLogger
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// RequestLogger logs the requests handled by a generated server with
+// log/slog, sampled per operationId. Requests are logged with the route
+// template of their operation, such as /pets/{id}, rather than their path, so
+// that logs can be aggregated by route without a distinct value per resource.
+type RequestLogger struct {
+	// Logger receives the records. Defaults to slog.Default().
+	Logger *slog.Logger
+	// Level is the level requests are logged at, except for those failing
+	// with a 5xx status, which are logged at slog.LevelError. Defaults to
+	// slog.LevelInfo.
+	Level slog.Level
+	// SampleRates holds the rate of requests logged for each operationId,
+	// between 0 and 1. Operations which aren't listed are always logged.
+	// Requests which didn't match an operation are listed under "".
+	SampleRates map[string]float64
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it to make sampling deterministic.
+	Rand func() float64
+	// Clock times requests. Defaults to SystemClock.
+	Clock Clock
+}
+
+type requestLogKey struct{}
+
+// requestLogRoute is filled in with the operation which handled a request.
+type requestLogRoute struct {
+	operationID string
+	route       string
+}
+
+// StartRequest prepares to log a request, returning the context to handle it
+// with and a function to call with its status once it has been handled.
+// Generated middleware calls it; it is only needed for other frameworks.
+func (l *RequestLogger) StartRequest(ctx context.Context, method string) (context.Context, func(status int)) {
+	clock := clockOrSystem(l.Clock)
+	start := clock.Now()
+	route := &requestLogRoute{}
+	return context.WithValue(ctx, requestLogKey{}, route), func(status int) {
+		l.log(ctx, method, route, status, clock.Now().Sub(start))
+	}
+}
+
+// Middleware logs the requests handled by next, for servers built on
+// net/http. Install it around the handler of a generated server, so that it
+// sees every request.
+func (l *RequestLogger) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, done := l.StartRequest(r.Context(), r.Method)
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+		done(rec.statusCode())
+	})
+}
+
+// SetRequestLogRoute records the operation handling the request of ctx, and
+// its route template, for a RequestLogger. Generated servers call it; it does
+// nothing unless the request is being logged.
+func SetRequestLogRoute(ctx context.Context, operationID, route string) {
+	if r, ok := ctx.Value(requestLogKey{}).(*requestLogRoute); ok {
+		r.operationID = operationID
+		r.route = route
+	}
+}
+
+func (l *RequestLogger) sampled(operationID string) bool {
+	rate, ok := l.SampleRates[operationID]
+	if !ok || rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+	random := l.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < rate
+}
+
+func (l *RequestLogger) log(ctx context.Context, method string, route *requestLogRoute, status int, duration time.Duration) {
+	if !l.sampled(route.operationID) {
+		return
+	}
+	logger := l.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	level := l.Level
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{slog.String("method", method)}
+	if route.operationID != "" {
+		attrs = append(attrs,
+			slog.String("operationId", route.operationID),
+			slog.String("route", route.route),
+		)
+	}
+	attrs = append(attrs,
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+	)
+	logger.LogAttrs(ctx, level, "request", attrs...)
+}
+
+// statusRecorder remembers the status written through it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
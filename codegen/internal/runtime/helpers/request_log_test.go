@@ -0,0 +1,117 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newJSONRequestLogger returns a RequestLogger writing JSON records to out.
+func newJSONRequestLogger(out *bytes.Buffer) *RequestLogger {
+	return &RequestLogger{Logger: slog.New(slog.NewJSONHandler(out, nil))}
+}
+
+func decodeRecords(t *testing.T, out *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestRequestLogger_Middleware(t *testing.T) {
+	var out bytes.Buffer
+	logger := newJSONRequestLogger(&out)
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	logger.Clock = clock
+
+	handler := logger.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetRequestLogRoute(r.Context(), "getPet", "/pets/{id}")
+		clock.Advance(1500 * time.Millisecond)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/pets/42", nil))
+
+	records := decodeRecords(t, &out)
+	require.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "request", record["msg"])
+	assert.Equal(t, "GET", record["method"])
+	assert.Equal(t, "getPet", record["operationId"])
+	assert.Equal(t, "/pets/{id}", record["route"])
+	assert.EqualValues(t, http.StatusNotFound, record["status"])
+	assert.EqualValues(t, 1500*time.Millisecond, record["duration"])
+	assert.NotContains(t, out.String(), "/pets/42")
+}
+
+func TestRequestLogger_ServerErrors(t *testing.T) {
+	var out bytes.Buffer
+	logger := newJSONRequestLogger(&out)
+	logger.Level = slog.LevelDebug
+
+	handler := logger.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusBadGateway)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/nowhere", nil))
+
+	records := decodeRecords(t, &out)
+	require.Len(t, records, 1)
+	assert.Equal(t, "ERROR", records[0]["level"])
+	assert.EqualValues(t, http.StatusBadGateway, records[0]["status"])
+	// Unmatched requests are logged without an operation or route.
+	assert.NotContains(t, records[0], "operationId")
+	assert.NotContains(t, records[0], "route")
+}
+
+func TestRequestLogger_Sampling(t *testing.T) {
+	var out bytes.Buffer
+	logger := newJSONRequestLogger(&out)
+	rolls := []float64{0.05, 0.5}
+	logger.Rand = func() float64 {
+		r := rolls[0]
+		rolls = rolls[1:]
+		return r
+	}
+	logger.SampleRates = map[string]float64{
+		"healthCheck": 0,
+		"listPets":    0.1,
+	}
+
+	serve := func(operationID string) {
+		handler := logger.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			SetRequestLogRoute(r.Context(), operationID, "/"+operationID)
+		}))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+	serve("healthCheck")
+	serve("listPets")
+	serve("listPets")
+	serve("getPet")
+
+	records := decodeRecords(t, &out)
+	require.Len(t, records, 2)
+	assert.Equal(t, "listPets", records[0]["operationId"])
+	assert.EqualValues(t, http.StatusOK, records[0]["status"])
+	assert.Equal(t, "getPet", records[1]["operationId"])
+	assert.Empty(t, rolls)
+}
+
+func TestSetRequestLogRoute_NotLogging(t *testing.T) {
+	// Without a RequestLogger, recording the route does nothing.
+	SetRequestLogRoute(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "getPet", "/pets/{id}")
+}
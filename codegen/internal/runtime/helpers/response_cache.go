@@ -0,0 +1,293 @@
+package helpers
+
+//oapi-runtime:function helpers/ResponseCache
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HTTPDoer performs HTTP requests. It is satisfied by *http.Client and by the
+// HttpRequestDoer interface of generated clients.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// CachedResponse is a response stored in a ResponseCache.
+type CachedResponse struct {
+	StatusCode   int
+	Header       http.Header
+	Body         []byte
+	ETag         string    // Validator sent back as If-None-Match
+	LastModified string    // Validator sent back as If-Modified-Since
+	Expires      time.Time // Entry is fresh until this instant
+
+	// Vary holds the request headers named by the response's Vary header,
+	// with the values they had on the request which stored the entry. The
+	// entry only serves requests carrying the same values.
+	Vary http.Header
+}
+
+// ResponseCache stores responses keyed by request URL. Implementations must
+// be safe for concurrent use.
+type ResponseCache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+	Delete(key string)
+}
+
+// MemoryResponseCache is an unbounded in-memory ResponseCache.
+type MemoryResponseCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CachedResponse
+}
+
+// NewMemoryResponseCache returns an empty MemoryResponseCache.
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{entries: make(map[string]*CachedResponse)}
+}
+
+// Get returns the entry stored under key, if any.
+func (c *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+// Set stores resp under key, replacing any previous entry.
+func (c *MemoryResponseCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+}
+
+// Delete removes the entry stored under key.
+func (c *MemoryResponseCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// DoCached sends req through doer, serving it from cache when a fresh entry
+// exists and revalidating stale entries with If-None-Match/If-Modified-Since.
+// Only GET requests are cached, and the Cache-Control directives no-store,
+// no-cache and max-age are honored on both requests and responses. Entries
+// are keyed by URL and only serve requests which match them on the headers
+// named by the response's Vary header. Responses marked private are never
+// stored, nor are responses to requests carrying Authorization unless the
+// response is marked public, must-revalidate or s-maxage. A nil cache
+// disables caching. Freshness is judged by clock, which defaults to
+// SystemClock when nil.
+func DoCached(doer HTTPDoer, cache ResponseCache, clock Clock, req *http.Request) (*http.Response, error) {
+	if cache == nil || req.Method != http.MethodGet {
+		return doer.Do(req)
+	}
+
+	reqDirectives := parseCacheControl(req.Header.Get("Cache-Control"))
+	if _, noStore := reqDirectives["no-store"]; noStore {
+		return doer.Do(req)
+	}
+
+	key := req.URL.String()
+	now := clockOrSystem(clock).Now()
+
+	entry, found := cache.Get(key)
+	if found && !entry.matches(req) {
+		found = false
+	}
+	if found {
+		_, noCache := reqDirectives["no-cache"]
+		if !noCache && now.Before(entry.Expires) {
+			return entry.toResponse(req), nil
+		}
+		if entry.ETag != "" && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" && req.Header.Get("If-Modified-Since") == "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		refreshed := *entry
+		refreshed.Header = mergeNotModifiedHeader(entry.Header, resp.Header)
+		refreshed.Expires = cacheExpiry(refreshed.Header, now)
+		refreshed.ETag = refreshed.Header.Get("ETag")
+		refreshed.LastModified = refreshed.Header.Get("Last-Modified")
+		cache.Set(key, &refreshed)
+		return refreshed.toResponse(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	if !storable(req, resp) {
+		cache.Delete(key)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	cache.Set(key, &CachedResponse{
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header.Clone(),
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Expires:      cacheExpiry(resp.Header, now),
+		Vary:         varyValues(req, resp.Header),
+	})
+	return resp, nil
+}
+
+// storable reports whether resp may be stored for req: it isn't marked
+// no-store or private, doesn't vary on every request header, and, when req
+// carries credentials, is explicitly marked as shareable.
+func storable(req *http.Request, resp *http.Response) bool {
+	directives := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if _, noStore := directives["no-store"]; noStore {
+		return false
+	}
+	if _, private := directives["private"]; private {
+		return false
+	}
+	for _, name := range varyNames(resp.Header) {
+		if name == "*" {
+			return false
+		}
+	}
+	if req.Header.Get("Authorization") != "" {
+		_, public := directives["public"]
+		_, mustRevalidate := directives["must-revalidate"]
+		_, sMaxAge := directives["s-maxage"]
+		return public || mustRevalidate || sMaxAge
+	}
+	return true
+}
+
+// varyNames returns the canonical header names listed by a Vary header.
+func varyNames(header http.Header) []string {
+	var names []string
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+	return names
+}
+
+// varyValues captures the values req carries for the headers named by the
+// Vary header of a response to it.
+func varyValues(req *http.Request, header http.Header) http.Header {
+	names := varyNames(header)
+	if len(names) == 0 {
+		return nil
+	}
+	values := make(http.Header, len(names))
+	for _, name := range names {
+		values[name] = req.Header.Values(name)
+	}
+	return values
+}
+
+// matches reports whether req carries the same values as the request which
+// stored the entry for every header the entry varies on.
+func (c *CachedResponse) matches(req *http.Request) bool {
+	for name, want := range c.Vary {
+		got := req.Header.Values(name)
+		if strings.Join(got, ", ") != strings.Join(want, ", ") {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeNotModifiedHeader updates the stored header of an entry with the
+// fields sent in a 304 response revalidating it.
+func mergeNotModifiedHeader(stored, notModified http.Header) http.Header {
+	merged := stored.Clone()
+	for name, values := range notModified {
+		switch name {
+		case "Content-Length", "Content-Encoding", "Transfer-Encoding", "Content-Range":
+			continue
+		}
+		merged[name] = values
+	}
+	return merged
+}
+
+// toResponse builds a fresh *http.Response from a cached entry.
+func (c *CachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(c.StatusCode) + " " + http.StatusText(c.StatusCode),
+		StatusCode:    c.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
+
+// cacheExpiry computes when a response stops being fresh. Responses marked
+// no-cache, or carrying neither max-age nor Expires, are stale immediately and
+// are revalidated on every use.
+func cacheExpiry(header http.Header, now time.Time) time.Time {
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	if _, noCache := directives["no-cache"]; noCache {
+		return time.Time{}
+	}
+	if maxAge, ok := directives["max-age"]; ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil {
+			return time.Time{}
+		}
+		if age, err := strconv.Atoi(header.Get("Age")); err == nil {
+			seconds -= age
+		}
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+	if expires := header.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// parseCacheControl splits a Cache-Control header into lower-cased directives
+// and their (unquoted) values.
+func parseCacheControl(header string) map[string]string {
+	directives := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return directives
+}
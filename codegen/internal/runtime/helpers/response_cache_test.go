@@ -0,0 +1,223 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheTestServer counts requests and replies with the given Cache-Control
+// header and a fixed ETag, honoring If-None-Match.
+func cacheTestServer(t *testing.T, cacheControl string) (*httptest.Server, *int, *int) {
+	t.Helper()
+	var hits, notModified int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("ETag", `"v1"`)
+		if cacheControl != "" {
+			w.Header().Set("Cache-Control", cacheControl)
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = io.WriteString(w, `{"name":"fido"}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits, &notModified
+}
+
+func doCachedGet(t *testing.T, cache ResponseCache, clock Clock, url string, header http.Header) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := DoCached(http.DefaultClient, cache, clock, req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestDoCached_FreshResponseServedFromCache(t *testing.T) {
+	srv, hits, _ := cacheTestServer(t, "max-age=60")
+	cache := NewMemoryResponseCache()
+
+	assert.Equal(t, `{"name":"fido"}`, doCachedGet(t, cache, nil, srv.URL, nil))
+	assert.Equal(t, `{"name":"fido"}`, doCachedGet(t, cache, nil, srv.URL, nil))
+	assert.Equal(t, 1, *hits)
+}
+
+func TestDoCached_Expiry(t *testing.T) {
+	srv, hits, notModified := cacheTestServer(t, "max-age=60")
+	cache := NewMemoryResponseCache()
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	doCachedGet(t, cache, clock, srv.URL, nil)
+	clock.Advance(59 * time.Second)
+	doCachedGet(t, cache, clock, srv.URL, nil)
+	assert.Equal(t, 1, *hits)
+
+	clock.Advance(time.Second)
+	doCachedGet(t, cache, clock, srv.URL, nil)
+	assert.Equal(t, 2, *hits)
+	assert.Equal(t, 1, *notModified)
+}
+
+func TestDoCached_StaleResponseRevalidated(t *testing.T) {
+	srv, hits, notModified := cacheTestServer(t, "no-cache")
+	cache := NewMemoryResponseCache()
+
+	assert.Equal(t, `{"name":"fido"}`, doCachedGet(t, cache, nil, srv.URL, nil))
+	assert.Equal(t, `{"name":"fido"}`, doCachedGet(t, cache, nil, srv.URL, nil))
+	assert.Equal(t, 2, *hits)
+	assert.Equal(t, 1, *notModified)
+}
+
+func TestDoCached_NoStore(t *testing.T) {
+	srv, hits, _ := cacheTestServer(t, "no-store")
+	cache := NewMemoryResponseCache()
+
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	assert.Equal(t, 2, *hits)
+
+	_, found := cache.Get(srv.URL)
+	assert.False(t, found)
+}
+
+func TestDoCached_RequestNoCacheForcesRevalidation(t *testing.T) {
+	srv, hits, notModified := cacheTestServer(t, "max-age=60")
+	cache := NewMemoryResponseCache()
+
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	doCachedGet(t, cache, nil, srv.URL, http.Header{"Cache-Control": {"no-cache"}})
+	assert.Equal(t, 2, *hits)
+	assert.Equal(t, 1, *notModified)
+}
+
+func TestDoCached_NilCache(t *testing.T) {
+	srv, hits, _ := cacheTestServer(t, "max-age=60")
+
+	doCachedGet(t, nil, nil, srv.URL, nil)
+	doCachedGet(t, nil, nil, srv.URL, nil)
+	assert.Equal(t, 2, *hits)
+}
+
+func TestDoCached_Vary(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Header().Set("Vary", "Accept-Language")
+		_, _ = io.WriteString(w, r.Header.Get("Accept-Language"))
+	}))
+	t.Cleanup(srv.Close)
+	cache := NewMemoryResponseCache()
+
+	assert.Equal(t, "en", doCachedGet(t, cache, nil, srv.URL, http.Header{"Accept-Language": {"en"}}))
+	assert.Equal(t, "en", doCachedGet(t, cache, nil, srv.URL, http.Header{"Accept-Language": {"en"}}))
+	assert.Equal(t, 1, hits)
+
+	assert.Equal(t, "fr", doCachedGet(t, cache, nil, srv.URL, http.Header{"Accept-Language": {"fr"}}))
+	assert.Equal(t, 2, hits)
+}
+
+func TestDoCached_PrivateNotStored(t *testing.T) {
+	srv, hits, _ := cacheTestServer(t, "private, max-age=60")
+	cache := NewMemoryResponseCache()
+
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	assert.Equal(t, 2, *hits)
+}
+
+func TestDoCached_Authorization(t *testing.T) {
+	auth := http.Header{"Authorization": {"Bearer alice"}}
+
+	t.Run("not stored by default", func(t *testing.T) {
+		srv, hits, _ := cacheTestServer(t, "max-age=60")
+		cache := NewMemoryResponseCache()
+
+		doCachedGet(t, cache, nil, srv.URL, auth)
+		doCachedGet(t, cache, nil, srv.URL, auth)
+		assert.Equal(t, 2, *hits)
+	})
+
+	t.Run("stored when public", func(t *testing.T) {
+		srv, hits, _ := cacheTestServer(t, "public, max-age=60")
+		cache := NewMemoryResponseCache()
+
+		doCachedGet(t, cache, nil, srv.URL, auth)
+		doCachedGet(t, cache, nil, srv.URL, auth)
+		assert.Equal(t, 1, *hits)
+	})
+}
+
+func TestDoCached_NotModifiedMergesHeaders(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("Cache-Control", "max-age=60")
+			w.Header().Set("X-Revision", "2")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Revision", "1")
+		_, _ = io.WriteString(w, `{"name":"fido"}`)
+	}))
+	t.Cleanup(srv.Close)
+	cache := NewMemoryResponseCache()
+
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	assert.Equal(t, 2, hits)
+
+	entry, found := cache.Get(srv.URL)
+	require.True(t, found)
+	assert.Equal(t, "2", entry.Header.Get("X-Revision"))
+	assert.Equal(t, []byte(`{"name":"fido"}`), entry.Body)
+
+	// The merged max-age makes the entry fresh.
+	doCachedGet(t, cache, nil, srv.URL, nil)
+	assert.Equal(t, 2, hits)
+}
+
+func TestParseCacheControl(t *testing.T) {
+	tests := map[string]struct {
+		header string
+		want   map[string]string
+	}{
+		"empty": {
+			header: "",
+			want:   map[string]string{},
+		},
+		"single directive": {
+			header: "no-store",
+			want:   map[string]string{"no-store": ""},
+		},
+		"multiple directives": {
+			header: `Max-Age=30, private, community="UCI"`,
+			want:   map[string]string{"max-age": "30", "private": "", "community": "UCI"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseCacheControl(tc.header))
+		})
+	}
+}
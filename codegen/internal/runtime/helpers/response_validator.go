@@ -0,0 +1,802 @@
+package helpers
+
+//oapi-runtime:function helpers/ResponseValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxSchemaDepth bounds the $ref chains followed for a single value, so that
+// cyclic references don't recurse forever.
+const maxSchemaDepth = 64
+
+// ResponseValidator checks responses against those the operations of an
+// OpenAPI spec declare: their status codes, content types and required
+// headers, and the JSON Schemas of JSON bodies. It is safe for concurrent
+// use.
+type ResponseValidator struct {
+	doc    map[string]any
+	routes []responseRoute
+}
+
+// responseRoute is a path template of the spec, with its operations by
+// lowercase method.
+type responseRoute struct {
+	template   string
+	pattern    *regexp.Regexp
+	params     int
+	operations map[string]any
+}
+
+// ResponseValidationError describes a response which doesn't match the spec.
+type ResponseValidationError struct {
+	Method string // Method of the request
+	Route  string // Path template of the operation, such as /pets/{id}
+	Status int    // Status code of the response
+	// Pointer is the JSON pointer of the invalid value in the body, empty
+	// when the response is at fault as a whole.
+	Pointer string
+	// SchemaPath is the JSON pointer, in the spec, of the declaration the
+	// response breaks, such as
+	// #/components/schemas/Pet/properties/name/type.
+	SchemaPath string
+	Message    string
+}
+
+func (e *ResponseValidationError) Error() string {
+	at := ""
+	if e.Pointer != "" {
+		at = " at " + e.Pointer
+	}
+	return fmt.Sprintf("%s %s: %d response%s: %s (%s)", e.Method, e.Route, e.Status, at, e.Message, e.SchemaPath)
+}
+
+// schemaViolation is a value breaking a schema keyword.
+type schemaViolation struct {
+	pointer    string
+	schemaPath string
+	message    string
+}
+
+// NewResponseValidator returns a ResponseValidator of spec, the JSON of an
+// OpenAPI document. Only its paths and components are read; local $refs are
+// followed, and other references accept any value.
+func NewResponseValidator(spec []byte) (*ResponseValidator, error) {
+	dec := json.NewDecoder(bytes.NewReader(spec))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parsing spec: %w", err)
+	}
+	v := &ResponseValidator{doc: doc}
+	paths, _ := doc["paths"].(map[string]any)
+	for template, item := range paths {
+		operations, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		expr, params := pathTemplatePattern(template)
+		v.routes = append(v.routes, responseRoute{
+			template:   template,
+			pattern:    regexp.MustCompile(expr),
+			params:     params,
+			operations: operations,
+		})
+	}
+	// Templates with fewer parameters match first, so that /pets/mine wins
+	// over /pets/{id}.
+	sort.Slice(v.routes, func(i, j int) bool {
+		if v.routes[i].params != v.routes[j].params {
+			return v.routes[i].params < v.routes[j].params
+		}
+		return v.routes[i].template < v.routes[j].template
+	})
+	return v, nil
+}
+
+// pathTemplatePattern returns the regular expression of the paths matching
+// template, and the number of parameters of template.
+func pathTemplatePattern(template string) (string, int) {
+	var b strings.Builder
+	b.WriteString("^")
+	params := 0
+	for {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(template[open:], '}')
+		if end < 0 {
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(template[:open]))
+		b.WriteString("[^/]+")
+		template = template[open+end+1:]
+		params++
+	}
+	b.WriteString(regexp.QuoteMeta(template))
+	b.WriteString("$")
+	return b.String(), params
+}
+
+// Validate checks a response with status, header and body, written for a
+// request with method and path, against the operation the spec declares for
+// them. It returns a *ResponseValidationError describing the first mismatch,
+// or nil when the response matches, or no operation matches the request.
+func (v *ResponseValidator) Validate(method, path string, status int, header http.Header, body []byte) error {
+	route, operation := v.match(method, path)
+	if operation == nil {
+		return nil
+	}
+	fail := func(pointer, schemaPath, format string, args ...any) error {
+		return &ResponseValidationError{
+			Method:     method,
+			Route:      route.template,
+			Status:     status,
+			Pointer:    pointer,
+			SchemaPath: schemaPath,
+			Message:    fmt.Sprintf(format, args...),
+		}
+	}
+
+	responsesPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + strings.ToLower(method) + "/responses"
+	responses, _ := operation["responses"].(map[string]any)
+	key, ok := responseKey(responses, status)
+	if !ok {
+		return fail("", responsesPath, "status %d isn't declared", status)
+	}
+	response, responsePath := v.resolve(responses[key], responsesPath+"/"+escapeSchemaPointer(key))
+	responseObject, _ := response.(map[string]any)
+
+	headers, _ := responseObject["headers"].(map[string]any)
+	for _, name := range sortedSchemaKeys(headers) {
+		headerPath := responsePath + "/headers/" + escapeSchemaPointer(name)
+		h, headerPath := v.resolve(headers[name], headerPath)
+		if hm, ok := h.(map[string]any); ok && hm["required"] == true && header.Get(name) == "" {
+			return fail("", headerPath+"/required", "missing required header %s", name)
+		}
+	}
+
+	if method == http.MethodHead {
+		return nil
+	}
+	content, _ := responseObject["content"].(map[string]any)
+	if len(content) == 0 {
+		if len(body) > 0 {
+			return fail("", responsePath, "has a body, but declares no content")
+		}
+		return nil
+	}
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		return fail("", responsePath+"/content", "has no Content-Type")
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fail("", responsePath+"/content", "invalid Content-Type %q: %v", contentType, err)
+	}
+	mediaKey, ok := mediaTypeKey(content, mediaType)
+	if !ok {
+		return fail("", responsePath+"/content", "content type %s isn't declared", mediaType)
+	}
+	mediaPath := responsePath + "/content/" + escapeSchemaPointer(mediaKey)
+	mediaObject, _ := content[mediaKey].(map[string]any)
+	schema, ok := mediaObject["schema"]
+	if !ok || !isJSONMediaType(mediaType) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return fail("", mediaPath, "invalid JSON body: %v", err)
+	}
+	if violation := v.check(value, schema, mediaPath+"/schema", "", 0); violation != nil {
+		return fail(violation.pointer, violation.schemaPath, "%s", violation.message)
+	}
+	return nil
+}
+
+// match returns the route and operation the spec declares for a request with
+// method and path, or a nil operation when there's none.
+func (v *ResponseValidator) match(method, path string) (*responseRoute, map[string]any) {
+	for i := range v.routes {
+		if !v.routes[i].pattern.MatchString(path) {
+			continue
+		}
+		if op, ok := v.routes[i].operations[strings.ToLower(method)].(map[string]any); ok {
+			return &v.routes[i], op
+		}
+	}
+	return nil, nil
+}
+
+// responseKey returns the key of the response declared for status in
+// responses: the status itself, its range, such as 2XX, or default.
+func responseKey(responses map[string]any, status int) (string, bool) {
+	code := strconv.Itoa(status)
+	for _, key := range []string{code, code[:1] + "XX", code[:1] + "xx", "default"} {
+		if _, ok := responses[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// mediaTypeKey returns the key of the media type declared for mediaType in
+// content: the media type itself, its range, such as text/*, or */*.
+func mediaTypeKey(content map[string]any, mediaType string) (string, bool) {
+	for _, key := range []string{mediaType, mediaType[:strings.IndexByte(mediaType+"/", '/')] + "/*", "*/*"} {
+		if _, ok := content[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// isJSONMediaType tells whether bodies of mediaType are JSON.
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// resolve follows the $ref of node, if any, within the spec, returning the
+// node it refers to and its path.
+func (v *ResponseValidator) resolve(node any, path string) (any, string) {
+	for range maxSchemaDepth {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return node, path
+		}
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return node, path
+		}
+		target, ok := v.lookup(ref)
+		if !ok {
+			return nil, ref
+		}
+		node, path = target, ref
+	}
+	return node, path
+}
+
+// lookup returns the node of the spec a local reference, such as
+// #/components/schemas/Pet, refers to.
+func (v *ResponseValidator) lookup(ref string) (any, bool) {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, false
+	}
+	var node any = v.doc
+	if pointer == "" {
+		return node, true
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch n := node.(type) {
+		case map[string]any:
+			if node, ok = n[token]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, false
+			}
+			node = n[i]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// check validates value, at pointer in the body, against schema, at
+// schemaPath in the spec, returning the first keyword it breaks.
+func (v *ResponseValidator) check(value, schema any, schemaPath, pointer string, depth int) *schemaViolation {
+	if depth > maxSchemaDepth {
+		return nil
+	}
+	violation := func(keyword, format string, args ...any) *schemaViolation {
+		return &schemaViolation{pointer: pointer, schemaPath: schemaPath + "/" + keyword, message: fmt.Sprintf(format, args...)}
+	}
+	if allowed, ok := schema.(bool); ok {
+		if !allowed {
+			return &schemaViolation{pointer: pointer, schemaPath: schemaPath, message: "no value is allowed"}
+		}
+		return nil
+	}
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if ref, ok := s["$ref"].(string); ok {
+		if target, ok := v.lookup(ref); ok {
+			if found := v.check(value, target, ref, pointer, depth+1); found != nil {
+				return found
+			}
+		}
+	}
+	if value == nil && s["nullable"] == true {
+		return nil
+	}
+
+	if types := schemaTypes(s["type"]); len(types) > 0 {
+		actual := jsonType(value)
+		matched := false
+		for _, t := range types {
+			if t == actual || (t == "number" && actual == "integer") {
+				matched = true
+			}
+		}
+		if !matched {
+			return violation("type", "expected %s, got %s", strings.Join(types, " or "), actual)
+		}
+	}
+	if enum, ok := s["enum"].([]any); ok {
+		found := false
+		for _, allowed := range enum {
+			if jsonEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return violation("enum", "%s isn't one of the allowed values", formatJSONValue(value))
+		}
+	}
+	if allowed, ok := s["const"]; ok && !jsonEqual(value, allowed) {
+		return violation("const", "%s isn't the allowed value %s", formatJSONValue(value), formatJSONValue(allowed))
+	}
+
+	switch value := value.(type) {
+	case string:
+		if found := checkString(value, s, violation); found != nil {
+			return found
+		}
+	case json.Number:
+		if found := checkNumber(value, s, violation); found != nil {
+			return found
+		}
+	case []any:
+		if found := v.checkArray(value, s, schemaPath, pointer, depth, violation); found != nil {
+			return found
+		}
+	case map[string]any:
+		if found := v.checkObject(value, s, schemaPath, pointer, depth, violation); found != nil {
+			return found
+		}
+	}
+
+	if all, ok := s["allOf"].([]any); ok {
+		for i, sub := range all {
+			if found := v.check(value, sub, schemaPath+"/allOf/"+strconv.Itoa(i), pointer, depth+1); found != nil {
+				return found
+			}
+		}
+	}
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+		for i, sub := range anyOf {
+			if v.check(value, sub, schemaPath+"/anyOf/"+strconv.Itoa(i), pointer, depth+1) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return violation("anyOf", "doesn't match any schema of anyOf")
+		}
+	}
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		if i, ok := v.discriminatedBranch(value, s, oneOf); ok {
+			return v.check(value, oneOf[i], schemaPath+"/oneOf/"+strconv.Itoa(i), pointer, depth+1)
+		}
+		matches := 0
+		for i, sub := range oneOf {
+			if v.check(value, sub, schemaPath+"/oneOf/"+strconv.Itoa(i), pointer, depth+1) == nil {
+				matches++
+			}
+		}
+		switch {
+		case matches == 0:
+			return violation("oneOf", "doesn't match any schema of oneOf")
+		case matches > 1:
+			return violation("oneOf", "matches %d schemas of oneOf, rather than one", matches)
+		}
+	}
+	if not, ok := s["not"]; ok && v.check(value, not, schemaPath+"/not", pointer, depth+1) == nil {
+		return violation("not", "matches the schema of not")
+	}
+	return nil
+}
+
+// discriminatedBranch returns the index of the oneOf branch the
+// discriminator of s selects for value, so that the mismatches of that branch
+// are reported rather than the oneOf as a whole.
+func (v *ResponseValidator) discriminatedBranch(value any, s map[string]any, oneOf []any) (int, bool) {
+	discriminator, _ := s["discriminator"].(map[string]any)
+	property, _ := discriminator["propertyName"].(string)
+	object, _ := value.(map[string]any)
+	name, ok := object[property].(string)
+	if property == "" || !ok {
+		return 0, false
+	}
+	ref := "#/components/schemas/" + name
+	if mapping, ok := discriminator["mapping"].(map[string]any); ok {
+		if mapped, ok := mapping[name].(string); ok {
+			ref = mapped
+			if !strings.Contains(mapped, "/") {
+				ref = "#/components/schemas/" + mapped
+			}
+		}
+	}
+	for i, sub := range oneOf {
+		if m, ok := sub.(map[string]any); ok && m["$ref"] == ref {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func checkString(value string, s map[string]any, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	length := utf8.RuneCountInString(value)
+	if limit, ok := schemaNumber(s["minLength"]); ok && float64(length) < limit {
+		return violation("minLength", "length %d is shorter than %v", length, limit)
+	}
+	if limit, ok := schemaNumber(s["maxLength"]); ok && float64(length) > limit {
+		return violation("maxLength", "length %d is longer than %v", length, limit)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(value) {
+			return violation("pattern", "%q doesn't match %s", value, pattern)
+		}
+	}
+	format, _ := s["format"].(string)
+	var err error
+	switch format {
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	case "date":
+		_, err = time.Parse(time.DateOnly, value)
+	case "uuid":
+		if !uuidPattern.MatchString(value) {
+			err = fmt.Errorf("not a UUID")
+		}
+	}
+	if err != nil {
+		return violation("format", "%q isn't a valid %s", value, format)
+	}
+	return nil
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func checkNumber(value json.Number, s map[string]any, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	n, err := value.Float64()
+	if err != nil {
+		return violation("type", "invalid number %s", value)
+	}
+	// exclusiveMinimum and exclusiveMaximum are booleans qualifying minimum
+	// and maximum in OpenAPI 3.0, and limits of their own in 3.1.
+	if limit, ok := schemaNumber(s["minimum"]); ok {
+		if s["exclusiveMinimum"] == true && n <= limit {
+			return violation("exclusiveMinimum", "%s isn't greater than %v", value, limit)
+		}
+		if n < limit {
+			return violation("minimum", "%s is less than %v", value, limit)
+		}
+	}
+	if limit, ok := schemaNumber(s["maximum"]); ok {
+		if s["exclusiveMaximum"] == true && n >= limit {
+			return violation("exclusiveMaximum", "%s isn't less than %v", value, limit)
+		}
+		if n > limit {
+			return violation("maximum", "%s is greater than %v", value, limit)
+		}
+	}
+	if limit, ok := schemaNumber(s["exclusiveMinimum"]); ok && n <= limit {
+		return violation("exclusiveMinimum", "%s isn't greater than %v", value, limit)
+	}
+	if limit, ok := schemaNumber(s["exclusiveMaximum"]); ok && n >= limit {
+		return violation("exclusiveMaximum", "%s isn't less than %v", value, limit)
+	}
+	if divisor, ok := schemaNumber(s["multipleOf"]); ok && divisor > 0 {
+		if q := n / divisor; math.Abs(q-math.Round(q)) > 1e-9 {
+			return violation("multipleOf", "%s isn't a multiple of %v", value, divisor)
+		}
+	}
+	return nil
+}
+
+func (v *ResponseValidator) checkArray(value []any, s map[string]any, schemaPath, pointer string, depth int, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	if limit, ok := schemaNumber(s["minItems"]); ok && float64(len(value)) < limit {
+		return violation("minItems", "has %d items, fewer than %v", len(value), limit)
+	}
+	if limit, ok := schemaNumber(s["maxItems"]); ok && float64(len(value)) > limit {
+		return violation("maxItems", "has %d items, more than %v", len(value), limit)
+	}
+	if s["uniqueItems"] == true {
+		for i := range value {
+			for j := range i {
+				if jsonEqual(value[i], value[j]) {
+					return violation("uniqueItems", "items %d and %d are equal", j, i)
+				}
+			}
+		}
+	}
+	prefix, _ := s["prefixItems"].([]any)
+	for i, item := range value {
+		itemPointer := pointer + "/" + strconv.Itoa(i)
+		if i < len(prefix) {
+			if found := v.check(item, prefix[i], schemaPath+"/prefixItems/"+strconv.Itoa(i), itemPointer, depth+1); found != nil {
+				return found
+			}
+			continue
+		}
+		if items, ok := s["items"]; ok {
+			if found := v.check(item, items, schemaPath+"/items", itemPointer, depth+1); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ResponseValidator) checkObject(value map[string]any, s map[string]any, schemaPath, pointer string, depth int, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := value[name]; !present {
+					return violation("required", "missing required property %q", name)
+				}
+			}
+		}
+	}
+	if limit, ok := schemaNumber(s["minProperties"]); ok && float64(len(value)) < limit {
+		return violation("minProperties", "has %d properties, fewer than %v", len(value), limit)
+	}
+	if limit, ok := schemaNumber(s["maxProperties"]); ok && float64(len(value)) > limit {
+		return violation("maxProperties", "has %d properties, more than %v", len(value), limit)
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	patterns, _ := s["patternProperties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+	for _, name := range sortedSchemaKeys(value) {
+		propertyPointer := pointer + "/" + escapeSchemaPointer(name)
+		known := false
+		if sub, ok := properties[name]; ok {
+			known = true
+			if found := v.check(value[name], sub, schemaPath+"/properties/"+escapeSchemaPointer(name), propertyPointer, depth+1); found != nil {
+				return found
+			}
+		}
+		for _, pattern := range sortedSchemaKeys(patterns) {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+				known = true
+				if found := v.check(value[name], patterns[pattern], schemaPath+"/patternProperties/"+escapeSchemaPointer(pattern), propertyPointer, depth+1); found != nil {
+					return found
+				}
+			}
+		}
+		if known || !hasAdditional {
+			continue
+		}
+		if allowed, ok := additional.(bool); ok && !allowed {
+			return &schemaViolation{pointer: propertyPointer, schemaPath: schemaPath + "/additionalProperties", message: fmt.Sprintf("property %q isn't allowed", name)}
+		}
+		if found := v.check(value[name], additional, schemaPath+"/additionalProperties", propertyPointer, depth+1); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// schemaTypes returns the types of a type keyword, a name or a list of them.
+func schemaTypes(node any) []string {
+	switch t := node.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// schemaNumber returns the value of a numeric keyword.
+func schemaNumber(node any) (float64, bool) {
+	n, ok := node.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+// jsonType returns the JSON Schema type of a decoded JSON value; numbers
+// without a fractional part are integers.
+func jsonType(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if f, err := value.Float64(); err == nil && f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// jsonEqual tells whether two decoded JSON values are equal, comparing
+// numbers by value.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		bn, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		af, aerr := a.Float64()
+		bf, berr := bn.Float64()
+		return aerr == nil && berr == nil && af == bf
+	case []any:
+		bs, ok := b.([]any)
+		if !ok || len(a) != len(bs) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], bs[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bm, ok := b.(map[string]any)
+		if !ok || len(a) != len(bm) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := bm[k]
+			if !ok || !jsonEqual(av, bv) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+func formatJSONValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func escapeSchemaPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func sortedSchemaKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Middleware validates the responses next writes, calling report with the
+// request and error of each which doesn't match the spec, once it has been
+// written. Bodies are copied as they're written, so it is meant for tests.
+func (v *ResponseValidator) Middleware(report func(r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &responseCapture{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			status, header := rec.status, rec.header
+			if status == 0 {
+				status, header = http.StatusOK, w.Header().Clone()
+			}
+			if header.Get("Content-Type") == "" && rec.body.Len() > 0 {
+				// As net/http does when writing the body.
+				header.Set("Content-Type", http.DetectContentType(rec.body.Bytes()))
+			}
+			if err := v.Validate(r.Method, r.URL.Path, status, header, rec.body.Bytes()); err != nil {
+				report(r, err)
+			}
+		})
+	}
+}
+
+// responseCapture copies the status, headers and body written through it.
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+		c.header = c.ResponseWriter.Header().Clone()
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		c.WriteHeader(http.StatusOK)
+	}
+	c.body.Write(p)
+	return c.ResponseWriter.Write(p)
+}
+
+// Flush lets streamed responses through.
+func (c *responseCapture) Flush() {
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (c *responseCapture) Unwrap() http.ResponseWriter {
+	return c.ResponseWriter
+}
+
+// TestingT is the part of testing.TB NewValidatingTestServer uses.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Cleanup(func())
+}
+
+// NewValidatingTestServer starts an httptest.Server serving handler, which
+// fails t for each response not matching spec, the JSON of an OpenAPI
+// document, with the schema path of the mismatch. The server is closed when
+// the test ends.
+func NewValidatingTestServer(t TestingT, spec []byte, handler http.Handler) *httptest.Server {
+	t.Helper()
+	validator, err := NewResponseValidator(spec)
+	if err != nil {
+		t.Fatalf("response validation: %v", err)
+		return nil
+	}
+	server := httptest.NewServer(validator.Middleware(func(r *http.Request, err error) {
+		t.Errorf("response validation: %v", err)
+	})(handler))
+	t.Cleanup(server.Close)
+	return server
+}
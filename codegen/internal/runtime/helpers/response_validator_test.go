@@ -0,0 +1,210 @@
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testValidationSpec = `{
+  "paths": {
+    "/pets": {
+      "get": {"responses": {
+        "200": {"content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Pet"}, "maxItems": 2}}}},
+        "default": {"$ref": "#/components/responses/Error"}
+      }}
+    },
+    "/pets/{id}": {
+      "get": {"responses": {
+        "200": {
+          "headers": {"ETag": {"required": true, "schema": {"type": "string"}}},
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Pet"}}}
+        },
+        "404": {"description": ""}
+      }},
+      "delete": {"responses": {"2XX": {"description": ""}}}
+    },
+    "/pets/mine": {
+      "get": {"responses": {"200": {"content": {"text/plain": {"schema": {"type": "string"}}}}}}
+    },
+    "/animals": {
+      "get": {"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Animal"}}}}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Pet": {
+        "type": "object",
+        "required": ["name"],
+        "additionalProperties": false,
+        "properties": {
+          "name": {"type": "string", "minLength": 1},
+          "age": {"type": "integer", "minimum": 0},
+          "born": {"type": "string", "format": "date"},
+          "owner": {"type": "string", "nullable": true},
+          "kind": {"enum": ["cat", "dog"]}
+        }
+      },
+      "Cat": {"type": "object", "required": ["type", "lives"], "properties": {"type": {"type": "string"}, "lives": {"type": "integer", "maximum": 9}}},
+      "Dog": {"type": "object", "required": ["type"], "properties": {"type": {"type": "string"}, "good": {"type": "boolean"}}},
+      "Animal": {
+        "oneOf": [{"$ref": "#/components/schemas/Cat"}, {"$ref": "#/components/schemas/Dog"}],
+        "discriminator": {"propertyName": "type", "mapping": {"cat": "#/components/schemas/Cat", "dog": "Dog"}}
+      },
+      "Error": {"type": "object", "required": ["message"], "properties": {"message": {"type": "string"}}}
+    },
+    "responses": {
+      "Error": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    }
+  }
+}`
+
+func jsonHeader(pairs ...string) http.Header {
+	h := http.Header{"Content-Type": {"application/json"}}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		h.Set(pairs[i], pairs[i+1])
+	}
+	return h
+}
+
+func TestResponseValidator(t *testing.T) {
+	v, err := NewResponseValidator([]byte(testValidationSpec))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		status     int
+		header     http.Header
+		body       string
+		pointer    string
+		schemaPath string
+		message    string
+	}{
+		{name: "valid", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":"Tom","age":3,"born":"2020-01-02","owner":null,"kind":"cat"}`},
+		{name: "wrong type", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":42}`, pointer: "/name", schemaPath: "#/components/schemas/Pet/properties/name/type",
+			message: "expected string, got integer"},
+		{name: "missing property", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{}`, schemaPath: "#/components/schemas/Pet/required", message: `missing required property "name"`},
+		{name: "extra property", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":"Tom","color":"grey"}`, pointer: "/color",
+			schemaPath: "#/components/schemas/Pet/additionalProperties", message: `property "color" isn't allowed`},
+		{name: "not an integer", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":"Tom","age":1.5}`, pointer: "/age", schemaPath: "#/components/schemas/Pet/properties/age/type",
+			message: "expected integer, got number"},
+		{name: "format", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":"Tom","born":"yesterday"}`, pointer: "/born",
+			schemaPath: "#/components/schemas/Pet/properties/born/format", message: `"yesterday" isn't a valid date`},
+		{name: "enum", method: "GET", path: "/pets/1", status: 200, header: jsonHeader("ETag", "v1"),
+			body: `{"name":"Tom","kind":"fish"}`, pointer: "/kind",
+			schemaPath: "#/components/schemas/Pet/properties/kind/enum", message: `"fish" isn't one of the allowed values`},
+		{name: "missing header", method: "GET", path: "/pets/1", status: 200, header: jsonHeader(),
+			body: `{"name":"Tom"}`, schemaPath: "#/paths/~1pets~1{id}/get/responses/200/headers/ETag/required",
+			message: "missing required header ETag"},
+		{name: "undeclared status", method: "GET", path: "/pets/1", status: 500, header: jsonHeader(),
+			body: `{}`, schemaPath: "#/paths/~1pets~1{id}/get/responses", message: "status 500 isn't declared"},
+		{name: "undeclared content type", method: "GET", path: "/pets/1", status: 200,
+			header: http.Header{"Content-Type": {"text/html"}, "Etag": {"v1"}}, body: `<p>`,
+			schemaPath: "#/paths/~1pets~1{id}/get/responses/200/content", message: "content type text/html isn't declared"},
+		{name: "body without content", method: "GET", path: "/pets/1", status: 404, header: jsonHeader(),
+			body: `{}`, schemaPath: "#/paths/~1pets~1{id}/get/responses/404", message: "has a body, but declares no content"},
+		{name: "status range", method: "DELETE", path: "/pets/1", status: 204},
+		{name: "array item", method: "GET", path: "/pets", status: 200, header: jsonHeader(),
+			body: `[{"name":"Tom"},{"name":""}]`, pointer: "/1/name",
+			schemaPath: "#/components/schemas/Pet/properties/name/minLength", message: "length 0 is shorter than 1"},
+		{name: "array length", method: "GET", path: "/pets", status: 200, header: jsonHeader(),
+			body:       `[{"name":"a"},{"name":"b"},{"name":"c"}]`,
+			schemaPath: "#/paths/~1pets/get/responses/200/content/application~1json/schema/maxItems",
+			message:    "has 3 items, more than 2"},
+		{name: "default response", method: "GET", path: "/pets", status: 503, header: jsonHeader(),
+			body: `{"message":1}`, pointer: "/message", schemaPath: "#/components/schemas/Error/properties/message/type",
+			message: "expected string, got integer"},
+		{name: "literal path", method: "GET", path: "/pets/mine", status: 200,
+			header: http.Header{"Content-Type": {"text/plain; charset=utf-8"}}, body: "mine"},
+		{name: "discriminator", method: "GET", path: "/animals", status: 200, header: jsonHeader(),
+			body: `{"type":"cat","lives":10}`, pointer: "/lives",
+			schemaPath: "#/components/schemas/Cat/properties/lives/maximum", message: "10 is greater than 9"},
+		{name: "discriminator mapping name", method: "GET", path: "/animals", status: 200, header: jsonHeader(),
+			body: `{"type":"dog","good":"very"}`, pointer: "/good",
+			schemaPath: "#/components/schemas/Dog/properties/good/type", message: "expected boolean, got string"},
+		{name: "invalid JSON", method: "GET", path: "/animals", status: 200, header: jsonHeader(),
+			body: `{`, schemaPath: "#/paths/~1animals/get/responses/200/content/application~1json",
+			message: "invalid JSON body: unexpected EOF"},
+		{name: "unknown path", method: "GET", path: "/owners", status: 500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.method, tt.path, tt.status, tt.header, []byte(tt.body))
+			if tt.message == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var verr *ResponseValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.pointer, verr.Pointer)
+			assert.Equal(t, tt.schemaPath, verr.SchemaPath)
+			assert.Equal(t, tt.message, verr.Message)
+		})
+	}
+}
+
+func TestResponseValidationError(t *testing.T) {
+	err := &ResponseValidationError{Method: "GET", Route: "/pets/{id}", Status: 200, Pointer: "/name",
+		SchemaPath: "#/components/schemas/Pet/properties/name/type", Message: "expected string, got integer"}
+	assert.Equal(t, "GET /pets/{id}: 200 response at /name: expected string, got integer (#/components/schemas/Pet/properties/name/type)", err.Error())
+}
+
+// recordingT records the failures NewValidatingTestServer reports.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestNewValidatingTestServer(t *testing.T) {
+	rt := &recordingT{TB: t}
+	server := NewValidatingTestServer(rt, []byte(testValidationSpec), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "v1")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/pets/1" {
+			_, _ = io.WriteString(w, `{"name":"Tom"}`)
+			return
+		}
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	for _, path := range []string{"/pets/1", "/pets/2"} {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	server.Close()
+	assert.Equal(t, []string{"response validation: GET /pets/{id}: 418 response: status 418 isn't declared (#/paths/~1pets~1{id}/get/responses)"}, rt.errors)
+}
+
+func TestResponseValidatorMiddlewareSniffsContentType(t *testing.T) {
+	v, err := NewResponseValidator([]byte(testValidationSpec))
+	require.NoError(t, err)
+	var reported []error
+	handler := v.Middleware(func(r *http.Request, err error) {
+		reported = append(reported, err)
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "mine")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pets/mine", nil))
+	assert.Equal(t, "mine", rec.Body.String())
+	assert.Empty(t, reported)
+}
@@ -0,0 +1,268 @@
+package helpers
+
+//oapi-runtime:function helpers/DecodeStream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+	"mime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// recordSeparator starts each item of an application/json-seq stream.
+const recordSeparator = 0x1e
+
+// streamMediaType returns the media type of contentType, lowercased and
+// without parameters.
+func streamMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(contentType, ";")
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// DecodeStream returns an iterator over the items of body, a stream of the
+// sequential media type contentType: text/event-stream, whose events carry
+// an item in their data, JSON Lines (application/jsonl or
+// application/x-ndjson), or JSON text sequences (application/json-seq).
+// Items are decoded from JSON, except event data decoded into strings,
+// which is taken as is. The iterator closes body when it stops, and yields
+// an error at most once, as its last value.
+func DecodeStream[T any](body io.ReadCloser, contentType string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer body.Close()
+		if streamMediaType(contentType) == "text/event-stream" {
+			events := bufio.NewReader(body)
+			for {
+				event, err := readEvent(events)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err == nil && !event.hasData {
+					continue
+				}
+				var item T
+				if err == nil {
+					err = decodeEventData(event.data, &item)
+				}
+				if !yield(item, err) || err != nil {
+					return
+				}
+			}
+		}
+
+		dec := json.NewDecoder(recordSeparatorReader{body})
+		for {
+			var item T
+			err := dec.Decode(&item)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseEvent is an event read from a text/event-stream.
+type sseEvent struct {
+	id      string
+	hasID   bool // Whether the event has an id field, which may be empty
+	name    string
+	retry   time.Duration
+	data    string
+	hasData bool
+}
+
+// readEvent reads the next event from r, skipping comments and blank lines.
+// Events without data still carry id and retry fields, which clients must
+// apply. It returns io.EOF at the end of the stream, dropping an unterminated
+// event as the spec requires.
+func readEvent(r *bufio.Reader) (sseEvent, error) {
+	var event sseEvent
+	var data []string
+	hasField := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return sseEvent{}, io.EOF
+			}
+			return sseEvent{}, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line == "" {
+			if hasField {
+				event.data = strings.Join(data, "\n")
+				return event, nil
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "data":
+			data = append(data, value)
+			event.hasData = true
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				event.id, event.hasID = value, true
+			}
+		case "event":
+			event.name = value
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 63); err == nil {
+				event.retry = time.Duration(ms) * time.Millisecond
+			}
+		default:
+			// Comments, whose field is empty, and unknown fields are ignored.
+			continue
+		}
+		hasField = true
+	}
+}
+
+// decodeEventData decodes the data of an event into v: strings as is, other
+// types from JSON.
+func decodeEventData[T any](data string, v *T) error {
+	if s, ok := any(v).(*string); ok {
+		*s = data
+		return nil
+	}
+	return json.Unmarshal([]byte(data), v)
+}
+
+// recordSeparatorReader reads an application/json-seq stream as whitespace
+// separated JSON values.
+type recordSeparatorReader struct {
+	r io.Reader
+}
+
+func (r recordSeparatorReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	for i, b := range p[:n] {
+		if b == recordSeparator {
+			p[i] = ' '
+		}
+	}
+	return n, err
+}
+
+// EncodeStream returns a request body streaming the items of seq in
+// contentType, any of the media types DecodeStream reads. Items are encoded
+// as the body is read, so a server reading slowly holds back seq. The body
+// fails rather than ends if ctx is done before seq, so that a truncated
+// stream isn't taken for a complete one. Closing the body stops seq.
+func EncodeStream[T any](ctx context.Context, seq iter.Seq[T], contentType string) io.ReadCloser {
+	mediaType := streamMediaType(contentType)
+	pr, pw := io.Pipe()
+	return &streamBody{
+		PipeReader: pr,
+		start: sync.OnceFunc(func() {
+			go func() {
+				var err error
+				for item := range seq {
+					if err = writeStreamItem(pw, item, mediaType); err != nil {
+						break
+					}
+				}
+				if err == nil {
+					err = ctx.Err()
+				}
+				_ = pw.CloseWithError(err)
+			}()
+		}),
+	}
+}
+
+// writeStreamItem writes item to w, framed for mediaType.
+func writeStreamItem[T any](w io.Writer, item T, mediaType string) error {
+	var data string
+	if s, ok := any(item).(string); ok && mediaType == "text/event-stream" {
+		data = s
+	} else {
+		buf, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		data = string(buf)
+	}
+
+	var frame string
+	switch mediaType {
+	case "text/event-stream":
+		frame = "data: " + strings.ReplaceAll(strings.ReplaceAll(data, "\r\n", "\n"), "\n", "\ndata: ") + "\n\n"
+	case "application/json-seq":
+		frame = "\x1e" + data + "\n"
+	default:
+		frame = data + "\n"
+	}
+	_, err := io.WriteString(w, frame)
+	return err
+}
+
+// streamBody starts encoding its items on the first read, so that a body
+// which is never sent doesn't leave a goroutine behind.
+type streamBody struct {
+	*io.PipeReader
+	start func()
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	b.start()
+	return b.PipeReader.Read(p)
+}
+
+// StreamChannel sends the items of seq on the returned channel, which
+// buffers up to buffer of them. Once the buffer is full, seq isn't advanced
+// until an item is received, which holds back reading the stream. The error
+// ending the stream, or ctx.Err() if ctx is done first, is sent on the error
+// channel, and both channels are then closed. Cancel ctx to stop receiving
+// early.
+func StreamChannel[T any](ctx context.Context, seq iter.Seq2[T, error], buffer int) (<-chan T, <-chan error) {
+	items := make(chan T, max(buffer, 0))
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(items)
+		for item, err := range seq {
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return items, errs
+}
+
+// ChannelSeq returns an iterator over the items received on ch, which stops
+// when ch is closed or ctx is done.
+func ChannelSeq[T any](ctx context.Context, ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			select {
+			case item, ok := <-ch:
+				if !ok || !yield(item) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
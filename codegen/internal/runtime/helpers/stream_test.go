@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type streamItem struct {
+	N int `json:"n"`
+}
+
+// collect drains seq, failing on error.
+func collect[T any](t *testing.T, seq func(func(T, error) bool)) []T {
+	t.Helper()
+	var items []T
+	for item, err := range seq {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	return items
+}
+
+func TestDecodeStream(t *testing.T) {
+	tests := []struct {
+		contentType string
+		body        string
+	}{
+		{"application/jsonl", "{\"n\":1}\n{\"n\":2}\n"},
+		{"application/x-ndjson; charset=utf-8", "{\"n\":1}\r\n\n{\"n\":2}"},
+		{"application/json-seq", "\x1e{\"n\":1}\n\x1e{\"n\":2}\n"},
+		{"text/event-stream", ": hello\n\nevent: tick\nid: 1\ndata: {\"n\":1}\n\nretry: 10\n\ndata: {\"n\":2}\r\n\r\ndata: {\"n\":3}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			items := collect(t, DecodeStream[streamItem](io.NopCloser(strings.NewReader(tt.body)), tt.contentType))
+			assert.Equal(t, []streamItem{{N: 1}, {N: 2}}, items)
+		})
+	}
+}
+
+func TestDecodeStream_EventStreamStrings(t *testing.T) {
+	body := "data: first\ndata: second\n\ndata\n\n"
+	items := collect(t, DecodeStream[string](io.NopCloser(strings.NewReader(body)), "text/event-stream"))
+	assert.Equal(t, []string{"first\nsecond", ""}, items)
+}
+
+func TestDecodeStream_Error(t *testing.T) {
+	var errs []error
+	for _, err := range DecodeStream[streamItem](io.NopCloser(strings.NewReader("{\"n\":1}\nnope\n{\"n\":3}\n")), "application/jsonl") {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 2)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+}
+
+func TestEncodeStream(t *testing.T) {
+	items := slices.Values([]streamItem{{N: 1}, {N: 2}})
+	for _, contentType := range []string{"application/jsonl", "application/json-seq", "text/event-stream"} {
+		t.Run(contentType, func(t *testing.T) {
+			body := EncodeStream(context.Background(), items, contentType)
+			decoded := collect(t, DecodeStream[streamItem](body, contentType))
+			assert.Equal(t, []streamItem{{N: 1}, {N: 2}}, decoded)
+		})
+	}
+
+	body, err := io.ReadAll(EncodeStream(context.Background(), slices.Values([]string{"a\nb"}), "text/event-stream"))
+	require.NoError(t, err)
+	assert.Equal(t, "data: a\ndata: b\n\n", string(body))
+}
+
+func TestEncodeStream_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan streamItem, 1)
+	ch <- streamItem{N: 1}
+	body := EncodeStream(ctx, ChannelSeq(ctx, ch), "application/jsonl")
+
+	buf := make([]byte, 64)
+	n, err := body.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"n\":1}\n", string(buf[:n]))
+
+	// The body fails instead of ending, so the request isn't completed.
+	cancel()
+	_, err = io.ReadAll(body)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestStreamChannel(t *testing.T) {
+	body := io.NopCloser(strings.NewReader("{\"n\":1}\n{\"n\":2}\nnope\n"))
+	items, errs := StreamChannel(context.Background(), DecodeStream[streamItem](body, "application/jsonl"), 1)
+
+	var received []streamItem
+	for item := range items {
+		received = append(received, item)
+	}
+	assert.Equal(t, []streamItem{{N: 1}, {N: 2}}, received)
+	assert.Error(t, <-errs)
+	_, open := <-errs
+	assert.False(t, open)
+}
+
+func TestStreamChannel_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	seq := func(yield func(int, error) bool) {
+		for i := 0; ; i++ {
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+	items, errs := StreamChannel(ctx, seq, 0)
+	assert.Equal(t, 0, <-items)
+	cancel()
+	for range items {
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
@@ -0,0 +1,58 @@
+package jsonpointer
+
+//oapi-runtime:function jsonpointer/DecodeJSON
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// DecodeError is an error decoding a JSON document, located by the JSON
+// Pointer of the value which failed to decode.
+type DecodeError struct {
+	// Pointer locates the failing value; "" is the whole document.
+	Pointer string
+	// Offset is the byte offset in the document the decoder failed at.
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	at := e.Pointer
+	if at == "" {
+		at = "document root"
+	}
+	return "decoding JSON at " + at + ": " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WrapDecodeError locates err, returned decoding data, in a *DecodeError.
+// Syntax and type errors carry the offset the decoder failed at; other
+// errors, such as those of UnmarshalJSON methods, are returned as is, as is
+// nil.
+func WrapDecodeError(data []byte, err error) error {
+	var offset, scanned int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &syntaxErr):
+		// The offset of a syntax error counts the invalid byte, which may
+		// close the value it belongs in.
+		offset, scanned = syntaxErr.Offset, syntaxErr.Offset-1
+	case errors.As(err, &typeErr):
+		offset, scanned = typeErr.Offset, typeErr.Offset
+	default:
+		return err
+	}
+	return &DecodeError{Pointer: PointerAtOffset(data, scanned), Offset: offset, Err: err}
+}
+
+// DecodeJSON is json.Unmarshal, returning errors located by WrapDecodeError.
+func DecodeJSON(data []byte, v any) error {
+	return WrapDecodeError(data, json.Unmarshal(data, v))
+}
@@ -0,0 +1,66 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pet struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+type petList struct {
+	Pets []pet `json:"pets"`
+}
+
+func TestDecodeJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		pointer string
+	}{
+		{"type error", `{"pets": [{"name": "Rex"}, {"name": 7}]}`, "/pets/1/name"},
+		{"array element", `{"pets": [{"tags": ["a", false]}]}`, "/pets/0/tags/1"},
+		{"container type", `{"pets": {"name": "Rex"}}`, "/pets"},
+		{"syntax error", `{"pets": [{"name": }]}`, "/pets/0/name"},
+		{"root", `[]`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v petList
+			err := DecodeJSON([]byte(tt.data), &v)
+			var decodeErr *DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, tt.pointer, decodeErr.Pointer)
+			assert.Positive(t, decodeErr.Offset)
+		})
+	}
+}
+
+func TestDecodeJSON_Valid(t *testing.T) {
+	var v petList
+	require.NoError(t, DecodeJSON([]byte(`{"pets": [{"name": "Rex"}]}`), &v))
+	assert.Equal(t, "Rex", v.Pets[0].Name)
+}
+
+func TestDecodeError(t *testing.T) {
+	var v petList
+	err := DecodeJSON([]byte(`{"pets": [{"name": 7}]}`), &v)
+	assert.ErrorContains(t, err, "decoding JSON at /pets/0/name: ")
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, err, &typeErr)
+
+	err = DecodeJSON([]byte(`"x"`), &v)
+	assert.ErrorContains(t, err, "decoding JSON at document root: ")
+}
+
+func TestWrapDecodeError(t *testing.T) {
+	assert.NoError(t, WrapDecodeError([]byte(`{}`), nil))
+	other := errors.New("invalid pet")
+	assert.Same(t, other, WrapDecodeError([]byte(`{}`), other))
+}
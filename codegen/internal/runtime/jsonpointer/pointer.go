@@ -0,0 +1,159 @@
+package jsonpointer
+
+//oapi-runtime:function jsonpointer/FormatPointer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EscapePointerToken escapes a reference token for use in a JSON Pointer, as
+// RFC 6901 requires: "~" becomes "~0" and "/" becomes "~1".
+func EscapePointerToken(token string) string {
+	if !strings.ContainsAny(token, "~/") {
+		return token
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// UnescapePointerToken reverses EscapePointerToken.
+func UnescapePointerToken(token string) string {
+	if !strings.Contains(token, "~") {
+		return token
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+// FormatPointer returns the JSON Pointer made of tokens, object keys or
+// array indexes, escaping them. No tokens make "", the whole document.
+func FormatPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/" + EscapePointerToken(token))
+	}
+	return b.String()
+}
+
+// ParsePointer splits a JSON Pointer into its unescaped reference tokens.
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with \"/\"", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = UnescapePointerToken(token)
+	}
+	return tokens, nil
+}
+
+// pointerFrame is an object or array enclosing the position PointerAtOffset
+// scans to.
+type pointerFrame struct {
+	array bool
+	// index is the index of the current element of an array, or -1 before
+	// the first one.
+	index int
+	// key is the key of the current member of an object, and inValue whether
+	// the scan is past its colon.
+	key     string
+	inValue bool
+}
+
+// PointerAtOffset returns the JSON Pointer of the innermost value of data
+// which the first offset bytes end in, as reported by the Offset of a
+// *json.SyntaxError or *json.UnmarshalTypeError. data need not be valid past
+// offset.
+func PointerAtOffset(data []byte, offset int64) string {
+	offset = min(max(offset, 0), int64(len(data)))
+	var stack []pointerFrame
+	for i := int64(0); i < offset; i++ {
+		switch c := data[i]; c {
+		case '{', '[':
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+			stack = append(stack, pointerFrame{array: c == '[', index: -1})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ':':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = true
+			}
+		case ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = false
+			}
+		case '"':
+			end := stringEnd(data, i)
+			if len(stack) > 0 {
+				top := &stack[len(stack)-1]
+				if !top.array && !top.inValue {
+					top.key = unquoteKey(data[i:min(end, int64(len(data)))])
+				} else {
+					startValue(top)
+				}
+			}
+			i = end - 1
+		case ' ', '\t', '\r', '\n':
+		default:
+			// A literal: a number, true, false or null.
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, frame := range stack {
+		switch {
+		case frame.array && frame.index >= 0:
+			b.WriteString("/" + strconv.Itoa(frame.index))
+		case !frame.array && frame.inValue:
+			b.WriteString("/" + EscapePointerToken(frame.key))
+		default:
+			// The scan stopped within the container itself.
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// startValue notes that a value starts in frame, moving arrays on to their
+// next element.
+func startValue(frame *pointerFrame) {
+	if frame.array && !frame.inValue {
+		frame.index++
+		frame.inValue = true
+	}
+}
+
+// stringEnd returns the offset just past the string starting at data[start],
+// or len(data) if it is unterminated.
+func stringEnd(data []byte, start int64) int64 {
+	for i := start + 1; i < int64(len(data)); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return int64(len(data))
+}
+
+// unquoteKey decodes a quoted object key, falling back to its raw text when
+// it isn't valid JSON.
+func unquoteKey(quoted []byte) string {
+	var key string
+	if err := json.Unmarshal(quoted, &key); err != nil {
+		return strings.Trim(string(quoted), `"`)
+	}
+	return key
+}
@@ -0,0 +1,72 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatPointer(t *testing.T) {
+	assert.Equal(t, "", FormatPointer())
+	assert.Equal(t, "/pets/0/name", FormatPointer("pets", "0", "name"))
+	assert.Equal(t, "/a~1b/c~0d/", FormatPointer("a/b", "c~d", ""))
+}
+
+func TestParsePointer(t *testing.T) {
+	tokens, err := ParsePointer("/a~1b/c~0d/~01")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a/b", "c~d", "~1"}, tokens)
+
+	tokens, err = ParsePointer("")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	_, err = ParsePointer("pets/0")
+	assert.Error(t, err)
+}
+
+func TestPointerAtOffset(t *testing.T) {
+	data := []byte(`{"pets": [{"name": "Rex"}, {"name": "Tom", "tags": ["a", {"x/y": 1}]}], "b": true}`)
+	tests := []struct {
+		after string // the pointer is taken at the end of the first occurrence of after
+		want  string
+	}{
+		{`{`, ""},
+		{`{"pets": [`, "/pets"},
+		{`"Rex"`, "/pets/0/name"},
+		{`"Rex"}`, "/pets/0"},
+		{`"Tom"`, "/pets/1/name"},
+		{`["a"`, "/pets/1/tags/0"},
+		{`{"x/y": 1`, "/pets/1/tags/1/x~1y"},
+		{`"b": true`, "/b"},
+		{`"b": true}`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.after, func(t *testing.T) {
+			i := indexAfter(t, data, tt.after)
+			assert.Equal(t, tt.want, PointerAtOffset(data, i))
+		})
+	}
+
+	// Offsets out of range are clamped.
+	assert.Equal(t, "", PointerAtOffset(data, -1))
+	assert.Equal(t, "", PointerAtOffset(data, 1000))
+}
+
+func TestPointerAtOffset_EscapedKeys(t *testing.T) {
+	data := []byte(`{"say \"hi\"": {"a/b": 1}}`)
+	assert.Equal(t, "/say \"hi\"/a~1b", PointerAtOffset(data, int64(len(data)-2)))
+}
+
+// indexAfter returns the offset just past the first occurrence of s in data.
+func indexAfter(t *testing.T, data []byte, s string) int64 {
+	t.Helper()
+	for i := 0; i+len(s) <= len(data); i++ {
+		if string(data[i:i+len(s)]) == s {
+			return int64(i + len(s))
+		}
+	}
+	t.Fatalf("%q not found", s)
+	return 0
+}
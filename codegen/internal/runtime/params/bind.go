@@ -42,23 +42,37 @@ func BindParameter(paramName string, value string, dest any, opts ParameterOptio
 		return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
 	}
 
-	// If the destination implements encoding.TextUnmarshaler, use it directly.
-	if tu, ok := dest.(encoding.TextUnmarshaler); ok {
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	t := v.Type()
+
+	// If the destination implements ParamBinder, or encoding.TextUnmarshaler,
+	// use it directly, except for base64 byte slices, whose style prefixes are
+	// stripped below. Label and matrix prefixes are trimmed rather than split
+	// off, as these values may contain literal commas.
+	if pb, ok := dest.(ParamBinder); ok {
+		if err := pb.BindParam(trimStylePrefix(style, paramName, value)); err != nil {
+			return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, dest, err)
+		}
+		return nil
+	}
+	if tu, ok := dest.(encoding.TextUnmarshaler); ok && !(opts.Format == "byte" && isByteSlice(t)) {
+		value = trimStylePrefix(style, paramName, value)
 		if err := tu.UnmarshalText([]byte(value)); err != nil {
 			return fmt.Errorf("error unmarshaling '%s' text as %T: %w", value, dest, err)
 		}
 		return nil
 	}
 
-	v := reflect.Indirect(reflect.ValueOf(dest))
-	t := v.Type()
-
 	if t.Kind() == reflect.Struct || t.Kind() == reflect.Map {
 		parts, err := splitStyledParameter(style, opts.Explode, true, paramName, value)
 		if err != nil {
 			return err
 		}
-		return bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, dest)
+		return bindSplitPartsToDestinationStruct(paramName, trimHeaderParts(parts, opts.ParamLocation), opts.Explode, dest)
+	}
+
+	if t.Kind() == reflect.Slice && style == "simple" && isObjectType(t.Elem()) {
+		return bindObjectSlice(paramName, value, v, opts)
 	}
 
 	if t.Kind() == reflect.Slice {
@@ -82,29 +96,73 @@ func BindParameter(paramName string, value string, dest any, opts ParameterOptio
 		if err != nil {
 			return fmt.Errorf("error splitting input '%s' into parts: %w", value, err)
 		}
-		return bindSplitPartsToDestinationArray(parts, dest)
+		return bindSplitPartsToDestinationArray(trimHeaderParts(parts, opts.ParamLocation), dest)
+	}
+
+	value, err = primitiveValue(style, opts.Explode, paramName, value)
+	if err != nil {
+		return err
 	}
+	return BindStringToObject(value, dest)
+}
 
-	// Primitive types need style-specific prefix stripping before binding.
-	// Label and matrix use splitStyledParameter for their prefix formats.
-	// Form style adds a "name=" prefix (e.g. "p=5") which is meaningful in
-	// query strings but must be stripped for cookie/header values. We use
-	// TrimPrefix instead of splitStyledParameter to avoid splitting on commas,
-	// which would break string primitives containing literal commas.
+// primitiveValue strips the style-specific prefix of a primitive value.
+// Label and matrix use splitStyledParameter for their prefix formats.
+// Form style adds a "name=" prefix (e.g. "p=5") which is meaningful in
+// query strings but must be stripped for cookie/header values. We use
+// TrimPrefix instead of splitStyledParameter to avoid splitting on commas,
+// which would break string primitives containing literal commas.
+func primitiveValue(style string, explode bool, paramName string, value string) (string, error) {
 	switch style {
 	case "label", "matrix":
-		parts, err := splitStyledParameter(style, opts.Explode, false, paramName, value)
+		parts, err := splitStyledParameter(style, explode, false, paramName, value)
 		if err != nil {
-			return fmt.Errorf("error splitting parameter '%s': %w", paramName, err)
+			return "", fmt.Errorf("error splitting parameter '%s': %w", paramName, err)
 		}
 		if len(parts) != 1 {
-			return fmt.Errorf("parameter '%s': expected single value, got %d parts", paramName, len(parts))
+			return "", fmt.Errorf("parameter '%s': expected single value, got %d parts", paramName, len(parts))
 		}
-		value = parts[0]
+		return parts[0], nil
 	case "form":
-		value = strings.TrimPrefix(value, paramName+"=")
+		return strings.TrimPrefix(value, paramName+"="), nil
 	}
-	return BindStringToObject(value, dest)
+	return value, nil
+}
+
+// trimStylePrefix trims the prefix the label and matrix styles give a
+// primitive value.
+func trimStylePrefix(style string, paramName string, value string) string {
+	switch style {
+	case "label":
+		return strings.TrimPrefix(value, ".")
+	case "matrix":
+		return strings.TrimPrefix(value, ";"+paramName+"=")
+	}
+	return value
+}
+
+// bindObjectSlice binds an array of objects styled by styleObjectSlice, with
+// the objects separated by semicolons, to the slice v.
+func bindObjectSlice(paramName string, value string, v reflect.Value, opts ParameterOptions) error {
+	items := strings.Split(value, ";")
+	newArray := reflect.MakeSlice(v.Type(), len(items), len(items))
+	for i, item := range items {
+		parts, err := splitStyledParameter("simple", opts.Explode, true, paramName, item)
+		if err != nil {
+			return err
+		}
+		dest := newArray.Index(i)
+		if dest.Kind() == reflect.Ptr {
+			dest.Set(reflect.New(dest.Type().Elem()))
+		} else {
+			dest = dest.Addr()
+		}
+		if err := bindSplitPartsToDestinationStruct(paramName, trimHeaderParts(parts, opts.ParamLocation), opts.Explode, dest.Interface()); err != nil {
+			return fmt.Errorf("error binding item %d of '%s': %w", i, paramName, err)
+		}
+	}
+	v.Set(newArray)
+	return nil
 }
 
 // BindQueryParameter binds a query parameter from pre-parsed url.Values.
@@ -139,6 +197,28 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 
 	switch style {
 	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, whatever their
+		// kind, as it's in their own format.
+		if pb, ok := output.(ParamBinder); ok {
+			values, found := queryParams[paramName]
+			if !found {
+				if opts.Required {
+					return &MissingRequiredParameterError{ParamName: paramName}
+				}
+				return nil
+			}
+			if len(values) != 1 {
+				return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+			}
+			if err := pb.BindParam(values[0]); err != nil {
+				return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, output, err)
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
 		if opts.Explode {
 			// Exploded: each value is a separate key=value pair.
 			// spaceDelimited and pipeDelimited with explode=true are
@@ -251,6 +331,11 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 			// that should be bound from a single string, not decomposed as
 			// key-value objects.
 			switch bv := output.(type) {
+			case ParamBinder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.BindParam(parts[0])
 			case Binder:
 				if len(parts) != 1 {
 					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
@@ -284,6 +369,30 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 	}
 }
 
+// BindNullableQueryParameter binds an optional query parameter which allows
+// empty values to dest: an absent parameter leaves dest unspecified, an empty
+// one, such as "?flag=", sets it to null, and others are bound with
+// BindQueryParameter.
+func BindNullableQueryParameter[T any](paramName string, queryParams url.Values, dest *types.Nullable[T], opts ParameterOptions) error {
+	values, found := queryParams[paramName]
+	switch {
+	case !found:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		dest.SetUnspecified()
+	case len(values) == 1 && values[0] == "":
+		dest.SetNull()
+	default:
+		var value T
+		if err := BindQueryParameter(paramName, queryParams, &value, opts); err != nil {
+			return err
+		}
+		dest.Set(value)
+	}
+	return nil
+}
+
 // BindRawQueryParameter works like BindQueryParameter but operates on the raw
 // (undecoded) query string. This correctly handles form/explode=false
 // parameters whose values contain literal commas encoded as %2C — something
@@ -317,6 +426,16 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 
 	switch style {
 	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, so the decoded
+		// query serves.
+		if _, ok := output.(ParamBinder); ok {
+			queryParams, err := url.ParseQuery(rawQuery)
+			if err != nil {
+				return fmt.Errorf("error parsing query string: %w", err)
+			}
+			return BindQueryParameter(paramName, queryParams, dest, opts)
+		}
+
 		if opts.Explode {
 			// For explode, url.ParseQuery is fine — no delimiter commas to
 			// confuse with literal commas.
@@ -334,7 +453,18 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 					}
 					return nil
 				}
-				err = bindSplitPartsToDestinationArray(values, output)
+				if opts.Format == "byte" && isByteSlice(t) {
+					if len(values) != 1 {
+						return fmt.Errorf("expected single base64 value for byte slice parameter '%s', got %d values", paramName, len(values))
+					}
+					decoded, decErr := base64Decode(values[0])
+					if decErr != nil {
+						return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+					}
+					v.SetBytes(decoded)
+				} else {
+					err = bindSplitPartsToDestinationArray(values, output)
+				}
 			case reflect.Struct:
 				var fieldsPresent bool
 				fieldsPresent, err = bindParamsToExplodedObject(paramName, queryParams, output)
@@ -382,8 +512,10 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
 		}
 
-		// Primitive types: decode as-is without splitting.
-		if k != reflect.Slice && k != reflect.Struct && k != reflect.Map {
+		// Primitive types and structs unmarshaled from text: decode as-is
+		// without splitting.
+		_, isText := output.(encoding.TextUnmarshaler)
+		if (k != reflect.Slice && k != reflect.Struct && k != reflect.Map) || (k == reflect.Struct && isText) {
 			decoded, err := url.QueryUnescape(rawValues[0])
 			if err != nil {
 				return fmt.Errorf("error decoding query parameter '%s' value %q: %w", paramName, rawValues[0], err)
@@ -405,7 +537,9 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 			normalized = strings.ReplaceAll(normalized, " ", "%20")
 			rawParts = strings.Split(normalized, "%20")
 		case "pipeDelimited":
-			rawParts = strings.Split(rawValues[0], "|")
+			normalized := strings.ReplaceAll(rawValues[0], "%7C", "|")
+			normalized = strings.ReplaceAll(normalized, "%7c", "|")
+			rawParts = strings.Split(normalized, "|")
 		default:
 			rawParts = strings.Split(rawValues[0], ",")
 		}
@@ -422,7 +556,15 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 		var err error
 		switch k {
 		case reflect.Slice:
-			err = bindSplitPartsToDestinationArray(parts, output)
+			if opts.Format == "byte" && isByteSlice(t) {
+				decoded, decErr := base64Decode(strings.Join(parts, ","))
+				if decErr != nil {
+					return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+				}
+				v.SetBytes(decoded)
+			} else {
+				err = bindSplitPartsToDestinationArray(parts, output)
+			}
 		case reflect.Struct:
 			err = bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, output)
 		}
@@ -497,10 +639,14 @@ func unmarshalDeepObject(dst any, paramName string, params url.Values, required
 	return nil
 }
 
-// UnmarshalDeepObject unmarshals deepObject-style query parameters to a
-// destination. Exported for use by generated code and tests.
-func UnmarshalDeepObject(dst any, paramName string, params url.Values) error {
-	return unmarshalDeepObject(dst, paramName, params, false)
+// BindDeepObjectParam binds an exploded deepObject query parameter, such as
+// "filter[status]=open&filter[created][gte]=2024-01-01", from queryParams to
+// dest, a pointer to a struct, map or slice. Struct fields are matched by their
+// json tags, and repeated keys bind to consecutive array items. A missing
+// parameter leaves dest unchanged, or returns a MissingRequiredParameterError
+// when required.
+func BindDeepObjectParam(paramName string, queryParams url.Values, dest any, required bool) error {
+	return unmarshalDeepObject(dest, paramName, queryParams, required)
 }
 
 type fieldOrValue struct {
@@ -584,6 +730,10 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 		return nil
 
 	case reflect.Slice:
+		if tu, ok := dst.(encoding.TextUnmarshaler); ok && len(pathValues.fields) == 0 {
+			// A scalar slice type, such as types.Base64Bytes.
+			return tu.UnmarshalText([]byte(pathValues.value))
+		}
 		sliceLength := len(pathValues.fields)
 		dstSlice := reflect.MakeSlice(it, sliceLength, sliceLength)
 		err := assignDeepObjectSlice(dstSlice, pathValues)
@@ -594,11 +744,14 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 		return nil
 
 	case reflect.Struct:
+		if dst, isBinder := v.Interface().(ParamBinder); isBinder {
+			return dst.BindParam(pathValues.value)
+		}
 		if dst, isBinder := v.Interface().(Binder); isBinder {
 			return dst.Bind(pathValues.value)
 		}
 
-		if it.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+		if isDate(it) {
 			var date types.Date
 			var err error
 			date.Time, err = time.Parse(types.DateFormat, pathValues.value)
@@ -633,6 +786,11 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 			return nil
 		}
 
+		// Scalar struct types, such as Decimal, parse themselves.
+		if dst, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			return dst.UnmarshalText([]byte(pathValues.value))
+		}
+
 		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
 		if err != nil {
 			return fmt.Errorf("failed enumerating fields: %w", err)
@@ -701,19 +859,20 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 
 func assignDeepObjectSlice(dst reflect.Value, pathValues fieldOrValue) error {
 	nValues := len(pathValues.fields)
-	values := make([]string, nValues)
+	values := make([]fieldOrValue, nValues)
 	for i := 0; i < nValues; i++ {
 		indexStr := strconv.Itoa(i)
 		fv, found := pathValues.fields[indexStr]
 		if !found {
 			return errors.New("array deepObjects must have consecutive indices")
 		}
-		values[i] = fv.value
+		values[i] = fv
 	}
 
+	// Items keep their fields, so arrays of objects bind too.
 	for i := 0; i < nValues; i++ {
 		dstElem := dst.Index(i).Addr()
-		err := assignPathValues(dstElem.Interface(), fieldOrValue{value: values[i]})
+		err := assignPathValues(dstElem.Interface(), values[i])
 		if err != nil {
 			return fmt.Errorf("error binding array: %w", err)
 		}
@@ -781,10 +940,14 @@ func bindParamsToExplodedObject(paramName string, values url.Values, dest any) (
 	return fieldsPresent, nil
 }
 
-// indirectBinder checks if dest implements Binder and returns reflect values.
+// indirectBinder checks if dest implements ParamBinder or Binder and returns
+// reflect values.
 func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
 	v := reflect.ValueOf(dest)
 	if v.Type().NumMethod() > 0 && v.CanInterface() {
+		if u, ok := v.Interface().(ParamBinder); ok {
+			return u, reflect.Value{}, nil
+		}
 		if u, ok := v.Interface().(Binder); ok {
 			return u, reflect.Value{}, nil
 		}
@@ -794,7 +957,11 @@ func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
 	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
 		return dest, reflect.Value{}, nil
 	}
-	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+	if isDate(t) {
+		return dest, reflect.Value{}, nil
+	}
+	// Scalar struct types, such as Decimal, parse themselves.
+	if _, ok := dest.(encoding.TextUnmarshaler); ok {
 		return dest, reflect.Value{}, nil
 	}
 	return nil, v, t
@@ -2,9 +2,13 @@ package params
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -193,6 +197,75 @@ func TestBindParameter_FormCookie_Roundtrip(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "hello,world", result)
 	})
+	t.Run("cookie_values", func(t *testing.T) {
+		for _, explode := range []bool{false, true} {
+			styled, err := StyleCookieParam("ids", []int{3, 4, 5}, cookieOpts(explode))
+			require.NoError(t, err)
+
+			var result []int
+			require.NoError(t, BindParameter("ids", styled, &result, cookieOpts(explode)))
+			assert.Equal(t, []int{3, 4, 5}, result)
+		}
+	})
+}
+
+func TestBindParameter_HeaderObjects_Roundtrip(t *testing.T) {
+	headerOpts := func(explode bool) ParameterOptions {
+		return ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader, Explode: explode}
+	}
+	type baggage struct {
+		Tenant  string  `json:"tenant"`
+		Sampled bool    `json:"sampled"`
+		Depth   *int    `json:"depth,omitempty"`
+		Weight  float64 `json:"weight"`
+	}
+	depth := 3
+
+	t.Run("object", func(t *testing.T) {
+		original := baggage{Tenant: "acme", Sampled: true, Depth: &depth, Weight: 0.5}
+		for _, explode := range []bool{false, true} {
+			styled, err := StyleParameter("X-Baggage", original, headerOpts(explode))
+			require.NoError(t, err)
+
+			var result baggage
+			require.NoError(t, BindParameter("X-Baggage", styled, &result, headerOpts(explode)))
+			assert.Equal(t, original, result)
+		}
+	})
+	t.Run("whitespace", func(t *testing.T) {
+		var result baggage
+		require.NoError(t, BindParameter("X-Baggage", "tenant=acme, sampled=true", &result, headerOpts(true)))
+		assert.Equal(t, baggage{Tenant: "acme", Sampled: true}, result)
+	})
+	t.Run("string_looking_like_number", func(t *testing.T) {
+		var result baggage
+		require.NoError(t, BindParameter("X-Baggage", "tenant=42", &result, headerOpts(true)))
+		assert.Equal(t, "42", result.Tenant)
+	})
+	t.Run("invalid_boolean", func(t *testing.T) {
+		var result baggage
+		assert.Error(t, BindParameter("X-Baggage", "sampled=yes", &result, headerOpts(true)))
+	})
+	t.Run("array_of_objects", func(t *testing.T) {
+		original := []baggage{{Tenant: "acme", Sampled: true}, {Tenant: "umbrella", Depth: &depth}}
+		for _, explode := range []bool{false, true} {
+			styled, err := StyleParameter("X-Spans", original, headerOpts(explode))
+			require.NoError(t, err)
+
+			var result []baggage
+			require.NoError(t, BindParameter("X-Spans", styled, &result, headerOpts(explode)))
+			assert.Equal(t, original, result)
+		}
+		styled, err := StyleParameter("X-Spans", original, headerOpts(true))
+		require.NoError(t, err)
+		assert.Equal(t, "sampled=true,tenant=acme,weight=0;depth=3,sampled=false,tenant=umbrella,weight=0", styled)
+	})
+	t.Run("array_of_object_pointers", func(t *testing.T) {
+		var result []*baggage
+		require.NoError(t, BindParameter("X-Spans", "tenant=a;tenant=b", &result, headerOpts(true)))
+		require.Len(t, result, 2)
+		assert.Equal(t, "b", result[1].Tenant)
+	})
 }
 
 func TestBindParameter_OptionalEmpty(t *testing.T) {
@@ -206,6 +279,34 @@ func TestBindParameter_OptionalEmpty(t *testing.T) {
 // BindQueryParameter (query — url.Values)
 // ---------------------------------------------------------------------------
 
+func TestBindNullableQueryParameter_Roundtrip(t *testing.T) {
+	opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true}
+	for name, original := range map[string]types.Nullable[[]int]{
+		"unspecified": nil,
+		"null":        types.NewNullNullable[[]int](),
+		"value":       types.NewNullableWithValue([]int{1, 2}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			styled, err := StyleNullableQueryParam("ids", original, opts)
+			require.NoError(t, err)
+			vals, err := url.ParseQuery(styled)
+			require.NoError(t, err)
+
+			result := types.NewNullableWithValue([]int{9})
+			require.NoError(t, BindNullableQueryParameter("ids", vals, &result, opts))
+			assert.Equal(t, original.IsSpecified(), result.IsSpecified())
+			assert.Equal(t, original.IsNull(), result.IsNull())
+			assert.Equal(t, original.Ptr(), result.Ptr())
+		})
+	}
+
+	var result types.Nullable[int]
+	err := BindNullableQueryParameter("n", url.Values{}, &result, ParameterOptions{Style: "form", Explode: true, Required: true})
+	var reqErr *MissingRequiredParameterError
+	assert.ErrorAs(t, err, &reqErr)
+	assert.Error(t, BindNullableQueryParameter("n", url.Values{"n": {"x"}}, &result, opts))
+}
+
 func TestBindQueryParameter_Form_Roundtrip(t *testing.T) {
 	t.Run("explode_primitive", func(t *testing.T) {
 		styled, err := StyleParameter("color", "blue", ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true})
@@ -251,6 +352,42 @@ func TestBindQueryParameter_Form_Roundtrip(t *testing.T) {
 	})
 }
 
+func TestBindQueryParameter_Delimited_Roundtrip(t *testing.T) {
+	type obj struct {
+		R int `json:"R"`
+		G int `json:"G"`
+	}
+	for _, style := range []string{"spaceDelimited", "pipeDelimited"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationQuery, Required: true}
+
+			ids := []int{3, 4, 5}
+			styled, err := StyleParameter("ids", ids, opts)
+			require.NoError(t, err)
+			vals, err := url.ParseQuery(styled)
+			require.NoError(t, err)
+			var idsResult []int
+			require.NoError(t, BindQueryParameter("ids", vals, &idsResult, opts))
+			assert.Equal(t, ids, idsResult)
+
+			// Clients send the query re-encoded by url.Values, with the
+			// pipe escaped.
+			var rawResult []int
+			require.NoError(t, BindRawQueryParameter("ids", vals.Encode(), &rawResult, opts))
+			assert.Equal(t, ids, rawResult)
+
+			color := obj{R: 100, G: 200}
+			styled, err = StyleParameter("color", color, opts)
+			require.NoError(t, err)
+			vals, err = url.ParseQuery(styled)
+			require.NoError(t, err)
+			var colorResult obj
+			require.NoError(t, BindQueryParameter("color", vals, &colorResult, opts))
+			assert.Equal(t, color, colorResult)
+		})
+	}
+}
+
 func TestBindQueryParameter_OptionalMissing(t *testing.T) {
 	vals := url.Values{}
 
@@ -325,3 +462,344 @@ func TestBindQueryParameter_DeepObject_Roundtrip(t *testing.T) {
 		assert.Equal(t, original, result)
 	})
 }
+
+func TestBindDeepObjectParam(t *testing.T) {
+	type item struct {
+		ID   int    `json:"id"`
+		Note string `json:"note"`
+	}
+	type filter struct {
+		Status *string     `json:"status,omitempty"`
+		Tags   []string    `json:"tags,omitempty"`
+		Items  []item      `json:"items,omitempty"`
+		Since  *types.Date `json:"since,omitempty"`
+	}
+	status := "a&b=c"
+	original := filter{
+		Status: &status,
+		Tags:   []string{"x y", "z"},
+		Items:  []item{{ID: 1, Note: "one"}, {ID: 2}},
+		Since:  &types.Date{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	styled, err := StyleDeepObjectParam("filter", original)
+	require.NoError(t, err)
+	vals, err := url.ParseQuery(styled)
+	require.NoError(t, err)
+
+	var result *filter
+	require.NoError(t, BindDeepObjectParam("filter", vals, &result, true))
+	require.NotNil(t, result)
+	assert.Equal(t, original, *result)
+
+	labels := map[string]string{}
+	require.NoError(t, BindDeepObjectParam("labels", url.Values{"labels[app]": {"web"}}, &labels, false))
+	assert.Equal(t, map[string]string{"app": "web"}, labels)
+
+	var missing *filter
+	require.NoError(t, BindDeepObjectParam("filter", url.Values{}, &missing, false))
+	assert.Nil(t, missing)
+
+	var reqErr *MissingRequiredParameterError
+	assert.ErrorAs(t, BindDeepObjectParam("filter", url.Values{}, &missing, true), &reqErr)
+}
+
+func TestBindParameter_Base64Bytes(t *testing.T) {
+	original := types.Base64Bytes("hello?>")
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath, Format: "byte"}
+			styled, err := StyleParameter("data", original, opts)
+			require.NoError(t, err)
+
+			var result types.Base64Bytes
+			require.NoError(t, BindParameter("data", styled, &result, opts))
+			assert.Equal(t, original, result)
+		})
+	}
+	for _, explode := range []bool{false, true} {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: explode, Format: "byte"}
+		styled, err := StyleParameter("data", original, opts)
+		require.NoError(t, err)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result types.Base64Bytes
+		require.NoError(t, BindQueryParameter("data", vals, &result, opts))
+		assert.Equal(t, original, result)
+
+		var raw types.Base64Bytes
+		require.NoError(t, BindRawQueryParameter("data", styled, &raw, opts))
+		assert.Equal(t, original, raw)
+	}
+	t.Run("deepObject", func(t *testing.T) {
+		type obj struct {
+			Data types.Base64Bytes `json:"data"`
+		}
+		opts := ParameterOptions{Style: "deepObject", ParamLocation: ParamLocationQuery, Explode: true}
+		styled, err := StyleParameter("filter", obj{Data: original}, opts)
+		require.NoError(t, err)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result obj
+		require.NoError(t, BindQueryParameter("filter", vals, &result, opts))
+		assert.Equal(t, original, result.Data)
+	})
+	t.Run("url-safe unpadded", func(t *testing.T) {
+		var result types.Base64Bytes
+		opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Format: "byte"}
+		require.NoError(t, BindParameter("data", "aGVsbG8_Pg", &result, opts))
+		assert.Equal(t, original, result)
+	})
+}
+
+func TestBindParameter_URI(t *testing.T) {
+	original := types.MustParseURI("https://example.com/pets?kind=cat&limit=1")
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath, Format: "uri"}
+			styled, err := StyleParameter("link", original, opts)
+			require.NoError(t, err)
+
+			var result types.URI
+			require.NoError(t, BindParameter("link", styled, &result, opts))
+			assert.Equal(t, original.String(), result.String())
+		})
+	}
+	for _, explode := range []bool{false, true} {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: explode, Format: "uri"}
+		styled, err := StyleParameter("link", original, opts)
+		require.NoError(t, err)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result types.URI
+		require.NoError(t, BindQueryParameter("link", vals, &result, opts))
+		assert.Equal(t, original.String(), result.String())
+
+		var raw types.URI
+		require.NoError(t, BindRawQueryParameter("link", styled, &raw, opts))
+		assert.Equal(t, original.String(), raw.String())
+	}
+}
+
+func TestBindParameter_IP(t *testing.T) {
+	v4 := types.MustParseIPv4("192.0.2.1")
+	v6 := types.MustParseIPv6("2001:db8::1")
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath}
+			styled, err := StyleParameter("addr", v4, opts)
+			require.NoError(t, err)
+			var result4 types.IPv4
+			require.NoError(t, BindParameter("addr", styled, &result4, opts))
+			assert.Equal(t, v4, result4)
+
+			styled, err = StyleParameter("addr", v6, opts)
+			require.NoError(t, err)
+			var result6 types.IPv6
+			require.NoError(t, BindParameter("addr", styled, &result6, opts))
+			assert.Equal(t, v6, result6)
+		})
+	}
+	for _, explode := range []bool{false, true} {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: explode, Format: "ipv6"}
+		styled, err := StyleParameter("addr", v6, opts)
+		require.NoError(t, err)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result types.IPv6
+		require.NoError(t, BindQueryParameter("addr", vals, &result, opts))
+		assert.Equal(t, v6, result)
+
+		var raw types.IPv6
+		require.NoError(t, BindRawQueryParameter("addr", styled, &raw, opts))
+		assert.Equal(t, v6, raw)
+	}
+
+	var wrong types.IPv4
+	err := BindParameter("addr", "2001:db8::1", &wrong, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath})
+	assert.ErrorIs(t, err, types.ErrInvalidIPv4)
+}
+
+func TestBindParameter_Time(t *testing.T) {
+	tm := types.MustParseTime("14:30:00+02:00")
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath}
+			styled, err := StyleParameter("at", tm, opts)
+			require.NoError(t, err)
+			assert.Contains(t, styled, "14:30:00+02:00", "not styled as a date")
+			var result types.Time
+			require.NoError(t, BindParameter("at", styled, &result, opts))
+			assert.True(t, tm.Equal(result.Time))
+		})
+	}
+	for _, explode := range []bool{false, true} {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: explode, Format: "time"}
+		styled, err := StyleParameter("at", tm, opts)
+		require.NoError(t, err)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result types.Time
+		require.NoError(t, BindQueryParameter("at", vals, &result, opts))
+		assert.Equal(t, tm.String(), result.String())
+
+		var raw types.Time
+		require.NoError(t, BindRawQueryParameter("at", styled, &raw, opts))
+		assert.Equal(t, tm.String(), raw.String())
+	}
+
+	var date types.Date
+	require.NoError(t, BindParameter("on", "2024-02-29", &date, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath}))
+	assert.Equal(t, "2024-02-29", date.String())
+}
+
+func TestBindParameter_Duration(t *testing.T) {
+	timeout := 90 * time.Minute
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath}
+			styled, err := StyleParameter("timeout", timeout, opts)
+			require.NoError(t, err)
+			assert.Contains(t, styled, "1h30m0s", "not styled as a Go duration")
+			var result time.Duration
+			require.NoError(t, BindParameter("timeout", styled, &result, opts))
+			assert.Equal(t, timeout, result)
+		})
+	}
+
+	t.Run("iso8601", func(t *testing.T) {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Format: "duration"}
+		styled, err := StyleParameter("timeout", &timeout, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "timeout=PT1H30M", styled)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result *time.Duration
+		require.NoError(t, BindQueryParameter("timeout", vals, &result, opts))
+		require.NotNil(t, result)
+		assert.Equal(t, timeout, *result)
+	})
+
+	t.Run("array_items", func(t *testing.T) {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery}
+		vals := url.Values{"timeouts": {"500ms,PT2S"}}
+		var result []time.Duration
+		require.NoError(t, BindQueryParameter("timeouts", vals, &result, opts))
+		assert.Equal(t, []time.Duration{500 * time.Millisecond, 2 * time.Second}, result)
+	})
+
+	var result time.Duration
+	err := BindParameter("timeout", "soon", &result, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath})
+	require.ErrorContains(t, err, "neither a Go nor an ISO 8601 duration")
+}
+
+// money is a domain type with its own parameter format, "12.50EUR", which
+// reflection would style as an object.
+type money struct {
+	Cents    int64
+	Currency string
+}
+
+func (m money) MarshalParam() (string, error) {
+	return fmt.Sprintf("%d.%02d%s", m.Cents/100, m.Cents%100, m.Currency), nil
+}
+
+func (m *money) BindParam(value string) error {
+	var units, cents int64
+	if _, err := fmt.Sscanf(value, "%d.%2d%s", &units, &cents, &m.Currency); err != nil {
+		return fmt.Errorf("invalid amount %q: %w", value, err)
+	}
+	m.Cents = units*100 + cents
+	return nil
+}
+
+// codes is a slice type with its own parameter format, "a+b".
+type codes []string
+
+func (c codes) MarshalParam() (string, error) {
+	return strings.Join(c, "+"), nil
+}
+
+func (c *codes) BindParam(value string) error {
+	*c = strings.Split(value, "+")
+	return nil
+}
+
+func TestParamMarshaler_Roundtrip(t *testing.T) {
+	price := money{Cents: 1250, Currency: "EUR"}
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		t.Run(style, func(t *testing.T) {
+			opts := ParameterOptions{Style: style, ParamLocation: ParamLocationPath}
+			styled, err := StyleParameter("price", price, opts)
+			require.NoError(t, err)
+			assert.Contains(t, styled, "12.50EUR")
+
+			var result money
+			require.NoError(t, BindParameter("price", styled, &result, opts))
+			assert.Equal(t, price, result)
+		})
+	}
+
+	t.Run("query", func(t *testing.T) {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery}
+		styled, err := StyleParameter("price", price, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "price=12.50EUR", styled)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result *money
+		require.NoError(t, BindQueryParameter("price", vals, &result, opts))
+		require.NotNil(t, result)
+		assert.Equal(t, price, *result)
+
+		var raw money
+		require.NoError(t, BindRawQueryParameter("price", styled, &raw, opts))
+		assert.Equal(t, price, raw)
+	})
+
+	t.Run("array_items", func(t *testing.T) {
+		prices := []money{price, {Cents: 5, Currency: "USD"}}
+		for _, explode := range []bool{false, true} {
+			opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: explode}
+			styled, err := StyleParameter("prices", prices, opts)
+			require.NoError(t, err)
+			vals, err := url.ParseQuery(styled)
+			require.NoError(t, err)
+
+			var result []money
+			require.NoError(t, BindQueryParameter("prices", vals, &result, opts))
+			assert.Equal(t, prices, result)
+		}
+	})
+
+	t.Run("slice_type", func(t *testing.T) {
+		opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery}
+		styled, err := StyleParameter("codes", codes{"a", "b"}, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "codes=a%2Bb", styled)
+		vals, err := url.ParseQuery(styled)
+		require.NoError(t, err)
+
+		var result codes
+		require.NoError(t, BindQueryParameter("codes", vals, &result, opts))
+		assert.Equal(t, codes{"a", "b"}, result)
+	})
+
+	t.Run("bind_error", func(t *testing.T) {
+		var result money
+		err := BindParameter("price", "free", &result, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath})
+		assert.ErrorContains(t, err, `invalid amount "free"`)
+	})
+}
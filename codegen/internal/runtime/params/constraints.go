@@ -0,0 +1,115 @@
+package params
+
+//oapi-runtime:function params/ParamConstraints
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+	"unicode/utf8"
+)
+
+// ParamConstraintError is returned when the bound value of a parameter
+// violates a constraint of its schema, such as its maximum or pattern.
+type ParamConstraintError struct {
+	ParamName  string
+	Constraint string // The schema keyword violated, such as "maximum"
+	Detail     string // What the value must be, such as "at most 100"
+}
+
+func (e *ParamConstraintError) Error() string {
+	return fmt.Sprintf("parameter '%s' violates %s: must be %s", e.ParamName, e.Constraint, e.Detail)
+}
+
+// ParamConstraint is a constraint of the schema of a parameter, checked against
+// its bound value by ValidateParameter.
+type ParamConstraint[T any] struct {
+	keyword string
+	detail  string
+	check   func(T) bool
+}
+
+// ValidateParameter checks value, the bound value of parameter paramName,
+// against constraints, returning a *ParamConstraintError for the first one it
+// violates.
+func ValidateParameter[T any](paramName string, value T, constraints ...ParamConstraint[T]) error {
+	for _, c := range constraints {
+		if !c.check(value) {
+			return &ParamConstraintError{ParamName: paramName, Constraint: c.keyword, Detail: c.detail}
+		}
+	}
+	return nil
+}
+
+// paramNumber is the types ParamMinimum and ParamMaximum constrain.
+type paramNumber interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// ParamEnum constrains a value to one of values.
+func ParamEnum[T comparable](values ...T) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "enum",
+		detail:  fmt.Sprintf("one of %v", values),
+		check:   func(v T) bool { return slices.Contains(values, v) },
+	}
+}
+
+// ParamMinimum constrains a value to min or more, or to more than min when
+// exclusive.
+func ParamMinimum[T paramNumber](min T, exclusive bool) ParamConstraint[T] {
+	if exclusive {
+		return ParamConstraint[T]{keyword: "exclusiveMinimum", detail: fmt.Sprintf("greater than %v", min), check: func(v T) bool { return v > min }}
+	}
+	return ParamConstraint[T]{keyword: "minimum", detail: fmt.Sprintf("at least %v", min), check: func(v T) bool { return v >= min }}
+}
+
+// ParamMaximum constrains a value to max or less, or to less than max when
+// exclusive.
+func ParamMaximum[T paramNumber](max T, exclusive bool) ParamConstraint[T] {
+	if exclusive {
+		return ParamConstraint[T]{keyword: "exclusiveMaximum", detail: fmt.Sprintf("less than %v", max), check: func(v T) bool { return v < max }}
+	}
+	return ParamConstraint[T]{keyword: "maximum", detail: fmt.Sprintf("at most %v", max), check: func(v T) bool { return v <= max }}
+}
+
+// ParamMinLength constrains a string to n characters or more.
+func ParamMinLength[T ~string](n int) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "minLength",
+		detail:  fmt.Sprintf("at least %d characters long", n),
+		check:   func(v T) bool { return utf8.RuneCountInString(string(v)) >= n },
+	}
+}
+
+// ParamMaxLength constrains a string to n characters or fewer.
+func ParamMaxLength[T ~string](n int) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "maxLength",
+		detail:  fmt.Sprintf("at most %d characters long", n),
+		check:   func(v T) bool { return utf8.RuneCountInString(string(v)) <= n },
+	}
+}
+
+// paramPatterns caches the regular expressions of ParamPattern, compiled on
+// first use.
+var paramPatterns sync.Map
+
+// ParamPattern constrains a string to match the regular expression expr, which
+// must compile with the regexp package. It isn't anchored.
+func ParamPattern[T ~string](expr string) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "pattern",
+		detail:  fmt.Sprintf("matching %q", expr),
+		check: func(v T) bool {
+			re, ok := paramPatterns.Load(expr)
+			if !ok {
+				re, _ = paramPatterns.LoadOrStore(expr, regexp.MustCompile(expr))
+			}
+			return re.(*regexp.Regexp).MatchString(string(v))
+		},
+	}
+}
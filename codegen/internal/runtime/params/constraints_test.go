@@ -0,0 +1,59 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParameter(t *testing.T) {
+	require.NoError(t, ValidateParameter("limit", int32(10), ParamMinimum[int32](1, false), ParamMaximum[int32](10, false)))
+	require.NoError(t, ValidateParameter("code", "AB12", ParamMinLength[string](2), ParamMaxLength[string](4), ParamPattern[string]("^[A-Z]+[0-9]+$")))
+	require.NoError(t, ValidateParameter("color", primitiveColor("red"), ParamEnum[primitiveColor]("red", "blue")))
+
+	for _, tc := range []struct {
+		name       string
+		err        error
+		constraint string
+		message    string
+	}{
+		{
+			name:       "minimum",
+			err:        ValidateParameter("limit", 0, ParamMinimum(1, false)),
+			constraint: "minimum",
+			message:    "parameter 'limit' violates minimum: must be at least 1",
+		},
+		{
+			name:       "exclusive maximum",
+			err:        ValidateParameter("ratio", 1.0, ParamMaximum(1.0, true)),
+			constraint: "exclusiveMaximum",
+			message:    "parameter 'ratio' violates exclusiveMaximum: must be less than 1",
+		},
+		{
+			name:       "max length counts characters",
+			err:        ValidateParameter("name", "ééé", ParamMaxLength[string](2)),
+			constraint: "maxLength",
+			message:    "parameter 'name' violates maxLength: must be at most 2 characters long",
+		},
+		{
+			name:       "pattern",
+			err:        ValidateParameter("code", "ab12", ParamMinLength[string](2), ParamPattern[string]("^[A-Z]+[0-9]+$")),
+			constraint: "pattern",
+			message:    `parameter 'code' violates pattern: must be matching "^[A-Z]+[0-9]+$"`,
+		},
+		{
+			name:       "enum",
+			err:        ValidateParameter("color", primitiveColor("green"), ParamEnum[primitiveColor]("red", "blue")),
+			constraint: "enum",
+			message:    "parameter 'color' violates enum: must be one of [red blue]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var violation *ParamConstraintError
+			require.ErrorAs(t, tc.err, &violation)
+			assert.Equal(t, tc.constraint, violation.Constraint)
+			assert.Equal(t, tc.message, tc.err.Error())
+		})
+	}
+}
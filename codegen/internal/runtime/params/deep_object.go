@@ -0,0 +1,23 @@
+package params
+
+//oapi-runtime:function params/MarshalDeepObject
+//oapi-runtime:standalone
+
+import "net/url"
+
+// MarshalDeepObject serializes i as the deepObject query parameter paramName.
+//
+// Deprecated: Use StyleDeepObjectParam, or MarshalDeepObject of the helpers
+// package.
+func MarshalDeepObject(i any, paramName string) (string, error) {
+	return StyleDeepObjectParam(paramName, i)
+}
+
+// UnmarshalDeepObject binds the deepObject query parameter paramName from
+// params to dst.
+//
+// Deprecated: Use BindDeepObjectParam, or UnmarshalDeepObject of the helpers
+// package.
+func UnmarshalDeepObject(dst any, paramName string, params url.Values) error {
+	return BindDeepObjectParam(paramName, params, dst, false)
+}
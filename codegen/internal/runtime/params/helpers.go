@@ -15,7 +15,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/types"
 )
 
@@ -35,6 +34,23 @@ type Binder interface {
 	Bind(value string) error
 }
 
+// ParamMarshaler is implemented by types which choose their own representation
+// in parameters, such as domain types set with x-go-type. MarshalParam returns
+// the value of a primitive parameter, or of an item of an array or a property
+// of an object; it's styled and escaped like any other string. It takes
+// precedence over encoding.TextMarshaler and reflection.
+type ParamMarshaler interface {
+	MarshalParam() (string, error)
+}
+
+// ParamBinder is the counterpart of ParamMarshaler: BindParam sets the value
+// from the string MarshalParam returned, unescaped and with the style's
+// prefixes and delimiters removed. It takes precedence over Binder,
+// encoding.TextUnmarshaler and reflection.
+type ParamBinder interface {
+	BindParam(value string) error
+}
+
 // MissingRequiredParameterError is returned when a required parameter is not
 // present in the request. Upper layers can use errors.As to detect this and
 // produce an appropriate HTTP error response.
@@ -47,10 +63,33 @@ func (e *MissingRequiredParameterError) Error() string {
 }
 
 // primitiveToString converts a primitive value to a string representation.
-// It handles basic Go types, time.Time, types.Date, and types that implement
-// json.Marshaler or fmt.Stringer.
+// It handles basic Go types, time.Time, types.Date, time.Duration, formatted
+// as a Go duration string such as "1h30m0s", and types that implement
+// ParamMarshaler, json.Marshaler or fmt.Stringer.
 func primitiveToString(value any) (string, error) {
-	// Check for known types first (time, date, uuid)
+	// The common builtin types are formatted without reflection.
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return FormatInt(v), nil
+	case int32:
+		return FormatInt(v), nil
+	case int64:
+		return FormatInt(v), nil
+	case float32:
+		return FormatFloat32(v), nil
+	case float64:
+		return FormatFloat64(v), nil
+	case bool:
+		return FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	case ParamMarshaler:
+		return v.MarshalParam()
+	}
+
+	// Check for known types first (time, date, text marshalers)
 	if res, ok := marshalKnownTypes(value); ok {
 		return res, nil
 	}
@@ -77,10 +116,6 @@ func primitiveToString(value any) (string, error) {
 	case reflect.String:
 		return v.String(), nil
 	case reflect.Struct:
-		// Check if it's a UUID
-		if u, ok := value.(uuid.UUID); ok {
-			return u.String(), nil
-		}
 		// Check if it implements json.Marshaler
 		if m, ok := value.(json.Marshaler); ok {
 			buf, err := m.MarshalJSON()
@@ -104,7 +139,8 @@ func primitiveToString(value any) (string, error) {
 	}
 }
 
-// marshalKnownTypes checks for special types (time.Time, Date, UUID) and marshals them.
+// marshalKnownTypes checks for special types (time.Time, Date, and text
+// marshalers such as Duration or UUID) and marshals them.
 func marshalKnownTypes(value any) (string, bool) {
 	v := reflect.Indirect(reflect.ValueOf(value))
 	t := v.Type()
@@ -115,16 +151,19 @@ func marshalKnownTypes(value any) (string, bool) {
 		return timeVal.Format(time.RFC3339Nano), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+	if isDate(t) {
 		d := v.Convert(reflect.TypeOf(types.Date{}))
 		dateVal := d.Interface().(types.Date)
 		return dateVal.Format(types.DateFormat), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(uuid.UUID{})) {
-		u := v.Convert(reflect.TypeOf(uuid.UUID{}))
-		uuidVal := u.Interface().(uuid.UUID)
-		return uuidVal.String(), true
+	// Checked last, as the kind of these types, such as the int64 of a
+	// Duration, would otherwise format them as a plain number. UUIDs, whatever
+	// their library, are formatted this way too, so none is imported here.
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text), true
+		}
 	}
 
 	return "", false
@@ -205,9 +244,54 @@ func sortedKeys(m map[string]string) []string {
 	return keys
 }
 
+// parseDuration parses a time.Duration from a Go duration string, such as
+// "1h30m", or an ISO 8601 duration, such as "PT1H30M".
+func parseDuration(src string) (time.Duration, error) {
+	if d, err := time.ParseDuration(src); err == nil {
+		return d, nil
+	}
+	d, err := types.ParseDuration(src)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %q is neither a Go nor an ISO 8601 duration", src)
+	}
+	return d.TimeDuration(), nil
+}
+
 // BindStringToObject binds a string value to a destination object.
-// It handles primitives, encoding.TextUnmarshaler, and the Binder interface.
+// It handles primitives, encoding.TextUnmarshaler, and the ParamBinder and
+// Binder interfaces.
 func BindStringToObject(src string, dst any) error {
+	// The common builtin types are parsed without reflection.
+	var err error
+	switch d := dst.(type) {
+	case *string:
+		*d = src
+		return nil
+	case *int:
+		*d, err = ParseInt[int](src)
+		return err
+	case *int32:
+		*d, err = ParseInt[int32](src)
+		return err
+	case *int64:
+		*d, err = ParseInt[int64](src)
+		return err
+	case *float32:
+		*d, err = ParseFloat[float32](src)
+		return err
+	case *float64:
+		*d, err = ParseFloat[float64](src)
+		return err
+	case *bool:
+		*d, err = ParseBool[bool](src)
+		return err
+	case *time.Duration:
+		*d, err = parseDuration(src)
+		return err
+	case ParamBinder:
+		return d.BindParam(src)
+	}
+
 	// Check for TextUnmarshaler
 	if tu, ok := dst.(encoding.TextUnmarshaler); ok {
 		return tu.UnmarshalText([]byte(src))
@@ -275,32 +359,101 @@ func bindSplitPartsToDestinationArray(parts []string, dest any) error {
 }
 
 // bindSplitPartsToDestinationStruct binds string parts to a destination struct via JSON.
+// Properties are typed after the fields of dest, so that numbers and booleans
+// bind to numeric and boolean fields rather than failing as strings.
 func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode bool, dest any) error {
-	var fields []string
+	var keys, values []string
 	if explode {
-		fields = make([]string, len(parts))
-		for i, property := range parts {
-			propertyParts := strings.Split(property, "=")
-			if len(propertyParts) != 2 {
+		for _, property := range parts {
+			key, value, found := strings.Cut(property, "=")
+			if !found {
 				return fmt.Errorf("parameter '%s' has invalid exploded format", paramName)
 			}
-			fields[i] = "\"" + propertyParts[0] + "\":\"" + propertyParts[1] + "\""
+			keys = append(keys, key)
+			values = append(values, value)
 		}
 	} else {
 		if len(parts)%2 != 0 {
 			return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
 		}
-		fields = make([]string, len(parts)/2)
 		for i := 0; i < len(parts); i += 2 {
-			key := parts[i]
-			value := parts[i+1]
-			fields[i/2] = "\"" + key + "\":\"" + value + "\""
+			keys = append(keys, parts[i])
+			values = append(values, parts[i+1])
+		}
+	}
+
+	t := reflect.TypeOf(dest)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]string, len(keys))
+	for i, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
 		}
+		value, err := propertyJSON(values[i], propertyKind(t, key))
+		if err != nil {
+			return err
+		}
+		fields[i] = string(name) + ":" + value
 	}
 	jsonParam := "{" + strings.Join(fields, ",") + "}"
 	return json.Unmarshal([]byte(jsonParam), dest)
 }
 
+// propertyKind returns the kind of the property named key of objects of type
+// t: of the field whose JSON name is key in a struct, or of the values of a
+// map, looking through pointers. It returns reflect.Invalid for unknown
+// properties.
+func propertyKind(t reflect.Type, key string) reflect.Kind {
+	var pt reflect.Type
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() && getFieldName(f) == key {
+				pt = f.Type
+				break
+			}
+		}
+	case reflect.Map:
+		pt = t.Elem()
+	}
+	if pt == nil {
+		return reflect.Invalid
+	}
+	for pt.Kind() == reflect.Ptr {
+		pt = pt.Elem()
+	}
+	return pt.Kind()
+}
+
+// propertyJSON returns value as a JSON value for a property of kind k: a bare
+// number or boolean when k is numeric or boolean and value parses as one, and
+// a string otherwise.
+func propertyJSON(value string, k reflect.Kind) (string, error) {
+	var err error
+	switch k {
+	case reflect.Bool:
+		if value != "true" && value != "false" {
+			err = strconv.ErrSyntax
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		err = strconv.ErrSyntax
+	}
+	if err == nil && json.Valid([]byte(value)) {
+		return value, nil
+	}
+	buf, err := json.Marshal(value)
+	return string(buf), err
+}
+
 // splitStyledParameter splits a styled parameter string value into parts based
 // on the OpenAPI style. The object flag indicates whether the destination is a
 // struct/map (affects matrix explode handling).
@@ -367,6 +520,44 @@ func splitStyledParameter(style string, explode bool, object bool, paramName str
 	return nil, fmt.Errorf("unhandled parameter style: %s", style)
 }
 
+// isObjectType reports whether values of type t, looking through pointers,
+// are styled as objects: structs and maps, except for times, dates and types
+// which marshal themselves as parameters or text.
+func isObjectType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+		return false
+	}
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) || isDate(t) {
+		return false
+	}
+	for _, i := range []reflect.Type{
+		reflect.TypeOf((*ParamMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*ParamBinder)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// trimHeaderParts trims the optional whitespace HTTP allows around list items,
+// as in "tenant=acme, sampled=true", from the parts of a header parameter.
+func trimHeaderParts(parts []string, paramLocation ParamLocation) []string {
+	if paramLocation != ParamLocationHeader {
+		return parts
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
 // findRawQueryParam extracts values for a named parameter from a raw
 // (undecoded) query string. The parameter key is decoded for comparison
 // purposes, but the returned values remain in their original encoded form.
@@ -402,6 +593,17 @@ func findRawQueryParam(rawQuery, paramName string) (values []string, found bool)
 	return values, found
 }
 
+// isDate reports whether t is types.Date, or a type defined from it. Other
+// types with the same underlying type which parse themselves, such as
+// types.Time, aren't dates.
+func isDate(t reflect.Type) bool {
+	dateType := reflect.TypeOf(types.Date{})
+	if t == dateType {
+		return true
+	}
+	return t.ConvertibleTo(dateType) && !reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
 // isByteSlice reports whether t is []byte (or equivalently []uint8).
 func isByteSlice(t reflect.Type) bool {
 	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
@@ -0,0 +1,120 @@
+package params
+
+//oapi-runtime:function params/MapParams
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// StyleMapQueryParameter serializes value as the query parameter paramName in
+// the form, spaceDelimited or pipeDelimited style, its entries sorted by key.
+// Exploded, each entry is a query key of its own, "app=web&tier=eu"; otherwise
+// keys and values alternate in a single one, "labels=app,web,tier,eu".
+func StyleMapQueryParameter[M ~map[string]T, T any](paramName string, value M, opts ParameterOptions, format func(T) string) (string, error) {
+	separator := ","
+	switch opts.Style {
+	case "", "form":
+	case "spaceDelimited":
+		separator = "%20"
+	case "pipeDelimited":
+		separator = "|"
+	default:
+		return "", fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	keys := make([]string, 0, len(value))
+	for k := range value {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		name := escapeParameterName(k, ParamLocationQuery)
+		v := escapeParameterString(format(value[k]), ParamLocationQuery, opts.AllowReserved)
+		if opts.Explode {
+			parts = append(parts, name+"="+v)
+		} else {
+			parts = append(parts, name+separator+v)
+		}
+	}
+	if opts.Explode {
+		return strings.Join(parts, "&"), nil
+	}
+	return escapeParameterName(paramName, ParamLocationQuery) + "=" + strings.Join(parts, separator), nil
+}
+
+// BindMapQueryParameter binds the query parameter paramName to dest, the field
+// of the parameter, a pointer when it's optional, parsing its values with
+// parse. Exploded, its entries are all the query keys but those of the other
+// parameters of the operation, declared, and keys indexing them, such as
+// "filter[status]" for "filter". A parameter without entries is absent.
+func BindMapQueryParameter[M ~map[string]T, T any, D *M | **M](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error), declared ...string) error {
+	separator := ","
+	switch opts.Style {
+	case "", "form":
+	case "spaceDelimited":
+		separator = " "
+	case "pipeDelimited":
+		separator = "|"
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	var keys, values []string
+	if opts.Explode {
+		for k, v := range queryParams {
+			if name, _, _ := strings.Cut(k, "["); slices.Contains(declared, name) {
+				continue
+			}
+			if len(v) != 1 {
+				return fmt.Errorf("entry '%s' of parameter '%s' is specified multiple times", k, paramName)
+			}
+			keys, values = append(keys, k), append(values, v[0])
+		}
+	} else if v, found := queryParams[paramName]; found {
+		if len(v) != 1 {
+			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+		}
+		if v[0] != "" {
+			parts := strings.Split(v[0], separator)
+			if len(parts)%2 != 0 {
+				return fmt.Errorf("parameter '%s' has a key without a value", paramName)
+			}
+			for i := 0; i < len(parts); i += 2 {
+				keys, values = append(keys, parts[i]), append(values, parts[i+1])
+			}
+		}
+	}
+	if len(keys) == 0 {
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	m := make(M, len(keys))
+	for i, k := range keys {
+		v, err := parse(values[i])
+		if err != nil {
+			return fmt.Errorf("error binding entry '%s' of parameter '%s': %w", k, paramName, err)
+		}
+		m[k] = v
+	}
+	switch d := any(dest).(type) {
+	case *M:
+		*d = m
+	case **M:
+		*d = &m
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package params
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapQueryParameter_Roundtrip(t *testing.T) {
+	labels := map[string]string{"tier": "eu west", "app": "web"}
+
+	tests := []struct {
+		name   string
+		opts   ParameterOptions
+		styled string
+	}{
+		{"form exploded", ParameterOptions{Style: "form", Explode: true}, "app=web&tier=eu+west"},
+		{"form", ParameterOptions{Style: "form"}, "labels=app,web,tier,eu+west"},
+		{"pipeDelimited", ParameterOptions{Style: "pipeDelimited"}, "labels=app|web|tier|eu+west"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			styled, err := StyleMapQueryParameter("labels", labels, tt.opts, FormatString[string])
+			require.NoError(t, err)
+			assert.Equal(t, tt.styled, styled)
+
+			query, err := url.ParseQuery(styled)
+			require.NoError(t, err)
+			var bound map[string]string
+			require.NoError(t, BindMapQueryParameter[map[string]string]("labels", query, &bound, tt.opts, ParseString[string]))
+			assert.Equal(t, labels, bound)
+		})
+	}
+}
+
+func TestBindMapQueryParameter(t *testing.T) {
+	t.Run("skips declared parameters", func(t *testing.T) {
+		query := url.Values{"limit": {"10"}, "filter[status]": {"open"}, "replicas": {"3"}}
+		opts := ParameterOptions{Style: "form", Explode: true}
+		var bound *map[string]int32
+		require.NoError(t, BindMapQueryParameter[map[string]int32]("counts", query, &bound, opts, ParseInt[int32], "limit", "filter"))
+		require.NotNil(t, bound)
+		assert.Equal(t, map[string]int32{"replicas": 3}, *bound)
+	})
+
+	t.Run("absent optional", func(t *testing.T) {
+		var bound *map[string]string
+		require.NoError(t, BindMapQueryParameter[map[string]string]("labels", url.Values{}, &bound, ParameterOptions{Style: "form"}, ParseString[string]))
+		assert.Nil(t, bound)
+	})
+
+	t.Run("absent required", func(t *testing.T) {
+		var bound map[string]string
+		err := BindMapQueryParameter[map[string]string]("labels", url.Values{"limit": {"1"}}, &bound,
+			ParameterOptions{Style: "form", Explode: true, Required: true}, ParseString[string], "limit")
+		var missing *MissingRequiredParameterError
+		require.ErrorAs(t, err, &missing)
+	})
+
+	t.Run("key without a value", func(t *testing.T) {
+		var bound map[string]string
+		err := BindMapQueryParameter[map[string]string]("labels", url.Values{"labels": {"app,web,tier"}}, &bound,
+			ParameterOptions{Style: "form"}, ParseString[string])
+		assert.ErrorContains(t, err, "key without a value")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		var bound map[string]int32
+		err := BindMapQueryParameter[map[string]int32]("counts", url.Values{"replicas": {"many"}}, &bound,
+			ParameterOptions{Style: "form", Explode: true}, ParseInt[int32])
+		assert.ErrorContains(t, err, "entry 'replicas' of parameter 'counts'")
+	})
+}
@@ -0,0 +1,175 @@
+package params
+
+//oapi-runtime:function params/PrimitiveParams
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseString parses a parameter value of a string type.
+func ParseString[T ~string](src string) (T, error) {
+	return T(src), nil
+}
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseUint parses a parameter value of an unsigned integer type, failing when
+// it doesn't fit the type.
+func ParseUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](src string) (T, error) {
+	u, err := strconv.ParseUint(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint: %w", err)
+	}
+	if v := T(u); uint64(v) == u {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse uint: %w", &strconv.NumError{Func: "ParseUint", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseFloat parses a parameter value of a floating-point type.
+func ParseFloat[T ~float32 | ~float64](src string) (T, error) {
+	f, err := strconv.ParseFloat(src, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float: %w", err)
+	}
+	return T(f), nil
+}
+
+// ParseBool parses a parameter value of a boolean type.
+func ParseBool[T ~bool](src string) (T, error) {
+	b, err := strconv.ParseBool(src)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse bool: %w", err)
+	}
+	return T(b), nil
+}
+
+// FormatString formats a parameter value of a string type.
+func FormatString[T ~string](v T) string {
+	return string(v)
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// FormatUint formats a parameter value of an unsigned integer type.
+func FormatUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// FormatFloat32 formats a parameter value of a float32 type, with the fewest
+// digits which parse back to it.
+func FormatFloat32[T ~float32](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// FormatFloat64 formats a parameter value of a float64 type, with the fewest
+// digits which parse back to it.
+func FormatFloat64[T ~float64](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 64)
+}
+
+// FormatBool formats a parameter value of a boolean type.
+func FormatBool[T ~bool](v T) string {
+	return strconv.FormatBool(bool(v))
+}
+
+// StylePrimitiveParameter serializes a parameter of a primitive type like
+// StyleParameter, formatting value with format, such as FormatInt[int32],
+// rather than by reflection.
+func StylePrimitiveParameter[T any](paramName string, value T, opts ParameterOptions, format func(T) string) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+	return styleString(style, paramName, opts.ParamLocation, opts.AllowReserved, format(value))
+}
+
+// BindPrimitiveParameter binds a path, header or cookie parameter of a
+// primitive type like BindParameter, parsing value with parse, such as
+// ParseInt[int32], rather than by reflection.
+func BindPrimitiveParameter[T any](paramName string, value string, dest *T, opts ParameterOptions, parse func(string) (T, error)) error {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+
+	if value == "" {
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	value, err := unescapeParameterString(value, opts.ParamLocation)
+	if err != nil {
+		return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
+	}
+	value, err = primitiveValue(style, opts.Explode, paramName, value)
+	if err != nil {
+		return err
+	}
+	v, err := parse(value)
+	if err != nil {
+		return err
+	}
+	*dest = v
+	return nil
+}
+
+// BindPrimitiveQueryParameter binds a query parameter of a primitive type like
+// BindQueryParameter, parsing its value with parse, such as ParseInt[int32],
+// rather than by reflection. dest is the field of the parameter, a pointer
+// when the parameter is optional.
+func BindPrimitiveQueryParameter[T any, D *T | **T](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error)) error {
+	switch opts.Style {
+	case "", "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	values := queryParams[paramName]
+	switch {
+	case len(values) == 0:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	case len(values) != 1 && opts.Explode:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	case len(values) != 1:
+		return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+
+	v, err := parse(values[0])
+	if err != nil {
+		return err
+	}
+	switch d := any(dest).(type) {
+	case *T:
+		*d = v
+	case **T:
+		*d = &v
+	}
+	return nil
+}
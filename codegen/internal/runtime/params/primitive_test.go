@@ -0,0 +1,113 @@
+package params
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type primitiveColor string
+
+func TestPrimitiveParameter_MatchesReflection(t *testing.T) {
+	for _, opts := range []ParameterOptions{
+		{Style: "simple", ParamLocation: ParamLocationPath},
+		{Style: "label", ParamLocation: ParamLocationPath, Explode: true},
+		{Style: "matrix", ParamLocation: ParamLocationPath},
+		{Style: "simple", ParamLocation: ParamLocationHeader},
+		{Style: "form", ParamLocation: ParamLocationCookie},
+	} {
+		t.Run(opts.Style+"/"+strconv.Itoa(int(opts.ParamLocation)), func(t *testing.T) {
+			want, err := StyleParameter("id", int32(-7), opts)
+			require.NoError(t, err)
+			got, err := StylePrimitiveParameter("id", int32(-7), opts, FormatInt[int32])
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+
+			var bound int32
+			require.NoError(t, BindPrimitiveParameter("id", got, &bound, opts, ParseInt[int32]))
+			assert.Equal(t, int32(-7), bound)
+		})
+	}
+
+	t.Run("named string", func(t *testing.T) {
+		opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath}
+		styled, err := StylePrimitiveParameter("color", primitiveColor("dark red"), opts, FormatString[primitiveColor])
+		require.NoError(t, err)
+		assert.Equal(t, "dark%20red", styled)
+
+		var color primitiveColor
+		require.NoError(t, BindPrimitiveParameter("color", styled, &color, opts, ParseString[primitiveColor]))
+		assert.Equal(t, primitiveColor("dark red"), color)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader}
+		styled, err := StylePrimitiveParameter("ratio", float32(0.1), opts, FormatFloat32[float32])
+		require.NoError(t, err)
+		assert.Equal(t, "0.1", styled)
+	})
+}
+
+func TestBindPrimitiveParameter_Errors(t *testing.T) {
+	opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Required: true}
+
+	var small int8
+	err := BindPrimitiveParameter("n", "300", &small, opts, ParseInt[int8])
+	require.ErrorIs(t, err, strconv.ErrRange)
+
+	var u uint16
+	require.Error(t, BindPrimitiveParameter("n", "-1", &u, opts, ParseUint[uint16]))
+
+	var b bool
+	require.Error(t, BindPrimitiveParameter("flag", "yes", &b, opts, ParseBool[bool]))
+
+	var missing int
+	var required *MissingRequiredParameterError
+	require.ErrorAs(t, BindPrimitiveParameter("n", "", &missing, opts, ParseInt[int]), &required)
+
+	opts.Style = "label"
+	require.Error(t, BindPrimitiveParameter("n", "5", &missing, opts, ParseInt[int]))
+}
+
+func TestBindPrimitiveQueryParameter(t *testing.T) {
+	opts := ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true}
+	query := url.Values{"limit": {"25"}, "twice": {"1", "2"}}
+
+	var limit int64
+	require.NoError(t, BindPrimitiveQueryParameter("limit", query, &limit, opts, ParseInt[int64]))
+	assert.Equal(t, int64(25), limit)
+
+	var optional *int64
+	require.NoError(t, BindPrimitiveQueryParameter("offset", query, &optional, opts, ParseInt[int64]))
+	assert.Nil(t, optional)
+	require.NoError(t, BindPrimitiveQueryParameter("limit", query, &optional, opts, ParseInt[int64]))
+	require.NotNil(t, optional)
+	assert.Equal(t, int64(25), *optional)
+
+	assert.Error(t, BindPrimitiveQueryParameter("twice", query, &limit, opts, ParseInt[int64]))
+
+	opts.Required = true
+	var required *MissingRequiredParameterError
+	require.ErrorAs(t, BindPrimitiveQueryParameter("offset", query, &limit, opts, ParseInt[int64]), &required)
+
+	opts.Style = "simple"
+	assert.Error(t, BindPrimitiveQueryParameter("limit", query, &limit, opts, ParseInt[int64]))
+}
+
+func BenchmarkBindParameter(b *testing.B) {
+	opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath}
+	var dest int32
+	b.Run("reflection", func(b *testing.B) {
+		for b.Loop() {
+			_ = BindParameter("id", "12345", &dest, opts)
+		}
+	})
+	b.Run("primitive", func(b *testing.B) {
+		for b.Loop() {
+			_ = BindPrimitiveParameter("id", "12345", &dest, opts, ParseInt[int32])
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
@@ -40,11 +41,26 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		t = v.Type()
 	}
 
+	// A time.Duration is sent as a Go duration string, such as "1h30m0s",
+	// unless the schema asks for format: duration, which is ISO 8601.
+	if d, ok := v.Interface().(time.Duration); ok && opts.Format == "duration" {
+		value = types.Duration(d)
+	}
+
+	// Types which choose their own representation are styled as primitives.
+	if m, ok := value.(ParamMarshaler); ok {
+		str, err := m.MarshalParam()
+		if err != nil {
+			return "", fmt.Errorf("error marshaling parameter '%s': %w", paramName, err)
+		}
+		return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, str)
+	}
+
 	// If the value implements encoding.TextMarshaler, use it — but not for
 	// time.Time or types.Date which have their own formatting logic.
 	if tu, ok := value.(encoding.TextMarshaler); ok {
 		it := reflect.Indirect(reflect.ValueOf(value)).Type()
-		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !it.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !isDate(it) {
 			b, err := tu.MarshalText()
 			if err != nil {
 				return "", fmt.Errorf("error marshaling '%s' as text: %w", value, err)
@@ -64,6 +80,9 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		for i := 0; i < n; i++ {
 			sliceVal[i] = v.Index(i).Interface()
 		}
+		if style == "simple" && isObjectType(t.Elem()) {
+			return styleObjectSlice(paramName, sliceVal, opts)
+		}
 		return styleSlice(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, sliceVal)
 	case reflect.Struct:
 		return styleStruct(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
@@ -74,6 +93,33 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 	}
 }
 
+// StyleNullableQueryParam serializes an optional query parameter which allows
+// empty values: to nothing when value is unspecified, to an empty value, such
+// as "flag=", when it's null, and with StyleParameter otherwise.
+func StyleNullableQueryParam[T any](paramName string, value types.Nullable[T], opts ParameterOptions) (string, error) {
+	if !value.IsSpecified() {
+		return "", nil
+	}
+	if value.IsNull() {
+		return escapeParameterName(paramName, ParamLocationQuery) + "=", nil
+	}
+	return StyleParameter(paramName, value.MustGet(), opts)
+}
+
+// StyleCookieParam serializes value as the value of a cookie parameter, in
+// the style of opts, which is form for cookies. The "name=" the form style
+// starts with is left out, as the cookie is named after the parameter already:
+// a primitive is sent as "5", an array as "3,4,5", and an exploded array as
+// "3&id=4&id=5", so that the cookie header reads id=3&id=4&id=5.
+func StyleCookieParam(paramName string, value any, opts ParameterOptions) (string, error) {
+	opts.ParamLocation = ParamLocationCookie
+	styled, err := StyleParameter(paramName, value, opts)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(styled, paramName+"="), nil
+}
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -83,7 +129,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(values, paramName)
+		return StyleDeepObjectParam(paramName, values)
 	}
 
 	var prefix string
@@ -120,7 +166,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if explode {
 			separator = "&" + prefix
 		} else {
-			separator = " "
+			separator = "%20"
 		}
 	case "pipeDelimited":
 		prefix = fmt.Sprintf("%s=", escapedName)
@@ -144,6 +190,21 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 	return prefix + strings.Join(parts, separator), nil
 }
 
+// styleObjectSlice serializes an array of objects in the simple style, which
+// OpenAPI leaves undefined: each object is styled on its own, as "id,1,name,a"
+// or exploded as "id=1,name=a", and the objects are separated by semicolons.
+func styleObjectSlice(paramName string, values []any, opts ParameterOptions) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		part, err := StyleParameter(paramName, v, opts)
+		if err != nil {
+			return "", fmt.Errorf("error formatting item %d of '%s': %w", i, paramName, err)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ";"), nil
+}
+
 func styleStruct(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
 	if timeVal, ok := marshalKnownTypes(value); ok {
 		return stylePrimitive(style, explode, paramName, paramLocation, allowReserved, timeVal)
@@ -153,7 +214,7 @@ func styleStruct(style string, explode bool, paramName string, paramLocation Par
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 
 	// If input implements json.Marshaler (e.g. objects with additional properties
@@ -214,7 +275,7 @@ func styleMap(style string, explode bool, paramName string, paramLocation ParamL
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 	v := reflect.ValueOf(value)
 
@@ -277,6 +338,17 @@ func processFieldDict(style string, explode bool, paramName string, paramLocatio
 			prefix = fmt.Sprintf("%s=", escapedName)
 			separator = ","
 		}
+	case "spaceDelimited", "pipeDelimited":
+		// Exploded, these are the form style: one key per property.
+		if explode {
+			separator = "&"
+		} else {
+			prefix = fmt.Sprintf("%s=", escapedName)
+			separator = "%20"
+			if style == "pipeDelimited" {
+				separator = "|"
+			}
+		}
 	case "deepObject":
 		if !explode {
 			return "", fmt.Errorf("deepObject parameters must be exploded")
@@ -299,7 +371,11 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 	if err != nil {
 		return "", err
 	}
+	return styleString(style, paramName, paramLocation, allowReserved, strVal)
+}
 
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
 	escapedName := escapeParameterName(paramName, paramLocation)
 
 	var prefix string
@@ -309,7 +385,9 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 		prefix = "."
 	case "matrix":
 		prefix = fmt.Sprintf(";%s=", escapedName)
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
 		prefix = fmt.Sprintf("%s=", escapedName)
 	default:
 		return "", fmt.Errorf("unsupported style '%s'", style)
@@ -321,40 +399,36 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 // Deep object marshaling
 // ---------------------------------------------------------------------------
 
-// MarshalDeepObject marshals an object to deepObject style query parameters.
-func MarshalDeepObject(i any, paramName string) (string, error) {
-	buf, err := json.Marshal(i)
+// StyleDeepObjectParam serializes value as an exploded deepObject query
+// parameter, such as "filter[status]=open&filter[created][gte]=2024-01-01".
+// value is marshaled to JSON first, so struct fields are named by their json
+// tags: objects nest in brackets, array items are indexed from 0, and null
+// members are left out. Names and values are query escaped, and numbers keep
+// their JSON form.
+func StyleDeepObjectParam(paramName string, value any) (string, error) {
+	buf, err := json.Marshal(value)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
 	}
-	var i2 any
-	err = json.Unmarshal(buf, &i2)
-	if err != nil {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
 		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
-	fields, err := marshalDeepObjectRecursive(i2, nil)
-	if err != nil {
-		return "", fmt.Errorf("error traversing JSON structure: %w", err)
-	}
-
-	for idx := range fields {
-		fields[idx] = paramName + fields[idx]
-	}
+	var fields []string
+	styleDeepObjectRecursive(url.QueryEscape(paramName), v, &fields)
 	return strings.Join(fields, "&"), nil
 }
 
-func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
-	var result []string
-
-	switch t := in.(type) {
+// styleDeepObjectRecursive appends the key=value pairs of v, whose escaped
+// key is key, to fields.
+func styleDeepObjectRecursive(key string, v any, fields *[]string) {
+	switch t := v.(type) {
+	case nil:
 	case []any:
-		for i, iface := range t {
-			newPath := append(path, strconv.Itoa(i))
-			fields, err := marshalDeepObjectRecursive(iface, newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing array: %w", err)
-			}
-			result = append(result, fields...)
+		for i, item := range t {
+			styleDeepObjectRecursive(key+"["+strconv.Itoa(i)+"]", item, fields)
 		}
 	case map[string]any:
 		keys := make([]string, 0, len(t))
@@ -362,20 +436,10 @@ func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
-
 		for _, k := range keys {
-			newPath := append(path, k)
-			fields, err := marshalDeepObjectRecursive(t[k], newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing map: %w", err)
-			}
-			result = append(result, fields...)
+			styleDeepObjectRecursive(key+"["+url.QueryEscape(k)+"]", t[k], fields)
 		}
 	default:
-		prefix := "[" + strings.Join(path, "][") + "]"
-		result = []string{
-			prefix + fmt.Sprintf("=%v", t),
-		}
+		*fields = append(*fields, key+"="+url.QueryEscape(fmt.Sprint(t)))
 	}
-	return result, nil
 }
@@ -117,6 +117,38 @@ func TestStyleParameter_Form(t *testing.T) {
 	})
 }
 
+func TestStyleParameter_Delimited(t *testing.T) {
+	type obj struct {
+		R int `json:"R"`
+		G int `json:"G"`
+	}
+	for _, tc := range []struct {
+		style   string
+		explode bool
+		value   any
+		want    string
+	}{
+		{style: "spaceDelimited", value: []string{"blue", "black", "brown"}, want: "color=blue%20black%20brown"},
+		{style: "pipeDelimited", value: []string{"blue", "black", "brown"}, want: "color=blue|black|brown"},
+		{style: "spaceDelimited", explode: true, value: []string{"blue", "black"}, want: "color=blue&color=black"},
+		{style: "spaceDelimited", value: obj{R: 100, G: 200}, want: "color=G%20200%20R%20100"},
+		{style: "pipeDelimited", value: obj{R: 100, G: 200}, want: "color=G|200|R|100"},
+		{style: "pipeDelimited", explode: true, value: obj{R: 100, G: 200}, want: "G=200&R=100"},
+		{style: "pipeDelimited", value: map[string]int{"R": 100}, want: "color=R|100"},
+		{style: "spaceDelimited", value: "blue", want: "color=blue"},
+	} {
+		t.Run(tc.style, func(t *testing.T) {
+			result, err := StyleParameter("color", tc.value, ParameterOptions{Style: tc.style, ParamLocation: ParamLocationQuery, Explode: tc.explode})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result)
+		})
+	}
+
+	result, err := StylePrimitiveParameter("limit", 5, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery}, FormatInt[int])
+	require.NoError(t, err)
+	assert.Equal(t, "limit=5", result)
+}
+
 func TestStyleParameter_Label(t *testing.T) {
 	opts := func(extra ...func(*ParameterOptions)) ParameterOptions {
 		o := ParameterOptions{Style: "label", ParamLocation: ParamLocationPath}
@@ -208,3 +240,56 @@ func TestStyleParameter_DeepObject(t *testing.T) {
 		assert.Equal(t, "filter[tags][0]=a&filter[tags][1]=b", result)
 	})
 }
+
+func TestStyleCookieParam(t *testing.T) {
+	opts := func(explode bool) ParameterOptions {
+		return ParameterOptions{Style: "form", Explode: explode}
+	}
+	type obj struct {
+		Role string `json:"role"`
+	}
+	for _, tc := range []struct {
+		name    string
+		value   any
+		explode bool
+		want    string
+	}{
+		{"primitive", "abc/def==", true, "abc/def=="},
+		{"array", []int{3, 4, 5}, false, "3,4,5"},
+		{"exploded_array", []int{3, 4, 5}, true, "3&id=4&id=5"},
+		{"object", obj{Role: "admin"}, false, "role,admin"},
+		{"exploded_object", obj{Role: "admin"}, true, "role=admin"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := StyleCookieParam("id", tc.value, opts(tc.explode))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result)
+		})
+	}
+}
+
+func TestStyleDeepObjectParam(t *testing.T) {
+	type created struct {
+		Gte string `json:"gte,omitempty"`
+		Lte string `json:"lte,omitempty"`
+	}
+	type filter struct {
+		Status   string   `json:"status"`
+		Name     *string  `json:"name"`
+		MinPrice float64  `json:"min_price"`
+		Tags     []string `json:"tags,omitempty"`
+		Created  *created `json:"created,omitempty"`
+	}
+	result, err := StyleDeepObjectParam("filter", filter{
+		Status:   "a&b=c",
+		MinPrice: 1500000,
+		Tags:     []string{"x y", "z"},
+		Created:  &created{Gte: "2024-01-01"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "filter[created][gte]=2024-01-01&filter[min_price]=1500000&filter[status]=a%26b%3Dc&filter[tags][0]=x+y&filter[tags][1]=z", result)
+
+	result, err = StyleDeepObjectParam("labels", map[string]string{"app/name": "web"})
+	require.NoError(t, err)
+	assert.Equal(t, "labels[app%2Fname]=web", result)
+}
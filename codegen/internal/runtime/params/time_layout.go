@@ -0,0 +1,82 @@
+package params
+
+//oapi-runtime:function params/TimeLayoutParams
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+// TimeLayoutUnix and TimeLayoutUnixMilli are the layouts of timestamps in
+// seconds and milliseconds since the Unix epoch.
+const (
+	TimeLayoutUnix      = "unix"
+	TimeLayoutUnixMilli = "unixmilli"
+)
+
+// ParseTimeLayout returns the parser of time.Time parameter values in layout.
+func ParseTimeLayout(layout string) func(string) (time.Time, error) {
+	return func(src string) (time.Time, error) {
+		return parseTimeLayout(layout, src)
+	}
+}
+
+// FormatTimeLayout returns the formatter of time.Time parameter values in
+// layout.
+func FormatTimeLayout(layout string) func(time.Time) string {
+	return func(v time.Time) string {
+		return formatTimeLayout(layout, v)
+	}
+}
+
+// ParseWrappedTimeLayout returns the parser of parameter values of a type
+// embedding time.Time, such as Date or DateTime, in layout.
+func ParseWrappedTimeLayout[T ~struct{ time.Time }](layout string) func(string) (T, error) {
+	return func(src string) (T, error) {
+		t, err := parseTimeLayout(layout, src)
+		return T(struct{ time.Time }{t}), err
+	}
+}
+
+// FormatWrappedTimeLayout returns the formatter of parameter values of a type
+// embedding time.Time, such as Date or DateTime, in layout.
+func FormatWrappedTimeLayout[T ~struct{ time.Time }](layout string) func(T) string {
+	return func(v T) string {
+		return formatTimeLayout(layout, struct{ time.Time }(v).Time)
+	}
+}
+
+func parseTimeLayout(layout, src string) (time.Time, error) {
+	switch layout {
+	case TimeLayoutUnix, TimeLayoutUnixMilli:
+		n, err := strconv.ParseInt(src, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse %s time: %w", layout, err)
+		}
+		if layout == TimeLayoutUnix {
+			return time.Unix(n, 0).UTC(), nil
+		}
+		return time.UnixMilli(n).UTC(), nil
+	}
+	t, err := time.Parse(layout, src)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	return t, nil
+}
+
+func formatTimeLayout(layout string, t time.Time) string {
+	switch layout {
+	case TimeLayoutUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case TimeLayoutUnixMilli:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+	return t.Format(layout)
+}
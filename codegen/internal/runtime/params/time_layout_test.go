@@ -0,0 +1,57 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeLayout(t *testing.T) {
+	instant := time.Date(2024, 2, 29, 13, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout    string
+		formatted string
+		parsed    time.Time
+	}{
+		{"20060102", "20240229", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{time.RFC1123, "Thu, 29 Feb 2024 13:45:00 UTC", instant},
+		{TimeLayoutUnix, "1709214300", instant},
+		{TimeLayoutUnixMilli, "1709214300000", instant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			assert.Equal(t, tt.formatted, FormatTimeLayout(tt.layout)(instant))
+			parsed, err := ParseTimeLayout(tt.layout)(tt.formatted)
+			require.NoError(t, err)
+			assert.True(t, tt.parsed.Equal(parsed), "parsed %v", parsed)
+
+			date := types.Date{Time: instant}
+			assert.Equal(t, tt.formatted, FormatWrappedTimeLayout[types.Date](tt.layout)(date))
+			parsedDate, err := ParseWrappedTimeLayout[types.Date](tt.layout)(tt.formatted)
+			require.NoError(t, err)
+			assert.True(t, tt.parsed.Equal(parsedDate.Time), "parsed %v", parsedDate)
+		})
+	}
+}
+
+func TestTimeLayout_Parameters(t *testing.T) {
+	opts := ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath}
+	day := types.Date{Time: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}
+
+	styled, err := StylePrimitiveParameter("day", day, opts, FormatWrappedTimeLayout[types.Date]("20060102"))
+	require.NoError(t, err)
+	assert.Equal(t, "20240229", styled)
+
+	var bound types.Date
+	require.NoError(t, BindPrimitiveParameter("day", styled, &bound, opts, ParseWrappedTimeLayout[types.Date]("20060102")))
+	assert.Equal(t, day, bound)
+
+	_, err = ParseTimeLayout(TimeLayoutUnix)("yesterday")
+	assert.ErrorContains(t, err, "failed to parse unix time")
+	_, err = ParseWrappedTimeLayout[types.Date]("20060102")("2024-02-29")
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package types
+
+//oapi-runtime:function types/Base64Bytes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidBase64 is returned when a string isn't base64.
+var ErrInvalidBase64 = errors.New("base64: invalid base64 data")
+
+// Base64Bytes is binary data encoded as base64, the format of strings with
+// format: byte. Its value is the decoded data, so it is never base64-encoded
+// twice. It is encoded with the standard, padded alphabet of RFC 4648, and
+// decoded from the standard or URL-safe alphabet, padded or not.
+type Base64Bytes []byte
+
+// ParseBase64Bytes decodes s as base64.
+func ParseBase64Bytes(s string) (Base64Bytes, error) {
+	// Padding, and the alphabet's characters 62 and 63, select the encoding:
+	// the unpadded encodings would take "=" for data.
+	enc := base64.RawStdEncoding
+	switch padded, urlSafe := strings.HasSuffix(s, "="), strings.ContainsAny(s, "-_"); {
+	case padded && urlSafe:
+		enc = base64.URLEncoding
+	case padded:
+		enc = base64.StdEncoding
+	case urlSafe:
+		enc = base64.RawURLEncoding
+	}
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrInvalidBase64, s, err)
+	}
+	return Base64Bytes(b), nil
+}
+
+// String returns b encoded as standard, padded base64.
+func (b Base64Bytes) String() string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// MarshalJSON encodes b as a base64 string, and nil as null, like []byte.
+func (b Base64Bytes) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.String())
+}
+
+func (b *Base64Bytes) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*b = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Base64Bytes.
+func (b Base64Bytes) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Base64Bytes.
+func (b *Base64Bytes) UnmarshalText(data []byte) error {
+	parsed, err := ParseBase64Bytes(string(data))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBase64Bytes(t *testing.T) {
+	want := Base64Bytes("hello?>")
+	for _, in := range []string{"aGVsbG8/Pg==", "aGVsbG8/Pg", "aGVsbG8_Pg==", "aGVsbG8_Pg"} {
+		t.Run(in, func(t *testing.T) {
+			b, err := ParseBase64Bytes(in)
+			require.NoError(t, err)
+			assert.Equal(t, want, b)
+		})
+	}
+
+	empty, err := ParseBase64Bytes("")
+	require.NoError(t, err)
+	assert.Equal(t, Base64Bytes{}, empty)
+}
+
+func TestParseBase64Bytes_Invalid(t *testing.T) {
+	for _, in := range []string{"a", "aGVsbG8/Pg=", "aGVs bG8=", "aGVsbG8/_g==", "not base64!"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseBase64Bytes(in)
+			assert.ErrorIs(t, err, ErrInvalidBase64)
+		})
+	}
+}
+
+func TestBase64Bytes_JSON(t *testing.T) {
+	type payload struct {
+		Data     Base64Bytes  `json:"data"`
+		Optional *Base64Bytes `json:"optional,omitempty"`
+		Nil      Base64Bytes  `json:"nil"`
+	}
+	data, err := json.Marshal(payload{Data: Base64Bytes("hello?>")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":"aGVsbG8/Pg==","nil":null}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"data":"aGVsbG8_Pg","optional":"","nil":null}`), &decoded))
+	assert.Equal(t, Base64Bytes("hello?>"), decoded.Data)
+	require.NotNil(t, decoded.Optional)
+	assert.Empty(t, *decoded.Optional)
+	assert.Nil(t, decoded.Nil)
+
+	assert.Error(t, json.Unmarshal([]byte(`42`), &decoded.Data))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`"!!"`), &decoded.Data), ErrInvalidBase64)
+}
+
+func TestBase64Bytes_Text(t *testing.T) {
+	text, err := Base64Bytes("hello?>").MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "aGVsbG8/Pg==", string(text))
+
+	var b Base64Bytes
+	require.NoError(t, b.UnmarshalText(text))
+	assert.Equal(t, Base64Bytes("hello?>"), b)
+}
@@ -0,0 +1,130 @@
+package types
+
+//oapi-runtime:function types/BigInt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var bigIntRegex = regexp.MustCompile(`^[+-]?\d+$`)
+
+// ErrInvalidBigInt is returned when a string isn't an integer.
+var ErrInvalidBigInt = errors.New("bigint: invalid integer")
+
+// BigInt is an integer of any size, the type of integers with
+// generation.precise-numbers: big, so that large 64-bit IDs and beyond keep
+// all of their digits. It is encoded in JSON as a number, and decoded from
+// numbers or strings. Use Int for arithmetic. The zero value is 0.
+type BigInt struct {
+	text string
+}
+
+// ParseBigInt parses an integer, such as "-42" or "18446744073709551616".
+func ParseBigInt(s string) (BigInt, error) {
+	if !bigIntRegex.MatchString(s) {
+		return BigInt{}, fmt.Errorf("%w: %q", ErrInvalidBigInt, s)
+	}
+	// Normalize to a JSON number: no plus sign or leading zeros.
+	sign := ""
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return BigInt{}, nil
+	}
+	return BigInt{text: sign + s}, nil
+}
+
+// MustParseBigInt is ParseBigInt, panicking if s isn't an integer. It is
+// meant for constants.
+func MustParseBigInt(s string) BigInt {
+	i, err := ParseBigInt(s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// NewBigInt returns the BigInt of the value of x.
+func NewBigInt(x *big.Int) BigInt {
+	if x.Sign() == 0 {
+		return BigInt{}
+	}
+	return BigInt{text: x.String()}
+}
+
+// String returns the decimal digits of i.
+func (i BigInt) String() string {
+	if i.text == "" {
+		return "0"
+	}
+	return i.text
+}
+
+// Int returns the value of i as a new big.Int.
+func (i BigInt) Int() *big.Int {
+	x, ok := new(big.Int).SetString(i.String(), 10)
+	if !ok {
+		// Unreachable: i was validated when parsed.
+		return new(big.Int)
+	}
+	return x
+}
+
+// Int64 returns the value of i, and whether an int64 holds it.
+func (i BigInt) Int64() (int64, bool) {
+	x := i.Int()
+	return x.Int64(), x.IsInt64()
+}
+
+// Cmp compares the values of i and j, returning -1, 0 or +1.
+func (i BigInt) Cmp(j BigInt) int {
+	return i.Int().Cmp(j.Int())
+}
+
+// IsZero reports whether i is zero.
+func (i BigInt) IsZero() bool {
+	return i.text == ""
+}
+
+func (i BigInt) MarshalJSON() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalJSON decodes a number or a string, keeping all of its digits.
+func (i *BigInt) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return i.UnmarshalText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler for BigInt.
+func (i BigInt) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for BigInt.
+func (i *BigInt) UnmarshalText(data []byte) error {
+	parsed, err := ParseBigInt(string(data))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
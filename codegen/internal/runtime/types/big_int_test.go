@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBigInt(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"42", "42"},
+		{"+7", "7"},
+		{"-0", "0"},
+		{"007", "7"},
+		{"-18446744073709551617", "-18446744073709551617"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			i, err := ParseBigInt(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, i.String())
+		})
+	}
+
+	for _, in := range []string{"", "-", "1.5", "1e3", "0x10", " 1"} {
+		_, err := ParseBigInt(in)
+		assert.ErrorIs(t, err, ErrInvalidBigInt, in)
+	}
+}
+
+func TestBigInt_Values(t *testing.T) {
+	var zero BigInt
+	assert.Equal(t, "0", zero.String())
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, zero, MustParseBigInt("-000"))
+
+	huge := MustParseBigInt("18446744073709551616")
+	sum := new(big.Int).Add(huge.Int(), big.NewInt(-1))
+	assert.Equal(t, "18446744073709551615", NewBigInt(sum).String())
+	assert.Equal(t, 1, huge.Cmp(NewBigInt(sum)))
+
+	_, ok := huge.Int64()
+	assert.False(t, ok)
+	n, ok := MustParseBigInt("-9007199254740993").Int64()
+	assert.True(t, ok)
+	assert.Equal(t, int64(-9007199254740993), n)
+	assert.Panics(t, func() { MustParseBigInt("ten") })
+}
+
+func TestBigInt_JSON(t *testing.T) {
+	type order struct {
+		ID     BigInt  `json:"id"`
+		Parent *BigInt `json:"parent,omitempty"`
+	}
+	o := order{ID: MustParseBigInt("9007199254740993")}
+
+	buf, err := json.Marshal(o)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":9007199254740993}`, string(buf))
+
+	var got order
+	// Strings are decoded as well as numbers.
+	require.NoError(t, json.Unmarshal([]byte(`{"id":123456789012345678901234567890,"parent":"-5"}`), &got))
+	assert.Equal(t, "123456789012345678901234567890", got.ID.String())
+	require.NotNil(t, got.Parent)
+	assert.Equal(t, "-5", got.Parent.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id":1.5}`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{"id":true}`), &got))
+}
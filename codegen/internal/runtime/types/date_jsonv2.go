@@ -0,0 +1,40 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+//oapi-runtime:function types/DateJSONv2
+
+import (
+	"encoding/json/jsontext"
+	"fmt"
+	"time"
+)
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// date as a DateFormat string.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(d.Format(DateFormat)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading a DateFormat string. null leaves the zero date.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case jsontext.KindNull:
+		*d = Date{}
+		return nil
+	case jsontext.KindString:
+		parsed, err := time.Parse(DateFormat, tok.String())
+		if err != nil {
+			return err
+		}
+		d.Time = parsed
+		return nil
+	default:
+		return fmt.Errorf("date: expected a string, got %v", tok.Kind())
+	}
+}
@@ -0,0 +1,33 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDate_JSONv2(t *testing.T) {
+	type event struct {
+		On Date `json:"on"`
+	}
+	var e event
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"on":"2024-02-29"}`), &e))
+	assert.Equal(t, "2024-02-29", e.On.String())
+
+	data, err := jsonv2.Marshal(e)
+	require.NoError(t, err)
+	assert.Equal(t, `{"on":"2024-02-29"}`, string(data))
+
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"on":null}`), &e))
+	assert.True(t, e.On.IsZero())
+
+	err = jsonv2.Unmarshal([]byte(`{"on":20240229}`), &e)
+	var semErr *jsonv2.SemanticError
+	require.ErrorAs(t, err, &semErr)
+	assert.Equal(t, "/on", string(semErr.JSONPointer))
+	assert.Error(t, jsonv2.Unmarshal([]byte(`{"on":"29/02/2024"}`), &e))
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestDate_MarshalJSON(t *testing.T) {
@@ -63,3 +65,18 @@ func TestDate_UnmarshalText(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testDate, date.Time)
 }
+
+func TestDate_YAML(t *testing.T) {
+	// Date round-trips through its text marshaling.
+	type event struct {
+		Date Date `yaml:"date"`
+	}
+	data, err := yaml.Marshal(event{Date: Date{time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)}})
+	require.NoError(t, err)
+	assert.Equal(t, "date: \"2019-04-01\"\n", string(data))
+
+	var e event
+	require.NoError(t, yaml.Unmarshal([]byte("date: 2019-04-01\n"), &e))
+	assert.Equal(t, "2019-04-01", e.Date.String())
+	assert.Error(t, yaml.Unmarshal([]byte("date: April\n"), &e))
+}
@@ -0,0 +1,151 @@
+package types
+
+//oapi-runtime:function types/DateTime
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DateTimeFormat is the layout of DateTime: an RFC 3339 date-time in UTC,
+// truncated to whole seconds.
+const DateTimeFormat = "2006-01-02T15:04:05Z"
+
+// DateTimeMillisFormat is the layout of DateTimeMillis: an RFC 3339
+// date-time in UTC, truncated to milliseconds.
+const DateTimeMillisFormat = "2006-01-02T15:04:05.000Z"
+
+// DateTime is a timestamp of format: date-time, for consumers which can't
+// read the nanoseconds time.Time marshals. It unmarshals RFC 3339 date-times
+// only, normalized to UTC, and marshals in UTC truncated to whole seconds,
+// such as 2024-02-29T13:45:00Z. See DateTimeMillis for millisecond precision.
+type DateTime struct {
+	time.Time
+}
+
+// NewDateTime returns t as a DateTime, in UTC.
+func NewDateTime(t time.Time) DateTime {
+	return DateTime{Time: t.UTC()}
+}
+
+// ParseDateTime parses s as an RFC 3339 date-time, such as
+// 2024-02-29T14:45:00+01:00, normalized to UTC.
+func ParseDateTime(s string) (DateTime, error) {
+	t, err := parseDateTime(s)
+	return DateTime{Time: t}, err
+}
+
+// MustParseDateTime is ParseDateTime, panicking if s isn't a date-time. It
+// initializes the defaults of DateTime fields.
+func MustParseDateTime(s string) DateTime {
+	d, err := ParseDateTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String returns d in UTC in DateTimeFormat.
+func (d DateTime) String() string {
+	return d.UTC().Truncate(time.Second).Format(DateTimeFormat)
+}
+
+// MarshalJSON implements json.Marshaler, writing d as String does.
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an RFC 3339 date-time.
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler, used for parameters.
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for parameters.
+func (d *DateTime) UnmarshalText(data []byte) error {
+	parsed, err := ParseDateTime(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// DateTimeMillis is DateTime with millisecond precision: it marshals in UTC
+// truncated to milliseconds, such as 2024-02-29T13:45:00.250Z.
+type DateTimeMillis struct {
+	time.Time
+}
+
+// NewDateTimeMillis returns t as a DateTimeMillis, in UTC.
+func NewDateTimeMillis(t time.Time) DateTimeMillis {
+	return DateTimeMillis{Time: t.UTC()}
+}
+
+// ParseDateTimeMillis parses s as an RFC 3339 date-time, such as
+// 2024-02-29T14:45:00.25+01:00, normalized to UTC.
+func ParseDateTimeMillis(s string) (DateTimeMillis, error) {
+	t, err := parseDateTime(s)
+	return DateTimeMillis{Time: t}, err
+}
+
+// MustParseDateTimeMillis is ParseDateTimeMillis, panicking if s isn't a
+// date-time. It initializes the defaults of DateTimeMillis fields.
+func MustParseDateTimeMillis(s string) DateTimeMillis {
+	d, err := ParseDateTimeMillis(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// String returns d in UTC in DateTimeMillisFormat.
+func (d DateTimeMillis) String() string {
+	return d.UTC().Truncate(time.Millisecond).Format(DateTimeMillisFormat)
+}
+
+// MarshalJSON implements json.Marshaler, writing d as String does.
+func (d DateTimeMillis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an RFC 3339 date-time.
+func (d *DateTimeMillis) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler, used for parameters.
+func (d DateTimeMillis) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for parameters.
+func (d *DateTimeMillis) UnmarshalText(data []byte) error {
+	parsed, err := ParseDateTimeMillis(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// parseDateTime parses s as an RFC 3339 date-time, in UTC.
+func parseDateTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
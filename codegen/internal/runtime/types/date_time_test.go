@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTime_JSON(t *testing.T) {
+	type event struct {
+		At     DateTime       `json:"at"`
+		Millis DateTimeMillis `json:"millis"`
+	}
+	at := time.Date(2024, 2, 29, 14, 45, 0, 250_999_999, time.FixedZone("CET", 3600))
+	data, err := json.Marshal(event{At: NewDateTime(at), Millis: NewDateTimeMillis(at)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"at":"2024-02-29T13:45:00Z","millis":"2024-02-29T13:45:00.250Z"}`, string(data))
+
+	var e event
+	require.NoError(t, json.Unmarshal([]byte(`{"at":"2024-02-29T14:45:00.5+01:00","millis":"2024-02-29T13:45:00Z"}`), &e))
+	assert.Equal(t, time.UTC, e.At.Location())
+	assert.True(t, e.At.Equal(time.Date(2024, 2, 29, 13, 45, 0, 500_000_000, time.UTC)), "precision is kept until marshaled")
+	assert.Equal(t, "2024-02-29T13:45:00Z", e.At.String())
+	assert.Equal(t, "2024-02-29T13:45:00.000Z", e.Millis.String())
+}
+
+func TestDateTime_RejectsNonRFC3339(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2024-02-29",
+		"2024-02-29 13:45:00Z",
+		"2024-02-29T13:45:00",
+		"Thu, 29 Feb 2024 13:45:00 GMT",
+	} {
+		var d DateTime
+		assert.Error(t, d.UnmarshalText([]byte(s)), s)
+		var m DateTimeMillis
+		assert.Error(t, json.Unmarshal([]byte(`"`+s+`"`), &m), s)
+	}
+	assert.Panics(t, func() { MustParseDateTime("yesterday") })
+	assert.Equal(t, "2024-02-29T13:45:00.100Z", MustParseDateTimeMillis("2024-02-29T13:45:00.1Z").String())
+}
@@ -0,0 +1,150 @@
+package types
+
+//oapi-runtime:function types/Decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var decimalRegex = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// ErrInvalidDecimal is returned when a string isn't a decimal number.
+var ErrInvalidDecimal = errors.New("decimal: invalid decimal number")
+
+// Decimal is an exact decimal number, such as an amount of money, the format
+// of strings with format: decimal or money. It keeps the digits it was given,
+// including trailing zeros, so no precision is lost to floating point; use
+// Rat for arithmetic. It is encoded in JSON as a string, and decoded from
+// strings or numbers. The zero value is 0.
+type Decimal struct {
+	text string
+}
+
+// ParseDecimal parses a decimal number, such as "12.50", "-0.001" or "1e6".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRegex.MatchString(s) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+	// Normalize to a JSON number: no plus sign, leading zeros or bare point.
+	sign := ""
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i:]
+	}
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+	text := sign + whole
+	if fraction != "" {
+		text += "." + fraction
+	}
+	return Decimal{text: text + exponent}, nil
+}
+
+// MustParseDecimal is ParseDecimal, panicking if s isn't a decimal number. It
+// is meant for constants.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromRat returns r rounded to scale digits after the decimal point.
+func NewDecimalFromRat(r *big.Rat, scale int) Decimal {
+	return Decimal{text: r.FloatString(scale)}
+}
+
+// String returns d as it was given, normalized as a JSON number.
+func (d Decimal) String() string {
+	if d.text == "" {
+		return "0"
+	}
+	return d.text
+}
+
+// Rat returns the exact value of d.
+func (d Decimal) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(d.String())
+	if !ok {
+		// Unreachable: d was validated when parsed.
+		return new(big.Rat)
+	}
+	return r
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares the values of d and e, returning -1, 0 or +1, so that 1.50
+// and 1.5 are equal.
+func (d Decimal) Cmp(e Decimal) int {
+	return d.Rat().Cmp(e.Rat())
+}
+
+// IsZero reports whether d is zero.
+func (d Decimal) IsZero() bool {
+	return d.Rat().Sign() == 0
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a string or a number, keeping all of its digits.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return d.UnmarshalText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler for Decimal.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Decimal.
+func (d *Decimal) UnmarshalText(data []byte) error {
+	parsed, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// DecimalNumber is a Decimal encoded in JSON as a number rather than a
+// string, the format of numbers with format: decimal or money. Its digits are
+// written as is, so no precision is lost to floating point, as long as the
+// other side doesn't decode it into one.
+type DecimalNumber struct {
+	Decimal
+}
+
+func (d DecimalNumber) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"12.50", "12.50"},
+		{"+1", "1"},
+		{"-0.001", "-0.001"},
+		{".5", "0.5"},
+		{"7.", "7"},
+		{"007.10", "7.10"},
+		{"1.5E-3", "1.5E-3"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+
+	for _, in := range []string{"", ".", "1.2.3", "1e", "NaN", "0x10", "1,5", " 1"} {
+		_, err := ParseDecimal(in)
+		assert.ErrorIs(t, err, ErrInvalidDecimal, in)
+	}
+}
+
+func TestDecimal_Values(t *testing.T) {
+	var zero Decimal
+	assert.Equal(t, "0", zero.String())
+	assert.True(t, zero.IsZero())
+
+	d := MustParseDecimal("0.1")
+	sum := new(big.Rat).Add(d.Rat(), MustParseDecimal("0.2").Rat())
+	assert.Equal(t, 0, NewDecimalFromRat(sum, 2).Cmp(MustParseDecimal("0.3")))
+	assert.Equal(t, "0.30", NewDecimalFromRat(sum, 2).String())
+	assert.Equal(t, 0, MustParseDecimal("1.50").Cmp(MustParseDecimal("1.5")))
+	assert.Equal(t, -1, MustParseDecimal("-2").Cmp(MustParseDecimal("1e-9")))
+	assert.InDelta(t, 0.1, d.Float64(), 1e-12)
+	assert.Panics(t, func() { MustParseDecimal("ten") })
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	type payment struct {
+		Amount Decimal        `json:"amount"`
+		Total  DecimalNumber  `json:"total"`
+		Fee    *DecimalNumber `json:"fee,omitempty"`
+	}
+	amount := "1234567890.123456789"
+	p := payment{Amount: MustParseDecimal(amount), Total: DecimalNumber{MustParseDecimal("0.10")}}
+
+	buf, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"1234567890.123456789","total":0.10}`, string(buf))
+
+	var got payment
+	// Numbers are decoded as precisely as strings.
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":1234567890.123456789,"total":"0.10","fee":0.000001}`), &got))
+	assert.Equal(t, amount, got.Amount.String())
+	assert.Equal(t, "0.10", got.Total.String())
+	require.NotNil(t, got.Fee)
+	assert.Equal(t, "0.000001", got.Fee.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"ten"}`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":true}`), &got))
+}
@@ -0,0 +1,188 @@
+package types
+
+//oapi-runtime:function types/Duration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Nominal lengths of the ISO 8601 duration units which vary in length.
+const (
+	durationDay   = 24 * time.Hour
+	durationWeek  = 7 * durationDay
+	durationMonth = 30 * durationDay
+	durationYear  = 365 * durationDay
+)
+
+// ErrInvalidDuration is returned when a string isn't an ISO 8601 duration.
+var ErrInvalidDuration = errors.New("duration: invalid ISO 8601 duration")
+
+// Duration is an ISO 8601 duration, such as "P1DT2H30M", the format of
+// strings with format: duration. It converts to and from time.Duration; as a
+// time.Duration has no calendar, years, months and days are taken as 365, 30
+// and 1 days of 24 hours.
+type Duration time.Duration
+
+// ParseDuration parses an ISO 8601 duration, such as "PT1H30M" or "-P2W".
+// Only the seconds may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	rest := s
+	negative := false
+	if strings.HasPrefix(rest, "-") {
+		negative, rest = true, rest[1:]
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	if !strings.HasPrefix(rest, "P") || rest == "P" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+	rest = rest[1:]
+
+	var total time.Duration
+	inTime := false
+	// units holds the designators allowed next, in order.
+	units := "YMWD"
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		number, designator := rest[:end], rest[end]
+		rest = rest[end+1:]
+		i := strings.IndexByte(units, designator)
+		if i < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		units = units[i+1:]
+
+		whole, fraction, hasFraction := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+		if hasFraction && !(inTime && designator == 'S') {
+			return 0, fmt.Errorf("%w: %q: only seconds may have a fraction", ErrInvalidDuration, s)
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		unit := durationUnit(designator, inTime)
+		if err != nil || n > int64(math.MaxInt64/unit) {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		part := time.Duration(n) * unit
+		if hasFraction {
+			if fraction == "" || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+			part += time.Duration(nanos)
+		}
+		if total > math.MaxInt64-part {
+			return 0, fmt.Errorf("%w: %q overflows time.Duration", ErrInvalidDuration, s)
+		}
+		total += part
+	}
+
+	if negative {
+		total = -total
+	}
+	return Duration(total), nil
+}
+
+// durationUnit returns the length of an ISO 8601 designator, whose meaning
+// depends on whether it is in the time part of the duration.
+func durationUnit(designator byte, inTime bool) time.Duration {
+	if inTime {
+		switch designator {
+		case 'H':
+			return time.Hour
+		case 'M':
+			return time.Minute
+		default:
+			return time.Second
+		}
+	}
+	switch designator {
+	case 'Y':
+		return durationYear
+	case 'M':
+		return durationMonth
+	case 'W':
+		return durationWeek
+	default:
+		return durationDay
+	}
+}
+
+// TimeDuration returns d as a time.Duration.
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// String formats d as an ISO 8601 duration in hours, minutes and seconds,
+// such as "PT26H30M" or "PT0.5S", so that it parses back exactly.
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		seconds := strconv.FormatUint(u/uint64(time.Second), 10)
+		if nanos := u % uint64(time.Second); nanos > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Duration.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Duration.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT0S", 0},
+		{"PT1H30M", 90 * time.Minute},
+		{"P1DT2H", 26 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+		{"P1Y2M", (365 + 60) * 24 * time.Hour},
+		{"PT1.5S", 1500 * time.Millisecond},
+		{"PT0,000000001S", time.Nanosecond},
+		{"-PT45S", -45 * time.Second},
+		{"+P1D", 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDuration(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d.TimeDuration())
+		})
+	}
+}
+
+func TestParseDuration_Invalid(t *testing.T) {
+	for _, in := range []string{"", "P", "PT", "P1DT", "1H", "PT1H1H", "PM1", "P1H", "PT1M1H", "P1.5D", "PT1.S", "PT1.0000000001S", "P1D2", "P99999999999Y"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseDuration(in)
+			assert.ErrorIs(t, err, ErrInvalidDuration)
+		})
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	assert.Equal(t, "PT0S", Duration(0).String())
+	assert.Equal(t, "PT26H30M", Duration(26*time.Hour+30*time.Minute).String())
+	assert.Equal(t, "PT1M0.5S", Duration(time.Minute+500*time.Millisecond).String())
+	assert.Equal(t, "-PT0.000000001S", Duration(-1).String())
+
+	for _, d := range []time.Duration{time.Hour + time.Nanosecond, -90 * time.Second, 1<<63 - 1, -1 << 63} {
+		parsed, err := ParseDuration(Duration(d).String())
+		if d == -1<<63 {
+			// The most negative duration has no positive counterpart to parse.
+			assert.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, d, parsed.TimeDuration())
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	type obj struct {
+		Timeout Duration `json:"timeout"`
+	}
+	buf, err := json.Marshal(obj{Timeout: Duration(90 * time.Second)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"timeout":"PT1M30S"}`, string(buf))
+
+	var got obj
+	require.NoError(t, json.Unmarshal([]byte(`{"timeout":"P1DT1S"}`), &got))
+	assert.Equal(t, 24*time.Hour+time.Second, got.Timeout.TimeDuration())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":"1h"}`), &got))
+	assert.Error(t, json.Unmarshal([]byte(`{"timeout":60}`), &got))
+}
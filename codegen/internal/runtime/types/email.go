@@ -49,3 +49,26 @@ func (e *Email) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, with the
+// validation of MarshalJSON.
+func (e Email) MarshalYAML() (any, error) {
+	if !emailRegex.MatchString(string(e)) {
+		return nil, ErrValidationEmail
+	}
+	return string(e), nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, with the validation of UnmarshalJSON.
+func (e *Email) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*e = Email(s)
+	if !emailRegex.MatchString(s) {
+		return ErrValidationEmail
+	}
+	return nil
+}
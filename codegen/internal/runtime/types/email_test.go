@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestEmail_MarshalJSON_Validation(t *testing.T) {
@@ -174,3 +176,20 @@ func TestEmail_UnmarshalJSON_NullableEmail_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestEmail_YAML(t *testing.T) {
+	type contact struct {
+		Email Email `yaml:"email"`
+	}
+	data, err := yaml.Marshal(contact{Email: "validemail@openapicodegen.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "email: validemail@openapicodegen.com\n", string(data))
+
+	var c contact
+	require.NoError(t, yaml.Unmarshal(data, &c))
+	assert.Equal(t, Email("validemail@openapicodegen.com"), c.Email)
+
+	_, err = yaml.Marshal(contact{Email: "invalidemail"})
+	assert.ErrorIs(t, err, ErrValidationEmail)
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("email: invalidemail\n"), &c), ErrValidationEmail)
+}
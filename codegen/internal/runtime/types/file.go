@@ -10,23 +10,38 @@ import (
 )
 
 type File struct {
-	multipart *multipart.FileHeader
-	data      []byte
-	filename  string
+	multipart   *multipart.FileHeader
+	data        []byte
+	filename    string
+	contentType string
 }
 
 func (file *File) InitFromMultipart(header *multipart.FileHeader) {
 	file.multipart = header
 	file.data = nil
 	file.filename = ""
+	file.contentType = ""
 }
 
 func (file *File) InitFromBytes(data []byte, filename string) {
 	file.data = data
 	file.filename = filename
+	file.contentType = ""
 	file.multipart = nil
 }
 
+// SetFilename sets the filename sent with the file in multipart bodies,
+// overriding the one of a file parsed from a multipart body.
+func (file *File) SetFilename(filename string) {
+	file.filename = filename
+}
+
+// SetContentType sets the Content-Type sent with the file in multipart
+// bodies, overriding the one of a file parsed from a multipart body.
+func (file *File) SetContentType(contentType string) {
+	file.contentType = contentType
+}
+
 func (file File) MarshalJSON() ([]byte, error) {
 	b, err := file.Bytes()
 	if err != nil {
@@ -59,12 +74,21 @@ func (file File) Reader() (io.ReadCloser, error) {
 }
 
 func (file File) Filename() string {
-	if file.multipart != nil {
+	if file.filename == "" && file.multipart != nil {
 		return file.multipart.Filename
 	}
 	return file.filename
 }
 
+// ContentType returns the file's Content-Type: the one set, or else the one
+// of its multipart part, if any.
+func (file File) ContentType() string {
+	if file.contentType == "" && file.multipart != nil {
+		return file.multipart.Header.Get("Content-Type")
+	}
+	return file.contentType
+}
+
 func (file File) FileSize() int64 {
 	if file.multipart != nil {
 		return file.multipart.Size
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"mime/multipart"
+	"net/textproto"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -52,3 +55,45 @@ func TestFileJSON(t *testing.T) {
 	assert.Equal(t, []byte("hello"), o4Bytes)
 
 }
+
+func TestFileMultipartMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="photo"; filename="cat.png"`)
+	h.Set("Content-Type", "image/png")
+	part, err := w.CreatePart(h)
+	require.NoError(t, err)
+	_, err = part.Write([]byte("png-data"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	form, err := multipart.NewReader(&buf, w.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+
+	var f File
+	f.InitFromMultipart(form.File["photo"][0])
+	assert.Equal(t, "cat.png", f.Filename())
+	assert.Equal(t, "image/png", f.ContentType())
+
+	f.SetFilename("kitten.png")
+	f.SetContentType("image/x-png")
+	assert.Equal(t, "kitten.png", f.Filename())
+	assert.Equal(t, "image/x-png", f.ContentType())
+	data, err := f.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("png-data"), data)
+}
+
+func TestFileBytesMetadata(t *testing.T) {
+	var f File
+	f.InitFromBytes([]byte("hello"), "hello.txt")
+	assert.Equal(t, "hello.txt", f.Filename())
+	assert.Equal(t, "", f.ContentType())
+
+	f.SetContentType("text/plain")
+	assert.Equal(t, "text/plain", f.ContentType())
+
+	f.InitFromBytes([]byte("bye"), "")
+	assert.Equal(t, "", f.ContentType())
+}
@@ -0,0 +1,162 @@
+package types
+
+//oapi-runtime:function types/IP
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+var (
+	// ErrInvalidIPv4 is returned when a string isn't an IPv4 address.
+	ErrInvalidIPv4 = errors.New("ipv4: invalid IPv4 address")
+	// ErrInvalidIPv6 is returned when a string isn't an IPv6 address.
+	ErrInvalidIPv6 = errors.New("ipv6: invalid IPv6 address")
+)
+
+// IPv4 is an IPv4 address in dotted decimal form, the format of strings with
+// format: ipv4. It wraps a netip.Addr, and is validated when unmarshaled.
+// The zero IPv4 is no address, and marshals as "".
+type IPv4 struct {
+	netip.Addr
+}
+
+// ParseIPv4 parses s as an IPv4 address, such as 192.0.2.1. IPv6 addresses,
+// even IPv4-mapped ones, are rejected.
+func ParseIPv4(s string) (IPv4, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return IPv4{}, fmt.Errorf("%w: %q: %w", ErrInvalidIPv4, s, err)
+	}
+	if !addr.Is4() {
+		return IPv4{}, fmt.Errorf("%w: %q: not an IPv4 address", ErrInvalidIPv4, s)
+	}
+	return IPv4{Addr: addr}, nil
+}
+
+// MustParseIPv4 is ParseIPv4, panicking if s isn't an IPv4 address. It
+// initializes the defaults of IPv4 fields.
+func MustParseIPv4(s string) IPv4 {
+	a, err := ParseIPv4(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns a in dotted decimal form, or "" for the zero IPv4.
+func (a IPv4) String() string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.Addr.String()
+}
+
+func (a IPv4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses a JSON string as an IPv4 address; null leaves a
+// unchanged.
+func (a *IPv4) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for IPv4.
+func (a IPv4) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for IPv4.
+func (a *IPv4) UnmarshalText(data []byte) error {
+	parsed, err := ParseIPv4(string(data))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// IPv6 is an IPv6 address, the format of strings with format: ipv6. It wraps
+// a netip.Addr, and is validated when unmarshaled. The zero IPv6 is no
+// address, and marshals as "".
+type IPv6 struct {
+	netip.Addr
+}
+
+// ParseIPv6 parses s as an IPv6 address, such as 2001:db8::1, including
+// IPv4-mapped ones, such as ::ffff:192.0.2.1. IPv4 addresses, and addresses
+// with a zone, are rejected.
+func ParseIPv6(s string) (IPv6, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return IPv6{}, fmt.Errorf("%w: %q: %w", ErrInvalidIPv6, s, err)
+	}
+	if !addr.Is6() {
+		return IPv6{}, fmt.Errorf("%w: %q: not an IPv6 address", ErrInvalidIPv6, s)
+	}
+	if addr.Zone() != "" {
+		return IPv6{}, fmt.Errorf("%w: %q: has a zone", ErrInvalidIPv6, s)
+	}
+	return IPv6{Addr: addr}, nil
+}
+
+// MustParseIPv6 is ParseIPv6, panicking if s isn't an IPv6 address. It
+// initializes the defaults of IPv6 fields.
+func MustParseIPv6(s string) IPv6 {
+	a, err := ParseIPv6(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns a in its canonical form, RFC 5952, or "" for the zero IPv6.
+func (a IPv6) String() string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.Addr.String()
+}
+
+func (a IPv6) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses a JSON string as an IPv6 address; null leaves a
+// unchanged.
+func (a *IPv6) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for IPv6.
+func (a IPv6) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for IPv6.
+func (a *IPv6) UnmarshalText(data []byte) error {
+	parsed, err := ParseIPv6(string(data))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
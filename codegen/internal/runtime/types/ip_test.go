@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPv4(t *testing.T) {
+	a, err := ParseIPv4("192.0.2.1")
+	require.NoError(t, err)
+	assert.True(t, a.Is4())
+	assert.True(t, a.IsGlobalUnicast())
+	assert.Equal(t, "192.0.2.1", a.String())
+
+	for _, in := range []string{"", "192.0.2", "192.0.2.256", "192.0.02.1", "::1", "::ffff:192.0.2.1", "example.com"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseIPv4(in)
+			assert.ErrorIs(t, err, ErrInvalidIPv4)
+		})
+	}
+}
+
+func TestParseIPv6(t *testing.T) {
+	a, err := ParseIPv6("2001:0db8:0000:0000:0000:0000:0000:0001")
+	require.NoError(t, err)
+	assert.True(t, a.Is6())
+	assert.Equal(t, "2001:db8::1", a.String())
+
+	mapped, err := ParseIPv6("::ffff:192.0.2.1")
+	require.NoError(t, err)
+	assert.True(t, mapped.Is4In6())
+
+	for _, in := range []string{"", "192.0.2.1", "2001:db8::1::2", "fe80::1%eth0", "2001:db8::g"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseIPv6(in)
+			assert.ErrorIs(t, err, ErrInvalidIPv6)
+		})
+	}
+}
+
+func TestMustParseIP(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", MustParseIPv4("10.0.0.1").String())
+	assert.Equal(t, "::1", MustParseIPv6("::1").String())
+	assert.Panics(t, func() { MustParseIPv4("::1") })
+	assert.Panics(t, func() { MustParseIPv6("10.0.0.1") })
+}
+
+func TestIP_JSON(t *testing.T) {
+	type payload struct {
+		V4       IPv4  `json:"v4"`
+		V6       IPv6  `json:"v6"`
+		Optional *IPv4 `json:"optional,omitempty"`
+	}
+	data, err := json.Marshal(payload{V4: MustParseIPv4("192.0.2.1"), V6: MustParseIPv6("2001:db8::1")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"v4":"192.0.2.1","v6":"2001:db8::1"}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"v4":"198.51.100.7","v6":"::1","optional":null}`), &decoded))
+	assert.Equal(t, "198.51.100.7", decoded.V4.String())
+	assert.True(t, decoded.V6.IsLoopback())
+	assert.Nil(t, decoded.Optional)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"v4":"::1"}`), &decoded), ErrInvalidIPv4)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"v6":"10.0.0.1"}`), &decoded), ErrInvalidIPv6)
+	assert.Error(t, json.Unmarshal([]byte(`{"v4":42}`), &decoded))
+
+	data, err = json.Marshal(payload{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"v4":"","v6":""}`, string(data))
+}
+
+func TestIP_Text(t *testing.T) {
+	var a IPv6
+	require.NoError(t, a.UnmarshalText([]byte("2001:DB8::A")))
+	text, err := a.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8::a", string(text))
+}
@@ -3,6 +3,8 @@ package types
 //oapi-runtime:function types/Nullable
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 )
@@ -28,6 +30,15 @@ func NewNullNullable[T any]() Nullable[T] {
 	return Nullable[T]{false: *new(T)}
 }
 
+// NullableFromPtr creates a Nullable from a pointer, as optional pointer
+// fields hold values: nil makes it explicitly null.
+func NullableFromPtr[T any](p *T) Nullable[T] {
+	if p == nil {
+		return NewNullNullable[T]()
+	}
+	return NewNullableWithValue(*p)
+}
+
 // Get returns the value if set, or an error if null or unspecified.
 func (n Nullable[T]) Get() (T, error) {
 	if v, ok := n[true]; ok {
@@ -49,6 +60,15 @@ func (n Nullable[T]) MustGet() T {
 	return v
 }
 
+// Ptr returns a pointer to a copy of the value, or nil if null or
+// unspecified.
+func (n Nullable[T]) Ptr() *T {
+	if v, ok := n[true]; ok {
+		return &v
+	}
+	return nil
+}
+
 // Set assigns a value.
 func (n *Nullable[T]) Set(value T) {
 	*n = Nullable[T]{true: value}
@@ -78,6 +98,12 @@ func (n Nullable[T]) IsSpecified() bool {
 	return len(n) > 0
 }
 
+// IsZero reports whether the field is unspecified, so fields tagged omitzero
+// are omitted only then, and written as null when explicitly null.
+func (n Nullable[T]) IsZero() bool {
+	return len(n) == 0
+}
+
 // MarshalJSON implements json.Marshaler.
 func (n Nullable[T]) MarshalJSON() ([]byte, error) {
 	if n.IsNull() {
@@ -104,6 +130,51 @@ func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2. Null
+// and unspecified are written as null.
+func (n Nullable[T]) MarshalYAML() (any, error) {
+	if v, ok := n[true]; ok {
+		return v, nil
+	}
+	return nil, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too. yaml doesn't call unmarshalers for null, which leaves n unspecified
+// rather than null.
+func (n *Nullable[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// Scan implements sql.Scanner, so Nullable columns can be read with
+// database/sql. NULL makes n null; other values are converted to T as
+// Rows.Scan converts them, including through T's own Scan method.
+func (n *Nullable[T]) Scan(src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return err
+	}
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	n.Set(v.V)
+	return nil
+}
+
+// Value implements driver.Valuer, so Nullable columns can be written with
+// database/sql. Null and unspecified are written as NULL, and values through
+// T's own Value method, or else as database/sql converts them.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	v, ok := n[true]
+	return sql.Null[T]{V: v, Valid: ok}.Value()
+}
+
 // ErrNullableIsNull is returned when trying to get a value from a null Nullable.
 var ErrNullableIsNull = errors.New("nullable value is null")
 
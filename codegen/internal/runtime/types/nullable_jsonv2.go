@@ -0,0 +1,39 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+//oapi-runtime:function types/NullableJSONv2
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// value with the options of enc. Null and unspecified are written as null;
+// tag fields omitzero, not omitempty, to omit them while unspecified, as
+// encoding/json/v2 omits null with omitempty.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if v, ok := n[true]; ok {
+		return jsonv2.MarshalEncode(enc, v)
+	}
+	return enc.WriteToken(jsontext.Null)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading the value with the options of dec. null makes n null.
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == jsontext.KindNull {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := jsonv2.UnmarshalDecode(dec, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
@@ -0,0 +1,38 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullable_JSONv2(t *testing.T) {
+	type patch struct {
+		Name  Nullable[string] `json:"name,omitzero"`
+		Since Nullable[Date]   `json:"since,omitzero"`
+		Tags  Nullable[[]string]
+	}
+
+	var p patch
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"name":null,"since":"2024-02-29","Tags":["a"]}`), &p))
+	assert.True(t, p.Name.IsNull())
+	assert.Equal(t, "2024-02-29", p.Since.MustGet().String())
+	assert.Equal(t, []string{"a"}, p.Tags.MustGet())
+
+	data, err := jsonv2.Marshal(patch{Name: NewNullNullable[string]()})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":null,"Tags":null}`, string(data), "explicit nulls are written, unspecified omitzero fields aren't")
+
+	// Options of the caller reach the value.
+	data, err = jsonv2.Marshal(NewNullableWithValue([]string(nil)), jsonv2.FormatNilSliceAsNull(true))
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+	data, err = jsonv2.Marshal(NewNullableWithValue(map[string]int{"b": 1, "a": 2}), jsonv2.Deterministic(true), jsontext.SpaceAfterColon(true))
+	require.NoError(t, err)
+	assert.Equal(t, `{"a": 2,"b": 1}`, string(data))
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNullable_Scan(t *testing.T) {
+	var n Nullable[string]
+	require.NoError(t, n.Scan("hello"))
+	assert.Equal(t, "hello", n.MustGet())
+
+	require.NoError(t, n.Scan([]byte("bytes")))
+	assert.Equal(t, "bytes", n.MustGet())
+
+	require.NoError(t, n.Scan(nil))
+	assert.True(t, n.IsNull())
+
+	var i Nullable[int32]
+	require.NoError(t, i.Scan(int64(42)))
+	assert.Equal(t, int32(42), i.MustGet())
+	assert.Error(t, i.Scan("forty-two"))
+
+	var f Nullable[float64]
+	require.NoError(t, f.Scan("1.5"))
+	assert.Equal(t, 1.5, f.MustGet())
+
+	var tm Nullable[time.Time]
+	now := time.Now()
+	require.NoError(t, tm.Scan(now))
+	assert.Equal(t, now, tm.MustGet())
+
+	var scanner Nullable[sql.NullString]
+	require.NoError(t, scanner.Scan("via Scan"))
+	assert.Equal(t, sql.NullString{String: "via Scan", Valid: true}, scanner.MustGet())
+}
+
+type upper string
+
+func (u upper) Value() (driver.Value, error) {
+	return "UPPER:" + string(u), nil
+}
+
+func TestNullable_Value(t *testing.T) {
+	v, err := NewNullableWithValue("hello").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", v)
+
+	v, err = NewNullableWithValue(int32(42)).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+
+	v, err = NewNullNullable[string]().Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	var unspecified Nullable[string]
+	v, err = unspecified.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = NewNullableWithValue(upper("x")).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "UPPER:x", v)
+
+	var _ sql.Scanner = (*Nullable[string])(nil)
+	var _ driver.Valuer = Nullable[string]{}
+}
+
+func TestNullable_YAML(t *testing.T) {
+	type config struct {
+		Name    Nullable[string] `yaml:"name,omitempty"`
+		Comment Nullable[string] `yaml:"comment,omitempty"`
+		Port    Nullable[int]    `yaml:"port,omitempty"`
+	}
+	in := config{Name: NewNullableWithValue("api"), Comment: NewNullNullable[string]()}
+	data, err := yaml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, "name: api\ncomment: null\n", string(data))
+
+	var out config
+	require.NoError(t, yaml.Unmarshal([]byte("name: api\nport: 8080\n"), &out))
+	assert.Equal(t, "api", out.Name.MustGet())
+	assert.Equal(t, 8080, out.Port.MustGet())
+	assert.False(t, out.Comment.IsSpecified())
+	assert.Error(t, yaml.Unmarshal([]byte("port: eighty\n"), &out))
+}
+
+func TestNullable_IsZero(t *testing.T) {
+	var unspecified Nullable[string]
+	assert.True(t, unspecified.IsZero())
+	assert.False(t, NewNullNullable[string]().IsZero())
+	assert.False(t, NewNullableWithValue("").IsZero())
+
+	type patch struct {
+		Name Nullable[string] `json:"name,omitzero"`
+	}
+	data, err := json.Marshal(patch{})
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+	data, err = json.Marshal(patch{Name: NewNullNullable[string]()})
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":null}`, string(data))
+}
+
+func TestNullable_Ptr(t *testing.T) {
+	s := "hello"
+	n := NullableFromPtr(&s)
+	assert.Equal(t, "hello", n.MustGet())
+	p := n.Ptr()
+	require.NotNil(t, p)
+	assert.Equal(t, "hello", *p)
+	*p = "changed"
+	assert.Equal(t, "hello", n.MustGet())
+
+	null := NullableFromPtr[string](nil)
+	assert.True(t, null.IsNull())
+	assert.Nil(t, null.Ptr())
+
+	var unspecified Nullable[string]
+	assert.Nil(t, unspecified.Ptr())
+}
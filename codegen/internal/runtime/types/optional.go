@@ -0,0 +1,112 @@
+package types
+
+//oapi-runtime:function types/Optional
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Optional holds a value which may be absent, for optional fields which
+// can't be null. Unlike a pointer, it's set without taking an address, and
+// unlike Nullable, it has no null state: JSON null unmarshals as absent.
+//
+// Tag Optional fields with omitzero, so that absent ones are omitted.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// NewOptional returns an Optional holding value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Get returns the value, and whether it's set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// MustGet returns the value, or panics if it isn't set.
+func (o Optional[T]) MustGet() T {
+	if !o.set {
+		panic(ErrOptionalNotSet)
+	}
+	return o.value
+}
+
+// GetOr returns the value if it's set, or else def.
+func (o Optional[T]) GetOr(def T) T {
+	if !o.set {
+		return def
+	}
+	return o.value
+}
+
+// Set assigns a value.
+func (o *Optional[T]) Set(value T) {
+	*o = Optional[T]{value: value, set: true}
+}
+
+// Unset clears the value, as if it was never set.
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// IsSet returns true if a value is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsZero reports whether the value isn't set, so fields tagged omitzero are
+// omitted then.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON implements json.Marshaler. An absent value, which omitzero
+// should have omitted, is written as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null leaves the value absent,
+// as it leaves a pointer nil.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2.
+func (o Optional[T]) MarshalYAML() (any, error) {
+	if !o.set {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too.
+func (o *Optional[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// ErrOptionalNotSet is returned when trying to get a value from an Optional
+// which isn't set.
+var ErrOptionalNotSet = errors.New("optional value is not set")
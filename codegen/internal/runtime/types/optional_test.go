@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestOptional(t *testing.T) {
+	var o Optional[int]
+	_, ok := o.Get()
+	assert.False(t, ok)
+	assert.True(t, o.IsZero())
+	assert.Equal(t, 7, o.GetOr(7))
+	assert.PanicsWithValue(t, ErrOptionalNotSet, func() { o.MustGet() })
+
+	o.Set(0)
+	v, ok := o.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	assert.False(t, o.IsZero(), "a zero value that is set isn't zero")
+
+	o.Unset()
+	assert.False(t, o.IsSet())
+	assert.Equal(t, "x", NewOptional("x").MustGet())
+}
+
+func TestOptional_JSON(t *testing.T) {
+	type patch struct {
+		Name Optional[string] `json:"name,omitzero"`
+		Age  Optional[int]    `json:"age,omitzero"`
+	}
+	data, err := json.Marshal(patch{Age: NewOptional(0)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"age":0}`, string(data))
+
+	var p patch
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"ada","age":null}`), &p))
+	assert.Equal(t, "ada", p.Name.MustGet())
+	assert.False(t, p.Age.IsSet())
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"old"}`), &p))
+}
+
+func TestOptional_YAML(t *testing.T) {
+	type patch struct {
+		Name Optional[string] `yaml:"name,omitempty"`
+		Age  Optional[int]    `yaml:"age,omitempty"`
+	}
+	data, err := yaml.Marshal(patch{Age: NewOptional(3)})
+	require.NoError(t, err)
+	assert.Equal(t, "age: 3\n", string(data))
+
+	var p patch
+	require.NoError(t, yaml.Unmarshal([]byte("name: ada\n"), &p))
+	assert.Equal(t, "ada", p.Name.MustGet())
+	assert.False(t, p.Age.IsSet())
+}
@@ -0,0 +1,133 @@
+package types
+
+//oapi-runtime:function types/Set
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// ErrDuplicateSetItem is the sentinel error wrapped by the errors of
+// Set.UnmarshalJSON when an array holds an item twice.
+var ErrDuplicateSetItem = errors.New("set: duplicate item")
+
+// Set is an ordered set of unique items, for arrays with uniqueItems: true.
+// Items keep the order they're added in, and marshal to a JSON array in that
+// order. Unmarshaling rejects arrays which hold an item twice. The zero value
+// is an empty set, ready to use.
+type Set[T comparable] struct {
+	items []T
+	index map[T]struct{}
+}
+
+// NewSet returns a Set of items, skipping those already added.
+func NewSet[T comparable](items ...T) Set[T] {
+	var s Set[T]
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds item unless the set has it already, and reports whether it did.
+func (s *Set[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+	return true
+}
+
+// Remove removes item, and reports whether the set had it.
+func (s *Set[T]) Remove(item T) bool {
+	if !s.Has(item) {
+		return false
+	}
+	delete(s.index, item)
+	s.items = slices.DeleteFunc(s.items, func(v T) bool { return v == item })
+	return true
+}
+
+// Has reports whether the set holds item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of the items, in order.
+func (s Set[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// All returns an iterator over the items, in order.
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// IsZero reports whether the set is empty, so fields tagged omitzero are
+// omitted then.
+func (s Set[T]) IsZero() bool {
+	return len(s.items) == 0
+}
+
+// MarshalJSON implements json.Marshaler, writing the items as an array, [] when
+// there are none.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an array of unique items.
+// null leaves the set empty.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// setItems replaces the items of s with items, unless they hold duplicates.
+func (s *Set[T]) setItems(items []T) error {
+	var set Set[T]
+	for i, item := range items {
+		if !set.Add(item) {
+			return fmt.Errorf("%w at index %d: %v", ErrDuplicateSetItem, i, item)
+		}
+	}
+	*s = set
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, writing
+// the items as a sequence.
+func (s Set[T]) MarshalYAML() (any, error) {
+	if s.items == nil {
+		return []T{}, nil
+	}
+	return s.items, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, rejecting duplicates like UnmarshalJSON.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSet_Order(t *testing.T) {
+	s := NewSet("b", "a", "b", "c")
+	assert.Equal(t, []string{"b", "a", "c"}, s.Items())
+	assert.Equal(t, 3, s.Len())
+
+	assert.False(t, s.Add("a"))
+	assert.True(t, s.Add("d"))
+	assert.True(t, s.Remove("a"))
+	assert.False(t, s.Remove("a"))
+	assert.False(t, s.Has("a"))
+	assert.True(t, s.Has("d"))
+	assert.Equal(t, []string{"b", "c", "d"}, slices.Collect(s.All()))
+}
+
+func TestSet_ZeroValue(t *testing.T) {
+	var s Set[int]
+	assert.True(t, s.IsZero())
+	assert.False(t, s.Has(1))
+	assert.False(t, s.Remove(1))
+	assert.True(t, s.Add(1))
+	assert.False(t, s.IsZero())
+}
+
+func TestSet_JSON(t *testing.T) {
+	type tagged struct {
+		Tags Set[string] `json:"tags"`
+		IDs  Set[int]    `json:"ids,omitzero"`
+	}
+	data, err := json.Marshal(tagged{Tags: NewSet("x", "y")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["x","y"]}`, string(data))
+
+	data, err = json.Marshal(tagged{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":[]}`, string(data))
+
+	var out tagged
+	require.NoError(t, json.Unmarshal([]byte(`{"tags":["y","x"],"ids":[3,1]}`), &out))
+	assert.Equal(t, []string{"y", "x"}, out.Tags.Items())
+	assert.Equal(t, []int{3, 1}, out.IDs.Items())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"tags":null}`), &out))
+	assert.Zero(t, out.Tags.Len())
+}
+
+func TestSet_UnmarshalDuplicate(t *testing.T) {
+	s := NewSet("kept")
+	err := json.Unmarshal([]byte(`["x","y","x"]`), &s)
+	require.ErrorIs(t, err, ErrDuplicateSetItem)
+	assert.Contains(t, err.Error(), "index 2")
+	assert.Equal(t, []string{"kept"}, s.Items())
+}
+
+func TestSet_YAML(t *testing.T) {
+	data, err := yaml.Marshal(NewSet("x", "y"))
+	require.NoError(t, err)
+	assert.Equal(t, "- x\n- \"y\"\n", string(data))
+
+	var s Set[string]
+	require.NoError(t, yaml.Unmarshal(data, &s))
+	assert.Equal(t, []string{"x", "y"}, s.Items())
+	assert.ErrorIs(t, yaml.Unmarshal([]byte("[a, a]"), &s), ErrDuplicateSetItem)
+}
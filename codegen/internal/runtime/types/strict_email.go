@@ -0,0 +1,80 @@
+package types
+
+//oapi-runtime:function types/StrictEmail
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// ErrValidationStrictEmail is the sentinel error wrapped by the errors of
+// StrictEmail when it isn't a valid address.
+var ErrValidationStrictEmail = errors.New("email: invalid address")
+
+// StrictEmail is an email address validated with net/mail, as an RFC 5322
+// addr-spec, such as "gopher@example.com", when it's marshaled or
+// unmarshaled, from JSON, YAML or parameters. Unlike Email, it rejects what
+// net/mail can't parse, and addresses with a display name or angle brackets.
+// Validation errors wrap ErrValidationStrictEmail.
+type StrictEmail string
+
+// Validate returns an error wrapping ErrValidationStrictEmail if e isn't a
+// bare email address.
+func (e StrictEmail) Validate() error {
+	s := string(e)
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return fmt.Errorf("%w %q: %v", ErrValidationStrictEmail, s, err)
+	}
+	// net/mail also accepts "Name <address>", "<address>" and surrounding
+	// spaces, which aren't bare addresses.
+	if addr.Name != "" || strings.HasSuffix(s, ">") || strings.TrimSpace(s) != s {
+		return fmt.Errorf("%w %q: not a bare address", ErrValidationStrictEmail, s)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, used for both JSON and
+// parameters.
+func (e StrictEmail) MarshalText() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for both JSON and
+// parameters.
+func (e *StrictEmail) UnmarshalText(text []byte) error {
+	if err := StrictEmail(text).Validate(); err != nil {
+		return err
+	}
+	*e = StrictEmail(text)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, with the
+// validation of MarshalText.
+func (e StrictEmail) MarshalYAML() (any, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return string(e), nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, with the validation of UnmarshalText.
+func (e *StrictEmail) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// String returns e unchanged.
+func (e StrictEmail) String() string {
+	return string(e)
+}
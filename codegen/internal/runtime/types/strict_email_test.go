@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStrictEmail_JSON(t *testing.T) {
+	type user struct {
+		Email StrictEmail `json:"email" yaml:"email"`
+	}
+	in := user{Email: "gopher@example.com"}
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"email":"gopher@example.com"}`, string(data))
+
+	var out user
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+
+	data, err = yaml.Marshal(in)
+	require.NoError(t, err)
+	out = user{}
+	require.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestStrictEmail_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"gopher",
+		"gopher@",
+		"@example.com",
+		"gopher@@example.com",
+		"Gopher <gopher@example.com>",
+		"<gopher@example.com>",
+		" gopher@example.com",
+		"gopher@example.com, other@example.com",
+	} {
+		var e StrictEmail
+		assert.ErrorIs(t, e.UnmarshalText([]byte(s)), ErrValidationStrictEmail, s)
+		assert.ErrorIs(t, json.Unmarshal([]byte(`"`+s+`"`), &e), ErrValidationStrictEmail, s)
+		_, err := json.Marshal(StrictEmail(s))
+		assert.ErrorIs(t, err, ErrValidationStrictEmail, s)
+		assert.Error(t, yaml.Unmarshal([]byte(`"`+s+`"`), &e), s)
+	}
+}
+
+func TestStrictEmail_QuotedLocalPart(t *testing.T) {
+	var e StrictEmail
+	require.NoError(t, e.UnmarshalText([]byte(`"john doe"@example.com`)))
+	assert.Equal(t, `"john doe"@example.com`, e.String())
+}
@@ -0,0 +1,77 @@
+package types
+
+//oapi-runtime:function types/Time
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TimeFormat is the RFC 3339 full-time layout of format: time, a time of day
+// with its offset from UTC. Fractional seconds are accepted when parsing.
+const TimeFormat = "15:04:05Z07:00"
+
+// timeFormatFraction formats fractional seconds, when there are any.
+const timeFormatFraction = "15:04:05.999999999Z07:00"
+
+// Time is a time of day, such as 14:30:00+02:00, the format of strings with
+// format: time. Its date is January 1st of year 0.
+type Time struct {
+	time.Time
+}
+
+// ParseTime parses s as an RFC 3339 full-time, such as 14:30:00Z or
+// 08:15:30.5-05:00.
+func ParseTime(s string) (Time, error) {
+	parsed, err := time.Parse(TimeFormat, s)
+	if err != nil {
+		return Time{}, err
+	}
+	return Time{Time: parsed}, nil
+}
+
+// MustParseTime is ParseTime, panicking if s isn't a time of day. It
+// initializes the defaults of Time fields.
+func MustParseTime(s string) Time {
+	t, err := ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var timeStr string
+	err := json.Unmarshal(data, &timeStr)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(timeStr))
+}
+
+func (t Time) String() string {
+	return t.Format(timeFormatFraction)
+}
+
+func (t *Time) UnmarshalText(data []byte) error {
+	parsed, err := ParseTime(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for Time.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Format returns the time formatted according to layout.
+func (t Time) Format(layout string) string {
+	return t.Time.Format(layout)
+}
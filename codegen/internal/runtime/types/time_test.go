@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTime_MarshalJSON(t *testing.T) {
+	b := struct {
+		TimeField Time `json:"time"`
+	}{
+		TimeField: Time{time.Date(0, 1, 1, 14, 30, 5, 0, time.FixedZone("", 2*60*60))},
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"time":"14:30:05+02:00"}`, string(jsonBytes))
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		TimeField Time `json:"time"`
+	}{}
+	err := json.Unmarshal([]byte(`{"time":"08:15:30.5-05:00"}`), &b)
+	require.NoError(t, err)
+	assert.Equal(t, 8, b.TimeField.Hour())
+	assert.Equal(t, 500*time.Millisecond, time.Duration(b.TimeField.Nanosecond()))
+	_, offset := b.TimeField.Zone()
+	assert.Equal(t, -5*60*60, offset)
+	assert.Equal(t, "08:15:30.5-05:00", b.TimeField.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"time":"08:15:30"}`), &b), "the offset is required")
+	assert.Error(t, json.Unmarshal([]byte(`{"time":"25:00:00Z"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"time":830}`), &b))
+}
+
+func TestTime_Stringer(t *testing.T) {
+	tm := MustParseTime("23:59:59Z")
+	assert.Equal(t, "23:59:59Z", fmt.Sprintf("%v", tm))
+	assert.Equal(t, "23:59:59Z", fmt.Sprintf("%v", &tm))
+}
+
+func TestTime_Text(t *testing.T) {
+	var tm Time
+	require.NoError(t, tm.UnmarshalText([]byte("00:00:00+00:00")))
+	text, err := tm.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:00Z", string(text))
+	assert.Error(t, tm.UnmarshalText([]byte("noon")))
+	assert.Panics(t, func() { MustParseTime("noon") })
+}
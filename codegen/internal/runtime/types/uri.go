@@ -0,0 +1,87 @@
+package types
+
+//oapi-runtime:function types/URI
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidURI is returned when a string isn't a URI reference.
+var ErrInvalidURI = errors.New("uri: invalid URI")
+
+// URI is a URI, or a relative URI reference, the format of strings with
+// format: uri and uri-reference. It wraps a url.URL, so its parts are at
+// hand, and is validated when unmarshaled. The zero URI is the empty
+// reference, "".
+type URI struct {
+	url.URL
+}
+
+// ParseURI parses s as a URI reference. Unlike url.Parse, it rejects
+// whitespace, which RFC 3986 doesn't allow in URIs.
+func ParseURI(s string) (URI, error) {
+	if strings.ContainsAny(s, " \t\r\n") {
+		return URI{}, fmt.Errorf("%w: %q: contains whitespace", ErrInvalidURI, s)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("%w: %q: %w", ErrInvalidURI, s, err)
+	}
+	return URI{URL: *u}, nil
+}
+
+// MustParseURI is ParseURI, panicking if s isn't a URI reference. It
+// initializes the defaults of URI fields.
+func MustParseURI(s string) URI {
+	u, err := ParseURI(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// NewURI returns the URI of u.
+func NewURI(u *url.URL) URI {
+	return URI{URL: *u}
+}
+
+// String reassembles u into a URI string.
+func (u URI) String() string {
+	return u.URL.String()
+}
+
+func (u URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON parses a JSON string as a URI; null leaves u unchanged.
+func (u *URI) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for URI.
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for URI.
+func (u *URI) UnmarshalText(data []byte) error {
+	parsed, err := ParseURI(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURI(t *testing.T) {
+	u, err := ParseURI("https://user@example.com:8443/pets/1?fields=name#top")
+	require.NoError(t, err)
+	assert.Equal(t, "https", u.Scheme)
+	assert.Equal(t, "example.com", u.Hostname())
+	assert.Equal(t, "/pets/1", u.Path)
+	assert.Equal(t, "name", u.Query().Get("fields"))
+	assert.True(t, u.IsAbs())
+	assert.Equal(t, "https://user@example.com:8443/pets/1?fields=name#top", u.String())
+
+	for _, in := range []string{"/pets/1", "../pets?x=1", "#top", "urn:isbn:0451450523", ""} {
+		t.Run(in, func(t *testing.T) {
+			u, err := ParseURI(in)
+			require.NoError(t, err)
+			assert.Equal(t, in, u.String())
+		})
+	}
+}
+
+func TestMustParseURI(t *testing.T) {
+	assert.Equal(t, "about:blank", MustParseURI("about:blank").String())
+	assert.Panics(t, func() { MustParseURI("not a uri") })
+}
+
+func TestParseURI_Invalid(t *testing.T) {
+	for _, in := range []string{"https://example.com/a b", "http://[::1", "/pets/%zz", "https://exa\nmple.com"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseURI(in)
+			assert.ErrorIs(t, err, ErrInvalidURI)
+		})
+	}
+}
+
+func TestURI_JSON(t *testing.T) {
+	type payload struct {
+		Link     URI  `json:"link"`
+		Optional *URI `json:"optional,omitempty"`
+	}
+	link, err := url.Parse("https://example.com/pets?limit=10")
+	require.NoError(t, err)
+	data, err := json.Marshal(payload{Link: NewURI(link)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"link":"https://example.com/pets?limit=10"}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"link":"https://example.com/pets?limit=10","optional":"/pets"}`), &decoded))
+	assert.Equal(t, "example.com", decoded.Link.Host)
+	require.NotNil(t, decoded.Optional)
+	assert.Equal(t, "/pets", decoded.Optional.Path)
+
+	err = json.Unmarshal([]byte(`{"link":"not a uri"}`), &decoded)
+	assert.ErrorIs(t, err, ErrInvalidURI)
+	err = json.Unmarshal([]byte(`{"link":42}`), &decoded)
+	assert.Error(t, err)
+}
+
+func TestURI_Text(t *testing.T) {
+	var u URI
+	require.NoError(t, u.UnmarshalText([]byte("mailto:pets@example.com")))
+	assert.Equal(t, "mailto", u.Scheme)
+	text, err := u.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "mailto:pets@example.com", string(text))
+}
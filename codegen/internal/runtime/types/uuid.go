@@ -2,6 +2,9 @@ package types
 
 //oapi-runtime:function types/UUID
 
-import "github.com/google/uuid"
+// The import is aliased so that generated code mapping format: uuid to
+// another library's uuid package, such as github.com/gofrs/uuid, doesn't
+// clash with it.
+import googleuuid "github.com/google/uuid"
 
-type UUID = uuid.UUID
+type UUID = googleuuid.UUID
@@ -0,0 +1,60 @@
+package types
+
+//oapi-runtime:function types/UUIDString
+
+import "errors"
+
+// ErrValidationUUID is the sentinel error returned when a UUIDString isn't in
+// the canonical 8-4-4-4-12 hexadecimal form.
+var ErrValidationUUID = errors.New("uuid: invalid format")
+
+// UUIDString is a UUID kept as its string form, for code which would rather
+// not depend on a UUID library. It must be in the canonical 8-4-4-4-12
+// hexadecimal form, such as "9cb14230-b640-11ec-b909-0242ac120002", to be
+// marshaled or unmarshaled.
+type UUIDString string
+
+// Validate returns ErrValidationUUID if u isn't a canonical UUID.
+func (u UUIDString) Validate() error {
+	if len(u) != 36 {
+		return ErrValidationUUID
+	}
+	for i := 0; i < len(u); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if u[i] != '-' {
+				return ErrValidationUUID
+			}
+		default:
+			c := u[i]
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return ErrValidationUUID
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, used for both JSON and
+// parameters.
+func (u UUIDString) MarshalText() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for both JSON and
+// parameters.
+func (u *UUIDString) UnmarshalText(text []byte) error {
+	if err := UUIDString(text).Validate(); err != nil {
+		return err
+	}
+	*u = UUIDString(text)
+	return nil
+}
+
+// String returns u unchanged.
+func (u UUIDString) String() string {
+	return string(u)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDString_JSON(t *testing.T) {
+	type resource struct {
+		ID UUIDString `json:"id"`
+	}
+	in := resource{ID: "9cb14230-b640-11ec-b909-0242ac120002"}
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"9cb14230-b640-11ec-b909-0242ac120002"}`, string(data))
+
+	var out resource
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestUUIDString_Invalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"this-is-not-a-uuid",
+		"9cb14230b64011ecb9090242ac120002",
+		"9cb14230-b640-11ec-b909-0242ac12000g",
+		"{9cb14230-b640-11ec-b909-0242ac120002}",
+	} {
+		var u UUIDString
+		assert.ErrorIs(t, u.UnmarshalText([]byte(s)), ErrValidationUUID, s)
+		_, err := json.Marshal(UUIDString(s))
+		assert.Error(t, err, s)
+	}
+}
+
+func TestUUIDString_UpperCase(t *testing.T) {
+	var u UUIDString
+	require.NoError(t, u.UnmarshalText([]byte("9CB14230-B640-11EC-B909-0242AC120002")))
+	assert.Equal(t, "9CB14230-B640-11EC-B909-0242AC120002", u.String())
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestUUID_MarshalJSON_Zero(t *testing.T) {
@@ -51,3 +53,19 @@ func TestUUID_UnmarshalJSON_Pass(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testUUID, b.UUIDField)
 }
+
+func TestUUID_YAML(t *testing.T) {
+	// UUID round-trips through the text marshaling of uuid.UUID.
+	type resource struct {
+		ID UUID `yaml:"id"`
+	}
+	in := resource{ID: uuid.MustParse("9cb14230-b640-11ec-b909-0242ac120002")}
+	data, err := yaml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, "id: 9cb14230-b640-11ec-b909-0242ac120002\n", string(data))
+
+	var out resource
+	require.NoError(t, yaml.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+	assert.Error(t, yaml.Unmarshal([]byte("id: not-a-uuid\n"), &out))
+}
@@ -25,6 +25,17 @@ func TestGenerateRuntime(t *testing.T) {
 		assert.True(t, strings.HasPrefix(code, "// Code generated"))
 	})
 
+	t.Run("types for encoding/json/v2", func(t *testing.T) {
+		code := rt.TypesJSONv2
+		require.NotEmpty(t, code)
+
+		assert.True(t, strings.HasPrefix(code, "// Code generated"))
+		assert.Contains(t, code, "\n//go:build go1.27 && goexperiment.jsonv2\n\npackage types")
+		assert.Contains(t, code, "func (n Nullable[T]) MarshalJSONTo(")
+		assert.Contains(t, code, "func (d *Date) UnmarshalJSONFrom(")
+		assert.NotContains(t, rt.Types, "MarshalJSONTo")
+	})
+
 	t.Run("params", func(t *testing.T) {
 		code := rt.Params
 		require.NotEmpty(t, code)
@@ -54,6 +65,17 @@ func TestGenerateRuntime(t *testing.T) {
 		assert.Contains(t, code, "func MarshalForm(")
 		assert.True(t, strings.HasPrefix(code, "// Code generated"))
 	})
+
+	t.Run("jsonpointer", func(t *testing.T) {
+		code := rt.JSONPointer
+		require.NotEmpty(t, code)
+
+		assert.Contains(t, code, "package jsonpointer")
+		assert.Contains(t, code, "func DecodeJSON(")
+		assert.Contains(t, code, "type DecodeError struct")
+		assert.Contains(t, code, "func PointerAtOffset(")
+		assert.True(t, strings.HasPrefix(code, "// Code generated"))
+	})
 }
 
 func TestGenerateRuntimeEmptyPath(t *testing.T) {
@@ -5,12 +5,15 @@ package runtimeextract
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"go/ast"
 	"go/parser"
 	"go/token"
 	"io/fs"
 	"path"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -26,15 +29,17 @@ type Import struct {
 // generator to rewrite them to the target base path.
 const RuntimeModulePrefix = "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/"
 
-// typesQualifierRe matches "types." followed by a Go identifier, used to
-// strip the package qualifier when inlining runtime code.
-var typesQualifierRe = regexp.MustCompile(`\btypes\.([A-Za-z_]\w*)`)
+// JSONv2Constraint is the build constraint of runtime files implementing the
+// interfaces of encoding/json/v2, which Go 1.27 has with GOEXPERIMENT=jsonv2,
+// on by default.
+const JSONv2Constraint = "go1.27 && goexperiment.jsonv2"
 
 // ExtractPackage reads all .go files from a sub-directory of the given FS
-// that contain an //oapi-runtime:function annotation and returns the
-// concatenated code bodies and merged imports. No qualifier substitution
-// is performed — the output is suitable for a standalone runtime package.
-func ExtractPackage(fsys fs.FS, dir string) (code string, imports []Import, err error) {
+// that contain an //oapi-runtime:function annotation and have the //go:build
+// constraint given, none when it's empty, and returns the concatenated code
+// bodies and merged imports. No qualifier substitution is performed — the
+// output is suitable for a standalone runtime package.
+func ExtractPackage(fsys fs.FS, dir, constraint string) (code string, imports []Import, err error) {
 	entries, err := fs.ReadDir(fsys, dir)
 	if err != nil {
 		return "", nil, fmt.Errorf("reading directory %s: %w", dir, err)
@@ -64,6 +69,9 @@ func ExtractPackage(fsys fs.FS, dir string) (code string, imports []Import, err
 		if !strings.Contains(content, "//oapi-runtime:function ") {
 			continue
 		}
+		if buildConstraint(content) != constraint {
+			continue
+		}
 
 		fileImports, body, err := parseGoFile(filePath, data)
 		if err != nil {
@@ -90,18 +98,26 @@ func ExtractPackage(fsys fs.FS, dir string) (code string, imports []Import, err
 	return strings.Join(codeParts, "\n"), imports, nil
 }
 
-// ExtractAllInline reads ALL runtime .go files (types/*, params/*, helpers/*),
+// ExtractAllInline reads ALL runtime .go files (types/*, params/*, helpers/*,
+// jsonpointer/*),
 // strips package qualifiers for inlining (types.Date → Date, etc.), merges
 // imports, and returns a single code body ready to be inserted into a
 // generated file. Internal runtime import paths are removed from the import
 // list.
 //
+// Files with a //go:build constraint are only included when it's among
+// constraints, as the generated file has no constraint of its own. Files
+// annotated //oapi-runtime:standalone, such as deprecated aliases of
+// functions of another sub-package, are left out, as their names would clash
+// once everything is in one package.
+//
 // The returned code is wrapped in marker comments so the DCE pass can
 // identify which declarations are runtime candidates.
-func ExtractAllInline(fsys fs.FS) (code string, imports []Import, err error) {
+func ExtractAllInline(fsys fs.FS, constraints ...string) (code string, imports []Import, err error) {
 	// Order matters: types first (they define types used by params), then
-	// params, then helpers. This ensures declarations appear before use.
-	dirs := []string{"types", "params", "helpers"}
+	// params, then helpers and jsonpointer. This ensures declarations appear
+	// before use.
+	dirs := []string{"types", "params", "helpers", "jsonpointer"}
 
 	importSet := make(map[Import]bool)
 	var codeParts []string
@@ -132,14 +148,23 @@ func ExtractAllInline(fsys fs.FS) (code string, imports []Import, err error) {
 			if !strings.Contains(content, "//oapi-runtime:function ") {
 				continue
 			}
+			if c := buildConstraint(content); c != "" && !slices.Contains(constraints, c) {
+				continue
+			}
+			if strings.Contains(content, "//oapi-runtime:standalone") {
+				continue
+			}
 
-			fileImports, body, err := parseGoFile(filePath, data)
+			// Strip runtime package qualifiers for inlining.
+			data, err = dequalifyRuntime(filePath, data, dirs)
 			if err != nil {
 				return "", nil, fmt.Errorf("parsing %s: %w", filePath, err)
 			}
 
-			// Strip type qualifiers for inlining.
-			body = dequalifyTypes(body)
+			fileImports, body, err := parseGoFile(filePath, data)
+			if err != nil {
+				return "", nil, fmt.Errorf("parsing %s: %w", filePath, err)
+			}
 
 			for _, imp := range fileImports {
 				// Skip internal runtime imports — when inlined,
@@ -206,6 +231,21 @@ func parseGoFile(filePath string, data []byte) ([]Import, string, error) {
 	return imports, strings.TrimSpace(body), nil
 }
 
+// buildConstraint returns the expression of the //go:build line of a Go
+// source file, or "" if it has none.
+func buildConstraint(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if expr, ok := strings.CutPrefix(line, "//go:build "); ok {
+			return strings.TrimSpace(expr)
+		}
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return ""
+}
+
 // findBodyStart returns the byte offset where the code body begins,
 // after the package clause and any import declarations.
 func findBodyStart(data []byte) int {
@@ -268,8 +308,64 @@ func stripAnnotations(code string) string {
 	return strings.Join(lines, "\n")
 }
 
-// dequalifyTypes strips the "types." package qualifier from all references,
-// since when inlined everything lives in the same package.
-func dequalifyTypes(code string) string {
-	return typesQualifierRe.ReplaceAllString(code, "$1")
+// dequalifyRuntime strips the qualifiers of the runtime sub-packages a Go
+// source file imports, such as "types." in types.Date, from the references to
+// them, since when inlined everything lives in the same package. Only
+// selectors of the imported packages are rewritten, not those of variables or
+// fields named like them, such as params.Limit of a params argument. Comments
+// are stripped of the qualifiers of packages, the runtime sub-packages.
+func dequalifyRuntime(filePath string, data []byte, packages []string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, data, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeNames := make(map[string]bool)
+	for _, imp := range f.Imports {
+		impPath := strings.Trim(imp.Path.Value, `"`)
+		if !strings.HasPrefix(impPath, RuntimeModulePrefix) {
+			continue
+		}
+		if imp.Name != nil {
+			runtimeNames[imp.Name.Name] = true
+		} else {
+			runtimeNames[path.Base(impPath)] = true
+		}
+	}
+
+	// The parser resolves identifiers declared in the file, so those left
+	// unresolved with the name of a runtime import are its package.
+	var qualifiers [][2]int
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil && runtimeNames[x.Name] {
+			qualifiers = append(qualifiers, [2]int{fset.Position(x.Pos()).Offset, fset.Position(sel.Sel.Pos()).Offset})
+		}
+		return true
+	})
+	// Comments mention the declarations of any sub-package the same way,
+	// such as "types.Date".
+	commentQualifierRe := regexp.MustCompile(`\b(?:` + strings.Join(packages, "|") + `)\.[A-Za-z_]`)
+	for _, group := range f.Comments {
+		for _, c := range group.List {
+			start := fset.Position(c.Pos()).Offset
+			for _, m := range commentQualifierRe.FindAllStringIndex(c.Text, -1) {
+				qualifiers = append(qualifiers, [2]int{start + m[0], start + m[1] - 1})
+			}
+		}
+	}
+	slices.SortFunc(qualifiers, func(a, b [2]int) int { return a[0] - b[0] })
+
+	var b bytes.Buffer
+	last := 0
+	for _, q := range qualifiers {
+		b.Write(data[last:q[0]])
+		last = q[1]
+	}
+	b.Write(data[last:])
+	return b.Bytes(), nil
 }
@@ -0,0 +1,63 @@
+package runtimeextract
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAllInline_Dequalify(t *testing.T) {
+	fsys := fstest.MapFS{
+		"types/date.go": {Data: []byte(`package types
+
+//oapi-runtime:function types/Date
+
+type Date struct{}
+`)},
+		"params/bind.go": {Data: []byte(`package params
+
+//oapi-runtime:function params/BindDate
+
+import "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/types"
+
+// BindDate binds params.Value to a types.Date.
+func BindDate(params struct{ Value string }) (types.Date, string) {
+	return types.Date{}, params.Value
+}
+`)},
+		"params/deprecated.go": {Data: []byte(`package params
+
+//oapi-runtime:function params/OldBindDate
+//oapi-runtime:standalone
+
+func OldBindDate() {}
+`)},
+		"helpers/limit.go": {Data: []byte(`package helpers
+
+//oapi-runtime:function helpers/Limit
+
+import "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/runtime/params"
+
+type options struct{ params struct{ Limit int } }
+
+func Limit(o options) (int, error) {
+	_, _ = params.BindDate(struct{ Value string }{})
+	return o.params.Limit, nil
+}
+`)},
+		"jsonpointer/empty.go": {Data: []byte("package jsonpointer\n")},
+	}
+
+	code, imports, err := ExtractAllInline(fsys)
+	require.NoError(t, err)
+	assert.Empty(t, imports)
+
+	assert.Contains(t, code, "// BindDate binds Value to a Date.")
+	assert.Contains(t, code, "func BindDate(params struct{ Value string }) (Date, string) {")
+	assert.Contains(t, code, "return Date{}, params.Value")
+	assert.Contains(t, code, "_, _ = BindDate(struct{ Value string }{})")
+	assert.Contains(t, code, "return o.params.Limit, nil")
+	assert.NotContains(t, code, "OldBindDate")
+}
@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"path/filepath"
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -85,11 +86,27 @@ type SchemaDescriptor struct {
 	OneOf           []*SchemaDescriptor
 	AdditionalProps *SchemaDescriptor
 
+	// PrefixItems holds the descriptors of the items of a tuple, by
+	// position; nil for items that don't need a generated type.
+	PrefixItems []*SchemaDescriptor
+
 	// ConstOneOfItems holds the extracted items when this schema matches the
 	// OpenAPI 3.1 enum-via-oneOf idiom (type: string|integer + oneOf of
 	// const+title branches). Populated during gather; nil when the idiom does
 	// not apply or detection is disabled. Presence signals KindEnum.
 	ConstOneOfItems []constOneOfItem
+
+	// RequestVariant and ResponseVariant name the variants of a component
+	// struct without its readOnly and writeOnly properties respectively,
+	// generated with generation.request-response-variants. Empty when the
+	// variant isn't generated.
+	RequestVariant  string
+	ResponseVariant string
+
+	// Anonymous is set for inline object schemas which are anonymous
+	// structs, with inline-schemas promote: when-needed. They have no
+	// ShortName, and no type of their own.
+	Anonymous bool
 }
 
 // DiscriminatorInfo holds discriminator metadata extracted from the OpenAPI spec.
@@ -135,6 +152,34 @@ func (d *SchemaDescriptor) ParseExternalRef() (filePath, internalPath string) {
 	return parts[0], "#" + parts[1]
 }
 
+// proxyRef returns the $ref of proxy. An external $ref is relative to the
+// file it's in, which may itself be referenced by the root spec; its file is
+// made relative to the root spec, as import-mapping keys are, when it's been
+// resolved: "../common/types.yaml#/components/schemas/Error" in
+// schemas/pets.yaml is "common/types.yaml#/components/schemas/Error".
+func proxyRef(proxy *base.SchemaProxy) string {
+	ref := proxy.GetReference()
+	file, fragment, ok := strings.Cut(ref, "#")
+	if !ok || file == "" || proxy.GoLow() == nil {
+		return ref
+	}
+	// The index of a resolved reference is that of the file it refers to.
+	idx := proxy.GoLow().GetIndex()
+	if idx == nil || idx.GetRolodex() == nil || idx.GetRolodex().GetRootIndex() == nil {
+		return ref
+	}
+	target := idx.GetSpecAbsolutePath()
+	root := idx.GetRolodex().GetRootIndex().GetSpecAbsolutePath()
+	if target == "" || root == "" || target == root || strings.Contains(target, "://") || strings.Contains(root, "://") {
+		return ref
+	}
+	rel, err := filepath.Rel(filepath.Dir(root), target)
+	if err != nil {
+		return ref
+	}
+	return filepath.ToSlash(rel) + "#" + fragment
+}
+
 // IsComponentSchema returns true if this schema is defined in #/components/schemas
 func (d *SchemaDescriptor) IsComponentSchema() bool {
 	return len(d.Path) >= 2 && d.Path[0] == "components" && d.Path[1] == "schemas"
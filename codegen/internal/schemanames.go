@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"strings"
+	"text/template"
 )
 
 // SchemaContext identifies what kind of schema this is based on its location.
@@ -24,38 +25,110 @@ const (
 	ContextAdditionalProperties
 )
 
+// SchemaNamingOptions configures how ComputeSchemaNames names schemas.
+type SchemaNamingOptions struct {
+	// PreferTitles names schemas declaring a title after it.
+	PreferTitles bool
+	// Collisions configures how colliding names are told apart, and pins
+	// names.
+	Collisions NameCollisions
+	// InlineNameTemplate names the schemas defined in others, when set.
+	InlineNameTemplate *template.Template
+}
+
 // ComputeSchemaNames assigns StableName and ShortName to each schema descriptor.
 // StableName is deterministic from the path; ShortName is a friendly alias.
 // If a schema has a TypeNameOverride extension, that takes precedence over computed names.
-func ComputeSchemaNames(schemas []*SchemaDescriptor, converter *NameConverter, contentTypeNamer *ContentTypeShortNamer) {
+// With PreferTitles, schemas declaring a title are short-named after it instead,
+// and schemas defined in others are named by InlineNameTemplate when set.
+// Names pinned by Collisions take precedence over all of them, and colliding
+// short names are told apart as Collisions says, failing with
+// NameCollisionError. Anonymous schemas get no short names.
+func ComputeSchemaNames(schemas []*SchemaDescriptor, converter *NameConverter, contentTypeNamer *ContentTypeShortNamer, opts SchemaNamingOptions) error {
 	// First: compute stable names from full paths
 	for _, s := range schemas {
 		// Check for TypeNameOverride extension
-		if s.Extensions != nil && s.Extensions.TypeNameOverride != "" {
-			s.StableName = s.Extensions.TypeNameOverride
+		if name := typeNameOverride(s); name != "" {
+			s.StableName = name
 		} else {
 			s.StableName = computeStableName(s.Path, converter)
 		}
 	}
 
-	// Second: generate candidate short names
+	// Second: generate candidate short names, those of parents first as the
+	// name template uses them
 	candidates := make(map[*SchemaDescriptor]string)
-	for _, s := range schemas {
+	var candidate func(s *SchemaDescriptor) (string, error)
+	candidate = func(s *SchemaDescriptor) (string, error) {
+		if name, ok := candidates[s]; ok {
+			return name, nil
+		}
+		var name string
 		// TypeNameOverride also applies to short names
-		if s.Extensions != nil && s.Extensions.TypeNameOverride != "" {
-			candidates[s] = s.Extensions.TypeNameOverride
+		if pinned, ok := opts.Collisions.Pinned[jsonPointer(s.Path)]; ok {
+			name = pinned
+		} else if override := typeNameOverride(s); override != "" {
+			name = override
+		} else if title := schemaTitle(s); opts.PreferTitles && title != "" {
+			name = converter.ToTypeName(title)
 		} else {
-			candidates[s] = generateCandidateName(s, converter, contentTypeNamer)
+			name = generateCandidateName(s, converter, contentTypeNamer)
+			if opts.InlineNameTemplate != nil && s.Parent != nil {
+				parentName, err := candidate(s.Parent)
+				if err != nil {
+					return "", err
+				}
+				if name, err = inlineSchemaName(opts.InlineNameTemplate, s, name, parentName, converter); err != nil {
+					return "", err
+				}
+			}
+		}
+		candidates[s] = name
+		return name, nil
+	}
+	for _, s := range schemas {
+		if _, err := candidate(s); err != nil {
+			return err
 		}
 	}
 
 	// Third: detect collisions and resolve them for short names
-	resolveCollisions(schemas, candidates, converter)
+	if err := resolveCollisions(schemas, candidates, converter, opts.Collisions); err != nil {
+		return err
+	}
 
 	// Assign final short names
 	for _, s := range schemas {
-		s.ShortName = candidates[s]
+		if !s.Anonymous {
+			s.ShortName = candidates[s]
+		}
+	}
+	return nil
+}
+
+// typeNameOverride returns the type name the extensions of a schema set: its
+// TypeNameOverride, or its NameOverride (x-go-name), unless it's the schema
+// of a property, whose field that names instead.
+func typeNameOverride(s *SchemaDescriptor) string {
+	if s.Extensions == nil {
+		return ""
+	}
+	if s.Extensions.TypeNameOverride != "" {
+		return s.Extensions.TypeNameOverride
+	}
+	if n := len(s.Path); n >= 2 && s.Path[n-2] == "properties" {
+		return ""
+	}
+	return s.Extensions.NameOverride
+}
+
+// schemaTitle returns the title of a schema defined at the descriptor's
+// path, or "" for references, whose titles name their targets.
+func schemaTitle(s *SchemaDescriptor) string {
+	if s.IsReference() || s.Schema == nil {
+		return ""
 	}
+	return strings.TrimSpace(s.Schema.Title)
 }
 
 // computeStableName generates a deterministic type name from the full path.
@@ -3,26 +3,49 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
 
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
 	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/templates"
 )
 
 // ServerGenerator generates server code from operation descriptors.
 type ServerGenerator struct {
-	tmpl       *template.Template
-	serverType string
+	tmpl           *template.Template
+	serverType     string
+	requestLogging bool
+	oidcSchemes    []OIDCScheme
+	bearerSchemes  []BearerScheme
+	// responseSpec is the JSON of the responses NewValidatingTestServer
+	// validates against; it is only generated when set.
+	responseSpec string
+	// skipParamTypes leaves out the parameter struct types, which the
+	// client generated in the same package already declares.
+	skipParamTypes bool
+}
+
+// BearerScheme is a security scheme of type http with the bearer scheme.
+type BearerScheme struct {
+	Name    string // Name of the scheme in components/securitySchemes
+	GoName  string // Go identifier derived from Name
+	KeyName string // Unexported name of the context key type of the claims
 }
 
 // NewServerGenerator creates a new server generator for the specified server type.
 // rp holds the package prefixes for runtime sub-packages; all empty when embedded.
-func NewServerGenerator(serverType string, rp RuntimePrefixes) (*ServerGenerator, error) {
+// requestLogging enables generation of RequestLoggingMiddleware.
+func NewServerGenerator(serverType string, rp RuntimePrefixes, requestLogging bool) (*ServerGenerator, error) {
 	if serverType == "" {
 		// No server generation requested
 		return &ServerGenerator{serverType: ""}, nil
 	}
 
-	tmpl := template.New("server").Funcs(templates.Funcs()).Funcs(rp.FuncMap())
+	tmpl := template.New("server").Funcs(templates.Funcs()).Funcs(rp.FuncMap()).Funcs(template.FuncMap{
+		"requestLogging": func() bool { return requestLogging },
+	})
 
 	// Get templates for the specified server type
 	serverTemplates, err := getServerTemplates(serverType)
@@ -40,7 +63,53 @@ func NewServerGenerator(serverType string, rp RuntimePrefixes) (*ServerGenerator
 		return nil, err
 	}
 
-	return &ServerGenerator{tmpl: tmpl, serverType: serverType}, nil
+	return &ServerGenerator{tmpl: tmpl, serverType: serverType, requestLogging: requestLogging}, nil
+}
+
+// SetOIDCSchemes sets the OpenID Connect security schemes authenticators are
+// generated for.
+func (g *ServerGenerator) SetOIDCSchemes(schemes []OIDCScheme) {
+	g.oidcSchemes = schemes
+}
+
+// SetBearerSchemes sets the bearer security schemes JWT authenticators are
+// generated for.
+func (g *ServerGenerator) SetBearerSchemes(schemes []BearerScheme) {
+	g.bearerSchemes = schemes
+}
+
+// SetResponseValidationSpec sets the JSON of the responses of the spec, which
+// NewValidatingTestServer is generated to validate responses against.
+func (g *ServerGenerator) SetResponseValidationSpec(spec string) {
+	g.responseSpec = spec
+}
+
+// SetSkipParamTypes leaves the parameter struct types out of the server code,
+// for when the client generated alongside it declares them.
+func (g *ServerGenerator) SetSkipParamTypes(skip bool) {
+	g.skipParamTypes = skip
+}
+
+// gatherBearerSchemes collects the security schemes of type http with the
+// bearer scheme, in spec order.
+func gatherBearerSchemes(doc *v3.Document) []BearerScheme {
+	var schemes []BearerScheme
+	if doc.Components == nil || doc.Components.SecuritySchemes == nil {
+		return nil
+	}
+	for pair := doc.Components.SecuritySchemes.First(); pair != nil; pair = pair.Next() {
+		scheme := pair.Value()
+		if scheme == nil || scheme.Type != "http" || !strings.EqualFold(scheme.Scheme, "bearer") {
+			continue
+		}
+		goName := ToGoIdentifier(pair.Key())
+		schemes = append(schemes, BearerScheme{
+			Name:    pair.Key(),
+			GoName:  goName,
+			KeyName: LowercaseFirstCharacter(goName) + "ClaimsKey",
+		})
+	}
+	return schemes
 }
 
 // getServerTemplates returns the templates for the specified server type.
@@ -97,6 +166,15 @@ func (g *ServerGenerator) GenerateWrapper(ops []*OperationDescriptor) (string, e
 	return buf.String(), nil
 }
 
+// GenerateRequestLogging generates RequestLoggingMiddleware.
+func (g *ServerGenerator) GenerateRequestLogging() (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "request_logging", nil); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // GenerateErrors generates the error types.
 func (g *ServerGenerator) GenerateErrors() (string, error) {
 	var buf bytes.Buffer
@@ -115,6 +193,61 @@ func (g *ServerGenerator) GenerateParamTypes(ops []*OperationDescriptor) (string
 	return buf.String(), nil
 }
 
+// GenerateFormBinders generates binder functions for form and multipart
+// request bodies.
+func (g *ServerGenerator) GenerateFormBinders(ops []*OperationDescriptor) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "form_binders", ops); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateEventWriters generates EventWriter constructors for operations
+// streaming server-sent events.
+func (g *ServerGenerator) GenerateEventWriters(ops []*OperationDescriptor) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "event_writers", ops); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateOIDCAuthenticators generates OIDCAuthenticator constructors for
+// OpenID Connect security schemes.
+func (g *ServerGenerator) GenerateOIDCAuthenticators(schemes []OIDCScheme) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "oidc_authenticators", schemes); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateJWTAuthenticators generates JWTAuthenticator constructors and
+// claims accessors for bearer security schemes.
+func (g *ServerGenerator) GenerateJWTAuthenticators(schemes []BearerScheme) (string, error) {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "jwt_authenticators", schemes); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// GenerateValidatingTestServer generates NewValidatingTestServer, embedding
+// spec, the JSON of the responses it validates against.
+func (g *ServerGenerator) GenerateValidatingTestServer(spec string) (string, error) {
+	// Raw strings keep the embedded JSON readable, unless it holds backquotes.
+	literal := "`" + spec + "`"
+	if strings.Contains(spec, "`") {
+		literal = strconv.Quote(spec)
+	}
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, "test_server", literal); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 // GenerateServer generates all server code components.
 // Returns empty string if no server type was configured.
 func (g *ServerGenerator) GenerateServer(ops []*OperationDescriptor) (string, error) {
@@ -133,12 +266,28 @@ func (g *ServerGenerator) GenerateServer(ops []*OperationDescriptor) (string, er
 	buf.WriteString("\n")
 
 	// Generate param types
-	paramTypes, err := g.GenerateParamTypes(ops)
+	if !g.skipParamTypes {
+		paramTypes, err := g.GenerateParamTypes(ops)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(paramTypes)
+		buf.WriteString("\n")
+	}
+
+	// Generate form body binders
+	formBinders, err := g.GenerateFormBinders(ops)
 	if err != nil {
 		return "", err
 	}
-	buf.WriteString(paramTypes)
-	buf.WriteString("\n")
+	buf.WriteString(formBinders)
+
+	// Generate event stream writers
+	eventWriters, err := g.GenerateEventWriters(ops)
+	if err != nil {
+		return "", err
+	}
+	buf.WriteString(eventWriters)
 
 	// Generate wrapper
 	wrapper, err := g.GenerateWrapper(ops)
@@ -156,6 +305,43 @@ func (g *ServerGenerator) GenerateServer(ops []*OperationDescriptor) (string, er
 	buf.WriteString(handler)
 	buf.WriteString("\n")
 
+	// Generate OpenID Connect authenticators
+	if len(g.oidcSchemes) > 0 {
+		authenticators, err := g.GenerateOIDCAuthenticators(g.oidcSchemes)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(authenticators)
+	}
+
+	// Generate JWT authenticators of bearer schemes
+	if len(g.bearerSchemes) > 0 {
+		authenticators, err := g.GenerateJWTAuthenticators(g.bearerSchemes)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(authenticators)
+	}
+
+	// Generate the validating test server
+	if g.responseSpec != "" {
+		testServer, err := g.GenerateValidatingTestServer(g.responseSpec)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(testServer)
+	}
+
+	// Generate request logging middleware
+	if g.requestLogging {
+		requestLogging, err := g.GenerateRequestLogging()
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(requestLogging)
+		buf.WriteString("\n")
+	}
+
 	// Generate errors
 	errors, err := g.GenerateErrors()
 	if err != nil {
@@ -66,3 +66,89 @@ func TestSkipExternalRefResolution(t *testing.T) {
 
 	t.Logf("Generated code:\n%s", code)
 }
+
+// TestSkipExternalRefResolution_Unmapped verifies that external $refs match
+// the import mapping by file location, however the path is spelled, and that
+// files missing from it are warned about once.
+func TestSkipExternalRefResolution_Unmapped(t *testing.T) {
+	spec := `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    Container:
+      type: object
+      properties:
+        a:
+          $ref: packagea/spec.yaml#/components/schemas/ObjectA
+        b:
+          $ref: ./packagea/../packageb/spec.yaml#/components/schemas/ObjectB
+        c:
+          $ref: ./other.yaml#/components/schemas/Other
+        d:
+          type: array
+          items:
+            $ref: other.yaml#/components/schemas/Other
+`
+	docConfig := datamodel.NewDocumentConfiguration()
+	docConfig.SkipExternalRefResolution = true
+	doc, err := libopenapi.NewDocumentWithConfiguration([]byte(spec), docConfig)
+	require.NoError(t, err)
+
+	code, diagnostics, err := GenerateWithDiagnostics(doc, []byte(spec), Configuration{
+		PackageName: "api",
+		ImportMapping: map[string]string{
+			"./packagea/spec.yaml": "pkga github.com/org/project/packagea",
+			"./packageb/spec.yaml": "pkgb github.com/org/project/packageb",
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "*pkga.ObjectA")
+	assert.Contains(t, code, "*pkgb.ObjectB")
+	assert.Contains(t, code, "C *any")
+	assert.Contains(t, code, "D []any")
+
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, SeverityWarning, diagnostics[0].Severity)
+	assert.Equal(t, "no import-mapping for ./other.yaml; its schemas are any", diagnostics[0].Message)
+	assert.Equal(t, "#/components/schemas/Container/properties/c", diagnostics[0].Pointer)
+	assert.Equal(t, 15, diagnostics[0].Line)
+}
+
+// TestSkipExternalRefResolution_AllowEmptyValue verifies that a query
+// parameter allowing empty values, whose schema is an unresolved external
+// $ref, is generated rather than dereferenced.
+func TestSkipExternalRefResolution_AllowEmptyValue(t *testing.T) {
+	spec := `openapi: "3.1.0"
+info:
+  title: Test
+  version: "1.0"
+paths:
+  /items:
+    get:
+      operationId: getItems
+      parameters:
+        - name: tag
+          in: query
+          allowEmptyValue: true
+          schema:
+            $ref: "./common.yaml#/components/schemas/Tag"
+      responses:
+        "200":
+          description: ok
+`
+	docConfig := datamodel.NewDocumentConfiguration()
+	docConfig.SkipExternalRefResolution = true
+	doc, err := libopenapi.NewDocumentWithConfiguration([]byte(spec), docConfig)
+	require.NoError(t, err)
+
+	code, err := Generate(doc, nil, Configuration{
+		PackageName:   "api",
+		Generation:    GenerationOptions{Client: true},
+		ImportMapping: map[string]string{"./common.yaml": "github.com/example/common"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type GetItemsParams struct")
+}
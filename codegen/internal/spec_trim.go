@@ -0,0 +1,288 @@
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// specNodeKind tells trimSpec how to read the keys of a spec node.
+type specNodeKind int
+
+const (
+	// specObject is an OpenAPI or JSON Schema object, whose keys are keywords.
+	specObject specNodeKind = iota
+	// specResponse is a Response object, whose description is required.
+	specResponse
+	// specNamedMap maps user-defined names, such as property names or
+	// status codes, to objects. Its keys are never stripped.
+	specNamedMap
+	// specData is a literal value, such as a default or an enum, which is
+	// embedded as is.
+	specData
+)
+
+// specNamedMaps are the keywords whose values map names to objects, with the
+// kind of those objects.
+var specNamedMaps = map[string]specNodeKind{
+	"paths":             specObject,
+	"webhooks":          specObject,
+	"properties":        specObject,
+	"patternProperties": specObject,
+	"dependentSchemas":  specObject,
+	"$defs":             specObject,
+	"definitions":       specObject,
+	"schemas":           specObject,
+	"parameters":        specObject,
+	"requestBodies":     specObject,
+	"headers":           specObject,
+	"securitySchemes":   specObject,
+	"links":             specObject,
+	"pathItems":         specObject,
+	"content":           specObject,
+	"encoding":          specObject,
+	"variables":         specObject,
+	"examples":          specObject,
+	"responses":         specResponse,
+}
+
+// specDataKeywords are the keywords whose values are literal data.
+var specDataKeywords = map[string]bool{
+	"default":  true,
+	"enum":     true,
+	"const":    true,
+	"example":  true,
+	"value":    true,
+	"mapping":  true,
+	"scopes":   true,
+	"security": true,
+}
+
+// trimSpec removes the parts of an OpenAPI spec selected by opts, and
+// re-encodes it as JSON, compact if opts.Minify is set. Response descriptions,
+// which the spec requires, are emptied rather than removed, so the trimmed
+// spec stays valid for request validation.
+func trimSpec(specData []byte, opts EmbeddedSpecOptions) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(specData, &doc); err != nil {
+		return nil, fmt.Errorf("parsing spec: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("parsing spec: empty document")
+	}
+	root := doc.Content[0]
+	trimSpecNode(root, specObject, opts)
+
+	var buf bytes.Buffer
+	if err := writeSpecJSON(&buf, root); err != nil {
+		return nil, err
+	}
+	if opts.Minify {
+		return buf.Bytes(), nil
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+		return nil, fmt.Errorf("indenting spec: %w", err)
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// trimSpecNode strips the keys selected by opts from node, which is read as
+// kind, and from its descendants.
+func trimSpecNode(node *yaml.Node, kind specNodeKind, opts EmbeddedSpecOptions) {
+	if node.Kind == yaml.SequenceNode {
+		for _, item := range node.Content {
+			trimSpecNode(item, kind, opts)
+		}
+		return
+	}
+	if node.Kind != yaml.MappingNode || kind == specData {
+		return
+	}
+
+	if kind == specNamedMap {
+		for i := 1; i < len(node.Content); i += 2 {
+			trimSpecNode(node.Content[i], specObject, opts)
+		}
+		return
+	}
+
+	content := node.Content[:0]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch {
+		case key.Value == "description" && opts.StripDescriptions:
+			if kind != specResponse {
+				continue
+			}
+			value.Kind, value.Tag, value.Value, value.Style, value.Content = yaml.ScalarNode, "!!str", "", 0, nil
+		case (key.Value == "example" || key.Value == "examples") && opts.StripExamples:
+			continue
+		case strings.HasPrefix(key.Value, "x-"):
+			if opts.StripExtensions {
+				continue
+			}
+		case key.Value == "callbacks":
+			// Callbacks map names to maps of expressions to path items.
+			if value.Kind == yaml.MappingNode {
+				for j := 1; j < len(value.Content); j += 2 {
+					trimSpecNode(value.Content[j], specNamedMap, opts)
+				}
+			}
+		case specDataKeywords[key.Value]:
+		case key.Value == "examples" && value.Kind == yaml.SequenceNode:
+			// The examples of a schema are data.
+		default:
+			if child, ok := specNamedMaps[key.Value]; ok && value.Kind == yaml.MappingNode {
+				for j := 1; j < len(value.Content); j += 2 {
+					trimSpecNode(value.Content[j], child, opts)
+				}
+			} else {
+				trimSpecNode(value, specObject, opts)
+			}
+		}
+		content = append(content, key, value)
+	}
+	node.Content = content
+}
+
+// writeSpecJSON writes node as compact JSON, keeping the order of its keys.
+func writeSpecJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return writeSpecJSON(buf, node.Alias)
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err := writeSpecJSON(buf, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeSpecJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	default:
+		if node.Tag == "!!str" || node.Tag == "!!timestamp" || node.Tag == "!!binary" {
+			// Timestamps are kept as written, not reformatted by time.Time.
+			data, err := json.Marshal(node.Value)
+			if err != nil {
+				return err
+			}
+			buf.Write(data)
+			return nil
+		}
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return fmt.Errorf("decoding spec value at line %d: %w", node.Line, err)
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("encoding spec value at line %d: %w", node.Line, err)
+		}
+		buf.Write(data)
+	}
+	return nil
+}
+
+// responseValidationMethods are the keys of path items holding operations.
+var responseValidationMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "delete": true,
+	"options": true, "head": true, "patch": true, "trace": true, "query": true,
+}
+
+// responseValidationSpec returns, as compact JSON, the parts of an OpenAPI
+// spec NewValidatingTestServer checks responses against: the responses of
+// each operation, and the components they can refer to.
+func responseValidationSpec(specData []byte) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(specData, &doc); err != nil {
+		return "", fmt.Errorf("parsing spec: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return "", fmt.Errorf("parsing spec: empty document")
+	}
+	root := doc.Content[0]
+
+	out := &yaml.Node{Kind: yaml.MappingNode}
+	if paths := specMappingValue(root, "paths"); paths != nil {
+		outPaths := &yaml.Node{Kind: yaml.MappingNode}
+		for i := 0; i+1 < len(paths.Content); i += 2 {
+			item := paths.Content[i+1]
+			if item.Kind != yaml.MappingNode {
+				continue
+			}
+			outItem := &yaml.Node{Kind: yaml.MappingNode}
+			for j := 0; j+1 < len(item.Content); j += 2 {
+				method := item.Content[j]
+				if !responseValidationMethods[method.Value] {
+					continue
+				}
+				if responses := specMappingValue(item.Content[j+1], "responses"); responses != nil {
+					outItem.Content = append(outItem.Content, method, &yaml.Node{
+						Kind:    yaml.MappingNode,
+						Content: []*yaml.Node{specKey("responses"), responses},
+					})
+				}
+			}
+			if len(outItem.Content) > 0 {
+				outPaths.Content = append(outPaths.Content, paths.Content[i], outItem)
+			}
+		}
+		out.Content = append(out.Content, specKey("paths"), outPaths)
+	}
+	if components := specMappingValue(root, "components"); components != nil {
+		outComponents := &yaml.Node{Kind: yaml.MappingNode}
+		for _, name := range []string{"schemas", "responses", "headers"} {
+			if section := specMappingValue(components, name); section != nil {
+				outComponents.Content = append(outComponents.Content, specKey(name), section)
+			}
+		}
+		out.Content = append(out.Content, specKey("components"), outComponents)
+	}
+
+	var buf bytes.Buffer
+	if err := writeSpecJSON(&buf, out); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// specMappingValue returns the value of key in the mapping node, if it is a
+// mapping itself.
+func specMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i+1].Kind == yaml.MappingNode {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func specKey(name string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name}
+}
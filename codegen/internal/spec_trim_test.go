@@ -0,0 +1,141 @@
+package codegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const trimSpecInput = `openapi: "3.1.0"
+info:
+  title: Trim
+  version: "1.0"
+  description: A long description.
+  x-logo: logo.png
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      description: Lists pets.
+      x-internal: true
+      parameters:
+        - name: limit
+          in: query
+          description: How many.
+          example: 10
+          schema:
+            type: integer
+            default: 20
+      responses:
+        "200":
+          description: The pets.
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+              examples:
+                rex:
+                  summary: Rex
+                  value: {name: Rex}
+components:
+  schemas:
+    Pet:
+      type: object
+      description: A pet.
+      examples:
+        - name: Tom
+      properties:
+        description:
+          type: string
+          description: What the pet looks like.
+        x-ray:
+          type: string
+        born:
+          type: string
+          example: 2024-01-02
+        kind:
+          type: string
+          enum: [cat, dog]
+          default: {description: kept}
+`
+
+func TestTrimSpec(t *testing.T) {
+	trimmed, err := trimSpec([]byte(trimSpecInput), EmbeddedSpecOptions{
+		StripDescriptions: true,
+		StripExamples:     true,
+		StripExtensions:   true,
+	})
+	require.NoError(t, err)
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(trimmed, &spec))
+	assert.Equal(t, map[string]any{"title": "Trim", "version": "1.0"}, spec["info"])
+
+	get := spec["paths"].(map[string]any)["/pets"].(map[string]any)["get"].(map[string]any)
+	assert.NotContains(t, get, "description")
+	assert.NotContains(t, get, "x-internal")
+	assert.Equal(t, map[string]any{
+		"name": "limit", "in": "query",
+		"schema": map[string]any{"type": "integer", "default": float64(20)},
+	}, get["parameters"].([]any)[0])
+
+	// Response descriptions are required, so they are emptied.
+	ok := get["responses"].(map[string]any)["200"].(map[string]any)
+	assert.Equal(t, "", ok["description"])
+	assert.NotContains(t, ok["content"].(map[string]any)["application/json"], "examples")
+
+	// Names and data which look like keywords are kept.
+	pet := spec["components"].(map[string]any)["schemas"].(map[string]any)["Pet"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "object", "properties": pet["properties"]}, pet)
+	properties := pet["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string"}, properties["description"])
+	assert.Equal(t, map[string]any{"type": "string"}, properties["x-ray"])
+	assert.Equal(t, map[string]any{"description": "kept"}, properties["kind"].(map[string]any)["default"])
+
+	// The trimmed spec still loads.
+	doc, err := libopenapi.NewDocument(trimmed)
+	require.NoError(t, err)
+	model, err := doc.BuildV3Model()
+	require.NoError(t, err)
+	assert.Equal(t, "listPets", model.Model.Paths.PathItems.GetOrZero("/pets").Get.OperationId)
+}
+
+func TestTrimSpec_KeepsWhatIsNotStripped(t *testing.T) {
+	trimmed, err := trimSpec([]byte(trimSpecInput), EmbeddedSpecOptions{Minify: true})
+	require.NoError(t, err)
+	assert.NotContains(t, string(trimmed), "\n")
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(trimmed, &spec))
+	info := spec["info"].(map[string]any)
+	assert.Equal(t, "A long description.", info["description"])
+	assert.Equal(t, "logo.png", info["x-logo"])
+
+	pet := spec["components"].(map[string]any)["schemas"].(map[string]any)["Pet"].(map[string]any)
+	assert.Equal(t, []any{map[string]any{"name": "Tom"}}, pet["examples"])
+	// Timestamps are kept as written.
+	born := pet["properties"].(map[string]any)["born"].(map[string]any)
+	assert.Equal(t, "2024-01-02", born["example"])
+}
+
+func TestTrimSpec_KeepsKeyOrder(t *testing.T) {
+	trimmed, err := trimSpec([]byte("openapi: 3.1.0\ninfo: {version: '1', title: T}\npaths: {}\n"), EmbeddedSpecOptions{Minify: true})
+	require.NoError(t, err)
+	assert.Equal(t, `{"openapi":"3.1.0","info":{"version":"1","title":"T"},"paths":{}}`, string(trimmed))
+}
+
+func TestResponseValidationSpec(t *testing.T) {
+	spec, err := responseValidationSpec([]byte(trimSpecInput))
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(spec), &decoded))
+	assert.Len(t, decoded, 2, "only paths and components are kept")
+	listPets := decoded["paths"].(map[string]any)["/pets"].(map[string]any)["get"].(map[string]any)
+	assert.Len(t, listPets, 1, "only responses are kept")
+	assert.Contains(t, listPets["responses"], "200")
+	assert.Contains(t, decoded["components"].(map[string]any)["schemas"], "Pet")
+}
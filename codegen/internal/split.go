@@ -0,0 +1,214 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/imports"
+)
+
+// DefaultMaxFileSize is the generated file size, in bytes, above which schema
+// type declarations are split into separate files.
+const DefaultMaxFileSize = 1 << 20
+
+// SplitOutput splits a generated Go file which is larger than maxSize. The
+// declarations of schema types (those annotated with their schema path), along
+// with their methods and enum constants, are moved into chunk files of at most
+// maxSize bytes where possible. Types are assigned to chunks in name order, so
+// the partitioning is stable across runs. Everything else stays in the main
+// file. Code no larger than maxSize, or a non-positive maxSize, is returned
+// unchanged with no chunks.
+func SplitOutput(code string, maxSize int) (main string, chunks []string, err error) {
+	if maxSize <= 0 || len(code) <= maxSize {
+		return code, nil, nil
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "generated.go", code, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing generated code: %w", err)
+	}
+
+	// Collect the source ranges of every declaration belonging to a schema type.
+	schemaTypes := make(map[string]bool)
+	for _, decl := range file.Decls {
+		if name := schemaTypeName(decl); name != "" {
+			schemaTypes[name] = true
+		}
+	}
+	if len(schemaTypes) == 0 {
+		return code, nil, nil
+	}
+
+	type span struct{ start, end int }
+	spansByType := make(map[string][]span)
+	for _, decl := range file.Decls {
+		owner := declOwner(decl)
+		if !schemaTypes[owner] {
+			continue
+		}
+		start := decl.Pos()
+		if doc := declDoc(decl); doc != nil {
+			start = doc.Pos()
+		}
+		spansByType[owner] = append(spansByType[owner], span{
+			start: fset.Position(start).Offset,
+			end:   fset.Position(decl.End()).Offset,
+		})
+	}
+
+	// The header of every chunk repeats the main file's package clause and
+	// imports; goimports drops the ones a chunk doesn't use.
+	headerEnd := file.Name.End()
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			headerEnd = gd.End()
+		}
+	}
+	header := code[:fset.Position(headerEnd).Offset] + "\n\n"
+
+	// Pack types into chunks in name order.
+	names := make([]string, 0, len(spansByType))
+	for name := range spansByType {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var removed []span
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, header+current.String())
+			current.Reset()
+		}
+	}
+	for _, name := range names {
+		var decls strings.Builder
+		for _, s := range spansByType[name] {
+			decls.WriteString(code[s.start:s.end])
+			decls.WriteString("\n\n")
+			removed = append(removed, s)
+		}
+		if current.Len() > 0 && len(header)+current.Len()+decls.Len() > maxSize {
+			flush()
+		}
+		current.WriteString(decls.String())
+	}
+	flush()
+
+	// Rebuild the main file without the moved declarations.
+	sort.Slice(removed, func(i, j int) bool { return removed[i].start < removed[j].start })
+	var mainBuf strings.Builder
+	offset := 0
+	for _, s := range removed {
+		mainBuf.WriteString(code[offset:s.start])
+		offset = s.end
+	}
+	mainBuf.WriteString(code[offset:])
+
+	main, err = formatSplitFile(mainBuf.String())
+	if err != nil {
+		return "", nil, err
+	}
+	for i, chunk := range chunks {
+		if chunks[i], err = formatSplitFile(chunk); err != nil {
+			return "", nil, err
+		}
+	}
+	return main, chunks, nil
+}
+
+// ChunkFileName returns the name of the n-th (1-based) chunk file for the
+// given output file, e.g. "types.gen.go" -> "types_1.gen.go".
+func ChunkFileName(output string, n int) string {
+	stem, ext := output, ".go"
+	if strings.HasSuffix(output, ".gen.go") {
+		stem, ext = strings.TrimSuffix(output, ".gen.go"), ".gen.go"
+	} else {
+		stem = strings.TrimSuffix(output, ".go")
+	}
+	return fmt.Sprintf("%s_%d%s", stem, n, ext)
+}
+
+func formatSplitFile(src string) (string, error) {
+	formatted, err := imports.Process("", []byte(src), nil)
+	if err != nil {
+		return "", fmt.Errorf("formatting split output: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// schemaTypeName returns the name of a type declared for a schema, which the
+// type generator annotates with a "// #/..." schema path comment.
+func schemaTypeName(decl ast.Decl) string {
+	gd, ok := decl.(*ast.GenDecl)
+	if !ok || gd.Tok != token.TYPE || len(gd.Specs) != 1 || gd.Doc == nil {
+		return ""
+	}
+	if !strings.HasPrefix(gd.Doc.List[0].Text, "// #/") {
+		return ""
+	}
+	return gd.Specs[0].(*ast.TypeSpec).Name.Name
+}
+
+// declOwner returns the type a declaration belongs to: the declared type, the
+// receiver of a method, or the type of a group of constants.
+func declOwner(decl ast.Decl) string {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil || len(d.Recv.List) == 0 {
+			return ""
+		}
+		return baseTypeName(d.Recv.List[0].Type)
+	case *ast.GenDecl:
+		switch d.Tok {
+		case token.TYPE:
+			if len(d.Specs) == 1 {
+				return d.Specs[0].(*ast.TypeSpec).Name.Name
+			}
+		case token.CONST:
+			owner := ""
+			for _, spec := range d.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if vs.Type == nil {
+					continue
+				}
+				name := baseTypeName(vs.Type)
+				if owner != "" && owner != name {
+					return ""
+				}
+				owner = name
+			}
+			return owner
+		}
+	}
+	return ""
+}
+
+func declDoc(decl ast.Decl) *ast.CommentGroup {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return d.Doc
+	case *ast.GenDecl:
+		return d.Doc
+	}
+	return nil
+}
+
+func baseTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return baseTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr:
+		return baseTypeName(t.X)
+	case *ast.IndexListExpr:
+		return baseTypeName(t.X)
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const splitTestSpec = `
+openapi: "3.1.0"
+info:
+  title: Split
+  version: "1.0"
+paths: {}
+components:
+  schemas:
+    Zebra:
+      type: object
+      properties:
+        stripes:
+          type: integer
+    Apple:
+      type: object
+      properties:
+        color:
+          $ref: '#/components/schemas/Color'
+        picked:
+          type: string
+          format: date-time
+    Color:
+      type: string
+      enum: [red, green]
+    Mango:
+      type: object
+      properties:
+        ripe:
+          type: boolean
+`
+
+func generateSplitTestCode(t *testing.T) string {
+	t.Helper()
+	doc, err := libopenapi.NewDocument([]byte(splitTestSpec))
+	require.NoError(t, err)
+	code, err := Generate(doc, []byte(splitTestSpec), Configuration{PackageName: "split"})
+	require.NoError(t, err)
+	return code
+}
+
+func TestSplitOutput(t *testing.T) {
+	code := generateSplitTestCode(t)
+
+	main, chunks, err := SplitOutput(code, 200)
+	require.NoError(t, err)
+	require.NotEmpty(t, chunks)
+
+	// Every type and its methods and enum values are in exactly one chunk, in
+	// name order.
+	all := strings.Join(chunks, "\n")
+	for _, decl := range []string{"type Apple struct", "type Color string", "Red   Color", "type Mango struct", "type Zebra struct"} {
+		assert.NotContains(t, main, decl)
+		assert.Equal(t, 1, strings.Count(all, decl), decl)
+	}
+	assert.Contains(t, chunks[0], "type Apple struct")
+	assert.Contains(t, chunks[len(chunks)-1], "type Zebra struct")
+
+	for _, src := range append([]string{main}, chunks...) {
+		assert.True(t, strings.HasPrefix(src, "// Code generated"))
+		_, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+		require.NoError(t, err)
+	}
+
+	// Chunks only import what they use.
+	for _, chunk := range chunks {
+		assert.Equal(t, strings.Contains(chunk, "time.Time"), strings.Contains(chunk, `"time"`))
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		main2, chunks2, err := SplitOutput(generateSplitTestCode(t), 200)
+		require.NoError(t, err)
+		assert.Equal(t, main, main2)
+		assert.Equal(t, chunks, chunks2)
+	})
+}
+
+func TestSplitOutput_Small(t *testing.T) {
+	code := generateSplitTestCode(t)
+
+	for _, maxSize := range []int{len(code), -1} {
+		main, chunks, err := SplitOutput(code, maxSize)
+		require.NoError(t, err)
+		assert.Equal(t, code, main)
+		assert.Empty(t, chunks)
+	}
+}
+
+func TestChunkFileName(t *testing.T) {
+	assert.Equal(t, "types_1.gen.go", ChunkFileName("types.gen.go", 1))
+	assert.Equal(t, "out/api_12.go", ChunkFileName("out/api.go", 12))
+}
@@ -18,12 +18,16 @@ type structTemplateProperty struct {
 	Type                string // Full Go type (e.g., "*string")
 	BaseType            string // Go type without pointer (e.g., "string")
 	Pointer             bool   // Whether this is a pointer type
+	Optional            bool   // Whether this is an Optional[T]
+	Nullable            bool   // Whether this is a Nullable[T]
+	TypesPrefix         string // Runtime types package prefix of Nullable[T] (e.g., "types.")
 	Required            bool   // Whether the field is required
 	RequiresNilCheck    bool   // Whether marshal needs a nil guard
 	Default             string // Go literal for default value (empty if none)
 	NeedsTypeConversion bool   // Whether default needs explicit type conversion
 	IsStruct            bool   // Whether this is a struct type (for recursive ApplyDefaults)
 	IsExternal          bool   // Whether this references an external type
+	Const               string // Constant holding the value of a const property (empty if none)
 }
 
 // structTemplateData is the data passed to struct-related templates.
@@ -32,6 +36,7 @@ type structTemplateData struct {
 	AddPropsType string // Go type for additional properties (e.g., "any", "int")
 	Properties   []structTemplateProperty
 	NeedsReflect bool // Whether the ApplyDefaults method needs the reflect package
+	HasConst     bool // Whether any property is const, which needs a constructor
 }
 
 // buildStructTemplateData converts StructFields into the enriched template data.
@@ -43,33 +48,59 @@ func buildStructTemplateData(typeName string, fields []StructField, addPropsType
 
 	for _, f := range fields {
 		baseType := strings.TrimPrefix(f.Type, "*")
+		if f.Optional {
+			baseType = optionalElemType(f.Type)
+		}
+		typesPrefix, nullableElem, nullable := cutNullableType(f.Type)
+		if nullable {
+			baseType = nullableElem
+		}
 		prop := structTemplateProperty{
 			GoFieldName:         f.Name,
 			JSONFieldName:       f.JSONName,
 			Type:                f.Type,
 			BaseType:            baseType,
 			Pointer:             f.Pointer,
+			Optional:            f.Optional,
+			Nullable:            nullable,
+			TypesPrefix:         typesPrefix,
 			Required:            f.Required,
 			RequiresNilCheck:    f.Pointer,
 			Default:             f.Default,
 			NeedsTypeConversion: needsTypeConversion(baseType),
 			IsStruct:            f.IsStruct,
 			IsExternal:          f.IsExternal,
+			Const:               f.Const,
 		}
 		if f.IsExternal && f.Pointer {
 			data.NeedsReflect = true
 		}
+		if f.Const != "" {
+			data.HasConst = true
+		}
 		data.Properties = append(data.Properties, prop)
 	}
 
 	return data
 }
 
+// cutNullableType splits a Nullable[T] type expression into its runtime
+// types package prefix and T, reporting whether it is one.
+func cutNullableType(goType string) (prefix, elem string, ok bool) {
+	prefix, elem, ok = strings.Cut(goType, "Nullable[")
+	if !ok || (prefix != "" && !strings.HasSuffix(prefix, ".")) || !strings.HasSuffix(elem, "]") {
+		return "", "", false
+	}
+	return prefix, strings.TrimSuffix(elem, "]"), true
+}
+
 // loadStructTemplates loads and parses the struct-related templates.
 func loadStructTemplates() (*template.Template, error) {
 	entries := []string{
 		"files/struct/additional-properties.go.tmpl",
 		"files/struct/apply-defaults.go.tmpl",
+		"files/struct/constructor.go.tmpl",
+		"files/struct/tuple.go.tmpl",
 	}
 
 	tmpl := template.New("struct")
@@ -88,8 +119,9 @@ func loadStructTemplates() (*template.Template, error) {
 }
 
 // GenerateAdditionalPropertiesCode generates Get/Set + MarshalJSON/UnmarshalJSON
-// for structs with additionalProperties.
-func GenerateAdditionalPropertiesCode(typeName string, fields []StructField, addPropsType string) (string, error) {
+// for structs with additionalProperties, and MarshalJSONTo/UnmarshalJSONFrom
+// with jsonV2.
+func GenerateAdditionalPropertiesCode(typeName string, fields []StructField, addPropsType string, jsonV2 bool) (string, error) {
 	data := buildStructTemplateData(typeName, fields, addPropsType)
 
 	tmpl, err := loadStructTemplates()
@@ -108,6 +140,11 @@ func GenerateAdditionalPropertiesCode(typeName string, fields []StructField, add
 	if err := tmpl.ExecuteTemplate(&buf, "additional_properties_marshal", data); err != nil {
 		return "", fmt.Errorf("executing additional_properties_marshal: %w", err)
 	}
+	if jsonV2 {
+		if err := tmpl.ExecuteTemplate(&buf, "additional_properties_jsonv2", data); err != nil {
+			return "", fmt.Errorf("executing additional_properties_jsonv2: %w", err)
+		}
+	}
 
 	return buf.String(), nil
 }
@@ -129,3 +166,24 @@ func GenerateApplyDefaultsCode(typeName string, fields []StructField) (string, b
 
 	return buf.String(), data.NeedsReflect, nil
 }
+
+// GenerateConstructorCode generates the New<Type> constructor of a struct,
+// which sets its const properties. It returns "" for structs without them.
+func GenerateConstructorCode(typeName string, fields []StructField) (string, error) {
+	data := buildStructTemplateData(typeName, fields, "")
+	if !data.HasConst {
+		return "", nil
+	}
+
+	tmpl, err := loadStructTemplates()
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	if err := tmpl.ExecuteTemplate(&buf, "constructor", data); err != nil {
+		return "", fmt.Errorf("executing constructor: %w", err)
+	}
+
+	return buf.String(), nil
+}
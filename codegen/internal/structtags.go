@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"bytes"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
@@ -15,6 +16,9 @@ type StructTagInfo struct {
 	FieldName string
 	// IsOptional is true if the field is optional (not required)
 	IsOptional bool
+	// Validate is the go-playground/validator tag derived from the schema's
+	// constraints (e.g., "required,max=64"), empty when it has none.
+	Validate string
 }
 
 // StructTagTemplate defines a single struct tag with a name and template.
@@ -22,7 +26,7 @@ type StructTagTemplate struct {
 	// Name is the tag name (e.g., "json", "yaml", "form")
 	Name string `yaml:"name"`
 	// Template is a Go text/template that produces the tag value.
-	// Available fields: .FieldName, .IsOptional
+	// Available fields: .FieldName, .IsOptional, .Validate
 	// Example: `{{ .FieldName }}{{if .IsOptional}},omitempty{{end}}`
 	Template string `yaml:"template"`
 }
@@ -32,8 +36,31 @@ type StructTagsConfig struct {
 	// Tags is the list of tags to generate for struct fields.
 	// Order is preserved in the generated output.
 	Tags []StructTagTemplate `yaml:"tags,omitempty"`
+
+	// YAML adds a yaml tag matching the json one, so models round-trip
+	// through YAML files. Entries in Tags named yaml override it.
+	YAML bool `yaml:"yaml,omitempty"`
+
+	// OmitZero tags the json tags of optional fields omitzero, which
+	// encoding/json (Go 1.24+) omits only when they're zero, so empty
+	// values which are present, such as an empty slice, are still written:
+	// "replace" in place of omitempty, or "add" along with it. The
+	// x-oapi-codegen-omitzero and x-oapi-codegen-omitempty extensions
+	// override it per field.
+	OmitZero string `yaml:"omitzero,omitempty"`
+
+	// Validate adds a validate tag of go-playground/validator derived from
+	// the schema's constraints: required, bounds, lengths, enums and string
+	// formats. Entries in Tags named validate override it.
+	Validate bool `yaml:"validate,omitempty"`
 }
 
+// Values of StructTagsConfig.OmitZero.
+const (
+	OmitZeroReplace = "replace" // json:"name,omitzero"
+	OmitZeroAdd     = "add"     // json:"name,omitempty,omitzero"
+)
+
 // DefaultStructTagsConfig returns the default struct tag configuration.
 // By default, json and form tags are generated. Extension-driven concerns
 // (omitzero, json-ignore, omitempty overrides) are handled by post-processing
@@ -56,6 +83,23 @@ func DefaultStructTagsConfig() StructTagsConfig {
 // Merge merges user config on top of this config by name.
 // User entries override matching defaults; new entries are appended.
 func (c StructTagsConfig) Merge(other StructTagsConfig) StructTagsConfig {
+	if other.OmitZero != "" {
+		c.OmitZero = other.OmitZero
+	}
+	if other.YAML {
+		c.YAML = true
+		c.Tags = append(slices.Clone(c.Tags), StructTagTemplate{
+			Name:     "yaml",
+			Template: `{{ .FieldName }}{{if .IsOptional}},omitempty{{end}}`,
+		})
+	}
+	if other.Validate {
+		c.Validate = true
+		c.Tags = append(slices.Clone(c.Tags), StructTagTemplate{
+			Name:     "validate",
+			Template: `{{ .Validate }}`,
+		})
+	}
 	if len(other.Tags) == 0 {
 		return c
 	}
@@ -72,7 +116,7 @@ func (c StructTagsConfig) Merge(other StructTagsConfig) StructTagsConfig {
 		}
 		merged[t.Name] = t
 	}
-	result := StructTagsConfig{Tags: make([]StructTagTemplate, 0, len(order))}
+	result := StructTagsConfig{Tags: make([]StructTagTemplate, 0, len(order)), OmitZero: c.OmitZero}
 	for _, name := range order {
 		result.Tags = append(result.Tags, merged[name])
 	}
@@ -82,6 +126,7 @@ func (c StructTagsConfig) Merge(other StructTagsConfig) StructTagsConfig {
 // StructTagGenerator generates struct tags from templates.
 type StructTagGenerator struct {
 	templates []*tagTemplate
+	omitZero  string // StructTagsConfig.OmitZero
 }
 
 type tagTemplate struct {
@@ -94,6 +139,7 @@ type tagTemplate struct {
 func NewStructTagGenerator(config StructTagsConfig) *StructTagGenerator {
 	g := &StructTagGenerator{
 		templates: make([]*tagTemplate, 0, len(config.Tags)),
+		omitZero:  config.OmitZero,
 	}
 
 	for _, tag := range config.Tags {
@@ -1,8 +1,13 @@
 package codegen
 
 import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
 )
 
 // senderFuncs returns template functions for the shared sender templates.
@@ -22,6 +27,8 @@ func senderFuncs() template.FuncMap {
 		"methodComment":           senderMethodComment,
 		"typedMethodComment":      senderTypedMethodComment,
 		"requestBuilderComment":   senderRequestBuilderComment,
+		"statusErrorResponses":    senderStatusErrorResponses,
+		"statusErrorTypes":        senderStatusErrorTypes,
 	}
 }
 
@@ -29,19 +36,20 @@ func senderFuncs() template.FuncMap {
 
 // senderMethodName returns the Go method name for an operation.
 //
-//	"FindPets" or "FindPetsWithBody"
-func senderMethodName(op *OperationDescriptor) string {
+//	"FindPets" or "FindPetsWithBody", "doFindPets" when methods are hidden
+func senderMethodName(data SenderTemplateData, op *OperationDescriptor) string {
+	name := op.GoOperationID
 	if op.HasBody {
-		return op.GoOperationID + "WithBody"
+		name += "WithBody"
 	}
-	return op.GoOperationID
+	return hiddenName(name, data.HideMethods)
 }
 
 // senderTypedMethodName returns the Go method name for a typed-body variant.
 //
 //	"FindPets" or "FindPetsWithFormBody"
-func senderTypedMethodName(op *OperationDescriptor, body *RequestBodyDescriptor) string {
-	return op.GoOperationID + body.FuncSuffix
+func senderTypedMethodName(data SenderTemplateData, op *OperationDescriptor, body *RequestBodyDescriptor) string {
+	return hiddenName(op.GoOperationID+body.FuncSuffix, data.HideMethods)
 }
 
 // senderRequestBuilderName returns the free function name for building an HTTP request.
@@ -52,14 +60,29 @@ func senderRequestBuilderName(data SenderTemplateData, op *OperationDescriptor)
 	if op.HasBody {
 		name += "WithBody"
 	}
-	return name
+	return hiddenName(name, data.HideBuilders)
 }
 
 // senderTypedRequestBuilderName returns the free function name for building a typed-body request.
 //
 //	"NewFindPetsWebhookRequestWithFormBody"
 func senderTypedRequestBuilderName(data SenderTemplateData, op *OperationDescriptor, body *RequestBodyDescriptor) string {
-	return "New" + op.GoOperationID + data.Prefix + "Request" + body.FuncSuffix
+	return hiddenName("New"+op.GoOperationID+data.Prefix+"Request"+body.FuncSuffix, data.HideBuilders)
+}
+
+// hiddenName unexports name if hidden. Builder names lose the capital of
+// their "New" prefix; method names gain a "do" prefix, so they can't clash
+// with the unexported helpers of the client, such as allow or record.
+//
+//	"NewFindPetsRequest" -> "newFindPetsRequest", "FindPets" -> "doFindPets"
+func hiddenName(name string, hidden bool) string {
+	if !hidden {
+		return name
+	}
+	if strings.HasPrefix(name, "New") {
+		return "n" + name[1:]
+	}
+	return "do" + name
 }
 
 // --- Signature fragments ---
@@ -88,14 +111,19 @@ func senderMethodParams(data SenderTemplateData, op *OperationDescriptor) string
 }
 
 // senderMethodArgs returns the forwarding arguments from a method to a request builder.
+// Operations declaring their own servers look theirs up.
 //
-//	Client:    "c.Server, id, name"
+//	Client:    "c.Server, id, name" or "c.serverFor(\"uploadFile\"), id"
 //	Initiator: "targetURL"
 func senderMethodArgs(data SenderTemplateData, op *OperationDescriptor) string {
 	if data.IsClient {
 		var buf strings.Builder
 		buf.WriteString(data.Receiver)
-		buf.WriteString(".Server")
+		if op.Server != "" {
+			buf.WriteString(".serverFor(" + strconv.Quote(op.OperationID) + ")")
+		} else {
+			buf.WriteString(".Server")
+		}
 		for _, p := range op.PathParams {
 			buf.WriteString(", ")
 			buf.WriteString(p.GoVariableName())
@@ -192,3 +220,85 @@ func senderRequestBuilderComment(data SenderTemplateData, op *OperationDescripto
 	}
 	return "creates a " + op.Method + " request for the " + data.PrefixLower
 }
+
+// --- Status error types ---
+
+// statusErrorResponse is a documented 4xx or 5xx response with a JSON body,
+// which simple methods return as an error type specific to its status.
+type statusErrorResponse struct {
+	Response  *ResponseDescriptor
+	TypeName  string // "ClientNotFoundError", "Webhook5XXError"
+	Condition string // Go expression matching resp.StatusCode
+}
+
+// senderStatusErrorResponses returns the status error responses of a simple
+// operation, specific status codes first and then ranges such as 4XX, the
+// order in which they match.
+func senderStatusErrorResponses(data SenderTemplateData, op *OperationDescriptor) []statusErrorResponse {
+	var exact, ranges []statusErrorResponse
+	for _, r := range op.Responses {
+		if len(r.Contents) == 0 || !r.Contents[0].IsJSON {
+			continue
+		}
+		code := strings.ToUpper(r.StatusCode)
+		if code == "" || (code[0] != '4' && code[0] != '5') {
+			continue
+		}
+		prefix := strings.TrimSuffix(data.ErrorType, "HttpError")
+		if r.HasFixedStatusCode() {
+			exact = append(exact, statusErrorResponse{
+				Response:  r,
+				TypeName:  prefix + statusErrorName(code),
+				Condition: "resp.StatusCode == " + code,
+			})
+		} else if len(code) == 3 && strings.HasSuffix(code, "XX") {
+			class := int(code[0] - '0')
+			ranges = append(ranges, statusErrorResponse{
+				Response:  r,
+				TypeName:  prefix + code + "Error",
+				Condition: fmt.Sprintf("resp.StatusCode >= %d00 && resp.StatusCode < %d00", class, class+1),
+			})
+		}
+	}
+	return append(exact, ranges...)
+}
+
+// senderStatusErrorTypes returns the distinct status error types used by the
+// simple and download operations, sorted by name.
+func senderStatusErrorTypes(data SenderTemplateData) []statusErrorResponse {
+	seen := make(map[string]bool)
+	var types []statusErrorResponse
+	for _, op := range data.Operations {
+		if !isSimpleOperation(op) && !isDownloadOperation(op) {
+			continue
+		}
+		for _, r := range senderStatusErrorResponses(data, op) {
+			if !seen[r.TypeName] {
+				seen[r.TypeName] = true
+				types = append(types, r)
+			}
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].TypeName < types[j].TypeName })
+	return types
+}
+
+// statusErrorName returns the error type name for a status code, without the
+// sender prefix.
+//
+//	"404" -> "NotFoundError", "500" -> "InternalServerError", "499" -> "499Error"
+func statusErrorName(code string) string {
+	n, err := strconv.Atoi(code)
+	text := ""
+	if err == nil {
+		text = http.StatusText(n)
+	}
+	if text == "" {
+		return code + "Error"
+	}
+	var name strings.Builder
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		name.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return strings.TrimSuffix(name.String(), "Error") + "Error"
+}
@@ -32,6 +32,15 @@ var StdHTTPReceiverTemplates = map[string]ReceiverTemplate{
 		},
 		Template: "server/stdhttp/receiver.go.tmpl",
 	},
+	"callback_subscriptions": {
+		Name: "callback_subscriptions",
+		Imports: []Import{
+			{Path: "encoding/json"},
+			{Path: "errors"},
+			{Path: "net/http"},
+		},
+		Template: "server/callback_subscriptions.go.tmpl",
+	},
 }
 
 // ChiReceiverTemplates contains receiver templates for Chi servers.
@@ -46,6 +55,15 @@ var ChiReceiverTemplates = map[string]ReceiverTemplate{
 		},
 		Template: "server/chi/receiver.go.tmpl",
 	},
+	"callback_subscriptions": {
+		Name: "callback_subscriptions",
+		Imports: []Import{
+			{Path: "encoding/json"},
+			{Path: "errors"},
+			{Path: "net/http"},
+		},
+		Template: "server/callback_subscriptions.go.tmpl",
+	},
 }
 
 // EchoReceiverTemplates contains receiver templates for Echo v5 servers.
@@ -105,6 +123,15 @@ var GorillaReceiverTemplates = map[string]ReceiverTemplate{
 		},
 		Template: "server/gorilla/receiver.go.tmpl",
 	},
+	"callback_subscriptions": {
+		Name: "callback_subscriptions",
+		Imports: []Import{
+			{Path: "encoding/json"},
+			{Path: "errors"},
+			{Path: "net/http"},
+		},
+		Template: "server/callback_subscriptions.go.tmpl",
+	},
 }
 
 // FiberReceiverTemplates contains receiver templates for Fiber servers.
@@ -163,6 +190,13 @@ var StdHTTPServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/stdhttp/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "net/http"},
+		},
+		Template: "server/stdhttp/request_logging.go.tmpl",
+	},
 }
 
 // ChiServerTemplates contains templates for Chi server generation.
@@ -193,6 +227,13 @@ var ChiServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/chi/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "net/http"},
+		},
+		Template: "server/chi/request_logging.go.tmpl",
+	},
 }
 
 // EchoServerTemplates contains templates for Echo v5 server generation.
@@ -223,6 +264,13 @@ var EchoServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/echo/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "github.com/labstack/echo/v5"},
+		},
+		Template: "server/echo/request_logging.go.tmpl",
+	},
 }
 
 // EchoV4ServerTemplates contains templates for Echo v4 server generation.
@@ -253,6 +301,15 @@ var EchoV4ServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/echo-v4/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "errors"},
+			{Path: "net/http"},
+			{Path: "github.com/labstack/echo/v4"},
+		},
+		Template: "server/echo-v4/request_logging.go.tmpl",
+	},
 }
 
 // GinServerTemplates contains templates for Gin server generation.
@@ -283,6 +340,13 @@ var GinServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/gin/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "github.com/gin-gonic/gin"},
+		},
+		Template: "server/gin/request_logging.go.tmpl",
+	},
 }
 
 // GorillaServerTemplates contains templates for Gorilla server generation.
@@ -313,6 +377,13 @@ var GorillaServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/gorilla/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "net/http"},
+		},
+		Template: "server/gorilla/request_logging.go.tmpl",
+	},
 }
 
 // FiberServerTemplates contains templates for Fiber server generation.
@@ -342,6 +413,14 @@ var FiberServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/fiber/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "errors"},
+			{Path: "github.com/gofiber/fiber/v3"},
+		},
+		Template: "server/fiber/request_logging.go.tmpl",
+	},
 }
 
 // IrisServerTemplates contains templates for Iris server generation.
@@ -372,6 +451,13 @@ var IrisServerTemplates = map[string]ServerTemplate{
 		},
 		Template: "server/iris/wrapper.go.tmpl",
 	},
+	"request_logging": {
+		Name: "request_logging",
+		Imports: []Import{
+			{Path: "github.com/kataras/iris/v12"},
+		},
+		Template: "server/iris/request_logging.go.tmpl",
+	},
 }
 
 // SharedServerTemplates contains templates shared across all server implementations.
@@ -379,15 +465,57 @@ var SharedServerTemplates = map[string]ServerTemplate{
 	"errors": {
 		Name: "errors",
 		Imports: []Import{
+			{Path: "encoding/json"},
 			{Path: "fmt"},
+			{Path: "net/http"},
+			{Path: "strings"},
 		},
 		Template: "server/errors.go.tmpl",
 	},
 	"param_types": {
 		Name: "param_types",
-		Imports: []Import{},
+		Imports: []Import{
+			{Path: "encoding/json"},
+			{Path: "fmt"},
+			{Path: "net/url"},
+			{Path: "strings"},
+		},
 		Template: "server/param_types.go.tmpl",
 	},
+	"form_binders": {
+		Name: "form_binders",
+		Imports: []Import{
+			{Path: "mime/multipart"},
+			{Path: "net/url"},
+		},
+		Template: "server/form_binders.go.tmpl",
+	},
+	"event_writers": {
+		Name: "event_writers",
+		Imports: []Import{
+			{Path: "io"},
+		},
+		Template: "server/event_writers.go.tmpl",
+	},
+	"oidc_authenticators": {
+		Name:     "oidc_authenticators",
+		Template: "server/oidc_authenticators.go.tmpl",
+	},
+	"jwt_authenticators": {
+		Name: "jwt_authenticators",
+		Imports: []Import{
+			{Path: "context"},
+		},
+		Template: "server/jwt_authenticators.go.tmpl",
+	},
+	"test_server": {
+		Name: "test_server",
+		Imports: []Import{
+			{Path: "net/http"},
+			{Path: "net/http/httptest"},
+		},
+		Template: "server/test_server.go.tmpl",
+	},
 }
 
 // InitiatorTemplate defines a template for initiator (webhook/callback sender) generation.
@@ -416,18 +544,65 @@ type ClientTemplate struct {
 	Template string   // Template path in embedded FS
 }
 
-// ClientTemplates contains the base template for client generation.
+// ClientTemplates contains the templates for client generation.
 var ClientTemplates = map[string]ClientTemplate{
 	"base": {
 		Name: "base",
 		Imports: []Import{
 			{Path: "context"},
+			{Path: "errors"},
+			{Path: "io"},
+			{Path: "net"},
 			{Path: "net/http"},
 			{Path: "net/url"},
 			{Path: "strings"},
 		},
 		Template: "client/base.go.tmpl",
 	},
+	"mock": {
+		Name: "mock",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "io"},
+			{Path: "net/http"},
+		},
+		Template: "client/mock.go.tmpl",
+	},
+	"mock_simple": {
+		Name: "mock_simple",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "iter"},
+		},
+		Template: "client/mock_simple.go.tmpl",
+	},
+	"tag_clients": {
+		Name: "tag_clients",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "io"},
+			{Path: "net/http"},
+		},
+		Template: "client/tag_clients.go.tmpl",
+	},
+	"lro": {
+		Name: "lro",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "fmt"},
+			{Path: "net/http"},
+		},
+		Template: "client/lro.go.tmpl",
+	},
+	"streams": {
+		Name: "streams",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "iter"},
+			{Path: "net/http"},
+		},
+		Template: "client/streams.go.tmpl",
+	},
 }
 
 // SenderTemplate defines a template shared between client and initiator generation.
@@ -457,6 +632,17 @@ var SenderTemplates = map[string]SenderTemplate{
 		},
 		Template: "sender/methods.go.tmpl",
 	},
+	"sender_request_options": {
+		Name: "sender_request_options",
+		Imports: []Import{
+			{Path: "context"},
+			{Path: "io"},
+			{Path: "net/http"},
+			{Path: "net/url"},
+			{Path: "time"},
+		},
+		Template: "sender/request_options.go.tmpl",
+	},
 	"sender_request_builders": {
 		Name: "sender_request_builders",
 		Imports: []Import{
@@ -477,7 +663,9 @@ var SenderTemplates = map[string]SenderTemplate{
 			{Path: "encoding/json"},
 			{Path: "fmt"},
 			{Path: "io"},
+			{Path: "iter"},
 			{Path: "net/http"},
+			{Path: "slices"},
 		},
 		Template: "sender/simple.go.tmpl",
 	},
@@ -8,13 +8,16 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
 	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
@@ -25,7 +28,7 @@ type TreePlantingRequest struct {
 	// What kind of tree to plant
 	Kind string `form:"kind" json:"kind"`
 	// URL to receive the planting result callback
-	CallbackURL string `form:"callbackUrl" json:"callbackUrl"`
+	CallbackURL oapiCodegenTypesPkg.URI `form:"callbackUrl" json:"callbackUrl"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -59,17 +62,17 @@ func (s *TreePlantingResult) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/7RUTU/jMBC951eMACknmsLectw9Ie0BIRDHlWtPyUBqG3vCqlrtf185sRO3DYVqd2+t",
-	"Mx/vzXszxqIWlmo4+7K4WizPCtJrUxcAb+g8GV3D1WK5WBYATNxiDd9E266EfIF79FwAKPTSkeU+Nrx5",
-	"kCmENDEJNg6EVuBQIr2hA2kUwhNqdCKkLQoruPGhaSUsVbYVmn+wQwxPANZ4Hn4B+G6zEW5bwx2+dugZ",
-	"BIRA6HNIP8UwY2PtG1XDbfh27xDjRzekfjVqm8oOj+RQ1cCuw/FZGs2oeYoDENa2JPvq1bM3Ov8G4GWD",
-	"G7H7BnDhcF1DeV5Js7FGo2ZfDZG+CshuI/zIqhyRemu0Rz/VK6+X12Vefnf++SwSURBSomVUWdYMr4+Y",
-	"vcftY3aPxM2NSqSSOzJSnEaAKq9e/rqIFBYro7bnVUp9cO3vchdHbpIRbzLL/lh81zJow7SOdPcSd+xz",
-	"P4HbC5s10geGOirA52Q4LsaphguzKA+IHfgu89+ynOu648PxSMSdV+A7KdH7dde222ICFSpFXEPRmW1I",
-	"3XhrsQazekbJxd6QR0CX0Jo9TS/hhbTK/mY2iq/WBcmZcsapTk52gODZTafmgPtjgw6BzWA44AZ7f4/h",
-	"AczpNQX3iWDWw8FL9adLNZH6RPW1cRvBNXSO3uv5cPc9dIkK9jz2Vyj1LEbphlU/UTFSn5ZvTihSpxDu",
-	"SL3LWNNrh0AKdTgN6EIWcEP+4Kae7pKjss9YPwz4b+YYF+6/zO3fkJ7OdI/0MG5lTItCH1k0btCNGwY/",
-	"hd+5M4NqqIo/AQAA//8Bdnw15QgAAA==",
+	"H4sIAAAAAAAC/7RUTU/bQBC9+1c8AZJPxIHefGxPSD0gBOJYbXYneMDZXXbHVFHV/17523FCIGp7886X",
+	"5817M86TVZ5znH1ZXC2WZwnbtcsT4I1CZGdzXC2Wi2UCCEtJOb6pslwp/YJ7ipIAhqIO7KWJrW0Rug9h",
+	"y8JKXICyBoE08RsFaGcIT2QpqDptkXglRcwTIFOeM18qKz8kENUmwLso7RcQq81GhW2OO3qtKAoU6kA0",
+	"OWyfEgAAnO9q35gct7XvPhB1ztCmfnVm25dtjRzI5JBQ0WDWzgpZGeMA5X3JuqmePUdnpz4g6oI2atcG",
+	"XARa50jPM+023lmyErM2MmZ1Z7dd+x2qdOg0emcjxbFeer28TsfnfP7TWfRAobQmL2QmWQdwfYTsPWwf",
+	"o3tkKW5MD6pXxwSU9CMgMxqB9NdFB2GxcmZ7nvWpD6H8vTOEXZEAM7HMxxKrUmCd8LqDO0vckc/92Nws",
+	"7KCQPhDUUQI+R8NxMk4VXD2LdA/Ynu4m+lum++aZDocj0e28Qay0phjXVVluk7GpPOmRNJ/AgW1oHYBs",
+	"PeVwq2fSksyG3L2BS5RuxuklXtiayXMio87qQ0258BRxX2e09C1ECeOp2cP+WFAgiGsFBymo0fcQXjdz",
+	"ek0lTSLcuqk21B8v1QjqE9XXLmyU5KgCv/fPh7vvENcz2OCYr1D/z2Sgrl31Exlj82n6DhHF5hTAFZt3",
+	"EVt+rQhsyNangUKdBSk47t3U01VylPYD0q8H/Ddz7Bbuv8zt34Aez3TT6X7cyrmSlD2yaFJQGDYMP1Xc",
+	"uTMta2SSPwMAAXZ8NeUIAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -274,6 +277,68 @@ func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
+
 type TreePlantedJSONRequestBody = TreePlantingResult
 
 // RequestEditorFn is the function signature for the RequestEditor callback function.
@@ -347,38 +412,197 @@ func (p *CallbackInitiator) applyEditors(ctx context.Context, req *http.Request,
 	return nil
 }
 
+// RequestOption customizes a single call to a CallbackInitiator method. Options are
+// applied after the request has been built and the CallbackInitiator's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a CallbackInitiator-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleCallbackInitiator methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleCallbackInitiator methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (p *CallbackInitiator) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = oapiCodegenHelpersPkg.ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := p.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // CallbackInitiatorInterface is the interface specification for the callback initiator.
 type CallbackInitiatorInterface interface {
 	// TreePlantedWithBody sends a POST callback request
-	TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 }
 
 // TreePlantedWithBody sends a POST callback request
 // Tree planting result notification
-func (p *CallbackInitiator) TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *CallbackInitiator) TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewTreePlantedCallbackRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "TreePlanted", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // TreePlanted sends a POST callback request with application/json body
-func (p *CallbackInitiator) TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *CallbackInitiator) TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewTreePlantedCallbackRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "TreePlanted", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewTreePlantedCallbackRequest creates a POST request for the callback with application/json body
@@ -439,6 +663,10 @@ func NewSimpleCallbackInitiator(opts ...CallbackInitiatorOption) (*SimpleCallbac
 	return &SimpleCallbackInitiator{CallbackInitiator: inner}, nil
 }
 
+// SimpleCallbackInitiatorInterface is the interface specification for SimpleCallbackInitiator.
+type SimpleCallbackInitiatorInterface interface {
+}
+
 // CallbackReceiverInterface represents handlers for receiving callback requests.
 type CallbackReceiverInterface interface {
 	// Tree planting result notification
@@ -470,3 +698,83 @@ func TreePlantedCallbackHandler(si CallbackReceiverInterface, errHandler func(w
 		handler.ServeHTTP(w, r)
 	})
 }
+
+// CallbackSubscriptions hosts the callbacks of the API for a client. It
+// implements CallbackReceiverInterface, and serves the callback handlers at
+// the URLs of its subscriptions, delivering each callback to the
+// subscription of the request which asked for it. Mount it on your router at
+// the path of its base URL.
+type CallbackSubscriptions struct {
+	router *oapiCodegenHelpersPkg.CallbackRouter
+}
+
+// NewCallbackSubscriptions returns CallbackSubscriptions minting callback URLs
+// below baseURL, the absolute URL it is reachable at, such as
+// https://client.example.com/callbacks.
+func NewCallbackSubscriptions(baseURL string) (*CallbackSubscriptions, error) {
+	router, err := oapiCodegenHelpersPkg.NewCallbackRouter(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackSubscriptions{router: router}, nil
+}
+
+// ServeHTTP serves the callbacks of the subscriptions, answering others with
+// 404 Not Found.
+func (s *CallbackSubscriptions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, ok := s.router.Route(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch name {
+	case "TreePlanted":
+		if r.Method != "POST" {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		TreePlantedCallbackHandler(s, nil).ServeHTTP(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// deliver hands callback to the subscription at the URL of r, and answers r
+// with status.
+func (s *CallbackSubscriptions) deliver(w http.ResponseWriter, r *http.Request, callback any, status int) {
+	if err := s.router.Deliver(r, callback); err != nil {
+		if errors.Is(err, oapiCodegenHelpersPkg.ErrUnknownCallback) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// TreePlantedCallback is a TreePlanted callback received by a subscription.
+type TreePlantedCallback struct {
+	Body TreePlantedJSONRequestBody
+}
+
+// TreePlantedSubscription receives the TreePlanted callbacks of one
+// request. Send its URL as the {$request.body#/callbackUrl} of the request.
+type TreePlantedSubscription = oapiCodegenHelpersPkg.CallbackSubscription[TreePlantedCallback]
+
+// SubscribeTreePlanted returns a subscription to the TreePlanted callbacks of a
+// request, with a callback URL of its own. Close it once no more are expected.
+func (s *CallbackSubscriptions) SubscribeTreePlanted() *TreePlantedSubscription {
+	return oapiCodegenHelpersPkg.SubscribeCallback[TreePlantedCallback](s.router, "TreePlanted")
+}
+
+// HandleTreePlantedCallback delivers the TreePlanted callback r to its subscription.
+func (s *CallbackSubscriptions) HandleTreePlantedCallback(w http.ResponseWriter, r *http.Request) {
+	var callback TreePlantedCallback
+	if err := json.NewDecoder(r.Body).Decode(&callback.Body); err != nil {
+		http.Error(w, "decoding callback body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.deliver(w, r, callback, 200)
+}
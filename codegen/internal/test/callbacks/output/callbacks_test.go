@@ -1,9 +1,13 @@
 package output
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -13,11 +17,11 @@ func TestSchemaInstantiation(t *testing.T) {
 	req := TreePlantingRequest{
 		Location:    "north meadow",
 		Kind:        "oak",
-		CallbackURL: "https://example.com/callback",
+		CallbackURL: types.MustParseURI("https://example.com/callback"),
 	}
 	assert.Equal(t, "oak", req.Kind)
 	assert.Equal(t, "north meadow", req.Location)
-	assert.Equal(t, "https://example.com/callback", req.CallbackURL)
+	assert.Equal(t, "https://example.com/callback", req.CallbackURL.String())
 
 	tree := TreeWithID{
 		Location: "north meadow",
@@ -89,6 +93,49 @@ func TestCallbackReceiverInterfaceImplementable(t *testing.T) {
 	require.NotNil(t, handler)
 }
 
+// Verify callbacks reach the subscription of the request which asked for them
+func TestCallbackSubscriptions(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	subs, err := NewCallbackSubscriptions(server.URL + "/callbacks")
+	require.NoError(t, err)
+	var _ CallbackReceiverInterface = subs
+	mux.Handle("/callbacks/", subs)
+
+	first := subs.SubscribeTreePlanted()
+	defer first.Close()
+	second := subs.SubscribeTreePlanted()
+	assert.NotEqual(t, first.URL, second.URL)
+
+	initiator, err := NewCallbackInitiator()
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	kind := "oak"
+	resp, err := initiator.TreePlanted(ctx, second.URL, TreePlantingResult{Kind: &kind, Success: true})
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	callback, err := second.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &kind, callback.Body.Kind)
+	assert.True(t, callback.Body.Success)
+
+	second.Close()
+	resp, err = initiator.TreePlanted(ctx, second.URL, TreePlantingResult{Success: true})
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "closed subscriptions reject callbacks")
+
+	resp, err = http.Get(first.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
 // Verify ApplyDefaults methods exist
 func TestApplyDefaults(t *testing.T) {
 	req := &TreePlantingRequest{}
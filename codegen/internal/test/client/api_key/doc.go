@@ -0,0 +1,5 @@
+// Package api_key tests the client options generated for security schemes of
+// type apiKey, with keys which rotate.
+package api_key
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
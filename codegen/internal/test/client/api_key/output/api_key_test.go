@@ -0,0 +1,74 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingServer records the credentials of each request.
+func recordingServer(t *testing.T) (*httptest.Server, *[]string) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var session string
+		if cookie, err := r.Cookie("session"); err == nil {
+			session = cookie.Value
+		}
+		seen = append(seen, fmt.Sprintf("%s|%s|%s", r.Header.Get("X-API-Key"), r.URL.Query().Get("api_key"), session))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server, &seen
+}
+
+func TestAPIKeyLocations(t *testing.T) {
+	server, seen := recordingServer(t)
+	client, err := NewClient(server.URL,
+		WithPetsKey(helpers.StaticAPIKey("header-key")),
+		WithQueryKey(helpers.StaticAPIKey("query-key")),
+		WithSessionKey(helpers.StaticAPIKey("cookie-key")),
+	)
+	require.NoError(t, err)
+
+	resp, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, []string{"header-key|query-key|cookie-key"}, *seen)
+}
+
+func TestAPIKeyRotation(t *testing.T) {
+	server, seen := recordingServer(t)
+	current := "first"
+	client, err := NewClient(server.URL, WithPetsKey(helpers.APIKeyFunc(func(ctx context.Context) (string, error) {
+		return current, nil
+	})))
+	require.NoError(t, err)
+
+	for _, key := range []string{"first", "second"} {
+		current = key
+		resp, err := client.ListPets(context.Background())
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Equal(t, []string{"first||", "second||"}, *seen)
+}
+
+func TestAPIKeyProviderError(t *testing.T) {
+	server, seen := recordingServer(t)
+	unavailable := errors.New("key service unavailable")
+	client, err := NewClient(server.URL, WithPetsKey(helpers.APIKeyFunc(func(ctx context.Context) (string, error) {
+		return "", unavailable
+	})))
+	require.NoError(t, err)
+
+	_, err = client.ListPets(context.Background())
+	assert.ErrorIs(t, err, unavailable)
+	assert.Empty(t, *seen)
+}
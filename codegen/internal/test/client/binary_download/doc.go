@@ -0,0 +1,4 @@
+// Package binary_download tests streaming binary responses to an io.Writer.
+package binary_download
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *SimpleClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestDownloadFile_StreamsBody(t *testing.T) {
+	contents := strings.Repeat("0123456789", 100_000)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/files/archive.bin", r.URL.Path)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte(contents))
+	})
+
+	var buf bytes.Buffer
+	n, err := client.DownloadFile(context.Background(), "archive.bin", &buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(contents), n)
+	assert.Equal(t, contents, buf.String())
+}
+
+func TestDownloadFile_NotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"no such file"}`))
+	})
+
+	var buf bytes.Buffer
+	n, err := client.DownloadFile(context.Background(), "missing.bin", &buf)
+
+	var notFound *ClientNotFoundError[Problem]
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "no such file", notFound.Body.Title)
+	assert.Zero(t, n)
+	assert.Zero(t, buf.Len())
+}
+
+func TestGetReport_BinaryString(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/7" {
+			w.WriteHeader(http.StatusTeapot)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.7"))
+	})
+
+	var buf bytes.Buffer
+	n, err := client.GetReport(context.Background(), 7, &buf)
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, n)
+	assert.Equal(t, "%PDF-1.7", buf.String())
+
+	_, err = client.GetReport(context.Background(), 8, &buf)
+	var httpErr *ClientHttpError[struct{}]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusTeapot, httpErr.StatusCode)
+}
@@ -0,0 +1,4 @@
+// Package binary_upload tests sending binary request bodies from an io.Reader.
+package binary_upload
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,80 @@
+package output
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingServer answers requests with status and body, recording the
+// Content-Type and body of the last one.
+type recordingServer struct {
+	contentType string
+	body        string
+}
+
+func (s *recordingServer) start(t *testing.T, status int, body string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		s.contentType, s.body = r.Header.Get("Content-Type"), string(data)
+		if body != "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestUploadFile_SendsReader(t *testing.T) {
+	var rec recordingServer
+	client, err := NewClient(rec.start(t, http.StatusNoContent, ""))
+	require.NoError(t, err)
+
+	contents := strings.Repeat("\x00\x01\xff", 100_000)
+	resp, err := client.UploadFile(context.Background(), "archive.bin", strings.NewReader(contents))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "application/octet-stream", rec.contentType)
+	assert.Equal(t, contents, rec.body)
+}
+
+func TestUploadAvatar_MediaTypes(t *testing.T) {
+	var rec recordingServer
+	client, err := NewSimpleClient(rec.start(t, http.StatusCreated, `{"size":4}`))
+	require.NoError(t, err)
+
+	avatar, err := client.UploadAvatar(context.Background(), strings.NewReader("\xff\xd8\xff\xe0"))
+	require.NoError(t, err)
+	assert.Equal(t, 4, avatar.Size)
+	assert.Equal(t, "image/jpeg", rec.contentType)
+
+	resp, err := client.UploadAvatarWithImagePngBody(context.Background(), strings.NewReader("\x89PNG"))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, "image/png", rec.contentType)
+	assert.Equal(t, "\x89PNG", rec.body)
+}
+
+func TestUploadImage_WildcardNeedsContentType(t *testing.T) {
+	var rec recordingServer
+	client, err := NewClient(rec.start(t, http.StatusNoContent, ""))
+	require.NoError(t, err)
+
+	// image/* names no media type to send, so only UploadImageWithBody exists.
+	resp, err := client.UploadImageWithBody(context.Background(), "image/webp", strings.NewReader("RIFF"))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, "image/webp", rec.contentType)
+}
@@ -0,0 +1,4 @@
+// Package breaker tests consulting a circuit breaker around client calls.
+package breaker
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
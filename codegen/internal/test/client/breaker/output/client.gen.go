@@ -0,0 +1,870 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	oapiCodegenJSONPointerPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/jsonpointer"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name string `form:"name" json:"name"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/paths//pets/get/responses/200/content/application/json/schema
+type FindPetsJSONResponse = []Pet
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6xSsXLqMBDs9RU7vDfjzjZJp5IuHQVdJoViHyCCpYvuSIa/zwhM7ABDMpl0p909392u",
+	"I1Nw7C0m92VdTifGh2W0BnijJD4Gi2lZl7UB1OuWLGaJ3AslLEjUsNO1ZHXFpIcCWJEeCyAyJac+hofW",
+	"YulDOyeVnkskHIOQnMRAcVfXxfAEWpImedbDHqNeAGhiUAo6lgOOeeubw8hqIzF8ZQFp1tS5cxTQPZOF",
+	"S8ntLziv1MllC/A/0dKi+Fc1seMYKKhUxwFSzUkLAwAc5bodrs1ufJrxuiPRWWz3w6QM+kSthaYdmRuH",
+	"3z77+tE/2v4XMS3WhEDvYNKR5E/T+nb1Ac/NPZVL5L/ImnHo8XlDjZpzyx+D6+iphznl6NSPXcj88Dp9",
+	"TTT5sDIfAwCiQ/JaVgMAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+type addPetJSONRequestBody = Pet
+
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Breaker-Test/1.0.0"
+
+// RequestEditorFn is the function signature for the RequestEditor callback function.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests.
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the OpenAPI spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// Debug dumps requests and responses, with sensitive values redacted,
+	// while it is enabled. Set with WithDebugDump; nil disables dumping.
+	Debug *oapiCodegenHelpersPkg.DebugDumper
+
+	// FaultInjector injects latency, errors and malformed responses into
+	// requests, per operation. Set with WithFaultInjection; nil disables it.
+	FaultInjector *oapiCodegenHelpersPkg.FaultInjector
+
+	// Breaker is consulted before each call and told its outcome, keyed by
+	// operationId. Set with WithBreaker; nil disables it.
+	Breaker oapiCodegenHelpersPkg.Breaker
+
+	// PriorityQueue bounds the number of requests in flight, ordering those
+	// waiting by priority. Set with WithPriorityQueue; nil disables it.
+	PriorityQueue *oapiCodegenHelpersPkg.PriorityQueue
+
+	// RateLimiter delays requests to stay within the rate limits reported
+	// by the server. Set with WithRateLimiting or WithRateLimiter; nil
+	// disables it.
+	RateLimiter *oapiCodegenHelpersPkg.RateLimiter
+
+	// Clock is used for everything time-dependent, such as cache freshness
+	// and per-call timeouts, and by Debug and FaultInjector unless they have
+	// their own. Set with WithClock to control time in tests; defaults to the
+	// system clock.
+	Clock oapiCodegenHelpersPkg.Clock
+}
+
+// ClientOption allows setting custom parameters during construction.
+type ClientOption func(*Client) error
+
+// NewClient creates a new Client with reasonable defaults.
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+		Clock:  oapiCodegenHelpersPkg.SystemClock,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
+	// Ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// Create httpClient if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
+	}
+	if client.FaultInjector != nil {
+		client.Client = client.FaultInjector.WrapWithClock(client.Client, client.Clock)
+	}
+	if client.Debug != nil {
+		client.Client = client.Debug.WrapWithClock(client.Client, client.Clock)
+	}
+	if client.PriorityQueue != nil {
+		client.Client = client.PriorityQueue.Wrap(client.Client)
+	}
+	if client.RateLimiter != nil {
+		client.Client = client.RateLimiter.WrapWithClock(client.Client, client.Clock)
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// WithDebugDump writes the method, URL, headers and body of every request and
+// response to w while the client's Debug dumper is enabled. Credentials, API
+// keys and properties marked sensitive in the spec are redacted. Dumping
+// starts out enabled if enabled is set, and can be toggled at any time with
+// client.Debug.SetEnabled.
+func WithDebugDump(w io.Writer, enabled bool) ClientOption {
+	return func(c *Client) error {
+		c.Debug = oapiCodegenHelpersPkg.NewDebugDumper(w, debugRedactions)
+		c.Debug.SetEnabled(enabled)
+		return nil
+	}
+}
+
+// WithFaultInjection sends requests through injector, which injects latency,
+// errors, error statuses and malformed response bodies per operationId. It is
+// meant for testing how code using the client copes with an unreliable
+// service.
+func WithFaultInjection(injector *oapiCodegenHelpersPkg.FaultInjector) ClientOption {
+	return func(c *Client) error {
+		c.FaultInjector = injector
+		return nil
+	}
+}
+
+// WithClock sets the Clock the client tells time with, such as a
+// FakeClock from the runtime helpers in tests.
+func WithClock(clock oapiCodegenHelpersPkg.Clock) ClientOption {
+	return func(c *Client) error {
+		c.Clock = clock
+		return nil
+	}
+}
+
+// WithBreaker installs a circuit breaker, which can fail calls to an
+// operation without sending them, based on the outcome of earlier calls.
+// Transport errors and 5xx responses are recorded as failures.
+func WithBreaker(breaker oapiCodegenHelpersPkg.Breaker) ClientOption {
+	return func(c *Client) error {
+		c.Breaker = breaker
+		return nil
+	}
+}
+
+// WithPriorityQueue sends requests through queue, which bounds how many are
+// in flight at once and, under contention, sends the waiting requests of
+// highest priority first. Priorities are set per operationId by the queue,
+// or per call with WithPriority. A request holds its slot until its response
+// body is closed.
+func WithPriorityQueue(queue *oapiCodegenHelpersPkg.PriorityQueue) ClientOption {
+	return func(c *Client) error {
+		c.PriorityQueue = queue
+		return nil
+	}
+}
+
+// WithRateLimiting delays requests to stay within the rate limits the server
+// reports in the X-RateLimit-Remaining and X-RateLimit-Reset response headers, and
+// with 429 Too Many Requests responses. scope sets whether a limit holds
+// back every request of the client, or only those of the limited operation.
+func WithRateLimiting(scope oapiCodegenHelpersPkg.RateLimitScope) ClientOption {
+	return WithRateLimiter(oapiCodegenHelpersPkg.NewRateLimiter(rateLimitHeaders, scope))
+}
+
+// WithRateLimiter delays requests with limiter, which can be shared by the
+// clients of an API to throttle them together.
+func WithRateLimiter(limiter *oapiCodegenHelpersPkg.RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// rateLimitHeaders are the response headers WithRateLimiting reads limits from.
+var rateLimitHeaders = oapiCodegenHelpersPkg.RateLimitHeaders{
+	Remaining: "X-RateLimit-Remaining",
+	Reset:     "X-RateLimit-Reset",
+}
+
+// debugRedactions lists the API key headers and query parameters, and the
+// sensitive properties, declared by the spec.
+var debugRedactions = oapiCodegenHelpersPkg.DebugRedactions{}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
+// allow asks the Breaker, if any, whether a call to operationID may proceed.
+func (c *Client) allow(operationID string) error {
+	if c.Breaker == nil {
+		return nil
+	}
+	return c.Breaker.Allow(operationID)
+}
+
+// record reports the outcome of a call to operationID to the Breaker, if any.
+func (c *Client) record(operationID string, resp *http.Response, err error) {
+	if c.Breaker != nil {
+		c.Breaker.Record(operationID, oapiCodegenHelpersPkg.BreakerSuccess(resp, err))
+	}
+}
+
+// now returns the time according to the client's Clock.
+func (c *Client) now() time.Time {
+	if c.Clock == nil {
+		return time.Now()
+	}
+	return c.Clock.Now()
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+	priority *oapiCodegenHelpersPkg.Priority
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call. The timeout is kept by the
+// client's Clock.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// WithPriority sets the priority of the call in the client's PriorityQueue,
+// overriding the priority of its operation.
+func WithPriority(priority oapiCodegenHelpersPkg.Priority) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.priority = &priority
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := c.now()
+	var o requestOptions
+	ctx = oapiCodegenHelpersPkg.ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.priority != nil {
+		ctx = oapiCodegenHelpersPkg.ContextWithPriority(ctx, *o.priority)
+		req = req.WithContext(ctx)
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := oapiCodegenHelpersPkg.ContextWithDeadline(ctx, c.Clock, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// ClientInterface is the interface specification for the client.
+type ClientInterface interface {
+	// FindPets makes a GET request to /pets
+	FindPets(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+	// AddPetWithBody makes a POST request to /pets
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+}
+
+// FindPets makes a GET request to /pets
+func (c *Client) FindPets(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewFindPetsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "findPets", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.allow("findPets"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("findPets", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// AddPetWithBody makes a POST request to /pets
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "addPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.allow("addPet"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("addPet", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// AddPet makes a POST request to /pets with application/json body
+func (c *Client) AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "addPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.allow("addPet"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("addPet", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// NewFindPetsRequest creates a GET request for /pets
+func NewFindPetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddPetRequest creates a POST request for /pets with application/json body
+func NewAddPetRequest(server string, body addPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithBody creates a POST request for /pets with any body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", reqURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// ClientHttpError represents an HTTP error response.
+// The type parameter E is the type of the parsed error body.
+type ClientHttpError[E any] struct {
+	StatusCode int
+	Body       E
+	RawBody    []byte
+}
+
+func (e *ClientHttpError[E]) Error() string {
+	return fmt.Sprintf("HTTP %d", e.StatusCode)
+}
+
+// SimpleClient wraps Client with typed responses for operations that have
+// unambiguous response types. Methods return the success type directly,
+// and HTTP errors are returned as *ClientHttpError[E] where E is the error type.
+type SimpleClient struct {
+	*Client
+}
+
+// NewSimpleClient creates a new SimpleClient which wraps a Client.
+func NewSimpleClient(server string, opts ...ClientOption) (*SimpleClient, error) {
+	inner, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &SimpleClient{Client: inner}, nil
+}
+
+// FindPets makes a GET request to /pets and returns the parsed response.
+
+// On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
+func (c *SimpleClient) FindPets(ctx context.Context, opts ...RequestOption) ([]Pet, error) {
+	var result []Pet
+	resp, err := c.Client.FindPets(ctx, opts...)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	// No typed error response defined
+	return result, &ClientHttpError[struct{}]{
+		StatusCode: resp.StatusCode,
+		RawBody:    rawBody,
+	}
+}
+
+// AddPet makes a POST request to /pets and returns the parsed response.
+
+// On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
+func (c *SimpleClient) AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error) {
+	var result Pet
+	resp, err := c.Client.AddPet(ctx, body, opts...)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	// No typed error response defined
+	return result, &ClientHttpError[struct{}]{
+		StatusCode: resp.StatusCode,
+		RawBody:    rawBody,
+	}
+}
+
+// SimpleClientInterface is the interface specification for SimpleClient.
+type SimpleClientInterface interface {
+	// FindPets makes a GET request to /pets and returns the parsed response.
+	FindPets(ctx context.Context, opts ...RequestOption) ([]Pet, error)
+	// AddPet makes a POST request to /pets and returns the parsed response.
+	AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error)
+}
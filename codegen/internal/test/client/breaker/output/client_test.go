@@ -0,0 +1,102 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errOpen = errors.New("circuit open")
+
+// countingBreaker opens an operation's circuit after maxFailures consecutive
+// failures.
+type countingBreaker struct {
+	maxFailures int
+	failures    map[string]int
+	outcomes    []string
+}
+
+func (b *countingBreaker) Allow(operationID string) error {
+	if b.failures[operationID] >= b.maxFailures {
+		return errOpen
+	}
+	return nil
+}
+
+func (b *countingBreaker) Record(operationID string, success bool) {
+	if success {
+		b.failures[operationID] = 0
+		b.outcomes = append(b.outcomes, operationID+":ok")
+		return
+	}
+	b.failures[operationID]++
+	b.outcomes = append(b.outcomes, operationID+":fail")
+}
+
+func TestBreaker(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"Fido"}`))
+	}))
+	defer srv.Close()
+
+	breaker := &countingBreaker{maxFailures: 2, failures: map[string]int{}}
+	client, err := NewSimpleClient(srv.URL, WithBreaker(breaker))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.FindPets(ctx)
+		var httpErr *ClientHttpError[struct{}]
+		require.ErrorAs(t, err, &httpErr)
+	}
+
+	// The findPets circuit is open, so the call fails without a request.
+	_, err = client.FindPets(ctx)
+	assert.ErrorIs(t, err, errOpen)
+	assert.Equal(t, 2, requests)
+
+	// Other operations are unaffected.
+	pet, err := client.AddPet(ctx, Pet{Name: "Fido"})
+	require.NoError(t, err)
+	assert.Equal(t, "Fido", pet.Name)
+
+	assert.Equal(t, []string{"findPets:fail", "findPets:fail", "addPet:ok"}, breaker.outcomes)
+}
+
+func TestBreaker_ClientErrorsAreSuccesses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	breaker := &countingBreaker{maxFailures: 1, failures: map[string]int{}}
+	client, err := NewClient(srv.URL, WithBreaker(breaker))
+	require.NoError(t, err)
+
+	resp, err := client.FindPets(context.Background())
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, []string{"findPets:ok"}, breaker.outcomes)
+}
+
+func TestBreaker_TransportErrors(t *testing.T) {
+	breaker := &countingBreaker{maxFailures: 1, failures: map[string]int{}}
+	client, err := NewClient("http://127.0.0.1:0", WithBreaker(breaker))
+	require.NoError(t, err)
+
+	_, err = client.FindPets(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, []string{"findPets:fail"}, breaker.outcomes)
+}
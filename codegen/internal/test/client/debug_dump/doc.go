@@ -0,0 +1,5 @@
+// Package debug_dump tests the client's redacted debug dumps of requests and
+// responses.
+package debug_dump
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
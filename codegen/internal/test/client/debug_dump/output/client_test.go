@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"ada","ssn":"123-45-6789"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDebugDumpRedactsSensitiveValues(t *testing.T) {
+	srv := newUserServer(t)
+
+	var dump bytes.Buffer
+	client, err := NewSimpleClient(srv.URL,
+		WithDebugDump(&dump, true),
+		WithDefaultHeader("X-API-Key", "key-secret"),
+		WithDefaultQueryParam("api_key", "query-secret"),
+		WithDefaultHeader("Authorization", "Bearer token-secret"),
+	)
+	require.NoError(t, err)
+
+	recovery := RecoveryCode("recovery-secret")
+	user, err := client.CreateUser(context.Background(), NewUser{Name: "ada", Password: "hunter2", Recovery: &recovery})
+	require.NoError(t, err)
+
+	// The client still sees the unredacted response.
+	require.NotNil(t, user.Ssn)
+	assert.Equal(t, "123-45-6789", *user.Ssn)
+
+	out := dump.String()
+	for _, secret := range []string{"key-secret", "query-secret", "token-secret", "hunter2", "recovery-secret", "123-45-6789"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, "--> POST "+srv.URL+"/users?api_key=%5BREDACTED%5D\n")
+	assert.Contains(t, out, `{"name":"ada","password":"[REDACTED]","recovery":"[REDACTED]"}`)
+	assert.Contains(t, out, "<-- 200 OK")
+	assert.Contains(t, out, `{"id":1,"name":"ada","ssn":"[REDACTED]"}`)
+}
+
+func TestDebugDumpToggle(t *testing.T) {
+	srv := newUserServer(t)
+
+	var dump bytes.Buffer
+	client, err := NewSimpleClient(srv.URL, WithDebugDump(&dump, false))
+	require.NoError(t, err)
+
+	_, err = client.CreateUser(context.Background(), NewUser{Name: "ada", Password: "hunter2"})
+	require.NoError(t, err)
+	assert.Empty(t, dump.String())
+
+	client.Debug.SetEnabled(true)
+	_, err = client.CreateUser(context.Background(), NewUser{Name: "ada", Password: "hunter2"})
+	require.NoError(t, err)
+	assert.Contains(t, dump.String(), "--> POST ")
+}
+
+func TestDebugDumpDisabledByDefault(t *testing.T) {
+	client, err := NewClient("http://example.com")
+	require.NoError(t, err)
+	assert.Nil(t, client.Debug)
+}
@@ -0,0 +1,5 @@
+// Package default_options tests the client options which add default headers,
+// a User-Agent and query parameters to every request.
+package default_options
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,100 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordingServer returns a server which records the last request it
+// received.
+func newRecordingServer(t *testing.T) (*httptest.Server, **http.Request) {
+	t.Helper()
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`["a"]`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &last
+}
+
+func TestNoUserAgentByDefault(t *testing.T) {
+	srv, last := newRecordingServer(t)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Go-http-client/1.1", (*last).UserAgent())
+}
+
+func TestDefaultUserAgent(t *testing.T) {
+	srv, last := newRecordingServer(t)
+
+	client, err := NewSimpleClient(srv.URL, WithUserAgent(DefaultUserAgent))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Default-Options-Test/2.1.0", DefaultUserAgent)
+	assert.Equal(t, DefaultUserAgent, (*last).UserAgent())
+}
+
+func TestWithUserAgent(t *testing.T) {
+	srv, last := newRecordingServer(t)
+
+	client, err := NewSimpleClient(srv.URL, WithUserAgent("my-app/1.0"))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "my-app/1.0", (*last).UserAgent())
+}
+
+func TestWithDefaultHeader(t *testing.T) {
+	srv, last := newRecordingServer(t)
+
+	client, err := NewSimpleClient(srv.URL,
+		WithDefaultHeader("X-Tenant", "acme"),
+		WithDefaultHeader("X-Trace", "on"),
+	)
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", (*last).Header.Get("X-Tenant"))
+	assert.Equal(t, "on", (*last).Header.Get("X-Trace"))
+
+	// Headers set by the operation take precedence over defaults
+	tenant := "globex"
+	_, err = client.ListThings(context.Background(), &ListThingsParams{XTenant: &tenant})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"globex"}, (*last).Header.Values("X-Tenant"))
+}
+
+func TestWithDefaultQueryParam(t *testing.T) {
+	srv, last := newRecordingServer(t)
+
+	client, err := NewSimpleClient(srv.URL, WithDefaultQueryParam("api-version", "2024-01-01"))
+	require.NoError(t, err)
+
+	tags := []string{"a", "b"}
+	_, err = client.ListThings(context.Background(), &ListThingsParams{Tags: &tags})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01", (*last).URL.Query().Get("api-version"))
+	assert.Equal(t, []string{"a", "b"}, (*last).URL.Query()["tags"])
+
+	// Query parameters set by the operation take precedence over defaults
+	version := "2025-06-01"
+	_, err = client.ListThings(context.Background(), &ListThingsParams{ApiVersion: &version})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2025-06-01"}, (*last).URL.Query()["api-version"])
+}
@@ -0,0 +1,4 @@
+// Package fault_injection tests injecting faults into client requests per operation.
+package fault_injection
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,83 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPetServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`[{"id":1,"name":"Fido"}]`))
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":2,"name":"Rex"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFaultInjectionPerOperation(t *testing.T) {
+	srv := newPetServer(t)
+
+	outage := errors.New("outage")
+	client, err := NewSimpleClient(srv.URL, WithFaultInjection(&helpers.FaultInjector{
+		Operations: map[string]helpers.Fault{
+			"findPets": {ErrorRate: 1, Error: outage},
+			"addPet":   {StatusRate: 1, StatusCode: http.StatusServiceUnavailable},
+		},
+	}))
+	require.NoError(t, err)
+
+	_, err = client.FindPets(context.Background(), nil)
+	assert.ErrorIs(t, err, outage)
+
+	_, err = client.AddPet(context.Background(), NewPet{Name: "Rex"})
+	var httpErr *ClientHttpError[Error]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+
+	// Operations without faults are unaffected.
+	resp, err := client.DeletePet(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestFaultInjectionMalformedBody(t *testing.T) {
+	srv := newPetServer(t)
+
+	client, err := NewSimpleClient(srv.URL, WithFaultInjection(&helpers.FaultInjector{
+		Default: helpers.Fault{MalformedRate: 1},
+	}))
+	require.NoError(t, err)
+
+	_, err = client.FindPets(context.Background(), nil)
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+}
+
+func TestFaultInjectionLatencyRespectsTimeout(t *testing.T) {
+	srv := newPetServer(t)
+
+	client, err := NewSimpleClient(srv.URL, WithFaultInjection(&helpers.FaultInjector{
+		Operations: map[string]helpers.Fault{"findPets": {Latency: time.Hour}},
+	}))
+	require.NoError(t, err)
+
+	_, err = client.FindPets(context.Background(), nil, WithTimeout(10*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,5 @@
+// Package idempotency_key tests automatic population of Idempotency-Key
+// header parameters by generated clients.
+package idempotency_key
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
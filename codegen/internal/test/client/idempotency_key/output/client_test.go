@@ -0,0 +1,136 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newKeyServer returns a server which records the value of header on each
+// request. It fails the first failures requests with 503.
+func newKeyServer(t *testing.T, header string, failures int) (*httptest.Server, *[]string) {
+	t.Helper()
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(header))
+		if len(keys) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"amount":100}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &keys
+}
+
+// retryingDoer resends a request until it gets a non-503 response.
+type retryingDoer struct {
+	attempts int
+}
+
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; ; i++ {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil || resp.StatusCode != http.StatusServiceUnavailable || i+1 == d.attempts {
+			return resp, err
+		}
+		_ = resp.Body.Close()
+	}
+}
+
+func TestIdempotencyKeyGenerated(t *testing.T) {
+	srv, keys := newKeyServer(t, "Idempotency-Key", 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.CreatePayment(context.Background(), nil, Payment{Amount: 100})
+	require.NoError(t, err)
+	_, err = client.CreatePayment(context.Background(), &CreatePaymentParams{}, Payment{Amount: 100})
+	require.NoError(t, err)
+
+	require.Len(t, *keys, 2)
+	for _, key := range *keys {
+		_, err := uuid.Parse(key)
+		assert.NoError(t, err)
+	}
+	assert.NotEqual(t, (*keys)[0], (*keys)[1])
+}
+
+func TestIdempotencyKeyProvided(t *testing.T) {
+	srv, keys := newKeyServer(t, "Idempotency-Key", 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	key := "payment-42"
+	_, err = client.CreatePayment(context.Background(), &CreatePaymentParams{IdempotencyKey: &key}, Payment{Amount: 100})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"payment-42"}, *keys)
+}
+
+func TestIdempotencyKeyFromExtension(t *testing.T) {
+	srv, keys := newKeyServer(t, "X-Request-Token", 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.CreateRefund(context.Background(), &CreateRefundParams{}, Payment{Amount: 100})
+	require.NoError(t, err)
+
+	require.Len(t, *keys, 1)
+	_, err = uuid.Parse((*keys)[0])
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyKeyReusedOnRetry(t *testing.T) {
+	srv, keys := newKeyServer(t, "Idempotency-Key", 2)
+
+	client, err := NewSimpleClient(srv.URL, WithHTTPClient(&retryingDoer{attempts: 3}))
+	require.NoError(t, err)
+
+	_, err = client.CreatePayment(context.Background(), nil, Payment{Amount: 100})
+	require.NoError(t, err)
+
+	require.Len(t, *keys, 3)
+	assert.NotEmpty(t, (*keys)[0])
+	assert.Equal(t, (*keys)[0], (*keys)[1])
+	assert.Equal(t, (*keys)[0], (*keys)[2])
+}
+
+func TestIdempotencyKeyUUIDFormat(t *testing.T) {
+	srv, keys := newKeyServer(t, "Idempotency-Key", 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.CreateTransfer(context.Background(), &CreateTransferParams{}, Payment{Amount: 100})
+	require.NoError(t, err)
+	key := uuid.MustParse("6f1c1e1a-3b8e-4c4e-9a5e-0d7f2f6b1c2a")
+	_, err = client.CreateTransfer(context.Background(), &CreateTransferParams{IdempotencyKey: key}, Payment{Amount: 100})
+	require.NoError(t, err)
+
+	require.Len(t, *keys, 2)
+	generated, err := uuid.Parse((*keys)[0])
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, generated)
+	assert.Equal(t, key.String(), (*keys)[1])
+}
@@ -0,0 +1,4 @@
+// Package lro tests polling long-running operations until they finish.
+package lro
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
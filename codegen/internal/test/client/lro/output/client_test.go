@@ -0,0 +1,104 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+// newJobServer returns a server which accepts jobs, and reports the job as
+// running for as many polls as there are retryAfter values, each sent as a
+// Retry-After header unless empty, before it succeeds.
+func newJobServer(t *testing.T, retryAfter ...string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/jobs":
+			w.Header().Set("Location", "/jobs/42")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/jobs/42":
+			assert.Equal(t, "secret", r.Header.Get("X-Token"))
+			w.Header().Set("Content-Type", "application/json")
+			if len(retryAfter) > 0 {
+				if retryAfter[0] != "" {
+					w.Header().Set("Retry-After", retryAfter[0])
+				}
+				retryAfter = retryAfter[1:]
+				_, _ = w.Write([]byte(`{"id":"42","status":"Running"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"42","status":"Succeeded","result":"done"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestWaitForCreateJob(t *testing.T) {
+	srv := newJobServer(t, "", "5")
+	clock := helpers.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	client, err := NewClient(srv.URL, WithClock(clock), WithPollInterval(time.Second, 10*time.Second))
+	require.NoError(t, err)
+
+	resp, err := client.CreateJob(context.Background(), NewJob{Input: "data"})
+	require.NoError(t, err)
+
+	type result struct {
+		job Job
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		job, err := client.WaitForCreateJob(context.Background(), resp, WithHeader("X-Token", "secret"))
+		done <- result{job, err}
+	}()
+
+	// Polls back off from the poll interval, unless the status resource
+	// asks for a delay with Retry-After.
+	for _, delay := range []time.Duration{time.Second, 2 * time.Second, 5 * time.Second} {
+		require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+		clock.Advance(delay - time.Millisecond)
+		assert.Equal(t, 1, clock.Waiters())
+		clock.Advance(time.Millisecond)
+	}
+
+	r := <-done
+	require.NoError(t, r.err)
+	assert.Equal(t, "Succeeded", r.job.Status)
+	require.NotNil(t, r.job.Result)
+	assert.Equal(t, "done", *r.job.Result)
+}
+
+func TestWaitForCreateJob_ContextDone(t *testing.T) {
+	srv := newJobServer(t, "", "", "")
+
+	client, err := NewClient(srv.URL, WithPollInterval(time.Millisecond, time.Millisecond))
+	require.NoError(t, err)
+
+	resp, err := client.CreateJob(context.Background(), NewJob{Input: "data"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForCreateJob(ctx, resp)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWaitForCreateJob_NotAccepted(t *testing.T) {
+	client, err := NewClient("http://example.com")
+	require.NoError(t, err)
+
+	resp := &http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody}
+	_, err = client.WaitForCreateJob(context.Background(), resp)
+	assert.EqualError(t, err, "CreateJob was not accepted: HTTP 400")
+}
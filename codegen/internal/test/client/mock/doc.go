@@ -0,0 +1,4 @@
+// Package mock tests the generated MockClient and MockSimpleClient.
+package mock
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// petNames is code under test which depends only on the simple client
+// interface.
+func petNames(ctx context.Context, client SimpleClientInterface) ([]string, error) {
+	pets, err := client.FindPets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(pets))
+	for _, p := range pets {
+		names = append(names, p.Name)
+	}
+	return names, nil
+}
+
+func TestMockSimpleClient(t *testing.T) {
+	mock := &MockSimpleClient{
+		FindPetsFn: func(ctx context.Context, params *FindPetsParams, opts ...RequestOption) ([]Pet, error) {
+			return []Pet{{ID: 1, Name: "Fido"}, {ID: 2, Name: "Rex"}}, nil
+		},
+	}
+
+	names, err := petNames(context.Background(), mock)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Fido", "Rex"}, names)
+}
+
+func TestMockSimpleClient_Error(t *testing.T) {
+	mock := &MockSimpleClient{
+		AddPetFn: func(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error) {
+			return Pet{}, &ClientHttpError[Error]{StatusCode: http.StatusConflict}
+		},
+	}
+
+	_, err := mock.AddPet(context.Background(), NewPet{Name: "Fido"})
+	var httpErr *ClientHttpError[Error]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusConflict, httpErr.StatusCode)
+}
+
+func TestMockClient(t *testing.T) {
+	var deleted int64
+	var client ClientInterface = &MockClient{
+		DeletePetFn: func(ctx context.Context, id int64, opts ...RequestOption) (*http.Response, error) {
+			deleted = id
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil
+		},
+	}
+
+	resp, err := client.DeletePet(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, int64(42), deleted)
+}
+
+func TestMockClient_UnsetPanics(t *testing.T) {
+	mock := &MockClient{}
+	assert.PanicsWithValue(t, "MockClient.FindPets called but FindPetsFn is not set", func() {
+		_, _ = mock.FindPets(context.Background(), nil)
+	})
+}
+
+func TestSimpleClientImplementsInterface(t *testing.T) {
+	client, err := NewSimpleClient("http://example.com")
+	require.NoError(t, err)
+
+	var _ SimpleClientInterface = client
+	var _ ClientInterface = client.Client
+}
@@ -0,0 +1,5 @@
+// Package operation_servers tests sending requests to the servers declared
+// by operations and paths.
+package operation_servers
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
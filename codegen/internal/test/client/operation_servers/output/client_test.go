@@ -0,0 +1,62 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// urlRecorder records the URLs of the requests it is asked to send.
+type urlRecorder struct {
+	urls []string
+}
+
+func (r *urlRecorder) Do(req *http.Request) (*http.Response, error) {
+	r.urls = append(r.urls, req.URL.String())
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func callAll(t *testing.T, client *Client) {
+	t.Helper()
+	ctx := context.Background()
+	for _, call := range []func() (*http.Response, error){
+		func() (*http.Response, error) { return client.ListThings(ctx) },
+		func() (*http.Response, error) { return client.UploadFile(ctx, "a.txt") },
+		func() (*http.Response, error) { return client.GetReport(ctx) },
+	} {
+		resp, err := call()
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+}
+
+func TestOperationServers(t *testing.T) {
+	doer := &urlRecorder{}
+	client, err := NewClient("https://api.example.com/v1", WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	callAll(t, client)
+	assert.Equal(t, []string{
+		"https://api.example.com/v1/things",
+		// The path's server, with its variable set to the default.
+		"https://eu.uploads.example.com/files/uploads/a.txt",
+		// The operation's relative server, resolved against the client's.
+		"https://api.example.com/v2/reports",
+	}, doer.urls)
+}
+
+func TestOperationServers_Forced(t *testing.T) {
+	doer := &urlRecorder{}
+	client, err := NewClient("http://localhost:8080", WithHTTPClient(doer), WithForceServer())
+	require.NoError(t, err)
+
+	callAll(t, client)
+	assert.Equal(t, []string{
+		"http://localhost:8080/things",
+		"http://localhost:8080/uploads/a.txt",
+		"http://localhost:8080/reports",
+	}, doer.urls)
+}
@@ -0,0 +1,4 @@
+// Package priority_queue tests bounding and prioritizing client requests.
+package priority_queue
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,64 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	received := make(chan string, 4)
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.URL.RawQuery
+		<-unblock
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	queue := helpers.NewPriorityQueue(1, map[string]helpers.Priority{"export": helpers.PriorityLow})
+	client, err := NewClient(srv.URL, WithPriorityQueue(queue))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	call := func(fn func() (*http.Response, error)) {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			resp, err := fn()
+			if assert.NoError(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	ctx := context.Background()
+
+	// The first request takes the only slot.
+	call(func() (*http.Response, error) { return client.Search(ctx, WithQueryParam("n", "1")) })
+	assert.Equal(t, "n=1", <-received)
+
+	// Exports are low priority, and overtaken by a normal search and by an
+	// export raised to high priority for this call.
+	call(func() (*http.Response, error) { return client.Export(ctx, WithQueryParam("n", "2")) })
+	require.Eventually(t, func() bool { return queue.Waiting() == 1 }, time.Second, time.Millisecond)
+	call(func() (*http.Response, error) { return client.Search(ctx, WithQueryParam("n", "3")) })
+	require.Eventually(t, func() bool { return queue.Waiting() == 2 }, time.Second, time.Millisecond)
+	call(func() (*http.Response, error) {
+		return client.Export(ctx, WithQueryParam("n", "4"), WithPriority(helpers.PriorityHigh))
+	})
+	require.Eventually(t, func() bool { return queue.Waiting() == 3 }, time.Second, time.Millisecond)
+
+	close(unblock)
+	assert.Equal(t, "n=4", <-received)
+	assert.Equal(t, "n=3", <-received)
+	assert.Equal(t, "n=2", <-received)
+	for range 4 {
+		<-done
+	}
+}
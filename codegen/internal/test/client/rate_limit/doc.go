@@ -0,0 +1,5 @@
+// Package rate_limit tests throttling clients with the rate limit headers
+// declared by the spec.
+package rate_limit
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
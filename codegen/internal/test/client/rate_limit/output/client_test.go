@@ -0,0 +1,93 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+func TestRateLimitHeaders(t *testing.T) {
+	// Remaining is taken from the spec, Reset from the configuration.
+	assert.Equal(t, helpers.RateLimitHeaders{Remaining: "Ratelimit-Remaining", Reset: "RateLimit-Reset-After"}, rateLimitHeaders)
+}
+
+func newTestClient(t *testing.T, scope helpers.RateLimitScope, handler http.HandlerFunc) (*Client, *helpers.FakeClock) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	clock := helpers.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	client, err := NewClient(srv.URL, WithClock(clock), WithRateLimiting(scope))
+	require.NoError(t, err)
+	return client, clock
+}
+
+func TestWithRateLimiting_PerClient(t *testing.T) {
+	var requests atomic.Int32
+	client, clock := newTestClient(t, helpers.RateLimitPerClient, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Ratelimit-Remaining", "0")
+		w.Header().Set("RateLimit-Reset-After", "10")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	ctx := context.Background()
+
+	resp, err := client.Search(ctx)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	// The exhausted limit holds back calls to every operation.
+	done := make(chan error)
+	go func() {
+		resp, err := client.Export(ctx)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		done <- err
+	}()
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	assert.EqualValues(t, 1, requests.Load())
+
+	clock.Advance(10 * time.Second)
+	require.NoError(t, <-done)
+	assert.EqualValues(t, 2, requests.Load())
+}
+
+func TestWithRateLimiting_PerOperation(t *testing.T) {
+	client, clock := newTestClient(t, helpers.RateLimitPerOperation, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search" {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	ctx := context.Background()
+
+	// The 429 response is returned to the caller as is.
+	resp, err := client.Search(ctx)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, clock.Now().Add(30*time.Second), client.RateLimiter.Until("search"))
+
+	// Other operations aren't held back.
+	resp, err = client.Export(ctx)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Calls to the limited one give up when their context is done.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.Search(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,5 @@
+// Package request_options tests the per-call RequestOptions accepted by client
+// methods.
+package request_options
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
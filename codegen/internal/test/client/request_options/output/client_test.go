@@ -0,0 +1,219 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+// newRecordingServer returns a server which records the last request it
+// received, after waiting for delay.
+func newRecordingServer(t *testing.T, delay time.Duration) (*httptest.Server, **http.Request) {
+	t.Helper()
+	var last *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`["a"]`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &last
+}
+
+func TestWithHeader(t *testing.T) {
+	srv, last := newRecordingServer(t, 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	tenant := "from-params"
+	_, err = client.ListThings(context.Background(), &ListThingsParams{XTenant: &tenant},
+		WithHeader("X-Tenant", "from-option"),
+		WithHeader("X-Trace", "on"),
+	)
+	require.NoError(t, err)
+
+	// Per-call headers override those set by the operation.
+	assert.Equal(t, "from-option", (*last).Header.Get("X-Tenant"))
+	assert.Equal(t, "on", (*last).Header.Get("X-Trace"))
+}
+
+func TestWithQueryParam(t *testing.T) {
+	srv, last := newRecordingServer(t, 0)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	limit := 5
+	_, err = client.ListThings(context.Background(), &ListThingsParams{Limit: &limit},
+		WithQueryParam("debug", "true"),
+		WithQueryParam("tag", "a b"),
+	)
+	require.NoError(t, err)
+
+	query := (*last).URL.Query()
+	assert.Equal(t, "5", query.Get("limit"))
+	assert.Equal(t, "true", query.Get("debug"))
+	assert.Equal(t, "a b", query.Get("tag"))
+}
+
+func TestWithEditor(t *testing.T) {
+	srv, last := newRecordingServer(t, 0)
+
+	var calls []string
+	client, err := NewSimpleClient(srv.URL, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		calls = append(calls, "client")
+		return nil
+	}))
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil, WithEditor(func(ctx context.Context, req *http.Request) error {
+		calls = append(calls, "call")
+		req.Header.Set("X-Edited", "yes")
+		return nil
+	}))
+	require.NoError(t, err)
+
+	// Per-call editors run after the client's own editors.
+	assert.Equal(t, []string{"client", "call"}, calls)
+	assert.Equal(t, "yes", (*last).Header.Get("X-Edited"))
+
+	editErr := errors.New("edit failed")
+	_, err = client.ListThings(context.Background(), nil, WithEditor(func(ctx context.Context, req *http.Request) error {
+		return editErr
+	}))
+	assert.ErrorIs(t, err, editErr)
+}
+
+func TestRequestEditorsPassedDirectly(t *testing.T) {
+	srv, last := newRecordingServer(t, 0)
+
+	client, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	// Callers written against methods taking ...RequestEditorFn still compile.
+	editors := []RequestEditorFn{
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-First", "1")
+			return nil
+		},
+	}
+	resp, err := client.ListThings(context.Background(), nil, editors...)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "1", (*last).Header.Get("X-First"))
+
+	resp, err = client.ListThings(context.Background(), nil, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Second", "2")
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "2", (*last).Header.Get("X-Second"))
+}
+
+func TestWithTimeout(t *testing.T) {
+	srv, _ := newRecordingServer(t, time.Second)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil, WithTimeout(10*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithTimeout_FakeClock(t *testing.T) {
+	srv, _ := newRecordingServer(t, time.Minute)
+	clock := helpers.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	client, err := NewSimpleClient(srv.URL, WithClock(clock))
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.ListThings(context.Background(), nil, WithTimeout(time.Hour))
+		done <- err
+	}()
+
+	// The timeout only expires once the client's clock says so.
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	clock.Advance(time.Hour)
+	assert.ErrorIs(t, <-done, context.DeadlineExceeded)
+}
+
+func TestWithDeadline_CoversResponseBody(t *testing.T) {
+	srv, _ := newRecordingServer(t, 0)
+
+	client, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	resp, err := client.ListThings(context.Background(), nil, WithDeadline(time.Now().Add(time.Minute)))
+	require.NoError(t, err)
+
+	// The body can still be read after the method returns.
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `["a"]`, string(body))
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestWithDeadline_EarliestWins(t *testing.T) {
+	srv, _ := newRecordingServer(t, time.Second)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = client.ListThings(context.Background(), nil,
+		WithTimeout(time.Hour),
+		WithDeadline(time.Now().Add(10*time.Millisecond)),
+	)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithResponse(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("X-RateLimit-Remaining", "41")
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc"})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`["a"]`))
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	var resp *http.Response
+	things, err := client.ListThings(context.Background(), nil, WithResponse(&resp), WithTimeout(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, things)
+	require.NotNil(t, resp)
+	assert.Equal(t, "req-1", resp.Header.Get("X-Request-Id"))
+	assert.Equal(t, "41", resp.Header.Get("X-RateLimit-Remaining"))
+	require.Len(t, resp.Cookies(), 1)
+	assert.Equal(t, "abc", resp.Cookies()[0].Value)
+
+	// Error responses are stored too.
+	status = http.StatusTooManyRequests
+	resp = nil
+	_, err = client.ListThings(context.Background(), nil, WithResponse(&resp))
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "req-1", resp.Header.Get("X-Request-Id"))
+}
@@ -0,0 +1,5 @@
+// Package response_cache tests client response caching for operations marked
+// with x-oapi-codegen-cacheable.
+package response_cache
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,114 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+// newPetServer returns a server that serves pets with the given Cache-Control
+// header and a stable ETag, and counts the requests it receives.
+func newPetServer(t *testing.T, cacheControl string) (*httptest.Server, *int) {
+	t.Helper()
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("ETag", `"pet-1"`)
+		w.Header().Set("Cache-Control", cacheControl)
+		if r.Header.Get("If-None-Match") == `"pet-1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/pets" {
+			_, _ = w.Write([]byte(`[{"id":1,"name":"fido"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1,"name":"fido"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func TestCacheableOperationServedFromCache(t *testing.T) {
+	srv, hits := newPetServer(t, "max-age=60")
+
+	client, err := NewSimpleClient(srv.URL, WithResponseCache(nil))
+	require.NoError(t, err)
+
+	for range 3 {
+		pet, err := client.GetPet(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "fido", pet.Name)
+	}
+	assert.Equal(t, 1, *hits)
+}
+
+func TestCacheableOperationExpiresWithClock(t *testing.T) {
+	srv, hits := newPetServer(t, "max-age=60")
+	clock := helpers.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	client, err := NewSimpleClient(srv.URL, WithResponseCache(nil), WithClock(clock))
+	require.NoError(t, err)
+
+	_, err = client.GetPet(context.Background(), 1)
+	require.NoError(t, err)
+	clock.Advance(30 * time.Second)
+	_, err = client.GetPet(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, *hits)
+
+	clock.Advance(30 * time.Second)
+	_, err = client.GetPet(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *hits)
+}
+
+func TestCacheableOperationRevalidated(t *testing.T) {
+	srv, hits := newPetServer(t, "no-cache")
+
+	client, err := NewSimpleClient(srv.URL, WithResponseCache(helpers.NewMemoryResponseCache()))
+	require.NoError(t, err)
+
+	for range 2 {
+		pet, err := client.GetPet(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, "fido", pet.Name)
+	}
+	assert.Equal(t, 2, *hits)
+}
+
+func TestNonCacheableOperationBypassesCache(t *testing.T) {
+	srv, hits := newPetServer(t, "max-age=60")
+
+	client, err := NewSimpleClient(srv.URL, WithResponseCache(nil))
+	require.NoError(t, err)
+
+	for range 2 {
+		pets, err := client.ListPets(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, pets, 1)
+	}
+	assert.Equal(t, 2, *hits)
+}
+
+func TestCachingDisabledByDefault(t *testing.T) {
+	srv, hits := newPetServer(t, "max-age=60")
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+	assert.Nil(t, client.ResponseCache)
+
+	for range 2 {
+		_, err := client.GetPet(context.Background(), 1)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, *hits)
+}
@@ -0,0 +1,5 @@
+// Package simple_only tests generating SimpleClient without the raw client
+// and request builders in the public API.
+package simple_only
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
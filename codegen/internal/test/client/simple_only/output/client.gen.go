@@ -0,0 +1,1168 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// #/components/schemas/NewPet
+type NewPet struct {
+	Name string `form:"name" json:"name"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *NewPet) ApplyDefaults() {
+}
+
+// #/components/schemas/Pet
+type Pet struct {
+	ID   int64  `form:"id" json:"id"`
+	Name string `form:"name" json:"name"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Error
+type Error struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Error) ApplyDefaults() {
+}
+
+// #/paths//pets/get/responses/200/content/application/json/schema
+type FindPetsJSONResponse = []Pet
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/8RUTW/TQBC97694Kki+QOxCxWGPfBy4QCV6QxwW7ziZyt7d7k6oLMR/R7ab2E0dJ0JC",
+	"vU1msvs+9o19IGcCa1y8XRWrywvFrvJaAb8oJvZO43JVrAoFCEtNGt+4CTXhQ83kBF9d3eKGkqhgZJO6",
+	"g3kg6QtgTTIUgA8UjbB3n61Gxc5ek6SHWTDRNCQU0+7fwGs405BGzQ3Lvguw07jbUmwnvVRuqDF60gGk",
+	"DaTBTmhN8WESKQXvEk1gsjdFkY0/AUupjByklz7hCACld0JOHgOZEGoue2n5bfLu8XSe3EjQxGjaJzMW",
+	"atLTI8DLSJVG9iIvfRO8IycpHwBSfk2SKQAIPs3bbmzn+t6Muy0lee9tOyJ1TY5kNSRuSS0IX5Y9L3qJ",
+	"/Re63wv4h5e62RAc3SOQ/K8HO8v7gVhltrUc5fopRh+fg2UPnO1WNP/N9s9wgaWahGYzM4zG2CztKtuD",
+	"Re2+CZPWkXSdv8AAAFQ+Nkb62burxcBcHQ/Mx16XVaNNWu2I9CUwRFKrKR//85ZKUYd6vncG/NhZFDsH",
+	"hadkeoPUobokkd1aAcDZSGxf4RQaW61OOznn4xlM+xQtcJ0j1FBKZn383r8DAEJCRNqGBgAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+type addPetJSONRequestBody = NewPet
+
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Simple-Client-Only-Test/1.0.0"
+
+// RequestEditorFn is the function signature for the RequestEditor callback function.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests.
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the OpenAPI spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// ClientOption allows setting custom parameters during construction.
+type ClientOption func(*Client) error
+
+// NewClient creates a new Client with reasonable defaults.
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
+	// Ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// Create httpClient if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// FindPetsParams defines parameters for FindPets.
+type FindPetsParams struct {
+	// limit (optional)
+	Limit *int `form:"limit" json:"limit"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *FindPetsParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Limit != nil {
+		if frag, err := StylePrimitiveParameter("limit", *p.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, FormatInt[int]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *FindPetsParams) FromURLValues(values url.Values) error {
+	if err := BindPrimitiveQueryParameter("limit", values, &p.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, ParseInt[int]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	return nil
+}
+
+// doFindPets makes a GET request to /pets
+func (c *Client) doFindPets(ctx context.Context, params *FindPetsParams, opts ...RequestOption) (*http.Response, error) {
+	req, err := newFindPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "findPets", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// doAddPetWithBody makes a POST request to /pets
+func (c *Client) doAddPetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	req, err := newAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "addPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// doAddPet makes a POST request to /pets with application/json body
+func (c *Client) doAddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	req, err := newAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "addPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// newFindPetsRequest creates a GET request for /pets
+func newFindPetsRequest(server string, params *FindPetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := reqURL.Query()
+		if params.Limit != nil {
+			if queryFrag, err := StylePrimitiveParameter("limit", *params.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, FormatInt[int]); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		reqURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// newAddPetRequest creates a POST request for /pets with application/json body
+func newAddPetRequest(server string, body addPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return newAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// newAddPetRequestWithBody creates a POST request for /pets with any body
+func newAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", reqURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// ClientHttpError represents an HTTP error response.
+// The type parameter E is the type of the parsed error body.
+type ClientHttpError[E any] struct {
+	StatusCode int
+	Body       E
+	RawBody    []byte
+}
+
+func (e *ClientHttpError[E]) Error() string {
+	return fmt.Sprintf("HTTP %d", e.StatusCode)
+}
+
+// SimpleClient wraps Client with typed responses for operations that have
+// unambiguous response types. Methods return the success type directly,
+// and HTTP errors are returned as *ClientHttpError[E] where E is the error type.
+type SimpleClient struct {
+	*Client
+}
+
+// NewSimpleClient creates a new SimpleClient which wraps a Client.
+func NewSimpleClient(server string, opts ...ClientOption) (*SimpleClient, error) {
+	inner, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &SimpleClient{Client: inner}, nil
+}
+
+// FindPets makes a GET request to /pets and returns the parsed response.
+
+// On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
+func (c *SimpleClient) FindPets(ctx context.Context, params *FindPetsParams, opts ...RequestOption) ([]Pet, error) {
+	var result []Pet
+	resp, err := c.Client.doFindPets(ctx, params, opts...)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := DecodeJSON(rawBody, &result); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	// No typed error response defined
+	return result, &ClientHttpError[struct{}]{
+		StatusCode: resp.StatusCode,
+		RawBody:    rawBody,
+	}
+}
+
+// AddPet makes a POST request to /pets and returns the parsed response.
+
+// On success, returns the response body. On HTTP error, returns *ClientHttpError[Error].
+func (c *SimpleClient) AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error) {
+	var result Pet
+	resp, err := c.Client.doAddPet(ctx, body, opts...)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := DecodeJSON(rawBody, &result); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	// Parse error response
+	var errBody Error
+	_ = json.Unmarshal(rawBody, &errBody) // Best effort parse
+	return result, &ClientHttpError[Error]{
+		StatusCode: resp.StatusCode,
+		Body:       errBody,
+		RawBody:    rawBody,
+	}
+}
+
+// SimpleClientInterface is the interface specification for SimpleClient.
+type SimpleClientInterface interface {
+	// FindPets makes a GET request to /pets and returns the parsed response.
+	FindPets(ctx context.Context, params *FindPetsParams, opts ...RequestOption) ([]Pet, error)
+	// AddPet makes a POST request to /pets and returns the parsed response.
+	AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error)
+}
+
+// MockSimpleClient implements SimpleClientInterface with a function field
+// per method, so that tests can stub individual calls. Calling a method whose
+// function field is nil panics.
+type MockSimpleClient struct {
+	FindPetsFn func(ctx context.Context, params *FindPetsParams, opts ...RequestOption) ([]Pet, error)
+	AddPetFn   func(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error)
+}
+
+var _ SimpleClientInterface = (*MockSimpleClient)(nil)
+
+// FindPets calls FindPetsFn.
+func (m *MockSimpleClient) FindPets(ctx context.Context, params *FindPetsParams, opts ...RequestOption) ([]Pet, error) {
+	if m.FindPetsFn == nil {
+		panic("MockSimpleClient.FindPets called but FindPetsFn is not set")
+	}
+	return m.FindPetsFn(ctx, params, opts...)
+}
+
+// AddPet calls AddPetFn.
+func (m *MockSimpleClient) AddPet(ctx context.Context, body addPetJSONRequestBody, opts ...RequestOption) (Pet, error) {
+	if m.AddPetFn == nil {
+		panic("MockSimpleClient.AddPet called but AddPetFn is not set")
+	}
+	return m.AddPetFn(ctx, body, opts...)
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// ParamLocation indicates where a parameter is located in an HTTP request.
+type ParamLocation int
+
+const (
+	ParamLocationUndefined ParamLocation = iota
+	ParamLocationQuery
+	ParamLocationPath
+	ParamLocationHeader
+	ParamLocationCookie
+)
+
+// MissingRequiredParameterError is returned when a required parameter is not
+// present in the request. Upper layers can use errors.As to detect this and
+// produce an appropriate HTTP error response.
+type MissingRequiredParameterError struct {
+	ParamName string
+}
+
+func (e *MissingRequiredParameterError) Error() string {
+	return fmt.Sprintf("parameter '%s' is required", e.ParamName)
+}
+
+// escapeParameterName escapes a parameter name for use in query strings and
+// paths. This ensures characters like [] in parameter names (e.g. user_ids[])
+// are properly percent-encoded per RFC 3986.
+func escapeParameterName(name string, paramLocation ParamLocation) string {
+	// Parameter names should always be encoded regardless of allowReserved,
+	// which only applies to values per the OpenAPI spec.
+	return escapeParameterString(name, paramLocation, false)
+}
+
+// escapeParameterString escapes a parameter value based on its location.
+// Query and path parameters need URL escaping; headers and cookies do not.
+// When allowReserved is true and the location is query, RFC 3986 reserved
+// characters are left unencoded per the OpenAPI allowReserved specification.
+func escapeParameterString(value string, paramLocation ParamLocation, allowReserved bool) string {
+	switch paramLocation {
+	case ParamLocationQuery:
+		if allowReserved {
+			return escapeQueryAllowReserved(value)
+		}
+		return url.QueryEscape(value)
+	case ParamLocationPath:
+		return url.PathEscape(value)
+	default:
+		return value
+	}
+}
+
+// escapeQueryAllowReserved percent-encodes a query parameter value while
+// leaving RFC 3986 reserved characters (:/?#[]@!$&'()*+,;=) unencoded, as
+// specified by OpenAPI's allowReserved parameter option.
+func escapeQueryAllowReserved(value string) string {
+	const reserved = `:/?#[]@!$&'()*+,;=`
+
+	var buf strings.Builder
+	for _, b := range []byte(value) {
+		if isUnreserved(b) || strings.IndexByte(reserved, b) >= 0 {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+// isUnreserved reports whether the byte is an RFC 3986 unreserved character:
+// ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// ParameterOptions carries OpenAPI parameter metadata to bind and style
+// functions so they can handle style dispatch, explode, required,
+// type-aware coercions, and location-aware escaping from a single
+// uniform call site. All fields have sensible zero-value defaults.
+type ParameterOptions struct {
+	Style         string        // OpenAPI style: "simple", "form", "label", "matrix", "deepObject", "pipeDelimited", "spaceDelimited"
+	ParamLocation ParamLocation // Where the parameter appears: query, path, header, cookie
+	Explode       bool
+	Required      bool
+	Type          string // OpenAPI type: "string", "integer", "array", "object"
+	Format        string // OpenAPI format: "int32", "date-time", etc.
+	AllowReserved bool   // When true, reserved characters in query values are not percent-encoded
+}
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// StylePrimitiveParameter serializes a parameter of a primitive type like
+// StyleParameter, formatting value with format, such as FormatInt[int32],
+// rather than by reflection.
+func StylePrimitiveParameter[T any](paramName string, value T, opts ParameterOptions, format func(T) string) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+	return styleString(style, paramName, opts.ParamLocation, opts.AllowReserved, format(value))
+}
+
+// BindPrimitiveQueryParameter binds a query parameter of a primitive type like
+// BindQueryParameter, parsing its value with parse, such as ParseInt[int32],
+// rather than by reflection. dest is the field of the parameter, a pointer
+// when the parameter is optional.
+func BindPrimitiveQueryParameter[T any, D *T | **T](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error)) error {
+	switch opts.Style {
+	case "", "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	values := queryParams[paramName]
+	switch {
+	case len(values) == 0:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	case len(values) != 1 && opts.Explode:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	case len(values) != 1:
+		return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+
+	v, err := parse(values[0])
+	if err != nil {
+		return err
+	}
+	switch d := any(dest).(type) {
+	case *T:
+		*d = v
+	case **T:
+		*d = &v
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
+	escapedName := escapeParameterName(paramName, paramLocation)
+
+	var prefix string
+	switch style {
+	case "simple":
+	case "label":
+		prefix = "."
+	case "matrix":
+		prefix = fmt.Sprintf(";%s=", escapedName)
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
+		prefix = fmt.Sprintf("%s=", escapedName)
+	default:
+		return "", fmt.Errorf("unsupported style '%s'", style)
+	}
+	return prefix + escapeParameterString(strVal, paramLocation, allowReserved), nil
+}
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// DecodeError is an error decoding a JSON document, located by the JSON
+// Pointer of the value which failed to decode.
+type DecodeError struct {
+	// Pointer locates the failing value; "" is the whole document.
+	Pointer string
+	// Offset is the byte offset in the document the decoder failed at.
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	at := e.Pointer
+	if at == "" {
+		at = "document root"
+	}
+	return "decoding JSON at " + at + ": " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WrapDecodeError locates err, returned decoding data, in a *DecodeError.
+// Syntax and type errors carry the offset the decoder failed at; other
+// errors, such as those of UnmarshalJSON methods, are returned as is, as is
+// nil.
+func WrapDecodeError(data []byte, err error) error {
+	var offset, scanned int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &syntaxErr):
+		// The offset of a syntax error counts the invalid byte, which may
+		// close the value it belongs in.
+		offset, scanned = syntaxErr.Offset, syntaxErr.Offset-1
+	case errors.As(err, &typeErr):
+		offset, scanned = typeErr.Offset, typeErr.Offset
+	default:
+		return err
+	}
+	return &DecodeError{Pointer: PointerAtOffset(data, scanned), Offset: offset, Err: err}
+}
+
+// DecodeJSON is json.Unmarshal, returning errors located by WrapDecodeError.
+func DecodeJSON(data []byte, v any) error {
+	return WrapDecodeError(data, json.Unmarshal(data, v))
+}
+
+// EscapePointerToken escapes a reference token for use in a JSON Pointer, as
+// RFC 6901 requires: "~" becomes "~0" and "/" becomes "~1".
+func EscapePointerToken(token string) string {
+	if !strings.ContainsAny(token, "~/") {
+		return token
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// pointerFrame is an object or array enclosing the position PointerAtOffset
+// scans to.
+type pointerFrame struct {
+	array bool
+	// index is the index of the current element of an array, or -1 before
+	// the first one.
+	index int
+	// key is the key of the current member of an object, and inValue whether
+	// the scan is past its colon.
+	key     string
+	inValue bool
+}
+
+// PointerAtOffset returns the JSON Pointer of the innermost value of data
+// which the first offset bytes end in, as reported by the Offset of a
+// *json.SyntaxError or *json.UnmarshalTypeError. data need not be valid past
+// offset.
+func PointerAtOffset(data []byte, offset int64) string {
+	offset = min(max(offset, 0), int64(len(data)))
+	var stack []pointerFrame
+	for i := int64(0); i < offset; i++ {
+		switch c := data[i]; c {
+		case '{', '[':
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+			stack = append(stack, pointerFrame{array: c == '[', index: -1})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ':':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = true
+			}
+		case ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = false
+			}
+		case '"':
+			end := stringEnd(data, i)
+			if len(stack) > 0 {
+				top := &stack[len(stack)-1]
+				if !top.array && !top.inValue {
+					top.key = unquoteKey(data[i:min(end, int64(len(data)))])
+				} else {
+					startValue(top)
+				}
+			}
+			i = end - 1
+		case ' ', '\t', '\r', '\n':
+		default:
+			// A literal: a number, true, false or null.
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, frame := range stack {
+		switch {
+		case frame.array && frame.index >= 0:
+			b.WriteString("/" + strconv.Itoa(frame.index))
+		case !frame.array && frame.inValue:
+			b.WriteString("/" + EscapePointerToken(frame.key))
+		default:
+			// The scan stopped within the container itself.
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// startValue notes that a value starts in frame, moving arrays on to their
+// next element.
+func startValue(frame *pointerFrame) {
+	if frame.array && !frame.inValue {
+		frame.index++
+		frame.inValue = true
+	}
+}
+
+// stringEnd returns the offset just past the string starting at data[start],
+// or len(data) if it is unterminated.
+func stringEnd(data []byte, start int64) int64 {
+	for i := start + 1; i < int64(len(data)); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return int64(len(data))
+}
+
+// unquoteKey decodes a quoted object key, falling back to its raw text when
+// it isn't valid JSON.
+func unquoteKey(quoted []byte) string {
+	var key string
+	if err := json.Unmarshal(quoted, &key); err != nil {
+		return strings.Trim(string(quoted), `"`)
+	}
+	return key
+}
@@ -0,0 +1,48 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			_ = json.NewEncoder(w).Encode([]Pet{{ID: 1, Name: "Fido"}})
+		case http.MethodPost:
+			var pet NewPet
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&pet))
+			_ = json.NewEncoder(w).Encode(Pet{ID: 2, Name: pet.Name})
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	limit := 2
+	pets, err := client.FindPets(context.Background(), &FindPetsParams{Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, []Pet{{ID: 1, Name: "Fido"}}, pets)
+
+	pet, err := client.AddPet(context.Background(), NewPet{Name: "Rex"})
+	require.NoError(t, err)
+	assert.Equal(t, Pet{ID: 2, Name: "Rex"}, pet)
+}
+
+func TestClientOperationsUnexported(t *testing.T) {
+	for _, name := range []string{"FindPets", "AddPet", "AddPetWithBody", "DeletePet"} {
+		_, ok := reflect.TypeOf(&Client{}).MethodByName(name)
+		assert.False(t, ok, "Client has method %s", name)
+	}
+}
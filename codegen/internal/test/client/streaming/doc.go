@@ -0,0 +1,5 @@
+// Package streaming tests the iterators and channel adapters generated for
+// operations streaming their request or response items.
+package streaming
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
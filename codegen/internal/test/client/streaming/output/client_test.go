@@ -0,0 +1,203 @@
+package output
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *SimpleClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+	return client
+}
+
+var prices = []Price{{Symbol: "ACME", Price: 1.5}, {Symbol: "ACME", Price: 1.75}}
+
+func TestStreamPrices(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ACME", r.URL.Query().Get("symbol"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(": hello\n\n" +
+			"data: {\"symbol\":\"ACME\",\"price\":1.5}\n\n" +
+			"event: price\ndata: {\"symbol\":\"ACME\",\n" +
+			"data: \"price\":1.75}\n\n"))
+	})
+
+	symbol := "ACME"
+	seq, err := client.StreamPrices(context.Background(), &StreamPricesParams{Symbol: &symbol})
+	require.NoError(t, err)
+
+	var got []Price
+	for price, err := range seq {
+		require.NoError(t, err)
+		got = append(got, price)
+	}
+	assert.Equal(t, prices, got)
+}
+
+func TestStreamPrices_Error(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"unknown symbol"}`))
+	})
+
+	seq, err := client.StreamPrices(context.Background(), nil)
+	assert.Nil(t, seq)
+	var httpErr *ClientHttpError[Error]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, "unknown symbol", httpErr.Body.Message)
+}
+
+func TestStreamPricesChan(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: {\"symbol\":\"ACME\",\"price\":1.5}\n\n" +
+			"data: not json\n\n"))
+	})
+
+	items, errs, err := client.StreamPricesChan(context.Background(), nil, 1)
+	require.NoError(t, err)
+
+	var got []Price
+	for price := range items {
+		got = append(got, price)
+	}
+	assert.Equal(t, prices[:1], got)
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, <-errs, &syntaxErr)
+}
+
+func TestStreamPricesEvents(t *testing.T) {
+	var connections atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch connections.Add(1) {
+		case 1:
+			assert.Empty(t, r.Header.Get("Last-Event-ID"))
+			_, _ = w.Write([]byte("id: 1\ndata: {\"symbol\":\"ACME\",\"price\":1.5}\n\n"))
+			// Dropping the connection interrupts the stream.
+		default:
+			assert.Equal(t, "1", r.Header.Get("Last-Event-ID"))
+			_, _ = w.Write([]byte("id: 2\ndata: {\"symbol\":\"ACME\",\"price\":1.75}\n\n"))
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamPricesEvents(ctx, nil)
+	require.NoError(t, err)
+	stream.InitialDelay = time.Millisecond
+	var reconnections []helpers.Reconnection
+	stream.OnReconnect = func(r helpers.Reconnection) {
+		reconnections = append(reconnections, r)
+	}
+
+	var got []helpers.Event[Price]
+	for event, err := range stream.Events() {
+		require.NoError(t, err)
+		got = append(got, event)
+		if len(got) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []helpers.Event[Price]{{ID: "1", Data: prices[0]}, {ID: "2", Data: prices[1]}}, got)
+	require.Len(t, reconnections, 1)
+	assert.Equal(t, "1", reconnections[0].LastEventID)
+	assert.Equal(t, 1, stream.Reconnections())
+}
+
+func TestExportPrices(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/jsonl")
+		_, _ = w.Write([]byte("{\"symbol\":\"ACME\",\"price\":1.5}\n{\"symbol\":\"ACME\",\"price\":1.75}\n"))
+	})
+
+	seq, err := client.ExportPrices(context.Background())
+	require.NoError(t, err)
+
+	var got []Price
+	for price, err := range seq {
+		require.NoError(t, err)
+		got = append(got, price)
+	}
+	assert.Equal(t, prices, got)
+}
+
+// importHandler records the prices of an import, one per line.
+func importHandler(t *testing.T, got *[]Price) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/jsonl", r.Header.Get("Content-Type"))
+		lines := bufio.NewScanner(r.Body)
+		for lines.Scan() {
+			var price Price
+			require.NoError(t, json.Unmarshal(lines.Bytes(), &price))
+			*got = append(*got, price)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestImportPricesWithItems(t *testing.T) {
+	var got []Price
+	client := newTestClient(t, importHandler(t, &got))
+
+	resp, err := client.Client.ImportPricesWithItems(context.Background(), slices.Values(prices))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, prices, got)
+}
+
+func TestImportPricesWithItemChan(t *testing.T) {
+	var got []Price
+	client := newTestClient(t, importHandler(t, &got))
+
+	items := make(chan Price)
+	go func() {
+		defer close(items)
+		for _, price := range prices {
+			items <- price
+		}
+	}()
+
+	resp, err := client.Client.ImportPricesWithItemChan(context.Background(), items)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, prices, got)
+}
+
+func TestImportPricesWithItemChan_ContextDone(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = bufio.NewReader(r.Body).ReadString('\n')
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	items := make(chan Price)
+	go func() {
+		items <- prices[0]
+		cancel()
+	}()
+
+	// The channel is never closed: the request fails instead of hanging.
+	_, err := client.Client.ImportPricesWithItemChan(ctx, items)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,5 @@
+// Package tag_clients tests generating an interface and sub-client per
+// operation tag.
+package tag_clients
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
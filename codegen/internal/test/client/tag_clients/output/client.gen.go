@@ -0,0 +1,1152 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name string `form:"name" json:"name"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6RTPW/bMBDd9SsOaoEsbeS0nTi2HdqpHrIFGVjxRbpAIpnjuYBR9L8XpCxbMewgiDfh",
+	"8b7eh0KEt5EN1Z+vb65XdcX+IZiKSFkHGLq1HbUDw2uqiP5AEgdvqC610WqfcnEToeWDqINOH0QhQqxy",
+	"8D+doYGTrqFp96a2S4buct/9DopW7AiFpHkA0UfydkTuHln3KBF7Q08byHaBpbbHaM0CIdJthCH2ig6y",
+	"exGkGHzCYk39abWql50OqRWOWsje9qA4nx5DOk2wFVjFGnqeoeBpg6Rfg9selmWQBc6QygZ7uA1e4XV5",
+	"lI1x4LYsbB5T8M+pnqJP9F7wYOjqXdOGMQaffWymytSsoVcvanJzXpNvha2bvW/+svs3FTsMUJyUaHo6",
+	"KdEHShoEZN3I/jWJYHcUhxzGBXRG1ktj8uW8JN8LuyJJEAd5xQ/xq9Q9V2Pqvb8krGEe2/Swg/YvH9JB",
+	"f5Syt66curfVIWKmmnXeDVkfdk9ah9+PaLU69uoum7u3X/KdystLivnVsXNJhX1X/R8At+6fj80EAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+type createPetJSONRequestBody = Pet
+
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Tag-clients/1.0"
+
+// RequestEditorFn is the function signature for the RequestEditor callback function.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests.
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the OpenAPI spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// ClientOption allows setting custom parameters during construction.
+type ClientOption func(*Client) error
+
+// NewClient creates a new Client with reasonable defaults.
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
+	// Ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// Create httpClient if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// ClientInterface is the interface specification for the client.
+type ClientInterface interface {
+	// GetHealth makes a GET request to /health
+	GetHealth(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+	// ListOrders makes a GET request to /orders
+	ListOrders(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+	// ListPets makes a GET request to /pets
+	ListPets(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error)
+	// CreatePetWithBody makes a POST request to /pets
+	CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+	// DeletePet makes a DELETE request to /pets/{id}
+	DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error)
+}
+
+// OrdersClientInterface is the interface specification for the operations
+// tagged "orders". It is implemented by *OrdersClient, and by every
+// ClientInterface.
+type OrdersClientInterface interface {
+	// ListOrders makes a GET request to /orders
+	ListOrders(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+}
+
+// OrdersClient makes the requests of the operations tagged "orders",
+// through the Client which returned it.
+type OrdersClient struct {
+	client *Client
+}
+
+// Orders returns the sub-client of the operations tagged "orders".
+func (c *Client) Orders() *OrdersClient {
+	return &OrdersClient{client: c}
+}
+
+// ListOrders makes a GET request to /orders
+func (c *OrdersClient) ListOrders(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	return c.client.ListOrders(ctx, opts...)
+}
+
+// PetsClientInterface is the interface specification for the operations
+// tagged "pets". It is implemented by *PetsClient, and by every
+// ClientInterface.
+type PetsClientInterface interface {
+	// ListPets makes a GET request to /pets
+	ListPets(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error)
+	// CreatePetWithBody makes a POST request to /pets
+	CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+	// DeletePet makes a DELETE request to /pets/{id}
+	DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error)
+}
+
+// PetsClient makes the requests of the operations tagged "pets",
+// through the Client which returned it.
+type PetsClient struct {
+	client *Client
+}
+
+// Pets returns the sub-client of the operations tagged "pets".
+func (c *Client) Pets() *PetsClient {
+	return &PetsClient{client: c}
+}
+
+// ListPets makes a GET request to /pets
+func (c *PetsClient) ListPets(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error) {
+	return c.client.ListPets(ctx, params, opts...)
+}
+
+// CreatePetWithBody makes a POST request to /pets
+func (c *PetsClient) CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	return c.client.CreatePetWithBody(ctx, contentType, body, opts...)
+}
+
+// CreatePet makes a POST request to /pets with application/json body
+func (c *PetsClient) CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	return c.client.CreatePet(ctx, body, opts...)
+}
+
+// DeletePet makes a DELETE request to /pets/{id}
+func (c *PetsClient) DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error) {
+	return c.client.DeletePet(ctx, id, opts...)
+}
+
+// StoreAdminClientInterface is the interface specification for the operations
+// tagged "store admin". It is implemented by *StoreAdminClient, and by every
+// ClientInterface.
+type StoreAdminClientInterface interface {
+	// DeletePet makes a DELETE request to /pets/{id}
+	DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error)
+}
+
+// StoreAdminClient makes the requests of the operations tagged "store admin",
+// through the Client which returned it.
+type StoreAdminClient struct {
+	client *Client
+}
+
+// StoreAdmin returns the sub-client of the operations tagged "store admin".
+func (c *Client) StoreAdmin() *StoreAdminClient {
+	return &StoreAdminClient{client: c}
+}
+
+// DeletePet makes a DELETE request to /pets/{id}
+func (c *StoreAdminClient) DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error) {
+	return c.client.DeletePet(ctx, id, opts...)
+}
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	// limit (optional)
+	Limit *int `form:"limit" json:"limit"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *ListPetsParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Limit != nil {
+		if frag, err := StylePrimitiveParameter("limit", *p.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, FormatInt[int]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *ListPetsParams) FromURLValues(values url.Values) error {
+	if err := BindPrimitiveQueryParameter("limit", values, &p.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, ParseInt[int]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	return nil
+}
+
+// GetHealth makes a GET request to /health
+func (c *Client) GetHealth(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "getHealth", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// ListOrders makes a GET request to /orders
+func (c *Client) ListOrders(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewListOrdersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "listOrders", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// ListPets makes a GET request to /pets
+func (c *Client) ListPets(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewListPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "listPets", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// CreatePetWithBody makes a POST request to /pets
+func (c *Client) CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewCreatePetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "createPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// CreatePet makes a POST request to /pets with application/json body
+func (c *Client) CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewCreatePetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "createPet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// DeletePet makes a DELETE request to /pets/{id}
+func (c *Client) DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewDeletePetRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "deletePet", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// NewGetHealthRequest creates a GET request for /health
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOrdersRequest creates a GET request for /orders
+func NewListOrdersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPetsRequest creates a GET request for /pets
+func NewListPetsRequest(server string, params *ListPetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := reqURL.Query()
+		if params.Limit != nil {
+			if queryFrag, err := StylePrimitiveParameter("limit", *params.Limit, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, FormatInt[int]); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		reqURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePetRequest creates a POST request for /pets with application/json body
+func NewCreatePetRequest(server string, body createPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePetRequestWithBody creates a POST request for /pets with any body
+func NewCreatePetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", reqURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePetRequest creates a DELETE request for /pets/{id}
+func NewDeletePetRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+	pathParam0, err = StylePrimitiveParameter("id", id, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", AllowReserved: false}, FormatInt[int])
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// MockClient implements ClientInterface with a function field per method, so
+// that tests can stub individual calls. Calling a method whose function field
+// is nil panics.
+type MockClient struct {
+	GetHealthFn         func(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+	ListOrdersFn        func(ctx context.Context, opts ...RequestOption) (*http.Response, error)
+	ListPetsFn          func(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error)
+	CreatePetWithBodyFn func(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreatePetFn         func(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+	DeletePetFn         func(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error)
+}
+
+var _ ClientInterface = (*MockClient)(nil)
+
+// GetHealth calls GetHealthFn.
+func (m *MockClient) GetHealth(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	if m.GetHealthFn == nil {
+		panic("MockClient.GetHealth called but GetHealthFn is not set")
+	}
+	return m.GetHealthFn(ctx, opts...)
+}
+
+// ListOrders calls ListOrdersFn.
+func (m *MockClient) ListOrders(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
+	if m.ListOrdersFn == nil {
+		panic("MockClient.ListOrders called but ListOrdersFn is not set")
+	}
+	return m.ListOrdersFn(ctx, opts...)
+}
+
+// ListPets calls ListPetsFn.
+func (m *MockClient) ListPets(ctx context.Context, params *ListPetsParams, opts ...RequestOption) (*http.Response, error) {
+	if m.ListPetsFn == nil {
+		panic("MockClient.ListPets called but ListPetsFn is not set")
+	}
+	return m.ListPetsFn(ctx, params, opts...)
+}
+
+// CreatePetWithBody calls CreatePetWithBodyFn.
+func (m *MockClient) CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	if m.CreatePetWithBodyFn == nil {
+		panic("MockClient.CreatePetWithBody called but CreatePetWithBodyFn is not set")
+	}
+	return m.CreatePetWithBodyFn(ctx, contentType, body, opts...)
+}
+
+// CreatePet calls CreatePetFn.
+func (m *MockClient) CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	if m.CreatePetFn == nil {
+		panic("MockClient.CreatePet called but CreatePetFn is not set")
+	}
+	return m.CreatePetFn(ctx, body, opts...)
+}
+
+// DeletePet calls DeletePetFn.
+func (m *MockClient) DeletePet(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error) {
+	if m.DeletePetFn == nil {
+		panic("MockClient.DeletePet called but DeletePetFn is not set")
+	}
+	return m.DeletePetFn(ctx, id, opts...)
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// ParamLocation indicates where a parameter is located in an HTTP request.
+type ParamLocation int
+
+const (
+	ParamLocationUndefined ParamLocation = iota
+	ParamLocationQuery
+	ParamLocationPath
+	ParamLocationHeader
+	ParamLocationCookie
+)
+
+// MissingRequiredParameterError is returned when a required parameter is not
+// present in the request. Upper layers can use errors.As to detect this and
+// produce an appropriate HTTP error response.
+type MissingRequiredParameterError struct {
+	ParamName string
+}
+
+func (e *MissingRequiredParameterError) Error() string {
+	return fmt.Sprintf("parameter '%s' is required", e.ParamName)
+}
+
+// escapeParameterName escapes a parameter name for use in query strings and
+// paths. This ensures characters like [] in parameter names (e.g. user_ids[])
+// are properly percent-encoded per RFC 3986.
+func escapeParameterName(name string, paramLocation ParamLocation) string {
+	// Parameter names should always be encoded regardless of allowReserved,
+	// which only applies to values per the OpenAPI spec.
+	return escapeParameterString(name, paramLocation, false)
+}
+
+// escapeParameterString escapes a parameter value based on its location.
+// Query and path parameters need URL escaping; headers and cookies do not.
+// When allowReserved is true and the location is query, RFC 3986 reserved
+// characters are left unencoded per the OpenAPI allowReserved specification.
+func escapeParameterString(value string, paramLocation ParamLocation, allowReserved bool) string {
+	switch paramLocation {
+	case ParamLocationQuery:
+		if allowReserved {
+			return escapeQueryAllowReserved(value)
+		}
+		return url.QueryEscape(value)
+	case ParamLocationPath:
+		return url.PathEscape(value)
+	default:
+		return value
+	}
+}
+
+// escapeQueryAllowReserved percent-encodes a query parameter value while
+// leaving RFC 3986 reserved characters (:/?#[]@!$&'()*+,;=) unencoded, as
+// specified by OpenAPI's allowReserved parameter option.
+func escapeQueryAllowReserved(value string) string {
+	const reserved = `:/?#[]@!$&'()*+,;=`
+
+	var buf strings.Builder
+	for _, b := range []byte(value) {
+		if isUnreserved(b) || strings.IndexByte(reserved, b) >= 0 {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+// isUnreserved reports whether the byte is an RFC 3986 unreserved character:
+// ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// ParameterOptions carries OpenAPI parameter metadata to bind and style
+// functions so they can handle style dispatch, explode, required,
+// type-aware coercions, and location-aware escaping from a single
+// uniform call site. All fields have sensible zero-value defaults.
+type ParameterOptions struct {
+	Style         string        // OpenAPI style: "simple", "form", "label", "matrix", "deepObject", "pipeDelimited", "spaceDelimited"
+	ParamLocation ParamLocation // Where the parameter appears: query, path, header, cookie
+	Explode       bool
+	Required      bool
+	Type          string // OpenAPI type: "string", "integer", "array", "object"
+	Format        string // OpenAPI format: "int32", "date-time", etc.
+	AllowReserved bool   // When true, reserved characters in query values are not percent-encoded
+}
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// StylePrimitiveParameter serializes a parameter of a primitive type like
+// StyleParameter, formatting value with format, such as FormatInt[int32],
+// rather than by reflection.
+func StylePrimitiveParameter[T any](paramName string, value T, opts ParameterOptions, format func(T) string) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+	return styleString(style, paramName, opts.ParamLocation, opts.AllowReserved, format(value))
+}
+
+// BindPrimitiveQueryParameter binds a query parameter of a primitive type like
+// BindQueryParameter, parsing its value with parse, such as ParseInt[int32],
+// rather than by reflection. dest is the field of the parameter, a pointer
+// when the parameter is optional.
+func BindPrimitiveQueryParameter[T any, D *T | **T](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error)) error {
+	switch opts.Style {
+	case "", "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	values := queryParams[paramName]
+	switch {
+	case len(values) == 0:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	case len(values) != 1 && opts.Explode:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	case len(values) != 1:
+		return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+
+	v, err := parse(values[0])
+	if err != nil {
+		return err
+	}
+	switch d := any(dest).(type) {
+	case *T:
+		*d = v
+	case **T:
+		*d = &v
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
+	escapedName := escapeParameterName(paramName, paramLocation)
+
+	var prefix string
+	switch style {
+	case "simple":
+	case "label":
+		prefix = "."
+	case "matrix":
+		prefix = fmt.Sprintf(";%s=", escapedName)
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
+		prefix = fmt.Sprintf("%s=", escapedName)
+	default:
+		return "", fmt.Errorf("unsupported style '%s'", style)
+	}
+	return prefix + escapeParameterString(strVal, paramLocation, allowReserved), nil
+}
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
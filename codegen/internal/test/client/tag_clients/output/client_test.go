@@ -0,0 +1,68 @@
+package output
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every ClientInterface implements the tag interfaces, so MockClient can stand
+// in for a sub-client.
+var (
+	_ PetsClientInterface       = (*PetsClient)(nil)
+	_ PetsClientInterface       = (*MockClient)(nil)
+	_ OrdersClientInterface     = (*MockClient)(nil)
+	_ StoreAdminClientInterface = (*MockClient)(nil)
+)
+
+func TestTagClients(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	limit := 10
+	calls := []func() (*http.Response, error){
+		func() (*http.Response, error) { return client.Pets().ListPets(ctx, &ListPetsParams{Limit: &limit}) },
+		func() (*http.Response, error) { return client.Pets().CreatePet(ctx, Pet{Name: "Fido"}) },
+		func() (*http.Response, error) { return client.StoreAdmin().DeletePet(ctx, 7) },
+		func() (*http.Response, error) { return client.Orders().ListOrders(ctx) },
+	}
+	for _, call := range calls {
+		resp, err := call()
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	assert.Equal(t, []string{
+		"GET /pets?limit=10",
+		"POST /pets",
+		"DELETE /pets/7",
+		"GET /orders",
+	}, requests)
+}
+
+func TestTagClients_Mock(t *testing.T) {
+	var called bool
+	var pets PetsClientInterface = &MockClient{
+		DeletePetFn: func(ctx context.Context, id int, opts ...RequestOption) (*http.Response, error) {
+			called = true
+			assert.Equal(t, 7, id)
+			return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+		},
+	}
+
+	resp, err := pets.DeletePet(context.Background(), 7)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.True(t, called)
+}
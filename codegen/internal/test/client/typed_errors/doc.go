@@ -0,0 +1,5 @@
+// Package typed_errors tests the per-status error types returned by
+// SimpleClient methods for documented 4xx and 5xx responses.
+package typed_errors
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,97 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/jsonpointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a SimpleClient for a server which responds to every
+// request with status and body.
+func newTestClient(t *testing.T, status int, body string) *SimpleClient {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprint(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+	return client
+}
+
+func TestTypedErrors_NotFound(t *testing.T) {
+	client := newTestClient(t, http.StatusNotFound, `{"title":"no such pet"}`)
+
+	_, err := client.GetPet(context.Background(), 1)
+
+	var notFound *ClientNotFoundError[Problem]
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "no such pet", notFound.Body.Title)
+	assert.Equal(t, http.StatusNotFound, notFound.StatusCode)
+
+	// The generic error type still matches.
+	var httpErr *ClientHttpError[Problem]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.StatusCode)
+
+	var conflict *ClientConflictError[Conflict]
+	assert.False(t, errors.As(err, &conflict))
+}
+
+func TestTypedErrors_Conflict(t *testing.T) {
+	client := newTestClient(t, http.StatusConflict, `{"retryAfter":30}`)
+
+	_, err := client.GetPet(context.Background(), 1)
+
+	var conflict *ClientConflictError[Conflict]
+	require.ErrorAs(t, err, &conflict)
+	assert.Equal(t, 30, conflict.Body.RetryAfter)
+	assert.Equal(t, "HTTP 409", err.Error())
+}
+
+func TestTypedErrors_DecodeError(t *testing.T) {
+	client := newTestClient(t, http.StatusOK, `{"id":1,"name":7}`)
+
+	_, err := client.GetPet(context.Background(), 1)
+
+	// Decode errors point at the failing field.
+	var decodeErr *jsonpointer.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "/name", decodeErr.Pointer)
+	assert.ErrorContains(t, err, "decoding JSON at /name: ")
+}
+
+func TestTypedErrors_Range(t *testing.T) {
+	client := newTestClient(t, http.StatusBadGateway, `{"title":"upstream down"}`)
+
+	_, err := client.GetPet(context.Background(), 1)
+
+	var serverErr *Client5XXError[Problem]
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, http.StatusBadGateway, serverErr.StatusCode)
+	assert.Equal(t, "upstream down", serverErr.Body.Title)
+}
+
+func TestTypedErrors_Default(t *testing.T) {
+	client := newTestClient(t, http.StatusTeapot, `{"title":"teapot"}`)
+
+	_, err := client.GetPet(context.Background(), 1)
+
+	// Undocumented statuses fall back to the default response's error type.
+	var httpErr *ClientHttpError[Problem]
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, "teapot", httpErr.Body.Title)
+
+	var notFound *ClientNotFoundError[Problem]
+	assert.False(t, errors.As(err, &notFound))
+}
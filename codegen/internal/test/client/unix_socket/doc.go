@@ -0,0 +1,5 @@
+// Package unix_socket tests dialing generated clients over Unix domain
+// sockets and custom dialers.
+package unix_socket
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveUnixSocket serves items over a Unix socket, returning its path.
+func serveUnixSocket(t *testing.T) string {
+	t.Helper()
+	// Socket paths are limited to about a hundred bytes, which t.TempDir can
+	// exceed.
+	dir, err := os.MkdirTemp("", "uds")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "api.sock")
+
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := strings.CutPrefix(r.URL.Path, "/api/v1/items/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":` + id + `,"host":"` + r.Host + `"}`))
+	})}
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(func() { _ = srv.Close() })
+	return socket
+}
+
+func TestWithUnixSocket(t *testing.T) {
+	socket := serveUnixSocket(t)
+	client, err := NewSimpleClient("http://sidecar/api/v1", WithUnixSocket(socket))
+	require.NoError(t, err)
+
+	item, err := client.GetItem(context.Background(), 42)
+	require.NoError(t, err)
+	assert.Equal(t, Item{ID: 42, Host: "sidecar"}, item)
+}
+
+func TestUnixSocketServerURL(t *testing.T) {
+	socket := serveUnixSocket(t)
+	client, err := NewSimpleClient("http+unix://" + url.PathEscape(socket) + "/api/v1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost/api/v1/", client.Server)
+
+	item, err := client.GetItem(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, Item{ID: 7, Host: "localhost"}, item)
+}
+
+func TestWithDialer(t *testing.T) {
+	socket := serveUnixSocket(t)
+	var dialed atomic.Int32
+	var addrs []string
+	dial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed.Add(1)
+		addrs = append(addrs, addr)
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", socket)
+	}
+	client, err := NewSimpleClient("http://sidecar:8080/api/v1", WithDialer(dial))
+	require.NoError(t, err)
+
+	item, err := client.GetItem(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, Item{ID: 1, Host: "sidecar:8080"}, item)
+	assert.EqualValues(t, 1, dialed.Load())
+	assert.Equal(t, []string{"sidecar:8080"}, addrs)
+}
+
+func TestWithDialer_HTTPClient(t *testing.T) {
+	_, err := NewClient("http://sidecar", WithHTTPClient(http.DefaultClient), WithUnixSocket("/tmp/api.sock"))
+	assert.Error(t, err)
+}
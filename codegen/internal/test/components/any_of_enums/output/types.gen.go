@@ -17,7 +17,8 @@ import (
 // #/components/schemas/test
 
 type Test struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsTestAnyOf0 returns the union data inside the Test as a TestAnyOf0.
@@ -31,6 +32,7 @@ func (t Test) AsTestAnyOf0() (TestAnyOf0, error) {
 func (t *Test) FromTestAnyOf0(v TestAnyOf0) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -42,6 +44,7 @@ func (t *Test) MergeTestAnyOf0(v TestAnyOf0) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -56,6 +59,7 @@ func (t Test) AsTestAnyOf1() (TestAnyOf1, error) {
 func (t *Test) FromTestAnyOf1(v TestAnyOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -67,9 +71,46 @@ func (t *Test) MergeTestAnyOf1(v TestAnyOf1) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *Test) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsTestAnyOf0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsTestAnyOf1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesTestAnyOf0 reports whether the union data matches TestAnyOf0.
+func (t Test) MatchesTestAnyOf0() bool {
+	return t.matched[0]
+}
+
+// MatchesTestAnyOf1 reports whether the union data matches TestAnyOf1.
+func (t Test) MatchesTestAnyOf1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t Test) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t Test) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -77,6 +118,7 @@ func (t Test) MarshalJSON() ([]byte, error) {
 
 func (t *Test) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -95,7 +137,7 @@ func (s *TestAnyOf0) ApplyDefaults() {
 
 // #/components/schemas/test/anyOf/1
 type TestAnyOf1 struct {
-	FieldA *string `form:"fieldA,omitempty" json:"fieldA,omitempty"`
+	FieldA *TestAnyOf1FieldA `form:"fieldA,omitempty" json:"fieldA,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -110,14 +152,29 @@ const (
 	Bar TestAnyOf1FieldA = "bar"
 )
 
+// Values returns the TestAnyOf1FieldA constants, in the order of the spec.
+func (TestAnyOf1FieldA) Values() []TestAnyOf1FieldA {
+	return []TestAnyOf1FieldA{Foo, Bar}
+}
+
+// ParseTestAnyOf1FieldA returns the TestAnyOf1FieldA constant whose value is s. Other strings
+// return an error.
+func ParseTestAnyOf1FieldA(s string) (TestAnyOf1FieldA, error) {
+	switch v := TestAnyOf1FieldA(s); v {
+	case Foo, Bar:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid TestAnyOf1FieldA value %q", s)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
 	"H4sIAAAAAAAC/6xSsW7cMAzd9RUPcYEsvfMl7VJtHTN1KdBZtmlLwZkURLrF/X0hOwdfrh3DiXqkHt8T",
 	"1eBFdSE8fX3+5kG8zGAxTMRUgtGAP5EYi9YsWUTgy4/RNYhmWX3bTsni0h17mVsJOR16GWgifn9IdYS2",
-	"dYZrXINflTMgF8lU7ILEmgZC4I0eM80dFcSgFauiPsMibfLsksk10CjLeUBHu9ajk0wccvL4cjwdTy7x",
+	"dYZrXINflTMgF8lU7ILEmgZC4I0eM80dFcSgFauiPsMirRnsksk10CjLeUBHu9ajk0wccvL4cjwdTy7x",
 	"KN4BluxM/sYpfpKaA35T0STs8bT252BR64XWSK0mwERvCVDVBkvCL4O/MtQopFlYSa+NwMPz6fSwH4Fe",
 	"2IjtFgJCzufUr5Ttqwq/rwLaR5rDPQp8KjR6PDZtL3MWJjZtt15dlT+6vVBvv9U2ot0a1rf0kO6V+quZ",
-	"dQP7yMN1S+nWXo0x0Xn4fi9uY1QriaePJdmifoF/H+SAUeQ/aBeK+xsAAP//dJpKJ+ICAAA=",
+	"dQP7yMN1S+nWXo0x0Xn4fi9uY1QriaePJdmifoF/H+SAUeQ/aBeK+zsAdJpKJ+ICAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
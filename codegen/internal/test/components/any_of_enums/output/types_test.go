@@ -38,7 +38,7 @@ func TestFromAsTestAnyOf0(t *testing.T) {
 // TestFromAsTestAnyOf1 tests the variant with an enum-constrained string field.
 func TestFromAsTestAnyOf1(t *testing.T) {
 	var u Test
-	err := u.FromTestAnyOf1(TestAnyOf1{FieldA: ptr(string(Foo))})
+	err := u.FromTestAnyOf1(TestAnyOf1{FieldA: ptr(Foo)})
 	require.NoError(t, err)
 
 	data, err := u.MarshalJSON()
@@ -51,7 +51,7 @@ func TestFromAsTestAnyOf1(t *testing.T) {
 	got, err := decoded.AsTestAnyOf1()
 	require.NoError(t, err)
 	require.NotNil(t, got.FieldA)
-	assert.Equal(t, "foo", *got.FieldA)
+	assert.Equal(t, Foo, *got.FieldA)
 }
 
 // TestUnmarshalJSONIntoUnion verifies that raw JSON unmarshals into the union
@@ -71,7 +71,7 @@ func TestUnmarshalJSONIntoUnion(t *testing.T) {
 	v1, err := u.AsTestAnyOf1()
 	require.NoError(t, err)
 	require.NotNil(t, v1.FieldA)
-	assert.Equal(t, "bar", *v1.FieldA)
+	assert.Equal(t, Bar, *v1.FieldA)
 }
 
 func TestApplyDefaults(t *testing.T) {
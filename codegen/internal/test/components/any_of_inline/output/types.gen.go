@@ -70,7 +70,8 @@ type GetPets200ResponseJSON1 = []GetPets200ResponseJSON2
 // #/paths//pets/get/responses/200/content/application/json/schema/properties/data/items
 
 type GetPets200ResponseJSON2 struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [3]bool
 }
 
 // AsCat returns the union data inside the GetPets200ResponseJSON2 as a Cat.
@@ -84,6 +85,7 @@ func (t GetPets200ResponseJSON2) AsCat() (Cat, error) {
 func (t *GetPets200ResponseJSON2) FromCat(v Cat) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -95,6 +97,7 @@ func (t *GetPets200ResponseJSON2) MergeCat(v Cat) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -109,6 +112,7 @@ func (t GetPets200ResponseJSON2) AsDog() (Dog, error) {
 func (t *GetPets200ResponseJSON2) FromDog(v Dog) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -120,6 +124,7 @@ func (t *GetPets200ResponseJSON2) MergeDog(v Dog) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -134,6 +139,7 @@ func (t GetPets200ResponseJSON2) AsRat() (Rat, error) {
 func (t *GetPets200ResponseJSON2) FromRat(v Rat) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -145,9 +151,54 @@ func (t *GetPets200ResponseJSON2) MergeRat(v Rat) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *GetPets200ResponseJSON2) match() {
+	t.matched = [3]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsCat(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsDog(); err == nil {
+		t.matched[1] = true
+	}
+	if _, err := t.AsRat(); err == nil {
+		t.matched[2] = true
+	}
+}
+
+// MatchesCat reports whether the union data matches Cat.
+func (t GetPets200ResponseJSON2) MatchesCat() bool {
+	return t.matched[0]
+}
+
+// MatchesDog reports whether the union data matches Dog.
+func (t GetPets200ResponseJSON2) MatchesDog() bool {
+	return t.matched[1]
+}
+
+// MatchesRat reports whether the union data matches Rat.
+func (t GetPets200ResponseJSON2) MatchesRat() bool {
+	return t.matched[2]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t GetPets200ResponseJSON2) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t GetPets200ResponseJSON2) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -155,6 +206,7 @@ func (t GetPets200ResponseJSON2) MarshalJSON() ([]byte, error) {
 
 func (t *GetPets200ResponseJSON2) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -164,16 +216,16 @@ func (t *GetPets200ResponseJSON2) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9RTzW7bPBC86ykG+D7Al8Zy+nPRzUiCwuihQdICBYoe1tTaYiqR7O4qrfv0hWQ7luMk",
-	"bnMo0Bs53BnOkLsxcaDkC7waT8aTzIdFLDLglkV9DAVOexgwbzUXOCPTFziPSwWFEldkiunlLANKVic+",
-	"Wc/6UPkeB9V1/K6wiuFqz8FgEcKO/S2ju0wa6iigeWwNrpcvt/JCpuNMWTo7na0TtFIXqMySFnnOP6hJ",
-	"NY9dbHJKPlN2rXhbrUunyb/j1bS1qsDnL1kiq3qRPLH1C2DJtl4A2jYNyarAWzYQaq+GuEBXu6k4TMih",
-	"TNEHg7C1EvQeb4wLclW3hKOAOYO9VSygLmifE7HbCdl4c0lMLP2LzMqis3e5u19YUwzKurUMjF5OJqPd",
-	"9p7H69Y5Vl209R13UOpiMA42ZAOUUu1dbyC/0Rj2TwF1FTd0HwVslbhAnN+ws4PDJF0q80PnA8tk9BC+",
-	"FSURWj147o0bfZgKUFi9Xzx22LXH/8KLAqP/chebFAMH03wdT/MzstEzqedx+Vzq1fDW0evJ6eNf+zFQ",
-	"a1UU/5PLHeXNU90wC8YSqMZ1P0+4EImS7Wx0xO0AXXeOtt81mKNs72N6fAP5UKBiKlk2QKCGC3w6mV7O",
-	"TtZlm5hrkTOyfbW93jkcNa/rqckebylfDrOvVdXEh+Ud3Js6VjQX5uNSLtZRjlalVkQPq+Yx1kyhx8/j",
-	"8s9foozLf+wl5iRfj7zE1XN6Qv5KT/xeRv3WMj2V8lcAAAD//xm6HytuBwAA",
+	"H4sIAAAAAAAC/9RUT28TPxC9+1M86feTciHZlD8X36K2QhEHqhYkJMRh4p1kXXZtM54thE+PdtN00zZp",
+	"oAckcvK+mTd+bzyTmDhQ8havJtPJ1PiwjNYANyzZx2Bx0sOAeq3Z4pQ0v8BZXGVQKHFJmjG7mBug5OzE",
+	"J+1ZHyrf46C6jt8ztGK42nNQaISwY3/D6C6ThjoKaBFbhevLl9vyQponJrN0cqwBxmiltqhUU7ZFwT+o",
+	"STVPXGwKSt5kdq14XW9SZ8m/4/Ws1cri8xeTSKu+SJFY+wOwYt0cgNw2Dcna4i0rCLXPirhEl2sAYJ9D",
+	"DmWKPiiEtZWQH/AmOCdXdUc4Clgw2GvFAuqM9j4Ruy8hnRgAAGJi6TsyL20n72K4XzinGDLnrWRg9HI6",
+	"HQ2fDzRetc5xzsu2vuPupLoYlIPusgFKqfauF1Bc5xjuR4HsKm7oIQroOrFFXFyz00fBJJ0r9bvKh19J",
+	"SvvwbVESofXeuFdu8n4qQGH9fnkoCIzxv/DSYvRf4WKTYuCgudjYy8Up6eiZ1LO4ei71cvfW0evpyeGn",
+	"/Rio1SqK/8nlQHnz1DTMg7IEqnHV7xPORaKYQUZH3C7QVado+1w7e2TuPUyP30I+WFRMJcstEKhhi0/j",
+	"2cV8vEm7tbkpckpqzcHZebxqPm+2xhweKV8O523VrOLD6g7uRR1LWgjz8VIu1lGOZqVWJD/OWsRYMwUD",
+	"oPsz/fNOlHH1j3ViQfL1SCcunzMT8ldm4vc85m8t01Mufw0AGbofK24HAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -0,0 +1,5 @@
+// Package any_of_multi_match tests anyOf unions recording every member the
+// data matches, such as capability mixins.
+package any_of_multi_match
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,351 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+// #/components/schemas/Resource
+
+type Resource struct {
+	union   json.RawMessage
+	matched [3]bool
+}
+
+// AsTimestamped returns the union data inside the Resource as a Timestamped.
+func (t Resource) AsTimestamped() (Timestamped, error) {
+	var body Timestamped
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTimestamped overwrites any union data inside the Resource as the provided Timestamped.
+func (t *Resource) FromTimestamped(v Timestamped) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	t.match()
+	return err
+}
+
+// MergeTimestamped performs a merge with any union data inside the Resource, using the provided Timestamped.
+func (t *Resource) MergeTimestamped(v Timestamped) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	t.match()
+	return err
+}
+
+// AsOwned returns the union data inside the Resource as a Owned.
+func (t Resource) AsOwned() (Owned, error) {
+	var body Owned
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOwned overwrites any union data inside the Resource as the provided Owned.
+func (t *Resource) FromOwned(v Owned) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	t.match()
+	return err
+}
+
+// MergeOwned performs a merge with any union data inside the Resource, using the provided Owned.
+func (t *Resource) MergeOwned(v Owned) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	t.match()
+	return err
+}
+
+// AsTagged returns the union data inside the Resource as a Tagged.
+func (t Resource) AsTagged() (Tagged, error) {
+	var body Tagged
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTagged overwrites any union data inside the Resource as the provided Tagged.
+func (t *Resource) FromTagged(v Tagged) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	t.match()
+	return err
+}
+
+// MergeTagged performs a merge with any union data inside the Resource, using the provided Tagged.
+func (t *Resource) MergeTagged(v Tagged) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	t.match()
+	return err
+}
+
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *Resource) match() {
+	t.matched = [3]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsTimestamped(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "createdAt") {
+		t.matched[0] = true
+	}
+	if _, err := t.AsOwned(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "owner") {
+		t.matched[1] = true
+	}
+	if _, err := t.AsTagged(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "tags") {
+		t.matched[2] = true
+	}
+}
+
+// MatchesTimestamped reports whether the union data matches Timestamped.
+func (t Resource) MatchesTimestamped() bool {
+	return t.matched[0]
+}
+
+// MatchesOwned reports whether the union data matches Owned.
+func (t Resource) MatchesOwned() bool {
+	return t.matched[1]
+}
+
+// MatchesTagged reports whether the union data matches Tagged.
+func (t Resource) MatchesTagged() bool {
+	return t.matched[2]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t Resource) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (t Resource) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Resource) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	t.match()
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *Resource) ApplyDefaults() {
+}
+
+// #/components/schemas/Timestamped
+type Timestamped struct {
+	CreatedAt time.Time `form:"createdAt" json:"createdAt"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Timestamped) ApplyDefaults() {
+}
+
+// #/components/schemas/Owned
+type Owned struct {
+	Owner string `form:"owner" json:"owner"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Owned) ApplyDefaults() {
+}
+
+// #/components/schemas/Tagged
+type Tagged struct {
+	Tags []string `form:"tags" json:"tags"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Tagged) ApplyDefaults() {
+}
+
+// #/components/schemas/Identifier
+
+type Identifier struct {
+	union   json.RawMessage
+	matched [2]bool
+}
+
+// AsString0 returns the union data inside the Identifier as a string.
+func (t Identifier) AsString0() (string, error) {
+	var body string
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromString0 overwrites any union data inside the Identifier as the provided string.
+func (t *Identifier) FromString0(v string) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	t.match()
+	return err
+}
+
+// MergeString0 performs a merge with any union data inside the Identifier, using the provided string.
+func (t *Identifier) MergeString0(v string) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	t.match()
+	return err
+}
+
+// AsInt1 returns the union data inside the Identifier as a int.
+func (t Identifier) AsInt1() (int, error) {
+	var body int
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInt1 overwrites any union data inside the Identifier as the provided int.
+func (t *Identifier) FromInt1(v int) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	t.match()
+	return err
+}
+
+// MergeInt1 performs a merge with any union data inside the Identifier, using the provided int.
+func (t *Identifier) MergeInt1(v int) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	t.match()
+	return err
+}
+
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *Identifier) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsInt1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t Identifier) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesInt1 reports whether the union data matches int.
+func (t Identifier) MatchesInt1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t Identifier) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (t Identifier) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Identifier) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	t.match()
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *Identifier) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/4ySzY7TQBCE736KksM1CYjb3PYIEkSCvSEO7XHbaZT5oafDYiHeHa1/SBDZyDe7uqrr",
+	"G3tS5khZHOq3uze713UlsUuuAkzsxA4Uh0OHcD6ZbAOZP1bAD9YiKTrUYyKTHYvDr9+VTyGnyNGKq4Di",
+	"jxxofAQ2eIBySWf1DE+qwuV5OXwKjUQySRGpg6dMjZzEBgT5KbHsxvinOTotw4S1vABbvFLuHOrN/sKw",
+	"nwH2jxK4GIXMbb0ycniK682P1PeL+6prwbMhs0NqvrG3WVL+fhbl1uGLVybj9sG+zrOsKbOacLmc76/p",
+	"Ii17i6nE/krukgYyh5aMtyaBx9l4oHVE6Smy3qMZDXdJpi+yrs6oL/fanuf/l5EqDVeqGId/bDeoNvjA",
+	"oWEtsHRqQZnU0AywI4vi/efDxzEz3bh3LUeTTlhfvHM3f8AiSzTuWas/AwAJon9WYQMAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
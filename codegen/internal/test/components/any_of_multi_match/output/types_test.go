@@ -0,0 +1,65 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceMatchesEveryMixin(t *testing.T) {
+	var r Resource
+	require.NoError(t, json.Unmarshal([]byte(`{"createdAt":"2024-02-29T13:45:00Z","owner":"ann"}`), &r))
+
+	assert.True(t, r.Valid())
+	assert.True(t, r.MatchesTimestamped())
+	assert.True(t, r.MatchesOwned())
+	assert.False(t, r.MatchesTagged())
+
+	owned, err := r.AsOwned()
+	require.NoError(t, err)
+	assert.Equal(t, "ann", owned.Owner)
+}
+
+func TestResourceMatchesNone(t *testing.T) {
+	var r Resource
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"x"}`), &r))
+	assert.False(t, r.Valid())
+	assert.False(t, r.MatchesTimestamped())
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &r))
+	assert.False(t, r.Valid())
+}
+
+func TestResourceMergeAddsMatches(t *testing.T) {
+	var r Resource
+	require.NoError(t, r.FromOwned(Owned{Owner: "ann"}))
+	assert.True(t, r.MatchesOwned())
+	assert.False(t, r.MatchesTagged())
+
+	require.NoError(t, r.MergeTagged(Tagged{Tags: []string{"red"}}))
+	require.NoError(t, r.MergeTimestamped(Timestamped{CreatedAt: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}))
+	assert.True(t, r.MatchesOwned())
+	assert.True(t, r.MatchesTagged())
+	assert.True(t, r.MatchesTimestamped())
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owner":"ann","tags":["red"],"createdAt":"2024-02-29T00:00:00Z"}`, string(b))
+}
+
+func TestIdentifierMatchesByJSONType(t *testing.T) {
+	var id Identifier
+	require.NoError(t, json.Unmarshal([]byte(`"abc"`), &id))
+	assert.True(t, id.MatchesString0())
+	assert.False(t, id.MatchesInt1())
+
+	require.NoError(t, json.Unmarshal([]byte(`42`), &id))
+	assert.False(t, id.MatchesString0())
+	assert.True(t, id.MatchesInt1())
+
+	require.NoError(t, json.Unmarshal([]byte(`true`), &id))
+	assert.False(t, id.Valid())
+}
@@ -40,7 +40,8 @@ func (s *Application) ApplyDefaults() {
 
 // Optional claims configuration
 type ApplicationOptionalClaims struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [1]bool
 }
 
 // AsOptionalClaims returns the union data inside the ApplicationOptionalClaims as a OptionalClaims.
@@ -54,6 +55,7 @@ func (t ApplicationOptionalClaims) AsOptionalClaims() (OptionalClaims, error) {
 func (t *ApplicationOptionalClaims) FromOptionalClaims(v OptionalClaims) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -65,9 +67,38 @@ func (t *ApplicationOptionalClaims) MergeOptionalClaims(v OptionalClaims) error
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ApplicationOptionalClaims) match() {
+	t.matched = [1]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsOptionalClaims(); err == nil {
+		t.matched[0] = true
+	}
+}
+
+// MatchesOptionalClaims reports whether the union data matches OptionalClaims.
+func (t ApplicationOptionalClaims) MatchesOptionalClaims() bool {
+	return t.matched[0]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ApplicationOptionalClaims) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ApplicationOptionalClaims) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -75,6 +106,7 @@ func (t ApplicationOptionalClaims) MarshalJSON() ([]byte, error) {
 
 func (t *ApplicationOptionalClaims) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -84,12 +116,12 @@ func (t *ApplicationOptionalClaims) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/5RRvYocPRDM9RQF88Eldzv7nblEmXHkaJMDx1pNz6htTbeQemwW43c3M7feHzAczqRS",
-	"laq6usPn1hbCy/7ZI8jpMPYh58OIH2wJKvkEFUKlEXYqhImEajBqK9l1SGal+b6f2NJy3EWdew2Fn6IO",
-	"NJHcX3i1av3L/tl1rsOXRPLmCa14s02h3blSJYn0CDa0pEseLglcB0s3nGEL+AhRWz/dOS0kobDHh91+",
-	"t3cso3oHGFsmfx0br9TMAd+pNlbx+H+jl2Cpefz85aLORYXE2ipvMdEctiNwKMYqIX/Kgeczhi2Hhx6/",
-	"UrQzVKoWqsZ0IQE8vOo3kivwR9msskwXOMRIrb3P/VhK5hjWRP+YRMJM78bQv866BVxXeAsAT/iv0ujx",
-	"0PXX+vpzd/19bQ83yoFarLw9+0u7iBsPUWXkaanbiDciWXIOx3WpVhdyvwMAAP//35X1R9MCAAA=",
+	"H4sIAAAAAAAC/5RRzYrcPBC86ykK/MFedsfzbdiLbiGnnOaykLNGbludyN1CaicMIe8e7J3MDwSW+GSV",
+	"qlTV1R0+t7YQXvbPHkFOh7EPOR9G/GBLUMknqBAqjbBTIUwkVINRW8muQzIrzff9xJaW4y7q3Gso/BR1",
+	"oInk/sCrVetf9s+ucx2+JJI3T2jFm20K7c6VKkmkR7ChJV3ycEngOli64QxbwEeI2vrozmkhCYU9Puz2",
+	"u71jGdU7wNgy+evYeKVmDvhOtbGKx/8bvQRLzePnLxd1Liok1lZ5i4nmsP0Ch2KsEvKnHHg+Y9hyeOjx",
+	"K0U7Q6VqoWpMFxLAw6t+I7kCf5TNKst0gUOM1Nr73I+lZI5hTfSPSSTM9G4M/eus67et8BYAnvBfpdHj",
+	"oeuv9fXn7vr72h5ulAO1WHm79pd2ETceosrI01K3EW9EsuQcjutSrS7kfg8A35X1R9MCAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -1000,6 +1000,54 @@ func (t OneOfObject5) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfObject5Variant is implemented by each member of OneOfObject5, so that the
+// member its discriminator property selects can be handled as its own type.
+type OneOfObject5Variant interface {
+	isOneOfObject5Variant()
+}
+
+func (OneOfVariant4) isOneOfObject5Variant() {}
+
+func (OneOfVariant5) isOneOfObject5Variant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfObject5) Variant() (OneOfObject5Variant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "OneOfVariant4":
+		return t.AsOneOfVariant4()
+	case "OneOfVariant5":
+		return t.AsOneOfVariant5()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfObject5 as the provided
+// member, setting its discriminator value.
+func (t *OneOfObject5) FromVariant(v OneOfObject5Variant) error {
+	switch v := v.(type) {
+	case OneOfVariant4:
+		return t.FromOneOfVariant4(v)
+	case *OneOfVariant4:
+		if v != nil {
+			return t.FromOneOfVariant4(*v)
+		}
+	case OneOfVariant5:
+		return t.FromOneOfVariant5(v)
+	case *OneOfVariant5:
+		if v != nil {
+			return t.FromOneOfVariant5(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfObject5Variant %T", v)
+}
+
 func (t OneOfObject5) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1100,6 +1148,54 @@ func (t OneOfObject6) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfObject6Variant is implemented by each member of OneOfObject6, so that the
+// member its discriminator property selects can be handled as its own type.
+type OneOfObject6Variant interface {
+	isOneOfObject6Variant()
+}
+
+func (OneOfVariant4) isOneOfObject6Variant() {}
+
+func (OneOfVariant5) isOneOfObject6Variant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfObject6) Variant() (OneOfObject6Variant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v4":
+		return t.AsOneOfVariant4()
+	case "v5":
+		return t.AsOneOfVariant5()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfObject6 as the provided
+// member, setting its discriminator value.
+func (t *OneOfObject6) FromVariant(v OneOfObject6Variant) error {
+	switch v := v.(type) {
+	case OneOfVariant4:
+		return t.FromOneOfVariant4(v)
+	case *OneOfVariant4:
+		if v != nil {
+			return t.FromOneOfVariant4(*v)
+		}
+	case OneOfVariant5:
+		return t.FromOneOfVariant5(v)
+	case *OneOfVariant5:
+		if v != nil {
+			return t.FromOneOfVariant5(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfObject6Variant %T", v)
+}
+
 func (t OneOfObject6) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1566,6 +1662,54 @@ func (t OneOfObject9) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfObject9Variant is implemented by each member of OneOfObject9, so that the
+// member its type property selects can be handled as its own type.
+type OneOfObject9Variant interface {
+	isOneOfObject9Variant()
+}
+
+func (OneOfVariant1) isOneOfObject9Variant() {}
+
+func (OneOfVariant6) isOneOfObject9Variant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfObject9) Variant() (OneOfObject9Variant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1":
+		return t.AsOneOfVariant1()
+	case "v6":
+		return t.AsOneOfVariant6()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfObject9 as the provided
+// member, setting its discriminator value.
+func (t *OneOfObject9) FromVariant(v OneOfObject9Variant) error {
+	switch v := v.(type) {
+	case OneOfVariant1:
+		return t.FromOneOfVariant1(v)
+	case *OneOfVariant1:
+		if v != nil {
+			return t.FromOneOfVariant1(*v)
+		}
+	case OneOfVariant6:
+		return t.FromOneOfVariant6(v)
+	case *OneOfVariant6:
+		if v != nil {
+			return t.FromOneOfVariant6(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfObject9Variant %T", v)
+}
+
 func (t OneOfObject9) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -1897,6 +2041,106 @@ func (s *OneOfObject12) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// AsString0 returns the OneOfObject12AllOf0 union data inside the OneOfObject12 as a string.
+func (s OneOfObject12) AsString0() (string, error) {
+	var union OneOfObject12AllOf0
+	if s.OneOfObject12AllOf0 != nil {
+		union = *s.OneOfObject12AllOf0
+	}
+	return union.AsString0()
+}
+
+// FromString0 overwrites the OneOfObject12AllOf0 union data inside the OneOfObject12 as the provided string.
+func (s *OneOfObject12) FromString0(v string) error {
+	if s.OneOfObject12AllOf0 == nil {
+		s.OneOfObject12AllOf0 = new(OneOfObject12AllOf0)
+	}
+	return s.OneOfObject12AllOf0.FromString0(v)
+}
+
+// MergeString0 performs a merge with the OneOfObject12AllOf0 union data inside the OneOfObject12, using the provided string.
+func (s *OneOfObject12) MergeString0(v string) error {
+	if s.OneOfObject12AllOf0 == nil {
+		s.OneOfObject12AllOf0 = new(OneOfObject12AllOf0)
+	}
+	return s.OneOfObject12AllOf0.MergeString0(v)
+}
+
+// AsFloat321 returns the OneOfObject12AllOf0 union data inside the OneOfObject12 as a float32.
+func (s OneOfObject12) AsFloat321() (float32, error) {
+	var union OneOfObject12AllOf0
+	if s.OneOfObject12AllOf0 != nil {
+		union = *s.OneOfObject12AllOf0
+	}
+	return union.AsFloat321()
+}
+
+// FromFloat321 overwrites the OneOfObject12AllOf0 union data inside the OneOfObject12 as the provided float32.
+func (s *OneOfObject12) FromFloat321(v float32) error {
+	if s.OneOfObject12AllOf0 == nil {
+		s.OneOfObject12AllOf0 = new(OneOfObject12AllOf0)
+	}
+	return s.OneOfObject12AllOf0.FromFloat321(v)
+}
+
+// MergeFloat321 performs a merge with the OneOfObject12AllOf0 union data inside the OneOfObject12, using the provided float32.
+func (s *OneOfObject12) MergeFloat321(v float32) error {
+	if s.OneOfObject12AllOf0 == nil {
+		s.OneOfObject12AllOf0 = new(OneOfObject12AllOf0)
+	}
+	return s.OneOfObject12AllOf0.MergeFloat321(v)
+}
+
+// AsOneOfVariant3 returns the OneOfObject12AllOf1 union data inside the OneOfObject12 as a OneOfVariant3.
+func (s OneOfObject12) AsOneOfVariant3() (OneOfVariant3, error) {
+	var union OneOfObject12AllOf1
+	if s.OneOfObject12AllOf1 != nil {
+		union = *s.OneOfObject12AllOf1
+	}
+	return union.AsOneOfVariant3()
+}
+
+// FromOneOfVariant3 overwrites the OneOfObject12AllOf1 union data inside the OneOfObject12 as the provided OneOfVariant3.
+func (s *OneOfObject12) FromOneOfVariant3(v OneOfVariant3) error {
+	if s.OneOfObject12AllOf1 == nil {
+		s.OneOfObject12AllOf1 = new(OneOfObject12AllOf1)
+	}
+	return s.OneOfObject12AllOf1.FromOneOfVariant3(v)
+}
+
+// MergeOneOfVariant3 performs a merge with the OneOfObject12AllOf1 union data inside the OneOfObject12, using the provided OneOfVariant3.
+func (s *OneOfObject12) MergeOneOfVariant3(v OneOfVariant3) error {
+	if s.OneOfObject12AllOf1 == nil {
+		s.OneOfObject12AllOf1 = new(OneOfObject12AllOf1)
+	}
+	return s.OneOfObject12AllOf1.MergeOneOfVariant3(v)
+}
+
+// AsOneOfVariant4 returns the OneOfObject12AllOf1 union data inside the OneOfObject12 as a OneOfVariant4.
+func (s OneOfObject12) AsOneOfVariant4() (OneOfVariant4, error) {
+	var union OneOfObject12AllOf1
+	if s.OneOfObject12AllOf1 != nil {
+		union = *s.OneOfObject12AllOf1
+	}
+	return union.AsOneOfVariant4()
+}
+
+// FromOneOfVariant4 overwrites the OneOfObject12AllOf1 union data inside the OneOfObject12 as the provided OneOfVariant4.
+func (s *OneOfObject12) FromOneOfVariant4(v OneOfVariant4) error {
+	if s.OneOfObject12AllOf1 == nil {
+		s.OneOfObject12AllOf1 = new(OneOfObject12AllOf1)
+	}
+	return s.OneOfObject12AllOf1.FromOneOfVariant4(v)
+}
+
+// MergeOneOfVariant4 performs a merge with the OneOfObject12AllOf1 union data inside the OneOfObject12, using the provided OneOfVariant4.
+func (s *OneOfObject12) MergeOneOfVariant4(v OneOfVariant4) error {
+	if s.OneOfObject12AllOf1 == nil {
+		s.OneOfObject12AllOf1 = new(OneOfObject12AllOf1)
+	}
+	return s.OneOfObject12AllOf1.MergeOneOfVariant4(v)
+}
+
 // ApplyDefaults sets default values for fields that are nil.
 func (s *OneOfObject12) ApplyDefaults() {
 }
@@ -2128,6 +2372,54 @@ func (t OneOfObject13) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfObject13Variant is implemented by each member of OneOfObject13, so that the
+// member its type property selects can be handled as its own type.
+type OneOfObject13Variant interface {
+	isOneOfObject13Variant()
+}
+
+func (OneOfVariant1) isOneOfObject13Variant() {}
+
+func (OneOfVariant6) isOneOfObject13Variant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfObject13) Variant() (OneOfObject13Variant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "v1":
+		return t.AsOneOfVariant1()
+	case "v6":
+		return t.AsOneOfVariant6()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfObject13 as the provided
+// member, setting its discriminator value.
+func (t *OneOfObject13) FromVariant(v OneOfObject13Variant) error {
+	switch v := v.(type) {
+	case OneOfVariant1:
+		return t.FromOneOfVariant1(v)
+	case *OneOfVariant1:
+		if v != nil {
+			return t.FromOneOfVariant1(*v)
+		}
+	case OneOfVariant6:
+		return t.FromOneOfVariant6(v)
+	case *OneOfVariant6:
+		if v != nil {
+			return t.FromOneOfVariant6(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfObject13Variant %T", v)
+}
+
 func (t OneOfObject13) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -2175,7 +2467,8 @@ func (t *OneOfObject13) ApplyDefaults() {
 
 // simple anyOf case
 type AnyOfObject1 struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsOneOfVariant4 returns the union data inside the AnyOfObject1 as a OneOfVariant4.
@@ -2189,6 +2482,7 @@ func (t AnyOfObject1) AsOneOfVariant4() (OneOfVariant4, error) {
 func (t *AnyOfObject1) FromOneOfVariant4(v OneOfVariant4) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -2200,6 +2494,7 @@ func (t *AnyOfObject1) MergeOneOfVariant4(v OneOfVariant4) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -2214,6 +2509,7 @@ func (t AnyOfObject1) AsOneOfVariant5() (OneOfVariant5, error) {
 func (t *AnyOfObject1) FromOneOfVariant5(v OneOfVariant5) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -2225,9 +2521,46 @@ func (t *AnyOfObject1) MergeOneOfVariant5(v OneOfVariant5) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *AnyOfObject1) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsOneOfVariant4(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "discriminator", "name") {
+		t.matched[0] = true
+	}
+	if _, err := t.AsOneOfVariant5(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "discriminator", "id") {
+		t.matched[1] = true
+	}
+}
+
+// MatchesOneOfVariant4 reports whether the union data matches OneOfVariant4.
+func (t AnyOfObject1) MatchesOneOfVariant4() bool {
+	return t.matched[0]
+}
+
+// MatchesOneOfVariant5 reports whether the union data matches OneOfVariant5.
+func (t AnyOfObject1) MatchesOneOfVariant5() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t AnyOfObject1) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t AnyOfObject1) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -2235,6 +2568,7 @@ func (t AnyOfObject1) MarshalJSON() ([]byte, error) {
 
 func (t *AnyOfObject1) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -2318,6 +2652,21 @@ const (
 	Enum1Three Enum1 = "Three"
 )
 
+// Values returns the Enum1 constants, in the order of the spec.
+func (Enum1) Values() []Enum1 {
+	return []Enum1{Enum1One, Enum1Two, Enum1Three}
+}
+
+// ParseEnum1 returns the Enum1 constant whose value is s. Other strings
+// return an error.
+func ParseEnum1(s string) (Enum1, error) {
+	switch v := Enum1(s); v {
+	case Enum1One, Enum1Two, Enum1Three:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Enum1 value %q", s)
+}
+
 // #/components/schemas/Enum2
 // Conflicts with Enum1, enum values need to be prefixed with type
 // name.
@@ -2329,6 +2678,21 @@ const (
 	Enum2Four  Enum2 = "Four"
 )
 
+// Values returns the Enum2 constants, in the order of the spec.
+func (Enum2) Values() []Enum2 {
+	return []Enum2{Enum2Two, Enum2Three, Enum2Four}
+}
+
+// ParseEnum2 returns the Enum2 constant whose value is s. Other strings
+// return an error.
+func ParseEnum2(s string) (Enum2, error) {
+	switch v := Enum2(s); v {
+	case Enum2Two, Enum2Three, Enum2Four:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Enum2 value %q", s)
+}
+
 // #/components/schemas/Enum3
 // Enum values conflict with Enums above, need to be prefixed
 // with type name.
@@ -2340,6 +2704,21 @@ const (
 	Enum3Bar      Enum3 = "Bar"
 )
 
+// Values returns the Enum3 constants, in the order of the spec.
+func (Enum3) Values() []Enum3 {
+	return []Enum3{Enum3Enum1One, Enum3Foo, Enum3Bar}
+}
+
+// ParseEnum3 returns the Enum3 constant whose value is s. Other strings
+// return an error.
+func ParseEnum3(s string) (Enum3, error) {
+	switch v := Enum3(s); v {
+	case Enum3Enum1One, Enum3Foo, Enum3Bar:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Enum3 value %q", s)
+}
+
 // #/components/schemas/Enum4
 // No conflicts here, should have unmodified enums
 type Enum4 string
@@ -2350,6 +2729,21 @@ const (
 	Mouse Enum4 = "Mouse"
 )
 
+// Values returns the Enum4 constants, in the order of the spec.
+func (Enum4) Values() []Enum4 {
+	return []Enum4{Cat, Dog, Mouse}
+}
+
+// ParseEnum4 returns the Enum4 constant whose value is s. Other strings
+// return an error.
+func ParseEnum4(s string) (Enum4, error) {
+	switch v := Enum4(s); v {
+	case Cat, Dog, Mouse:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Enum4 value %q", s)
+}
+
 // #/components/schemas/Enum5
 // Numerical enum
 type Enum5 int
@@ -2360,6 +2754,39 @@ const (
 	Enum5N7 Enum5 = 7
 )
 
+// Values returns the Enum5 constants, in the order of the spec.
+func (Enum5) Values() []Enum5 {
+	return []Enum5{Enum5N5, Enum5N6, Enum5N7}
+}
+
+// String returns the name of the Enum5 constant equal to e, or the
+// type and number for values not in the enum.
+func (e Enum5) String() string {
+	switch e {
+	case Enum5N5:
+		return "Enum5N5"
+	case Enum5N6:
+		return "Enum5N6"
+	case Enum5N7:
+		return "Enum5N7"
+	}
+	return fmt.Sprintf("Enum5(%d)", int(e))
+}
+
+// ParseEnum5 returns the Enum5 constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseEnum5(s string) (Enum5, error) {
+	switch s {
+	case "Enum5N5", "5":
+		return Enum5N5, nil
+	case "Enum5N6", "6":
+		return Enum5N6, nil
+	case "Enum5N7", "7":
+		return Enum5N7, nil
+	}
+	return 0, fmt.Errorf("invalid Enum5 value %q", s)
+}
+
 // #/components/schemas/EnumUnion
 // Two enums of the same type combined with allOf.
 type EnumUnion struct {
@@ -2390,21 +2817,36 @@ const (
 	FunnyValuesEmpty    FunnyValues = ""
 )
 
+// Values returns the FunnyValues constants, in the order of the spec.
+func (FunnyValues) Values() []FunnyValues {
+	return []FunnyValues{FunnyValuesAsterisk, FunnyValuesN5, FunnyValuesAnd, FunnyValuesPercent, FunnyValuesEmpty}
+}
+
+// ParseFunnyValues returns the FunnyValues constant whose value is s. Other strings
+// return an error.
+func ParseFunnyValues(s string) (FunnyValues, error) {
+	switch v := FunnyValues(s); v {
+	case FunnyValuesAsterisk, FunnyValuesN5, FunnyValuesAnd, FunnyValuesPercent, FunnyValuesEmpty:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid FunnyValues value %q", s)
+}
+
 // #/components/schemas/RenameMe
 // This schema should be renamed via x-go-name when generating
-type RenameMe struct {
+type NewName struct {
 	Prop1 string `form:"prop1" json:"prop1"`
 	Prop2 string `form:"prop2" json:"prop2"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
-func (s *RenameMe) ApplyDefaults() {
+func (s *NewName) ApplyDefaults() {
 }
 
 // #/components/schemas/ReferenceToRenameMe
 // When a Schema is renamed, $ref should refer to the new name
 type ReferenceToRenameMe struct {
-	NewName RenameMe `form:"ToNewName" json:"ToNewName"`
+	ToNewName NewName `form:"ToNewName" json:"ToNewName"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -2601,49 +3043,49 @@ type PostParamsWithAddPropsRequest = map[string]int
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+xbX4/bNhJ/96cYbO8uwEH7x/Z60+otbRO0BzRbJHvtw+GwoKWRxVYiVZKyY6Af/kDq",
-	"HyVTkmXv5hI0+7SWyOFvhsOZH8kRz5CRjPpwsby6uZpfzCiLuD8D2KKQlDMf5lc3VzczAEVVgj48oFTw",
-	"HsUWxQwgoQEyiboDACMp+vDTjw+zjKhY6ofXyGQu8BK3KPYqpmxzSeWlwAgFsgDDouMGVfEPAM9QEEU5",
-	"+zH0oej8uu77o3xX9yzbhygDQTNloP5ZPgR4iKkEZGHGKVOAH6hUEiQHFRMFAU8zzpApCQFhsEYIBBKF",
-	"IVAGKqayFiMzDICwEBhXul0mclYP/RU83H9/70NEN7lA4LmCXbyHnAU8TZEpyjZGGgQklyghIjTJBcq6",
-	"O0BGBElRoZC+9RTgEv4mMPLh4qvrBux10/r65+rf+/VvGKiLsrfAP3KU6lse7v1aCZeopiFFef2u6dZI",
-	"khlnEmUjZ3Fz0/zoWD5LSIAxT0LjFdVfwJlCpuxeACTLEhqYOb7+TXLWfgsggxhT0n0KoPYZ+sCNvgcv",
-	"M6EdR1Ebb/PHGboeu01TjC+vX4Uh1SBJ8nMtvLD2/MIhTO34U46xcI4RC3xSTZauUSKei6cc5NY5CN0+",
-	"qSYr1yCSfnjKMe4u3L51H82nD3Ov+w34k5G7OEvuolfu8iy5y165t2fJve2VuzpL7qpX7t1Zcvv94eVZ",
-	"cl/2yv36LLlf98r95iy53/Svi5vzFsZNv+Qzl1z/mpuft+jmzlVH2P6kKPFK9xuIEjqBvqGYhCdgNlJ/",
-	"pSr+VyWkGSHEiORJK3O7aUTJEq7flf81fKSgK/JxR1X8SMLwUedoOUz4DK2RGtOrMNThVw7TvFegmSbo",
-	"ISwuZRgbgTUP97CLaRAbXkQFAqlju8UYSmmHXEzzsILTZvM+4vNny+6GdtaCICZycEj9R5kPf+Qo9taz",
-	"Em7ogxI5Wi+k2msOLmmaJa3nDs7Uy5eII8F1Rqr1XpyuNwPCONunPJdAGUNR6V9NCkMMJSgOa2wDzwUz",
-	"XFxxIGUnow1EXDjRayE7Ln4/2awO8/UzSqPMRH7qtLmL4RkhUgnKNq3XDfb/lNb8bz9Td6zeU9m6wg/q",
-	"OksIncjTLRUyLt2rXe83jl/rP2inqnY49uLWuAll+m1IBQbKaW1PR4VZE48HvFNHE5eIoU1Wj285bDq8",
-	"/3FbdWh7Y1bqFFca8OERLz7ejytBlCnctLys68wavtdx6aMj1Sft+02e1A3LtFv0eW9+FLmykuKaY8GT",
-	"1tw65zSiQqq3HS9wthRIwnuW7N+V9tdW9adE+GqJUFnPoUm2lWCvOGNB2HBIeYgJyJjnSQgx2SJQBWYN",
-	"m0MZFF47K0n9fkeTpDpsoSxI8hBD2MXI9EsqQSJTEAmeQpBQ/b/iIM1h1NVs1PUrlJ01uhNU4ZBZ+ly5",
-	"7tjxydK5rWyq59H6Wc+Y3cQxN9ZrJ8gDX3qbJwlZNz7zxae++NQpPgXAKk9qYAwciPmu1P1DH/sFHhV8",
-	"jrIqxzjSjst32TEeScNxQ/OsQDUizJGowjpLDefCw3EHDvuc9vueozR+S5KE7wY3Eh/XfNOtAhFJ5JgX",
-	"LZ1WeKWV19uJvcMC+yfXv6vbiF5HLI7b/sVRM9BI775LOju2aXzSue7SwB7618c/XdyzN1D2GPZIotfZ",
-	"/nhPNDuriaHLbA4KLme2oyENTCMxOEHDoaI4WXnhPKWxs/uLEV3u3AtICLLXIbdA1N3e9DkY0d3KJ1Rh",
-	"ehDaOk4ytjN4KiVffkYTVtGxQif7kNCphDl+LADXN6SyvH6EkOqWKWVE8SoQmw42JxgCZob/hQhKmJq/",
-	"OKHT4pROywPdF2O6U5ZQhoAJplpsn67PFaouHQvAsQia/ixP1xa1qPqvOU+QsK767ixX6mzaTYvzOaPt",
-	"M4SOoU52jJOd41wHuR1wkCwx2fIDhgWDvIQgl4qnkBIhY5JQtrnOWfNjmi2N4HLB70eS52e59lZja68V",
-	"ZWCdKx16UpJlT6D47Sk6rKpOLWTN8BURNHtVR5C0r+umKa/j7uesuf4r8duuvL2diBhgu5qA1zb4fLrF",
-	"MyIUJclf3vK2GUczpmTkd3wMiMQBi1qNPrZxOcNHHj1uS/POn8m+hfhHU79ykqXd3JLUBPogOw+Q5IM0",
-	"fGISnpwj7Av/MccpMumpW0zT+1NMk3ZtwnEmcDnZkVYwzT4lI9xNWl4a7uCqmk922e3dJJiuw80aVasa",
-	"xDmXXR/2ikCwTrA5T74sDoE1IpqgVxIbBWkexCCRSZw2552CQuc+olMQ6D6HPKjoO9w7ONzn0GLFc87w",
-	"iFZqx7tPNIoDc7uzd++JLh/euwzvqB3R0mUJ+01n29W8sOahVZfjVidJ7qMafhUAzVPb4s5NVe8N62Xf",
-	"zvCsvdny1C3WYaKbL08Ii4ZNcBWjKM4rZXEwXddDf4mXn0C8HD8KtSrKnE5QFBcVFWug6eLMKmH72By8",
-	"ZTP/+U77O5VPB2Mv/GmHo3acb63htpyDM6LKZBNV7fHcnsx0omHcRLzHYKtn1WDCHdURCtAignV3KZ+f",
-	"Bq1wMRH+liT58Sf2zrMpF+JCbIHusODUf4Y7LTPi3B+/uo+4SInyTQ1tu/fitN6H96IFlvKW6jXLU3fI",
-	"bcoivuMsSmigynsL3WXhAbI8LS1pqiaLoknIBBZp2rRtJSY9/NWsVwEtUKO8Z+jBw4578KApoAV0MR3o",
-	"/BmBNhg9eMNzYSFdjiB9bWEKStQNaAlkzbfoueA21RwV7GPhGnMY477h3INviQ34dgTwW17jlBCjQK9V",
-	"8ZKzlIc0ohia4eQ4mu+I8uB7vvHgJ55Le5ZXY1DyFAUNSGJkzfqDVTXUyoM7D15aQ/zbvp/o+1pvxwtd",
-	"TGVIjCBJioXBA56uKatcx/Dyqz6SPlTtbmbkYkLjsoy/VmHxCepQWf09bpGVNn+TM7b/pRXMW4hfhxs0",
-	"tE6a61CzYrVXj/mRNeyLf9qM7sWq9esfrV9/b/0qfrxDPd5P6IRnasLK69rS69d6I6+7hLClBD5cbvil",
-	"ufw3NVwbZKakuEZbv/fhLe6siqgjk4x+Np4+dKvFhFoSI9UrupUzVX/L+sAHTfKrVpOUdX5FmZyxhmec",
-	"pbKSuTHWAUz7HsNdQ8uO1vyBlwYb++yjcsgK9oVD4VqY0bZTqNv+WsQ/nLlCcthu56xuqPYr1QhtNQ/q",
-	"eoeqr6d9BtDz2c2QvezagIve8n4j2CqPcZch93/yUftg94uWzkfD/WbvNJxZ7Avc79pnKs3IM/fXGH/p",
-	"aSmSirFjHWcs25oof5pZu4M7I1eVNDjzgEeRB2uuYithGxCLHmCL/zewZQ+w5UcARhIaoAeo6eKar8vA",
-	"Zn1IXwW3g+9DXJGtbvRlTfwvAAD//9rLIRuFQgAA",
+	"H4sIAAAAAAAC/+xbX4/bNhJ/96cYbO8uwEHeXdvrTau3tE3QHJBskey1D4fDgpZGFluJVEnKjoF++AOp",
+	"/xIlWfZuboPGT5ZEDn/z43BmSI14gowk1IWL1eX15eJiRlnA3RnADoWknLmwuLy+vJ4BKKoidOEepYKP",
+	"KHYoZgAR9ZBJ1B0AGInRhXdv72cJUaHUN6+QyVTgHHcoDiqkbDunci4wQIHMQz/ruEWV/QHgCQqiKGdv",
+	"fReyzq/Lvm/lh7Jn3t5H6QmaKAP1z/wmwH1IJSDzE06ZAvxEpZIgOaiQKPB4nHCGTEnwCIMNgieQKPSB",
+	"MlAhlaUYmaAHhPnAuNLtEpGycuhv4P7uxzsXArpNBQJPFezDA6TM43GMTFG2NdLAI6lECQGhUSpQlt0B",
+	"EiJIjAqFdGt3AebwN4GBCxffXFVgr6rWVz8Xf+82v6GnLvLeAv9IUarvuX9wSyVsoqqGFOXVh6pbJUkm",
+	"nEmUlZzl9XV10WI+iYiHIY98YxXFz+NMIVP1XgAkSSLqmTm++k1y1nwKIL0QY9K+C6AOCbrAjb6dh4nQ",
+	"hqNoHW/14wxtt+3UZOPLq1e+TzVIEv1cCs/YXlxYhKk9f8wxltYxQoGPqsnKNkrAU/GYg9xYB6G7R9Vk",
+	"bRtE0k+POcbthd227oLF9GHudL8BezJyl2fJXfbKXZ0ld9Ur9+YsuTe9ctdnyV33yr09S26/Pbw8S+7L",
+	"XrnfniX32165350l97v+dXF93sK47pd85pLrX3OL8xbdwrrqCDuc5CVesUMp2CZXB9A3FCP/BMxG6q9U",
+	"hf8qhFQj+BiQNGpEbnsakWcJVx/yf1U+kqUr8mFPVfhAfP9Bx2g5nPCZtEZqTK98X7tfOZzmvQKdaYIe",
+	"opZLmYyNwIb7B9iH1AtNXkQFAil9ey1jyKV1czGAeZ7TJou+xOfP2oM87SwFQUjk4JD6R5kLf6QoDrV7",
+	"OVzfBSVSrD2Q6hChC5LGSdS4b8mZevMlYglwrZFKvZen682AMM4OMU8lUMZQFPoXk8IQfQmKwwabwFPB",
+	"TC6uOJC8k9EGAi6s6LWQPRe/n0yrhb7+jNIoMzE/tXJuy/CMEKkEZdvG4wr7f3I2/9ufqVtW76nZusJP",
+	"6iqJCJ2Yp9dUSLi0r3a93zh+rf+kjarY4dQXt8ZNKNNPfSrQU1a2He0VZpU/HrBO7U1sIoY2WT22ZeF0",
+	"eP9jZ3Voe2NW6hRTGrDhESs+3o4LQZQp3DasrG3MGr7TMumjPdWztv0qTrqzoovM+nw0F1msdGf9cyx4",
+	"1Jhb65wGVEj1nsTjLQUS/45Fhw85/5pVd4qHL5YIleUcmmBbCHayMxaELYeY+xiBDHka+RCSHQJVYNaw",
+	"OZRB4TSjktTP9zSKisMWyrwo9dGHfYhMP6QSJDIFgeAxeBHV/xUHaQ6jLmejpl+gbK3RvaAKh2jpM+Wy",
+	"Y8smc+OuRVM9j7XLcsbqTSxzU3tsBdmxpfdpFJFNZTNfbeqrTZ1iUwCssKQKxsCBmGsL3T/1Zb/AA6O/",
+	"Vn/WG3ZstsuOsUjqjxPNkwzViDBLoPLLKDUcC7vjDhz2Wfn7kaM0dkuiiO8HNxKfl77prEBAIjlmRSsr",
+	"C6+08hIIO1gYODy6/m3dRvQ6YnHc9C+OMgMN9O47T2fHNo2POtftNLAn/evLP225Z6+j7CH2yESvtf1x",
+	"Hml21hNdl9kcZLmc2Y761DONxOAEDbuK7GTlhfWUph7dX4zocmtfQEKQA/AgR9Te3vQZGNHd8jtUYdxx",
+	"bS0jGVbx8ZR8+QVNWJGOZTrVDwmtSpjjxwxw+YZU5q8fwae6ZUwZUbxwxKZDPScYAmaG/4UISphavDih",
+	"0/KUTquO7ssx3SmLKEPACGMttk/Xp3JVc8sCsCyCqj9L4w2KTv8N5xES1lbfHuVynU27aX4+ZbR5htAi",
+	"6mTDONk4zjWQmwEDSSITLT+hb/iBOXipVDyGmAgZkoiy7VXKqotpXBrB+YI/jATPL3LtrcfWXsPLwCZV",
+	"wDjEJEkeQfGbU3RYF50ayKrhi0TQ7FUtTrL+um6a8trvfsma61+Ov+oEsLuZiBhgt56At074YjrjCRGK",
+	"kugvz3ydxtGIKRn5HR88InGA0Vqjz00uZ/jAg4ddTu/iifjNxD+Y+pWTmLbnlqRMoDvReSBJ7oThE4Pw",
+	"5BhRf+E/ZjhZJD11i2l6P8cwWa9NOI4Cm5EdyYJp9pxIuJ20vDTcwVW1mGyyu9tJMG2HmyWqRjWIdS7b",
+	"NuxkjmATYSkZ5tkhsEZEI3TyxEZBnHohSGQSp815q6DQuo9oFQTazyE7FX3dvYPFfLqMZfc5wyNaqT1v",
+	"39EoOnTbo3fviS4f3rsM76gt3tLGRP1Ja9tVPajNQ6Mux65OFN0FJfzCAZq7dcatm6reN6zzvp3hWXuz",
+	"1albrG6gW6xOcIsmm+AqRJGdV8rsYLqsh/7qL5+Bvxw/Cq1VlFmNICsuyirWQKeLhVx943Pn4A3O3Kc7",
+	"7W9VPnXGXrrTDkfrfr6xhptyOmdEBWUTVe2x3J7IdCIx9kS8h7D1k2ow4R3VEQrQzIO1dylfngYNdzER",
+	"/o5E6fEn9tazKRviTGyGrltw6j7BOy0z4mK0GUDARUyUa2pom72Xp/XuvhfNsORvqV6zNLa73Kos4gfO",
+	"goh6Kn9vobssHUCWxjmTpmoyK5qERGAWpk3bRmDSw1/OehXQAjXKO4YO3O+5A/c6BawBXU4HunhCoBVG",
+	"B97wVNSQrkaQvq5h8nLUFWgJZMN36NjgVtUcBexj4Ro6DLlvOHfge1IHfDMC+D0vcUoIUaDTqHhJWcx9",
+	"GlD0zXByHM0PRDnwI9868I6nsj7L6zEoaYyCeiQysmb9zqoYau3ArQMva0P8u/5+ou9rvT3PdAEegAoR",
+	"JInRjAMejzeUFaZj8vLLviR9qNrdzMjFhMZ5GX+pwvIZ6lCw/hF3yHLO36SMHX5pOPMG4tf+Fk1aJ7UL",
+	"MyKMVY/ZUW3YF/+sZ3Qv1o2rfzSu/t64yi4+oB7vHVrhmZqwTOHC6jcIwnTxYUcJfJpv+VxfZjVcW2Sm",
+	"pLhEWz534T3uaxVRRwYZfW88fOhWywm1JEaqk3XLZ6r8lvWeD1Lyq1aT5HV+WZmcYcMxxlKwZN4Yg8pK",
+	"5Rjuq7TsaM3veU7Y2GcfhUEWsC8sCpfCjLatQt3m1yJud+YyyX6znbW6odivFCM01ezU9Q5VX0/7DKDn",
+	"s5shvuq1ARe95f1GcK08xl6G3P/JR2mD7S9aWh8N99PeajirZV9gf9Y8U6lGntm/xvhLT0sWVAyPC7fL",
+	"rfHyp9HaHtzquYqgwZkDPAgc2HAV1gK2AbHsAbb8fwNb9QBbfQZgJKIeOoA6XdzwTe7Yah/SF86t832I",
+	"zbOVjb6uif8NANrLIRuFQgAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -0,0 +1,5 @@
+// Package const_schemas tests const schemas mapping to single-constant types
+// that reject other values, and constructors setting const properties.
+package const_schemas
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,259 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/EventVersion
+// Version of the event envelope.
+type EventVersion int
+
+const (
+	N2 EventVersion = 2
+)
+
+// Values returns the EventVersion constants, in the order of the spec.
+func (EventVersion) Values() []EventVersion {
+	return []EventVersion{N2}
+}
+
+// String returns the name of the EventVersion constant equal to e, or the
+// type and number for values not in the enum.
+func (e EventVersion) String() string {
+	switch e {
+	case N2:
+		return "N2"
+	}
+	return fmt.Sprintf("EventVersion(%d)", int(e))
+}
+
+// ParseEventVersion returns the EventVersion constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseEventVersion(s string) (EventVersion, error) {
+	switch s {
+	case "N2", "2":
+		return N2, nil
+	}
+	return 0, fmt.Errorf("invalid EventVersion value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than N2.
+func (e *EventVersion) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch EventVersion(v) {
+	case N2:
+		*e = EventVersion(v)
+		return nil
+	}
+	return fmt.Errorf("invalid EventVersion value %d", v)
+}
+
+// #/components/schemas/UserCreated
+type UserCreated struct {
+	Type    UserCreatedType    `form:"type" json:"type"`
+	Version EventVersion       `form:"version" json:"version"`
+	User    string             `form:"user" json:"user"`
+	Source  *UserCreatedSource `form:"source,omitempty" json:"source,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *UserCreated) ApplyDefaults() {
+}
+
+// NewUserCreated returns a new UserCreated with its const properties set.
+func NewUserCreated() UserCreated {
+	var s UserCreated
+	s.Type = UserCreatedTypeUserCreated
+	s.Version = N2
+	s.Source = new(UserCreatedSource)
+	*s.Source = Accounts
+	return s
+}
+
+// #/components/schemas/UserCreated/properties/type
+type UserCreatedType string
+
+const (
+	UserCreatedTypeUserCreated UserCreatedType = "user.created"
+)
+
+// Values returns the UserCreatedType constants, in the order of the spec.
+func (UserCreatedType) Values() []UserCreatedType {
+	return []UserCreatedType{UserCreatedTypeUserCreated}
+}
+
+// ParseUserCreatedType returns the UserCreatedType constant whose value is s. Other strings
+// return an error.
+func ParseUserCreatedType(s string) (UserCreatedType, error) {
+	switch v := UserCreatedType(s); v {
+	case UserCreatedTypeUserCreated:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid UserCreatedType value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than UserCreatedTypeUserCreated.
+func (e *UserCreatedType) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch UserCreatedType(v) {
+	case UserCreatedTypeUserCreated:
+		*e = UserCreatedType(v)
+		return nil
+	}
+	return fmt.Errorf("invalid UserCreatedType value %q", v)
+}
+
+// #/components/schemas/UserCreated/properties/source
+type UserCreatedSource string
+
+const (
+	Accounts UserCreatedSource = "accounts"
+)
+
+// Values returns the UserCreatedSource constants, in the order of the spec.
+func (UserCreatedSource) Values() []UserCreatedSource {
+	return []UserCreatedSource{Accounts}
+}
+
+// ParseUserCreatedSource returns the UserCreatedSource constant whose value is s. Other strings
+// return an error.
+func ParseUserCreatedSource(s string) (UserCreatedSource, error) {
+	switch v := UserCreatedSource(s); v {
+	case Accounts:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid UserCreatedSource value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than Accounts.
+func (e *UserCreatedSource) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch UserCreatedSource(v) {
+	case Accounts:
+		*e = UserCreatedSource(v)
+		return nil
+	}
+	return fmt.Errorf("invalid UserCreatedSource value %q", v)
+}
+
+// #/components/schemas/UserDeleted
+type UserDeleted struct {
+	Type UserDeletedType `form:"type" json:"type"`
+	User string          `form:"user" json:"user"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *UserDeleted) ApplyDefaults() {
+}
+
+// NewUserDeleted returns a new UserDeleted with its const properties set.
+func NewUserDeleted() UserDeleted {
+	var s UserDeleted
+	s.Type = UserDeletedTypeUserDeleted
+	return s
+}
+
+// #/components/schemas/UserDeleted/properties/type
+type UserDeletedType string
+
+const (
+	UserDeletedTypeUserDeleted UserDeletedType = "user.deleted"
+)
+
+// Values returns the UserDeletedType constants, in the order of the spec.
+func (UserDeletedType) Values() []UserDeletedType {
+	return []UserDeletedType{UserDeletedTypeUserDeleted}
+}
+
+// ParseUserDeletedType returns the UserDeletedType constant whose value is s. Other strings
+// return an error.
+func ParseUserDeletedType(s string) (UserDeletedType, error) {
+	switch v := UserDeletedType(s); v {
+	case UserDeletedTypeUserDeleted:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid UserDeletedType value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than UserDeletedTypeUserDeleted.
+func (e *UserDeletedType) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch UserDeletedType(v) {
+	case UserDeletedTypeUserDeleted:
+		*e = UserDeletedType(v)
+		return nil
+	}
+	return fmt.Errorf("invalid UserDeletedType value %q", v)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7SQv07zQBDEez/FyN9XRg5/umsDjwANojDnCTmU3B27a0sI8e7okosJFaTAlXd2Z/S7",
+	"SZmxz8Ghve4uu4u2CXGdXANYsC0dVimqQf2Gu14bYKJoSNGh3V/n3jbq8P7R+LTLKTKaFnc1lF/gdmK0",
+	"+2rcK8BA9RKyFQl1h7SGbQiWezBO3KbMrjp8IXG42o93SlkJe+NwTLS3TIf09EJvVRK+jkE4ODyU7eJI",
+	"v8ColMd6lSVligXqMaqGzVOdoSYhPp/Ilamkdf6AM2+n7w8u33/h2qH9t/wqa1mbWp6W1M6ekvwjh6ZR",
+	"/Bm4vfdpjKZzkzfc8swm/6DA4UDxu7d/DgAv5UGauAIAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
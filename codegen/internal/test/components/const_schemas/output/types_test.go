@@ -0,0 +1,42 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConstTypes checks that const properties unmarshal only from their
+// constant value.
+func TestConstTypes(t *testing.T) {
+	var event UserCreated
+	err := json.Unmarshal([]byte(`{"type":"user.created","version":2,"user":"ann","source":"accounts"}`), &event)
+	require.NoError(t, err)
+	assert.Equal(t, UserCreatedTypeUserCreated, event.Type)
+	assert.Equal(t, N2, event.Version)
+	assert.Equal(t, Accounts, *event.Source)
+
+	err = json.Unmarshal([]byte(`{"type":"user.deleted","version":2,"user":"ann"}`), &event)
+	assert.ErrorContains(t, err, `invalid UserCreatedType value "user.deleted"`)
+
+	err = json.Unmarshal([]byte(`{"type":"user.created","version":3,"user":"ann"}`), &event)
+	assert.ErrorContains(t, err, "invalid EventVersion value 3")
+}
+
+// TestConstructors checks that constructors set const properties, so events
+// marshal with them.
+func TestConstructors(t *testing.T) {
+	created := NewUserCreated()
+	created.User = "ann"
+	b, err := json.Marshal(created)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"user.created","version":2,"user":"ann","source":"accounts"}`, string(b))
+
+	deleted := NewUserDeleted()
+	deleted.User = "ann"
+	b, err = json.Marshal(deleted)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"user.deleted","user":"ann"}`, string(b))
+}
@@ -12,6 +12,7 @@ import (
 	"sync"
 
 	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // #/components/schemas/SimpleDefaults
@@ -192,7 +193,8 @@ func (s *AnyOfWithDefaults) ApplyDefaults() {
 // #/components/schemas/AnyOfWithDefaults/properties/value
 
 type AnyOfWithDefaultsValue struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsAnyOfWithDefaultsValueAnyOf0 returns the union data inside the AnyOfWithDefaultsValue as a AnyOfWithDefaultsValueAnyOf0.
@@ -206,6 +208,7 @@ func (t AnyOfWithDefaultsValue) AsAnyOfWithDefaultsValueAnyOf0() (AnyOfWithDefau
 func (t *AnyOfWithDefaultsValue) FromAnyOfWithDefaultsValueAnyOf0(v AnyOfWithDefaultsValueAnyOf0) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -217,6 +220,7 @@ func (t *AnyOfWithDefaultsValue) MergeAnyOfWithDefaultsValueAnyOf0(v AnyOfWithDe
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -231,6 +235,7 @@ func (t AnyOfWithDefaultsValue) AsAnyOfWithDefaultsValueAnyOf1() (AnyOfWithDefau
 func (t *AnyOfWithDefaultsValue) FromAnyOfWithDefaultsValueAnyOf1(v AnyOfWithDefaultsValueAnyOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -242,9 +247,46 @@ func (t *AnyOfWithDefaultsValue) MergeAnyOfWithDefaultsValueAnyOf1(v AnyOfWithDe
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *AnyOfWithDefaultsValue) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsAnyOfWithDefaultsValueAnyOf0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsAnyOfWithDefaultsValueAnyOf1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesAnyOfWithDefaultsValueAnyOf0 reports whether the union data matches AnyOfWithDefaultsValueAnyOf0.
+func (t AnyOfWithDefaultsValue) MatchesAnyOfWithDefaultsValueAnyOf0() bool {
+	return t.matched[0]
+}
+
+// MatchesAnyOfWithDefaultsValueAnyOf1 reports whether the union data matches AnyOfWithDefaultsValueAnyOf1.
+func (t AnyOfWithDefaultsValue) MatchesAnyOfWithDefaultsValueAnyOf1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t AnyOfWithDefaultsValue) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t AnyOfWithDefaultsValue) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -252,6 +294,7 @@ func (t AnyOfWithDefaultsValue) MarshalJSON() ([]byte, error) {
 
 func (t *AnyOfWithDefaultsValue) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -483,22 +526,39 @@ func (s *RequiredAndOptional) ApplyDefaults() {
 	}
 }
 
+// #/components/schemas/NullableDefaults
+type NullableDefaults struct {
+	Label oapiCodegenTypesPkg.Nullable[string] `form:"label,omitempty" json:"label,omitempty"`
+	Limit oapiCodegenTypesPkg.Nullable[int32]  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *NullableDefaults) ApplyDefaults() {
+	if !s.Label.IsSpecified() {
+		s.Label = oapiCodegenTypesPkg.NewNullableWithValue[string]("nullable-default")
+	}
+	if !s.Limit.IsSpecified() {
+		s.Limit = oapiCodegenTypesPkg.NewNullableWithValue[int32](10)
+	}
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/6xWS2/bOBC++1cMlAVyUuJX4kQ3Z4O9bbzYFumh6IG2RvEUFMmSlBuj6H8v9LQZ0XYk",
-	"xydjHt/M8JuPlFQomKIIgsnV6GoYDEgkMhoAWLIcI3jEhGXcwjPjGRr4jMYOADaoDUkRQVDkKGbXJoJf",
-	"vwcrmSopUFiTY5jVGlNW/AW4gAdmaAVKU0qWNmjgJ9k1xGUFUwR9olRxrIpWiQB2qzACufyOK1uZlJYK",
-	"tSVsggCM1SRe/iHk8c5YZ5fOPXNVN4JgjZzLoHGRsAcwSFh8Qe0DmY4b61JKfgAgdyETPgCrM2zsCZfs",
-	"UBMiS5f+HiZXo+n+FLfTd8+RSJ0yG5VZPuz78XgymY2Hk9u7m+lsdnM3nA0qWp/QWIwregyEYNYy4zFo",
-	"XGXaIMyV4tvHfZbLjJ4sC5ZiJ3oV0yjsjt/VmtxD+UtjEsHlxfVue6+r1b12F/Jy73g5Cfz7LZRnhkNz",
-	"5D/Olshd08GB3KHK+mFlCJy4Ta5VP2qbeQd2NBzWrC6KGUqJsjgmS1Iw/l8zCDARu9r9l6kvZNc9aVUa",
-	"E3rtRGzKVFhP7mtxB+ZAVQPOtWZbSHKFeC6iwttzFLKYmvYkLIfcs7bCTo/89dtui2UmbKcrqqGWie0i",
-	"cWcGEpBifrFU4+chZ7DZWsGipjtseEgtxxSzu+efGW+7jqrH3Z7qX1iGBh/TGgl7tC+//tyb9v6+JkoK",
-	"PEXUIg85iyhNzF2kouoHUSVVLsr5WUSVGCHzX3ZntvZwFlej8aQRFedvuSqVlNt9BBUJu+ph17djyQz2",
-	"ejryxLAQaNC/Or5aFDHGvZ6Zm+YqekRUINBYEi8QQppxS4ojcNwgLw8wD3kqIzrudgEyOuN5fvup8e4j",
-	"LiuHeb67rYVj7MfsvMGtJ6Dz/o5bzqLByWFQb5OnGi0WRrAlb+/Lqe9jb9sJ4wbrFfoff2SkMYaNqTTN",
-	"uEeHddhcxIsq6sg66Sp6X5+1bU/MHu+TdH2+c/EgdfrwqfPbN2Kri5O49ZH17aXOb/dSe0738icAAP//",
-	"D156coUOAAA=",
+	"H4sIAAAAAAAC/6xXTW/jNhC961cMtAX2UiW2lGw2unkb9Na4aIvtYbEH2hrFLEYkS1LuGkX/eyGJkqWI",
+	"stdybsJ8PM7jmyEpqVAwxVMIk5vlzSIMuMhlGgBYbglTeMKclWThM6MSDfyBxgYAe9SGS5FCWOcoZncm",
+	"hX//C7ayUFKgsCYNAMx2hwWrPwHewSdm+BaU5gW3fI8G/uF2B1mzggkAAH7nhSJ0i7pEAHtQmILc/IVb",
+	"60xKS4XacuyCAIzVXLz8zJGyo7HNbpw9s1s3hXCHRDLsXFzYCQwuLL6g9oHcxZ11IyVNAFQuZMIHYHWJ",
+	"nT0nyaaKEGWx8deQ3Czv+iw+3H03j1zqgtm0yfJhP8ZxkjzEi+TDx/u7h4f7j4uHwMn6jMZi5uQxEIHZ",
+	"yZIy0LgttUFYKUWHp77KTcZMlQUr8CJ5FdMo7FHf7Y4PN+UHjXkK79/dHrv31rXu7bAh3/e2l7jAn15D",
+	"eThM8QAAILZBGpomCQ1JNetHzhAO4vbVrPpRx8oPYJeLRavquubQjCjLMm65FIx+7YgAE9lwdn9h6k9u",
+	"dzNlVRpz/u0iYQumopa5r8Q08EI5giut2QHyakI8B1HtnUmFWyzMmAmrIHvWUdh5yl++HrtYlsJedER1",
+	"0jJxWOdDzsAFFFgdLI5+FXKFmqMWrNfsGwCiqWk5NTHHc/4zo7Hr5PQMu8d9RU1o+DalcWFP1uWfv+FJ",
+	"+/jYCiUFnhNqLfBKoTRnw0aqV30jqaSqhnJ1lVANRsT8h92VpX26SqtlnHRDRfRaq2aSiPwC1QlpcJbH",
+	"FIcNMzjr6qgSo3pAw/mr4zeLIsNs1jVz3x1FT4gKBBrLxQtEUJRkuSIEwj1Ss4FVyHMTcWFv1yDLK67n",
+	"10+N797iZuWoyh92a+2I/ZgXd/DoCri4f+ORsy4wmQb1FnmuUAAAFGxD43459z72lp0zMti20G/4d8k1",
+	"ZrA3bqYZeeawDVuJbO2iTrSTdtH9+WxtvWH2eJ/l0OfbFw/SRQ+fNn98Io6qOIvbbtncWtr8cS2t53wt",
+	"7Y9ESVQ1SfMo8/0ethEzL7vRc7vJ+9KU8SOEoiQKv3p5Crf0mCdV/7MeVDd/Ptje71YSB96HePD/AK/b",
+	"i9CaDwAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -322,3 +322,24 @@ func TestApplyDefaultsChain(t *testing.T) {
 		assert.Equal(t, false, *d.Level1.Level2.Level3.Enabled)
 	})
 }
+
+// TestNullableDefaults tests that ApplyDefaults only fills in unspecified
+// Nullable fields, leaving explicit nulls alone
+func TestNullableDefaults(t *testing.T) {
+	t.Run("applies defaults to unspecified fields", func(t *testing.T) {
+		n := NullableDefaults{}
+		n.ApplyDefaults()
+
+		assert.Equal(t, "nullable-default", n.Label.MustGet())
+		assert.Equal(t, int32(10), n.Limit.MustGet())
+	})
+
+	t.Run("keeps explicit nulls", func(t *testing.T) {
+		var n NullableDefaults
+		require.NoError(t, json.Unmarshal([]byte(`{"label": null}`), &n))
+		n.ApplyDefaults()
+
+		assert.True(t, n.Label.IsNull())
+		assert.Equal(t, int32(10), n.Limit.MustGet())
+	})
+}
@@ -0,0 +1,5 @@
+// Package dynamic_refs tests $dynamicRef resolving to the schema bound to its
+// $dynamicAnchor, such as the items of a generic list envelope.
+package dynamic_refs
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
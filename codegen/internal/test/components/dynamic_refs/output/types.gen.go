@@ -0,0 +1,268 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name string `form:"name" json:"name"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Owner
+type Owner struct {
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Owner) ApplyDefaults() {
+}
+
+// #/components/schemas/PagedList
+// A page of items, whose type the schemas referencing it bind.
+type PagedList struct {
+	Items []any   `form:"items" json:"items"`
+	Next  *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PagedList) ApplyDefaults() {
+}
+
+// #/components/schemas/PetList
+type PetList struct {
+	Items []Pet   `form:"items" json:"items"`
+	Next  *string `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PetList) ApplyDefaults() {
+}
+
+// #/components/schemas/PetList/allOf/1/properties/items
+type PetListAllOf1Item = []Pet
+
+// #/components/schemas/NameList
+type NameList struct {
+	Items []string `form:"items" json:"items"`
+	Next  *string  `form:"next,omitempty" json:"next,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *NameList) ApplyDefaults() {
+}
+
+// #/components/schemas/OwnerList
+type OwnerList struct {
+	Items []Owner `form:"items" json:"items"`
+	Next  *string `form:"next,omitempty" json:"next,omitempty"`
+	Total *int    `form:"total,omitempty" json:"total,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *OwnerList) ApplyDefaults() {
+}
+
+// #/components/schemas/OwnerList/allOf/0/properties/items
+type OwnerListAllOf0Item = []Owner
+
+// #/components/schemas/Directory
+type Directory struct {
+	Owners *OwnerList `form:"owners,omitempty" json:"owners,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Directory) ApplyDefaults() {
+	if s.Owners != nil {
+		s.Owners.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Tree
+type Tree struct {
+	Value    *int   `form:"value,omitempty" json:"value,omitempty"`
+	Children []Tree `form:"children,omitempty" json:"children,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Tree) ApplyDefaults() {
+}
+
+// #/components/schemas/Tree/properties/children
+type TreeChildren = []Tree
+
+// #/components/schemas/LabeledTree
+type LabeledTree struct {
+	Label    *string       `form:"label,omitempty" json:"label,omitempty"`
+	Value    *int          `form:"value,omitempty" json:"value,omitempty"`
+	Children []LabeledTree `form:"children,omitempty" json:"children,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *LabeledTree) ApplyDefaults() {
+}
+
+// #/components/schemas/LabeledTree/allOf/1/properties/children
+type LabeledTreeAllOf1Children = []LabeledTree
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/8RUzW7bPBC86ykW/gL48tV22htvAXIpEDRG0VvRA02NJAbUkiU3Sf32BaXI8l/UGG1R",
+	"naT9md0ZjugDWAeraPZhcb1YzQrLlVcF0RNisp4VXS9Wi1VBJFYcFN1uWbfWUESFCDZIRdDSpNyzDJDu",
+	"haiG9C9EPiBqsZ4/loqcTbKGpJdcRAqeE9JQTDR7v1rNxk+iEslEG6Tb5oaCrkG+ojCi5Md4FrDsNxLp",
+	"EJw13fDlQ/J8mCVKpkGrj6NEVxGVovl/S+Pb4BksadnXpuUacmeTzIsxp4oBKvVY65G9bAMU+c0DjOxI",
+	"f3+0EaWir6xbfHsJh5iVErsvRs6PXwNakmi57sL3z4w4MescKFpt3STqWtcoM0tVTJ6BFbTpf3pufEKH",
+	"QtJgkGJnEcs1WaGN5XLxJlU62ClZuoJTBjpGvd2LnpQRXZW9fz/3B5wr5qPa+CGTuuR+VEeLqOIU/oZN",
+	"46Pq8oMj9vWcNNgg/vw3Z77Jy/2QT7rFP9rvvKX3l9HO3Vcj4rvLtssNZ+z2mrXyI160OwwNe1oW1Ih/",
+	"/WQ6EXoKtzbCiI/bC39znyGSumTgqNuXiN3Fc0yDfYnLVnnS7vHMPXYoJpFprCsj+E/82XnJnsqd3sCh",
+	"PGA0IUSum/+a+TmaLk969QL5OQBYm4iOawcAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+const (
+	emailRegexString = "^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
+)
+
+var (
+	emailRegex = regexp.MustCompile(emailRegexString)
+)
+
+// ErrValidationEmail is the sentinel error returned when an email fails validation
+var ErrValidationEmail = errors.New("email: failed to pass regex validation")
+
+// Email represents an email address.
+// It is a string type that must pass regex validation before being marshalled
+// to JSON or unmarshalled from JSON.
+type Email string
+
+func (e Email) MarshalJSON() ([]byte, error) {
+	if !emailRegex.MatchString(string(e)) {
+		return nil, ErrValidationEmail
+	}
+
+	return json.Marshal(string(e))
+}
+
+func (e *Email) UnmarshalJSON(data []byte) error {
+	if e == nil {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*e = Email(s)
+	if !emailRegex.MatchString(s) {
+		return ErrValidationEmail
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, with the
+// validation of MarshalJSON.
+func (e Email) MarshalYAML() (any, error) {
+	if !emailRegex.MatchString(string(e)) {
+		return nil, ErrValidationEmail
+	}
+	return string(e), nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, with the validation of UnmarshalJSON.
+func (e *Email) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*e = Email(s)
+	if !emailRegex.MatchString(s) {
+		return ErrValidationEmail
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
@@ -0,0 +1,52 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicRefsBindListItems(t *testing.T) {
+	var pets PetList
+	require.NoError(t, json.Unmarshal([]byte(`{"items":[{"name":"Rex"},{"name":"Tom"}],"next":"abc"}`), &pets))
+	assert.Equal(t, []Pet{{Name: "Rex"}, {Name: "Tom"}}, pets.Items)
+
+	names := NameList{Items: []string{"a", "b"}}
+	data, err := json.Marshal(names)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"items":["a","b"]}`, string(data))
+
+	dir := Directory{Owners: &OwnerList{Items: []Owner{{Email: ptr("a@example.com")}}, Total: ptr(1)}}
+	data, err = json.Marshal(dir)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owners":{"items":[{"email":"a@example.com"}],"total":1}}`, string(data))
+}
+
+func TestDynamicRefsKeepGenericSchema(t *testing.T) {
+	// Unbound, the items are those of the anchor PagedList declares.
+	var page PagedList
+	require.NoError(t, json.Unmarshal([]byte(`{"items":[1,"two"]}`), &page))
+	assert.Equal(t, []any{float64(1), "two"}, page.Items)
+}
+
+func TestDynamicRefsRecursive(t *testing.T) {
+	// The children of a LabeledTree are LabeledTrees, which rebinds the
+	// anchor of Tree.
+	tree := LabeledTree{
+		Label:    ptr("root"),
+		Children: []LabeledTree{{Label: ptr("leaf"), Value: ptr(1)}},
+	}
+	data, err := json.Marshal(tree)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"label":"root","children":[{"label":"leaf","value":1}]}`, string(data))
+
+	var plain Tree
+	require.NoError(t, json.Unmarshal([]byte(`{"value":1,"children":[{"value":2}]}`), &plain))
+	assert.Equal(t, []Tree{{Value: ptr(2)}}, plain.Children)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -26,6 +26,39 @@ const (
 	LOW Severity = 0
 )
 
+// Values returns the Severity constants, in the order of the spec.
+func (Severity) Values() []Severity {
+	return []Severity{HIGH, MEDIUM, LOW}
+}
+
+// String returns the name of the Severity constant equal to e, or the
+// type and number for values not in the enum.
+func (e Severity) String() string {
+	switch e {
+	case HIGH:
+		return "HIGH"
+	case MEDIUM:
+		return "MEDIUM"
+	case LOW:
+		return "LOW"
+	}
+	return fmt.Sprintf("Severity(%d)", int(e))
+}
+
+// ParseSeverity returns the Severity constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseSeverity(s string) (Severity, error) {
+	switch s {
+	case "HIGH", "2":
+		return HIGH, nil
+	case "MEDIUM", "1":
+		return MEDIUM, nil
+	case "LOW", "0":
+		return LOW, nil
+	}
+	return 0, fmt.Errorf("invalid Severity value %q", s)
+}
+
 // #/components/schemas/Color
 type Color string
 
@@ -37,28 +70,43 @@ const (
 	Blue Color = "b"
 )
 
+// Values returns the Color constants, in the order of the spec.
+func (Color) Values() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+// ParseColor returns the Color constant whose value is s. Other strings
+// return an error.
+func ParseColor(s string) (Color, error) {
+	switch v := Color(s); v {
+	case Red, Green, Blue:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
 // #/components/schemas/MixedOneOf
 
 type MixedOneOf struct {
 	union json.RawMessage
 }
 
-// AsAny0 returns the union data inside the MixedOneOf as a any.
-func (t MixedOneOf) AsAny0() (any, error) {
-	var body any
+// AsMixedOneOfOneOf0 returns the union data inside the MixedOneOf as a MixedOneOfOneOf0.
+func (t MixedOneOf) AsMixedOneOfOneOf0() (MixedOneOfOneOf0, error) {
+	var body MixedOneOfOneOf0
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromAny0 overwrites any union data inside the MixedOneOf as the provided any.
-func (t *MixedOneOf) FromAny0(v any) error {
+// FromMixedOneOfOneOf0 overwrites any union data inside the MixedOneOf as the provided MixedOneOfOneOf0.
+func (t *MixedOneOf) FromMixedOneOfOneOf0(v MixedOneOfOneOf0) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeAny0 performs a merge with any union data inside the MixedOneOf, using the provided any.
-func (t *MixedOneOf) MergeAny0(v any) error {
+// MergeMixedOneOfOneOf0 performs a merge with any union data inside the MixedOneOf, using the provided MixedOneOfOneOf0.
+func (t *MixedOneOf) MergeMixedOneOfOneOf0(v MixedOneOfOneOf0) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -68,22 +116,22 @@ func (t *MixedOneOf) MergeAny0(v any) error {
 	return err
 }
 
-// AsAny1 returns the union data inside the MixedOneOf as a any.
-func (t MixedOneOf) AsAny1() (any, error) {
-	var body any
+// AsMixedOneOfOneOf1 returns the union data inside the MixedOneOf as a MixedOneOfOneOf1.
+func (t MixedOneOf) AsMixedOneOfOneOf1() (MixedOneOfOneOf1, error) {
+	var body MixedOneOfOneOf1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromAny1 overwrites any union data inside the MixedOneOf as the provided any.
-func (t *MixedOneOf) FromAny1(v any) error {
+// FromMixedOneOfOneOf1 overwrites any union data inside the MixedOneOf as the provided MixedOneOfOneOf1.
+func (t *MixedOneOf) FromMixedOneOfOneOf1(v MixedOneOfOneOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeAny1 performs a merge with any union data inside the MixedOneOf, using the provided any.
-func (t *MixedOneOf) MergeAny1(v any) error {
+// MergeMixedOneOfOneOf1 performs a merge with any union data inside the MixedOneOf, using the provided MixedOneOfOneOf1.
+func (t *MixedOneOf) MergeMixedOneOfOneOf1(v MixedOneOfOneOf1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -107,6 +155,80 @@ func (t *MixedOneOf) UnmarshalJSON(b []byte) error {
 func (t *MixedOneOf) ApplyDefaults() {
 }
 
+// #/components/schemas/MixedOneOf/oneOf/0
+type MixedOneOfOneOf0 string
+
+const (
+	A MixedOneOfOneOf0 = "a"
+)
+
+// Values returns the MixedOneOfOneOf0 constants, in the order of the spec.
+func (MixedOneOfOneOf0) Values() []MixedOneOfOneOf0 {
+	return []MixedOneOfOneOf0{A}
+}
+
+// ParseMixedOneOfOneOf0 returns the MixedOneOfOneOf0 constant whose value is s. Other strings
+// return an error.
+func ParseMixedOneOfOneOf0(s string) (MixedOneOfOneOf0, error) {
+	switch v := MixedOneOfOneOf0(s); v {
+	case A:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid MixedOneOfOneOf0 value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than A.
+func (e *MixedOneOfOneOf0) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch MixedOneOfOneOf0(v) {
+	case A:
+		*e = MixedOneOfOneOf0(v)
+		return nil
+	}
+	return fmt.Errorf("invalid MixedOneOfOneOf0 value %q", v)
+}
+
+// #/components/schemas/MixedOneOf/oneOf/1
+type MixedOneOfOneOf1 string
+
+const (
+	B MixedOneOfOneOf1 = "b"
+)
+
+// Values returns the MixedOneOfOneOf1 constants, in the order of the spec.
+func (MixedOneOfOneOf1) Values() []MixedOneOfOneOf1 {
+	return []MixedOneOfOneOf1{B}
+}
+
+// ParseMixedOneOfOneOf1 returns the MixedOneOfOneOf1 constant whose value is s. Other strings
+// return an error.
+func ParseMixedOneOfOneOf1(s string) (MixedOneOfOneOf1, error) {
+	switch v := MixedOneOfOneOf1(s); v {
+	case B:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid MixedOneOfOneOf1 value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than B.
+func (e *MixedOneOfOneOf1) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch MixedOneOfOneOf1(v) {
+	case B:
+		*e = MixedOneOfOneOf1(v)
+		return nil
+	}
+	return fmt.Errorf("invalid MixedOneOfOneOf1 value %q", v)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
 	"H4sIAAAAAAAC/5RTwWrcShC86ysK9vIe8S7r5Kab4xjbEGdDQvBVvVKvZshMt5hprbOEQD4iX5gvCZJ2",
@@ -117,7 +239,7 @@ var openAPISpecJSON = []string{
 	"Hm8xhVDi9ZOrZ8oXchI+ys4Z767e3X65m3Oez6HvN/dz3Pol7UsSPJA37DQN6RQj8FKDpvKZ82zJS/sX",
 	"45+4mWunl7TvKcUzON+65bBK7WFOeJ2YZU7ZzpFvQ89z4PZF36rhDDWFODle4AO3ZH7PGFapHCwea4FA",
 	"9dd8KsAZssIcwzdeI2KfDaKGSFY7/P7560hnzudTBXcUwtCtpH3rYNP7xG0/NHLa6l68yqlSmqatu/Pf",
-	"uNk8zfof/sVF6BzNE6EnyMeQ/gQAAP//fcizEU0EAAA=",
+	"uNk8zfof/sVF6BzNE6EnyMeQ/gwAfcizEU0EAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
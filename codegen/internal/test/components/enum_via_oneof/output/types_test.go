@@ -53,7 +53,7 @@ func TestColorJSONRoundTrip(t *testing.T) {
 // compile (the union methods would not exist).
 func TestMixedOneOfStillUnion(t *testing.T) {
 	var m MixedOneOf
-	require.NoError(t, m.FromAny0("a"))
+	require.NoError(t, m.FromMixedOneOfOneOf0(A))
 
 	data, err := json.Marshal(m)
 	require.NoError(t, err)
@@ -27,17 +27,32 @@ const (
 	BarN1            Bar = "1"
 )
 
+// Values returns the Bar constants, in the order of the spec.
+func (Bar) Values() []Bar {
+	return []Bar{BarEmpty, BarFoo, BarBar, BarFooBar0, BarFooBar1, BarN1Foo, BarXFoo0, BarXFoo1, BarUnderscoreFoo, BarN1}
+}
+
+// ParseBar returns the Bar constant whose value is s. Other strings
+// return an error.
+func ParseBar(s string) (Bar, error) {
+	switch v := Bar(s); v {
+	case BarEmpty, BarFoo, BarBar, BarFooBar0, BarFooBar1, BarN1Foo, BarXFoo0, BarXFoo1, BarUnderscoreFoo, BarN1:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Bar value %q", s)
+}
+
 // #/paths//foo/get/responses/200/content/application/json/schema
 type GetFooJSONResponse = []Bar
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/2SRQYujQBCF7/0rHpMFT6PO7M1jYAfCwu5l76ExFdOLVjVdlSz590urQU1u1veq3ie6",
-	"w0H1Sg0OfU+d70F8HcB+IHU7/CE1nVArrObZ0BFT8haE8S/YBTefglwVdOoIrVdSJ5HYx9Dge1mXn84F",
-	"PkvjAAvWr1Q/cu+vrBpFDrhR0iDcoC7rsnYuertovqzOMjYAHdn0AEicX+RwajL/EpmTRBqFlfSxCnzW",
-	"9TIAJ9I2hWij7ffPVdIKG7GtlwEfYx/aUVb9VeFtCmh7ocE/U8DukRr4lPz9JQtGg76eAN8SnRsUu6qV",
-	"IQoTm1aTQKu9T4VzS5Dv52yq2vv06JzkailwN6P8KxflO4piNSzfL097n7bZK3nfko9tQZFXimeANTl+",
-	"iRxX89vHm/sfAAD//5W/OQySAgAA",
+	"H4sIAAAAAAAC/2SRQYvbQAyF7/oVj03Bp9je7W2OC10IhfbS+zI4ijPFloaRkpJ/X+xsiJ3cpO/J7zP2",
+	"BjuzEwfshoH7OIDlNELiyEYb/GFzu6JOxTyKo2fhEj2p4F/yI86xJD0ZeN8zumhspJkl5hTwvW7rN6Ik",
+	"Bw0EePJhofox9f6aVLOIgDMXSyoBbd3WLVGOfrRAQHPQuQHo2a8DoPnrRXb7MPEPVQIAoLBlFWO7nQJv",
+	"bXtfgD1bV1L22fb75yLpVJzFl8dAzHlI3Sxr/prKOgWsO/IYHyngl8wBsZR4ecqS82jPjwDfCh8Cqk3T",
+	"6ZhVWNyaq8Ca91gqonsQ6CafR+A9lkBLuXlJ0hMAYP6VtxjYoqoWy/37AdupaJ09k+2avK4LqumkegRY",
+	"ks8P1c/F/vL6Qv8HAJW/OQySAgAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
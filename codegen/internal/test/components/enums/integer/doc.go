@@ -0,0 +1,4 @@
+// Package integer tests integer enum generation with String and Parse functions.
+package integer
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,197 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Priority
+type Priority int
+
+const (
+	Low    Priority = 1
+	Medium Priority = 5
+	High   Priority = 10
+)
+
+// Values returns the Priority constants, in the order of the spec.
+func (Priority) Values() []Priority {
+	return []Priority{Low, Medium, High}
+}
+
+// String returns the name of the Priority constant equal to e, or the
+// type and number for values not in the enum.
+func (e Priority) String() string {
+	switch e {
+	case Low:
+		return "Low"
+	case Medium:
+		return "Medium"
+	case High:
+		return "High"
+	}
+	return fmt.Sprintf("Priority(%d)", int(e))
+}
+
+// ParsePriority returns the Priority constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "Low", "1":
+		return Low, nil
+	case "Medium", "5":
+		return Medium, nil
+	case "High", "10":
+		return High, nil
+	}
+	return 0, fmt.Errorf("invalid Priority value %q", s)
+}
+
+// #/components/schemas/Offset
+type Offset int
+
+const (
+	Minus2 Offset = -2
+	Minus1 Offset = -1
+	N0     Offset = 0
+	N1     Offset = 1
+	N2     Offset = 2
+)
+
+// Values returns the Offset constants, in the order of the spec.
+func (Offset) Values() []Offset {
+	return []Offset{Minus2, Minus1, N0, N1, N2}
+}
+
+// String returns the name of the Offset constant equal to e, or the
+// type and number for values not in the enum.
+func (e Offset) String() string {
+	switch e {
+	case Minus2:
+		return "Minus2"
+	case Minus1:
+		return "Minus1"
+	case N0:
+		return "N0"
+	case N1:
+		return "N1"
+	case N2:
+		return "N2"
+	}
+	return fmt.Sprintf("Offset(%d)", int(e))
+}
+
+// ParseOffset returns the Offset constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseOffset(s string) (Offset, error) {
+	switch s {
+	case "Minus2", "-2":
+		return Minus2, nil
+	case "Minus1", "-1":
+		return Minus1, nil
+	case "N0", "0":
+		return N0, nil
+	case "N1", "1":
+		return N1, nil
+	case "N2", "2":
+		return N2, nil
+	}
+	return 0, fmt.Errorf("invalid Offset value %q", s)
+}
+
+// #/components/schemas/Level
+type Level int
+
+const (
+	Debug Level = -4
+	Info  Level = 0
+	Warn  Level = 4
+)
+
+// Values returns the Level constants, in the order of the spec.
+func (Level) Values() []Level {
+	return []Level{Debug, Info, Warn}
+}
+
+// String returns the name of the Level constant equal to e, or the
+// type and number for values not in the enum.
+func (e Level) String() string {
+	switch e {
+	case Debug:
+		return "Debug"
+	case Info:
+		return "Info"
+	case Warn:
+		return "Warn"
+	}
+	return fmt.Sprintf("Level(%d)", int(e))
+}
+
+// ParseLevel returns the Level constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseLevel(s string) (Level, error) {
+	switch s {
+	case "Debug", "-4":
+		return Debug, nil
+	case "Info", "0":
+		return Info, nil
+	case "Warn", "4":
+		return Warn, nil
+	}
+	return 0, fmt.Errorf("invalid Level value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/4yRwWrrMBBF9/6KS97yycZO043WLTSQNoUWughZKMnYFsQjI42dhNJ/L5GTdGEo3Znx",
+	"mXuPJNcSm9ZqTO6yIssnieXS6QQQK3vSmLNQRR6P3DUB7xQkAXrywTrWyLM8y5PWSB00Pr+SrWtax8QS",
+	"zhFhW1Nj4ifwD2+t8YHQm31HAQcrNdg0FFB610BqAh2F+JwcN169dd7KadgH5NSShh2ELjPirtFYFQr3",
+	"CkW+voyP6flH2hsfGzRWC3dQeKad7RqFJ1vV64vVC1VGbH/1UtFph80pGlXE5I24oW9ZloHkDz7pVCEt",
+	"FHKFQmF66/o5rmNalv+3joMMVx0is6Ce9r8VxL0rAKTXd3qgTVfdxkBM1khnY3TOpRuT+Rj8MJ7H4Cz5",
+	"HgCz6b31NAIAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegerEnumString(t *testing.T) {
+	assert.Equal(t, "Medium", Medium.String())
+	assert.Equal(t, "Minus2", Minus2.String())
+	assert.Equal(t, "Debug", Debug.String())
+	assert.Equal(t, "Priority(7)", Priority(7).String())
+	assert.Equal(t, "level is Warn", fmt.Sprintf("level is %v", Warn))
+}
+
+func TestIntegerEnumParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Offset
+	}{
+		{"Minus2", Minus2},
+		{"-2", Minus2},
+		{"N0", N0},
+		{"0", N0},
+		{"2", N2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			v, err := ParseOffset(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+
+	p, err := ParsePriority("High")
+	require.NoError(t, err)
+	assert.Equal(t, High, p)
+
+	_, err = ParsePriority("7")
+	assert.Error(t, err)
+	_, err = ParseLevel("debug")
+	assert.Error(t, err)
+}
+
+func TestIntegerEnumParseRoundTrip(t *testing.T) {
+	for _, l := range []Level{Debug, Info, Warn} {
+		parsed, err := ParseLevel(l.String())
+		require.NoError(t, err)
+		assert.Equal(t, l, parsed)
+	}
+}
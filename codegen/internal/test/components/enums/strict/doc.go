@@ -0,0 +1,5 @@
+// Package strict tests enums with generation.strict-enums, whose UnmarshalJSON
+// rejects values outside them, and their Values and Parse functions.
+package strict
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,204 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Color
+type Color string
+
+const (
+	Red   Color = "red"
+	Green Color = "green"
+	Blue  Color = "blue"
+)
+
+// Values returns the Color constants, in the order of the spec.
+func (Color) Values() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+// ParseColor returns the Color constant whose value is s. Other strings
+// return an error.
+func ParseColor(s string) (Color, error) {
+	switch v := Color(s); v {
+	case Red, Green, Blue:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values not in
+// the enum.
+func (e *Color) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Color(v) {
+	case Red, Green, Blue:
+		*e = Color(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Color value %q", v)
+}
+
+// #/components/schemas/Priority
+type Priority int
+
+const (
+	N1 Priority = 1
+	N2 Priority = 2
+	N3 Priority = 3
+)
+
+// Values returns the Priority constants, in the order of the spec.
+func (Priority) Values() []Priority {
+	return []Priority{N1, N2, N3}
+}
+
+// String returns the name of the Priority constant equal to e, or the
+// type and number for values not in the enum.
+func (e Priority) String() string {
+	switch e {
+	case N1:
+		return "N1"
+	case N2:
+		return "N2"
+	case N3:
+		return "N3"
+	}
+	return fmt.Sprintf("Priority(%d)", int(e))
+}
+
+// ParsePriority returns the Priority constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "N1", "1":
+		return N1, nil
+	case "N2", "2":
+		return N2, nil
+	case "N3", "3":
+		return N3, nil
+	}
+	return 0, fmt.Errorf("invalid Priority value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values not in
+// the enum.
+func (e *Priority) UnmarshalJSON(data []byte) error {
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Priority(v) {
+	case N1, N2, N3:
+		*e = Priority(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Priority value %d", v)
+}
+
+// #/components/schemas/Pet
+type Pet struct {
+	Color Color    `form:"color" json:"color"`
+	Size  *PetSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Pet/properties/size
+type PetSize string
+
+const (
+	Small PetSize = "small"
+	Large PetSize = "large"
+)
+
+// Values returns the PetSize constants, in the order of the spec.
+func (PetSize) Values() []PetSize {
+	return []PetSize{Small, Large}
+}
+
+// ParsePetSize returns the PetSize constant whose value is s. Other strings
+// return an error.
+func ParsePetSize(s string) (PetSize, error) {
+	switch v := PetSize(s); v {
+	case Small, Large:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid PetSize value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values not in
+// the enum.
+func (e *PetSize) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch PetSize(v) {
+	case Small, Large:
+		*e = PetSize(v)
+		return nil
+	}
+	return fmt.Errorf("invalid PetSize value %q", v)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/2yPwU7zMBCE73mKUf7/GLWU3nzlBZA4Vj2k7jRd5NhmvUEqiHdHCQESCZ9W4/1W86XM",
+	"2GZxqPeb3eauriRekqsAEwt0eDIVb2Ac+lIBr9QiKTrU03Ju7Voc3j8qn/qcIqOVES7+yr6dRuAhhaRf",
+	"I2C3TIdiKrGbo/G2w0F5btApGRucwsDj9P2oklTstuYlGjvq+sCuwX2D/czR1kg6PdPbHClfBlGeHQ5+",
+	"bHec86wpU01YvmHAL+uP77/y4lD/2/46b2fh7eRa/ywXeeMS/cN+IVD6NoQGodWOx+pzAP1400CaAQAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,42 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValues(t *testing.T) {
+	assert.Equal(t, []Color{Red, Green, Blue}, Color("").Values())
+	assert.Equal(t, []Priority{N1, N2, N3}, Priority(0).Values())
+	assert.Equal(t, []PetSize{Small, Large}, PetSize("").Values())
+}
+
+func TestStringEnumParse(t *testing.T) {
+	c, err := ParseColor("green")
+	require.NoError(t, err)
+	assert.Equal(t, Green, c)
+
+	_, err = ParseColor("purple")
+	assert.EqualError(t, err, `invalid Color value "purple"`)
+}
+
+func TestStrictUnmarshal(t *testing.T) {
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"color":"red","size":"large"}`), &pet))
+	assert.Equal(t, Red, pet.Color)
+	assert.Equal(t, Large, *pet.Size)
+
+	err := json.Unmarshal([]byte(`{"color":"purple"}`), &pet)
+	assert.ErrorContains(t, err, `invalid Color value "purple"`)
+
+	err = json.Unmarshal([]byte(`{"color":"red","size":"medium"}`), &pet)
+	assert.ErrorContains(t, err, `invalid PetSize value "medium"`)
+
+	var p Priority
+	require.NoError(t, json.Unmarshal([]byte(`3`), &p))
+	assert.Equal(t, N3, p)
+	assert.EqualError(t, json.Unmarshal([]byte(`4`), &p), "invalid Priority value 4")
+}
@@ -0,0 +1,5 @@
+// Package go_name tests that x-go-name overrides the Go identifier of a
+// property or schema without changing its JSON name.
+package go_name
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,98 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/device_info
+type Device struct {
+	OSVersion string               `form:"iOSVersion" json:"iOSVersion"`
+	Build     *DeviceInfoBuildInfo `form:"build_info,omitempty" json:"build_info,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Device) ApplyDefaults() {
+	if s.Build != nil {
+		s.Build.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/device_info/properties/build_info
+type DeviceInfoBuildInfo struct {
+	Number *int `form:"number,omitempty" json:"number,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *DeviceInfoBuildInfo) ApplyDefaults() {
+}
+
+// #/components/schemas/Inventory
+type Inventory struct {
+	Primary *Device `form:"primary,omitempty" json:"primary,omitempty"`
+	Spare   *Device `form:"spare,omitempty" json:"spare,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Inventory) ApplyDefaults() {
+	if s.Primary != nil {
+		s.Primary.ApplyDefaults()
+	}
+	if s.Spare != nil {
+		s.Spare.ApplyDefaults()
+	}
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5xRu07DQBDs/RUrg5QqMYjuSkRDRYFEgxA62xNnUbx37J0tIsS/Ixu/ggJFtjrN7Mys",
+	"bpyHWM+G0pvN9eYqTVi2ziREkeMehj7WlVuLrZEQtdDATgyl/aa3cRcMfX4lhau9E0gMnTIUO9S2fxKV",
+	"aLnA6+jaTTx4GHL5G4o4QFOKobteMOCK94YVpaFnfnh8+sl/GUivzkMjI4zORPPWjI2JISpLtYAXqZNs",
+	"ovOG9+XR3X/c/svpttMtqFNXdiNNnUOPsdGfJaKC9ty9tJDo9PDP953K8Mq1nVXdXCq2hlYX2dxXNpSV",
+	"LXpaTZLgreIsh+8BAK1nIYRXAgAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
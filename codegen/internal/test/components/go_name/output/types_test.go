@@ -0,0 +1,30 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoNameKeepsJSONNames(t *testing.T) {
+	number := 7
+	device := Device{OSVersion: "17.4", Build: &DeviceInfoBuildInfo{Number: &number}}
+
+	b, err := json.Marshal(device)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iOSVersion":"17.4","build_info":{"number":7}}`, string(b))
+
+	var decoded Device
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, device, decoded)
+}
+
+func TestGoNameOfReferencedSchemaNamesOnlyItsType(t *testing.T) {
+	inventory := Inventory{Primary: &Device{OSVersion: "17.4"}, Spare: &Device{OSVersion: "16.0"}}
+
+	b, err := json.Marshal(inventory)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"primary":{"iOSVersion":"17.4"},"spare":{"iOSVersion":"16.0"}}`, string(b))
+}
@@ -0,0 +1,5 @@
+// Package inline_schemas tests the inline-schemas options: plain inline
+// objects as anonymous structs, and the name template of promoted ones.
+package inline_schemas
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,475 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Order
+type Order struct {
+	Customer struct {
+		Name    string `form:"name" json:"name"`
+		Address *struct {
+			City *string `form:"city,omitempty" json:"city,omitempty"`
+		} `form:"address,omitempty" json:"address,omitempty"`
+	} `form:"customer" json:"customer"`
+	Lines []struct {
+		// Stock keeping unit.
+		Sku      string `form:"sku" json:"sku"`
+		Quantity int    `form:"quantity" json:"quantity"`
+	} `form:"lines,omitempty" json:"lines,omitempty"`
+	Shipping *OrderShipping `form:"shipping,omitempty" json:"shipping,omitempty"`
+	Metadata *OrderMetadata `form:"metadata,omitempty" json:"metadata,omitempty"`
+	Status   *OrderStatus   `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Order) ApplyDefaults() {
+	if s.Shipping != nil {
+		s.Shipping.ApplyDefaults()
+	}
+	if s.Metadata != nil {
+		s.Metadata.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Order/properties/lines
+type OrderLines = []struct {
+	// Stock keeping unit.
+	Sku      string `form:"sku" json:"sku"`
+	Quantity int    `form:"quantity" json:"quantity"`
+}
+
+// #/components/schemas/Order/properties/shipping
+type OrderShipping struct {
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *OrderShipping) ApplyDefaults() {
+	if s.Method == nil {
+		v := "standard"
+		s.Method = &v
+	}
+}
+
+// #/components/schemas/Order/properties/metadata
+type OrderMetadata struct {
+	Source               *string           `form:"source,omitempty" json:"source,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Get returns the specified additional property value and whether it was found.
+func (a OrderMetadata) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Set sets an additional property value.
+func (a *OrderMetadata) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+func (a *OrderMetadata) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["source"]; found {
+		var val string
+		if err := json.Unmarshal(raw, &val); err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		a.Source = &val
+		delete(object, "source")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+func (a OrderMetadata) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Source != nil {
+		object["source"], err = json.Marshal(a.Source)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'source': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *OrderMetadata) ApplyDefaults() {
+}
+
+// #/components/schemas/Order/properties/status
+type OrderStatus string
+
+const (
+	Open   OrderStatus = "open"
+	Closed OrderStatus = "closed"
+)
+
+// Values returns the OrderStatus constants, in the order of the spec.
+func (OrderStatus) Values() []OrderStatus {
+	return []OrderStatus{Open, Closed}
+}
+
+// ParseOrderStatus returns the OrderStatus constant whose value is s. Other strings
+// return an error.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	switch v := OrderStatus(s); v {
+	case Open, Closed:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OrderStatus value %q", s)
+}
+
+// #/paths//resources/{id}/patch/responses/200/content/application/json/schema
+
+type PatchResourceJSONResponse struct {
+	union json.RawMessage
+}
+
+// AsPatchResourceJSONResponseOneOf0 returns the union data inside the PatchResourceJSONResponse as a PatchResourceJSONResponseOneOf0.
+func (t PatchResourceJSONResponse) AsPatchResourceJSONResponseOneOf0() (PatchResourceJSONResponseOneOf0, error) {
+	var body PatchResourceJSONResponseOneOf0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPatchResourceJSONResponseOneOf0 overwrites any union data inside the PatchResourceJSONResponse as the provided PatchResourceJSONResponseOneOf0.
+func (t *PatchResourceJSONResponse) FromPatchResourceJSONResponseOneOf0(v PatchResourceJSONResponseOneOf0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePatchResourceJSONResponseOneOf0 performs a merge with any union data inside the PatchResourceJSONResponse, using the provided PatchResourceJSONResponseOneOf0.
+func (t *PatchResourceJSONResponse) MergePatchResourceJSONResponseOneOf0(v PatchResourceJSONResponseOneOf0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPatchResourceJSONResponseOneOf1 returns the union data inside the PatchResourceJSONResponse as a PatchResourceJSONResponseOneOf1.
+func (t PatchResourceJSONResponse) AsPatchResourceJSONResponseOneOf1() (PatchResourceJSONResponseOneOf1, error) {
+	var body PatchResourceJSONResponseOneOf1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPatchResourceJSONResponseOneOf1 overwrites any union data inside the PatchResourceJSONResponse as the provided PatchResourceJSONResponseOneOf1.
+func (t *PatchResourceJSONResponse) FromPatchResourceJSONResponseOneOf1(v PatchResourceJSONResponseOneOf1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePatchResourceJSONResponseOneOf1 performs a merge with any union data inside the PatchResourceJSONResponse, using the provided PatchResourceJSONResponseOneOf1.
+func (t *PatchResourceJSONResponse) MergePatchResourceJSONResponseOneOf1(v PatchResourceJSONResponseOneOf1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PatchResourceJSONResponse) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PatchResourceJSONResponse) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *PatchResourceJSONResponse) ApplyDefaults() {
+}
+
+// #/paths//resources/{id}/patch/responses/200/content/application/json/schema/oneOf/0
+type PatchResourceJSONResponseOneOf0 struct {
+	ID string `form:"id" json:"id"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PatchResourceJSONResponseOneOf0) ApplyDefaults() {
+}
+
+// #/paths//resources/{id}/patch/responses/200/content/application/json/schema/oneOf/1
+type PatchResourceJSONResponseOneOf1 struct {
+	Ids []string `form:"ids" json:"ids"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PatchResourceJSONResponseOneOf1) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6RVTVPbMBC9+1e8Sa8QQnvTP+AE0/bG5KBKCxaxV0K76kym0//ecZw0CtiQlpyc/Xxv",
+	"90mKidimYLD4srxerhZN4IdoGuAnZQmRDa6Xq+WqATRoRwY33AUmiGupt0g59lFD5CZZbWVIvMoksWRH",
+	"cvUr+N+DCUhWXTt+AjFRtkPSjTej5+s+pQHG6Gx7UspySAEuwbYng+D/moDAuwJtZcr0XEImb6C5UOUY",
+	"EZvKAug2kYFoDvzYHPIlRRaqWi8+r1aL41/Ak7gcku7m872lkQR5HKgvq2AXWYn1tLFNqQtuN4SrJ4l8",
+	"6p0GCwCR6fbhtRm43HOJP57I6URAPZn74NeTISkPu9FQsz/9BT/nmZzmRyDK/2OU90DanO12NiYo9W+U",
+	"eMHUxT5FJtZdyri53Sdwmz1l09RJJ+wrvq6Ixp7yupmn+Ql3nQ28ryGwmWA58raPRQY4xalcgEmUPCKT",
+	"QGM8ivHQ41gRczupkA3nrl7F3AJ257M5UxLW+0wi0/GTCnlr7y7o9rV1pv1wf4lp3pPEhAhm0VXTkk25",
+	"wHOxrEG36+Y8BrIpL00vbplvGt0GG6IU+BGFgy6bfzh/B0BmJimw0iPlSmm3e40xkR9a9qRt9KPmxluf",
+	"/BGCtCENyM5Q1twUxgZnCwjw9GBLp4PXsrf5+DD0pNZbtR9AM97jZ6Kx3odhTba7myk3mShqtUwo8VV9",
+	"4tIb3A9v9QVcF4X8uvkzAMZ/KN66BwAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ErrDuplicateSetItem is the sentinel error wrapped by the errors of
+// Set.UnmarshalJSON when an array holds an item twice.
+var ErrDuplicateSetItem = errors.New("set: duplicate item")
+
+// Set is an ordered set of unique items, for arrays with uniqueItems: true.
+// Items keep the order they're added in, and marshal to a JSON array in that
+// order. Unmarshaling rejects arrays which hold an item twice. The zero value
+// is an empty set, ready to use.
+type Set[T comparable] struct {
+	items []T
+	index map[T]struct{}
+}
+
+// Add adds item unless the set has it already, and reports whether it did.
+func (s *Set[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+	return true
+}
+
+// Remove removes item, and reports whether the set had it.
+func (s *Set[T]) Remove(item T) bool {
+	if !s.Has(item) {
+		return false
+	}
+	delete(s.index, item)
+	s.items = slices.DeleteFunc(s.items, func(v T) bool { return v == item })
+	return true
+}
+
+// Has reports whether the set holds item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of the items, in order.
+func (s Set[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// All returns an iterator over the items, in order.
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// IsZero reports whether the set is empty, so fields tagged omitzero are
+// omitted then.
+func (s Set[T]) IsZero() bool {
+	return len(s.items) == 0
+}
+
+// MarshalJSON implements json.Marshaler, writing the items as an array, [] when
+// there are none.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an array of unique items.
+// null leaves the set empty.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// setItems replaces the items of s with items, unless they hold duplicates.
+func (s *Set[T]) setItems(items []T) error {
+	var set Set[T]
+	for i, item := range items {
+		if !set.Add(item) {
+			return fmt.Errorf("%w at index %d: %v", ErrDuplicateSetItem, i, item)
+		}
+	}
+	*s = set
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, writing
+// the items as a sequence.
+func (s Set[T]) MarshalYAML() (any, error) {
+	if s.items == nil {
+		return []T{}, nil
+	}
+	return s.items, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, rejecting duplicates like UnmarshalJSON.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+// JSONMerge merges two JSON-encoded objects. Fields from patch override
+// fields in base. Both arguments must be valid JSON objects (or nil/null).
+func JSONMerge(base, patch json.RawMessage) (json.RawMessage, error) {
+	if len(base) == 0 || string(base) == "null" {
+		return patch, nil
+	}
+	if len(patch) == 0 || string(patch) == "null" {
+		return base, nil
+	}
+
+	var baseMap map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling base: %w", err)
+	}
+
+	var patchMap map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling patch: %w", err)
+	}
+
+	for k, v := range patchMap {
+		baseMap[k] = v
+	}
+
+	return json.Marshal(baseMap)
+}
@@ -0,0 +1,53 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnonymousStructs verifies that plain inline objects are held in
+// anonymous structs, which round-trip through JSON.
+func TestAnonymousStructs(t *testing.T) {
+	var order Order
+	order.Customer.Name = "Ada"
+	order.Lines = append(order.Lines, struct {
+		// Stock keeping unit.
+		Sku      string `form:"sku" json:"sku"`
+		Quantity int    `form:"quantity" json:"quantity"`
+	}{Sku: "A-1", Quantity: 2})
+
+	data, err := json.Marshal(order)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"customer":{"name":"Ada"},"lines":[{"sku":"A-1","quantity":2}]}`, string(data))
+
+	var decoded Order
+	require.NoError(t, json.Unmarshal([]byte(`{"customer":{"name":"Ada","address":{"city":"London"}}}`), &decoded))
+	require.NotNil(t, decoded.Customer.Address)
+	assert.Equal(t, "London", *decoded.Customer.Address.City)
+}
+
+// TestPromotedSchemas verifies that inline objects needing methods keep
+// their types.
+func TestPromotedSchemas(t *testing.T) {
+	shipping := OrderShipping{}
+	shipping.ApplyDefaults()
+	require.NotNil(t, shipping.Method)
+	assert.Equal(t, "standard", *shipping.Method)
+
+	var metadata OrderMetadata
+	require.NoError(t, json.Unmarshal([]byte(`{"source":"web","campaign":"spring"}`), &metadata))
+	assert.Equal(t, map[string]string{"campaign": "spring"}, metadata.AdditionalProperties)
+}
+
+// TestNameTemplate verifies that promoted inline schemas are named by the
+// name template, after the schema they're defined in.
+func TestNameTemplate(t *testing.T) {
+	var resp PatchResourceJSONResponse
+	require.NoError(t, resp.FromPatchResourceJSONResponseOneOf1(PatchResourceJSONResponseOneOf1{Ids: []string{"a", "b"}}))
+	ids, err := resp.AsPatchResourceJSONResponseOneOf1()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids.Ids)
+}
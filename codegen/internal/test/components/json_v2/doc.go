@@ -0,0 +1,5 @@
+// Package json_v2 tests the json-v2 generation option, which adds the
+// methods of encoding/json/v2 to generated marshalers and runtime types.
+package json_v2
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
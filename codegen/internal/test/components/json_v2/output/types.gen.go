@@ -0,0 +1,1124 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name                 string            `form:"name" json:"name"`
+	Nickname             Nullable[string]  `form:"nickname,omitempty" json:"nickname,omitzero"`
+	Born                 *Date             `form:"born,omitempty" json:"born,omitempty"`
+	Age                  *int              `form:"age,omitempty" json:"age,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Get returns the specified additional property value and whether it was found.
+func (a Pet) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Set sets an additional property value.
+func (a *Pet) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+func (a *Pet) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["name"]; found {
+		if err := json.Unmarshal(raw, &a.Name); err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["nickname"]; found {
+		if err := json.Unmarshal(raw, &a.Nickname); err != nil {
+			return fmt.Errorf("error reading 'nickname': %w", err)
+		}
+		delete(object, "nickname")
+	}
+
+	if raw, found := object["born"]; found {
+		var val Date
+		if err := json.Unmarshal(raw, &val); err != nil {
+			return fmt.Errorf("error reading 'born': %w", err)
+		}
+		a.Born = &val
+		delete(object, "born")
+	}
+
+	if raw, found := object["age"]; found {
+		var val int
+		if err := json.Unmarshal(raw, &val); err != nil {
+			return fmt.Errorf("error reading 'age': %w", err)
+		}
+		a.Age = &val
+		delete(object, "age")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+func (a Pet) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	object["nickname"], err = json.Marshal(a.Nickname)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'nickname': %w", err)
+	}
+
+	if a.Born != nil {
+		object["born"], err = json.Marshal(a.Born)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'born': %w", err)
+		}
+	}
+
+	if a.Age != nil {
+		object["age"], err = json.Marshal(a.Age)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'age': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// properties, then the additional properties sorted by name, with the options
+// of enc. Additional properties named like a property are skipped.
+func (a Pet) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	if err := enc.WriteToken(jsontext.String("name")); err != nil {
+		return err
+	}
+	if err := jsonv2.MarshalEncode(enc, a.Name); err != nil {
+		return fmt.Errorf("error marshaling 'name': %w", err)
+	}
+	if err := enc.WriteToken(jsontext.String("nickname")); err != nil {
+		return err
+	}
+	if err := jsonv2.MarshalEncode(enc, a.Nickname); err != nil {
+		return fmt.Errorf("error marshaling 'nickname': %w", err)
+	}
+	if a.Born != nil {
+		if err := enc.WriteToken(jsontext.String("born")); err != nil {
+			return err
+		}
+		if err := jsonv2.MarshalEncode(enc, a.Born); err != nil {
+			return fmt.Errorf("error marshaling 'born': %w", err)
+		}
+	}
+	if a.Age != nil {
+		if err := enc.WriteToken(jsontext.String("age")); err != nil {
+			return err
+		}
+		if err := jsonv2.MarshalEncode(enc, a.Age); err != nil {
+			return fmt.Errorf("error marshaling 'age': %w", err)
+		}
+	}
+	for _, fieldName := range slices.Sorted(maps.Keys(a.AdditionalProperties)) {
+		switch fieldName {
+		case "name", "nickname", "born", "age":
+			continue
+		}
+		if err := enc.WriteToken(jsontext.String(fieldName)); err != nil {
+			return err
+		}
+		if err := jsonv2.MarshalEncode(enc, a.AdditionalProperties[fieldName]); err != nil {
+			return fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading the properties and additional properties with the options of dec.
+func (a *Pet) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case jsontext.KindNull:
+		return nil
+	case jsontext.KindBeginObject:
+	default:
+		return fmt.Errorf("expected an object, got %v", tok.Kind())
+	}
+	for dec.PeekKind() != jsontext.KindEndObject {
+		tok, err := dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		switch fieldName := tok.String(); fieldName {
+		case "name":
+			if err := jsonv2.UnmarshalDecode(dec, &a.Name); err != nil {
+				return fmt.Errorf("error reading 'name': %w", err)
+			}
+		case "nickname":
+			if err := jsonv2.UnmarshalDecode(dec, &a.Nickname); err != nil {
+				return fmt.Errorf("error reading 'nickname': %w", err)
+			}
+		case "born":
+			var val Date
+			if err := jsonv2.UnmarshalDecode(dec, &val); err != nil {
+				return fmt.Errorf("error reading 'born': %w", err)
+			}
+			a.Born = &val
+		case "age":
+			var val int
+			if err := jsonv2.UnmarshalDecode(dec, &val); err != nil {
+				return fmt.Errorf("error reading 'age': %w", err)
+			}
+			a.Age = &val
+		default:
+			var fieldVal string
+			if err := jsonv2.UnmarshalDecode(dec, &fieldVal); err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			if a.AdditionalProperties == nil {
+				a.AdditionalProperties = make(map[string]string)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	_, err = dec.ReadToken()
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Patch
+type Patch struct {
+	Nickname Nullable[string] `form:"nickname,omitempty" json:"nickname,omitzero"`
+	Tags     []string         `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Patch) ApplyDefaults() {
+}
+
+// #/components/schemas/Cat
+type Cat struct {
+	Meows *bool `form:"meows,omitempty" json:"meows,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Cat) ApplyDefaults() {
+}
+
+// #/components/schemas/Dog
+type Dog struct {
+	Barks *bool `form:"barks,omitempty" json:"barks,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Dog) ApplyDefaults() {
+}
+
+// #/components/schemas/Animal
+
+type Animal struct {
+	union json.RawMessage
+}
+
+// AsCat returns the union data inside the Animal as a Cat.
+func (t Animal) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Animal as the provided Cat.
+func (t *Animal) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Animal, using the provided Cat.
+func (t *Animal) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Animal as a Dog.
+func (t Animal) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Animal as the provided Dog.
+func (t *Animal) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Animal, using the provided Dog.
+func (t *Animal) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Animal) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Animal) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2.
+func (t Animal) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if t.union == nil {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteValue(jsontext.Value(t.union))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2.
+func (t *Animal) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	v, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	t.union = json.RawMessage(v.Clone())
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *Animal) ApplyDefaults() {
+}
+
+// #/components/schemas/Listing
+
+type Listing struct {
+	ID    int     `form:"id" json:"id"`
+	Note  *string `form:"note,omitempty" json:"note,omitempty"`
+	union json.RawMessage
+}
+
+// AsCat returns the union data inside the Listing as a Cat.
+func (t Listing) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Listing as the provided Cat.
+func (t *Listing) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Listing, using the provided Cat.
+func (t *Listing) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Listing as a Dog.
+func (t Listing) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Listing as the provided Dog.
+func (t *Listing) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Listing, using the provided Dog.
+func (t *Listing) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Listing) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+	object["id"], err = json.Marshal(t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+	if t.Note != nil {
+		object["note"], err = json.Marshal(t.Note)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'note': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Listing) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.ID)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+	if raw, found := object["note"]; found {
+		err = json.Unmarshal(raw, &t.Note)
+		if err != nil {
+			return fmt.Errorf("error reading 'note': %w", err)
+		}
+	}
+	return err
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, marshaling
+// the fixed fields with the options of enc.
+func (t Listing) MarshalJSONTo(enc *jsontext.Encoder) error {
+	object := make(map[string]jsontext.Value)
+	if t.union != nil {
+		if err := jsonv2.Unmarshal(t.union, &object); err != nil {
+			return err
+		}
+	}
+	var err error
+	object["id"], err = jsonv2.Marshal(t.ID, enc.Options())
+	if err != nil {
+		return fmt.Errorf("error marshaling 'id': %w", err)
+	}
+	if t.Note != nil {
+		object["note"], err = jsonv2.Marshal(t.Note, enc.Options())
+		if err != nil {
+			return fmt.Errorf("error marshaling 'note': %w", err)
+		}
+	}
+	return jsonv2.MarshalEncode(enc, object)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// unmarshaling the fixed fields with the options of dec.
+func (t *Listing) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	v, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	t.union = json.RawMessage(v.Clone())
+	object := make(map[string]jsontext.Value)
+	if err := jsonv2.Unmarshal(v, &object, dec.Options()); err != nil {
+		return err
+	}
+	if raw, found := object["id"]; found {
+		if err := jsonv2.Unmarshal(raw, &t.ID, dec.Options()); err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+	if raw, found := object["note"]; found {
+		if err := jsonv2.Unmarshal(raw, &t.Note, dec.Options()); err != nil {
+			return fmt.Errorf("error reading 'note': %w", err)
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *Listing) ApplyDefaults() {
+}
+
+// #/components/schemas/Reading
+type Reading struct {
+	Day     Date
+	Celsius *int
+	// Rest holds the items after the others.
+	Rest []string
+}
+
+// items returns the items of the Reading in order. Absent items at the
+// end are left out; those before a present one are null.
+func (t Reading) items() []any {
+	items := []any{t.Day, t.Celsius}
+	n := 1
+	if t.Celsius != nil {
+		n = 2
+	}
+	if len(t.Rest) > 0 {
+		n = len(items)
+	}
+	items = items[:n]
+	for _, item := range t.Rest {
+		items = append(items, item)
+	}
+	return items
+}
+
+// MarshalJSON encodes the Reading as a JSON array of its items.
+func (t Reading) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the Reading from a JSON array of its items.
+func (t *Reading) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	if len(items) < 1 {
+		return fmt.Errorf("expected at least 1 items, got %d", len(items))
+	}
+	*t = Reading{}
+	if err := json.Unmarshal(items[0], &t.Day); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if len(items) > 1 {
+		if err := json.Unmarshal(items[1], &t.Celsius); err != nil {
+			return fmt.Errorf("error reading item 1: %w", err)
+		}
+	}
+	if len(items) > 2 {
+		t.Rest = make([]string, len(items)-2)
+		for i, raw := range items[2:] {
+			if err := json.Unmarshal(raw, &t.Rest[i]); err != nil {
+				return fmt.Errorf("error reading item %d: %w", 2+i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// items with the options of enc.
+func (t Reading) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginArray); err != nil {
+		return err
+	}
+	for i, item := range t.items() {
+		if err := jsonv2.MarshalEncode(enc, item); err != nil {
+			return fmt.Errorf("error marshaling item %d: %w", i, err)
+		}
+	}
+	return enc.WriteToken(jsontext.EndArray)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading the items with the options of dec.
+func (t *Reading) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case jsontext.KindNull:
+		return nil
+	case jsontext.KindBeginArray:
+	default:
+		return fmt.Errorf("expected an array, got %v", tok.Kind())
+	}
+	*t = Reading{}
+	n := 0
+	for ; dec.PeekKind() != jsontext.KindEndArray; n++ {
+		var err error
+		switch n {
+		case 0:
+			err = jsonv2.UnmarshalDecode(dec, &t.Day)
+		case 1:
+			err = jsonv2.UnmarshalDecode(dec, &t.Celsius)
+		default:
+			var item string
+			err = jsonv2.UnmarshalDecode(dec, &item)
+			t.Rest = append(t.Rest, item)
+		}
+		if err != nil {
+			return fmt.Errorf("error reading item %d: %w", n, err)
+		}
+	}
+	if _, err := dec.ReadToken(); err != nil {
+		return err
+	}
+	if n < 1 {
+		return fmt.Errorf("expected at least 1 items, got %d", n)
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Reading) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7SUMW/bQAyFd/2KB7dAlsay2+22IlkCFKjRNchAS5TMRCLVOzqtUfS/F7VlGa6F2B66",
+	"3VHfkXzEo6xjpU4CJp+m8+lskolWFjLglWMS04D5dDadZYCLNxzAWlgpWufPyTR//Zh15KsU8Ot3Vljb",
+	"mbJ6ChmQihW3tD0CC/bdAfBNxwG2fObC+1Dk72uJXAY8KrX81Ie7aB1HF077t8Df74fbPlvyKFofICle",
+	"xsHHHfkBE103zeRpAJYW9WxeoLLYkgeU5DyEqR6pJOpcc+zjVJbiYkrNYkTUSbEFebF6Y2Cjk7letFOd",
+	"RnCKkTanNCDO7dGDkdbvyK9svGX7MdLG0qxh0gwA7q2+MumS4suZpJ9VWmr2jCl/rfYX4BbvI1cBN+/y",
+	"g63z3tP5HfnNhei91Tv0iyQXrS9bAynfWgIpz/kNUPNzi/LfJX9jKk8kb93VR1rRh62nMB/kciU/H46N",
+	"dnvVOg6/qpI2Jyn+HdMAF9wkWads1OlH5f8MAEvNuQwzBQAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+const DateFormat = "2006-01-02"
+
+type Date struct {
+	time.Time
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(DateFormat))
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var dateStr string
+	err := json.Unmarshal(data, &dateStr)
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(DateFormat, dateStr)
+	if err != nil {
+		return err
+	}
+	d.Time = parsed
+	return nil
+}
+
+func (d Date) String() string {
+	return d.Format(DateFormat)
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := time.Parse(DateFormat, string(data))
+	if err != nil {
+		return err
+	}
+	d.Time = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for Date.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.Format(DateFormat)), nil
+}
+
+// Format returns the date formatted according to layout.
+func (d Date) Format(layout string) string {
+	return d.Time.Format(layout)
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// date as a DateFormat string.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(d.Format(DateFormat)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading a DateFormat string. null leaves the zero date.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case jsontext.KindNull:
+		*d = Date{}
+		return nil
+	case jsontext.KindString:
+		parsed, err := time.Parse(DateFormat, tok.String())
+		if err != nil {
+			return err
+		}
+		d.Time = parsed
+		return nil
+	default:
+		return fmt.Errorf("date: expected a string, got %v", tok.Kind())
+	}
+}
+
+// Nullable is a generic type that can distinguish between:
+// - Field not provided (unspecified)
+// - Field explicitly set to null
+// - Field has a value
+//
+// This is implemented as a map[bool]T where:
+// - Empty map: unspecified
+// - map[false]T: explicitly null
+// - map[true]T: has a value
+type Nullable[T any] map[bool]T
+
+// Get returns the value if set, or an error if null or unspecified.
+func (n Nullable[T]) Get() (T, error) {
+	if v, ok := n[true]; ok {
+		return v, nil
+	}
+	var zero T
+	if n.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	return zero, ErrNullableNotSpecified
+}
+
+// MustGet returns the value or panics if null or unspecified.
+func (n Nullable[T]) MustGet() T {
+	v, err := n.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if null or
+// unspecified.
+func (n Nullable[T]) Ptr() *T {
+	if v, ok := n[true]; ok {
+		return &v
+	}
+	return nil
+}
+
+// Set assigns a value.
+func (n *Nullable[T]) Set(value T) {
+	*n = Nullable[T]{true: value}
+}
+
+// SetNull marks the field as explicitly null.
+func (n *Nullable[T]) SetNull() {
+	*n = Nullable[T]{false: *new(T)}
+}
+
+// SetUnspecified clears the field (as if it was never set).
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+// IsNull returns true if the field is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	if n == nil {
+		return false
+	}
+	_, ok := n[false]
+	return ok
+}
+
+// IsSpecified returns true if the field was provided (either null or a value).
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) > 0
+}
+
+// IsZero reports whether the field is unspecified, so fields tagged omitzero
+// are omitted only then, and written as null when explicitly null.
+func (n Nullable[T]) IsZero() bool {
+	return len(n) == 0
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.IsNull() {
+		return []byte("null"), nil
+	}
+	if v, ok := n[true]; ok {
+		return json.Marshal(v)
+	}
+	// Unspecified - this shouldn't be called if omitempty is used correctly
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2. Null
+// and unspecified are written as null.
+func (n Nullable[T]) MarshalYAML() (any, error) {
+	if v, ok := n[true]; ok {
+		return v, nil
+	}
+	return nil, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too. yaml doesn't call unmarshalers for null, which leaves n unspecified
+// rather than null.
+func (n *Nullable[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// Scan implements sql.Scanner, so Nullable columns can be read with
+// database/sql. NULL makes n null; other values are converted to T as
+// Rows.Scan converts them, including through T's own Scan method.
+func (n *Nullable[T]) Scan(src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return err
+	}
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	n.Set(v.V)
+	return nil
+}
+
+// Value implements driver.Valuer, so Nullable columns can be written with
+// database/sql. Null and unspecified are written as NULL, and values through
+// T's own Value method, or else as database/sql converts them.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	v, ok := n[true]
+	return sql.Null[T]{V: v, Valid: ok}.Value()
+}
+
+// ErrNullableIsNull is returned when trying to get a value from a null Nullable.
+var ErrNullableIsNull = errors.New("nullable value is null")
+
+// ErrNullableNotSpecified is returned when trying to get a value from an unspecified Nullable.
+var ErrNullableNotSpecified = errors.New("nullable value is not specified")
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// value with the options of enc. Null and unspecified are written as null;
+// tag fields omitzero, not omitempty, to omit them while unspecified, as
+// encoding/json/v2 omits null with omitempty.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if v, ok := n[true]; ok {
+		return jsonv2.MarshalEncode(enc, v)
+	}
+	return enc.WriteToken(jsontext.Null)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading the value with the options of dec. null makes n null.
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == jsontext.KindNull {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := jsonv2.UnmarshalDecode(dec, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// ErrDuplicateSetItem is the sentinel error wrapped by the errors of
+// Set.UnmarshalJSON when an array holds an item twice.
+var ErrDuplicateSetItem = errors.New("set: duplicate item")
+
+// Set is an ordered set of unique items, for arrays with uniqueItems: true.
+// Items keep the order they're added in, and marshal to a JSON array in that
+// order. Unmarshaling rejects arrays which hold an item twice. The zero value
+// is an empty set, ready to use.
+type Set[T comparable] struct {
+	items []T
+	index map[T]struct{}
+}
+
+// Add adds item unless the set has it already, and reports whether it did.
+func (s *Set[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+	return true
+}
+
+// Remove removes item, and reports whether the set had it.
+func (s *Set[T]) Remove(item T) bool {
+	if !s.Has(item) {
+		return false
+	}
+	delete(s.index, item)
+	s.items = slices.DeleteFunc(s.items, func(v T) bool { return v == item })
+	return true
+}
+
+// Has reports whether the set holds item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of the items, in order.
+func (s Set[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// All returns an iterator over the items, in order.
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// IsZero reports whether the set is empty, so fields tagged omitzero are
+// omitted then.
+func (s Set[T]) IsZero() bool {
+	return len(s.items) == 0
+}
+
+// MarshalJSON implements json.Marshaler, writing the items as an array, [] when
+// there are none.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an array of unique items.
+// null leaves the set empty.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// setItems replaces the items of s with items, unless they hold duplicates.
+func (s *Set[T]) setItems(items []T) error {
+	var set Set[T]
+	for i, item := range items {
+		if !set.Add(item) {
+			return fmt.Errorf("%w at index %d: %v", ErrDuplicateSetItem, i, item)
+		}
+	}
+	*s = set
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, writing
+// the items as a sequence.
+func (s Set[T]) MarshalYAML() (any, error) {
+	if s.items == nil {
+		return []T{}, nil
+	}
+	return s.items, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, rejecting duplicates like UnmarshalJSON.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+// JSONMerge merges two JSON-encoded objects. Fields from patch override
+// fields in base. Both arguments must be valid JSON objects (or nil/null).
+func JSONMerge(base, patch json.RawMessage) (json.RawMessage, error) {
+	if len(base) == 0 || string(base) == "null" {
+		return patch, nil
+	}
+	if len(patch) == 0 || string(patch) == "null" {
+		return base, nil
+	}
+
+	var baseMap map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling base: %w", err)
+	}
+
+	var patchMap map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling patch: %w", err)
+	}
+
+	for k, v := range patchMap {
+		baseMap[k] = v
+	}
+
+	return json.Marshal(baseMap)
+}
@@ -0,0 +1,102 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package output
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONv2AdditionalProperties(t *testing.T) {
+	var pet Pet
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"name":"Rex","nickname":null,"born":"2020-05-01","color":"brown","size":"L"}`), &pet))
+	assert.Equal(t, "Rex", pet.Name)
+	assert.True(t, pet.Nickname.IsNull())
+	assert.Equal(t, "2020-05-01", pet.Born.String())
+	assert.Equal(t, map[string]string{"color": "brown", "size": "L"}, pet.AdditionalProperties)
+
+	data, err := jsonv2.Marshal(pet)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"Rex","nickname":null,"born":"2020-05-01","color":"brown","size":"L"}`, string(data))
+
+	// The options of the caller reach the properties.
+	data, err = jsonv2.Marshal(Pet{Name: "Rex", AdditionalProperties: map[string]string{"a": "b"}}, jsontext.Multiline(true))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n\t\"name\": \"Rex\",\n\t\"nickname\": null,\n\t\"a\": \"b\"\n}", string(data))
+
+	err = jsonv2.Unmarshal([]byte(`{"name":"Rex","age":"old"}`), &pet)
+	var semErr *jsonv2.SemanticError
+	require.ErrorAs(t, err, &semErr)
+	assert.Contains(t, err.Error(), "error reading 'age'")
+}
+
+func TestJSONv2NullableOmitZero(t *testing.T) {
+	// encoding/json/v2 omits null with omitempty, so only omitzero is set,
+	// which omits unspecified fields and writes explicit nulls with either
+	// package.
+	var patch Patch
+	patch.Nickname.SetNull()
+	for _, marshal := range []func(any) ([]byte, error){
+		func(v any) ([]byte, error) { return jsonv2.Marshal(v) },
+		json.Marshal,
+	} {
+		data, err := marshal(patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"nickname":null}`, string(data))
+
+		data, err = marshal(Patch{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(data))
+	}
+}
+
+func TestJSONv2Unions(t *testing.T) {
+	var animal Animal
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"meows":true}`), &animal))
+	cat, err := animal.AsCat()
+	require.NoError(t, err)
+	assert.True(t, *cat.Meows)
+
+	data, err := jsonv2.Marshal(animal)
+	require.NoError(t, err)
+	assert.Equal(t, `{"meows":true}`, string(data))
+
+	data, err = jsonv2.Marshal(Animal{})
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	var listing Listing
+	require.NoError(t, jsonv2.Unmarshal([]byte(`{"id":7,"barks":true}`), &listing))
+	assert.Equal(t, 7, listing.ID)
+	dog, err := listing.AsDog()
+	require.NoError(t, err)
+	assert.True(t, *dog.Barks)
+
+	listing.ID = 8
+	data, err = jsonv2.Marshal(listing, jsonv2.Deterministic(true))
+	require.NoError(t, err)
+	assert.Equal(t, `{"barks":true,"id":8}`, string(data))
+}
+
+func TestJSONv2Tuples(t *testing.T) {
+	var reading Reading
+	require.NoError(t, jsonv2.Unmarshal([]byte(`["2020-05-01",21,"sunny","dry"]`), &reading))
+	assert.Equal(t, "2020-05-01", reading.Day.String())
+	require.NotNil(t, reading.Celsius)
+	assert.Equal(t, 21, *reading.Celsius)
+	assert.Equal(t, []string{"sunny", "dry"}, reading.Rest)
+
+	// The options of the caller reach the items.
+	data, err := jsonv2.Marshal(Reading{Day: reading.Day, Rest: []string{"a"}}, jsontext.Multiline(true))
+	require.NoError(t, err)
+	assert.Equal(t, "[\n\t\"2020-05-01\",\n\tnull,\n\t\"a\"\n]", string(data))
+
+	err = jsonv2.Unmarshal([]byte(`["2020-05-01","warm"]`), &reading)
+	assert.ErrorContains(t, err, "error reading item 1")
+	assert.ErrorContains(t, jsonv2.Unmarshal([]byte(`[]`), &reading), "at least 1 items")
+}
@@ -20,7 +20,8 @@ type ArrayOfAnyOf = []ArrayOfAnyOfItem
 // #/components/schemas/ArrayOfAnyOf/items
 
 type ArrayOfAnyOfItem struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsString0 returns the union data inside the ArrayOfAnyOfItem as a string.
@@ -34,6 +35,7 @@ func (t ArrayOfAnyOfItem) AsString0() (string, error) {
 func (t *ArrayOfAnyOfItem) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -45,6 +47,7 @@ func (t *ArrayOfAnyOfItem) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -59,6 +62,7 @@ func (t ArrayOfAnyOfItem) AsArrayOfAnyOfAnyOf1() (ArrayOfAnyOfAnyOf1, error) {
 func (t *ArrayOfAnyOfItem) FromArrayOfAnyOfAnyOf1(v ArrayOfAnyOfAnyOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -70,9 +74,46 @@ func (t *ArrayOfAnyOfItem) MergeArrayOfAnyOfAnyOf1(v ArrayOfAnyOfAnyOf1) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ArrayOfAnyOfItem) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsArrayOfAnyOfAnyOf1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t ArrayOfAnyOfItem) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesArrayOfAnyOfAnyOf1 reports whether the union data matches ArrayOfAnyOfAnyOf1.
+func (t ArrayOfAnyOfItem) MatchesArrayOfAnyOfAnyOf1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ArrayOfAnyOfItem) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ArrayOfAnyOfItem) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -80,6 +121,7 @@ func (t ArrayOfAnyOfItem) MarshalJSON() ([]byte, error) {
 
 func (t *ArrayOfAnyOfItem) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -108,7 +150,8 @@ func (s *ObjectWithAnyOfProperty) ApplyDefaults() {
 // #/components/schemas/ObjectWithAnyOfProperty/properties/value
 
 type ObjectWithAnyOfPropertyValue struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsString0 returns the union data inside the ObjectWithAnyOfPropertyValue as a string.
@@ -122,6 +165,7 @@ func (t ObjectWithAnyOfPropertyValue) AsString0() (string, error) {
 func (t *ObjectWithAnyOfPropertyValue) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -133,6 +177,7 @@ func (t *ObjectWithAnyOfPropertyValue) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -147,6 +192,7 @@ func (t ObjectWithAnyOfPropertyValue) AsInt1() (int, error) {
 func (t *ObjectWithAnyOfPropertyValue) FromInt1(v int) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -158,9 +204,46 @@ func (t *ObjectWithAnyOfPropertyValue) MergeInt1(v int) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ObjectWithAnyOfPropertyValue) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsInt1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t ObjectWithAnyOfPropertyValue) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesInt1 reports whether the union data matches int.
+func (t ObjectWithAnyOfPropertyValue) MatchesInt1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ObjectWithAnyOfPropertyValue) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ObjectWithAnyOfPropertyValue) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -168,6 +251,7 @@ func (t ObjectWithAnyOfPropertyValue) MarshalJSON() ([]byte, error) {
 
 func (t *ObjectWithAnyOfPropertyValue) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -326,6 +410,56 @@ func (s *AllOfWithOneOf) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// AsAllOfWithOneOfAllOf1OneOf0 returns the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as a AllOfWithOneOfAllOf1OneOf0.
+func (s AllOfWithOneOf) AsAllOfWithOneOfAllOf1OneOf0() (AllOfWithOneOfAllOf1OneOf0, error) {
+	var union AllOfWithOneOfAllOf1
+	if s.AllOfWithOneOfAllOf1 != nil {
+		union = *s.AllOfWithOneOfAllOf1
+	}
+	return union.AsAllOfWithOneOfAllOf1OneOf0()
+}
+
+// FromAllOfWithOneOfAllOf1OneOf0 overwrites the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as the provided AllOfWithOneOfAllOf1OneOf0.
+func (s *AllOfWithOneOf) FromAllOfWithOneOfAllOf1OneOf0(v AllOfWithOneOfAllOf1OneOf0) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.FromAllOfWithOneOfAllOf1OneOf0(v)
+}
+
+// MergeAllOfWithOneOfAllOf1OneOf0 performs a merge with the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf, using the provided AllOfWithOneOfAllOf1OneOf0.
+func (s *AllOfWithOneOf) MergeAllOfWithOneOfAllOf1OneOf0(v AllOfWithOneOfAllOf1OneOf0) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.MergeAllOfWithOneOfAllOf1OneOf0(v)
+}
+
+// AsAllOfWithOneOfAllOf1OneOf1 returns the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as a AllOfWithOneOfAllOf1OneOf1.
+func (s AllOfWithOneOf) AsAllOfWithOneOfAllOf1OneOf1() (AllOfWithOneOfAllOf1OneOf1, error) {
+	var union AllOfWithOneOfAllOf1
+	if s.AllOfWithOneOfAllOf1 != nil {
+		union = *s.AllOfWithOneOfAllOf1
+	}
+	return union.AsAllOfWithOneOfAllOf1OneOf1()
+}
+
+// FromAllOfWithOneOfAllOf1OneOf1 overwrites the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as the provided AllOfWithOneOfAllOf1OneOf1.
+func (s *AllOfWithOneOf) FromAllOfWithOneOfAllOf1OneOf1(v AllOfWithOneOfAllOf1OneOf1) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.FromAllOfWithOneOfAllOf1OneOf1(v)
+}
+
+// MergeAllOfWithOneOfAllOf1OneOf1 performs a merge with the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf, using the provided AllOfWithOneOfAllOf1OneOf1.
+func (s *AllOfWithOneOf) MergeAllOfWithOneOfAllOf1OneOf1(v AllOfWithOneOfAllOf1OneOf1) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.MergeAllOfWithOneOfAllOf1OneOf1(v)
+}
+
 // ApplyDefaults sets default values for fields that are nil.
 func (s *AllOfWithOneOf) ApplyDefaults() {
 }
@@ -535,10 +669,10 @@ func (t *Prompt) ApplyDefaults() {
 
 // #/components/schemas/Prompt/oneOf/0
 type PromptOneOf0 struct {
-	Type    *string       `form:"type,omitempty" json:"type,omitempty"`
-	Prompt  []ChatMessage `form:"prompt" json:"prompt"`
-	Name    string        `form:"name" json:"name"`
-	Version int           `form:"version" json:"version"`
+	Type    *PromptOneOf0AllOf0Type `form:"type,omitempty" json:"type,omitempty"`
+	Prompt  []ChatMessage           `form:"prompt" json:"prompt"`
+	Name    string                  `form:"name" json:"name"`
+	Version int                     `form:"version" json:"version"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -552,12 +686,27 @@ const (
 	Chat PromptOneOf0AllOf0Type = "chat"
 )
 
+// Values returns the PromptOneOf0AllOf0Type constants, in the order of the spec.
+func (PromptOneOf0AllOf0Type) Values() []PromptOneOf0AllOf0Type {
+	return []PromptOneOf0AllOf0Type{Chat}
+}
+
+// ParsePromptOneOf0AllOf0Type returns the PromptOneOf0AllOf0Type constant whose value is s. Other strings
+// return an error.
+func ParsePromptOneOf0AllOf0Type(s string) (PromptOneOf0AllOf0Type, error) {
+	switch v := PromptOneOf0AllOf0Type(s); v {
+	case Chat:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid PromptOneOf0AllOf0Type value %q", s)
+}
+
 // #/components/schemas/Prompt/oneOf/1
 type PromptOneOf1 struct {
-	Type    *string `form:"type,omitempty" json:"type,omitempty"`
-	Prompt  string  `form:"prompt" json:"prompt"`
-	Name    string  `form:"name" json:"name"`
-	Version int     `form:"version" json:"version"`
+	Type    *PromptOneOf1AllOf0Type `form:"type,omitempty" json:"type,omitempty"`
+	Prompt  string                  `form:"prompt" json:"prompt"`
+	Name    string                  `form:"name" json:"name"`
+	Version int                     `form:"version" json:"version"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -571,6 +720,21 @@ const (
 	Text PromptOneOf1AllOf0Type = "text"
 )
 
+// Values returns the PromptOneOf1AllOf0Type constants, in the order of the spec.
+func (PromptOneOf1AllOf0Type) Values() []PromptOneOf1AllOf0Type {
+	return []PromptOneOf1AllOf0Type{Text}
+}
+
+// ParsePromptOneOf1AllOf0Type returns the PromptOneOf1AllOf0Type constant whose value is s. Other strings
+// return an error.
+func ParsePromptOneOf1AllOf0Type(s string) (PromptOneOf1AllOf0Type, error) {
+	switch v := PromptOneOf1AllOf0Type(s); v {
+	case Text:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid PromptOneOf1AllOf0Type value %q", s)
+}
+
 // #/components/schemas/CompositionEnumTest
 type CompositionEnumTest struct {
 	FieldA *CompositionEnumTestFieldA `form:"fieldA,omitempty" json:"fieldA,omitempty"`
@@ -585,7 +749,8 @@ func (s *CompositionEnumTest) ApplyDefaults() {
 // #/components/schemas/CompositionEnumTest/properties/fieldA
 
 type CompositionEnumTestFieldA struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsString0 returns the union data inside the CompositionEnumTestFieldA as a string.
@@ -599,6 +764,7 @@ func (t CompositionEnumTestFieldA) AsString0() (string, error) {
 func (t *CompositionEnumTestFieldA) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -610,6 +776,7 @@ func (t *CompositionEnumTestFieldA) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -624,6 +791,7 @@ func (t CompositionEnumTestFieldA) AsCompositionEnumTestFieldAAnyOf1() (Composit
 func (t *CompositionEnumTestFieldA) FromCompositionEnumTestFieldAAnyOf1(v CompositionEnumTestFieldAAnyOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -635,9 +803,46 @@ func (t *CompositionEnumTestFieldA) MergeCompositionEnumTestFieldAAnyOf1(v Compo
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *CompositionEnumTestFieldA) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsCompositionEnumTestFieldAAnyOf1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t CompositionEnumTestFieldA) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesCompositionEnumTestFieldAAnyOf1 reports whether the union data matches CompositionEnumTestFieldAAnyOf1.
+func (t CompositionEnumTestFieldA) MatchesCompositionEnumTestFieldAAnyOf1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t CompositionEnumTestFieldA) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t CompositionEnumTestFieldA) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -645,6 +850,7 @@ func (t CompositionEnumTestFieldA) MarshalJSON() ([]byte, error) {
 
 func (t *CompositionEnumTestFieldA) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -660,6 +866,21 @@ const (
 	CompositionEnumTestFieldAAnyOf1Bar CompositionEnumTestFieldAAnyOf1 = "bar"
 )
 
+// Values returns the CompositionEnumTestFieldAAnyOf1 constants, in the order of the spec.
+func (CompositionEnumTestFieldAAnyOf1) Values() []CompositionEnumTestFieldAAnyOf1 {
+	return []CompositionEnumTestFieldAAnyOf1{CompositionEnumTestFieldAAnyOf1Foo, CompositionEnumTestFieldAAnyOf1Bar}
+}
+
+// ParseCompositionEnumTestFieldAAnyOf1 returns the CompositionEnumTestFieldAAnyOf1 constant whose value is s. Other strings
+// return an error.
+func ParseCompositionEnumTestFieldAAnyOf1(s string) (CompositionEnumTestFieldAAnyOf1, error) {
+	switch v := CompositionEnumTestFieldAAnyOf1(s); v {
+	case CompositionEnumTestFieldAAnyOf1Foo, CompositionEnumTestFieldAAnyOf1Bar:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid CompositionEnumTestFieldAAnyOf1 value %q", s)
+}
+
 // #/components/schemas/CompositionEnumTest/properties/fieldB
 type CompositionEnumTestFieldB struct {
 }
@@ -746,6 +967,21 @@ const (
 	CompositionEnumTestFieldCOneOf1Bar CompositionEnumTestFieldCOneOf1 = "bar"
 )
 
+// Values returns the CompositionEnumTestFieldCOneOf1 constants, in the order of the spec.
+func (CompositionEnumTestFieldCOneOf1) Values() []CompositionEnumTestFieldCOneOf1 {
+	return []CompositionEnumTestFieldCOneOf1{CompositionEnumTestFieldCOneOf1Foo, CompositionEnumTestFieldCOneOf1Bar}
+}
+
+// ParseCompositionEnumTestFieldCOneOf1 returns the CompositionEnumTestFieldCOneOf1 constant whose value is s. Other strings
+// return an error.
+func ParseCompositionEnumTestFieldCOneOf1(s string) (CompositionEnumTestFieldCOneOf1, error) {
+	switch v := CompositionEnumTestFieldCOneOf1(s); v {
+	case CompositionEnumTestFieldCOneOf1Foo, CompositionEnumTestFieldCOneOf1Bar:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid CompositionEnumTestFieldCOneOf1 value %q", s)
+}
+
 // #/paths//ensure-everything-is-referenced/get/responses/200/content/application/json/schema
 type EnsureEverythingIsReferencedJSONResponse struct {
 	ArrayOfAnyOf            *ArrayOfAnyOf            `form:"arrayOfAnyOf,omitempty" json:"arrayOfAnyOf,omitempty"`
@@ -777,23 +1013,23 @@ func (s *EnsureEverythingIsReferencedJSONResponse) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9RXzY7bNhC+6ykGcgGnQCXvT5KDbs4ihxxaL9oFeqalkcVUGqok5cS3PkSfsE9SkJQl",
-	"2qYce7NdoHtakfzm55tfz+AXVBoLYJuNxA3TCCqvsGGgUWkF//z1N7C6XpULRrtVuRCEqxJy0aw5YQGc",
-	"YMskF52CL2yn0mgWzeC3HMkcqiyaAcBtCksp2Q6+cF2BFQNcY6PgjdKS0waEBLH+jLn+0QLuUljZTx/R",
-	"StGi1DsfxEnjBqVD3R+inKEjilPNCXs91mhGWjno29T5CLkgzThZ8Ra/f2efvUv7U6uAet4skFEBJce6",
-	"gFaiQrllmguCN60UTavdldP1PnUOLRyrnsCia2uemwggdc2oWrRIrOUZxPfpTXobR5xKkUUAmusas30A",
-	"l0MAn0zkIoAtSsUFZRDfpjdx1DJdKYNbIKlOYoJblDtdcdokXCUSS5RIORbmDcAGtfsHwHBoHfpUZODA",
-	"HwfsJ/XrgOzfS1StIIVqLwAgvru5icdPgAJVLnmrrYFtzXKsRF2g9J6YaCBpHwXAWscSF7T4rAQd3kKf",
-	"vcenAHrXYtaH/+SyTxPuW+ypNNm7KpcmbKF7gB8klhnEs0UumlYQklYLZ4haLD10HEA7k37nurJPHvuM",
-	"vV7RKizovM6Vyb+X0HkgKKTT5vvw8hk8HuBDGlyxXS/50eJCEu1jxU2yfaSuMYV1vfiHUyFxNL4zAvun",
-	"TvYMkiQZmijcZlPtM0kSC1gG8tOlu83c/sRiRuvZcTYnPca118BFoHam64YXUyXYN+0o5Otddq7z7/1d",
-	"na+YgLUhO7es7tA3kp3W9yQn49U5d+6zS0bSMIsCDgbL82IHrVzfI3Faesm5zniuL/7BqQgVwyRj5o9Y",
-	"g1eBXte8XHSkp1HnYv02m9ohhjINNkALGlVO+jvl65qpE0aDPiYvGn1hJ/dymqu1EDUyekltH54XmXfZ",
-	"1Nr2U2hn24drBk8VwtwNlXn/sumUBhIa1gi1UNoswN6QSC3yA1P4eDCLLqzY4+oIxnG/1UVTHOx3sD87",
-	"LrHwU8vI9z57SfbkCb/qZ9l8OnQDVoeMccCpKnADdR4cqCO/c4t4qJj+GZViG7zSeCnqbxMeWEMvdNGI",
-	"9z57QYPNL8q3P+oD435cUsKcehzOXyVoh84ftabJRnGk8ruaioVdOyHA/jgLL4EJ5BXTR8Z9i3Sfkyne",
-	"Rzf/9wxp/HoNQ2Nbupih0Ah4n135o3uYAg/TvwBCzNkpsfz+rTLIbZjXBEohAqdrJg/NOhifZ9Lklc16",
-	"uHBB/e/N+jcAAP//n8hEYgwTAAA=",
+	"H4sIAAAAAAAC/9RXy47iRhTd+yuOIBITKTb9mJmFd0xrFrNIaCUtZV3YF6iJueVUlZlhl4/IF+ZLIlcZ",
+	"29BlGro7LYUV9Tj3ce6rPMYvZCzlEKuVppWwBJOtaSNgyViDf/76G6Io5sup4N18OVVM8yUytVlIphyS",
+	"sRVaqsrgm9iZJBpHY/yWEdebJo3GAK4TzLQWO3yTdg0nBtLSxuCdsVryCkpDLb5SZn90gJsEc7fsI0qt",
+	"StJ21wdJtrQi7VG3hyhvaIeSXEimRo8zWrA1Hvo+8T4iU2yFZCfe4ff33LUPSbPrFHDDmwMKzrGUVOQo",
+	"NRnSW2GlYrwrtdqU1h95XR8T79DUs9oTmFdlIbM6AsTVplOtSmJRyhSj2+QquR5FkpcqjQArbUHpPoCz",
+	"NoAPdeQiYEvaSMUpRtfJ1SgqhV2bGjclNpWmmLakd3YteRVLE2takibOKK/vACuy/g9Qc+gc+pKn8ODP",
+	"LfaL+bVFRgAAaDKlYkNmLwAY3VxdjbolkJPJtCytM7AsREZrVeSke1fqaBDbPgoQpWdJKp5+NYoPT9Fk",
+	"7/EuYHclpU34Hx02aSL7Fnc/UWfvfDmrwxY6B37QtEwxGk8ztSkVE1sz9YaY6ayHHgXQ3qTfpV27K/dN",
+	"xl6uaB4WdFrnnKm7+hKdB4JCOl2+tzefweMBPqTBF9vlku8dLiTRXTayTrbPXG3qwrpc/N1jIaOou5dG",
+	"+6R1f4Ex4jhumyiu06H2GcdxBACzQH76dHeZ2+w4zP4YXlC3BOIG49tr4CBQO8N1I/OhEmyadhTy9SY9",
+	"1fn3/s5PV0zA2pCdW1FU1C0DjJzgpDs65c5tes5IamdRwMFgeZ7toJPbbcBbEHYxEN1T8QX+kByIMYYZ",
+	"AwAWG7oI9LbmZapiO4w6Fev36dAboi3TYAN0oDR60t8hXxfCPGI06GP8qtFXbnLPhrlaKFWQ4NfU9ul5",
+	"kfmQDj3bfgq92fbhGuNhTZj4oTJpbm4qY8HKYkEolLGQ3B8SSQQAn4Sh+4NZdGbFHldHMI77V100xAEA",
+	"AJr+rKSmvJ9atfzespEUAcADfbfPsvnx0A1YHTLGA4eqwA/USXCgdvxOIgC4Wwv7MxkjVnSh8VoVTxMe",
+	"eIae6WItvrdsBLU2vyrf/VEfGPfdIyXMaY/DyZsE7dD5o9Y02CiOVL6oqTjYpRMC7uMsBHMRXgt7ZNxT",
+	"pPc5GeK9c/N/z5Cl75cw1LWlsxkKjYCP6YUf3e0UuBv+Aggx56bE7OWvysDREK8xlkoFdhdCH5p1MD5P",
+	"pMkbm3V35gP1vzfr3wEAn8hEYgwTAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -200,30 +200,48 @@ func TestAllOfWithOneOf(t *testing.T) {
 		v0, _ := decoded.AllOfWithOneOfAllOf1.AsAllOfWithOneOfAllOf1OneOf0()
 		assert.Equal(t, false, *v0.OptionA)
 	})
+
+	t.Run("promoted accessors", func(t *testing.T) {
+		obj := AllOfWithOneOf{Base: ptr("test")}
+		_, err := obj.AsAllOfWithOneOfAllOf1OneOf1()
+		assert.Error(t, err)
+
+		require.NoError(t, obj.FromAllOfWithOneOfAllOf1OneOf1(AllOfWithOneOfAllOf1OneOf1{OptionB: ptr(7)}))
+		require.NoError(t, obj.MergeAllOfWithOneOfAllOf1OneOf0(AllOfWithOneOfAllOf1OneOf0{OptionA: ptr(true)}))
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"base":"test","optionA":true,"optionB":7}`, string(data))
+
+		var decoded AllOfWithOneOf
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		v1, err := decoded.AsAllOfWithOneOfAllOf1OneOf1()
+		require.NoError(t, err)
+		assert.Equal(t, 7, *v1.OptionB)
+	})
 }
 
 // ===== Scenario 5: oneOf with nested allOf, field preservation =====
 
 func TestPromptOneOf0HasPromptField(t *testing.T) {
 	v := PromptOneOf0{
-		Type: ptr("chat"), Name: "my-prompt", Version: 1,
+		Type: ptr(Chat), Name: "my-prompt", Version: 1,
 		Prompt: []ChatMessage{{Role: "user", Content: "hello"}},
 	}
-	assert.Equal(t, "chat", *v.Type)
+	assert.Equal(t, Chat, *v.Type)
 	require.Len(t, v.Prompt, 1)
 	assert.Equal(t, "user", v.Prompt[0].Role)
 }
 
 func TestPromptOneOf1HasPromptField(t *testing.T) {
-	v := PromptOneOf1{Type: ptr("text"), Name: "text-prompt", Version: 2, Prompt: "Write a poem"}
-	assert.Equal(t, "text", *v.Type)
+	v := PromptOneOf1{Type: ptr(Text), Name: "text-prompt", Version: 2, Prompt: "Write a poem"}
+	assert.Equal(t, Text, *v.Type)
 	assert.Equal(t, "Write a poem", v.Prompt)
 }
 
 func TestPromptUnionChatRoundTrip(t *testing.T) {
 	var u Prompt
 	require.NoError(t, u.FromPromptOneOf0(PromptOneOf0{
-		Type: ptr("chat"), Name: "chat-prompt", Version: 1,
+		Type: ptr(Chat), Name: "chat-prompt", Version: 1,
 		Prompt: []ChatMessage{{Role: "system", Content: "You are helpful"}, {Role: "user", Content: "Hi"}},
 	}))
 	data, err := u.MarshalJSON()
@@ -243,7 +261,7 @@ func TestPromptUnionChatRoundTrip(t *testing.T) {
 func TestPromptUnionTextRoundTrip(t *testing.T) {
 	var u Prompt
 	require.NoError(t, u.FromPromptOneOf1(PromptOneOf1{
-		Type: ptr("text"), Name: "text-prompt", Version: 3, Prompt: "Tell me a joke",
+		Type: ptr(Text), Name: "text-prompt", Version: 3, Prompt: "Tell me a joke",
 	}))
 	data, err := u.MarshalJSON()
 	require.NoError(t, err)
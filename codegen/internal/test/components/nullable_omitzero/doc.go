@@ -0,0 +1,5 @@
+// Package nullable_omitzero tests the nullable-omitzero generation option,
+// which omits unspecified Nullable fields while writing explicit nulls.
+package nullable_omitzero
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
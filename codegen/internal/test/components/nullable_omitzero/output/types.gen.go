@@ -0,0 +1,77 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Patch
+type Patch struct {
+	ID       string                               `form:"id" json:"id"`
+	Owner    oapiCodegenTypesPkg.Nullable[string] `form:"owner" json:"owner"`
+	Nickname oapiCodegenTypesPkg.Nullable[string] `form:"nickname,omitempty" json:"nickname,omitempty,omitzero"`
+	Age      Age                                  `form:"age,omitempty" json:"age,omitempty,omitzero"`
+	Note     *string                              `form:"note,omitempty" json:"note,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Patch) ApplyDefaults() {
+}
+
+// #/components/schemas/Age
+type Age = oapiCodegenTypesPkg.Nullable[int]
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5SQwWrrMBBF9/qKi9+DbILj0J12+YHSfchCsSf2tPKMKo1b2tJ/LyROTCkUuruMjs5c",
+	"RhNJSOxR3dXbuqkcy0m9A14oF1bx2NZN3TjA2CJ53E8xhmMk6Mj2TlldCjYUj49P1+qYVEiseAeUdqAx",
+	"nCPwEKwdLhGwt0Qeenyk1uZRpueJM3Uee+7W0FehfJjfUtZE2ZjKVQBwt+SrsFhm6W/js+Mntb9ga1Qy",
+	"xVgdboBw+yRhpD98Cf03+n+mk8fq32Y5xGa+wmbX02pZpUa/9t8t4rkCi1FPeenwNQAjKJxfugEAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+func TestNullableOmitZero(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch Patch
+		want  string
+	}{
+		{
+			name:  "unspecified fields are omitted",
+			patch: Patch{ID: "1", Owner: types.NewNullableWithValue("ada")},
+			want:  `{"id":"1","owner":"ada"}`,
+		},
+		{
+			name: "explicit nulls are written",
+			patch: Patch{
+				ID:       "1",
+				Owner:    types.NewNullNullable[string](),
+				Nickname: types.NewNullNullable[string](),
+				Age:      types.NewNullNullable[int](),
+			},
+			want: `{"id":"1","owner":null,"nickname":null,"age":null}`,
+		},
+		{
+			name: "values are written",
+			patch: Patch{
+				ID:       "1",
+				Owner:    types.NewNullableWithValue("ada"),
+				Nickname: types.NewNullableWithValue("al"),
+				Age:      types.NewNullableWithValue(36),
+			},
+			want: `{"id":"1","owner":"ada","nickname":"al","age":36}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+
+			var decoded Patch
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, tt.patch, decoded)
+		})
+	}
+}
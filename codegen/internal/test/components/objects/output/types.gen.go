@@ -10,16 +10,18 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // #/components/schemas/ProblemDetails
 type ProblemDetails struct {
-	Type                 *string        `form:"type,omitempty" json:"type,omitempty"`
-	Title                *string        `form:"title,omitempty" json:"title,omitempty"`
-	Status               *int32         `form:"status,omitempty" json:"status,omitempty"`
-	Detail               *string        `form:"detail,omitempty" json:"detail,omitempty"`
-	Instance             *string        `form:"instance,omitempty" json:"instance,omitempty"`
-	AdditionalProperties map[string]any `json:"-"`
+	Type                 *oapiCodegenTypesPkg.URI `form:"type,omitempty" json:"type,omitempty"`
+	Title                *string                  `form:"title,omitempty" json:"title,omitempty"`
+	Status               *int32                   `form:"status,omitempty" json:"status,omitempty"`
+	Detail               *string                  `form:"detail,omitempty" json:"detail,omitempty"`
+	Instance             *oapiCodegenTypesPkg.URI `form:"instance,omitempty" json:"instance,omitempty"`
+	AdditionalProperties map[string]any           `json:"-"`
 }
 
 // Get returns the specified additional property value and whether it was found.
@@ -46,7 +48,7 @@ func (a *ProblemDetails) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["type"]; found {
-		var val string
+		var val oapiCodegenTypesPkg.URI
 		if err := json.Unmarshal(raw, &val); err != nil {
 			return fmt.Errorf("error reading 'type': %w", err)
 		}
@@ -82,7 +84,7 @@ func (a *ProblemDetails) UnmarshalJSON(b []byte) error {
 	}
 
 	if raw, found := object["instance"]; found {
-		var val string
+		var val oapiCodegenTypesPkg.URI
 		if err := json.Unmarshal(raw, &val); err != nil {
 			return fmt.Errorf("error reading 'instance': %w", err)
 		}
@@ -155,7 +157,7 @@ func (a ProblemDetails) MarshalJSON() ([]byte, error) {
 // ApplyDefaults sets default values for fields that are nil.
 func (s *ProblemDetails) ApplyDefaults() {
 	if s.Type == nil {
-		v := "about:blank"
+		v := oapiCodegenTypesPkg.MustParseURI("about:blank")
 		s.Type = &v
 	}
 }
@@ -3,17 +3,19 @@ package output
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // TestProblemDetailsInstantiation verifies that ProblemDetails with additionalProperties: true
 // generates correctly with both known fields and AdditionalProperties map.
 // https://github.com/oapi-codegen/oapi-codegen/issues/1168
 func TestProblemDetailsInstantiation(t *testing.T) {
-	typVal := "https://example.com/error"
+	typVal := types.MustParseURI("https://example.com/error")
 	title := "Not Found"
 	status := int32(404)
 	detail := "The requested resource was not found"
-	instance := "/api/resource/123"
+	instance := types.MustParseURI("/api/resource/123")
 
 	pd := ProblemDetails{
 		Type:     &typVal,
@@ -23,8 +25,8 @@ func TestProblemDetailsInstantiation(t *testing.T) {
 		Instance: &instance,
 	}
 
-	if *pd.Type != "https://example.com/error" {
-		t.Errorf("Type = %q, want %q", *pd.Type, "https://example.com/error")
+	if pd.Type.String() != "https://example.com/error" {
+		t.Errorf("Type = %q, want %q", pd.Type, "https://example.com/error")
 	}
 	if *pd.Title != "Not Found" {
 		t.Errorf("Title = %q, want %q", *pd.Title, "Not Found")
@@ -35,8 +37,8 @@ func TestProblemDetailsInstantiation(t *testing.T) {
 	if *pd.Detail != "The requested resource was not found" {
 		t.Errorf("Detail = %q, want %q", *pd.Detail, "The requested resource was not found")
 	}
-	if *pd.Instance != "/api/resource/123" {
-		t.Errorf("Instance = %q, want %q", *pd.Instance, "/api/resource/123")
+	if pd.Instance.String() != "/api/resource/123" {
+		t.Errorf("Instance = %q, want %q", pd.Instance, "/api/resource/123")
 	}
 }
 
@@ -59,11 +61,11 @@ func TestProblemDetailsAdditionalProperties(t *testing.T) {
 }
 
 func TestProblemDetailsJSONRoundTrip(t *testing.T) {
-	typVal := "https://example.com/validation"
+	typVal := types.MustParseURI("https://example.com/validation")
 	title := "Validation Error"
 	status := int32(422)
 	detail := "Field 'name' is required"
-	instance := "/api/users"
+	instance := types.MustParseURI("/api/users")
 
 	original := ProblemDetails{
 		Type:     &typVal,
@@ -86,8 +88,8 @@ func TestProblemDetailsJSONRoundTrip(t *testing.T) {
 		t.Fatalf("Unmarshal failed: %v", err)
 	}
 
-	if *decoded.Type != *original.Type {
-		t.Errorf("Type mismatch: got %q, want %q", *decoded.Type, *original.Type)
+	if decoded.Type.String() != original.Type.String() {
+		t.Errorf("Type mismatch: got %q, want %q", decoded.Type, original.Type)
 	}
 	if *decoded.Title != *original.Title {
 		t.Errorf("Title mismatch: got %q, want %q", *decoded.Title, *original.Title)
@@ -98,8 +100,8 @@ func TestProblemDetailsJSONRoundTrip(t *testing.T) {
 	if *decoded.Detail != *original.Detail {
 		t.Errorf("Detail mismatch: got %q, want %q", *decoded.Detail, *original.Detail)
 	}
-	if *decoded.Instance != *original.Instance {
-		t.Errorf("Instance mismatch: got %q, want %q", *decoded.Instance, *original.Instance)
+	if decoded.Instance.String() != original.Instance.String() {
+		t.Errorf("Instance mismatch: got %q, want %q", decoded.Instance, original.Instance)
 	}
 
 	errors, ok := decoded.AdditionalProperties["errors"]
@@ -123,21 +125,21 @@ func TestProblemDetailsApplyDefaults(t *testing.T) {
 	if pd.Type == nil {
 		t.Fatal("Type should not be nil after ApplyDefaults")
 	}
-	if *pd.Type != "about:blank" {
-		t.Errorf("Type = %q, want %q", *pd.Type, "about:blank")
+	if pd.Type.String() != "about:blank" {
+		t.Errorf("Type = %q, want %q", pd.Type, "about:blank")
 	}
 }
 
 func TestProblemDetailsApplyDefaultsDoesNotOverwrite(t *testing.T) {
-	typVal := "https://example.com/custom"
+	typVal := types.MustParseURI("https://example.com/custom")
 	pd := &ProblemDetails{
 		Type: &typVal,
 	}
 	pd.ApplyDefaults()
 
 	// ApplyDefaults should not overwrite an already-set value
-	if *pd.Type != "https://example.com/custom" {
-		t.Errorf("Type = %q, want %q (should not be overwritten)", *pd.Type, "https://example.com/custom")
+	if pd.Type.String() != "https://example.com/custom" {
+		t.Errorf("Type = %q, want %q (should not be overwritten)", pd.Type, "https://example.com/custom")
 	}
 }
 
@@ -117,6 +117,58 @@ func (t ConfigSaveReq) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// ConfigSaveReqVariant is implemented by each member of ConfigSaveReq, so that the
+// member its config_type property selects can be handled as its own type.
+type ConfigSaveReqVariant interface {
+	isConfigSaveReqVariant()
+}
+
+func (ConfigHTTP) isConfigSaveReqVariant() {}
+
+func (ConfigSSH) isConfigSaveReqVariant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t ConfigSaveReq) Variant() (ConfigSaveReqVariant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "another_server":
+		return t.AsConfigHTTP()
+	case "apache_server":
+		return t.AsConfigHTTP()
+	case "web_server":
+		return t.AsConfigHTTP()
+	case "ssh_server":
+		return t.AsConfigSSH()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the ConfigSaveReq as the provided
+// member, setting its discriminator value.
+func (t *ConfigSaveReq) FromVariant(v ConfigSaveReqVariant) error {
+	switch v := v.(type) {
+	case ConfigHTTP:
+		return t.FromConfigHTTP(v)
+	case *ConfigHTTP:
+		if v != nil {
+			return t.FromConfigHTTP(*v)
+		}
+	case ConfigSSH:
+		return t.FromConfigSSH(v)
+	case *ConfigSSH:
+		if v != nil {
+			return t.FromConfigSSH(*v)
+		}
+	}
+	return fmt.Errorf("unsupported ConfigSaveReqVariant %T", v)
+}
+
 func (t ConfigSaveReq) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -146,14 +198,14 @@ func (s *ConfigSSH) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9xTPXPUMBDt/St2DC1nBzqXpIECmCHpbxR5fVawtcru2hn/e0aW706ZCXM56Ojsp/ee",
-	"3n6IAnoTXAPlp129uykL5ztqCoAZWRz5Bm529a4uANTpgA3co2gBMDiLXjAyAbwZsYFvX++LYLSXCFaW",
-	"fOcO6TyQaPoCkGkcDS8N3JkZIbEmNurIbwzGpwlFP1O7HEUQiYpezwCACWFwdlVWj0I+PwMQ2+NoXmIA",
-	"7xm7Bsp3laUxkEevUiWmVLdrlhjrJz6VpzASyAvK2an8WNdlbtyiWHZB13bd5hWBmBlbkMlaFOmmYViK",
-	"883RY7s82SXtF9VwtNclYAP08IhWNygwBWR1eaTUxv3KzoIltSg7fzjBfTaMP5IC8Ssk5xUPyCd8EuTL",
-	"Vkbkmbi9QIxTd4wZ7UNeVobGArLfGDXr3ja/ow15/NHlnpc3IPa/vEpxJ/1R0Lq4C6PzRinrzTa05fv6",
-	"Ul6razQhOH/IuyTS7wV5Rn7z9eldGNvjm5QvSwV4xoe/0hlP2iNfqT2l/5+2nd1sFPe/cPm3hf8dAAD/",
-	"/3I5IYWZBQAA",
+	"H4sIAAAAAAAC/9xTPXPUMBDt/St2DC1nBzqXpIECmCHpM4q8PivYWmV37Yz/PSPLd1ZmwlwCHZ399N7T",
+	"2w9RQG+Ca6D8dKgPV2XhfEdNATAjiyPfwNWhPtQFgDodsIFbFC0ABmfRC0YmgDcjNvDt620RjPYSwcqS",
+	"79wxnQcSTV8AMo2j4aWBGzMjJNbERh35jcH4OKHoZ2qXkwgiUdHrDgCYEAZnV2X1IOTzMwCxPY7mOQbw",
+	"nrFroHxXWRoDefQqVWJKdb1mibF+4mN5DiOBvKDsTuXHui73X4AWxbILurbrOq8IxMzYgkzWokg3DcNS",
+	"7DdHj+3yZJe0X1TDyV6XgA3Q/QNa3aDAFJDV5ZFSG+9WdrEHS2pRdv54hvtsGH8kBeIXSM4rHpHP+CTI",
+	"l62MyBNxe4EYp+4YM9qHvKwMjQVkvzFq1r1tficb8vijyz0vb0Dsf/kmxY30J0Hr4i6MzhulrDfb0Jbv",
+	"60t5qa7RhOD8cZcAiPR3gjwjv/r69C6M7fFVyuelAjzh/V/pjCftkd+oPaf/n7ad3WwU737h8m8L/3sA",
+	"cjkhhZkFAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -82,6 +82,55 @@ func TestFromConfigHTTPSetsDiscriminator(t *testing.T) {
 	assert.Equal(t, "another_server", disc)
 }
 
+// TestVariant checks that Variant returns the member the discriminator
+// selects for a type switch, and that UnmarshalJSON keeps unknown ones.
+func TestVariant(t *testing.T) {
+	var saveReq ConfigSaveReq
+	err := json.Unmarshal([]byte(`{"config_type":"ssh_server","host":"example.com"}`), &saveReq)
+	require.NoError(t, err)
+
+	v, err := saveReq.Variant()
+	require.NoError(t, err)
+	switch v := v.(type) {
+	case ConfigSSH:
+		assert.Equal(t, ptr("example.com"), v.Host)
+	default:
+		t.Fatalf("unexpected variant %T", v)
+	}
+
+	// A member added to the spec since is kept, for Variant to report.
+	err = json.Unmarshal([]byte(`{"config_type":"ftp_server","host":"example.com"}`), &saveReq)
+	require.NoError(t, err)
+	disc, err := saveReq.Discriminator()
+	require.NoError(t, err)
+	assert.Equal(t, "ftp_server", disc)
+	_, err = saveReq.Variant()
+	assert.EqualError(t, err, "unknown discriminator value: ftp_server")
+}
+
+// TestFromVariant checks that FromVariant sets the discriminator of the member
+// it is given, which MarshalJSON writes back.
+func TestFromVariant(t *testing.T) {
+	var saveReq ConfigSaveReq
+	require.NoError(t, saveReq.FromVariant(&ConfigHTTP{Host: "example.com", Port: 80}))
+
+	b, err := json.Marshal(saveReq)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"config_type":"another_server","host":"example.com","port":80}`, string(b))
+
+	var roundTrip ConfigSaveReq
+	require.NoError(t, json.Unmarshal(b, &roundTrip))
+	v, err := roundTrip.Variant()
+	require.NoError(t, err)
+	assert.Equal(t, ConfigHTTP{ConfigType: "another_server", Host: "example.com", Port: 80}, v)
+
+	assert.Error(t, saveReq.FromVariant(nil))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestApplyDefaults(t *testing.T) {
 	h := &ConfigHTTP{}
 	h.ApplyDefaults()
@@ -150,6 +150,21 @@ const (
 	Undefined RegistrationStateOneOf0 = "undefined"
 )
 
+// Values returns the RegistrationStateOneOf0 constants, in the order of the spec.
+func (RegistrationStateOneOf0) Values() []RegistrationStateOneOf0 {
+	return []RegistrationStateOneOf0{Undefined}
+}
+
+// ParseRegistrationStateOneOf0 returns the RegistrationStateOneOf0 constant whose value is s. Other strings
+// return an error.
+func ParseRegistrationStateOneOf0(s string) (RegistrationStateOneOf0, error) {
+	switch v := RegistrationStateOneOf0(s); v {
+	case Undefined:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RegistrationStateOneOf0 value %q", s)
+}
+
 // #/components/schemas/Registration/properties/state/oneOf/1
 type RegistrationStateOneOf1 string
 
@@ -157,6 +172,21 @@ const (
 	Registered RegistrationStateOneOf1 = "registered"
 )
 
+// Values returns the RegistrationStateOneOf1 constants, in the order of the spec.
+func (RegistrationStateOneOf1) Values() []RegistrationStateOneOf1 {
+	return []RegistrationStateOneOf1{Registered}
+}
+
+// ParseRegistrationStateOneOf1 returns the RegistrationStateOneOf1 constant whose value is s. Other strings
+// return an error.
+func ParseRegistrationStateOneOf1(s string) (RegistrationStateOneOf1, error) {
+	switch v := RegistrationStateOneOf1(s); v {
+	case Registered:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RegistrationStateOneOf1 value %q", s)
+}
+
 // #/components/schemas/Registration/properties/state/oneOf/2
 type RegistrationStateOneOf2 string
 
@@ -164,6 +194,21 @@ const (
 	Pending RegistrationStateOneOf2 = "pending"
 )
 
+// Values returns the RegistrationStateOneOf2 constants, in the order of the spec.
+func (RegistrationStateOneOf2) Values() []RegistrationStateOneOf2 {
+	return []RegistrationStateOneOf2{Pending}
+}
+
+// ParseRegistrationStateOneOf2 returns the RegistrationStateOneOf2 constant whose value is s. Other strings
+// return an error.
+func ParseRegistrationStateOneOf2(s string) (RegistrationStateOneOf2, error) {
+	switch v := RegistrationStateOneOf2(s); v {
+	case Pending:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RegistrationStateOneOf2 value %q", s)
+}
+
 // #/components/schemas/Registration/properties/state/oneOf/3
 type RegistrationStateOneOf3 string
 
@@ -171,6 +216,21 @@ const (
 	Active RegistrationStateOneOf3 = "active"
 )
 
+// Values returns the RegistrationStateOneOf3 constants, in the order of the spec.
+func (RegistrationStateOneOf3) Values() []RegistrationStateOneOf3 {
+	return []RegistrationStateOneOf3{Active}
+}
+
+// ParseRegistrationStateOneOf3 returns the RegistrationStateOneOf3 constant whose value is s. Other strings
+// return an error.
+func ParseRegistrationStateOneOf3(s string) (RegistrationStateOneOf3, error) {
+	switch v := RegistrationStateOneOf3(s); v {
+	case Active:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid RegistrationStateOneOf3 value %q", s)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
 	"H4sIAAAAAAAC/6yQzY7UMBCE736KknJlMrPsibwBJySExNmb1CQNjm2527MaId4dOeFnZw4Iob25q7vr",
@@ -178,7 +238,7 @@ var openAPISpecJSON = []string{
 	"vC2kofTYWK5zHT4vjKja7Hfys9iCtQaTHIjWCDxcfKi8OeqN62ALfx80oRGgS6qhvdcsgfBxwnMqXzGm",
 	"UjhauPYuZUafZcBjf+ofncRzGhxgYoHDiyjwiWoOuLCopDjgoT/1J5e9LTrg23fXICkymrZ9HReufnsC",
 	"HzmLWvHWFjcFsGvmgPT0haP9lPYQTai/hgA1b/xTYo/lpQActgxutV2vceJZIqe73g7fA/xHq7L9geU1",
-	"vDLjdD/9X0Z+NLnwbz4/AgAA//+mSD78zgIAAA==",
+	"vDLjdD/9X0Z+NLnwbz4/BgCmSD78zgIAAA==",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
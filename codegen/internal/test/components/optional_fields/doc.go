@@ -0,0 +1,5 @@
+// Package optional_fields tests the optional-fields generation option, which
+// wraps optional fields in Optional[T] instead of pointers.
+package optional_fields
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,326 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/PetPatch
+type PetPatch struct {
+	ID       string                               `form:"id" json:"id"`
+	Name     oapiCodegenTypesPkg.Optional[string] `form:"name,omitempty" json:"name,omitempty,omitzero"`
+	Age      oapiCodegenTypesPkg.Optional[int]    `form:"age,omitempty" json:"age,omitempty,omitzero"`
+	Nickname oapiCodegenTypesPkg.Nullable[string] `form:"nickname,omitempty" json:"nickname,omitempty"`
+	Tags     []string                             `form:"tags,omitempty" json:"tags,omitempty"`
+	Owner    oapiCodegenTypesPkg.Optional[Owner]  `form:"owner,omitempty" json:"owner,omitempty,omitzero"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PetPatch) ApplyDefaults() {
+	if !s.Age.IsSet() {
+		s.Age.Set(1)
+	}
+	if v, ok := s.Owner.Get(); ok {
+		v.ApplyDefaults()
+		s.Owner.Set(v)
+	}
+}
+
+// #/components/schemas/Owner
+type Owner struct {
+	Email   oapiCodegenTypesPkg.Optional[oapiCodegenTypesPkg.Email] `form:"email,omitempty" json:"email,omitempty,omitzero"`
+	Country oapiCodegenTypesPkg.Optional[string]                    `form:"country,omitempty" json:"country,omitempty,omitzero"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Owner) ApplyDefaults() {
+	if !s.Country.IsSet() {
+		s.Country.Set("NL")
+	}
+}
+
+// #/components/schemas/Labels
+type Labels struct {
+	Color                oapiCodegenTypesPkg.Optional[string] `form:"color,omitempty" json:"color,omitempty,omitzero"`
+	AdditionalProperties map[string]string                    `json:"-"`
+}
+
+// Get returns the specified additional property value and whether it was found.
+func (a Labels) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Set sets an additional property value.
+func (a *Labels) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+func (a *Labels) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["color"]; found {
+		if err := json.Unmarshal(raw, &a.Color); err != nil {
+			return fmt.Errorf("error reading 'color': %w", err)
+		}
+		delete(object, "color")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+func (a Labels) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Color.IsSet() {
+		object["color"], err = json.Marshal(a.Color)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'color': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Labels) ApplyDefaults() {
+}
+
+// #/components/schemas/NamedPet
+type NamedPet struct {
+	ID       string                               `form:"id" json:"id"`
+	Name     string                               `form:"name" json:"name"`
+	Age      oapiCodegenTypesPkg.Optional[int]    `form:"age,omitempty" json:"age,omitempty,omitzero"`
+	Nickname oapiCodegenTypesPkg.Nullable[string] `form:"nickname,omitempty" json:"nickname,omitempty"`
+	Tags     []string                             `form:"tags,omitempty" json:"tags,omitempty"`
+	Owner    oapiCodegenTypesPkg.Optional[Owner]  `form:"owner,omitempty" json:"owner,omitempty,omitzero"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *NamedPet) ApplyDefaults() {
+	if !s.Age.IsSet() {
+		s.Age.Set(1)
+	}
+	if v, ok := s.Owner.Get(); ok {
+		v.ApplyDefaults()
+		s.Owner.Set(v)
+	}
+}
+
+// #/components/schemas/Cat
+type Cat struct {
+	Meows oapiCodegenTypesPkg.Optional[bool] `form:"meows,omitempty" json:"meows,omitempty,omitzero"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Cat) ApplyDefaults() {
+}
+
+// #/components/schemas/Dog
+type Dog struct {
+	Barks oapiCodegenTypesPkg.Optional[bool] `form:"barks,omitempty" json:"barks,omitempty,omitzero"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Dog) ApplyDefaults() {
+}
+
+// #/components/schemas/Listing
+
+type Listing struct {
+	ID    int                                  `form:"id" json:"id"`
+	Note  oapiCodegenTypesPkg.Optional[string] `form:"note,omitempty" json:"note,omitempty,omitzero"`
+	union json.RawMessage
+}
+
+// AsCat returns the union data inside the Listing as a Cat.
+func (t Listing) AsCat() (Cat, error) {
+	var body Cat
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCat overwrites any union data inside the Listing as the provided Cat.
+func (t *Listing) FromCat(v Cat) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCat performs a merge with any union data inside the Listing, using the provided Cat.
+func (t *Listing) MergeCat(v Cat) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDog returns the union data inside the Listing as a Dog.
+func (t Listing) AsDog() (Dog, error) {
+	var body Dog
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDog overwrites any union data inside the Listing as the provided Dog.
+func (t *Listing) FromDog(v Dog) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDog performs a merge with any union data inside the Listing, using the provided Dog.
+func (t *Listing) MergeDog(v Dog) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Listing) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+	object["id"], err = json.Marshal(t.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+	if t.Note.IsSet() {
+		object["note"], err = json.Marshal(t.Note)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'note': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *Listing) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &t.ID)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+	}
+	if raw, found := object["note"]; found {
+		err = json.Unmarshal(raw, &t.Note)
+		if err != nil {
+			return fmt.Errorf("error reading 'note': %w", err)
+		}
+	}
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *Listing) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7STT2/bMAzF7/oUD9mAXLb8wW66tsegyb3ogbFph6ssehKzIhj23YcuceKt3pwcepMe",
+	"fiQl8lFbjtSKx+TLbDlbTJzESr0DvnPKotFjOVvMFg4wscAe69ZEIwVUwqHMriXbZY8fP12hTauRo2Xv",
+	"gFzsuKHfR2DDtiErdscbYIeWPXT7lQs7SYm/7SVx6fEo5dNJbJO2nEw4d5GAlJdzlylbklif5UgNj0JU",
+	"DzASjWtOPb3kivbBPJaX9FI8D5d4PNb4hEnchzB5OgNGdX6LU0p06Kli3PyB/ePp+hI59bmPiSuP6Yf5",
+	"ZQbz0wDm61d46gBg3Y8bmMFQu7khCaPNBCpNDZk/8me90H20dLgi/tznh5UDgBVtOeQbH1to0DRSjMpS",
+	"jhbeDGR4E/BADZcbtg6hENZVdwE+/7f3ne+nPb5n9FcTHT1yR3bjVxvWlwFLbVUDU3QAcK/1jUm3lJ5H",
+	"kq4km8T6vTb57wWMamOrrJGvn8gd2fRK9F7rqfs1AGWZz9QgBQAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+func TestOptionalFieldsJSON(t *testing.T) {
+	patch := PetPatch{ID: "1", Age: types.NewOptional(0)}
+	data, err := json.Marshal(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1","age":0}`, string(data), "absent fields are omitted, set zero values aren't")
+
+	var decoded PetPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","name":"Rex","owner":{"email":"ada@example.com"}}`), &decoded))
+	assert.Equal(t, "Rex", decoded.Name.MustGet())
+	assert.False(t, decoded.Age.IsSet())
+	assert.Equal(t, types.Email("ada@example.com"), decoded.Owner.MustGet().Email.MustGet())
+}
+
+func TestOptionalFieldsApplyDefaults(t *testing.T) {
+	patch := PetPatch{ID: "1", Owner: types.NewOptional(Owner{})}
+	patch.ApplyDefaults()
+	assert.Equal(t, 1, patch.Age.MustGet())
+	assert.Equal(t, "NL", patch.Owner.MustGet().Country.MustGet())
+	assert.False(t, patch.Name.IsSet())
+
+	patch = PetPatch{Age: types.NewOptional(5)}
+	patch.ApplyDefaults()
+	assert.Equal(t, 5, patch.Age.MustGet())
+}
+
+func TestOptionalFieldsAdditionalProperties(t *testing.T) {
+	labels := Labels{AdditionalProperties: map[string]string{"size": "L"}}
+	data, err := json.Marshal(labels)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"size":"L"}`, string(data))
+
+	labels.Color.Set("red")
+	data, err = json.Marshal(labels)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"color":"red","size":"L"}`, string(data))
+
+	var decoded Labels
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, labels, decoded)
+}
+
+func TestOptionalFieldsRequiredByAllOf(t *testing.T) {
+	// NamedPet requires name, so it's a plain value.
+	pet := NamedPet{ID: "1", Name: "Rex"}
+	data, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1","name":"Rex"}`, string(data))
+}
+
+func TestOptionalFieldsUnionFixedFields(t *testing.T) {
+	var listing Listing
+	require.NoError(t, listing.FromCat(Cat{Meows: types.NewOptional(true)}))
+	listing.ID = 1
+	data, err := json.Marshal(listing)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"meows":true}`, string(data), "an unset optional fixed field is omitted")
+
+	listing.Note.Set("indoor")
+	data, err = json.Marshal(listing)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":1,"note":"indoor","meows":true}`, string(data))
+
+	var decoded Listing
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "indoor", decoded.Note.MustGet())
+	cat, err := decoded.AsCat()
+	require.NoError(t, err)
+	assert.True(t, cat.Meows.MustGet())
+}
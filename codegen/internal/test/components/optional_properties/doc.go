@@ -0,0 +1,5 @@
+// Package optional_properties tests the optional-properties output option,
+// and the extension overriding it for a schema or property.
+package optional_properties
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,92 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name     string  `form:"name" json:"name"`
+	Age      int     `form:"age,omitempty" json:"age,omitzero"`
+	Owner    Owner   `form:"owner,omitempty" json:"owner,omitzero"`
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Owner
+type Owner struct {
+	Email oapiCodegenTypesPkg.Nullable[string] `form:"email,omitempty" json:"email,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Owner) ApplyDefaults() {
+}
+
+// #/components/schemas/Tagged
+type Tagged struct {
+	Tag string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Tagged) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6SQMU/DQAyF9/wKqyB1Sgtiuz8RBjbE4Cbu1XCxjzungBD/HaVNmwwlqsTmvDz7vvc0",
+	"kmBkB4uH1f3qblGwbNUVAMYWyEEVjVUwQEwaKRlTLgD2lDKrOFgcdiLaLjv4/ilqbaMKiWVXAOR6Ry0e",
+	"RoBHsuMAYF+RHOjmlWobpETvHSdqHDwLtvQyyOOrp12A/v/4dbqWLbH4s4z+gofFyFM66/ohlKa220Rb",
+	"B8ub9ZhjPYRYV715OVJw/XYVCcBnqRi5rLUhT1LqUGk5CQdRe7gjWjXFutDVNfekCwE3gWZ6pBY5zOI/",
+	"offU/JNkj6GbwzD0f0L8DgAfSS0JngIAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,41 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+func TestOptionalPropertiesOmitZero(t *testing.T) {
+	b, err := json.Marshal(Pet{Name: "rex"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"rex"}`, string(b))
+
+	// An empty owner isn't zero, and is written.
+	b, err = json.Marshal(Pet{Name: "rex", Owner: Owner{Email: types.NewNullNullable[string]()}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"rex","owner":{"email":null}}`, string(b))
+
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"rex","age":3}`), &pet))
+	assert.Equal(t, Pet{Name: "rex", Age: 3}, pet)
+}
+
+func TestOptionalPropertiesOverrides(t *testing.T) {
+	var owner Owner
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &owner))
+	assert.False(t, owner.Email.IsSpecified())
+
+	var pet Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"rex","nickname":"r"}`), &pet))
+	require.NotNil(t, pet.Nickname)
+	assert.Equal(t, "r", *pet.Nickname)
+
+	b, err := json.Marshal(Tagged{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(b))
+}
@@ -0,0 +1,4 @@
+// Package decimal tests the Decimal types for format: decimal and money.
+package decimal
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
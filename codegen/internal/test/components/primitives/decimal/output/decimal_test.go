@@ -0,0 +1,66 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalFields(t *testing.T) {
+	fee := types.DecimalNumber{Decimal: types.MustParseDecimal("0.000001")}
+	payment := Payment{
+		Amount: types.MustParseDecimal("12345678901234567.89"),
+		Rate:   types.DecimalNumber{Decimal: types.MustParseDecimal("0.10")},
+		Fee:    &fee,
+	}
+
+	buf, err := json.Marshal(payment)
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"12345678901234567.89","rate":0.10,"fee":0.000001}`, string(buf))
+
+	var decoded Payment
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+	assert.Equal(t, payment, decoded)
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	var gotQuery url.Values
+	var gotParams CreatePaymentParams
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		require.NoError(t, gotParams.FromURLValues(r.URL.Query()))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	limit := types.MustParseDecimal("1000.00")
+	minimum := types.MustParseDecimal("0.01")
+	params := &CreatePaymentParams{Limit: &limit, Range: &AmountRange{Min: &minimum}}
+	payment := Payment{Amount: types.MustParseDecimal("99.999999999999999999"), Rate: types.DecimalNumber{Decimal: types.MustParseDecimal("1e-20")}}
+	got, err := client.CreatePayment(context.Background(), params, payment)
+	require.NoError(t, err)
+	assert.Equal(t, payment, got)
+
+	assert.Equal(t, "1000.00", gotQuery.Get("limit"))
+	assert.Equal(t, "0.01", gotQuery.Get("range[min]"))
+	require.NotNil(t, gotParams.Limit)
+	assert.Equal(t, "1000.00", gotParams.Limit.String())
+	require.NotNil(t, gotParams.Range)
+	require.NotNil(t, gotParams.Range.Min)
+	assert.Equal(t, "0.01", gotParams.Range.Min.String())
+	assert.Nil(t, gotParams.Range.Max)
+}
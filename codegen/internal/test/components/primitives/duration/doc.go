@@ -0,0 +1,4 @@
+// Package duration tests the Duration type for format: duration strings.
+package duration
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,2618 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// #/components/schemas/Job
+type Job struct {
+	Name       string    `form:"name" json:"name"`
+	Timeout    Duration  `form:"timeout" json:"timeout"`
+	RetryAfter *Duration `form:"retryAfter,omitempty" json:"retryAfter,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Job) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5ySP2/bQAzFd32KB7WAl1aS2+22Fl3quVuR4SRR8QnW8cyjAujbB/pjSwlsOMjG4yOJ",
+	"35GPA3kbnEH6M9tnRZo437BJgBeS6Ngb7LMiKxJAnZ7I4E8vVh17NCyd1SRYPcaxIW+5nAIgcNQ5AjjQ",
+	"3PC3NqiErNKBy0UMVmxHShIv5cB3eNuRQU22PjlPVwFw3uDckwybXKyO1FmzyQA6BDKIKs4/vxFmZoN6",
+	"+cQiCp17ivqb62EdNCadUG2g0q8UFXslr2sdYEM4uWoamLeR/Va7DQh8FWoMdl/yirvAnrzGfK6M+YHL",
+	"3ZUsBvaRNvtJfxRFuj6BmmIlLuh0rX9HQsvlRr4B/Aj5HvQHsNf82LxIYwgcuDTJ9kBctlRp8n7d/8f7",
+	"f4O6jrjXp4tVZHSSuu0qJqMkD+6+zHlYd9cdgJDK8KtRks9MeR0A3m1MX2MDAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+type createJobJSONRequestBody = Job
+
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Duration-format/1.0.0"
+
+// RequestEditorFn is the function signature for the RequestEditor callback function.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests.
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the OpenAPI spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// ClientOption allows setting custom parameters during construction.
+type ClientOption func(*Client) error
+
+// NewClient creates a new Client with reasonable defaults.
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
+	// Ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// Create httpClient if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// ClientInterface is the interface specification for the client.
+type ClientInterface interface {
+	// CreateJobWithBody makes a POST request to /jobs
+	CreateJobWithBody(ctx context.Context, params *CreateJobParams, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreateJob(ctx context.Context, params *CreateJobParams, body createJobJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+}
+
+// CreateJobParams defines parameters for CreateJob.
+type CreateJobParams struct {
+	// deadline (optional)
+	Deadline *Duration `form:"deadline" json:"deadline"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *CreateJobParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Deadline != nil {
+		if frag, err := StyleParameter("deadline", *p.Deadline, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "duration", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *CreateJobParams) FromURLValues(values url.Values) error {
+	if err := BindQueryParameter("deadline", values, &p.Deadline, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "duration", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter deadline: %w", err)
+	}
+	return nil
+}
+
+// CreateJobWithBody makes a POST request to /jobs
+func (c *Client) CreateJobWithBody(ctx context.Context, params *CreateJobParams, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewCreateJobRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "createJob", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// CreateJob makes a POST request to /jobs with application/json body
+func (c *Client) CreateJob(ctx context.Context, params *CreateJobParams, body createJobJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewCreateJobRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "createJob", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
+
+// NewCreateJobRequest creates a POST request for /jobs with application/json body
+func NewCreateJobRequest(server string, params *CreateJobParams, body createJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateJobRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateJobRequestWithBody creates a POST request for /jobs with any body
+func NewCreateJobRequestWithBody(server string, params *CreateJobParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := reqURL.Query()
+		if params.Deadline != nil {
+			if queryFrag, err := StyleParameter("deadline", *params.Deadline, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "duration", AllowReserved: false}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		reqURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", reqURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// ClientHttpError represents an HTTP error response.
+// The type parameter E is the type of the parsed error body.
+type ClientHttpError[E any] struct {
+	StatusCode int
+	Body       E
+	RawBody    []byte
+}
+
+func (e *ClientHttpError[E]) Error() string {
+	return fmt.Sprintf("HTTP %d", e.StatusCode)
+}
+
+// SimpleClient wraps Client with typed responses for operations that have
+// unambiguous response types. Methods return the success type directly,
+// and HTTP errors are returned as *ClientHttpError[E] where E is the error type.
+type SimpleClient struct {
+	*Client
+}
+
+// NewSimpleClient creates a new SimpleClient which wraps a Client.
+func NewSimpleClient(server string, opts ...ClientOption) (*SimpleClient, error) {
+	inner, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &SimpleClient{Client: inner}, nil
+}
+
+// CreateJob makes a POST request to /jobs and returns the parsed response.
+
+// On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
+func (c *SimpleClient) CreateJob(ctx context.Context, params *CreateJobParams, body createJobJSONRequestBody, opts ...RequestOption) (Job, error) {
+	var result Job
+	resp, err := c.Client.CreateJob(ctx, params, body, opts...)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, err
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := DecodeJSON(rawBody, &result); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	// No typed error response defined
+	return result, &ClientHttpError[struct{}]{
+		StatusCode: resp.StatusCode,
+		RawBody:    rawBody,
+	}
+}
+
+// SimpleClientInterface is the interface specification for SimpleClient.
+type SimpleClientInterface interface {
+	// CreateJob makes a POST request to /jobs and returns the parsed response.
+	CreateJob(ctx context.Context, params *CreateJobParams, body createJobJSONRequestBody, opts ...RequestOption) (Job, error)
+}
+
+const DateFormat = "2006-01-02"
+
+type Date struct {
+	time.Time
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(DateFormat))
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var dateStr string
+	err := json.Unmarshal(data, &dateStr)
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(DateFormat, dateStr)
+	if err != nil {
+		return err
+	}
+	d.Time = parsed
+	return nil
+}
+
+func (d Date) String() string {
+	return d.Format(DateFormat)
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := time.Parse(DateFormat, string(data))
+	if err != nil {
+		return err
+	}
+	d.Time = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for Date.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.Format(DateFormat)), nil
+}
+
+// Format returns the date formatted according to layout.
+func (d Date) Format(layout string) string {
+	return d.Time.Format(layout)
+}
+
+// Nominal lengths of the ISO 8601 duration units which vary in length.
+const (
+	durationDay   = 24 * time.Hour
+	durationWeek  = 7 * durationDay
+	durationMonth = 30 * durationDay
+	durationYear  = 365 * durationDay
+)
+
+// ErrInvalidDuration is returned when a string isn't an ISO 8601 duration.
+var ErrInvalidDuration = errors.New("duration: invalid ISO 8601 duration")
+
+// Duration is an ISO 8601 duration, such as "P1DT2H30M", the format of
+// strings with format: duration. It converts to and from time.Duration; as a
+// time.Duration has no calendar, years, months and days are taken as 365, 30
+// and 1 days of 24 hours.
+type Duration time.Duration
+
+// ParseDuration parses an ISO 8601 duration, such as "PT1H30M" or "-P2W".
+// Only the seconds may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	rest := s
+	negative := false
+	if strings.HasPrefix(rest, "-") {
+		negative, rest = true, rest[1:]
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	if !strings.HasPrefix(rest, "P") || rest == "P" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+	rest = rest[1:]
+
+	var total time.Duration
+	inTime := false
+	// units holds the designators allowed next, in order.
+	units := "YMWD"
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		number, designator := rest[:end], rest[end]
+		rest = rest[end+1:]
+		i := strings.IndexByte(units, designator)
+		if i < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		units = units[i+1:]
+
+		whole, fraction, hasFraction := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+		if hasFraction && !(inTime && designator == 'S') {
+			return 0, fmt.Errorf("%w: %q: only seconds may have a fraction", ErrInvalidDuration, s)
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		unit := durationUnit(designator, inTime)
+		if err != nil || n > int64(math.MaxInt64/unit) {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		part := time.Duration(n) * unit
+		if hasFraction {
+			if fraction == "" || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+			part += time.Duration(nanos)
+		}
+		if total > math.MaxInt64-part {
+			return 0, fmt.Errorf("%w: %q overflows time.Duration", ErrInvalidDuration, s)
+		}
+		total += part
+	}
+
+	if negative {
+		total = -total
+	}
+	return Duration(total), nil
+}
+
+// durationUnit returns the length of an ISO 8601 designator, whose meaning
+// depends on whether it is in the time part of the duration.
+func durationUnit(designator byte, inTime bool) time.Duration {
+	if inTime {
+		switch designator {
+		case 'H':
+			return time.Hour
+		case 'M':
+			return time.Minute
+		default:
+			return time.Second
+		}
+	}
+	switch designator {
+	case 'Y':
+		return durationYear
+	case 'M':
+		return durationMonth
+	case 'W':
+		return durationWeek
+	default:
+		return durationDay
+	}
+}
+
+// TimeDuration returns d as a time.Duration.
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// String formats d as an ISO 8601 duration in hours, minutes and seconds,
+// such as "PT26H30M" or "PT0.5S", so that it parses back exactly.
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		seconds := strconv.FormatUint(u/uint64(time.Second), 10)
+		if nanos := u % uint64(time.Second); nanos > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Duration.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Duration.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// BindQueryParameter binds a query parameter from pre-parsed url.Values.
+// The Style field in opts selects parsing behavior. If Style is empty, "form"
+// is assumed. Supports form, spaceDelimited, pipeDelimited, and deepObject.
+func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts ParameterOptions) error {
+	style := opts.Style
+	if style == "" {
+		style = "form"
+	}
+
+	// Destination value management for optional (pointer) parameters.
+	dv := reflect.Indirect(reflect.ValueOf(dest))
+	v := dv
+	var output any
+	extraIndirect := !opts.Required && v.Kind() == reflect.Pointer
+	if !extraIndirect {
+		output = dest
+	} else {
+		if v.IsNil() {
+			t := v.Type()
+			newValue := reflect.New(t.Elem())
+			output = newValue.Interface()
+		} else {
+			output = v.Interface()
+		}
+		v = reflect.Indirect(reflect.ValueOf(output))
+	}
+
+	t := v.Type()
+	k := t.Kind()
+
+	switch style {
+	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, whatever their
+		// kind, as it's in their own format.
+		if pb, ok := output.(ParamBinder); ok {
+			values, found := queryParams[paramName]
+			if !found {
+				if opts.Required {
+					return &MissingRequiredParameterError{ParamName: paramName}
+				}
+				return nil
+			}
+			if len(values) != 1 {
+				return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+			}
+			if err := pb.BindParam(values[0]); err != nil {
+				return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, output, err)
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		if opts.Explode {
+			// Exploded: each value is a separate key=value pair.
+			// spaceDelimited and pipeDelimited with explode=true are
+			// serialized identically to form explode=true.
+			values, found := queryParams[paramName]
+			var err error
+
+			switch k {
+			case reflect.Slice:
+				if !found {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				if opts.Format == "byte" && isByteSlice(t) {
+					if len(values) != 1 {
+						return fmt.Errorf("expected single base64 value for byte slice parameter '%s', got %d values", paramName, len(values))
+					}
+					decoded, decErr := base64Decode(values[0])
+					if decErr != nil {
+						return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+					}
+					v.SetBytes(decoded)
+				} else {
+					err = bindSplitPartsToDestinationArray(values, output)
+				}
+			case reflect.Struct:
+				var fieldsPresent bool
+				fieldsPresent, err = bindParamsToExplodedObject(paramName, queryParams, output)
+				if !fieldsPresent {
+					return nil
+				}
+			default:
+				if len(values) == 0 {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				if len(values) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				if !found {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				err = BindStringToObject(values[0], output)
+			}
+			if err != nil {
+				return err
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		// Non-exploded: single value, delimiter-separated.
+		values, found := queryParams[paramName]
+		if !found {
+			if opts.Required {
+				return &MissingRequiredParameterError{ParamName: paramName}
+			}
+			return nil
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+		}
+
+		// Primitive types: use the raw value as-is without splitting.
+		if k != reflect.Slice && k != reflect.Struct && k != reflect.Map {
+			err := BindStringToObject(values[0], output)
+			if err != nil {
+				return err
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		var parts []string
+		switch style {
+		case "spaceDelimited":
+			parts = strings.Split(values[0], " ")
+		case "pipeDelimited":
+			parts = strings.Split(values[0], "|")
+		default:
+			parts = strings.Split(values[0], ",")
+		}
+
+		var err error
+		switch k {
+		case reflect.Slice:
+			if opts.Format == "byte" && isByteSlice(t) {
+				raw := strings.Join(parts, ",")
+				decoded, decErr := base64Decode(raw)
+				if decErr != nil {
+					return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+				}
+				v.SetBytes(decoded)
+			} else {
+				err = bindSplitPartsToDestinationArray(parts, output)
+			}
+		case reflect.Struct, reflect.Map:
+			// Some struct types (e.g. Date, time.Time) are scalar values
+			// that should be bound from a single string, not decomposed as
+			// key-value objects.
+			switch bv := output.(type) {
+			case ParamBinder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.BindParam(parts[0])
+			case Binder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.Bind(parts[0])
+			case encoding.TextUnmarshaler:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.UnmarshalText([]byte(parts[0]))
+			default:
+				err = bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, output)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if extraIndirect {
+			dv.Set(reflect.ValueOf(output))
+		}
+		return nil
+
+	case "deepObject":
+		if !opts.Explode {
+			return errors.New("deepObjects must be exploded")
+		}
+		return unmarshalDeepObject(dest, paramName, queryParams, opts.Required)
+
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// unmarshalDeepObject is the internal implementation of deep object
+// unmarshaling that supports the required parameter.
+func unmarshalDeepObject(dst any, paramName string, params url.Values, required bool) error {
+	var fieldNames []string
+	var fieldValues []string
+	searchStr := paramName + "["
+
+	for pName, pValues := range params {
+		if strings.HasPrefix(pName, searchStr) {
+			pName = pName[len(paramName):]
+			if len(pValues) == 1 {
+				fieldNames = append(fieldNames, pName)
+				fieldValues = append(fieldValues, pValues[0])
+			} else {
+				for i, value := range pValues {
+					fieldNames = append(fieldNames, pName+"["+strconv.Itoa(i)+"]")
+					fieldValues = append(fieldValues, value)
+				}
+			}
+		}
+	}
+
+	if len(fieldNames) == 0 {
+		if required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	paths := make([][]string, len(fieldNames))
+	for i, path := range fieldNames {
+		path = strings.TrimLeft(path, "[")
+		path = strings.TrimRight(path, "]")
+		paths[i] = strings.Split(path, "][")
+	}
+
+	fieldPaths := makeFieldOrValue(paths, fieldValues)
+	err := assignPathValues(dst, fieldPaths)
+	if err != nil {
+		return fmt.Errorf("error assigning value to destination: %w", err)
+	}
+	return nil
+}
+
+type fieldOrValue struct {
+	fields map[string]fieldOrValue
+	value  string
+}
+
+func (f *fieldOrValue) appendPathValue(path []string, value string) {
+	fieldName := path[0]
+	if len(path) == 1 {
+		f.fields[fieldName] = fieldOrValue{value: value}
+		return
+	}
+
+	pv, found := f.fields[fieldName]
+	if !found {
+		pv = fieldOrValue{
+			fields: make(map[string]fieldOrValue),
+		}
+		f.fields[fieldName] = pv
+	}
+	pv.appendPathValue(path[1:], value)
+}
+
+func makeFieldOrValue(paths [][]string, values []string) fieldOrValue {
+	f := fieldOrValue{
+		fields: make(map[string]fieldOrValue),
+	}
+	for i := range paths {
+		f.appendPathValue(paths[i], values[i])
+	}
+	return f
+}
+
+func getFieldName(f reflect.StructField) string {
+	n := f.Name
+	tag, found := f.Tag.Lookup("json")
+	if found {
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			n = parts[0]
+		}
+	}
+	return n
+}
+
+func fieldIndicesByJsonTag(i any) (map[string]int, error) {
+	t := reflect.TypeOf(i)
+	if t.Kind() != reflect.Struct {
+		return nil, errors.New("expected a struct as input")
+	}
+
+	n := t.NumField()
+	fieldMap := make(map[string]int)
+	for i := 0; i < n; i++ {
+		field := t.Field(i)
+		fieldName := getFieldName(field)
+		fieldMap[fieldName] = i
+	}
+	return fieldMap, nil
+}
+
+func assignPathValues(dst any, pathValues fieldOrValue) error {
+	v := reflect.ValueOf(dst)
+	iv := reflect.Indirect(v)
+	it := iv.Type()
+
+	switch it.Kind() {
+	case reflect.Map:
+		dstMap := reflect.MakeMap(iv.Type())
+		for key, value := range pathValues.fields {
+			dstKey := reflect.ValueOf(key)
+			dstVal := reflect.New(iv.Type().Elem())
+			err := assignPathValues(dstVal.Interface(), value)
+			if err != nil {
+				return fmt.Errorf("error binding map: %w", err)
+			}
+			dstMap.SetMapIndex(dstKey, dstVal.Elem())
+		}
+		iv.Set(dstMap)
+		return nil
+
+	case reflect.Slice:
+		if tu, ok := dst.(encoding.TextUnmarshaler); ok && len(pathValues.fields) == 0 {
+			// A scalar slice type, such as Base64Bytes.
+			return tu.UnmarshalText([]byte(pathValues.value))
+		}
+		sliceLength := len(pathValues.fields)
+		dstSlice := reflect.MakeSlice(it, sliceLength, sliceLength)
+		err := assignDeepObjectSlice(dstSlice, pathValues)
+		if err != nil {
+			return fmt.Errorf("error assigning slice: %w", err)
+		}
+		iv.Set(dstSlice)
+		return nil
+
+	case reflect.Struct:
+		if dst, isBinder := v.Interface().(ParamBinder); isBinder {
+			return dst.BindParam(pathValues.value)
+		}
+		if dst, isBinder := v.Interface().(Binder); isBinder {
+			return dst.Bind(pathValues.value)
+		}
+
+		if isDate(it) {
+			var date Date
+			var err error
+			date.Time, err = time.Parse(DateFormat, pathValues.value)
+			if err != nil {
+				return fmt.Errorf("invalid date format: %w", err)
+			}
+			dst := iv
+			if it != reflect.TypeOf(Date{}) {
+				ivPtr := iv.Addr()
+				aPtr := ivPtr.Convert(reflect.TypeOf(&Date{}))
+				dst = reflect.Indirect(aPtr)
+			}
+			dst.Set(reflect.ValueOf(date))
+			return nil
+		}
+
+		if it.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+			tm, err := time.Parse(time.RFC3339Nano, pathValues.value)
+			if err != nil {
+				tm, err = time.Parse(DateFormat, pathValues.value)
+				if err != nil {
+					return fmt.Errorf("error parsing '%s' as RFC3339 or date: %w", pathValues.value, err)
+				}
+			}
+			dst := iv
+			if it != reflect.TypeOf(time.Time{}) {
+				ivPtr := iv.Addr()
+				aPtr := ivPtr.Convert(reflect.TypeOf(&time.Time{}))
+				dst = reflect.Indirect(aPtr)
+			}
+			dst.Set(reflect.ValueOf(tm))
+			return nil
+		}
+
+		// Scalar struct types, such as Decimal, parse themselves.
+		if dst, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			return dst.UnmarshalText([]byte(pathValues.value))
+		}
+
+		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
+		if err != nil {
+			return fmt.Errorf("failed enumerating fields: %w", err)
+		}
+		for _, fieldName := range sortedFieldOrValueKeys(pathValues.fields) {
+			fieldValue := pathValues.fields[fieldName]
+			fieldIndex, found := fieldMap[fieldName]
+			if !found {
+				return fmt.Errorf("field [%s] is not present in destination object", fieldName)
+			}
+			field := iv.Field(fieldIndex)
+			err = assignPathValues(field.Addr().Interface(), fieldValue)
+			if err != nil {
+				return fmt.Errorf("error assigning field [%s]: %w", fieldName, err)
+			}
+		}
+		return nil
+
+	case reflect.Ptr:
+		dstVal := reflect.New(it.Elem())
+		dstPtr := dstVal.Interface()
+		err := assignPathValues(dstPtr, pathValues)
+		iv.Set(dstVal)
+		return err
+
+	case reflect.Bool:
+		val, err := strconv.ParseBool(pathValues.value)
+		if err != nil {
+			return fmt.Errorf("expected a valid bool, got %s", pathValues.value)
+		}
+		iv.SetBool(val)
+		return nil
+
+	case reflect.Float32:
+		val, err := strconv.ParseFloat(pathValues.value, 32)
+		if err != nil {
+			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+		}
+		iv.SetFloat(val)
+		return nil
+
+	case reflect.Float64:
+		val, err := strconv.ParseFloat(pathValues.value, 64)
+		if err != nil {
+			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+		}
+		iv.SetFloat(val)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err := strconv.ParseInt(pathValues.value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected a valid int, got %s", pathValues.value)
+		}
+		iv.SetInt(val)
+		return nil
+
+	case reflect.String:
+		iv.SetString(pathValues.value)
+		return nil
+
+	default:
+		return errors.New("unhandled type: " + it.String())
+	}
+}
+
+func assignDeepObjectSlice(dst reflect.Value, pathValues fieldOrValue) error {
+	nValues := len(pathValues.fields)
+	values := make([]fieldOrValue, nValues)
+	for i := 0; i < nValues; i++ {
+		indexStr := strconv.Itoa(i)
+		fv, found := pathValues.fields[indexStr]
+		if !found {
+			return errors.New("array deepObjects must have consecutive indices")
+		}
+		values[i] = fv
+	}
+
+	// Items keep their fields, so arrays of objects bind too.
+	for i := 0; i < nValues; i++ {
+		dstElem := dst.Index(i).Addr()
+		err := assignPathValues(dstElem.Interface(), values[i])
+		if err != nil {
+			return fmt.Errorf("error binding array: %w", err)
+		}
+	}
+	return nil
+}
+
+func sortedFieldOrValueKeys(m map[string]fieldOrValue) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// bindParamsToExplodedObject reflects the destination structure and pulls the
+// value for each settable field from the given query parameters. Returns
+// whether any fields were bound.
+func bindParamsToExplodedObject(paramName string, values url.Values, dest any) (bool, error) {
+	binder, v, t := indirectBinder(dest)
+	if binder != nil {
+		_, found := values[paramName]
+		if !found {
+			return false, nil
+		}
+		return true, BindStringToObject(values.Get(paramName), dest)
+	}
+	if t.Kind() != reflect.Struct {
+		return false, fmt.Errorf("unmarshaling query arg '%s' into wrong type", paramName)
+	}
+
+	fieldsPresent := false
+	for i := 0; i < t.NumField(); i++ {
+		fieldT := t.Field(i)
+		if !v.Field(i).CanSet() {
+			continue
+		}
+
+		tag := fieldT.Tag.Get("json")
+		fieldName := fieldT.Name
+		if tag != "" {
+			tagParts := strings.Split(tag, ",")
+			if tagParts[0] != "" {
+				fieldName = tagParts[0]
+			}
+		}
+
+		fieldVal, found := values[fieldName]
+		if found {
+			if len(fieldVal) != 1 {
+				return false, fmt.Errorf("field '%s' specified multiple times for param '%s'", fieldName, paramName)
+			}
+			err := BindStringToObject(fieldVal[0], v.Field(i).Addr().Interface())
+			if err != nil {
+				return false, fmt.Errorf("could not bind query arg '%s': %w", paramName, err)
+			}
+			fieldsPresent = true
+		}
+	}
+	return fieldsPresent, nil
+}
+
+// indirectBinder checks if dest implements ParamBinder or Binder and returns
+// reflect values.
+func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
+	v := reflect.ValueOf(dest)
+	if v.Type().NumMethod() > 0 && v.CanInterface() {
+		if u, ok := v.Interface().(ParamBinder); ok {
+			return u, reflect.Value{}, nil
+		}
+		if u, ok := v.Interface().(Binder); ok {
+			return u, reflect.Value{}, nil
+		}
+	}
+	v = reflect.Indirect(v)
+	t := v.Type()
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+		return dest, reflect.Value{}, nil
+	}
+	if isDate(t) {
+		return dest, reflect.Value{}, nil
+	}
+	// Scalar struct types, such as Decimal, parse themselves.
+	if _, ok := dest.(encoding.TextUnmarshaler); ok {
+		return dest, reflect.Value{}, nil
+	}
+	return nil, v, t
+}
+
+// ParamLocation indicates where a parameter is located in an HTTP request.
+type ParamLocation int
+
+const (
+	ParamLocationUndefined ParamLocation = iota
+	ParamLocationQuery
+	ParamLocationPath
+	ParamLocationHeader
+	ParamLocationCookie
+)
+
+// Binder is an interface for types that can bind themselves from a string value.
+type Binder interface {
+	Bind(value string) error
+}
+
+// ParamMarshaler is implemented by types which choose their own representation
+// in parameters, such as domain types set with x-go-type. MarshalParam returns
+// the value of a primitive parameter, or of an item of an array or a property
+// of an object; it's styled and escaped like any other string. It takes
+// precedence over encoding.TextMarshaler and reflection.
+type ParamMarshaler interface {
+	MarshalParam() (string, error)
+}
+
+// ParamBinder is the counterpart of ParamMarshaler: BindParam sets the value
+// from the string MarshalParam returned, unescaped and with the style's
+// prefixes and delimiters removed. It takes precedence over Binder,
+// encoding.TextUnmarshaler and reflection.
+type ParamBinder interface {
+	BindParam(value string) error
+}
+
+// MissingRequiredParameterError is returned when a required parameter is not
+// present in the request. Upper layers can use errors.As to detect this and
+// produce an appropriate HTTP error response.
+type MissingRequiredParameterError struct {
+	ParamName string
+}
+
+func (e *MissingRequiredParameterError) Error() string {
+	return fmt.Sprintf("parameter '%s' is required", e.ParamName)
+}
+
+// primitiveToString converts a primitive value to a string representation.
+// It handles basic Go types, time.Time, Date, time.Duration, formatted
+// as a Go duration string such as "1h30m0s", and types that implement
+// ParamMarshaler, json.Marshaler or fmt.Stringer.
+func primitiveToString(value any) (string, error) {
+	// The common builtin types are formatted without reflection.
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return FormatInt(v), nil
+	case int32:
+		return FormatInt(v), nil
+	case int64:
+		return FormatInt(v), nil
+	case float32:
+		return FormatFloat32(v), nil
+	case float64:
+		return FormatFloat64(v), nil
+	case bool:
+		return FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	case ParamMarshaler:
+		return v.MarshalParam()
+	}
+
+	// Check for known types first (time, date, text marshalers)
+	if res, ok := marshalKnownTypes(value); ok {
+		return res, nil
+	}
+
+	// Dereference pointers for optional values
+	v := reflect.Indirect(reflect.ValueOf(value))
+	t := v.Type()
+	kind := t.Kind()
+
+	switch kind {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return "true", nil
+		}
+		return "false", nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Struct:
+		// Check if it implements json.Marshaler
+		if m, ok := value.(json.Marshaler); ok {
+			buf, err := m.MarshalJSON()
+			if err != nil {
+				return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+			}
+			e := json.NewDecoder(bytes.NewReader(buf))
+			e.UseNumber()
+			var i2 any
+			if err = e.Decode(&i2); err != nil {
+				return "", fmt.Errorf("failed to decode JSON: %w", err)
+			}
+			return primitiveToString(i2)
+		}
+		fallthrough
+	default:
+		if s, ok := value.(fmt.Stringer); ok {
+			return s.String(), nil
+		}
+		return "", fmt.Errorf("unsupported type %s", reflect.TypeOf(value).String())
+	}
+}
+
+// marshalKnownTypes checks for special types (time.Time, Date, and text
+// marshalers such as Duration or UUID) and marshals them.
+func marshalKnownTypes(value any) (string, bool) {
+	v := reflect.Indirect(reflect.ValueOf(value))
+	t := v.Type()
+
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+		tt := v.Convert(reflect.TypeOf(time.Time{}))
+		timeVal := tt.Interface().(time.Time)
+		return timeVal.Format(time.RFC3339Nano), true
+	}
+
+	if isDate(t) {
+		d := v.Convert(reflect.TypeOf(Date{}))
+		dateVal := d.Interface().(Date)
+		return dateVal.Format(DateFormat), true
+	}
+
+	// Checked last, as the kind of these types, such as the int64 of a
+	// Duration, would otherwise format them as a plain number. UUIDs, whatever
+	// their library, are formatted this way too, so none is imported here.
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+
+	return "", false
+}
+
+// escapeParameterName escapes a parameter name for use in query strings and
+// paths. This ensures characters like [] in parameter names (e.g. user_ids[])
+// are properly percent-encoded per RFC 3986.
+func escapeParameterName(name string, paramLocation ParamLocation) string {
+	// Parameter names should always be encoded regardless of allowReserved,
+	// which only applies to values per the OpenAPI spec.
+	return escapeParameterString(name, paramLocation, false)
+}
+
+// escapeParameterString escapes a parameter value based on its location.
+// Query and path parameters need URL escaping; headers and cookies do not.
+// When allowReserved is true and the location is query, RFC 3986 reserved
+// characters are left unencoded per the OpenAPI allowReserved specification.
+func escapeParameterString(value string, paramLocation ParamLocation, allowReserved bool) string {
+	switch paramLocation {
+	case ParamLocationQuery:
+		if allowReserved {
+			return escapeQueryAllowReserved(value)
+		}
+		return url.QueryEscape(value)
+	case ParamLocationPath:
+		return url.PathEscape(value)
+	default:
+		return value
+	}
+}
+
+// escapeQueryAllowReserved percent-encodes a query parameter value while
+// leaving RFC 3986 reserved characters (:/?#[]@!$&'()*+,;=) unencoded, as
+// specified by OpenAPI's allowReserved parameter option.
+func escapeQueryAllowReserved(value string) string {
+	const reserved = `:/?#[]@!$&'()*+,;=`
+
+	var buf strings.Builder
+	for _, b := range []byte(value) {
+		if isUnreserved(b) || strings.IndexByte(reserved, b) >= 0 {
+			buf.WriteByte(b)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", b)
+		}
+	}
+	return buf.String()
+}
+
+// isUnreserved reports whether the byte is an RFC 3986 unreserved character:
+// ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseDuration parses a time.Duration from a Go duration string, such as
+// "1h30m", or an ISO 8601 duration, such as "PT1H30M".
+func parseDuration(src string) (time.Duration, error) {
+	if d, err := time.ParseDuration(src); err == nil {
+		return d, nil
+	}
+	d, err := ParseDuration(src)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %q is neither a Go nor an ISO 8601 duration", src)
+	}
+	return d.TimeDuration(), nil
+}
+
+// BindStringToObject binds a string value to a destination object.
+// It handles primitives, encoding.TextUnmarshaler, and the ParamBinder and
+// Binder interfaces.
+func BindStringToObject(src string, dst any) error {
+	// The common builtin types are parsed without reflection.
+	var err error
+	switch d := dst.(type) {
+	case *string:
+		*d = src
+		return nil
+	case *int:
+		*d, err = ParseInt[int](src)
+		return err
+	case *int32:
+		*d, err = ParseInt[int32](src)
+		return err
+	case *int64:
+		*d, err = ParseInt[int64](src)
+		return err
+	case *float32:
+		*d, err = ParseFloat[float32](src)
+		return err
+	case *float64:
+		*d, err = ParseFloat[float64](src)
+		return err
+	case *bool:
+		*d, err = ParseBool[bool](src)
+		return err
+	case *time.Duration:
+		*d, err = parseDuration(src)
+		return err
+	case ParamBinder:
+		return d.BindParam(src)
+	}
+
+	// Check for TextUnmarshaler
+	if tu, ok := dst.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(src))
+	}
+
+	// Check for Binder interface
+	if b, ok := dst.(Binder); ok {
+		return b.Bind(src)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr {
+		return fmt.Errorf("dst must be a pointer, got %T", dst)
+	}
+	v = v.Elem()
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(src)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(src, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse int: %w", err)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(src, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse uint: %w", err)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(src, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse float: %w", err)
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(src)
+		if err != nil {
+			return fmt.Errorf("failed to parse bool: %w", err)
+		}
+		v.SetBool(b)
+	default:
+		// Try JSON unmarshal as a fallback
+		return json.Unmarshal([]byte(src), dst)
+	}
+	return nil
+}
+
+// bindSplitPartsToDestinationArray binds a slice of string parts to a destination slice.
+func bindSplitPartsToDestinationArray(parts []string, dest any) error {
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	t := v.Type()
+
+	newArray := reflect.MakeSlice(t, len(parts), len(parts))
+	for i, p := range parts {
+		err := BindStringToObject(p, newArray.Index(i).Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("error setting array element: %w", err)
+		}
+	}
+	v.Set(newArray)
+	return nil
+}
+
+// bindSplitPartsToDestinationStruct binds string parts to a destination struct via JSON.
+// Properties are typed after the fields of dest, so that numbers and booleans
+// bind to numeric and boolean fields rather than failing as strings.
+func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode bool, dest any) error {
+	var keys, values []string
+	if explode {
+		for _, property := range parts {
+			key, value, found := strings.Cut(property, "=")
+			if !found {
+				return fmt.Errorf("parameter '%s' has invalid exploded format", paramName)
+			}
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+	} else {
+		if len(parts)%2 != 0 {
+			return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
+		}
+		for i := 0; i < len(parts); i += 2 {
+			keys = append(keys, parts[i])
+			values = append(values, parts[i+1])
+		}
+	}
+
+	t := reflect.TypeOf(dest)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]string, len(keys))
+	for i, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		value, err := propertyJSON(values[i], propertyKind(t, key))
+		if err != nil {
+			return err
+		}
+		fields[i] = string(name) + ":" + value
+	}
+	jsonParam := "{" + strings.Join(fields, ",") + "}"
+	return json.Unmarshal([]byte(jsonParam), dest)
+}
+
+// propertyKind returns the kind of the property named key of objects of type
+// t: of the field whose JSON name is key in a struct, or of the values of a
+// map, looking through pointers. It returns reflect.Invalid for unknown
+// properties.
+func propertyKind(t reflect.Type, key string) reflect.Kind {
+	var pt reflect.Type
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() && getFieldName(f) == key {
+				pt = f.Type
+				break
+			}
+		}
+	case reflect.Map:
+		pt = t.Elem()
+	}
+	if pt == nil {
+		return reflect.Invalid
+	}
+	for pt.Kind() == reflect.Ptr {
+		pt = pt.Elem()
+	}
+	return pt.Kind()
+}
+
+// propertyJSON returns value as a JSON value for a property of kind k: a bare
+// number or boolean when k is numeric or boolean and value parses as one, and
+// a string otherwise.
+func propertyJSON(value string, k reflect.Kind) (string, error) {
+	var err error
+	switch k {
+	case reflect.Bool:
+		if value != "true" && value != "false" {
+			err = strconv.ErrSyntax
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		err = strconv.ErrSyntax
+	}
+	if err == nil && json.Valid([]byte(value)) {
+		return value, nil
+	}
+	buf, err := json.Marshal(value)
+	return string(buf), err
+}
+
+// isObjectType reports whether values of type t, looking through pointers,
+// are styled as objects: structs and maps, except for times, dates and types
+// which marshal themselves as parameters or text.
+func isObjectType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+		return false
+	}
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) || isDate(t) {
+		return false
+	}
+	for _, i := range []reflect.Type{
+		reflect.TypeOf((*ParamMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*ParamBinder)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDate reports whether t is Date, or a type defined from it. Other
+// types with the same underlying type which parse themselves, such as
+// Time, aren't dates.
+func isDate(t reflect.Type) bool {
+	dateType := reflect.TypeOf(Date{})
+	if t == dateType {
+		return true
+	}
+	return t.ConvertibleTo(dateType) && !reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
+// isByteSlice reports whether t is []byte (or equivalently []uint8).
+func isByteSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// base64Decode decodes s as base64.
+//
+// Per OpenAPI 3.0, format: byte uses RFC 4648 Section 4 (standard alphabet,
+// padded). We use padding presence to select the right decoder, rather than
+// blindly cascading (which can produce corrupt output when RawStdEncoding
+// silently accepts padded input and treats '=' as data).
+func base64Decode(s string) ([]byte, error) {
+	if s == "" {
+		return []byte{}, nil
+	}
+
+	if strings.ContainsRune(s, '=') {
+		if strings.ContainsAny(s, "-_") {
+			return base64Decode1(base64.URLEncoding, s)
+		}
+		return base64Decode1(base64.StdEncoding, s)
+	}
+
+	if strings.ContainsAny(s, "-_") {
+		return base64Decode1(base64.RawURLEncoding, s)
+	}
+	return base64Decode1(base64.RawStdEncoding, s)
+}
+
+func base64Decode1(enc *base64.Encoding, s string) ([]byte, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to base64-decode string %q: %w", s, err)
+	}
+	return b, nil
+}
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// ParameterOptions carries OpenAPI parameter metadata to bind and style
+// functions so they can handle style dispatch, explode, required,
+// type-aware coercions, and location-aware escaping from a single
+// uniform call site. All fields have sensible zero-value defaults.
+type ParameterOptions struct {
+	Style         string        // OpenAPI style: "simple", "form", "label", "matrix", "deepObject", "pipeDelimited", "spaceDelimited"
+	ParamLocation ParamLocation // Where the parameter appears: query, path, header, cookie
+	Explode       bool
+	Required      bool
+	Type          string // OpenAPI type: "string", "integer", "array", "object"
+	Format        string // OpenAPI format: "int32", "date-time", etc.
+	AllowReserved bool   // When true, reserved characters in query values are not percent-encoded
+}
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseFloat parses a parameter value of a floating-point type.
+func ParseFloat[T ~float32 | ~float64](src string) (T, error) {
+	f, err := strconv.ParseFloat(src, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float: %w", err)
+	}
+	return T(f), nil
+}
+
+// ParseBool parses a parameter value of a boolean type.
+func ParseBool[T ~bool](src string) (T, error) {
+	b, err := strconv.ParseBool(src)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse bool: %w", err)
+	}
+	return T(b), nil
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// FormatFloat32 formats a parameter value of a float32 type, with the fewest
+// digits which parse back to it.
+func FormatFloat32[T ~float32](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// FormatFloat64 formats a parameter value of a float64 type, with the fewest
+// digits which parse back to it.
+func FormatFloat64[T ~float64](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 64)
+}
+
+// FormatBool formats a parameter value of a boolean type.
+func FormatBool[T ~bool](v T) string {
+	return strconv.FormatBool(bool(v))
+}
+
+// StyleParameter serializes a Go value into an OpenAPI-styled parameter string.
+// This is the entry point for client-side parameter serialization. The Style
+// field in opts selects the serialization format. If Style is empty, "simple"
+// is assumed.
+func StyleParameter(paramName string, value any, opts ParameterOptions) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+
+	t := reflect.TypeOf(value)
+	v := reflect.ValueOf(value)
+
+	// Dereference pointers; error on nil.
+	if t.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", fmt.Errorf("value is a nil pointer")
+		}
+		v = reflect.Indirect(v)
+		t = v.Type()
+	}
+
+	// A time.Duration is sent as a Go duration string, such as "1h30m0s",
+	// unless the schema asks for format: duration, which is ISO 8601.
+	if d, ok := v.Interface().(time.Duration); ok && opts.Format == "duration" {
+		value = Duration(d)
+	}
+
+	// Types which choose their own representation are styled as primitives.
+	if m, ok := value.(ParamMarshaler); ok {
+		str, err := m.MarshalParam()
+		if err != nil {
+			return "", fmt.Errorf("error marshaling parameter '%s': %w", paramName, err)
+		}
+		return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, str)
+	}
+
+	// If the value implements encoding.TextMarshaler, use it — but not for
+	// time.Time or Date which have their own formatting logic.
+	if tu, ok := value.(encoding.TextMarshaler); ok {
+		it := reflect.Indirect(reflect.ValueOf(value)).Type()
+		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !isDate(it) {
+			b, err := tu.MarshalText()
+			if err != nil {
+				return "", fmt.Errorf("error marshaling '%s' as text: %w", value, err)
+			}
+			return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, string(b))
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		if opts.Format == "byte" && isByteSlice(t) {
+			encoded := base64.StdEncoding.EncodeToString(v.Bytes())
+			return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, encoded)
+		}
+		n := v.Len()
+		sliceVal := make([]any, n)
+		for i := 0; i < n; i++ {
+			sliceVal[i] = v.Index(i).Interface()
+		}
+		if style == "simple" && isObjectType(t.Elem()) {
+			return styleObjectSlice(paramName, sliceVal, opts)
+		}
+		return styleSlice(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, sliceVal)
+	case reflect.Struct:
+		return styleStruct(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
+	case reflect.Map:
+		return styleMap(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
+	default:
+		return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+func styleSlice(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, values []any) (string, error) {
+	if style == "deepObject" {
+		if !explode {
+			return "", errors.New("deepObjects must be exploded")
+		}
+		return StyleDeepObjectParam(paramName, values)
+	}
+
+	var prefix string
+	var separator string
+
+	escapedName := escapeParameterName(paramName, paramLocation)
+
+	switch style {
+	case "simple":
+		separator = ","
+	case "label":
+		prefix = "."
+		if explode {
+			separator = "."
+		} else {
+			separator = ","
+		}
+	case "matrix":
+		prefix = fmt.Sprintf(";%s=", escapedName)
+		if explode {
+			separator = prefix
+		} else {
+			separator = ","
+		}
+	case "form":
+		prefix = fmt.Sprintf("%s=", escapedName)
+		if explode {
+			separator = "&" + prefix
+		} else {
+			separator = ","
+		}
+	case "spaceDelimited":
+		prefix = fmt.Sprintf("%s=", escapedName)
+		if explode {
+			separator = "&" + prefix
+		} else {
+			separator = "%20"
+		}
+	case "pipeDelimited":
+		prefix = fmt.Sprintf("%s=", escapedName)
+		if explode {
+			separator = "&" + prefix
+		} else {
+			separator = "|"
+		}
+	default:
+		return "", fmt.Errorf("unsupported style '%s'", style)
+	}
+
+	parts := make([]string, len(values))
+	for i, v := range values {
+		part, err := primitiveToString(v)
+		if err != nil {
+			return "", fmt.Errorf("error formatting '%s': %w", paramName, err)
+		}
+		parts[i] = escapeParameterString(part, paramLocation, allowReserved)
+	}
+	return prefix + strings.Join(parts, separator), nil
+}
+
+// styleObjectSlice serializes an array of objects in the simple style, which
+// OpenAPI leaves undefined: each object is styled on its own, as "id,1,name,a"
+// or exploded as "id=1,name=a", and the objects are separated by semicolons.
+func styleObjectSlice(paramName string, values []any, opts ParameterOptions) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		part, err := StyleParameter(paramName, v, opts)
+		if err != nil {
+			return "", fmt.Errorf("error formatting item %d of '%s': %w", i, paramName, err)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ";"), nil
+}
+
+func styleStruct(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
+	if timeVal, ok := marshalKnownTypes(value); ok {
+		return stylePrimitive(style, explode, paramName, paramLocation, allowReserved, timeVal)
+	}
+
+	if style == "deepObject" {
+		if !explode {
+			return "", errors.New("deepObjects must be exploded")
+		}
+		return StyleDeepObjectParam(paramName, value)
+	}
+
+	// If input implements json.Marshaler (e.g. objects with additional properties
+	// or anyOf), marshal to JSON and re-style the generic structure.
+	if m, ok := value.(json.Marshaler); ok {
+		buf, err := m.MarshalJSON()
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
+		}
+		e := json.NewDecoder(bytes.NewReader(buf))
+		e.UseNumber()
+		var i2 any
+		err = e.Decode(&i2)
+		if err != nil {
+			return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		return StyleParameter(paramName, i2, ParameterOptions{
+			Style:         style,
+			ParamLocation: paramLocation,
+			Explode:       explode,
+			AllowReserved: allowReserved,
+		})
+	}
+
+	// Build a dictionary of the struct's fields.
+	v := reflect.ValueOf(value)
+	t := reflect.TypeOf(value)
+	fieldDict := make(map[string]string)
+
+	for i := 0; i < t.NumField(); i++ {
+		fieldT := t.Field(i)
+		tag := fieldT.Tag.Get("json")
+		fieldName := fieldT.Name
+		if tag != "" {
+			tagParts := strings.Split(tag, ",")
+			if tagParts[0] != "" {
+				fieldName = tagParts[0]
+			}
+		}
+		f := v.Field(i)
+
+		// Skip nil optional fields.
+		if f.Type().Kind() == reflect.Ptr && f.IsNil() {
+			continue
+		}
+		str, err := primitiveToString(f.Interface())
+		if err != nil {
+			return "", fmt.Errorf("error formatting '%s': %w", paramName, err)
+		}
+		fieldDict[fieldName] = str
+	}
+
+	return processFieldDict(style, explode, paramName, paramLocation, allowReserved, fieldDict)
+}
+
+func styleMap(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
+	if style == "deepObject" {
+		if !explode {
+			return "", errors.New("deepObjects must be exploded")
+		}
+		return StyleDeepObjectParam(paramName, value)
+	}
+	v := reflect.ValueOf(value)
+
+	fieldDict := make(map[string]string)
+	for _, fieldName := range v.MapKeys() {
+		str, err := primitiveToString(v.MapIndex(fieldName).Interface())
+		if err != nil {
+			return "", fmt.Errorf("error formatting '%s': %w", paramName, err)
+		}
+		fieldDict[fieldName.String()] = str
+	}
+	return processFieldDict(style, explode, paramName, paramLocation, allowReserved, fieldDict)
+}
+
+func processFieldDict(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, fieldDict map[string]string) (string, error) {
+	var parts []string
+
+	if style != "deepObject" {
+		if explode {
+			for _, k := range sortedKeys(fieldDict) {
+				v := escapeParameterString(fieldDict[k], paramLocation, allowReserved)
+				parts = append(parts, k+"="+v)
+			}
+		} else {
+			for _, k := range sortedKeys(fieldDict) {
+				v := escapeParameterString(fieldDict[k], paramLocation, allowReserved)
+				parts = append(parts, k)
+				parts = append(parts, v)
+			}
+		}
+	}
+
+	escapedName := escapeParameterName(paramName, paramLocation)
+
+	var prefix string
+	var separator string
+
+	switch style {
+	case "simple":
+		separator = ","
+	case "label":
+		prefix = "."
+		if explode {
+			separator = prefix
+		} else {
+			separator = ","
+		}
+	case "matrix":
+		if explode {
+			separator = ";"
+			prefix = ";"
+		} else {
+			separator = ","
+			prefix = fmt.Sprintf(";%s=", escapedName)
+		}
+	case "form":
+		if explode {
+			separator = "&"
+		} else {
+			prefix = fmt.Sprintf("%s=", escapedName)
+			separator = ","
+		}
+	case "spaceDelimited", "pipeDelimited":
+		// Exploded, these are the form style: one key per property.
+		if explode {
+			separator = "&"
+		} else {
+			prefix = fmt.Sprintf("%s=", escapedName)
+			separator = "%20"
+			if style == "pipeDelimited" {
+				separator = "|"
+			}
+		}
+	case "deepObject":
+		if !explode {
+			return "", fmt.Errorf("deepObject parameters must be exploded")
+		}
+		for _, k := range sortedKeys(fieldDict) {
+			v := fieldDict[k]
+			part := fmt.Sprintf("%s[%s]=%s", escapedName, k, v)
+			parts = append(parts, part)
+		}
+		separator = "&"
+	default:
+		return "", fmt.Errorf("unsupported style '%s'", style)
+	}
+
+	return prefix + strings.Join(parts, separator), nil
+}
+
+func stylePrimitive(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
+	strVal, err := primitiveToString(value)
+	if err != nil {
+		return "", err
+	}
+	return styleString(style, paramName, paramLocation, allowReserved, strVal)
+}
+
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
+	escapedName := escapeParameterName(paramName, paramLocation)
+
+	var prefix string
+	switch style {
+	case "simple":
+	case "label":
+		prefix = "."
+	case "matrix":
+		prefix = fmt.Sprintf(";%s=", escapedName)
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
+		prefix = fmt.Sprintf("%s=", escapedName)
+	default:
+		return "", fmt.Errorf("unsupported style '%s'", style)
+	}
+	return prefix + escapeParameterString(strVal, paramLocation, allowReserved), nil
+}
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// StyleDeepObjectParam serializes value as an exploded deepObject query
+// parameter, such as "filter[status]=open&filter[created][gte]=2024-01-01".
+// value is marshaled to JSON first, so struct fields are named by their json
+// tags: objects nest in brackets, array items are indexed from 0, and null
+// members are left out. Names and values are query escaped, and numbers keep
+// their JSON form.
+func StyleDeepObjectParam(paramName string, value any) (string, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+	var fields []string
+	styleDeepObjectRecursive(url.QueryEscape(paramName), v, &fields)
+	return strings.Join(fields, "&"), nil
+}
+
+// styleDeepObjectRecursive appends the key=value pairs of v, whose escaped
+// key is key, to fields.
+func styleDeepObjectRecursive(key string, v any, fields *[]string) {
+	switch t := v.(type) {
+	case nil:
+	case []any:
+		for i, item := range t {
+			styleDeepObjectRecursive(key+"["+strconv.Itoa(i)+"]", item, fields)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			styleDeepObjectRecursive(key+"["+url.QueryEscape(k)+"]", t[k], fields)
+		}
+	default:
+		*fields = append(*fields, key+"="+url.QueryEscape(fmt.Sprint(t)))
+	}
+}
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// DecodeError is an error decoding a JSON document, located by the JSON
+// Pointer of the value which failed to decode.
+type DecodeError struct {
+	// Pointer locates the failing value; "" is the whole document.
+	Pointer string
+	// Offset is the byte offset in the document the decoder failed at.
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	at := e.Pointer
+	if at == "" {
+		at = "document root"
+	}
+	return "decoding JSON at " + at + ": " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WrapDecodeError locates err, returned decoding data, in a *DecodeError.
+// Syntax and type errors carry the offset the decoder failed at; other
+// errors, such as those of UnmarshalJSON methods, are returned as is, as is
+// nil.
+func WrapDecodeError(data []byte, err error) error {
+	var offset, scanned int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &syntaxErr):
+		// The offset of a syntax error counts the invalid byte, which may
+		// close the value it belongs in.
+		offset, scanned = syntaxErr.Offset, syntaxErr.Offset-1
+	case errors.As(err, &typeErr):
+		offset, scanned = typeErr.Offset, typeErr.Offset
+	default:
+		return err
+	}
+	return &DecodeError{Pointer: PointerAtOffset(data, scanned), Offset: offset, Err: err}
+}
+
+// DecodeJSON is json.Unmarshal, returning errors located by WrapDecodeError.
+func DecodeJSON(data []byte, v any) error {
+	return WrapDecodeError(data, json.Unmarshal(data, v))
+}
+
+// EscapePointerToken escapes a reference token for use in a JSON Pointer, as
+// RFC 6901 requires: "~" becomes "~0" and "/" becomes "~1".
+func EscapePointerToken(token string) string {
+	if !strings.ContainsAny(token, "~/") {
+		return token
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// pointerFrame is an object or array enclosing the position PointerAtOffset
+// scans to.
+type pointerFrame struct {
+	array bool
+	// index is the index of the current element of an array, or -1 before
+	// the first one.
+	index int
+	// key is the key of the current member of an object, and inValue whether
+	// the scan is past its colon.
+	key     string
+	inValue bool
+}
+
+// PointerAtOffset returns the JSON Pointer of the innermost value of data
+// which the first offset bytes end in, as reported by the Offset of a
+// *json.SyntaxError or *json.UnmarshalTypeError. data need not be valid past
+// offset.
+func PointerAtOffset(data []byte, offset int64) string {
+	offset = min(max(offset, 0), int64(len(data)))
+	var stack []pointerFrame
+	for i := int64(0); i < offset; i++ {
+		switch c := data[i]; c {
+		case '{', '[':
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+			stack = append(stack, pointerFrame{array: c == '[', index: -1})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ':':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = true
+			}
+		case ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = false
+			}
+		case '"':
+			end := stringEnd(data, i)
+			if len(stack) > 0 {
+				top := &stack[len(stack)-1]
+				if !top.array && !top.inValue {
+					top.key = unquoteKey(data[i:min(end, int64(len(data)))])
+				} else {
+					startValue(top)
+				}
+			}
+			i = end - 1
+		case ' ', '\t', '\r', '\n':
+		default:
+			// A literal: a number, true, false or null.
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, frame := range stack {
+		switch {
+		case frame.array && frame.index >= 0:
+			b.WriteString("/" + strconv.Itoa(frame.index))
+		case !frame.array && frame.inValue:
+			b.WriteString("/" + EscapePointerToken(frame.key))
+		default:
+			// The scan stopped within the container itself.
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// startValue notes that a value starts in frame, moving arrays on to their
+// next element.
+func startValue(frame *pointerFrame) {
+	if frame.array && !frame.inValue {
+		frame.index++
+		frame.inValue = true
+	}
+}
+
+// stringEnd returns the offset just past the string starting at data[start],
+// or len(data) if it is unterminated.
+func stringEnd(data []byte, start int64) int64 {
+	for i := start + 1; i < int64(len(data)); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return int64(len(data))
+}
+
+// unquoteKey decodes a quoted object key, falling back to its raw text when
+// it isn't valid JSON.
+func unquoteKey(quoted []byte) string {
+	var key string
+	if err := json.Unmarshal(quoted, &key); err != nil {
+		return strings.Trim(string(quoted), `"`)
+	}
+	return key
+}
@@ -0,0 +1,56 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationFields(t *testing.T) {
+	retryAfter := Duration(30 * time.Second)
+	job := Job{Name: "backup", Timeout: Duration(90 * time.Minute), RetryAfter: &retryAfter}
+
+	buf, err := json.Marshal(job)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"backup","timeout":"PT1H30M","retryAfter":"PT30S"}`, string(buf))
+
+	var decoded Job
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"backup","timeout":"P1DT12H"}`), &decoded))
+	assert.Equal(t, 36*time.Hour, decoded.Timeout.TimeDuration())
+	assert.Nil(t, decoded.RetryAfter)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"name":"backup","timeout":"90m"}`), &decoded))
+}
+
+func TestDurationRoundTrip(t *testing.T) {
+	var gotQuery string
+	var gotParams CreateJobParams
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		require.NoError(t, gotParams.FromURLValues(r.URL.Query()))
+		var job Job
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&job))
+		job.Timeout *= 2
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(job))
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	deadline := Duration(2 * time.Hour)
+	job, err := client.CreateJob(context.Background(), &CreateJobParams{Deadline: &deadline}, Job{Name: "backup", Timeout: Duration(time.Minute)})
+	require.NoError(t, err)
+
+	assert.Equal(t, "deadline=PT2H", gotQuery)
+	require.NotNil(t, gotParams.Deadline)
+	assert.Equal(t, 2*time.Hour, gotParams.Deadline.TimeDuration())
+	assert.Equal(t, 2*time.Minute, job.Timeout.TimeDuration())
+}
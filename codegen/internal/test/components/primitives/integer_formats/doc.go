@@ -0,0 +1,6 @@
+// Package integer_formats tests the sized integer formats, and unsigned types
+// for integers with a non-negative minimum, in models, parameters and
+// responses.
+package integer_formats
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,62 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type registerServer struct{}
+
+func (registerServer) GetRegister(w http.ResponseWriter, r *http.Request, address uint32, params GetRegisterParams) {
+	reg := Register{Address: address, Value: 1 << 63, Width: N32}
+	if params.Offset != nil {
+		reg.Offset = *params.Offset
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(reg)
+}
+
+func (registerServer) GetRawRegister(w http.ResponseWriter, r *http.Request, address uint32) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(uint64(address) << 32)
+}
+
+func TestUnsignedIntegerRoundTrip(t *testing.T) {
+	srv := httptest.NewServer(Handler(registerServer{}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	offset := int16(-4)
+	reg, err := client.GetRegister(context.Background(), 3_000_000_000, &GetRegisterParams{Offset: &offset})
+	require.NoError(t, err)
+	assert.Equal(t, Register{Address: 3_000_000_000, Value: 1 << 63, Width: N32, Offset: -4}, reg)
+
+	raw, err := client.GetRawRegister(context.Background(), 0xFFFFFFFF)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0xFFFFFFFF)<<32, raw)
+}
+
+func TestNegativeUnsignedParameterIsRejected(t *testing.T) {
+	srv := httptest.NewServer(Handler(registerServer{}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/registers/-1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestUnsignedEnum(t *testing.T) {
+	var w Width
+	require.NoError(t, json.Unmarshal([]byte(`64`), &w))
+	assert.Equal(t, N64, w)
+	assert.Error(t, json.Unmarshal([]byte(`-8`), &w))
+}
@@ -0,0 +1,5 @@
+// Package big tests that precise-numbers: big holds integers as BigInt and
+// numbers as DecimalNumber, keeping all of their digits.
+package big
+
+//go:generate go run ../../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
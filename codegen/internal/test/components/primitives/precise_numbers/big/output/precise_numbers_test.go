@@ -0,0 +1,57 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderServer struct{}
+
+func (orderServer) GetOrder(w http.ResponseWriter, r *http.Request, id types.BigInt) {
+	parent := new(big.Int).Sub(id.Int(), big.NewInt(1))
+	order := Order{
+		ID:       id,
+		ParentID: new(types.BigInt),
+		Quantity: types.MustParseBigInt("3"),
+		Price:    types.DecimalNumber{Decimal: types.MustParseDecimal("0.1000000000000000055511151231257827")},
+	}
+	*order.ParentID = types.NewBigInt(parent)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(order)
+}
+
+func TestBigNumbersKeepDigits(t *testing.T) {
+	srv := httptest.NewServer(Handler(orderServer{}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	order, err := client.GetOrder(context.Background(), types.MustParseBigInt("18446744073709551617"))
+	require.NoError(t, err)
+	assert.Equal(t, "18446744073709551617", order.ID.String())
+	require.NotNil(t, order.ParentID)
+	assert.Equal(t, "18446744073709551616", order.ParentID.String())
+	assert.Equal(t, "3", order.Quantity.String())
+	assert.Equal(t, "0.1000000000000000055511151231257827", order.Price.String())
+
+	b, err := json.Marshal(order)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":18446744073709551617,"parent_id":18446744073709551616,"quantity":3,"price":0.1000000000000000055511151231257827}`, string(b))
+	assert.Contains(t, string(b), `"id":18446744073709551617`)
+}
+
+func TestBigNumberDefault(t *testing.T) {
+	var order Order
+	order.ApplyDefaults()
+	require.NotNil(t, order.Priority)
+	assert.Equal(t, types.MustParseBigInt("5"), *order.Priority)
+}
@@ -0,0 +1,5 @@
+// Package json_number tests that precise-numbers: json-number holds integers
+// and numbers without a format as json.Number, keeping all of their digits.
+package json_number
+
+//go:generate go run ../../../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,50 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderServer struct{}
+
+func (orderServer) GetOrder(w http.ResponseWriter, r *http.Request, id json.Number) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"id":` + id.String() + `,"parent_id":9007199254740993,"quantity":3,"price":0.1000000000000000055511151231257827,"ratio":0.5}`))
+}
+
+func TestJSONNumberKeepsDigits(t *testing.T) {
+	srv := httptest.NewServer(Handler(orderServer{}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	order, err := client.GetOrder(context.Background(), "18446744073709551617")
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("18446744073709551617"), order.ID)
+	require.NotNil(t, order.ParentID)
+	assert.Equal(t, json.Number("9007199254740993"), *order.ParentID)
+	assert.Equal(t, json.Number("0.1000000000000000055511151231257827"), order.Price)
+	// Formatted schemas keep their types.
+	assert.Equal(t, int32(3), order.Quantity)
+	require.NotNil(t, order.Ratio)
+	assert.Equal(t, 0.5, *order.Ratio)
+
+	b, err := json.Marshal(order)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":18446744073709551617,"parent_id":9007199254740993,"quantity":3,"price":0.1000000000000000055511151231257827,"ratio":0.5}`, string(b))
+	assert.Contains(t, string(b), `"id":18446744073709551617`)
+}
+
+func TestJSONNumberDefault(t *testing.T) {
+	var order Order
+	order.ApplyDefaults()
+	require.NotNil(t, order.Priority)
+	assert.Equal(t, json.Number("5"), *order.Priority)
+}
@@ -0,0 +1,5 @@
+// Package read_write_only tests the request and response variants of schemas
+// with readOnly and writeOnly properties.
+package read_write_only
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,45 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariantConversions(t *testing.T) {
+	password := "secret"
+	user := User{ID: "u1", Name: "ann", Password: &password}
+	assert.Equal(t, UserRequest{Name: "ann", Password: &password}, user.ToRequest())
+	assert.Equal(t, UserResponse{ID: "u1", Name: "ann"}, user.ToResponse())
+
+	pet := Pet{ID: 7, Name: "rex"}
+	assert.Equal(t, PetRequest{Name: "rex"}, pet.ToRequest())
+}
+
+// TestVariantRoundTrip checks that clients send request bodies without
+// readOnly properties, and decode responses without writeOnly ones.
+func TestVariantRoundTrip(t *testing.T) {
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"u1","name":"ann","password":"leaked"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	password := "secret"
+	created, err := client.CreateUser(context.Background(), UserRequest{Name: "ann", Password: &password})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"name": "ann", "password": "secret"}, gotBody)
+	assert.Equal(t, UserResponse{ID: "u1", Name: "ann"}, created)
+}
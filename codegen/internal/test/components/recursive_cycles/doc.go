@@ -0,0 +1,6 @@
+// Package recursive_cycles tests that schemas in reference cycles through
+// required properties, which libopenapi reports as circular, generate
+// compiling types.
+package recursive_cycles
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
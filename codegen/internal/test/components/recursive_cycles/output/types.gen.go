@@ -0,0 +1,158 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Category
+type Category struct {
+	Name     string         `form:"name" json:"name"`
+	Label    *string        `form:"label,omitempty" json:"label,omitempty"`
+	Parent   *Category      `form:"parent" json:"parent"`
+	Children []Category     `form:"children,omitempty" json:"children,omitempty"`
+	ByName   map[string]any `form:"byName,omitempty" json:"byName,omitempty"`
+	Meta     CategoryMeta   `form:"meta" json:"meta"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Category) ApplyDefaults() {
+	if s.Label == nil {
+		v := "unnamed"
+		s.Label = &v
+	}
+	if s.Parent != nil {
+		s.Parent.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Category/properties/children
+type CategoryChildren = []Category
+
+// #/components/schemas/Category/properties/byName
+type CategoryByName = map[string]any
+
+// #/components/schemas/Category/properties/meta
+type CategoryMeta struct {
+	Owner *Category `form:"owner" json:"owner"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *CategoryMeta) ApplyDefaults() {
+	if s.Owner != nil {
+		s.Owner.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Husband
+type Husband struct {
+	Wife *Wife `form:"wife" json:"wife"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Husband) ApplyDefaults() {
+	if s.Wife != nil {
+		s.Wife.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Wife
+type Wife struct {
+	Husband *Husband `form:"husband" json:"husband"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Wife) ApplyDefaults() {
+	if s.Husband != nil {
+		s.Husband.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/LinkedNode
+type LinkedNode struct {
+	ID   *string     `form:"id,omitempty" json:"id,omitempty"`
+	Next *LinkedNode `form:"next" json:"next"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *LinkedNode) ApplyDefaults() {
+	if s.Next != nil {
+		s.Next.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/NodeBase
+type NodeBase struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *NodeBase) ApplyDefaults() {
+}
+
+// #/components/schemas/Folder
+type Folder = Category
+
+// #/components/schemas/Loop
+type Loop = any
+
+// #/components/schemas/LoopBack
+type LoopBack = any
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5RUwY7TMBC99ytGW6ReSgvilhuLhDgsC9rLHtAepvFLbda1gz3ZEiH+HblJk1Rb0rSn",
+	"evzevOfncXwJx6XJ6ObD6v3q3c3MuMJnMyIxYpHRA/IqRPMCyuvcIs6IXhCi8S6jmwOhZNExoz9/Z7nf",
+	"ld7BSUwNYq6x48Nfojl9JAkA7bWPIOcVIgX8qkwAiYYJVHKAk9UB/okFWx/qhkwkdYmM/OYncmlLLVll",
+	"9MPxDsuWv6QdhJ9aUBl8iSAG8diJKKH71bF3lGDctitb3sBeRBEpFFxZyahyqa/qtho3ww5vAoqMFvN1",
+	"H9O6zWh9PO+iw+faWBXgXnvgELgeVI1gF4ewa6U29f3ZSE7iTj9Wyojxju33M7leL5xuaoLs4Kb93iE8",
+	"DfbK/xg5AE9L17ib09dKKra2ptA9ANHBV1vd+RmIN1P7pYobdmra0O5NgbExTftTx+fRFGiMPw5Y4/q6",
+	"MTtmQZ+e55KL9vjHBB9eBcfWfiuaqO6Me4a696pze9jspd6OSiXiLUcsBvjL0+PwW6YMT8JdMzv9YRo/",
+	"R3cjF3FO2qjRD076hHbitNcm12QiMQUUCHA5lsRO9cvYgryzNVmwIvFtJ3Cqi0ZoruOzt6p/L9PfyZ33",
+	"5RRWwt1y/tyz0moqczH7NwCqZarhpgYAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredRecursiveFieldsArePointers(t *testing.T) {
+	root := &Category{Name: "root"}
+	child := Category{Name: "child", Parent: root, Meta: CategoryMeta{Owner: root}}
+
+	b, err := json.Marshal(child)
+	require.NoError(t, err)
+
+	var decoded Category
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	require.NotNil(t, decoded.Parent)
+	assert.Equal(t, "root", decoded.Parent.Name)
+	assert.Nil(t, decoded.Parent.Parent)
+	require.NotNil(t, decoded.Meta.Owner)
+	assert.Equal(t, "root", decoded.Meta.Owner.Name)
+}
+
+func TestRecursiveApplyDefaults(t *testing.T) {
+	child := Category{Name: "child", Parent: &Category{Name: "root"}}
+	child.ApplyDefaults()
+	require.NotNil(t, child.Label)
+	assert.Equal(t, "unnamed", *child.Label)
+	require.NotNil(t, child.Parent.Label)
+	assert.Equal(t, "unnamed", *child.Parent.Label)
+}
+
+func TestMutuallyRecursive(t *testing.T) {
+	var h Husband
+	require.NoError(t, json.Unmarshal([]byte(`{"wife":{"husband":{"wife":null}}}`), &h))
+	require.NotNil(t, h.Wife)
+	require.NotNil(t, h.Wife.Husband)
+	assert.Nil(t, h.Wife.Husband.Wife)
+}
+
+func TestRecursiveAllOf(t *testing.T) {
+	var n LinkedNode
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"a","next":{"id":"b","next":null}}`), &n))
+	require.NotNil(t, n.Next)
+	assert.Equal(t, "b", *n.Next.ID)
+}
+
+func TestReferenceAliases(t *testing.T) {
+	folder := Folder{Name: "docs", Children: []Category{{Name: "a"}}}
+	assert.Equal(t, "a", folder.Children[0].Name)
+
+	var loop Loop = map[string]any{}
+	assert.NotNil(t, loop)
+}
@@ -0,0 +1,5 @@
+// Package schema_titles tests naming schemas after their titles with the
+// prefer-schema-titles option.
+package schema_titles
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
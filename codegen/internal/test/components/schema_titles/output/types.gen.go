@@ -0,0 +1,140 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/pet_owner_v2
+type PetOwner struct {
+	Name *string      `form:"name,omitempty" json:"name,omitempty"`
+	Home *HomeAddress `form:"home,omitempty" json:"home,omitempty"`
+	Pets []Pet        `form:"pets,omitempty" json:"pets,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PetOwner) ApplyDefaults() {
+	if s.Home != nil {
+		s.Home.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/pet_owner_v2/properties/home
+type HomeAddress struct {
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *HomeAddress) ApplyDefaults() {
+}
+
+// #/components/schemas/pet_owner_v2/properties/pets
+type PetOwnerV2Pets = []Pet
+
+// #/components/schemas/animal
+type Pet struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Clinic
+type Clinic struct {
+	Address *ClinicAddress `form:"address,omitempty" json:"address,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Clinic) ApplyDefaults() {
+	if s.Address != nil {
+		s.Address.ApplyDefaults()
+	}
+}
+
+// #/components/schemas/Clinic/properties/address
+type ClinicAddress struct {
+	Street *string `form:"street,omitempty" json:"street,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *ClinicAddress) ApplyDefaults() {
+}
+
+// #/components/schemas/vet
+type Vet struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Vet) ApplyDefaults() {
+}
+
+// #/paths//owners/get/responses/200/content/application/json/schema
+type OwnerPage struct {
+	Owners []PetOwner `form:"owners,omitempty" json:"owners,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *OwnerPage) ApplyDefaults() {
+}
+
+// #/paths//owners/get/responses/200/content/application/json/schema/properties/owners
+type GetOwners200Response = []PetOwner
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6xUu24bMRDs+RUDKYArPeJ07AI3SZMYiJDWoHljifYdSZAL2fr74EjJOr0iBPFVy33M",
+	"cGcODJHeRKcx+jL9PJ2PlPNPQStAnLTU+GVX7AwW/SljwSwKWDNlF7zGqIxEI6vcz8zCq2cqIbCk1AAI",
+	"kcmIC/57o9G6LD9L37aamGPwmXnXDoxu5/PR/gg0zDa5KIV1sSIq03TQYoMXehlOASbG1tnCPXvOwR9W",
+	"gVzWO86+b1/uiXuz5GnHJlIjPD7Tykkxpn5lccOd9t9QpfOwJiWzOVt3wu7CKPAp8UnjZjyzoYvB00ue",
+	"1Q3zLFIeCvHD+vZG7Ru02qlQQmCMu10RL9wgMbbGssHjBrJilUYBwBBSqwPh7ilVPHVRrXMaedNxf9qN",
+	"ZUnOL9/Tq3DUVCm/hY742jSJOaurPl1yyDrZHGYu3CJSslbXjDtj199MMt51pr1RAFDjU10/WNExfoQd",
+	"+gsZczHZDn8BBQB3rfPO6n9jN9WOM25VuP/3K0si5apj4/Jo9OmiCPgm9P0bhlfnM8Ka6ejnXu9Rt1f+",
+	"TWFy3iRn/LbyNgkmuokNDZf0kx5/0uNPesDkmjr1gY79GQBsnndfrwUAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
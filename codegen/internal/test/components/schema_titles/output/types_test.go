@@ -0,0 +1,39 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTitleNames checks that titled schemas are named after their titles,
+// and references to them use those names.
+func TestTitleNames(t *testing.T) {
+	name := "Ann"
+	city := "Oslo"
+	page := OwnerPage{
+		Owners: []PetOwner{{
+			Name: &name,
+			Home: &HomeAddress{City: &city},
+			Pets: PetOwnerV2Pets{Pet{Name: &name}},
+		}},
+	}
+
+	data, err := json.Marshal(page)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"owners":[{"name":"Ann","home":{"city":"Oslo"},"pets":[{"name":"Ann"}]}]}`, string(data))
+
+	var decoded OwnerPage
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, page, decoded)
+}
+
+// TestUntitledAndOverriddenNames checks that schemas without a title keep
+// their usual names, and the type name extension wins over a title.
+func TestUntitledAndOverriddenNames(t *testing.T) {
+	street := "Main St"
+	_ = Clinic{Address: &ClinicAddress{Street: &street}}
+	_ = Vet{}
+}
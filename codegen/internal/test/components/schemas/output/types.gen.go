@@ -59,7 +59,7 @@ type EnumInObjInArray = []EnumInObjInArrayItem
 
 // #/components/schemas/EnumInObjInArray/items
 type EnumInObjInArrayItem struct {
-	Val *string `form:"val,omitempty" json:"val,omitempty"`
+	Val *EnumInObjInArrayVal `form:"val,omitempty" json:"val,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -74,16 +74,35 @@ const (
 	Second EnumInObjInArrayVal = "second"
 )
 
+// Values returns the EnumInObjInArrayVal constants, in the order of the spec.
+func (EnumInObjInArrayVal) Values() []EnumInObjInArrayVal {
+	return []EnumInObjInArrayVal{First, Second}
+}
+
+// ParseEnumInObjInArrayVal returns the EnumInObjInArrayVal constant whose value is s. Other strings
+// return an error.
+func ParseEnumInObjInArrayVal(s string) (EnumInObjInArrayVal, error) {
+	switch v := EnumInObjInArrayVal(s); v {
+	case First, Second:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid EnumInObjInArrayVal value %q", s)
+}
+
 // #/components/schemas/DeprecatedProperty
 type DeprecatedProperty struct {
 	// Use this now!
-	NewProp  string  `form:"newProp" json:"newProp"`
+	NewProp string `form:"newProp" json:"newProp"`
+	// Deprecated: the OpenAPI spec marks this as deprecated.
 	OldProp1 *string `form:"oldProp1,omitempty" json:"oldProp1,omitempty"`
 	// It used to do this and that
+	//
+	// Deprecated: the OpenAPI spec marks this as deprecated.
 	OldProp2 *string `form:"oldProp2,omitempty" json:"oldProp2,omitempty"`
 	// Deprecated: Use NewProp instead!
 	OldProp3 *string `form:"oldProp3,omitempty" json:"oldProp3,omitempty"`
 	// It used to do this and that
+	//
 	// Deprecated: Use NewProp instead!
 	OldProp4 *string `form:"oldProp4,omitempty" json:"oldProp4,omitempty"`
 }
@@ -140,35 +159,34 @@ func (s *Issue1051JSONResponse2002) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+RZ3W/cNhJ/118xlxTww0Xaj8Rwozdfrle4wNlGnEMfij5wxdGKsUSq5GjXgq//+4Gk",
-	"vlder9O0PaB+yYoczsdvhvPBqBIlK0UMr95Gy2j1KhAyVXEAsENthJIxrKJltAwASFCOMXxCQ3CHeoc6",
-	"AOBoEi1KcpT/DQDAEyTMoIFUadgxLVRlQBhTuaVKclA71ECiwAhuc2QGgXEODKg9a486bkzWsKm2kIoH",
-	"5FFgnGBjFQyh0nkMGVEZLxaNHZZBxBHLgpURE0HJKHPUC5Sm0hjiDnVNmZDbUJhQY4oaZYI8dtK2SP4H",
-	"gCpRM2vXFY/BH/6uO3tlPnYnG/oZJBwamTCAkpdKSAJ8EIYMGAWUMYJEFaWSKMlAwiRsEBKNjJCDkECZ",
-	"MB0bU2ICTHKQiixdqSvZidZoSiUNmrijXy+X/QdAoiShpOESACvLXCTOxsVno+R4F8AkGRZsugpAdYkx",
-	"qM1nTOhgs9QWOBJDZQYiZf2pLnE1twfwjcY0hlevFz0uC6+EWVw2J189zXX9xVzXc1yTypAq7kgLubVE",
-	"L+f+YcLBSln4e7B4fzzgrizV++Oh9SEXKAlKpllhnFdAyERpjQnltf2dVxy5u4Uaf6ns3doLymCjeG2D",
-	"qePkWCChNlEwXenNDkGyAmNIlRpAIWQMv1So68GalSY08hhIVxgcDykfTsahFPTn0dA/FK972hEKN+5f",
-	"ljtbgkO5KctNL3gm/I8Hf6MnPMKvA5+9XS4eU5bnlGlVbbNfT3Dh22XwpAc/ostmHO6x3ivNDZhMVTn3",
-	"9xtdxrOJwGZQtsnRwd946NA/nXd6BYOhj2wmDJ5x0KF7Js5pkXi3WjyunA6ngPBuNRfIt60JTnEwxDQJ",
-	"ufUhKqtig/qEYPRaTOJxZOsLw/HYhT6/s0qaHwVl107B4Y1erS9OwGK1vjh+q//N7hFstYFKmqoslbb1",
-	"wEXwAzl/GOBKnpGNESxKgp7K7UZ/TlU4htv3KFGL5MaVjHGutVYtCqbvudr/IbJqVuS/s5whhA+/qzSO",
-	"Katy+us5tbt0356fcum+PX+mS7PFc2uFuIOwz1CCamrMos0fg+YGmEaQVZ7bxBwdK1tfWnuC09G5bvS4",
-	"7dQbQrRevl9882hIN6l6rnR47mcj7j3dwrcwV/KWUXb2PNzr5Wzn8iHD5N6ASAcqOBw5Jjnr8c3rYc29",
-	"OHTwE078TlZFw6vxIjOtU21y3IsErXTKENDS2n0hgclpM7vPRJI120ZwBJVaKqY1q/8P06s1/ErebD5f",
-	"yUur4qjPvDjlgry/eOaC/BNLjYnDMRWY973KFglY4zg7zxQo6WSERsIubZtRJU2Pyg8EuuUd07VtEnLc",
-	"YW7dwlVSWZnOnj8D/B6Z5vqN4F8tz1enJKjl+eqZtqDKSZQ5QoFcsKYPsEYyIeGHu5vrp0E/Wy+XZy8K",
-	"zJ3kkVEFulk32q3+/lXGw68yb/YesISNE/yZUZFo2Rxo0w6SbXPfrayfSS8tGWjrceOmdiZrJyEKxgN/",
-	"38gzCUIS6pQl+OjlfXhisJwZhFKlC0ZxM4s2iw+hYqUIE8VxizLEB9IsJLYduDxVKoYN8w30YXl4Epz5",
-	"+b0thEcGg/avLYnTCaxlcSl5q86LuI0a966f/3J9WhZfQZ9+uvip+/1mTgD87E4cDBFHvDEKRR9ZLuIH",
-	"I1OTGNlwZJoWhLEEV8aaFUFYDDw9e3fnn3R2LH92jvd/ttZOb3UIqdCGDlYNJqp5mDhMrEeAOgyJECTu",
-	"7cnBisr5YGXOrubMCbEwcs1/DLr3OpBq/7c+6L241UncWmMPhtTXY1HXavgNyj8UgpIIpPwD6qCRde+I",
-	"zL+pTvVa/za9RlpdEVTGNlkKuPIaMcmd7KnYt79N7EPYb4YamWnxv/ausy0bIeMHbnj3R8t9IUQ3FaG2",
-	"9cBmhkupZF2oylxJeTRDzAYxK57PZmLI+Ejdfgi3KrR7oX9wcQp9RPvBOy1vpqeeegYWfJoJvFhbI7fd",
-	"g880rQre5M7xstXhjTfE7U8nm+HY0or1RhjSwVMtqG0vS0ZZzy6YfVmafVWa9jAT6A0mlRZU31myFpvX",
-	"TWZv9jwPhIwZYBDaKyzoDewRJPrwKbonIjvKuFKwRTINM40FK0tPyWyaFhy+VyB4BHeIsKl8DLAkQWNC",
-	"Uvcox/GVEZVDczCGDTLd4eA//tW0Jn2D9MOPn0LfsDTMwTGPgtYy/782I8HwE/wc/C8AAP//A4qpbIQa",
-	"AAA=",
+	"H4sIAAAAAAAC/+RZS3PjNhK+81f0elLlww6ph8flmDevN5tSqtZ2jWcrh1QOENEUMSYBBmhKYnnz37cA",
+	"viXq4ckk2ar4Yglo9OPrRj8glaNkuQjh4iqYBrMLT8hYhR7AGrURSoYwC6bB1AMgQSmG8AkNwTPqNWoP",
+	"gKOJtMjJUf7XA4CKIGIGDcRKw5ppoQoDwpjCLRWSg1qjBhIZBvCUIjMIjHNgQM1Ze9RxY7KEZbGCWGyR",
+	"B55xgo1V0IdCpyEkRHk4mdR2WAYBR8wzlgdMeDmjxFFPUJpCo49r1CUlQq58YXyNMWqUEfLQSVshVR8A",
+	"VI6aWbsWPITq8Hft2YX52J6s6UeQcGgkwgBKnishCXArDBkwCihhBJHKciVRkoGISVgiRBoZIQchgRJh",
+	"WjYmxwiY5CAVWbpcF7IVrdHkSho0YUs/n067LwCRkoSS+ksALM9TETkbJ5+NksNdABMlmLHdVQAqcwxB",
+	"LT9jRHububbAkegr0/0xWX4qc5yN7QF8ozEO4eLdpMNlUilhJnf1yYvDXOdfzHU+xjUqDKnsmbSQK0v0",
+	"du73OxyslEl1Dya3xwNuYaluj4fWfSpQEuRMs8w4r4CQkdIaI0pL+zktOHJ3CzX+UqAh2AhKYKl4aYOp",
+	"5eRYIKE2gbe70pntg2QZhhAr1a4BCBnCLwXqsrdmpQmNPATSBXrHQ6oKJ+NQ8rrzaOgfipcd7QCFR/ef",
+	"pc4Wb19uzFKD3pHwPx78tZ7wCr/2fHY1nbzGLE0p0apYJb+e4cKrqXfQgx/RZTMOL1hulOYGTKKKlFf3",
+	"G13GAyFdBmXLFB38tYf2/dN6p1PQ6/vIZkLvhIP23bPjnAaJD7PJ68zpcA4IH2ZjgfzUmOAUB0NMk5Cr",
+	"KkRlkS1RnxGMlRY78Tiw9Y3heOxCXz9bJc2PgpIHp2D/Rs/mN2dgMZvfHL/V/2YvCLbaQCFNkedKE/Iq",
+	"grfk/GGAK3lJkGvELCfoqNxu8OdUhWO4fY8StYgeXckY5lpr1SRj+oWrzR8iq2RZ+jvL6UO4/V2lcYxZ",
+	"kdJfz6ntpfv2+pxL9+31iS7NFs8VyvogbBKUoOoaM2nyR6+5AaYRZJGmNjEHx8rWl9Ye73x0Hmo9nlr1",
+	"+hDNp7eTb14N6TpVj5WOivvlgHtHN6lamIV8YpRcnoZ7Ph3tXO4TjF4MiLingsORY5SyDt+07Nfcm30H",
+	"H3Did7LIal61F5lpnIocaCMitNIpQUBLa/eFBCZ3m9lNIqKk3jaCI6jYUjGtWfl/mF6t4Qv5uPy8kHdW",
+	"xUGfeXPOBbm9OXFB/om5xsjhGAtMu15lhQSsdpydZzKUdDZCA2F3YEgXUd2j8j2BbnnNdGmbhBTXmFq3",
+	"cBUVVqaz588Av0Omvn4D+GfT69k5CWp6PTvRFhQpiTxFyJALVvcB1kgmJPzw/PhwGPTL+XR6+abAXEse",
+	"GJWhm3WD9ezvX2U8/CrzZueB0GuOmOrMoEiE3shhAIBmkGya+3ZlfiK9NGSgrceNVcG9TlgJgTcc+LtG",
+	"nkkQklDHLMLXSt79gcFyZBCKlc4YhfUsWi9ufcVy4UeK4wqlj1vSzCe26rk8ViqEJdNuYb88HARnfH5v",
+	"CuGRwaD5a0ri7gTWsLiTvFHnTdwGjXvbz3+5Pg2Lr6BPqw381H5+PyYAfnYn9oaII94YhGIVWS7ieyNT",
+	"nRhZf2TaLQhDCa6M1SuCMOt5evTujj/prFl6co6v/mytHZIC+BALbWhv1WCk6oeJ/cR6BKj9kPBB4sae",
+	"7K2olPdWxuyqz5wRCwPX/Mege68DqTZ/64K+Ejc7i1tj7N6Q+m4o6kH1v4OqHgpBSQRS1QNqr5EVsn5p",
+	"ZAZ39Zr/Nr0GWi0ICoMcSAFXlUZMcid7V+zVbxO79btNXyMzDf4PletASEPI+J4bPvzRct8I0WNBqG09",
+	"sJnhTipZZqowCymPZojRILYvI6eMFX3GR+r21l8p3+75ji04hT6i/cJbLR93Tx16BhZ8+L0Ra2vkqn3w",
+	"2U2rgte5c7hsdXhfGeL2dyeb/tjSiK2MMKS9Qy2obS/tA1LHzht9WRp9VdrtYXagNxgVWlD5bMkabN7V",
+	"mb3eq3ggJMwAAx+EBEHvYYMgsQqfrH0ioqR6FYQVkqmZacxYnleUzKZpweF7BYIH8Ixof0xxhCyK0Bif",
+	"1AvKYXzZX1T65mAIS2S6xaH68q+6NekapB9+/ORXDUvNHBzzwGssq361GQiGn+Bn738DAAOKqWyEGgAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -66,7 +66,8 @@ func TestN5StartsWithNumberInstantiation(t *testing.T) {
 }
 
 func TestEnumInObjInArrayType(t *testing.T) {
-	item := EnumInObjInArrayItem{Val: strPtr("first")}
+	first := First
+	item := EnumInObjInArrayItem{Val: &first}
 	arr := EnumInObjInArray{item}
 	if len(arr) != 1 {
 		t.Errorf("expected 1 item, got %d", len(arr))
@@ -0,0 +1,5 @@
+// Package struct_tags_omitzero tests the omitzero struct tags mode, which tags
+// optional fields omitzero in place of omitempty.
+package struct_tags_omitzero
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,79 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Filter
+type Filter struct {
+	Name   string                               `form:"name" json:"name"`
+	Tags   []string                             `form:"tags,omitempty" json:"tags,omitzero"`
+	Labels map[string]string                    `form:"labels,omitempty" json:"labels,omitzero"`
+	Limit  *int                                 `form:"limit,omitempty" json:"limit,omitzero"`
+	Note   oapiCodegenTypesPkg.Nullable[string] `form:"note,omitempty" json:"note,omitzero"`
+	Legacy []string                             `form:"legacy,omitempty" json:"legacy,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Filter) ApplyDefaults() {
+}
+
+// #/components/schemas/Filter/properties/labels
+type FilterLabels = map[string]string
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6ySMU8DMQyF9/sVT91bgdjyA5jZEYObc69GSRwcH6Ig/ju60nJUbcXC5rzYX95LopUL",
+	"VQlY3K1uVzeLTspGQwe4eOIAzeLvbIrmNkaH09A64JWtiZaAxX6okm9bwMdnFzVXLVy8hQ5occuZ9iVw",
+	"L8nZvmvAd3XCr585+kEyfhnFuA94LJT56SBX08rmwu04C0z78+pIa25Shh958nreRGa0+6WKcz5pu0JL",
+	"tOZ0gXcSAQCo78VFC6WHC86v8yWLn+OlOA9sc3L1v5MDZUyJ1tMLuo08H8IDxd3/XQrwtlSqsoza88Bl",
+	"efwvARtKjbuvAQCD+m9hYAIAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
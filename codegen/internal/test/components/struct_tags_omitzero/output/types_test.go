@@ -0,0 +1,38 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+func TestOmitZeroOmitsUnset(t *testing.T) {
+	b, err := json.Marshal(Filter{Name: "a"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"a"}`, string(b))
+}
+
+// TestOmitZeroWritesEmpty checks that empty values which are present are
+// still written, as omitempty would drop them.
+func TestOmitZeroWritesEmpty(t *testing.T) {
+	zero := 0
+	b, err := json.Marshal(Filter{
+		Name:   "a",
+		Tags:   []string{},
+		Labels: map[string]string{},
+		Limit:  &zero,
+		Note:   types.NewNullNullable[string](),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"a","tags":[],"labels":{},"limit":0,"note":null}`, string(b))
+}
+
+func TestOmitZeroExtensionOverride(t *testing.T) {
+	b, err := json.Marshal(Filter{Name: "a", Legacy: []string{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"a"}`, string(b))
+}
@@ -0,0 +1,5 @@
+// Package tuples tests arrays with prefixItems generating structs with a field
+// per item, encoded as JSON arrays.
+package tuples
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,338 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Point
+// A position, as in GeoJSON.
+type Point struct {
+	Longitude float32
+	Latitude  float32
+	// Meters above sea level.
+	Item2 *float32
+}
+
+// items returns the items of the Point in order. Absent items at the
+// end are left out; those before a present one are null.
+func (t Point) items() []any {
+	items := []any{t.Longitude, t.Latitude, t.Item2}
+	n := 2
+	if t.Item2 != nil {
+		n = 3
+	}
+	items = items[:n]
+	return items
+}
+
+// MarshalJSON encodes the Point as a JSON array of its items.
+func (t Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the Point from a JSON array of its items.
+func (t *Point) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("expected at least 2 items, got %d", len(items))
+	}
+	if len(items) > 3 {
+		return fmt.Errorf("expected at most 3 items, got %d", len(items))
+	}
+	*t = Point{}
+	if err := json.Unmarshal(items[0], &t.Longitude); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.Latitude); err != nil {
+		return fmt.Errorf("error reading item 1: %w", err)
+	}
+	if len(items) > 2 {
+		if err := json.Unmarshal(items[2], &t.Item2); err != nil {
+			return fmt.Errorf("error reading item 2: %w", err)
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Point) ApplyDefaults() {
+}
+
+// #/components/schemas/Label
+type Label struct {
+	Item0 string
+	Item1 *Color
+}
+
+// items returns the items of the Label in order. Absent items at the
+// end are left out; those before a present one are null.
+func (t Label) items() []any {
+	items := []any{t.Item0, t.Item1}
+	n := 1
+	if t.Item1 != nil {
+		n = 2
+	}
+	items = items[:n]
+	return items
+}
+
+// MarshalJSON encodes the Label as a JSON array of its items.
+func (t Label) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the Label from a JSON array of its items.
+func (t *Label) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	if len(items) < 1 {
+		return fmt.Errorf("expected at least 1 items, got %d", len(items))
+	}
+	*t = Label{}
+	if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if len(items) > 1 {
+		if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+			return fmt.Errorf("error reading item 1: %w", err)
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Label) ApplyDefaults() {
+}
+
+// #/components/schemas/Color
+type Color string
+
+const (
+	Red   Color = "red"
+	Green Color = "green"
+	Blue  Color = "blue"
+)
+
+// Values returns the Color constants, in the order of the spec.
+func (Color) Values() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+// ParseColor returns the Color constant whose value is s. Other strings
+// return an error.
+func ParseColor(s string) (Color, error) {
+	switch v := Color(s); v {
+	case Red, Green, Blue:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
+// #/components/schemas/Path
+type Path struct {
+	Name string
+	// Rest holds the items after the others.
+	Rest []Point
+}
+
+// items returns the items of the Path in order.
+func (t Path) items() []any {
+	items := []any{t.Name}
+	for _, item := range t.Rest {
+		items = append(items, item)
+	}
+	return items
+}
+
+// MarshalJSON encodes the Path as a JSON array of its items.
+func (t Path) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the Path from a JSON array of its items.
+func (t *Path) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	if len(items) < 1 {
+		return fmt.Errorf("expected at least 1 items, got %d", len(items))
+	}
+	*t = Path{}
+	if err := json.Unmarshal(items[0], &t.Name); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if len(items) > 1 {
+		t.Rest = make([]Point, len(items)-1)
+		for i, raw := range items[1:] {
+			if err := json.Unmarshal(raw, &t.Rest[i]); err != nil {
+				return fmt.Errorf("error reading item %d: %w", 1+i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Path) ApplyDefaults() {
+}
+
+// #/components/schemas/Feature
+type Feature struct {
+	At    Point        `form:"at" json:"at"`
+	Span  *FeatureSpan `form:"span,omitempty" json:"span,omitempty"`
+	Route []Point      `form:"route,omitempty" json:"route,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Feature) ApplyDefaults() {
+}
+
+// #/components/schemas/Feature/properties/span
+type FeatureSpan struct {
+	From int
+	To   int
+}
+
+// items returns the items of the FeatureSpan in order.
+func (t FeatureSpan) items() []any {
+	items := []any{t.From, t.To}
+	return items
+}
+
+// MarshalJSON encodes the FeatureSpan as a JSON array of its items.
+func (t FeatureSpan) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the FeatureSpan from a JSON array of its items.
+func (t *FeatureSpan) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	if len(items) < 2 {
+		return fmt.Errorf("expected at least 2 items, got %d", len(items))
+	}
+	*t = FeatureSpan{}
+	if err := json.Unmarshal(items[0], &t.From); err != nil {
+		return fmt.Errorf("error reading item 0: %w", err)
+	}
+	if err := json.Unmarshal(items[1], &t.To); err != nil {
+		return fmt.Errorf("error reading item 1: %w", err)
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *FeatureSpan) ApplyDefaults() {
+}
+
+// #/components/schemas/Feature/properties/route
+type FeatureRoute = []Point
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7RSy47UQAy85yusBWkvQ2YGbn1DSCAQLwluqz10ZioZo067cTsjVoh/R5NE2UTLIxzI",
+	"ySm53FVlS0L0iR1dPSv35e6q4FiLK4jO0MwSHe3LXbkriIwtwNHnLgXkInk7ZUfffxQHaZNERMsXWj6c",
+	"0Pq+JPooHG0oiY7IB+Vk/dDnlCTzpd6Qz8SRXkHefPrwvhy77S7BkVf1dyPScnxtaLOjpyPCw2/tQ8YI",
+	"JUXN34a+ESJ6Mk6LXVtBJ3iyFCQ2bN0R6xneVhIWrt/BoJl8JWdQhqeAM8Jg+a2vENwK9/s1VrMpx2YG",
+	"P1bUjq4fbe/XtR13tX0hQfS6b+7LpYrFKMSudXSjOG6oUSBuqAodbod1ezv9LwdT9NG3WOx/avmTxf4S",
+	"B4sv4a1TLIVK9QUHGyHF144VR0c33m4nsZKgxpi96O2+Xv3+5cvJxzn1YVq/vvjfpjZPjqOhWRzhLL5a",
+	"pf13lsmEq3SGv2vnh/pW5PNzAKb4CmCOBAAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
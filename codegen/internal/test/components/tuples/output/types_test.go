@@ -0,0 +1,64 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTuplesJSON(t *testing.T) {
+	feature := Feature{
+		At:    Point{Longitude: 13.4, Latitude: 52.5},
+		Span:  &FeatureSpan{From: 1, To: 5},
+		Route: []Point{{Longitude: 1, Latitude: 2, Item2: ptr(float32(30))}},
+	}
+	data, err := json.Marshal(feature)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"at":[13.4,52.5],"span":[1,5],"route":[[1,2,30]]}`, string(data))
+
+	var decoded Feature
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, feature, decoded)
+}
+
+func TestTuplesOptionalItems(t *testing.T) {
+	data, err := json.Marshal(Label{Item0: "sky"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `["sky"]`, string(data), "absent optional items are left out")
+
+	var label Label
+	require.NoError(t, json.Unmarshal([]byte(`["sky","blue"]`), &label))
+	require.NotNil(t, label.Item1)
+	assert.Equal(t, Blue, *label.Item1)
+
+	require.NoError(t, json.Unmarshal([]byte(`["sea"]`), &label))
+	assert.Equal(t, Label{Item0: "sea"}, label, "decoding replaces earlier items")
+}
+
+func TestTuplesRestItems(t *testing.T) {
+	path := Path{Name: "walk", Rest: []Point{{Longitude: 1, Latitude: 2}, {Longitude: 3, Latitude: 4}}}
+	data, err := json.Marshal(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["walk",[1,2],[3,4]]`, string(data))
+
+	var decoded Path
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, path, decoded)
+}
+
+func TestTuplesRejectInvalidArrays(t *testing.T) {
+	var point Point
+	assert.ErrorContains(t, json.Unmarshal([]byte(`[1]`), &point), "at least 2 items")
+	assert.ErrorContains(t, json.Unmarshal([]byte(`[1,2,3,4]`), &point), "at most 3 items", "items: false")
+	assert.ErrorContains(t, json.Unmarshal([]byte(`[1,"north"]`), &point), "item 1")
+	assert.Error(t, json.Unmarshal([]byte(`{"longitude":1}`), &point))
+
+	var path Path
+	assert.ErrorContains(t, json.Unmarshal([]byte(`["walk",[1,2],[3]]`), &path), "item 2")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,5 @@
+// Package unique_item_sets tests the unique-item-sets generation option, which
+// generates Set[T] instead of slices for arrays with uniqueItems: true.
+package unique_item_sets
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,475 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Tags     Set[string] `form:"tags" json:"tags"`
+	Scores   Set[int]    `form:"scores,omitempty" json:"scores,omitempty,omitzero"`
+	Statuses Set[Status] `form:"statuses,omitempty" json:"statuses,omitempty,omitzero"`
+	Owners   []Owner     `form:"owners,omitempty" json:"owners,omitempty"`
+	Aliases  []string    `form:"aliases,omitempty" json:"aliases,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/Pet/properties/statuses
+type PetStatuses = Set[Status]
+
+// #/components/schemas/Pet/properties/owners
+type PetOwners = []Owner
+
+// #/components/schemas/Status
+type Status string
+
+const (
+	Available Status = "available"
+	Pending   Status = "pending"
+	Sold      Status = "sold"
+)
+
+// Values returns the Status constants, in the order of the spec.
+func (Status) Values() []Status {
+	return []Status{Available, Pending, Sold}
+}
+
+// ParseStatus returns the Status constant whose value is s. Other strings
+// return an error.
+func ParseStatus(s string) (Status, error) {
+	switch v := Status(s); v {
+	case Available, Pending, Sold:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Status value %q", s)
+}
+
+// #/components/schemas/Owner
+type Owner struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Owner) ApplyDefaults() {
+}
+
+// #/components/schemas/Colors
+type Colors = Set[string]
+
+// #/components/schemas/Listing
+type Listing struct {
+	Labels        Set[string]    `form:"labels" json:"labels"`
+	Ids           Set[int]       `form:"ids,omitempty" json:"ids,omitempty,omitzero"`
+	ListingAllOf1 *ListingAllOf1 `json:"-"`
+}
+
+func (s Listing) MarshalJSON() ([]byte, error) {
+	result := make(map[string]any)
+
+	if !s.Labels.IsZero() {
+		result["labels"] = s.Labels
+	}
+	if !s.Ids.IsZero() {
+		result["ids"] = s.Ids
+	}
+
+	if s.ListingAllOf1 != nil {
+		unionData, err := json.Marshal(s.ListingAllOf1)
+		if err != nil {
+			return nil, err
+		}
+		var unionMap map[string]any
+		if err := json.Unmarshal(unionData, &unionMap); err == nil {
+			for k, v := range unionMap {
+				result[k] = v
+			}
+		}
+	}
+
+	return json.Marshal(result)
+}
+
+func (s *Listing) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if v, ok := raw["labels"]; ok {
+		if err := json.Unmarshal(v, &s.Labels); err != nil {
+			return err
+		}
+	}
+	if v, ok := raw["ids"]; ok {
+		if err := json.Unmarshal(v, &s.Ids); err != nil {
+			return err
+		}
+	}
+
+	var ListingAllOf1Val ListingAllOf1
+	if err := json.Unmarshal(data, &ListingAllOf1Val); err != nil {
+		return err
+	}
+	s.ListingAllOf1 = &ListingAllOf1Val
+
+	return nil
+}
+
+// AsOwner returns the ListingAllOf1 union data inside the Listing as a Owner.
+func (s Listing) AsOwner() (Owner, error) {
+	var union ListingAllOf1
+	if s.ListingAllOf1 != nil {
+		union = *s.ListingAllOf1
+	}
+	return union.AsOwner()
+}
+
+// FromOwner overwrites the ListingAllOf1 union data inside the Listing as the provided Owner.
+func (s *Listing) FromOwner(v Owner) error {
+	if s.ListingAllOf1 == nil {
+		s.ListingAllOf1 = new(ListingAllOf1)
+	}
+	return s.ListingAllOf1.FromOwner(v)
+}
+
+// MergeOwner performs a merge with the ListingAllOf1 union data inside the Listing, using the provided Owner.
+func (s *Listing) MergeOwner(v Owner) error {
+	if s.ListingAllOf1 == nil {
+		s.ListingAllOf1 = new(ListingAllOf1)
+	}
+	return s.ListingAllOf1.MergeOwner(v)
+}
+
+// AsPet returns the ListingAllOf1 union data inside the Listing as a Pet.
+func (s Listing) AsPet() (Pet, error) {
+	var union ListingAllOf1
+	if s.ListingAllOf1 != nil {
+		union = *s.ListingAllOf1
+	}
+	return union.AsPet()
+}
+
+// FromPet overwrites the ListingAllOf1 union data inside the Listing as the provided Pet.
+func (s *Listing) FromPet(v Pet) error {
+	if s.ListingAllOf1 == nil {
+		s.ListingAllOf1 = new(ListingAllOf1)
+	}
+	return s.ListingAllOf1.FromPet(v)
+}
+
+// MergePet performs a merge with the ListingAllOf1 union data inside the Listing, using the provided Pet.
+func (s *Listing) MergePet(v Pet) error {
+	if s.ListingAllOf1 == nil {
+		s.ListingAllOf1 = new(ListingAllOf1)
+	}
+	return s.ListingAllOf1.MergePet(v)
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Listing) ApplyDefaults() {
+}
+
+// #/components/schemas/Listing/allOf/1
+
+type ListingAllOf1 struct {
+	union json.RawMessage
+}
+
+// AsOwner returns the union data inside the ListingAllOf1 as a Owner.
+func (t ListingAllOf1) AsOwner() (Owner, error) {
+	var body Owner
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOwner overwrites any union data inside the ListingAllOf1 as the provided Owner.
+func (t *ListingAllOf1) FromOwner(v Owner) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOwner performs a merge with any union data inside the ListingAllOf1, using the provided Owner.
+func (t *ListingAllOf1) MergeOwner(v Owner) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPet returns the union data inside the ListingAllOf1 as a Pet.
+func (t ListingAllOf1) AsPet() (Pet, error) {
+	var body Pet
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPet overwrites any union data inside the ListingAllOf1 as the provided Pet.
+func (t *ListingAllOf1) FromPet(v Pet) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePet performs a merge with any union data inside the ListingAllOf1, using the provided Pet.
+func (t *ListingAllOf1) MergePet(v Pet) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	merged, err := JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ListingAllOf1) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *ListingAllOf1) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (t *ListingAllOf1) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7xTwW7bMAy96yseugG5tEmK3XTdacCAFhh2CnpgbNrlIEuqRHcohv37UDuuPTdOO2zr",
+	"TXqU+B7JxxDZUxSLsw/ry/X2zIivgjXAPacswVtcrrfrrQFU1LHFVy93LUOUG2TWbCLpbbb48dMUoYnB",
+	"s9dsDZCLW26oOwLXrP0B0IfIFmH/jQs9QInvWklcWuyU6nxzgGMKkZMK5+Ev8Bgfb0M2SokeJmjbifyk",
+	"3GQLTS1PYtKhE2BIkjWJr58CuQiJ/xeXeOWa00impG3+W7qSK2qdWuxuTot4n7iyWL3bjCPbHOa1+dJJ",
+	"WT29D989p3/fh1MSrh4pRwXkhF7Vm9eMti/PmoUwwL5tLHZ0T+Jo7/gckX0pvj5HDq7sO9spPOHoY9b1",
+	"1PDzGibcH4MLaSZtWuJSh2dlP8v7WbKKr4cX5NxVNVyAi2P6Z1vpaM8uT011rEAA6F/+ji2N6yXXLIx0",
+	"cWMBQMq3Ip+v8AWC52lje/APfP7yh2vWlfk1AArOLF+zBQAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ErrDuplicateSetItem is the sentinel error wrapped by the errors of
+// Set.UnmarshalJSON when an array holds an item twice.
+var ErrDuplicateSetItem = errors.New("set: duplicate item")
+
+// Set is an ordered set of unique items, for arrays with uniqueItems: true.
+// Items keep the order they're added in, and marshal to a JSON array in that
+// order. Unmarshaling rejects arrays which hold an item twice. The zero value
+// is an empty set, ready to use.
+type Set[T comparable] struct {
+	items []T
+	index map[T]struct{}
+}
+
+// Add adds item unless the set has it already, and reports whether it did.
+func (s *Set[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+	return true
+}
+
+// Remove removes item, and reports whether the set had it.
+func (s *Set[T]) Remove(item T) bool {
+	if !s.Has(item) {
+		return false
+	}
+	delete(s.index, item)
+	s.items = slices.DeleteFunc(s.items, func(v T) bool { return v == item })
+	return true
+}
+
+// Has reports whether the set holds item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of the items, in order.
+func (s Set[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// All returns an iterator over the items, in order.
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// IsZero reports whether the set is empty, so fields tagged omitzero are
+// omitted then.
+func (s Set[T]) IsZero() bool {
+	return len(s.items) == 0
+}
+
+// MarshalJSON implements json.Marshaler, writing the items as an array, [] when
+// there are none.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an array of unique items.
+// null leaves the set empty.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// setItems replaces the items of s with items, unless they hold duplicates.
+func (s *Set[T]) setItems(items []T) error {
+	var set Set[T]
+	for i, item := range items {
+		if !set.Add(item) {
+			return fmt.Errorf("%w at index %d: %v", ErrDuplicateSetItem, i, item)
+		}
+	}
+	*s = set
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, writing
+// the items as a sequence.
+func (s Set[T]) MarshalYAML() (any, error) {
+	if s.items == nil {
+		return []T{}, nil
+	}
+	return s.items, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, rejecting duplicates like UnmarshalJSON.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+// JSONMerge merges two JSON-encoded objects. Fields from patch override
+// fields in base. Both arguments must be valid JSON objects (or nil/null).
+func JSONMerge(base, patch json.RawMessage) (json.RawMessage, error) {
+	if len(base) == 0 || string(base) == "null" {
+		return patch, nil
+	}
+	if len(patch) == 0 || string(patch) == "null" {
+		return base, nil
+	}
+
+	var baseMap map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling base: %w", err)
+	}
+
+	var patchMap map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling patch: %w", err)
+	}
+
+	for k, v := range patchMap {
+		baseMap[k] = v
+	}
+
+	return json.Marshal(baseMap)
+}
@@ -0,0 +1,57 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniqueItemSetsJSON(t *testing.T) {
+	var pet Pet
+	pet.Tags.Add("fluffy")
+	pet.Tags.Add("good")
+	assert.False(t, pet.Tags.Add("fluffy"))
+
+	data, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["fluffy","good"]}`, string(data), "empty optional sets are omitted")
+
+	var decoded Pet
+	require.NoError(t, json.Unmarshal([]byte(`{"tags":["b","a"],"scores":[3,1],"statuses":["sold"]}`), &decoded))
+	assert.Equal(t, []string{"b", "a"}, decoded.Tags.Items())
+	assert.Equal(t, []int{3, 1}, decoded.Scores.Items())
+	assert.True(t, decoded.Statuses.Has(Sold))
+}
+
+func TestUniqueItemSetsRejectDuplicates(t *testing.T) {
+	var pet Pet
+	err := json.Unmarshal([]byte(`{"tags":["a"],"statuses":["sold","pending","sold"]}`), &pet)
+	require.ErrorIs(t, err, ErrDuplicateSetItem)
+	assert.Contains(t, err.Error(), "index 2")
+
+	var colors Colors
+	assert.ErrorIs(t, json.Unmarshal([]byte(`["red","red"]`), &colors), ErrDuplicateSetItem)
+}
+
+func TestUniqueItemSetsKeepSlicesForObjects(t *testing.T) {
+	// Owner isn't comparable, so owners stays a slice, as does aliases
+	// without uniqueItems.
+	pet := Pet{Owners: []Owner{{}, {}}, Aliases: []string{"x", "x"}}
+	data, err := json.Marshal(pet)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":[],"owners":[{},{}],"aliases":["x","x"]}`, string(data))
+}
+
+func TestUniqueItemSetsInAllOf(t *testing.T) {
+	var listing Listing
+	require.NoError(t, json.Unmarshal([]byte(`{"labels":["new"],"name":"Ada"}`), &listing))
+	assert.True(t, listing.Labels.Has("new"))
+
+	data, err := json.Marshal(listing)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"labels":["new"],"name":"Ada"}`, string(data))
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"ids":[1,1]}`), &listing), ErrDuplicateSetItem)
+}
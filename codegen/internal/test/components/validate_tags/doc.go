@@ -0,0 +1,5 @@
+// Package validate_tags tests the validate struct tags of go-playground/validator
+// derived from schema constraints.
+package validate_tags
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,109 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Account
+type Account struct {
+	Name     string                               `form:"name" json:"name" validate:"required,min=1,max=64"`
+	Age      int                                  `form:"age" json:"age" validate:"gte=0,lt=150"`
+	Active   bool                                 `form:"active" json:"active"`
+	Email    oapiCodegenTypesPkg.Email            `form:"email" json:"email" validate:"required,email"`
+	Contact  string                               `form:"contact,omitempty" json:"contact,omitempty"`
+	Status   Status                               `form:"status" json:"status" validate:"required,oneof=active suspended"`
+	Code     *string                              `form:"code,omitempty" json:"code,omitempty" validate:"omitempty,len=4"`
+	Score    *float32                             `form:"score,omitempty" json:"score,omitempty" validate:"omitempty,gte=0.5"`
+	Tags     []string                             `form:"tags,omitempty" json:"tags,omitempty" validate:"omitempty,min=1,max=3,unique"`
+	Nickname oapiCodegenTypesPkg.Nullable[string] `form:"nickname,omitempty" json:"nickname,omitempty"`
+	Homepage *oapiCodegenTypesPkg.URI             `form:"homepage,omitempty" json:"homepage,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Account) ApplyDefaults() {
+}
+
+type AccountContact = string
+
+// #/components/schemas/Status
+type Status string
+
+const (
+	Active    Status = "active"
+	Suspended Status = "suspended"
+)
+
+// Values returns the Status constants, in the order of the spec.
+func (Status) Values() []Status {
+	return []Status{Active, Suspended}
+}
+
+// ParseStatus returns the Status constant whose value is s. Other strings
+// return an error.
+func ParseStatus(s string) (Status, error) {
+	switch v := Status(s); v {
+	case Active, Suspended:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Status value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5yTT4/TMBDF7/kUTwVpL/2z1e4i5BtHJDjtserBdabpQDzO2uOqK8R3R03aNKVZBFyq",
+	"+jfznv/MS2hIbMMGk4f5cn4/KVi2wRSAstZksLc1l1YJSWN2CrVVKoA9xcRBDCatqLG6SwY/fhYu+CYI",
+	"iSZTAMntyNv2L/DJuZBFuwWgrw0ZhM03cnpCkV4yRyoNVmI9TWGr449T3tMU5C3XUyS1mtO6AACgiaGh",
+	"qEzp7AsctZfVeaekkaUaYM/yhaTSncFyiO3hjD889txWI5YsShXFa0/22RvcDyAdXJ0T7+mrPXTV5dOl",
+	"3l3v1nwTQk1Wet5e/y+utQ3RWzVdf89dELVO/1sPHGbBNjxzoaSKZHZUz8KeYuTy1qYb0nC395G2Bnfv",
+	"FpeELE7xWDy33XeDw5b/OMDH8QFecHIhjnhK9ps3Bjh/6vEx9LdaG6N9HVBW8ldtfzr457b59+Cd6MOA",
+	"ZuGXTKeCxkx9Tdh9H4/6qttxionkup6sxx/nY493wVMzmvA305EjFwDwfDXoERHJ8TVX54845dSQlFSu",
+	"i18DACe7dCJ7BAAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,50 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validAccount() Account {
+	return Account{Name: "ann", Age: 30, Email: "ann@example.com", Status: Active}
+}
+
+func TestValidateTagsAccept(t *testing.T) {
+	validate := validator.New()
+	require.NoError(t, validate.Struct(validAccount()))
+
+	// Optional fields are only checked when set.
+	account := validAccount()
+	code := "abcd"
+	account.Code = &code
+	account.Tags = []string{"a", "b"}
+	require.NoError(t, validate.Struct(account))
+}
+
+func TestValidateTagsReject(t *testing.T) {
+	validate := validator.New()
+	short := "abc"
+	tests := map[string]func(*Account){
+		"required":  func(a *Account) { a.Name = "" },
+		"maxLength": func(a *Account) { a.Name = "0123456789012345678901234567890123456789012345678901234567890123456789" },
+		"exclusive": func(a *Account) { a.Age = 150 },
+		"minimum":   func(a *Account) { a.Age = -1 },
+		"format":    func(a *Account) { a.Email = "ann" },
+		"enum":      func(a *Account) { a.Status = "deleted" },
+		"length":    func(a *Account) { a.Code = &short },
+		"unique":    func(a *Account) { a.Tags = []string{"a", "a"} },
+		"maxItems":  func(a *Account) { a.Tags = []string{"a", "b", "c", "d"} },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			account := validAccount()
+			modify(&account)
+			var errs validator.ValidationErrors
+			require.ErrorAs(t, validate.Struct(account), &errs)
+			assert.Len(t, errs, 1)
+		})
+	}
+}
@@ -19,25 +19,25 @@ import (
 
 // #/components/schemas/AllTypesRequired
 type AllTypesRequired struct {
-	IntField      int                       `form:"intField" json:"intField"`
-	Int32Field    int32                     `form:"int32Field" json:"int32Field"`
-	Int64Field    int64                     `form:"int64Field" json:"int64Field"`
-	FloatField    float32                   `form:"floatField" json:"floatField"`
-	DoubleField   float64                   `form:"doubleField" json:"doubleField"`
-	NumberField   float32                   `form:"numberField" json:"numberField"`
-	StringField   string                    `form:"stringField" json:"stringField"`
-	BoolField     bool                      `form:"boolField" json:"boolField"`
-	DateField     oapiCodegenTypesPkg.Date  `form:"dateField" json:"dateField"`
-	DateTimeField time.Time                 `form:"dateTimeField" json:"dateTimeField"`
-	UUIDField     oapiCodegenTypesPkg.UUID  `form:"uuidField" json:"uuidField"`
-	EmailField    oapiCodegenTypesPkg.Email `form:"emailField" json:"emailField"`
-	URIField      string                    `form:"uriField" json:"uriField"`
-	HostnameField string                    `form:"hostnameField" json:"hostnameField"`
-	Ipv4Field     string                    `form:"ipv4Field" json:"ipv4Field"`
-	Ipv6Field     string                    `form:"ipv6Field" json:"ipv6Field"`
-	ByteField     []byte                    `form:"byteField" json:"byteField"`
-	BinaryField   oapiCodegenTypesPkg.File  `form:"binaryField" json:"binaryField"`
-	PasswordField string                    `form:"passwordField" json:"passwordField"`
+	IntField      int                             `form:"intField" json:"intField"`
+	Int32Field    int32                           `form:"int32Field" json:"int32Field"`
+	Int64Field    int64                           `form:"int64Field" json:"int64Field"`
+	FloatField    float32                         `form:"floatField" json:"floatField"`
+	DoubleField   float64                         `form:"doubleField" json:"doubleField"`
+	NumberField   float32                         `form:"numberField" json:"numberField"`
+	StringField   string                          `form:"stringField" json:"stringField"`
+	BoolField     bool                            `form:"boolField" json:"boolField"`
+	DateField     oapiCodegenTypesPkg.Date        `form:"dateField" json:"dateField"`
+	DateTimeField time.Time                       `form:"dateTimeField" json:"dateTimeField"`
+	UUIDField     oapiCodegenTypesPkg.UUID        `form:"uuidField" json:"uuidField"`
+	EmailField    oapiCodegenTypesPkg.Email       `form:"emailField" json:"emailField"`
+	URIField      oapiCodegenTypesPkg.URI         `form:"uriField" json:"uriField"`
+	HostnameField string                          `form:"hostnameField" json:"hostnameField"`
+	Ipv4Field     oapiCodegenTypesPkg.IPv4        `form:"ipv4Field" json:"ipv4Field"`
+	Ipv6Field     oapiCodegenTypesPkg.IPv6        `form:"ipv6Field" json:"ipv6Field"`
+	ByteField     oapiCodegenTypesPkg.Base64Bytes `form:"byteField" json:"byteField"`
+	BinaryField   oapiCodegenTypesPkg.File        `form:"binaryField" json:"binaryField"`
+	PasswordField string                          `form:"passwordField" json:"passwordField"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -357,6 +357,21 @@ const (
 	Value3 StringEnum = "value3"
 )
 
+// Values returns the StringEnum constants, in the order of the spec.
+func (StringEnum) Values() []StringEnum {
+	return []StringEnum{Value1, Value2, Value3}
+}
+
+// ParseStringEnum returns the StringEnum constant whose value is s. Other strings
+// return an error.
+func ParseStringEnum(s string) (StringEnum, error) {
+	switch v := StringEnum(s); v {
+	case Value1, Value2, Value3:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid StringEnum value %q", s)
+}
+
 // #/components/schemas/IntegerEnum
 type IntegerEnum int
 
@@ -366,10 +381,43 @@ const (
 	IntegerEnumN3 IntegerEnum = 3
 )
 
+// Values returns the IntegerEnum constants, in the order of the spec.
+func (IntegerEnum) Values() []IntegerEnum {
+	return []IntegerEnum{IntegerEnumN1, IntegerEnumN2, IntegerEnumN3}
+}
+
+// String returns the name of the IntegerEnum constant equal to e, or the
+// type and number for values not in the enum.
+func (e IntegerEnum) String() string {
+	switch e {
+	case IntegerEnumN1:
+		return "IntegerEnumN1"
+	case IntegerEnumN2:
+		return "IntegerEnumN2"
+	case IntegerEnumN3:
+		return "IntegerEnumN3"
+	}
+	return fmt.Sprintf("IntegerEnum(%d)", int(e))
+}
+
+// ParseIntegerEnum returns the IntegerEnum constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseIntegerEnum(s string) (IntegerEnum, error) {
+	switch s {
+	case "IntegerEnumN1", "1":
+		return IntegerEnumN1, nil
+	case "IntegerEnumN2", "2":
+		return IntegerEnumN2, nil
+	case "IntegerEnumN3", "3":
+		return IntegerEnumN3, nil
+	}
+	return 0, fmt.Errorf("invalid IntegerEnum value %q", s)
+}
+
 // #/components/schemas/ObjectWithEnum
 type ObjectWithEnum struct {
-	Status   *string `form:"status,omitempty" json:"status,omitempty"`
-	Priority *int    `form:"priority,omitempty" json:"priority,omitempty"`
+	Status   *ObjectWithEnumStatus   `form:"status,omitempty" json:"status,omitempty"`
+	Priority *ObjectWithEnumPriority `form:"priority,omitempty" json:"priority,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -385,6 +433,21 @@ const (
 	Completed ObjectWithEnumStatus = "completed"
 )
 
+// Values returns the ObjectWithEnumStatus constants, in the order of the spec.
+func (ObjectWithEnumStatus) Values() []ObjectWithEnumStatus {
+	return []ObjectWithEnumStatus{Pending, Active, Completed}
+}
+
+// ParseObjectWithEnumStatus returns the ObjectWithEnumStatus constant whose value is s. Other strings
+// return an error.
+func ParseObjectWithEnumStatus(s string) (ObjectWithEnumStatus, error) {
+	switch v := ObjectWithEnumStatus(s); v {
+	case Pending, Active, Completed:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ObjectWithEnumStatus value %q", s)
+}
+
 // #/components/schemas/ObjectWithEnum/properties/priority
 type ObjectWithEnumPriority int
 
@@ -394,9 +457,42 @@ const (
 	ObjectWithEnumPriorityN3 ObjectWithEnumPriority = 3
 )
 
+// Values returns the ObjectWithEnumPriority constants, in the order of the spec.
+func (ObjectWithEnumPriority) Values() []ObjectWithEnumPriority {
+	return []ObjectWithEnumPriority{ObjectWithEnumPriorityN1, ObjectWithEnumPriorityN2, ObjectWithEnumPriorityN3}
+}
+
+// String returns the name of the ObjectWithEnumPriority constant equal to e, or the
+// type and number for values not in the enum.
+func (e ObjectWithEnumPriority) String() string {
+	switch e {
+	case ObjectWithEnumPriorityN1:
+		return "ObjectWithEnumPriorityN1"
+	case ObjectWithEnumPriorityN2:
+		return "ObjectWithEnumPriorityN2"
+	case ObjectWithEnumPriorityN3:
+		return "ObjectWithEnumPriorityN3"
+	}
+	return fmt.Sprintf("ObjectWithEnumPriority(%d)", int(e))
+}
+
+// ParseObjectWithEnumPriority returns the ObjectWithEnumPriority constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseObjectWithEnumPriority(s string) (ObjectWithEnumPriority, error) {
+	switch s {
+	case "ObjectWithEnumPriorityN1", "1":
+		return ObjectWithEnumPriorityN1, nil
+	case "ObjectWithEnumPriorityN2", "2":
+		return ObjectWithEnumPriorityN2, nil
+	case "ObjectWithEnumPriorityN3", "3":
+		return ObjectWithEnumPriorityN3, nil
+	}
+	return 0, fmt.Errorf("invalid ObjectWithEnumPriority value %q", s)
+}
+
 // #/components/schemas/InlineEnumInProperty
 type InlineEnumInProperty struct {
-	InlineStatus *string `form:"inlineStatus,omitempty" json:"inlineStatus,omitempty"`
+	InlineStatus *InlineEnumInPropertyInlineStatus `form:"inlineStatus,omitempty" json:"inlineStatus,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -411,6 +507,21 @@ const (
 	Off InlineEnumInPropertyInlineStatus = "off"
 )
 
+// Values returns the InlineEnumInPropertyInlineStatus constants, in the order of the spec.
+func (InlineEnumInPropertyInlineStatus) Values() []InlineEnumInPropertyInlineStatus {
+	return []InlineEnumInPropertyInlineStatus{On, Off}
+}
+
+// ParseInlineEnumInPropertyInlineStatus returns the InlineEnumInPropertyInlineStatus constant whose value is s. Other strings
+// return an error.
+func ParseInlineEnumInPropertyInlineStatus(s string) (InlineEnumInPropertyInlineStatus, error) {
+	switch v := InlineEnumInPropertyInlineStatus(s); v {
+	case On, Off:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid InlineEnumInPropertyInlineStatus value %q", s)
+}
+
 // #/components/schemas/BaseProperties
 type BaseProperties struct {
 	ID        *int       `form:"id,omitempty" json:"id,omitempty"`
@@ -471,7 +582,8 @@ func (s *AllOfInlineOnly) ApplyDefaults() {
 // #/components/schemas/AnyOfPrimitives
 
 type AnyOfPrimitives struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsString0 returns the union data inside the AnyOfPrimitives as a string.
@@ -485,6 +597,7 @@ func (t AnyOfPrimitives) AsString0() (string, error) {
 func (t *AnyOfPrimitives) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -496,6 +609,7 @@ func (t *AnyOfPrimitives) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -510,6 +624,7 @@ func (t AnyOfPrimitives) AsInt1() (int, error) {
 func (t *AnyOfPrimitives) FromInt1(v int) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -521,9 +636,46 @@ func (t *AnyOfPrimitives) MergeInt1(v int) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *AnyOfPrimitives) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsInt1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t AnyOfPrimitives) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesInt1 reports whether the union data matches int.
+func (t AnyOfPrimitives) MatchesInt1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t AnyOfPrimitives) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t AnyOfPrimitives) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -531,6 +683,7 @@ func (t AnyOfPrimitives) MarshalJSON() ([]byte, error) {
 
 func (t *AnyOfPrimitives) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -541,7 +694,8 @@ func (t *AnyOfPrimitives) ApplyDefaults() {
 // #/components/schemas/AnyOfObjects
 
 type AnyOfObjects struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsSimpleObject returns the union data inside the AnyOfObjects as a SimpleObject.
@@ -555,6 +709,7 @@ func (t AnyOfObjects) AsSimpleObject() (SimpleObject, error) {
 func (t *AnyOfObjects) FromSimpleObject(v SimpleObject) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -566,6 +721,7 @@ func (t *AnyOfObjects) MergeSimpleObject(v SimpleObject) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -580,6 +736,7 @@ func (t AnyOfObjects) AsBaseProperties() (BaseProperties, error) {
 func (t *AnyOfObjects) FromBaseProperties(v BaseProperties) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -591,9 +748,46 @@ func (t *AnyOfObjects) MergeBaseProperties(v BaseProperties) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *AnyOfObjects) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsSimpleObject(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "id") {
+		t.matched[0] = true
+	}
+	if _, err := t.AsBaseProperties(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesSimpleObject reports whether the union data matches SimpleObject.
+func (t AnyOfObjects) MatchesSimpleObject() bool {
+	return t.matched[0]
+}
+
+// MatchesBaseProperties reports whether the union data matches BaseProperties.
+func (t AnyOfObjects) MatchesBaseProperties() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t AnyOfObjects) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t AnyOfObjects) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -601,6 +795,7 @@ func (t AnyOfObjects) MarshalJSON() ([]byte, error) {
 
 func (t *AnyOfObjects) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -611,7 +806,8 @@ func (t *AnyOfObjects) ApplyDefaults() {
 // #/components/schemas/AnyOfMixed
 
 type AnyOfMixed struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [3]bool
 }
 
 // AsString0 returns the union data inside the AnyOfMixed as a string.
@@ -625,6 +821,7 @@ func (t AnyOfMixed) AsString0() (string, error) {
 func (t *AnyOfMixed) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -636,6 +833,7 @@ func (t *AnyOfMixed) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -650,6 +848,7 @@ func (t AnyOfMixed) AsSimpleObject() (SimpleObject, error) {
 func (t *AnyOfMixed) FromSimpleObject(v SimpleObject) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -661,6 +860,7 @@ func (t *AnyOfMixed) MergeSimpleObject(v SimpleObject) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -675,6 +875,7 @@ func (t AnyOfMixed) AsAnyOfMixedAnyOf2() (AnyOfMixedAnyOf2, error) {
 func (t *AnyOfMixed) FromAnyOfMixedAnyOf2(v AnyOfMixedAnyOf2) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -686,9 +887,54 @@ func (t *AnyOfMixed) MergeAnyOfMixedAnyOf2(v AnyOfMixedAnyOf2) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *AnyOfMixed) match() {
+	t.matched = [3]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsSimpleObject(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "id") {
+		t.matched[1] = true
+	}
+	if _, err := t.AsAnyOfMixedAnyOf2(); err == nil {
+		t.matched[2] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t AnyOfMixed) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesSimpleObject reports whether the union data matches SimpleObject.
+func (t AnyOfMixed) MatchesSimpleObject() bool {
+	return t.matched[1]
+}
+
+// MatchesAnyOfMixedAnyOf2 reports whether the union data matches AnyOfMixedAnyOf2.
+func (t AnyOfMixed) MatchesAnyOfMixedAnyOf2() bool {
+	return t.matched[2]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t AnyOfMixed) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t AnyOfMixed) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -696,6 +942,7 @@ func (t AnyOfMixed) MarshalJSON() ([]byte, error) {
 
 func (t *AnyOfMixed) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -727,7 +974,8 @@ func (s *ObjectWithAnyOfProperty) ApplyDefaults() {
 // #/components/schemas/ObjectWithAnyOfProperty/properties/value
 
 type ObjectWithAnyOfPropertyValue struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [3]bool
 }
 
 // AsString0 returns the union data inside the ObjectWithAnyOfPropertyValue as a string.
@@ -741,6 +989,7 @@ func (t ObjectWithAnyOfPropertyValue) AsString0() (string, error) {
 func (t *ObjectWithAnyOfPropertyValue) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -752,6 +1001,7 @@ func (t *ObjectWithAnyOfPropertyValue) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -766,6 +1016,7 @@ func (t ObjectWithAnyOfPropertyValue) AsInt1() (int, error) {
 func (t *ObjectWithAnyOfPropertyValue) FromInt1(v int) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -777,6 +1028,7 @@ func (t *ObjectWithAnyOfPropertyValue) MergeInt1(v int) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -791,6 +1043,7 @@ func (t ObjectWithAnyOfPropertyValue) AsBool2() (bool, error) {
 func (t *ObjectWithAnyOfPropertyValue) FromBool2(v bool) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -802,9 +1055,54 @@ func (t *ObjectWithAnyOfPropertyValue) MergeBool2(v bool) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ObjectWithAnyOfPropertyValue) match() {
+	t.matched = [3]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsInt1(); err == nil {
+		t.matched[1] = true
+	}
+	if _, err := t.AsBool2(); err == nil {
+		t.matched[2] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t ObjectWithAnyOfPropertyValue) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesInt1 reports whether the union data matches int.
+func (t ObjectWithAnyOfPropertyValue) MatchesInt1() bool {
+	return t.matched[1]
+}
+
+// MatchesBool2 reports whether the union data matches bool.
+func (t ObjectWithAnyOfPropertyValue) MatchesBool2() bool {
+	return t.matched[2]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ObjectWithAnyOfPropertyValue) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ObjectWithAnyOfPropertyValue) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -812,6 +1110,7 @@ func (t ObjectWithAnyOfPropertyValue) MarshalJSON() ([]byte, error) {
 
 func (t *ObjectWithAnyOfPropertyValue) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -825,7 +1124,8 @@ type ArrayOfAnyOf = []ArrayOfAnyOfItem
 // #/components/schemas/ArrayOfAnyOf/items
 
 type ArrayOfAnyOfItem struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsSimpleObject returns the union data inside the ArrayOfAnyOfItem as a SimpleObject.
@@ -839,6 +1139,7 @@ func (t ArrayOfAnyOfItem) AsSimpleObject() (SimpleObject, error) {
 func (t *ArrayOfAnyOfItem) FromSimpleObject(v SimpleObject) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -850,6 +1151,7 @@ func (t *ArrayOfAnyOfItem) MergeSimpleObject(v SimpleObject) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -864,6 +1166,7 @@ func (t ArrayOfAnyOfItem) AsBaseProperties() (BaseProperties, error) {
 func (t *ArrayOfAnyOfItem) FromBaseProperties(v BaseProperties) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -875,9 +1178,46 @@ func (t *ArrayOfAnyOfItem) MergeBaseProperties(v BaseProperties) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ArrayOfAnyOfItem) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsSimpleObject(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "id") {
+		t.matched[0] = true
+	}
+	if _, err := t.AsBaseProperties(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesSimpleObject reports whether the union data matches SimpleObject.
+func (t ArrayOfAnyOfItem) MatchesSimpleObject() bool {
+	return t.matched[0]
+}
+
+// MatchesBaseProperties reports whether the union data matches BaseProperties.
+func (t ArrayOfAnyOfItem) MatchesBaseProperties() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ArrayOfAnyOfItem) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ArrayOfAnyOfItem) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -885,6 +1225,7 @@ func (t ArrayOfAnyOfItem) MarshalJSON() ([]byte, error) {
 
 func (t *ArrayOfAnyOfItem) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -1047,6 +1388,54 @@ func (t OneOfWithDiscriminator) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfWithDiscriminatorVariant is implemented by each member of OneOfWithDiscriminator, so that the
+// member its petType property selects can be handled as its own type.
+type OneOfWithDiscriminatorVariant interface {
+	isOneOfWithDiscriminatorVariant()
+}
+
+func (Cat) isOneOfWithDiscriminatorVariant() {}
+
+func (Dog) isOneOfWithDiscriminatorVariant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfWithDiscriminator) Variant() (OneOfWithDiscriminatorVariant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Cat":
+		return t.AsCat()
+	case "Dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfWithDiscriminator as the provided
+// member, setting its discriminator value.
+func (t *OneOfWithDiscriminator) FromVariant(v OneOfWithDiscriminatorVariant) error {
+	switch v := v.(type) {
+	case Cat:
+		return t.FromCat(v)
+	case *Cat:
+		if v != nil {
+			return t.FromCat(*v)
+		}
+	case Dog:
+		return t.FromDog(v)
+	case *Dog:
+		if v != nil {
+			return t.FromDog(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfWithDiscriminatorVariant %T", v)
+}
+
 func (t OneOfWithDiscriminator) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1146,6 +1535,54 @@ func (t OneOfWithDiscriminatorMapping) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// OneOfWithDiscriminatorMappingVariant is implemented by each member of OneOfWithDiscriminatorMapping, so that the
+// member its petType property selects can be handled as its own type.
+type OneOfWithDiscriminatorMappingVariant interface {
+	isOneOfWithDiscriminatorMappingVariant()
+}
+
+func (Cat) isOneOfWithDiscriminatorMappingVariant() {}
+
+func (Dog) isOneOfWithDiscriminatorMappingVariant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t OneOfWithDiscriminatorMapping) Variant() (OneOfWithDiscriminatorMappingVariant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "cat":
+		return t.AsCat()
+	case "dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the OneOfWithDiscriminatorMapping as the provided
+// member, setting its discriminator value.
+func (t *OneOfWithDiscriminatorMapping) FromVariant(v OneOfWithDiscriminatorMappingVariant) error {
+	switch v := v.(type) {
+	case Cat:
+		return t.FromCat(v)
+	case *Cat:
+		if v != nil {
+			return t.FromCat(*v)
+		}
+	case Dog:
+		return t.FromDog(v)
+	case *Dog:
+		if v != nil {
+			return t.FromDog(*v)
+		}
+	}
+	return fmt.Errorf("unsupported OneOfWithDiscriminatorMappingVariant %T", v)
+}
+
 func (t OneOfWithDiscriminatorMapping) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1460,6 +1897,54 @@ func (t ObjectWithOneOfPropertyVariant) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// ObjectWithOneOfPropertyVariantVariant is implemented by each member of ObjectWithOneOfPropertyVariant, so that the
+// member its petType property selects can be handled as its own type.
+type ObjectWithOneOfPropertyVariantVariant interface {
+	isObjectWithOneOfPropertyVariantVariant()
+}
+
+func (Cat) isObjectWithOneOfPropertyVariantVariant() {}
+
+func (Dog) isObjectWithOneOfPropertyVariantVariant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t ObjectWithOneOfPropertyVariant) Variant() (ObjectWithOneOfPropertyVariantVariant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Cat":
+		return t.AsCat()
+	case "Dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the ObjectWithOneOfPropertyVariant as the provided
+// member, setting its discriminator value.
+func (t *ObjectWithOneOfPropertyVariant) FromVariant(v ObjectWithOneOfPropertyVariantVariant) error {
+	switch v := v.(type) {
+	case Cat:
+		return t.FromCat(v)
+	case *Cat:
+		if v != nil {
+			return t.FromCat(*v)
+		}
+	case Dog:
+		return t.FromDog(v)
+	case *Dog:
+		if v != nil {
+			return t.FromDog(*v)
+		}
+	}
+	return fmt.Errorf("unsupported ObjectWithOneOfPropertyVariantVariant %T", v)
+}
+
 func (t ObjectWithOneOfPropertyVariant) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1537,6 +2022,56 @@ func (s *AllOfWithOneOf) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// AsCat returns the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as a Cat.
+func (s AllOfWithOneOf) AsCat() (Cat, error) {
+	var union AllOfWithOneOfAllOf1
+	if s.AllOfWithOneOfAllOf1 != nil {
+		union = *s.AllOfWithOneOfAllOf1
+	}
+	return union.AsCat()
+}
+
+// FromCat overwrites the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as the provided Cat.
+func (s *AllOfWithOneOf) FromCat(v Cat) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.FromCat(v)
+}
+
+// MergeCat performs a merge with the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf, using the provided Cat.
+func (s *AllOfWithOneOf) MergeCat(v Cat) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.MergeCat(v)
+}
+
+// AsDog returns the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as a Dog.
+func (s AllOfWithOneOf) AsDog() (Dog, error) {
+	var union AllOfWithOneOfAllOf1
+	if s.AllOfWithOneOfAllOf1 != nil {
+		union = *s.AllOfWithOneOfAllOf1
+	}
+	return union.AsDog()
+}
+
+// FromDog overwrites the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf as the provided Dog.
+func (s *AllOfWithOneOf) FromDog(v Dog) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.FromDog(v)
+}
+
+// MergeDog performs a merge with the AllOfWithOneOfAllOf1 union data inside the AllOfWithOneOf, using the provided Dog.
+func (s *AllOfWithOneOf) MergeDog(v Dog) error {
+	if s.AllOfWithOneOfAllOf1 == nil {
+		s.AllOfWithOneOfAllOf1 = new(AllOfWithOneOfAllOf1)
+	}
+	return s.AllOfWithOneOfAllOf1.MergeDog(v)
+}
+
 // ApplyDefaults sets default values for fields that are nil.
 func (s *AllOfWithOneOf) ApplyDefaults() {
 }
@@ -1626,6 +2161,54 @@ func (t AllOfWithOneOfAllOf1) ValueByDiscriminator() (any, error) {
 	}
 }
 
+// AllOfWithOneOfAllOf1Variant is implemented by each member of AllOfWithOneOfAllOf1, so that the
+// member its petType property selects can be handled as its own type.
+type AllOfWithOneOfAllOf1Variant interface {
+	isAllOfWithOneOfAllOf1Variant()
+}
+
+func (Cat) isAllOfWithOneOfAllOf1Variant() {}
+
+func (Dog) isAllOfWithOneOfAllOf1Variant() {}
+
+// Variant returns the union member selected by the discriminator value.
+// UnmarshalJSON keeps data with values outside the mapping, such as those of
+// members added to the spec since, for which Variant returns an error.
+func (t AllOfWithOneOfAllOf1) Variant() (AllOfWithOneOfAllOf1Variant, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "Cat":
+		return t.AsCat()
+	case "Dog":
+		return t.AsDog()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+// FromVariant overwrites any union data inside the AllOfWithOneOfAllOf1 as the provided
+// member, setting its discriminator value.
+func (t *AllOfWithOneOfAllOf1) FromVariant(v AllOfWithOneOfAllOf1Variant) error {
+	switch v := v.(type) {
+	case Cat:
+		return t.FromCat(v)
+	case *Cat:
+		if v != nil {
+			return t.FromCat(*v)
+		}
+	case Dog:
+		return t.FromDog(v)
+	case *Dog:
+		if v != nil {
+			return t.FromDog(*v)
+		}
+	}
+	return fmt.Errorf("unsupported AllOfWithOneOfAllOf1Variant %T", v)
+}
+
 func (t AllOfWithOneOfAllOf1) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1712,26 +2295,116 @@ func (t *OneOfWithAllOf) ApplyDefaults() {
 
 // #/components/schemas/OneOfWithAllOf/oneOf/0
 type OneOfWithAllOfOneOf0 struct {
-	ID        *int       `form:"id,omitempty" json:"id,omitempty"`
-	CreatedAt *time.Time `form:"createdAt,omitempty" json:"createdAt,omitempty"`
-	Variant   *string    `form:"variant,omitempty" json:"variant,omitempty"`
+	ID        *int                               `form:"id,omitempty" json:"id,omitempty"`
+	CreatedAt *time.Time                         `form:"createdAt,omitempty" json:"createdAt,omitempty"`
+	Variant   *OneOfWithAllOfOneOf0AllOf1Variant `form:"variant,omitempty" json:"variant,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
 func (s *OneOfWithAllOfOneOf0) ApplyDefaults() {
 }
 
+// NewOneOfWithAllOfOneOf0 returns a new OneOfWithAllOfOneOf0 with its const properties set.
+func NewOneOfWithAllOfOneOf0() OneOfWithAllOfOneOf0 {
+	var s OneOfWithAllOfOneOf0
+	s.Variant = new(OneOfWithAllOfOneOf0AllOf1Variant)
+	*s.Variant = A
+	return s
+}
+
+// #/components/schemas/OneOfWithAllOf/oneOf/0/allOf/1/properties/variant
+type OneOfWithAllOfOneOf0AllOf1Variant string
+
+const (
+	A OneOfWithAllOfOneOf0AllOf1Variant = "a"
+)
+
+// Values returns the OneOfWithAllOfOneOf0AllOf1Variant constants, in the order of the spec.
+func (OneOfWithAllOfOneOf0AllOf1Variant) Values() []OneOfWithAllOfOneOf0AllOf1Variant {
+	return []OneOfWithAllOfOneOf0AllOf1Variant{A}
+}
+
+// ParseOneOfWithAllOfOneOf0AllOf1Variant returns the OneOfWithAllOfOneOf0AllOf1Variant constant whose value is s. Other strings
+// return an error.
+func ParseOneOfWithAllOfOneOf0AllOf1Variant(s string) (OneOfWithAllOfOneOf0AllOf1Variant, error) {
+	switch v := OneOfWithAllOfOneOf0AllOf1Variant(s); v {
+	case A:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OneOfWithAllOfOneOf0AllOf1Variant value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than A.
+func (e *OneOfWithAllOfOneOf0AllOf1Variant) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch OneOfWithAllOfOneOf0AllOf1Variant(v) {
+	case A:
+		*e = OneOfWithAllOfOneOf0AllOf1Variant(v)
+		return nil
+	}
+	return fmt.Errorf("invalid OneOfWithAllOfOneOf0AllOf1Variant value %q", v)
+}
+
 // #/components/schemas/OneOfWithAllOf/oneOf/1
 type OneOfWithAllOfOneOf1 struct {
-	ID        *int       `form:"id,omitempty" json:"id,omitempty"`
-	CreatedAt *time.Time `form:"createdAt,omitempty" json:"createdAt,omitempty"`
-	Variant   *string    `form:"variant,omitempty" json:"variant,omitempty"`
+	ID        *int                               `form:"id,omitempty" json:"id,omitempty"`
+	CreatedAt *time.Time                         `form:"createdAt,omitempty" json:"createdAt,omitempty"`
+	Variant   *OneOfWithAllOfOneOf1AllOf1Variant `form:"variant,omitempty" json:"variant,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
 func (s *OneOfWithAllOfOneOf1) ApplyDefaults() {
 }
 
+// NewOneOfWithAllOfOneOf1 returns a new OneOfWithAllOfOneOf1 with its const properties set.
+func NewOneOfWithAllOfOneOf1() OneOfWithAllOfOneOf1 {
+	var s OneOfWithAllOfOneOf1
+	s.Variant = new(OneOfWithAllOfOneOf1AllOf1Variant)
+	*s.Variant = B
+	return s
+}
+
+// #/components/schemas/OneOfWithAllOf/oneOf/1/allOf/1/properties/variant
+type OneOfWithAllOfOneOf1AllOf1Variant string
+
+const (
+	B OneOfWithAllOfOneOf1AllOf1Variant = "b"
+)
+
+// Values returns the OneOfWithAllOfOneOf1AllOf1Variant constants, in the order of the spec.
+func (OneOfWithAllOfOneOf1AllOf1Variant) Values() []OneOfWithAllOfOneOf1AllOf1Variant {
+	return []OneOfWithAllOfOneOf1AllOf1Variant{B}
+}
+
+// ParseOneOfWithAllOfOneOf1AllOf1Variant returns the OneOfWithAllOfOneOf1AllOf1Variant constant whose value is s. Other strings
+// return an error.
+func ParseOneOfWithAllOfOneOf1AllOf1Variant(s string) (OneOfWithAllOfOneOf1AllOf1Variant, error) {
+	switch v := OneOfWithAllOfOneOf1AllOf1Variant(s); v {
+	case B:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OneOfWithAllOfOneOf1AllOf1Variant value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than B.
+func (e *OneOfWithAllOfOneOf1AllOf1Variant) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch OneOfWithAllOfOneOf1AllOf1Variant(v) {
+	case B:
+		*e = OneOfWithAllOfOneOf1AllOf1Variant(v)
+		return nil
+	}
+	return fmt.Errorf("invalid OneOfWithAllOfOneOf1AllOf1Variant value %q", v)
+}
+
 // #/components/schemas/TreeNode
 type TreeNode struct {
 	Value    *string    `form:"value,omitempty" json:"value,omitempty"`
@@ -1877,14 +2550,98 @@ func (s *WithDefaults) ApplyDefaults() {
 
 // #/components/schemas/WithConst
 type WithConst struct {
-	Version *string `form:"version,omitempty" json:"version,omitempty"`
-	Type    *string `form:"type,omitempty" json:"type,omitempty"`
+	Version *WithConstVersion `form:"version,omitempty" json:"version,omitempty"`
+	Type    *WithConstType    `form:"type,omitempty" json:"type,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
 func (s *WithConst) ApplyDefaults() {
 }
 
+// NewWithConst returns a new WithConst with its const properties set.
+func NewWithConst() WithConst {
+	var s WithConst
+	s.Version = new(WithConstVersion)
+	*s.Version = N100
+	s.Type = new(WithConstType)
+	*s.Type = Fixed
+	return s
+}
+
+// #/components/schemas/WithConst/properties/version
+type WithConstVersion string
+
+const (
+	N100 WithConstVersion = "1.0.0"
+)
+
+// Values returns the WithConstVersion constants, in the order of the spec.
+func (WithConstVersion) Values() []WithConstVersion {
+	return []WithConstVersion{N100}
+}
+
+// ParseWithConstVersion returns the WithConstVersion constant whose value is s. Other strings
+// return an error.
+func ParseWithConstVersion(s string) (WithConstVersion, error) {
+	switch v := WithConstVersion(s); v {
+	case N100:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid WithConstVersion value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than N100.
+func (e *WithConstVersion) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch WithConstVersion(v) {
+	case N100:
+		*e = WithConstVersion(v)
+		return nil
+	}
+	return fmt.Errorf("invalid WithConstVersion value %q", v)
+}
+
+// #/components/schemas/WithConst/properties/type
+type WithConstType string
+
+const (
+	Fixed WithConstType = "fixed"
+)
+
+// Values returns the WithConstType constants, in the order of the spec.
+func (WithConstType) Values() []WithConstType {
+	return []WithConstType{Fixed}
+}
+
+// ParseWithConstType returns the WithConstType constant whose value is s. Other strings
+// return an error.
+func ParseWithConstType(s string) (WithConstType, error) {
+	switch v := WithConstType(s); v {
+	case Fixed:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid WithConstType value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than Fixed.
+func (e *WithConstType) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch WithConstType(v) {
+	case Fixed:
+		*e = WithConstType(v)
+		return nil
+	}
+	return fmt.Errorf("invalid WithConstType value %q", v)
+}
+
 // #/components/schemas/WithConstraints
 type WithConstraints struct {
 	BoundedInt          *int     `form:"boundedInt,omitempty" json:"boundedInt,omitempty"`
@@ -1910,7 +2667,82 @@ func (s *TypeArray31) ApplyDefaults() {
 }
 
 // #/components/schemas/PrefixItems31
-type PrefixItems31 = []string
+type PrefixItems31 struct {
+	Item0 *string
+	Item1 *int
+	Item2 *bool
+	// Rest holds the items after the others.
+	Rest []string
+}
+
+// items returns the items of the PrefixItems31 in order. Absent items at the
+// end are left out; those before a present one are null.
+func (t PrefixItems31) items() []any {
+	items := []any{t.Item0, t.Item1, t.Item2}
+	n := 0
+	if t.Item0 != nil {
+		n = 1
+	}
+	if t.Item1 != nil {
+		n = 2
+	}
+	if t.Item2 != nil {
+		n = 3
+	}
+	if len(t.Rest) > 0 {
+		n = len(items)
+	}
+	items = items[:n]
+	for _, item := range t.Rest {
+		items = append(items, item)
+	}
+	return items
+}
+
+// MarshalJSON encodes the PrefixItems31 as a JSON array of its items.
+func (t PrefixItems31) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.items())
+}
+
+// UnmarshalJSON decodes the PrefixItems31 from a JSON array of its items.
+func (t *PrefixItems31) UnmarshalJSON(b []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+	*t = PrefixItems31{}
+	if len(items) > 0 {
+		if err := json.Unmarshal(items[0], &t.Item0); err != nil {
+			return fmt.Errorf("error reading item 0: %w", err)
+		}
+	}
+	if len(items) > 1 {
+		if err := json.Unmarshal(items[1], &t.Item1); err != nil {
+			return fmt.Errorf("error reading item 1: %w", err)
+		}
+	}
+	if len(items) > 2 {
+		if err := json.Unmarshal(items[2], &t.Item2); err != nil {
+			return fmt.Errorf("error reading item 2: %w", err)
+		}
+	}
+	if len(items) > 3 {
+		t.Rest = make([]string, len(items)-3)
+		for i, raw := range items[3:] {
+			if err := json.Unmarshal(raw, &t.Rest[i]); err != nil {
+				return fmt.Errorf("error reading item %d: %w", 3+i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PrefixItems31) ApplyDefaults() {
+}
 
 // #/components/schemas/EmptySchema
 type EmptySchema = any
@@ -1939,7 +2771,8 @@ type ComplexNestedMetadata = map[string]any
 // #/components/schemas/ComplexNested/properties/metadata/additionalProperties
 
 type ComplexNestedMetadataValue struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [3]bool
 }
 
 // AsString0 returns the union data inside the ComplexNestedMetadataValue as a string.
@@ -1953,6 +2786,7 @@ func (t ComplexNestedMetadataValue) AsString0() (string, error) {
 func (t *ComplexNestedMetadataValue) FromString0(v string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -1964,6 +2798,7 @@ func (t *ComplexNestedMetadataValue) MergeString0(v string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -1978,6 +2813,7 @@ func (t ComplexNestedMetadataValue) AsInt1() (int, error) {
 func (t *ComplexNestedMetadataValue) FromInt1(v int) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -1989,6 +2825,7 @@ func (t *ComplexNestedMetadataValue) MergeInt1(v int) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -2003,6 +2840,7 @@ func (t ComplexNestedMetadataValue) AsLBracketString2() ([]string, error) {
 func (t *ComplexNestedMetadataValue) FromLBracketString2(v []string) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -2014,9 +2852,54 @@ func (t *ComplexNestedMetadataValue) MergeLBracketString2(v []string) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *ComplexNestedMetadataValue) match() {
+	t.matched = [3]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsString0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsInt1(); err == nil {
+		t.matched[1] = true
+	}
+	if _, err := t.AsLBracketString2(); err == nil {
+		t.matched[2] = true
+	}
+}
+
+// MatchesString0 reports whether the union data matches string.
+func (t ComplexNestedMetadataValue) MatchesString0() bool {
+	return t.matched[0]
+}
+
+// MatchesInt1 reports whether the union data matches int.
+func (t ComplexNestedMetadataValue) MatchesInt1() bool {
+	return t.matched[1]
+}
+
+// MatchesLBracketString2 reports whether the union data matches []string.
+func (t ComplexNestedMetadataValue) MatchesLBracketString2() bool {
+	return t.matched[2]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t ComplexNestedMetadataValue) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t ComplexNestedMetadataValue) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -2024,6 +2907,7 @@ func (t ComplexNestedMetadataValue) MarshalJSON() ([]byte, error) {
 
 func (t *ComplexNestedMetadataValue) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -2117,24 +3001,114 @@ func (t *ComplexNestedConfig) ApplyDefaults() {
 
 // #/components/schemas/ComplexNested/properties/config/oneOf/0
 type ComplexNestedConfigOneOf0 struct {
-	Mode  *string `form:"mode,omitempty" json:"mode,omitempty"`
-	Value *string `form:"value,omitempty" json:"value,omitempty"`
+	Mode  *ComplexNestedConfigOneOf0Mode `form:"mode,omitempty" json:"mode,omitempty"`
+	Value *string                        `form:"value,omitempty" json:"value,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
 func (s *ComplexNestedConfigOneOf0) ApplyDefaults() {
 }
 
+// NewComplexNestedConfigOneOf0 returns a new ComplexNestedConfigOneOf0 with its const properties set.
+func NewComplexNestedConfigOneOf0() ComplexNestedConfigOneOf0 {
+	var s ComplexNestedConfigOneOf0
+	s.Mode = new(ComplexNestedConfigOneOf0Mode)
+	*s.Mode = Simple
+	return s
+}
+
+// #/components/schemas/ComplexNested/properties/config/oneOf/0/properties/mode
+type ComplexNestedConfigOneOf0Mode string
+
+const (
+	Simple ComplexNestedConfigOneOf0Mode = "simple"
+)
+
+// Values returns the ComplexNestedConfigOneOf0Mode constants, in the order of the spec.
+func (ComplexNestedConfigOneOf0Mode) Values() []ComplexNestedConfigOneOf0Mode {
+	return []ComplexNestedConfigOneOf0Mode{Simple}
+}
+
+// ParseComplexNestedConfigOneOf0Mode returns the ComplexNestedConfigOneOf0Mode constant whose value is s. Other strings
+// return an error.
+func ParseComplexNestedConfigOneOf0Mode(s string) (ComplexNestedConfigOneOf0Mode, error) {
+	switch v := ComplexNestedConfigOneOf0Mode(s); v {
+	case Simple:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ComplexNestedConfigOneOf0Mode value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than Simple.
+func (e *ComplexNestedConfigOneOf0Mode) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch ComplexNestedConfigOneOf0Mode(v) {
+	case Simple:
+		*e = ComplexNestedConfigOneOf0Mode(v)
+		return nil
+	}
+	return fmt.Errorf("invalid ComplexNestedConfigOneOf0Mode value %q", v)
+}
+
 // #/components/schemas/ComplexNested/properties/config/oneOf/1
 type ComplexNestedConfigOneOf1 struct {
-	Mode    *string           `form:"mode,omitempty" json:"mode,omitempty"`
-	Options map[string]string `form:"options,omitempty" json:"options,omitempty"`
+	Mode    *ComplexNestedConfigOneOf1Mode `form:"mode,omitempty" json:"mode,omitempty"`
+	Options map[string]string              `form:"options,omitempty" json:"options,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
 func (s *ComplexNestedConfigOneOf1) ApplyDefaults() {
 }
 
+// NewComplexNestedConfigOneOf1 returns a new ComplexNestedConfigOneOf1 with its const properties set.
+func NewComplexNestedConfigOneOf1() ComplexNestedConfigOneOf1 {
+	var s ComplexNestedConfigOneOf1
+	s.Mode = new(ComplexNestedConfigOneOf1Mode)
+	*s.Mode = Advanced
+	return s
+}
+
+// #/components/schemas/ComplexNested/properties/config/oneOf/1/properties/mode
+type ComplexNestedConfigOneOf1Mode string
+
+const (
+	Advanced ComplexNestedConfigOneOf1Mode = "advanced"
+)
+
+// Values returns the ComplexNestedConfigOneOf1Mode constants, in the order of the spec.
+func (ComplexNestedConfigOneOf1Mode) Values() []ComplexNestedConfigOneOf1Mode {
+	return []ComplexNestedConfigOneOf1Mode{Advanced}
+}
+
+// ParseComplexNestedConfigOneOf1Mode returns the ComplexNestedConfigOneOf1Mode constant whose value is s. Other strings
+// return an error.
+func ParseComplexNestedConfigOneOf1Mode(s string) (ComplexNestedConfigOneOf1Mode, error) {
+	switch v := ComplexNestedConfigOneOf1Mode(s); v {
+	case Advanced:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid ComplexNestedConfigOneOf1Mode value %q", s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting values other
+// than Advanced.
+func (e *ComplexNestedConfigOneOf1Mode) UnmarshalJSON(data []byte) error {
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch ComplexNestedConfigOneOf1Mode(v) {
+	case Advanced:
+		*e = ComplexNestedConfigOneOf1Mode(v)
+		return nil
+	}
+	return fmt.Errorf("invalid ComplexNestedConfigOneOf1Mode value %q", v)
+}
+
 // #/components/schemas/ComplexNested/properties/config/oneOf/1/properties/options
 type ComplexNestedConfigOneOf1Options = map[string]string
 
@@ -2162,54 +3136,54 @@ func (s *GetInlineResponseJSONResponse) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+w8W28iOdbv/AqLmbdPNEm6px+QvgdCaIlZAgjI9Eaj2ZVDHYKnq+wal0nCjkbaH7G/",
-	"cH/Jyq6CKl/qZtKtntbkifhybj43Hx9gMVAckwHqvn1z+eai2yF0ywYdhAQRIQzQiEUxhx3QhDwBWkMi",
-	"0Gqzgwh3EHoCnhBGB6h7+eZC7kUogGTDSSzUsFydIByGKFFbUIyFAE4TtGUcMRyT3oYF8Ai004mx2CUS",
-	"bz8hURyC/IjQI4j0A0IsBo4l3EkwkOMrtSyb5JDEjCaQHFcj1L26uOjm/xqkpbtP+wrLNowKoKK4EyEc",
-	"xyHZKPT9XxNG9VmU8WeOIvQ9h+0Adb/rb1gUMwpUJP10bdJPSZg//Aob0e1IzgkNCYXekahaEUzU+qXO",
-	"Q2tRpFAQU4QgQj+7UMQhhkGGz5rk8NuecAjsbQj1EAmcwxRHYE3EXMpLkKIk8j/ixHAkjlABj8AdKySq",
-	"qp2J4IQ+quOMMccRCOBJ/3ep3gv5/x/p5nzuCCzlYoBOK09ICE1HO5aMkOD7nHFb3hpJ+fCW8QiLAdrv",
-	"M3lW6NhHkpGjiM0W2dTn9P+2B35YmSglD2qmU60fToo10BMqvOGax6oBHnKOD96gsbEbISIgslSvkr8d",
-	"4AC4fvgpHelMe9m1dgbzv30drlCqOCSi98CCQ2YxLHErqZxYpsuvWXDo5DaSjQzqDMfBZzWXbh6rOByG",
-	"4foQQ7LMKOj+2Q8o2odChu8CAZUndCvXj9LlVUf0Bc5CZ8aN56X3/Pzck46yt+chUJmnBJ8LsZJljLno",
-	"K4QBFrgJqoo4WhX7tiR0RrCSYGEGjQdCMT9YCyIQ2Cb823JMGk/wIvpxiEnLtOeYH+TI5MoMX7rpO/T/",
-	"zf+yHYvl5Haynvw0Ruv7xXiFemgYhtmZJR5Q1RbTaR15ciienbb1ZLT9QCAM9KG3V47B9+/MwW3IsLU9",
-	"YPuHEMxRuo8egJujqaDN0QfGQgsqFuAaW5PIGpcpkzkGESYW0D0n5tCOJUIGeov/+OmdY+y9RfvBpjO1",
-	"RnM0xknyzLhGqcslHE/IzhnNTCk/uPq1ua9Qu4owsnNuCeP9u9N4rhY2jFQPHCDUptN4QYlawEh3nSYK",
-	"OlcLpKCJtcn5ST/tlXIKMM35OKpti4xf7tEAnHS8JZCeIIUr18kqfC4f8i83oRYQ1Kachszg2pDAyWlU",
-	"M84WMI77ci0/WnMLIHJPEcB7DwDvcx06tFcMuScHkDuVNiD0xEDzQS3AHPfpIWiu0gAcVoSgvxzcXw7u",
-	"W3Rw3jnh7G46HV5Ps5TQNwmc7cMQP4TgmQTSbPvKKnlkE8V6Tj46r7NsHa4l1I5eIHTcanqoK2F0LYgT",
-	"/WLhAucqD5bCS1mpA+m4xlkQy+91rqqkWY/Mj9LTmX69Is90ex4DHS4m6O2byxNodb1DTwQjRmG+7WN6",
-	"mG/Rf//9HyR2gBgND+gZH5BgKMKfIAOEEYctcKAbCBQ1ObiE0A2gA9ujDaaUCbRh0QOhGZ5nInbpBlUP",
-	"TN4YJrSdSyI8TaixTdgax4poj3D9Lr69jOxKbTc17PPSYAp5KM/4ywsZF9G+PoM+IWC4XA7vvV2/qoir",
-	"9Kulp0htX223w51ZKXfUyZ1xkVBxLkTTiaSM+ENtf7jpE9/8TLyllb/yup/rvavqrcv9zuU8GAqJgOBc",
-	"bs55QDnapJsGw7JsRI5A21QpFbCPROxGjCaCY5IV9Kp5iwidKATosjiKX46jFy1I8XYO8+sfx6O1t3co",
-	"KnjbymBlUarB7cnUTkeuo5SyljoXAWwvgNvQLXMrMzZCqb7/jGL9Ew739dV6//hwczNZT+az4RQtlvPF",
-	"eLme+N8UhkFA0rC/4CxOhvRQIXisLc7YT5/lXMBmjEJraFscnroYXEL+RNkzrVYjgwwZDIO2dHTcuuyC",
-	"X6uv1QhaPeFZyNVTv/zQtshSa7BNxHKuKo9nd7f+vkwhH9N9pPOueXsoTEsvpmzz0hy4MgfepigmqURs",
-	"HLqoTCRF+EXQGdT0POXJ2YAbpGlY7JMGBQmdqOyNAWhgX/DwRpAnMAalNoYgIC+HxJwwTsShSZ3MhfzS",
-	"+P/K+P8kc5ltSclMaKZ5h9YVRAlidY6kGDUHttszXPZ0Ov+AemhCd8CJwHQD/ZE090SZmK8FXOMEbON8",
-	"NQ+A0IYDlmmi8CrEqcnxiwAamFEdh2Hx2lV95dLZ7HbMC5cVoN39aFbLmXdhpjhRfPCuLebcAMQFHfCS",
-	"hi7QBtIoYxNeBMf1NA8lcaoTJFYX9eSVz7D9faw1pxHwR7s7US9g57ymDmhOw0Mppy3xbwlPRMOuspag",
-	"E9gwWsKalrR4ua3ZvXJbd5QwilRRwTvPpIf5dsFJRGSsyXVIL7/0qqWiZ2Fya6oa5eB8lKuNGueU3JKX",
-	"XMWasvUFdD+NhU10X9J8LMj5nU+x8pUnOdnRe0Vy6yrlrNeVOudeRbmk5xSCvPTPXcXIYjnAuN1bNPlV",
-	"ED00z6uCMBsro74hMnBFhMoIn5q4t3WrCvmq8B0Aq3L8hUxRESKVrsAd4140jXBjUm7Y43Fp4MJ70u3D",
-	"LG0XByGdaRXJtziOCw82XxvleflLI1PljDIJbEAYQgF7rKRLLR3htoUqk8YeioA9VziZbENtjivB1C56",
-	"3pHkE/Ap0EexK32FT7NB9ng2aw+Yf3oF1iSY2kUCk7AJX0qjJ1rcsfS3ZRRjKsEefp4cKgV+3SCJUpzZ",
-	"WUwJdyV0GY0YzjiUR88Mpd89uMEV7wlzgul5D4+GXbdwOhWOp8Zt+oS+0fz2ejIb38gPi/lKVVHP6LNN",
-	"3bb2OvxKd6I/xQmcAtewyLRlC4ZI/MVSadv1NXlLzRv20G8YTWREw91vkKmHMxLJ5Xh0t1yd2td9DWnN",
-	"AWYsgHNvBk6ONzsSBhzoq78UH6nO5Dcl9BMEU5KI12PFej+DF+2oq8jT6cmIXMJmzxPyBJrLahe8GgfV",
-	"9IG5zTdtdPLOUszhTf/jcrIeo/lseu+rmEvAwUdOhFYKavtYWuzsPzNSS0w4UMToX4U7wm9QpH0+8aOD",
-	"qH+b9TmIm/GH4d10vULDmYy5s9Xa9yzU/Qi2eB8Kv76WAoAGYgqylaibffqnstFiS4iohGif3Qnkuyut",
-	"r7YSjNldWwCjHd+po6EUkm8DTwHjz7/kZ6E6J9q6ueyXB+rFf4xOp18ocDeG1OzekhcIuudki7PVejmc",
-	"zPzbLUqaTBoK7IHtaQCBq9HSoWARoSTaRwN0obenpIOXF/kwvGzCvXS0160QnLbdujDlsy6UUfZ+oCdN",
-	"pazkq9EPpjhKOlltPYgIzW6rZsfOcfjqhx8KTlT9zEVj6Nn6Aer+42fc+9cv//d916S0YXfVqzYWnZrf",
-	"KfltDw0pSBdn6DTH8jk7mRbj2bHxd7UYjyYfJiPvHPIQp7y+vdRsrFPaq220jzmbRmtj4oLDlqTHZCDW",
-	"RBzny1o/dZRVCZyH80pNGbeL9T3qo+HsXmX2vocyjmJxSH93ZoB+/+P0yvBTMd3Vvi883GwgFgnC9IB+",
-	"XM1naWZ8fEeOQ7IhwuxQ6pR2r/fsSostVTPCmq2G5YpzThViMR3/Hc3Gq/X4Bq3Wy7vR+m7pf4MaqVaN",
-	"l5mWcTeMMa6vgJdk+tUNQSWPM5XPM9UPNPms3f1Z2mpa3olsrm6cFznu+ufc9mvu+3W/gSPwY1L1WzZu",
-	"WVXKq/z2zOiWPNaWBj2rF1HhpuxRukh/cqrr2X35NXCAgydMN/nvipgV6QrlLlGdJkb6usHidrg4s4Hv",
-	"FsdnNmcWeUgL5+fAbNmPmXrd85kwTrTuHDXO/xcAAP//5miNURBOAAA=",
+	"H4sIAAAAAAAC/+w83W7jNtb3eooDt3cfPElmprkw8F04iQdw17EN22l3UHQXjHWcsCORKkUl8RYF9iH2",
+	"CfdJFpRkSRRp/dCZwbSor2yKPH88fzw8Mo+QkYiOYPDuzcWb84FH2Y6PPABJZYAjuOZhJPARWUyfEDYY",
+	"S1hvHzEkHsATiphyNoLBxZtztRbAx3graCTTYTU7BhIEEKdLICJSomAx7LgATiI63HIfH5B5XkTkYzzy",
+	"AM5iGkYBqq8ADyizLwA8QkEU3Kk/UuPrdFr+UGAccRZjfJgNMHh7fj4of9ZIy1YX6yrTtpxJZLK6EoBE",
+	"UUC3KfqzX2LO9KeQ81cfBfhW4G4Eg2/OtjyMOEMm47NsbnyWkbC4/wW3cuApzikLKMPhgahWEUzT+Sud",
+	"h96iyKAATwkByj67UOQ+wlGOz3go8NeECvTNZQBDoL51mJEQjQeRUPKStCqJ8kOtGA7EUSbxAYVlhkLV",
+	"tDKWgrKHdDsjIkiIEkV89ptS76X6/Xu2uHw28qpcjKCY6RWksmzUM2QEUiToHZe3RhIUnx0XIZEjSBLq",
+	"t+nYjzQnJyXWA7BTX9L/a4Jiv66jpCx/4jXrh5ViDfSUSWe49W3VAI+FIHtn0KS2GoBKDA3Va+TvEYmP",
+	"Qt/8jI7sSX/Z9XYGi799Ha5QqTjGcnjP/X1uMTy2K6l6sMqmX3F/75U2ko+M2gzHwmczl3YemzgcB8Fm",
+	"H2G8yikY/NE3KEwCSYcaAY07dKvmX2fTm7boC+yFzowdz8vw+fl5qBzlMBEBMpWn+J8LcSrLiAh5liL0",
+	"iSRdUDXE0abYt6OBNYIdCRb1oHFPGRF7Y0KIkpiE/7kck8YTvsizKCC0Z9pzyA9KZCPvsCb9CvAN/H/3",
+	"T75iuZreTjfTHyaw+bicrGEI4yDI9yx2gOoBANSd1sircqIpnpm2DVW0/UAx8PWhd28tg5fv64O7gBNj",
+	"uc+T+wDroywJ71HURzNB10fvOQ8MqESibWxDQ2NcpUz1MQwJNYAmgtaHHnksVaA3+I+e3lvGLg3a9yad",
+	"mTXWRyMSx89caJTaXMJhh0ZeW6ZUblz73NJXpKuqMC7fu8C4fF+Ml2phwsj0wAIiXVSMV5SoB4xsVfGg",
+	"onOtQCqa2JqcF/ppzlSPkLCSj4Pa9sj41RoNQKHjPYEMJa0cuQqrcDl8AEDFhHpASBeVNAjaF0AiaDGq",
+	"GWcPGId1pZYfrLkHELWmCuDSAcBlqUP7/oqh1pQASqfSB4SeGGg+qAeYwzo9BC3SNIAEDSHoLwf3l4P7",
+	"Mzo455xwfjebja9meUromgTOkyAg9wE6JoEsX742Sh75g2o9pxxdtFm2DtcQauV3mQzWBgcKxsCAOGWy",
+	"DZxpzA3wMlbaQFqOcQbE4+c6W1WyXo8st9LRmX69Is91exEhGy+n8O7NRQE6Pd7BEyXAGS52Z4TtFzv4",
+	"77//A/IRgbNgD89kD5JDSD5hDoiAwB0KZFv0U2pKcDFlW4Q9T2BLGOMStjy8pyzH80zlY7YgrQfGb2om",
+	"tFsoIhxNqLNNmBrHq2gPcN0OvsOc7EZtr2vY56WhLuQx25eYvpyQSRXt6zPoEgLGq9X4o7PrTyviafrV",
+	"01Nktp8uN+zaqJRb6uTWuEiZPBVi3YlkjLhD7b+52RXf4kS8Ryt/x+KD/b6r6a7LFlGObAzDWKJ/Kjen",
+	"XKAcbNJOQ82yTESWQNtVKVNg6o7smrNYCkLzgl4zbyFl0xQBXFRHycth9LwHKc7OYXH1/eR64+wdqgre",
+	"tzLYWJTqcHqqa6cl10mVspU6GwE8kShM6Nq6Y2szG2f6enAv1j+RIGmv1rvHh5ub6Wa6mI9nsFwtlpPV",
+	"Zup+Uhj7Ps3C/lLwKB6zfYPgiTY5Zz+7lrMBm3OGvaHtSFB0MdiE/InxZ9asRjUyVDD0+9Lh2XXZBr9V",
+	"X5sR9LrCM5CnV/3qS98iS6vBdhHLqao8md/duvuyFPmEJeHIsxAFAACAlccAw8w2L+oDb+sD7zIU00wi",
+	"Jg5dVHUkVfhV0DnUbD/VzpmAO6RpRCZxh4KEThQAwBAiZL55wCNbSZ+wNqi0MUCJvle6PMoFlft2T29H",
+	"flH7/bb2u5C5yraUZKYs17x97wqiArE+RVKc1Qd2uxNc9my2+ABDmLJHFFQStsUz1aTH49TEXC3gisRo",
+	"GuereQCArUCi0kTpVIjzAAAmLxKZX4/qJAiqx67mI5fO5sCrH7iMAG3vRzNazpwLMwD2C+/WYs4NYlTR",
+	"ASdp6ALtII1jbOKLFKSd5rEiLu0EidKDevzKe9j/PNab0xDFA/p2Vg8F7JLXzAEtWLA/ymlP/DsqYtmx",
+	"q6wn6Bi3nB1hTUtanNzW/GPqtu4Y5QzSooJznqnqLEtBQ6piTalDevll2CwVPQtTSzPVOA7ORbn6qHFJ",
+	"yS19Qf8oHUfY+gK6n8XCLrqvaD4U5Nz2p1r5KpOcfOudIrlxlLLW644652FDuWRoFYI69C9sxchqOaB2",
+	"ujdoctlYJ81zqiDMJ6lR31AVuELKVITPTNzZutMK+bryDoBROf5CppgSopSuwh0XTjRdk86k3PCHw1Tf",
+	"hrfQ7f08axdHqZxpE8m3JIoqFzZfG+Vl+UsjEwBgS2QnwgB8/tBIlwcAcE36FqrqNA4hRP7c4GTyBa05",
+	"rgLTOun5kcafUMyQPcjHo7fwWTbIH05m7Z6IT6/AmgLTOkkSGnThK9XoqRZ3DP3tGcV4mmCPP08OlQG/",
+	"6pBEpZyZWcwR7o7QVWvEsMahMnrmKN3OwR2OeE9EUMJOu3is2XUPp9PgeFrcpkvou17cXk3nkxv1ZblY",
+	"p1XUE/psF7tig175TPSH2IEicI2rTBu2UBOJu1gabRtaa/KGmrce7QEg61uPVUQjgz8hU/cnJJKryfXd",
+	"al20r7sa0kYgzrmPp54MrBxvH2ngC2SvflN8oDqX34yyT+jPaCxfjxXj/gxftK1uIk+nJydyhdtEqFeC",
+	"NZfVL3h1DqrZBXOfN2108k5SzPHN2Y+r6WYCi/nso6tirpD4PwoqtVJQ38vSamf/iZEaQCDxU2L0V+EO",
+	"8FutAeC54EcH0X4367IRN5MP47vZZg3juYq58/XGdS/S8xHuSBJIt76WCoAOYvLzmTDIv/0ztdFqS4hs",
+	"hGjuXQHy/Vutr7YRTL27tgJG276io+EoJNcGngrGn34u9yLtnOjr5vJ/HmgX/yE6Ff9QUJndffVOVeQG",
+	"p2SL8/VmNZ7O3dstjjSZdBTYPU9UoX/KOilYSBkNk3AE53p7SjZ4cV4O48s2SJSjveqFoFh2a8NUPrWh",
+	"DPP7g8WuC6bKbPiuLo4jnaymHoSU5afVesfOYfjtd99VnGj6NxedoefzRzD4x09k+K+f/+/bQZ3Sjt1V",
+	"r9pYBAAAAJAw+muCHSnIJufoNMfyOTuZlpP5ofF3vZxcTz9Mr51zyH2U8fruQrOx6pGqam1G+5i1abQ1",
+	"Ji4F7mi2TTXEmoijclrvq45jVQLr5rxSU8btcvMRztTtT5rZu27KJIzkPvvfmRH89ntxy/BDNd2tXp/C",
+	"eLvFSMaqoA7frxfzLDM+3COrN4WprHcoGW+PGi+Z6m+t1qSqy9NsNTyuOKdUIZazyd9hPllvJjew3qzu",
+	"rjd3K/cT1HXaqvEy1zLujjHG9gq4ZSm0NgSB/XKm8Xqm+YKmfKpvSYNnanCIxuzOeZHlrH/Kab/lvN98",
+	"4geQ5CFu+i8bu6wa5XX89MzZjj60lgYdqxdh5aTsULrI/nJq4Nh9+TVwQPwn1QXimzxkFekG5baS2s1I",
+	"XzdY3I6XJzbw3ZLoxObMKg9Z4fwUmD37MTOvezoT2oK2JTXO/zcA5miNURBOAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
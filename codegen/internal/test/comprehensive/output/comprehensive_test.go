@@ -1,6 +1,8 @@
 package output
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
@@ -44,3 +46,53 @@ func TestNullableRefAnyOf(t *testing.T) {
 		t.Errorf("NullableObject.ID = %d, want 1", val.ID)
 	}
 }
+
+// TestByteFieldBase64 verifies that format: byte fields hold the decoded data,
+// and are base64-encoded exactly once in JSON.
+func TestByteFieldBase64(t *testing.T) {
+	data, err := json.Marshal(AllTypesRequired{
+		EmailField: types.Email("pet@example.com"),
+		Ipv4Field:  types.MustParseIPv4("192.0.2.1"),
+		Ipv6Field:  types.MustParseIPv6("2001:db8::1"),
+		ByteField:  types.Base64Bytes("hello"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["byteField"] != "aGVsbG8=" {
+		t.Errorf("byteField = %v, want %q", raw["byteField"], "aGVsbG8=")
+	}
+
+	var decoded AllTypesRequired
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if string(decoded.ByteField) != "hello" {
+		t.Errorf("ByteField = %q, want %q", decoded.ByteField, "hello")
+	}
+}
+
+// TestIPFields verifies that format: ipv4 and ipv6 fields are validated
+// netip.Addr wrappers.
+func TestIPFields(t *testing.T) {
+	var decoded AllTypesRequired
+	err := json.Unmarshal([]byte(`{"ipv4Field":"192.0.2.1","ipv6Field":"2001:DB8::1"}`), &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Ipv4Field.Is4() || decoded.Ipv4Field.String() != "192.0.2.1" {
+		t.Errorf("Ipv4Field = %v, want 192.0.2.1", decoded.Ipv4Field)
+	}
+	if decoded.Ipv6Field.String() != "2001:db8::1" {
+		t.Errorf("Ipv6Field = %v, want 2001:db8::1", decoded.Ipv6Field)
+	}
+
+	err = json.Unmarshal([]byte(`{"ipv4Field":"2001:db8::1"}`), &decoded)
+	if !errors.Is(err, types.ErrInvalidIPv4) {
+		t.Errorf("err = %v, want %v", err, types.ErrInvalidIPv4)
+	}
+}
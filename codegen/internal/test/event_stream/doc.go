@@ -0,0 +1,5 @@
+// Package event_stream tests the EventWriters generated for servers of
+// operations streaming text/event-stream responses.
+package event_stream
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,351 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+// #/components/schemas/Price
+type Price struct {
+	Symbol string  `form:"symbol" json:"symbol"`
+	Price  float64 `form:"price" json:"price"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Price) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7SSQU+rQBSF9/yKk76XsHkPsLqavYkmXZjUnXExwC2MgbnjzKWx/94wtIIaa2IiqxnO",
+	"Obnnu8COrHZGYXWZrbNilRi7Y5UAe/LBsFW4yIqsSAAx0pHC9Z6sYCuedI97CpI4LW0YI7nzpqJ4BBqS",
+	"6QCwI6/FsL2tFUJM3kXnUfcUHNtwSo5Pui6KdL4CNYXKGyexUkxjcLUWCgtTxVbIyjIHCL1ITmPr/9Ps",
+	"9zJghPpt1VKvPyrAX087hfRPXnHv2JKVkIfoDXlskY7cHTffUIs23YabHxNvuEFn7C/TysFR/ELGNiNX",
+	"S7qT9jzZ5DnLdfU1101MH5J5vaP1uOEpFdeskmVDLp+okreZz4PxVCs8hENfcvcP8T98POrOj33FLEtN",
+	"xvn+Cf0UXYyeXXboS/KL1zv2vRaFmoeyo+R1ACFEvftUAwAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /health)
+	Health(w http.ResponseWriter, r *http.Request)
+
+	// (GET /logs)
+	TailLogs(w http.ResponseWriter, r *http.Request)
+
+	// (GET /prices)
+	StreamPrices(w http.ResponseWriter, r *http.Request)
+}
+
+// NewTailLogsEventWriter starts the text/event-stream response of
+// TailLogs on w, whose events carry string data. Call it before writing
+// anything else to the response.
+func NewTailLogsEventWriter(w io.Writer) *oapiCodegenHelpersPkg.EventWriter[string] {
+	return oapiCodegenHelpersPkg.NewEventWriter[string](w)
+}
+
+// NewStreamPricesEventWriter starts the text/event-stream response of
+// StreamPrices on w, whose events carry Price data. Call it before writing
+// anything else to the response.
+func NewStreamPricesEventWriter(w io.Writer) *oapiCodegenHelpersPkg.EventWriter[Price] {
+	return oapiCodegenHelpersPkg.NewEventWriter[Price](w)
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TailLogs operation middleware
+func (siw *ServerInterfaceWrapper) TailLogs(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TailLogs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamPrices operation middleware
+func (siw *ServerInterfaceWrapper) StreamPrices(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamPrices(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.Health)
+	m.HandleFunc("GET "+options.BaseURL+"/logs", wrapper.TailLogs)
+	m.HandleFunc("GET "+options.BaseURL+"/prices", wrapper.StreamPrices)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
@@ -0,0 +1,80 @@
+package output
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+type streamServer struct{}
+
+func (streamServer) Health(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (streamServer) TailLogs(w http.ResponseWriter, r *http.Request) {
+	events := NewTailLogsEventWriter(w)
+	_ = events.Send("starting")
+	_ = events.Send("ready")
+}
+
+func (streamServer) StreamPrices(w http.ResponseWriter, r *http.Request) {
+	events := NewStreamPricesEventWriter(w)
+	_ = events.Retry(5 * time.Second)
+	_ = events.Send(Price{Symbol: "ACME", Price: 12.5})
+	_ = events.SendEvent(helpers.Event[Price]{ID: "2", Name: "close", Data: Price{Symbol: "ACME", Price: 13}})
+}
+
+// readEvents parses the data and event fields of a server-sent event stream.
+func readEvents(t *testing.T, body string) (names, data []string) {
+	t.Helper()
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	name := ""
+	for scanner.Scan() {
+		field, value, _ := strings.Cut(scanner.Text(), ": ")
+		switch field {
+		case "event":
+			name = value
+		case "data":
+			names = append(names, name)
+			data = append(data, value)
+			name = ""
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return names, data
+}
+
+func TestEventWriters(t *testing.T) {
+	srv := httptest.NewServer(Handler(streamServer{}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/prices")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var body strings.Builder
+	_, err = bufio.NewReader(resp.Body).WriteTo(&body)
+	require.NoError(t, err)
+	names, data := readEvents(t, body.String())
+	require.Len(t, data, 2)
+	assert.Equal(t, []string{"", "close"}, names)
+	var price Price
+	require.NoError(t, json.Unmarshal([]byte(data[1]), &price))
+	assert.Equal(t, Price{Symbol: "ACME", Price: 13}, price)
+
+	rec := httptest.NewRecorder()
+	Handler(streamServer{}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs", nil))
+	_, data = readEvents(t, rec.Body.String())
+	assert.Equal(t, []string{"starting", "ready"}, data)
+}
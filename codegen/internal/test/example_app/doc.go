@@ -0,0 +1,6 @@
+// Package example_app tests generation of the example application, which
+// serves a stub of the generated server and calls it with the generated
+// client.
+package example_app
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,30 @@
+// Command example serves the operations of package api with
+// the stub Server of server.go, as a starting point for the service.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	api "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/example_app/api"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	handler, err := newHandler(NewServer())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Server listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, handler))
+}
+
+// newHandler wires si into std-http, returning its routes as an
+// http.Handler.
+func newHandler(si api.ServerInterface) (http.Handler, error) {
+	return api.Handler(si), nil
+}
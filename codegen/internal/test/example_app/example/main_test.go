@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/example_app/api"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// zero returns the zero value of T, as the arguments of requests made before
+// they're filled in.
+func zero[T any]() T {
+	var v T
+	return v
+}
+
+// newClient starts server serving the handler of the stub Server, and returns
+// a client of it.
+func newClient(t *testing.T, server func(t *testing.T, handler http.Handler) *httptest.Server) *api.Client {
+	t.Helper()
+	handler, err := newHandler(NewServer())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := api.NewClient(server(t, handler).URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// newTestServer starts an httptest.Server, closed when the test ends.
+func newTestServer(t *testing.T, handler http.Handler) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestRoutes checks that the client reaches a handler of the stub Server
+// for every operation.
+func TestRoutes(t *testing.T) {
+	c := newClient(t, newTestServer)
+
+	t.Run("DeleteOwnerPets", func(t *testing.T) {
+		resp, err := c.DeleteOwnerPets(context.Background(), "example", api.Kind("cat"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+			t.Errorf("DELETE /owners/{name}/pets/{kind} isn't routed: %s", resp.Status)
+		}
+	})
+
+	t.Run("ListPets", func(t *testing.T) {
+		resp, err := c.ListPets(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+			t.Errorf("GET /pets isn't routed: %s", resp.Status)
+		}
+	})
+
+	t.Run("AddPet", func(t *testing.T) {
+		resp, err := c.AddPetWithBody(context.Background(), "application/json", http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+			t.Errorf("POST /pets isn't routed: %s", resp.Status)
+		}
+	})
+
+	t.Run("GetPet", func(t *testing.T) {
+		resp, err := c.GetPet(context.Background(), zero[oapiCodegenTypesPkg.UUID]())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+			t.Errorf("GET /pets/{id} isn't routed: %s", resp.Status)
+		}
+	})
+}
+
+// TestOperations calls every operation through a server validating the
+// responses of the Server against the spec. Each is skipped until its
+// handler is implemented, and its request filled in.
+func TestOperations(t *testing.T) {
+	c := newClient(t, func(t *testing.T, handler http.Handler) *httptest.Server {
+		return api.NewValidatingTestServer(t, handler)
+	})
+
+	t.Run("DeleteOwnerPets", func(t *testing.T) {
+		t.Skip("TODO: implement Server.DeleteOwnerPets, then fill in its request")
+
+		resp, err := c.DeleteOwnerPets(context.Background(), "example", api.Kind("cat"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Errorf("DELETE /owners/{name}/pets/{kind}: %s", resp.Status)
+		}
+	})
+
+	t.Run("ListPets", func(t *testing.T) {
+		t.Skip("TODO: implement Server.ListPets, then fill in its request")
+
+		resp, err := c.ListPets(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Errorf("GET /pets: %s", resp.Status)
+		}
+	})
+
+	t.Run("AddPet", func(t *testing.T) {
+		t.Skip("TODO: implement Server.AddPet, then fill in its request")
+
+		resp, err := c.AddPetWithBody(context.Background(), "application/json", http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Errorf("POST /pets: %s", resp.Status)
+		}
+	})
+
+	t.Run("GetPet", func(t *testing.T) {
+		t.Skip("TODO: implement Server.GetPet, then fill in its request")
+
+		resp, err := c.GetPet(context.Background(), zero[oapiCodegenTypesPkg.UUID]())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			t.Errorf("GET /pets/{id}: %s", resp.Status)
+		}
+	})
+}
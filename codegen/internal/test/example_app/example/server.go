@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+
+	api "github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/example_app/api"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// Server implements api.ServerInterface. Its handlers are stubs,
+// responding 501 Not Implemented until they're written.
+type Server struct{}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+// NewServer returns a Server.
+func NewServer() *Server {
+	return &Server{}
+}
+
+// DeleteOwnerPets handles DELETE /owners/{name}/pets/{kind}.
+func (s *Server) DeleteOwnerPets(w http.ResponseWriter, r *http.Request, name string, kind api.Kind, params api.DeleteOwnerPetsParams) {
+	http.Error(w, "not implemented", http.StatusNotImplemented)
+}
+
+// ListPets handles GET /pets.
+func (s *Server) ListPets(w http.ResponseWriter, r *http.Request, params api.ListPetsParams) {
+	http.Error(w, "not implemented", http.StatusNotImplemented)
+}
+
+// AddPet handles POST /pets.
+func (s *Server) AddPet(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "not implemented", http.StatusNotImplemented)
+}
+
+// GetPet handles GET /pets/{id}.
+func (s *Server) GetPet(w http.ResponseWriter, r *http.Request, id oapiCodegenTypesPkg.UUID) {
+	http.Error(w, "not implemented", http.StatusNotImplemented)
+}
@@ -13,8 +13,8 @@ import (
 
 // #/components/schemas/Document
 type Document struct {
-	Name   *string `form:"name,omitempty" json:"name,omitempty"`
-	Status *string `form:"status,omitempty" json:"status,omitempty"`
+	Name   *string          `form:"name,omitempty" json:"name,omitempty"`
+	Status *Document_Status `form:"status,omitempty" json:"status,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -31,6 +31,21 @@ const (
 	Four  Document_Status = "four"
 )
 
+// Values returns the Document_Status constants, in the order of the spec.
+func (Document_Status) Values() []Document_Status {
+	return []Document_Status{One, Two, Three, Four}
+}
+
+// ParseDocument_Status returns the Document_Status constant whose value is s. Other strings
+// return an error.
+func ParseDocument_Status(s string) (Document_Status, error) {
+	switch v := Document_Status(s); v {
+	case One, Two, Three, Four:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Document_Status value %q", s)
+}
+
 // #/components/schemas/DocumentStatus
 type DocumentStatus struct {
 	Value *string `form:"value,omitempty" json:"value,omitempty"`
@@ -42,13 +57,13 @@ func (s *DocumentStatus) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9RSPYvbQBDt9Sse54AaW1Lk5tg6EI4UKS5dCGFvPZb2sHaWnZFzgfz4IMkfkiHE7XWa",
-	"maf3wb4VnkR6wuO2NnjbNLzR35E2wXaEPSdQ6DsMK8lWaFWjmLJsvLb9S+G4K9lGv3G8o4bCcvADr5SP",
-	"2zrjSMFGb7AtqqLOfNizyYAjJfEcDPKqqIqPeQao1wMZ0Jvt4oEyYEfiko864v5kAPCNRG+t8pFS8ruZ",
-	"55g4UlJPkkWrrQyC5YnXjDwN6fQBDFA7aDztLuKfSU/XRBI5CMkZDuR1VeXX8cbnw9cvD7Ob46AUdA4H",
-	"chvjwbtRtXwVDvnyDohrqbO3W+BDor1Bviodd5EDBZVywkr5iV3fUdB8lra+N279fvM+q9Ve8uwKGXhO",
-	"qInyDD0LDOUx4JdXcueHvnbm6mKo19zT9Jto8qG5rGWUn8OW/TQX9Z+T0/8RYiyxwXcOtIb+4jW0TURr",
-	"7LlPPxaBnhfid8Y62kP/71x/AwAA//+qyWhEFgQAAA==",
+	"H4sIAAAAAAAC/9RSTWvbQBC97694xAVdbEuVL2HPhRJ66CG9lVI267G0wdpZdkZuCv3xRXZkSYbSXHPb",
+	"mXl6H+it8CDSE+53tcXLpuGN/k60ia4jHDiDYt9hWIlZoVVNYsuyCdr2T1vPXckuhY3nPTUUl0MYeKW8",
+	"39WGE0WXgsVuW21rE+KBrQFOlCVwtCiqbbX9WBhAgx7Jgl5cl45kgD2JzyHpGffHAMA3Er21yifKOexn",
+	"nlPmRFkDiUlOW7EGKF95hzfQkF4ewAB1g8bD/ir+mfT1mkkSRyEZ4UBRV1UxjTc+775+uZvdPEelqHM4",
+	"ULiUjsGfVctn4Vgs74D4ljp3uwU+ZDpYFKvSc5c4UlQpL1gpP7HvO4pazNLWb41bv9+8j+q0l8JMEGtG",
+	"RrlQjtBRYCiPBT89kx9/9NSZycVQr2kaPxPNITbXtZzl57BlP+1V/efF6f8IcS6xxXeOtIb+4jW0zURr",
+	"HLjPPxaBHhfib4x1csf+37n+DgCqyWhEFgQAAA==",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -25,7 +25,7 @@ func TestEnumTypeGeneration(t *testing.T) {
 
 func TestDocumentWithStatus(t *testing.T) {
 	name := "test"
-	status := "one"
+	status := One
 	doc := Document{
 		Name:   &name,
 		Status: &status,
@@ -43,6 +43,21 @@ const (
 	Option2 TestField1Item = "option2"
 )
 
+// Values returns the TestField1Item constants, in the order of the spec.
+func (TestField1Item) Values() []TestField1Item {
+	return []TestField1Item{Option1, Option2}
+}
+
+// ParseTestField1Item returns the TestField1Item constant whose value is s. Other strings
+// return an error.
+func ParseTestField1Item(s string) (TestField1Item, error) {
+	switch v := TestField1Item(s); v {
+	case Option1, Option2:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid TestField1Item value %q", s)
+}
+
 // #/components/schemas/Test/properties/field2
 // A nested object with allocated name
 type MyTestRequestNestedField struct {
@@ -67,14 +82,14 @@ func (s *TestField3) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9xUzZKbMAy+8xSapFdCIO106lv30Jkc2kOnL+AYBbwDltcS283bd2zYAJt0Z3otJ/xZ",
-	"P58+WdrCkXlAKA9fPit40GwNyMUjNOgwaLHk4LeVFl7yhvJ4kzvdY7aFVsSzKorGSjucdob6grS3uaEa",
-	"G3Trg41JuIhZMvLotLcKNofdflduMuvOpDIAsdKhWhCCX8iSATxjYEtOQbnb7/aZod6TQyccvdi02Ov0",
-	"C8lh/INUhgI6PaKRCfKBPAaxyK9GAGeLXV3OZ4Aa2QTrJaX8CjoEfQE6A7qhh2fdDcgLayvY89IdkuEa",
-	"yYFSvPIuWq3QkTdLsK5ZXPT65ZhSwaclat2E7rO3IRLxdZ3VO3U6ZMF6Emxsuu46Mjqiqemz6z0h74s5",
-	"0zkRdajdrX113/5GgYBPgw1YL83zKeUtVN3osXoK8Vu/aQXfL/H9/MSnAVl+JDm+xVBrDQ//pCEN8j/L",
-	"+K6EmdfSptIKuQ5mg9cJjcWnFXOsFcg4668MkeWB6stM0pATdLJkrb3vrEkRikcmtxZgXAxvRfkQ8Kxg",
-	"sy3mLVJMK6SI1DdXDuzJ8bIz1f7jX1vfENXZnwAAAP//PTfE300FAAA=",
+	"H4sIAAAAAAAC/9xUy47bMAy8+ysG2V4d59GiqG/dQ4Ec2kPRH1BkxtHCFrUivd38fWHFie1NukCv9Uka",
+	"jcjhUOYDdiIdYb398rnEoxFnoadAqMlTNOrY47fTI17zmvP+JPempewBR9UgZVHUTo/dfmm5LdgEl1uu",
+	"qCY/37g+iRR9lowDeRNcicV2uVquF5nzBy4zQJ02VE4E4ReJZsALRXHsS6yXq+Uqs9wG9uRV+ltij9Sa",
+	"tES6cF4hlVGC909kdYBC5EBRHcmFBBwcNdV63AMViY0uaEr5FSZGcwIfQL5r8WKajmTCdkqtTK8jEedI",
+	"Dk7x1nfRzQw96xaNzteTg9a87lIqfJqizg/oKnsbIgmf17l5p05PolQNhp2bbpqGrenR1HRcv3tG3jdz",
+	"lLNnbsj4W/7mPv/GgUjPnYtUTen5kPIW2tz4MXsKAN686RLfT/37+UnPHYn+SHZ860PNPdz+k4fc6f9s",
+	"47sWZsHoMZVW6PXHrGlYAByGEbOrSvSMbFRIoo9cnS5UwLJX8joCgAmhcTZFKJ6E/fTsMhjmGPAh0qHE",
+	"4qEYp0hxZkrRS19cNUhgL9PObFYf/9r6mrnK/gwAPTfE300FAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
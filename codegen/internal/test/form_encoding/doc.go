@@ -0,0 +1,5 @@
+// Package form_encoding tests that encoding objects declared on form and
+// multipart request bodies are honored by clients and server binders.
+package form_encoding
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
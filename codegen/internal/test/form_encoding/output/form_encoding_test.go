@@ -0,0 +1,142 @@
+package output
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type formServer struct {
+	t       *testing.T
+	photo   PhotoUpload
+	search  SearchForm
+	parts   []string
+	request *http.Request
+}
+
+func (s *formServer) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	s.request = r
+	require.NoError(s.t, r.ParseMultipartForm(1<<20))
+	require.NoError(s.t, BindUploadPhotoMultipartBody(r.MultipartForm, &s.photo))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *formServer) UploadAlbum(w http.ResponseWriter, r *http.Request) {
+	s.request = r
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	require.NoError(s.t, err)
+	require.Equal(s.t, "multipart/mixed", mediaType)
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(s.t, err)
+		s.parts = append(s.parts, p.Header.Get("Content-Disposition"))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *formServer) Search(w http.ResponseWriter, r *http.Request) {
+	s.request = r
+	require.NoError(s.t, r.ParseForm())
+	require.NoError(s.t, BindSearchFormdataBody(r.PostForm, &s.search))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newFormServer(t *testing.T) (*formServer, *Client) {
+	t.Helper()
+	server := &formServer{t: t}
+	srv := httptest.NewServer(Handler(server))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(srv.URL)
+	require.NoError(t, err)
+	return server, client
+}
+
+func TestMultipartEncoding(t *testing.T) {
+	server, client := newFormServer(t)
+
+	camera := "pinhole"
+	iso := 400
+	body := PhotoUpload{
+		Title:    "sunset",
+		Metadata: &PhotoMetadata{Camera: &camera, Iso: &iso},
+		Keywords: []string{"sun", "sea"},
+	}
+	body.Photo.InitFromBytes([]byte("png-bytes"), "sunset.png")
+
+	resp, err := client.UploadPhoto(context.Background(), body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	assert.Equal(t, "sunset", server.photo.Title)
+	assert.Equal(t, body.Metadata, server.photo.Metadata)
+	assert.Equal(t, []string{"sun", "sea"}, server.photo.Keywords)
+
+	data, err := server.photo.Photo.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "png-bytes", string(data))
+	assert.Equal(t, "sunset.png", server.photo.Photo.Filename())
+
+	// The photo part uses the declared content type and header defaults
+	files := server.request.MultipartForm.File["photo"]
+	require.Len(t, files, 1)
+	assert.Equal(t, "image/png", files[0].Header.Get("Content-Type"))
+	assert.Equal(t, "camera", files[0].Header.Get("X-Photo-Source"))
+}
+
+func TestMultipartMixedEncoding(t *testing.T) {
+	server, client := newFormServer(t)
+
+	body := PhotoUpload{Title: "sunset"}
+	body.Photo.InitFromBytes([]byte("png-bytes"), "sunset.png")
+
+	resp, err := client.UploadAlbum(context.Background(), body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	assert.Equal(t, []string{
+		`inline; name="title"`,
+		`attachment; name="photo"; filename="sunset.png"`,
+	}, server.parts)
+}
+
+func TestFormEncoding(t *testing.T) {
+	server, client := newFormServer(t)
+
+	color := "red"
+	size := 2
+	body := SearchForm{
+		Query:  "shoes",
+		Tags:   []string{"new", "sale"},
+		Filter: &SearchFilter{Color: &color, Size: &size},
+	}
+
+	resp, err := client.Search(context.Background(), body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	assert.Equal(t, body, server.search)
+	assert.Equal(t, "new|sale", server.request.PostForm.Get("tags"))
+	assert.Equal(t, "red", server.request.PostForm.Get("filter[color]"))
+}
+
+func TestNewSearchRequestBody(t *testing.T) {
+	req, err := NewSearchRequest("https://example.com", SearchForm{Query: "q", Tags: []string{"a", "b"}})
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "query=q&tags=a%7Cb", string(data))
+	assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+}
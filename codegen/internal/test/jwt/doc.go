@@ -0,0 +1,5 @@
+// Package jwt tests the server authenticators generated for security schemes
+// of type http with the bearer scheme.
+package jwt
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
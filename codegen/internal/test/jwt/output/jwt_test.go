@@ -0,0 +1,100 @@
+package output
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("s3cret")
+
+// signToken signs claims with secret as an HS256 JWT.
+func signToken(t *testing.T, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type petServer struct{}
+
+func (petServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	claims, _ := PetsAuthClaimsFromContext(r.Context())
+	var custom struct {
+		Owner string `json:"owner"`
+	}
+	_ = claims.Decode(&custom)
+	_ = json.NewEncoder(w).Encode([]Pet{{Name: custom.Owner + "'s pet, for " + claims.Subject}})
+}
+
+func newServer(t *testing.T, keyFunc helpers.JWTKeyFunc) *httptest.Server {
+	server := httptest.NewServer(NewPetsAuthAuthenticator(keyFunc).Middleware(Handler(petServer{})))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func get(t *testing.T, url, token string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, url+"/pets", nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestBearerClaims(t *testing.T) {
+	server := newServer(t, func(ctx context.Context, header helpers.JWTHeader) (any, error) {
+		return secret, nil
+	})
+	token := signToken(t, map[string]any{
+		"sub":   "alice",
+		"owner": "bob",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+
+	resp := get(t, server.URL, token)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var pets []Pet
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&pets))
+	assert.Equal(t, []Pet{{Name: "bob's pet, for alice"}}, pets)
+}
+
+func TestBearerClaimsRejected(t *testing.T) {
+	server := newServer(t, func(ctx context.Context, header helpers.JWTHeader) (any, error) {
+		return []byte("other"), nil
+	})
+
+	resp := get(t, server.URL, signToken(t, map[string]any{"sub": "alice"}))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, `Bearer error="invalid_token"`, resp.Header.Get("WWW-Authenticate"))
+
+	resp = get(t, server.URL, "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestBearerClaimsUnverified(t *testing.T) {
+	server := newServer(t, nil)
+
+	resp := get(t, server.URL, signToken(t, map[string]any{"sub": "alice", "owner": "carol"}))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var pets []Pet
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&pets))
+	assert.Equal(t, []Pet{{Name: "carol's pet, for alice"}}, pets)
+}
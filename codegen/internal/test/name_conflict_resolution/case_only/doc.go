@@ -0,0 +1,5 @@
+// Package case_only tests that fields, parameters and operations whose names
+// differ only by case get distinct Go names.
+package case_only
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
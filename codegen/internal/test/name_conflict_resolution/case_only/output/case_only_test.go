@@ -0,0 +1,51 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestCaseOnlyFields(t *testing.T) {
+	user := User{ID0: ptr("a"), ID1: ptr(1), UserName: ptr("Ada"), Username: ptr("ada")}
+	data, err := json.Marshal(user)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"a","ID":1,"userName":"Ada","username":"ada"}`, string(data))
+
+	var decoded User
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, user, decoded)
+}
+
+func TestCaseOnlyParamsAndOperations(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	resp, err := client.GetUser1(context.Background(), &GetUser1Params{Id0: ptr("query"), Id1: ptr("header")})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "/users", got.URL.Path)
+	assert.Equal(t, "query", got.URL.Query().Get("id"))
+	assert.Equal(t, "header", got.Header.Get("Id"))
+
+	resp, err = client.GetUser0(context.Background())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "/Users", got.URL.Path)
+}
@@ -0,0 +1,5 @@
+// Package client_helpers tests that schemas can share names with the runtime
+// helpers used by opt-in client features, when the helpers are inlined.
+package client_helpers
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,39 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFaultSchema verifies that schemas named Fault, Breaker and Clock are
+// generated as-is, since fault injection, breakers and clocks aren't part of a
+// client without a runtime package.
+func TestFaultSchema(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/faults/f1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		state := "open"
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		_ = json.NewEncoder(w).Encode(Fault{Code: "E42", Breaker: &Breaker{State: &state}, Clock: &Clock{Now: &now}})
+	}))
+	defer srv.Close()
+
+	client, err := NewSimpleClient(srv.URL)
+	require.NoError(t, err)
+
+	fault, err := client.GetFault(context.Background(), "f1")
+	require.NoError(t, err)
+	assert.Equal(t, "E42", fault.Code)
+	assert.Nil(t, fault.Detail)
+	require.NotNil(t, fault.Breaker)
+	assert.Equal(t, "open", *fault.Breaker.State)
+	require.NotNil(t, fault.Clock)
+	assert.True(t, fault.Clock.Now.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -29,7 +29,8 @@ type GetSomething200ResponseJSON1 = []GetSomething200ResponseJSON2
 // #/paths//something/get/responses/200/content/application/json/schema/properties/results/items
 
 type GetSomething200ResponseJSON2 struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsGetSomething200ResponseJSONAnyOf0 returns the union data inside the GetSomething200ResponseJSON2 as a GetSomething200ResponseJSONAnyOf0.
@@ -43,6 +44,7 @@ func (t GetSomething200ResponseJSON2) AsGetSomething200ResponseJSONAnyOf0() (Get
 func (t *GetSomething200ResponseJSON2) FromGetSomething200ResponseJSONAnyOf0(v GetSomething200ResponseJSONAnyOf0) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -54,6 +56,7 @@ func (t *GetSomething200ResponseJSON2) MergeGetSomething200ResponseJSONAnyOf0(v
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -68,6 +71,7 @@ func (t GetSomething200ResponseJSON2) AsGetSomething200ResponseJSONAnyOf11() (Ge
 func (t *GetSomething200ResponseJSON2) FromGetSomething200ResponseJSONAnyOf11(v GetSomething200ResponseJSONAnyOf11) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -79,9 +83,46 @@ func (t *GetSomething200ResponseJSON2) MergeGetSomething200ResponseJSONAnyOf11(v
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *GetSomething200ResponseJSON2) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsGetSomething200ResponseJSONAnyOf0(); err == nil {
+		t.matched[0] = true
+	}
+	if _, err := t.AsGetSomething200ResponseJSONAnyOf11(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesGetSomething200ResponseJSONAnyOf0 reports whether the union data matches GetSomething200ResponseJSONAnyOf0.
+func (t GetSomething200ResponseJSON2) MatchesGetSomething200ResponseJSONAnyOf0() bool {
+	return t.matched[0]
+}
+
+// MatchesGetSomething200ResponseJSONAnyOf11 reports whether the union data matches GetSomething200ResponseJSONAnyOf11.
+func (t GetSomething200ResponseJSON2) MatchesGetSomething200ResponseJSONAnyOf11() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t GetSomething200ResponseJSON2) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t GetSomething200ResponseJSON2) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -89,6 +130,7 @@ func (t GetSomething200ResponseJSON2) MarshalJSON() ([]byte, error) {
 
 func (t *GetSomething200ResponseJSON2) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -129,14 +171,14 @@ func (s *GetSomething200ResponseJSONAnyOf12) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/7xTTW+bQBC98yue4ksrJeC0VaVy66nyKVJTqcdoDWOYyMxud4ZY/vfVgqlJncTJJZyY",
-	"fTP7PhgWWKn2hOsv376W+C5e9p3vFX59T5VBq5Y6hx1bCy90s0FDQtEZKVge3JZrcE1ivGGK2QKtWdCy",
-	"KBq2tl/nle8K7wJfVb6mhuRxwYlai8SdLbIFfrckcAKWLQtN5CxwiKTBixJ6JYWT/c2mGARdwlr6p6qG",
-	"7QNBXEfZAjunUHPRWJrRg4P03ZoiPlDe5Jf4tFze/STtt6Z3K6PuI3YtVy14Zk/ww+eZDyQucInP+TJf",
-	"ZiwbX2aAsW2pnIWIX6SWAQ8Ulb2UuB76g7NW00ChviNrWZpUAQ3Z+AL4kDywl1VdpvPbqfOATxnoNICk",
-	"/1gANWkVOdhAfHHbVxWpXswaKi9GYvMZwIWw5WpgLu7Vy2MUh+/w/ymGqMvDppyAkf70HKk+HQOukpWU",
-	"+QkWYgrBeO5xfucw9RQ0qXExuv2TOBt1z4xiXKjnwCT4Ra+v1X98fKwpvtwyOVKLxxV4B2kUo3+ltLOc",
-	"b+Ed17Om810T/fgrn23vSNU1b7j3kPjfAAAA///mDrwBGwUAAA==",
+	"H4sIAAAAAAAC/7xUTW+bQBC98yue4ksrJeC0VaVy66nyKVJTqcdoDWN2IjO73Rli+d9XgIlJncTJpT55",
+	"d97b98HCAivVjnD95dvXEt8lyL4NnSKs76kyaOWpddixeQShmw0aEkrOSMHy4LZcg2sS4w1TyhbwZlHL",
+	"omjYfLfOq9AWwUW+qkJNDcnTBffSWvTa2SJb4LcngROwbFloEmeBQyKNQZTQKSmc7G82xWDoEubp0VUN",
+	"20eCuJayBXZOoeaSsTRjBgfp2jUlfKC8yS/xabm8+0nabU3vVkbtR+w8Vx48iyf4EfIsRBIXucTnfJkv",
+	"M5ZNKDPA2LZUzkrEL1LLgAdKykFKXA/46MxrTyg0tGSepelXQEM2/gFC7DNwkFVd9vu3EzIDADx2oBMB",
+	"vf/jAqhJq8TRBuGL266qSPViBqiCGInNOYCLccvVoFzca5CnUxyew7+7GKouDzflZJjoT8eJ6lMacIU0",
+	"dn4yi6kvwXiecX7mwHpuNLlxKbn9s3M2al+gYrxQLw2Bq9ezvtX/8RdSTel1yJRILR2vwH+wRimFN1o7",
+	"q/keXQDoPwznUZP8+Cqfhbek6pp3nHto/O8A5g68ARsFAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -8,14 +8,18 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	oapiCodegenJSONPointerPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/jsonpointer"
 	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
 	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
@@ -460,46 +464,46 @@ func (s *CreatePetJSONRequest) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+xaWXMbuRF+56/oolJFqZaUKMoq21OVB0vrXduJI/moJOWXFDTT5GB3BhgDGInK8d9T",
-	"OObAXBxaWnudih4kEUej0efXDfIMGcloAGfHy+PTyYSyNQ8mAIqqBAOYXvI0Exgjk/QWgZEUIeRJQiXl",
-	"DARKnuRK/6tQqukEIEIZCprpsQD+PQEAeLlFEVKJEkiSQMTDPEWmMGpSy4hSKJgEEgouJVApc72JRXD9",
-	"XgaG1sFquZzDwer8ifl9rn8/X83h4Mny6RwOnj1/PoeD07Nz/eH0yfnS/HmqF58+PT3Tf549O9Wbls/1",
-	"ktXq9MzQfZFIDiG/RSGBk4wuQh7hBtkCt9nB6RM4pCyhDPWNM84kAr/5BUMFd1TF8AeBa8gEz1AoivLo",
-	"eAKgKRkZLI+Xx8vJJCMqNnc4gGt7T3gRwKW+6EJiaGRYieLQ3d27rr2ivcD1e3PxI0NwekHEFEiWIRES",
-	"KAMZxpgSOYeMCJKiQiHnIPBzjlJd8Iii+WhvIudGwjGSCIXUrJ+sObfSzrhU9j8AfTui2XwdBWbiJ87d",
-	"VHVKsRhgYaQSwOzgJORpxhkyJU+qlScXRMzc6oqz+4pA13bvCj4Fd5lq/2q5rD70kXO7LClPORcBfDBS",
-	"hFsJYUKRKbgTWsai0k7DtJ6cL61C3M7ppUCi8LXC9L07amqVHKG0phOWKwrqRgNUYSp36KDaOiRGPUgF",
-	"RgEokWM5HHKmkKm6hEiWJTQ05E9+kZzV58CZlD8GoO4zDJwvNKYqf2huAuP47dGCnFSCss1ozXoR5+pP",
-	"tZmOS+66Zt9Fuw3I+dlJW8/Gmur2dFnaE0kokb1WZaPXkdtdGNKfqVSavKzs6NCKyZI7aphVUqyvWxXA",
-	"BrtNqVz+PQm9JZOGB/8YwFVxSZtq/ujOqaK4GS4Evzo/9933XY7ivs9zP+tJz2nNyA6nNWu+O3/9/L/k",
-	"rJ5WGzbzsvTSlKgwRgk8+6FYW8/Kzx3a8A3mZ1QfFFG57DOaTbHAMxxphoJBFy13fk+ibsmjIe6fAtgu",
-	"NnyhTWlhfBG3SkNNzuAHCLvBUcNFt1OIiWzS0ZDGF33p82aPc9jtTqG/y7f9Ep+tlsvZeJTxLt/OduOq",
-	"+olfPUAMO852tocsmvbnaf7nmub3VvonkjWUPqhvvdzo+58k26nvTyR7NH1/ItkIfddP/F3pu2T/EfT9",
-	"KoC3eaJoliC8+XD1l4J/k0VMzVK7PBya8mZ1eubqmysRYWeFY/TuVQXH8DFGj5g2lLPiPEPPnqliokxF",
-	"qqcIZTDVQpuWpkQyiaA4qBhB6pgy1YxPQcZcqBLCHnQIfu6NpCg2uMh0PvmhPatHapOG4seYSlCCbja6",
-	"HCUMKFtTRhUClyFNEgtpblDdITJ7s60Cma/XdOvYr4RrKLo5qQRRuKEoIWcJSmkux/IUBQ1hTZLkhoS/",
-	"QkgY3Agkv5rp8D5M0PgP12oYV5YYjT2svDMkZt9TunMce4b/OoDX41sH2t6qoOboGnqHHV2Jo8LYHeGY",
-	"OGsx59WPqZ8QE+sF5ujyFqVHhUSIe11YlHwcw0sSljTuQe+TMc+TCHLtIrG1sSapmQQbmnWBMgfGlSYR",
-	"5SFClFvho10RYZgQa0G2AYFM0QqJDlUtL93KB8Sp38hQBqD2MNgGiIgiXeMFUSIEue+cr/UN2j9Dpvs3",
-	"Gm1QzTp2pqhIP0NDNN+6nQ2PeBPAj6X+C1tleLWGFNMbE/Fs/6+0ay9XGFov4PrFx8tXlT2AQJULJiHt",
-	"zzLWj4aDdSMc900uTB1nlxiyQwHfuWm128ToJjG4JYISpiybMiYCjTNH2hlCkjgZuQrWeLUT3iHjbKEV",
-	"cVRI0B7ogoWhl+ZSGRfElKoh/4M1F6BiLkvdFDQ1HSv8KKJ6LUmS+7lJEfV0aziXVda0cFwCaQUIq4/p",
-	"e5Q8FyH+4+1fr6ZHcBejwBbVQpFFlDLBy2SngqgVm5m2HU3OfMsxezQfEV2vUVTUTMgRjgt58i8a/cfl",
-	"OK2dbtymZwrGB7ugBicAjWreQ5khENeGekBed3TpLLy/KMXWRT97NNi7Wi5LwtdaUC4AeM3uwFPvrSwM",
-	"u6qxi563w4DXqDwEeMNVPAADtU4zVOPQyjWqhwnyuoqd3wVSMfxOqgm9281ZQhdEFBQ7EllX+rolSe41",
-	"dT0zLYiuHocqy3VMslTbzdc9z6BRL9t9Dev23VrdSJ+J+lKvAbUnrwJlnijZ5qWJCTqQQJuTVn9mT27q",
-	"jasB6SmaolQkzYZF6FWLRa3vSr9mbV+FjmLFXUzDWENgR+usUWKW5V5KsqKsa1V0tk1uKD6+EVn0q4aF",
-	"cF2B3QecTJnCTVmAjbFfr2IpwqoRdlWP5BIjKJMvERtUJhQ330YrLo8d7c7qxcxZ4Dlw5fL4hjz3VIIZ",
-	"f9vAsg88TnFFkn7hN+T6ppAr+JBnyLa9hYUwGw2OmRyBjkpklCW51NDI0RoFkODwjeTMpPJmyWnRYkGt",
-	"Ccp1QToAyOeg7jjwtfPdAvM6YiOQbw2aAtRltacLDbrHyGDn8fCVM9A+LJaq9Hms549G7uisZLsrWJ7t",
-	"hKoOWMe7ctPDXwlGvBMEcJlLxdN3+XbUk4FO3tsx0aNJfUD5CbnBZGxw/oK++XDnvDhoVAcd4BPJHil4",
-	"5kynk6F7NwuqGia10r0hRYbTJVX9abWJiZukPfTeot6C4UMQvL9C6+j99Pd9WkB3REZ5FXQjIa28Xvjj",
-	"d7sdxZ09b9s/vqWkIPvxPsMPZvTwqAM1fQsR+lF0MAD1wLGBPUMNnt/gLl/OXKODNY63dkexs5P4hV8f",
-	"aGaFnarpyhAjNvX40A6Y/S1MtfsLUAOXkxmGnbIdShZvggE0WTTqTEI1IaMTnRag83I8xMRtiJmq9zst",
-	"vrPwssQf/ajtwRoZan109bwe5t4PO21Pfx06rBStabi5b3S2slt3brzhPEHCbHL0Oli1rV7n6oKIcqmb",
-	"947UP39f1Hb3se921b/P+c0y8Ol+fbQayx6Z1d5kVrNJE1960n6hZ5ry/hZi6n4LaoehGlJsXOQTyX4P",
-	"F7GttC+IqA4Vm5g5UN26l4zKv2tYq/fxZ9TDT1Egj3r+GXz6cZRGPwCZXrbf3O9UspkBmYchSvk1o/rX",
-	"i+iPEs2NapsmuNjz/GLX0ONw78PwQ47qgWEsTxJyk2DjMWvnS+r/hYWT/wYAAP//uCNlHY8zAAA=",
+	"H4sIAAAAAAAC/+xaW28buRV+1684kBeQjUi2JMdIMkAfYm92k7SpnQvaIi8FNXOk4e4MOSE5ttzLfy94",
+	"mftFo8ibrIv1g23x8pHnyo+H4gkyklAPzk/np4vRiLI190YAiqoIPRhf8TgRGCKT9BaBkRjB51FEJeUM",
+	"BEoepUr/q1Cq8QggQOkLmug2D/4zAgB4tUXhU4kSSBRBwP00RqYwqKMlRCkUTALxBZcSqJSpnsQCuPkg",
+	"PYN1tJzPp3C0vHhqfl/o3y+WUzh6On82haPnL15M4WhxfqE/LJ5ezM2fZ3rw4tniXP95/nyhJ81f6CHL",
+	"5eLc4L6MJAef36KQwElCZz4PcINshtvkaPEUjimLKEMtccKZROCrX9BXcEdVCD8IXEMieIJCUZQnpyMA",
+	"jWR0MD+dn85Ho4So0MhwBDdWTnjpwZUWdCbRNzosVHHsZK+Ia0W0Atx8MIKfGMDxJRFjIEmCREigDKQf",
+	"YkzkFBIiSIwKhZyCwC8pSnXJA4pymksip0bDIZIAhdRbP1tzbrWdcKnsfwBaOqK3+SbwTMdPnLuuYpVs",
+	"MMDMaMWDydGZz+OEM2RKnhUjzy6JmLjRxc7uC4C26RURqghOmGL+cj4vPnTBuVkWqmKcSw8+Gi3CrQQ/",
+	"osgU3AmtY1FYp+ZaTy/m1iBu5vhKIFH4RmH8wS01tkYOUFrX8fMRGbqxAFUYyx02KKb2qVE3UoGBB0qk",
+	"mDf7nClkqhgH2n8i6hv4s18kZ+U+cC5VbQNQ9wl6LhZqXUU81CeBCfxmawYnlaBsM9iylYxz/edST4uQ",
+	"u8TsErTdgexYeda0s/Gmsj9d5f5EIkpkp1fZ7HXiZmeO9BcqlYaXhR8dWzVZuJOaW0XZ+LJXAWyw3ZXy",
+	"4Y9J6Q2d1CL4Rw+uMyHtUfMnt04un23OFL+8uKiG7/sUxX1X5H7RnZWgNS07gtaMeXTx+uX/KVgrVq35",
+	"zKs8SmOi/BAl8ORJNrZ8Kr9wbKPqMD+j+qiISmWX02yyARXHkabJ6w3RfOZjUnVDHzV1/+TBdrbhM+1K",
+	"MxOLuFWaanIGT8BvJ0e1EN2OISSyjqMpTVX1ecybOS5gtzuV/j7ddmt8spzPJ8NZxvt0O9nNq8orfvME",
+	"0R8428keuqj7X8XyP5csv7fRP5OkZvRee+vhxt7/IslOe38myYPZ+zNJBti7vOLvyt759h/A3q89eJdG",
+	"iiYRwtuP13/N9m9OEXNnKQkPx+Z6s1ycu/vNtQiw9YZj7F65FZzCpxArYNpRzrP1DJ5dU4VEmRup7iKU",
+	"wVgrbZy7EkkkguKgQgSpc8pYb3wMMuRC5RT2qEXx00pLjGKDs0SfJ0+avbql1GkQP4VUghJ0s0GhRQTK",
+	"1pRRhcClT6PIzIQVqjtEZiXbKpDpek23bvuFcg2i65NKEIUbihJSFqGURjiWxiioD2sSRSvi/wo+YbAS",
+	"SH413f69H6GJH67NMOxaYix22PXOQEwe03Hndlxx/DcevBleOgDFS0nN4Rq845aqxEnm7A44JM5bzHrl",
+	"ZcorhMRGgVk6lyJbDHwixL2+WOT7OIVXxM8x7kHPkyFPowBSidpHDF4daiLBpmZ9QZkC40pDBKmPEKRW",
+	"+WhHBOhHxHqQLUAgU7Rgon23lldu5AF56jdylB6q3U+2AQKiSFt7BkqEIPet/aW6QfOnz3X/ToMNqknL",
+	"zBgV6d5QH+Y7N7MWEW89+DG3f+arDK/XEGO8MhnP1v9yv66cFQbrJdy8/HT1uvAHEKhSwSTE3aeMjaP+",
+	"ZF1Lx12dM3OPs0MMbF/Cd2FazDY5ug4Gt0RQwpTdpgyJQBPMgQ4Gn0ROR1a5Nqqd8o4ZZzNtiJNMg3ZB",
+	"lywMXpxKZUIQY6r64g/WXIAKucxtk2FqHKv8IKB6LImi+6k5IsrHrdm5LE5NS8clkEaCsPYYf0DJU+Hj",
+	"P9/97Xp8AnchCmygZobMspQW1wzKQa3aTLetaHJW9RwzRwKBgK7XKAo0k3KE24U8+zcN/uvOOG2ddt6m",
+	"e7KN91ZBDU8AGoxKQcoMQFhq6iB57dml9eL9VUdsWfWTB6O9y/k8B77RinIJoFLs9irmvZVO0tIdO6t5",
+	"Ow54g6rCAFdchT00UNs0QTWMrdygOkyRN0XufBRMxex3VHTo2a7PAl0S4Y3K7lY5yNqOr1sSpZWibsVN",
+	"M9Dlw6CyVOcki9osvu65Bg06t91VsG7K1qhGeqOuoZUC1J57FSjTSElvtIsTtDCB5k4a9Zk9d1MuXPVo",
+	"T9EYpSJx0q/Cym0xu+u7q1/9bl+kjmzEXUj9EEIiHdZ57YqZX/dikmTXusaNzpbJDeLDO5Flv6pfCTcF",
+	"2T1gZcoUblDs4b+VG0uWVo2yi/tIKjGA/PAlYoPKpOL622ixy1OH3Xp7MX2WePaInC9f0+eeRjDt72pc",
+	"9sDlFFck6lZ+Ta9vM71ClfL0+XZlYKbMWoFjIgewo5wZJVEqgTN0WIMIEhy/lZyZo7x+5bRsMUOrk/KQ",
+	"9BLyKag7DnztYjfjvA5sAPMtUVOAsq72DKHe8BiY7Cp7+MYn0D5bzE3pjbrOj9rZ0SJEuxgAPNlJVR2x",
+	"DnedTYe/Egx4J/DgKpWKx+/T7aAnA314b4dkjzp6j/EjssJoaHL+irp5f+U8W2hQBR3gM0keKHmmTB8n",
+	"fXLXL1QlTmq1uyJiVFypyk+rdU5ch66w9wZ6g4b3UfDuG1pL7ae77tMgugNOlNdeOxPSxuukP9Vqt0Pc",
+	"WfO29eNbSjLYT/cJfjStxyctrOl7qJAG9ZbOBNRBx3rm9BV4fgNZvn5ztQrWsL01K4qtlcSv/PoAT5pt",
+	"vaZpOyEGTOqIoR00+3u4avsXoHqEkwn6rbrtOyzeej1sMivU6a3YlNHKTjPSeTWcYuLWx0SV652W3/1g",
+	"6GXOP7pZ28EW6St9tNW8Dgvvw1bbM177FstVawpu7hudjdOt/WxccR4hYfZwrFSwSlMrlatLIvKhrr+y",
+	"pP75x6w0u2v7blb5+5zf7QRe7FdHK225ArPcG2Y5GdX5ZUXbL3VPXd/fQ03tb0HNNFRiijVBPpPk9yCI",
+	"LaV9RUa1O7c5s+d2614yivguca3Ox59BDz8OaNjzT+/Tj0Ma/ABkatnV4n6rkU0PyNT3UcpvmdW/XUZ/",
+	"kGxuTFtt6vrqfPf62ay+x+HOh+FDluqgYSyNIrKKsPaYtfMl9Q9l4eh/AwC4I2UdjzMAAA==",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -565,6 +569,10 @@ type patchResourceApplicationMergePatchJsonRequestBody = ResourceMVO
 
 type postZapJSONRequestBody = Zap
 
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Comprehensive-name-collision-resolution-test/0.0.0"
+
 // RequestEditorFn is the function signature for the RequestEditor callback function.
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -589,6 +597,49 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// Debug dumps requests and responses, with sensitive values redacted,
+	// while it is enabled. Set with WithDebugDump; nil disables dumping.
+	Debug *oapiCodegenHelpersPkg.DebugDumper
+
+	// FaultInjector injects latency, errors and malformed responses into
+	// requests, per operation. Set with WithFaultInjection; nil disables it.
+	FaultInjector *oapiCodegenHelpersPkg.FaultInjector
+
+	// Breaker is consulted before each call and told its outcome, keyed by
+	// operationId. Set with WithBreaker; nil disables it.
+	Breaker oapiCodegenHelpersPkg.Breaker
+
+	// PriorityQueue bounds the number of requests in flight, ordering those
+	// waiting by priority. Set with WithPriorityQueue; nil disables it.
+	PriorityQueue *oapiCodegenHelpersPkg.PriorityQueue
+
+	// RateLimiter delays requests to stay within the rate limits reported
+	// by the server. Set with WithRateLimiting or WithRateLimiter; nil
+	// disables it.
+	RateLimiter *oapiCodegenHelpersPkg.RateLimiter
+
+	// Clock is used for everything time-dependent, such as cache freshness
+	// and per-call timeouts, and by Debug and FaultInjector unless they have
+	// their own. Set with WithClock to control time in tests; defaults to the
+	// system clock.
+	Clock oapiCodegenHelpersPkg.Clock
 }
 
 // ClientOption allows setting custom parameters during construction.
@@ -598,12 +649,20 @@ type ClientOption func(*Client) error
 func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	client := Client{
 		Server: server,
+		Clock:  oapiCodegenHelpersPkg.SystemClock,
 	}
 	for _, o := range opts {
 		if err := o(&client); err != nil {
 			return nil, err
 		}
 	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
 	// Ensure the server URL always has a trailing slash
 	if !strings.HasSuffix(client.Server, "/") {
 		client.Server += "/"
@@ -611,6 +670,25 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// Create httpClient if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
+	}
+	if client.FaultInjector != nil {
+		client.Client = client.FaultInjector.WrapWithClock(client.Client, client.Clock)
+	}
+	if client.Debug != nil {
+		client.Client = client.Debug.WrapWithClock(client.Client, client.Clock)
+	}
+	if client.PriorityQueue != nil {
+		client.Client = client.PriorityQueue.Wrap(client.Client)
+	}
+	if client.RateLimiter != nil {
+		client.Client = client.RateLimiter.WrapWithClock(client.Client, client.Clock)
 	}
 	return &client, nil
 }
@@ -624,6 +702,55 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -645,7 +772,174 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// WithDebugDump writes the method, URL, headers and body of every request and
+// response to w while the client's Debug dumper is enabled. Credentials, API
+// keys and properties marked sensitive in the spec are redacted. Dumping
+// starts out enabled if enabled is set, and can be toggled at any time with
+// client.Debug.SetEnabled.
+func WithDebugDump(w io.Writer, enabled bool) ClientOption {
+	return func(c *Client) error {
+		c.Debug = oapiCodegenHelpersPkg.NewDebugDumper(w, debugRedactions)
+		c.Debug.SetEnabled(enabled)
+		return nil
+	}
+}
+
+// WithFaultInjection sends requests through injector, which injects latency,
+// errors, error statuses and malformed response bodies per operationId. It is
+// meant for testing how code using the client copes with an unreliable
+// service.
+func WithFaultInjection(injector *oapiCodegenHelpersPkg.FaultInjector) ClientOption {
+	return func(c *Client) error {
+		c.FaultInjector = injector
+		return nil
+	}
+}
+
+// WithClock sets the Clock the client tells time with, such as a
+// FakeClock from the runtime helpers in tests.
+func WithClock(clock oapiCodegenHelpersPkg.Clock) ClientOption {
+	return func(c *Client) error {
+		c.Clock = clock
+		return nil
+	}
+}
+
+// WithBreaker installs a circuit breaker, which can fail calls to an
+// operation without sending them, based on the outcome of earlier calls.
+// Transport errors and 5xx responses are recorded as failures.
+func WithBreaker(breaker oapiCodegenHelpersPkg.Breaker) ClientOption {
+	return func(c *Client) error {
+		c.Breaker = breaker
+		return nil
+	}
+}
+
+// WithPriorityQueue sends requests through queue, which bounds how many are
+// in flight at once and, under contention, sends the waiting requests of
+// highest priority first. Priorities are set per operationId by the queue,
+// or per call with WithPriority. A request holds its slot until its response
+// body is closed.
+func WithPriorityQueue(queue *oapiCodegenHelpersPkg.PriorityQueue) ClientOption {
+	return func(c *Client) error {
+		c.PriorityQueue = queue
+		return nil
+	}
+}
+
+// WithRateLimiting delays requests to stay within the rate limits the server
+// reports in the X-RateLimit-Remaining and X-RateLimit-Reset response headers, and
+// with 429 Too Many Requests responses. scope sets whether a limit holds
+// back every request of the client, or only those of the limited operation.
+func WithRateLimiting(scope oapiCodegenHelpersPkg.RateLimitScope) ClientOption {
+	return WithRateLimiter(oapiCodegenHelpersPkg.NewRateLimiter(rateLimitHeaders, scope))
+}
+
+// WithRateLimiter delays requests with limiter, which can be shared by the
+// clients of an API to throttle them together.
+func WithRateLimiter(limiter *oapiCodegenHelpersPkg.RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.RateLimiter = limiter
+		return nil
+	}
+}
+
+// rateLimitHeaders are the response headers WithRateLimiting reads limits from.
+var rateLimitHeaders = oapiCodegenHelpersPkg.RateLimitHeaders{
+	Remaining: "X-RateLimit-Remaining",
+	Reset:     "X-RateLimit-Reset",
+}
+
+// debugRedactions lists the API key headers and query parameters, and the
+// sensitive properties, declared by the spec.
+var debugRedactions = oapiCodegenHelpersPkg.DebugRedactions{}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
+// allow asks the Breaker, if any, whether a call to operationID may proceed.
+func (c *Client) allow(operationID string) error {
+	if c.Breaker == nil {
+		return nil
+	}
+	return c.Breaker.Allow(operationID)
+}
+
+// record reports the outcome of a call to operationID to the Breaker, if any.
+func (c *Client) record(operationID string, resp *http.Response, err error) {
+	if c.Breaker != nil {
+		c.Breaker.Record(operationID, oapiCodegenHelpersPkg.BreakerSuccess(resp, err))
+	}
+}
+
+// now returns the time according to the client's Clock.
+func (c *Client) now() time.Time {
+	if c.Clock == nil {
+		return time.Now()
+	}
+	return c.Clock.Now()
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
@@ -659,46 +953,220 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+	priority *oapiCodegenHelpersPkg.Priority
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call. The timeout is kept by the
+// client's Clock.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// WithPriority sets the priority of the call in the client's PriorityQueue,
+// overriding the priority of its operation.
+func WithPriority(priority oapiCodegenHelpersPkg.Priority) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.priority = &priority
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := c.now()
+	var o requestOptions
+	ctx = oapiCodegenHelpersPkg.ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.priority != nil {
+		ctx = oapiCodegenHelpersPkg.ContextWithPriority(ctx, *o.priority)
+		req = req.WithContext(ctx)
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := oapiCodegenHelpersPkg.ContextWithDeadline(ctx, c.Clock, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // ClientInterface is the interface specification for the client.
 type ClientInterface interface {
 	// ListEntities makes a GET request to /entities
-	ListEntities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListEntities(ctx context.Context, opts ...RequestOption) (*http.Response, error)
 	// PostFooWithBody makes a POST request to /foo
-	PostFooWithBody(ctx context.Context, params *PostFooParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostFooWithBody(ctx context.Context, params *PostFooParams, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// ListItems makes a GET request to /items
-	ListItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListItems(ctx context.Context, opts ...RequestOption) (*http.Response, error)
 	// CreateItemWithBody makes a POST request to /items
-	CreateItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	CreateItem(ctx context.Context, body createItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateItemWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreateItem(ctx context.Context, body createItemJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// CreateOrderWithBody makes a POST request to /orders
-	CreateOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	CreateOrder(ctx context.Context, body createOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-	CreateOrderWithApplicationJsonPatchJsonBody(ctx context.Context, body createOrderApplicationJsonPatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-	CreateOrderWithApplicationMergePatchJsonBody(ctx context.Context, body createOrderApplicationMergePatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateOrderWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreateOrder(ctx context.Context, body createOrderJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+	CreateOrderWithApplicationJsonPatchJsonBody(ctx context.Context, body createOrderApplicationJsonPatchJsonRequestBody, opts ...RequestOption) (*http.Response, error)
+	CreateOrderWithApplicationMergePatchJsonBody(ctx context.Context, body createOrderApplicationMergePatchJsonRequestBody, opts ...RequestOption) (*http.Response, error)
 	// CreatePetWithBody makes a POST request to /pets
-	CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	CreatePet(ctx context.Context, body createPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// QueryWithBody makes a POST request to /query
-	QueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	Query(ctx context.Context, body queryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	QueryWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	Query(ctx context.Context, body queryJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// GetQux makes a GET request to /qux
-	GetQux(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetQux(ctx context.Context, opts ...RequestOption) (*http.Response, error)
 	// PostQuxWithBody makes a POST request to /qux
-	PostQuxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PostQux(ctx context.Context, body postQuxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostQuxWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	PostQux(ctx context.Context, body postQuxJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// PatchResourceWithBody makes a PATCH request to /resources/{id}
-	PatchResourceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PatchResource(ctx context.Context, id string, body patchResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PatchResourceWithApplicationJsonPatchJsonBody(ctx context.Context, id string, body patchResourceApplicationJsonPatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PatchResourceWithApplicationMergePatchJsonBody(ctx context.Context, id string, body patchResourceApplicationMergePatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PatchResourceWithBody(ctx context.Context, id string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	PatchResource(ctx context.Context, id string, body patchResourceJSONRequestBody, opts ...RequestOption) (*http.Response, error)
+	PatchResourceWithApplicationJsonPatchJsonBody(ctx context.Context, id string, body patchResourceApplicationJsonPatchJsonRequestBody, opts ...RequestOption) (*http.Response, error)
+	PatchResourceWithApplicationMergePatchJsonBody(ctx context.Context, id string, body patchResourceApplicationMergePatchJsonRequestBody, opts ...RequestOption) (*http.Response, error)
 	// GetStatus makes a GET request to /status
-	GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetStatus(ctx context.Context, opts ...RequestOption) (*http.Response, error)
 	// GetZap makes a GET request to /zap
-	GetZap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetZap(ctx context.Context, opts ...RequestOption) (*http.Response, error)
 	// PostZapWithBody makes a POST request to /zap
-	PostZapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	PostZap(ctx context.Context, body postZapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostZapWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	PostZap(ctx context.Context, body postZapJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 }
 
 // PostFooParams defines parameters for PostFoo.
@@ -707,342 +1175,481 @@ type PostFooParams struct {
 	Bar *string `form:"bar" json:"bar"`
 }
 
-// ListEntities makes a GET request to /entities
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *PostFooParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Bar != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("bar", *p.Bar, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
 
-func (c *Client) ListEntities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *PostFooParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("bar", values, &p.Bar, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter bar: %w", err)
+	}
+	return nil
+}
+
+// ListEntities makes a GET request to /entities
+func (c *Client) ListEntities(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewListEntitiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "listEntities", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("listEntities"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("listEntities", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostFooWithBody makes a POST request to /foo
-
-func (c *Client) PostFooWithBody(ctx context.Context, params *PostFooParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostFooWithBody(ctx context.Context, params *PostFooParams, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostFooRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postFoo", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postFoo"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postFoo", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostFoo makes a POST request to /foo with application/json body
-func (c *Client) PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostFooRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postFoo", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postFoo"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postFoo", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // ListItems makes a GET request to /items
-
-func (c *Client) ListItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) ListItems(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewListItemsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "listItems", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("listItems"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("listItems", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateItemWithBody makes a POST request to /items
-
-func (c *Client) CreateItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateItemWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateItemRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createItem", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createItem"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createItem", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateItem makes a POST request to /items with application/json body
-func (c *Client) CreateItem(ctx context.Context, body createItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateItem(ctx context.Context, body createItemJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateItemRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createItem", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createItem"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createItem", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateOrderWithBody makes a POST request to /orders
-
-func (c *Client) CreateOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateOrderWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateOrderRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createOrder", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createOrder"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createOrder", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateOrder makes a POST request to /orders with application/json body
-func (c *Client) CreateOrder(ctx context.Context, body createOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateOrder(ctx context.Context, body createOrderJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateOrderRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createOrder", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createOrder"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createOrder", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateOrderWithApplicationJsonPatchJsonBody makes a POST request to /orders with application/json-patch+json body
-func (c *Client) CreateOrderWithApplicationJsonPatchJsonBody(ctx context.Context, body createOrderApplicationJsonPatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateOrderWithApplicationJsonPatchJsonBody(ctx context.Context, body createOrderApplicationJsonPatchJsonRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateOrderRequestWithApplicationJsonPatchJsonBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createOrder", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createOrder"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createOrder", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreateOrderWithApplicationMergePatchJsonBody makes a POST request to /orders with application/merge-patch+json body
-func (c *Client) CreateOrderWithApplicationMergePatchJsonBody(ctx context.Context, body createOrderApplicationMergePatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreateOrderWithApplicationMergePatchJsonBody(ctx context.Context, body createOrderApplicationMergePatchJsonRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreateOrderRequestWithApplicationMergePatchJsonBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createOrder", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createOrder"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createOrder", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreatePetWithBody makes a POST request to /pets
-
-func (c *Client) CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreatePetWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreatePetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createPet", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createPet"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createPet", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // CreatePet makes a POST request to /pets with application/json body
-func (c *Client) CreatePet(ctx context.Context, body createPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewCreatePetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "createPet", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("createPet"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("createPet", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // QueryWithBody makes a POST request to /query
-
-func (c *Client) QueryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) QueryWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewQueryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "query", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("query"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("query", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // Query makes a POST request to /query with application/json body
-func (c *Client) Query(ctx context.Context, body queryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) Query(ctx context.Context, body queryJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewQueryRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "query", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("query"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("query", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetQux makes a GET request to /qux
-
-func (c *Client) GetQux(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetQux(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetQuxRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getQux", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("getQux"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("getQux", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostQuxWithBody makes a POST request to /qux
-
-func (c *Client) PostQuxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostQuxWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostQuxRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postQux", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postQux"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postQux", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostQux makes a POST request to /qux with application/json body
-func (c *Client) PostQux(ctx context.Context, body postQuxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostQux(ctx context.Context, body postQuxJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostQuxRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postQux", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postQux"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postQux", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PatchResourceWithBody makes a PATCH request to /resources/{id}
-
-func (c *Client) PatchResourceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PatchResourceWithBody(ctx context.Context, id string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPatchResourceRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "patchResource", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("patchResource"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("patchResource", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PatchResource makes a PATCH request to /resources/{id} with application/json body
-func (c *Client) PatchResource(ctx context.Context, id string, body patchResourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PatchResource(ctx context.Context, id string, body patchResourceJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPatchResourceRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "patchResource", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("patchResource"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("patchResource", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PatchResourceWithApplicationJsonPatchJsonBody makes a PATCH request to /resources/{id} with application/json-patch+json body
-func (c *Client) PatchResourceWithApplicationJsonPatchJsonBody(ctx context.Context, id string, body patchResourceApplicationJsonPatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PatchResourceWithApplicationJsonPatchJsonBody(ctx context.Context, id string, body patchResourceApplicationJsonPatchJsonRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPatchResourceRequestWithApplicationJsonPatchJsonBody(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "patchResource", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("patchResource"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("patchResource", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PatchResourceWithApplicationMergePatchJsonBody makes a PATCH request to /resources/{id} with application/merge-patch+json body
-func (c *Client) PatchResourceWithApplicationMergePatchJsonBody(ctx context.Context, id string, body patchResourceApplicationMergePatchJsonRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PatchResourceWithApplicationMergePatchJsonBody(ctx context.Context, id string, body patchResourceApplicationMergePatchJsonRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPatchResourceRequestWithApplicationMergePatchJsonBody(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "patchResource", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("patchResource"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("patchResource", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetStatus makes a GET request to /status
-
-func (c *Client) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetStatus(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getStatus", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("getStatus"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("getStatus", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetZap makes a GET request to /zap
-
-func (c *Client) GetZap(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetZap(ctx context.Context, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetZapRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getZap", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("getZap"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("getZap", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostZapWithBody makes a POST request to /zap
-
-func (c *Client) PostZapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostZapWithBody(ctx context.Context, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostZapRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postZap", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postZap"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postZap", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // PostZap makes a POST request to /zap with application/json body
-func (c *Client) PostZap(ctx context.Context, body postZapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) PostZap(ctx context.Context, body postZapJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewPostZapRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "postZap", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	if err := c.allow("postZap"); err != nil {
+		return releaseWithBody(req, nil, err, cancel)
+	}
+	resp, err := c.Client.Do(req)
+	c.record("postZap", resp, err)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewListEntitiesRequest creates a GET request for /entities
@@ -1105,7 +1712,7 @@ func NewPostFooRequestWithBody(server string, params *PostFooParams, contentType
 	if params != nil {
 		queryValues := reqURL.Query()
 		if params.Bar != nil {
-			if queryFrag, err := oapiCodegenParamsPkg.StyleParameter("bar", *params.Bar, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}); err != nil {
+			if queryFrag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("bar", *params.Bar, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1444,7 +2051,7 @@ func NewPatchResourceRequestWithBody(server string, id string, contentType strin
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = oapiCodegenParamsPkg.StyleParameter("id", id, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false})
+	pathParam0, err = oapiCodegenParamsPkg.StylePrimitiveParameter("id", id, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string])
 	if err != nil {
 		return nil, err
 	}
@@ -1599,9 +2206,9 @@ func NewSimpleClient(server string, opts ...ClientOption) (*SimpleClient, error)
 // ListEntities makes a GET request to /entities and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) ListEntities(ctx context.Context, reqEditors ...RequestEditorFn) (map[string]any, error) {
+func (c *SimpleClient) ListEntities(ctx context.Context, opts ...RequestOption) (map[string]any, error) {
 	var result map[string]any
-	resp, err := c.Client.ListEntities(ctx, reqEditors...)
+	resp, err := c.Client.ListEntities(ctx, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1613,7 +2220,7 @@ func (c *SimpleClient) ListEntities(ctx context.Context, reqEditors ...RequestEd
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1629,9 +2236,9 @@ func (c *SimpleClient) ListEntities(ctx context.Context, reqEditors ...RequestEd
 // PostFoo makes a POST request to /foo and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, reqEditors ...RequestEditorFn) (map[string]any, error) {
+func (c *SimpleClient) PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, opts ...RequestOption) (map[string]any, error) {
 	var result map[string]any
-	resp, err := c.Client.PostFoo(ctx, params, body, reqEditors...)
+	resp, err := c.Client.PostFoo(ctx, params, body, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1643,7 +2250,7 @@ func (c *SimpleClient) PostFoo(ctx context.Context, params *PostFooParams, body
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1659,9 +2266,9 @@ func (c *SimpleClient) PostFoo(ctx context.Context, params *PostFooParams, body
 // ListItems makes a GET request to /items and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) ListItems(ctx context.Context, reqEditors ...RequestEditorFn) (ListItemsResponse, error) {
+func (c *SimpleClient) ListItems(ctx context.Context, opts ...RequestOption) (ListItemsResponse, error) {
 	var result ListItemsResponse
-	resp, err := c.Client.ListItems(ctx, reqEditors...)
+	resp, err := c.Client.ListItems(ctx, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1673,7 +2280,7 @@ func (c *SimpleClient) ListItems(ctx context.Context, reqEditors ...RequestEdito
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1689,9 +2296,9 @@ func (c *SimpleClient) ListItems(ctx context.Context, reqEditors ...RequestEdito
 // CreateItem makes a POST request to /items and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) CreateItem(ctx context.Context, body createItemJSONRequestBody, reqEditors ...RequestEditorFn) (CreateItemResponse, error) {
+func (c *SimpleClient) CreateItem(ctx context.Context, body createItemJSONRequestBody, opts ...RequestOption) (CreateItemResponse, error) {
 	var result CreateItemResponse
-	resp, err := c.Client.CreateItem(ctx, body, reqEditors...)
+	resp, err := c.Client.CreateItem(ctx, body, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1703,7 +2310,7 @@ func (c *SimpleClient) CreateItem(ctx context.Context, body createItemJSONReques
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1719,9 +2326,9 @@ func (c *SimpleClient) CreateItem(ctx context.Context, body createItemJSONReques
 // CreateOrder makes a POST request to /orders and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) CreateOrder(ctx context.Context, body createOrderJSONRequestBody, reqEditors ...RequestEditorFn) (Order, error) {
+func (c *SimpleClient) CreateOrder(ctx context.Context, body createOrderJSONRequestBody, opts ...RequestOption) (Order, error) {
 	var result Order
-	resp, err := c.Client.CreateOrder(ctx, body, reqEditors...)
+	resp, err := c.Client.CreateOrder(ctx, body, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1733,7 +2340,7 @@ func (c *SimpleClient) CreateOrder(ctx context.Context, body createOrderJSONRequ
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1749,9 +2356,9 @@ func (c *SimpleClient) CreateOrder(ctx context.Context, body createOrderJSONRequ
 // CreatePet makes a POST request to /pets and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) CreatePet(ctx context.Context, body createPetJSONRequestBody, reqEditors ...RequestEditorFn) (Pet, error) {
+func (c *SimpleClient) CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (Pet, error) {
 	var result Pet
-	resp, err := c.Client.CreatePet(ctx, body, reqEditors...)
+	resp, err := c.Client.CreatePet(ctx, body, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1763,7 +2370,7 @@ func (c *SimpleClient) CreatePet(ctx context.Context, body createPetJSONRequestB
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1779,9 +2386,9 @@ func (c *SimpleClient) CreatePet(ctx context.Context, body createPetJSONRequestB
 // Query makes a POST request to /query and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) Query(ctx context.Context, body queryJSONRequestBody, reqEditors ...RequestEditorFn) (QueryResponse, error) {
+func (c *SimpleClient) Query(ctx context.Context, body queryJSONRequestBody, opts ...RequestOption) (QueryResponse, error) {
 	var result QueryResponse
-	resp, err := c.Client.Query(ctx, body, reqEditors...)
+	resp, err := c.Client.Query(ctx, body, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1793,7 +2400,7 @@ func (c *SimpleClient) Query(ctx context.Context, body queryJSONRequestBody, req
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1809,9 +2416,9 @@ func (c *SimpleClient) Query(ctx context.Context, body queryJSONRequestBody, req
 // GetQux makes a GET request to /qux and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) GetQux(ctx context.Context, reqEditors ...RequestEditorFn) (map[string]any, error) {
+func (c *SimpleClient) GetQux(ctx context.Context, opts ...RequestOption) (map[string]any, error) {
 	var result map[string]any
-	resp, err := c.Client.GetQux(ctx, reqEditors...)
+	resp, err := c.Client.GetQux(ctx, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1823,7 +2430,7 @@ func (c *SimpleClient) GetQux(ctx context.Context, reqEditors ...RequestEditorFn
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1839,9 +2446,9 @@ func (c *SimpleClient) GetQux(ctx context.Context, reqEditors ...RequestEditorFn
 // GetStatus makes a GET request to /status and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) GetStatus(ctx context.Context, reqEditors ...RequestEditorFn) (GetStatusResponse, error) {
+func (c *SimpleClient) GetStatus(ctx context.Context, opts ...RequestOption) (GetStatusResponse, error) {
 	var result GetStatusResponse
-	resp, err := c.Client.GetStatus(ctx, reqEditors...)
+	resp, err := c.Client.GetStatus(ctx, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1853,7 +2460,7 @@ func (c *SimpleClient) GetStatus(ctx context.Context, reqEditors ...RequestEdito
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1869,9 +2476,9 @@ func (c *SimpleClient) GetStatus(ctx context.Context, reqEditors ...RequestEdito
 // GetZap makes a GET request to /zap and returns the parsed response.
 
 // On success, returns the response body. On HTTP error, returns *ClientHttpError[struct{}].
-func (c *SimpleClient) GetZap(ctx context.Context, reqEditors ...RequestEditorFn) (map[string]any, error) {
+func (c *SimpleClient) GetZap(ctx context.Context, opts ...RequestOption) (map[string]any, error) {
 	var result map[string]any
-	resp, err := c.Client.GetZap(ctx, reqEditors...)
+	resp, err := c.Client.GetZap(ctx, opts...)
 	if err != nil {
 		return result, err
 	}
@@ -1883,7 +2490,7 @@ func (c *SimpleClient) GetZap(ctx context.Context, reqEditors ...RequestEditorFn
 	}
 
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if err := json.Unmarshal(rawBody, &result); err != nil {
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON(rawBody, &result); err != nil {
 			return result, err
 		}
 		return result, nil
@@ -1895,3 +2502,27 @@ func (c *SimpleClient) GetZap(ctx context.Context, reqEditors ...RequestEditorFn
 		RawBody:    rawBody,
 	}
 }
+
+// SimpleClientInterface is the interface specification for SimpleClient.
+type SimpleClientInterface interface {
+	// ListEntities makes a GET request to /entities and returns the parsed response.
+	ListEntities(ctx context.Context, opts ...RequestOption) (map[string]any, error)
+	// PostFoo makes a POST request to /foo and returns the parsed response.
+	PostFoo(ctx context.Context, params *PostFooParams, body postFooJSONRequestBody, opts ...RequestOption) (map[string]any, error)
+	// ListItems makes a GET request to /items and returns the parsed response.
+	ListItems(ctx context.Context, opts ...RequestOption) (ListItemsResponse, error)
+	// CreateItem makes a POST request to /items and returns the parsed response.
+	CreateItem(ctx context.Context, body createItemJSONRequestBody, opts ...RequestOption) (CreateItemResponse, error)
+	// CreateOrder makes a POST request to /orders and returns the parsed response.
+	CreateOrder(ctx context.Context, body createOrderJSONRequestBody, opts ...RequestOption) (Order, error)
+	// CreatePet makes a POST request to /pets and returns the parsed response.
+	CreatePet(ctx context.Context, body createPetJSONRequestBody, opts ...RequestOption) (Pet, error)
+	// Query makes a POST request to /query and returns the parsed response.
+	Query(ctx context.Context, body queryJSONRequestBody, opts ...RequestOption) (QueryResponse, error)
+	// GetQux makes a GET request to /qux and returns the parsed response.
+	GetQux(ctx context.Context, opts ...RequestOption) (map[string]any, error)
+	// GetStatus makes a GET request to /status and returns the parsed response.
+	GetStatus(ctx context.Context, opts ...RequestOption) (GetStatusResponse, error)
+	// GetZap makes a GET request to /zap and returns the parsed response.
+	GetZap(ctx context.Context, opts ...RequestOption) (map[string]any, error)
+}
@@ -0,0 +1,5 @@
+// Package strategies tests the name-collisions strategies, and names pinned
+// against collisions.
+package strategies
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
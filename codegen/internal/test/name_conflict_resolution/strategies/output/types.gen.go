@@ -0,0 +1,170 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Pet
+type Pet struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Pet) ApplyDefaults() {
+}
+
+// #/components/schemas/ListPetsJSONResponse
+type ListPetsJSONResponse struct {
+	Total *int `form:"total,omitempty" json:"total,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *ListPetsJSONResponse) ApplyDefaults() {
+}
+
+// #/paths//pet-owners/get/parameters/0/schema
+type GetPetOwnersParameterListPetOwners string
+
+const (
+	GetPetOwnersParameterListPetOwnersName  GetPetOwnersParameterListPetOwners = "name"
+	GetPetOwnersParameterListPetOwnersEmail GetPetOwnersParameterListPetOwners = "email"
+)
+
+// Values returns the GetPetOwnersParameterListPetOwners constants, in the order of the spec.
+func (GetPetOwnersParameterListPetOwners) Values() []GetPetOwnersParameterListPetOwners {
+	return []GetPetOwnersParameterListPetOwners{GetPetOwnersParameterListPetOwnersName, GetPetOwnersParameterListPetOwnersEmail}
+}
+
+// ParseGetPetOwnersParameterListPetOwners returns the GetPetOwnersParameterListPetOwners constant whose value is s. Other strings
+// return an error.
+func ParseGetPetOwnersParameterListPetOwners(s string) (GetPetOwnersParameterListPetOwners, error) {
+	switch v := GetPetOwnersParameterListPetOwners(s); v {
+	case GetPetOwnersParameterListPetOwnersName, GetPetOwnersParameterListPetOwnersEmail:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid GetPetOwnersParameterListPetOwners value %q", s)
+}
+
+// #/paths//pet_owners/get/parameters/0/schema
+type GetPetOwnersParameterListLegacyPetOwners string
+
+const (
+	GetPetOwnersParameterListLegacyPetOwnersName GetPetOwnersParameterListLegacyPetOwners = "name"
+)
+
+// Values returns the GetPetOwnersParameterListLegacyPetOwners constants, in the order of the spec.
+func (GetPetOwnersParameterListLegacyPetOwners) Values() []GetPetOwnersParameterListLegacyPetOwners {
+	return []GetPetOwnersParameterListLegacyPetOwners{GetPetOwnersParameterListLegacyPetOwnersName}
+}
+
+// ParseGetPetOwnersParameterListLegacyPetOwners returns the GetPetOwnersParameterListLegacyPetOwners constant whose value is s. Other strings
+// return an error.
+func ParseGetPetOwnersParameterListLegacyPetOwners(s string) (GetPetOwnersParameterListLegacyPetOwners, error) {
+	switch v := GetPetOwnersParameterListLegacyPetOwners(s); v {
+	case GetPetOwnersParameterListLegacyPetOwnersName:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid GetPetOwnersParameterListLegacyPetOwners value %q", s)
+}
+
+// #/paths//pets/get/responses/200/content/application/json/schema
+type PetPage struct {
+	Items []Pet `form:"items,omitempty" json:"items,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *PetPage) ApplyDefaults() {
+}
+
+// #/paths//pets/get/responses/200/content/application/json/schema/properties/items
+type GetPets200Response = []Pet
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/8xUQW/bPAy961c8pB/Qy1cn3XbSbadhQ5EG227DMLA2Y6uwRU1iW+TfD5bj2EW9oAN2",
+	"WE4KHx/JRz5YAnsKzmL1trguNivj/F6sAR45Jife4rrYFBsDqNOWLbbUMUppW9fDSBpJuXacTCBtUk+9",
+	"wNeGkcqGO0qQPbThxJDAkdSJTwO/4v9BqQddRCajoUfuA0jUca70QeCp6zN9BYoMlbYCBYqKu8NU82NV",
+	"GGAdWK/kyXPMgwA16/DAPNWidUl3rLc51QAAEChSx3riAsBV7m6RJOopCDhv8fOB42EWG/TaWQTQQ+jJ",
+	"Gp2vnwHsHzqLb4M07si13w0AAJFTEJ94NsTqzebdavoLVJzK6ILm+2wFg+JxAT9eu4Abrqk8/CNr+Dv6",
+	"B+uNNXrvHS998lzCk9Mmm6yULohnr8ehM//mmP/py+3287HO4D6XEJz3XEEF5EUbjnkv4+Jf57l0Vujm",
+	"90LfI1CdNfW9illaKV7Z65wJUAitK3P39X0S/xxdPtR0LLm751JfgCH2ktTNp55+TrlbBMaqFCMdFvEz",
+	"VOC/yHuLy4v16WJpffy8rHesl2aKWzMqy09gN11jQdmSnmx1c8a9Sxb5wx4qSu3LJs4r1xzNrwEA2xPW",
+	"SJYFAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ---------------------------------------------------------------------------
+// Internal style helpers
+// ---------------------------------------------------------------------------
+
+// ---------------------------------------------------------------------------
+// Deep object marshaling
+// ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOperationIDSuffixes verifies that the colliding parameter schemas of
+// operations are suffixed with their operationIds.
+func TestOperationIDSuffixes(t *testing.T) {
+	assert.Equal(t, GetPetOwnersParameterListPetOwners("email"), GetPetOwnersParameterListPetOwnersEmail)
+	assert.Equal(t, GetPetOwnersParameterListLegacyPetOwners("name"), GetPetOwnersParameterListLegacyPetOwnersName)
+}
+
+// TestPinnedName verifies that the pinned response keeps its name, and the
+// component schema it collided with keeps its own.
+func TestPinnedName(t *testing.T) {
+	total := 1
+	data, err := json.Marshal(ListPetsJSONResponse{Total: &total})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"total":1}`, string(data))
+
+	var page PetPage
+	require.NoError(t, json.Unmarshal([]byte(`{"items":[{"name":"Rex"}]}`), &page))
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "Rex", *page.Items[0].Name)
+}
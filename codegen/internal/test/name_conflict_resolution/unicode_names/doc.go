@@ -0,0 +1,5 @@
+// Package unicode_names tests that non-ASCII and emoji names generate valid,
+// distinct Go identifiers.
+package unicode_names
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,98 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// #/components/schemas/Größe
+type Grosse struct {
+	Cafe       *string `form:"café,omitempty" json:"café,omitempty"`
+	Resume0    *string `form:"resume,omitempty" json:"resume,omitempty"`
+	Resume1    *string `form:"résumé,omitempty" json:"résumé,omitempty"`
+	U540DU524D *string `form:"名前,omitempty" json:"名前,omitempty"`
+	U1F680     *int    `form:"🚀,omitempty" json:"🚀,omitempty"`
+	U1F6F8     *int    `form:"🛸,omitempty" json:"🛸,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Grosse) ApplyDefaults() {
+}
+
+// #/components/schemas/Mood
+type Mood string
+
+const (
+	U1F600 Mood = "😀"
+	U1F622 Mood = "😢"
+	Naive  Mood = "naïve"
+)
+
+// Values returns the Mood constants, in the order of the spec.
+func (Mood) Values() []Mood {
+	return []Mood{U1F600, U1F622, Naive}
+}
+
+// ParseMood returns the Mood constant whose value is s. Other strings
+// return an error.
+func ParseMood(s string) (Mood, error) {
+	switch v := Mood(s); v {
+	case U1F600, U1F622, Naive:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Mood value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/4yNsUozQRSF+3mKy9Q/y4a/mxewsrQSi3Fykow49w4zswERIa1FwDJosWCXyk7s51nS",
+	"5xFk1aggslb3nsN3zpEIttEb0v+bSdNq5XkmRhEtkbIXNjRp2qZVRMWXSxg6Ye9kCmIbkFW0ZZENXd8o",
+	"JyEKg0se0tktEOzbS3SU6nPt8S6IylWEITm/gCsfVkwSkYpHPkBEzs7q9ksecrkkz/NPOyF3AeNY3eYu",
+	"/KFvd7fe3a5HMb3v71f6J+a5YI70nXt4GeGORaZG/ToG7oKhU73vNyv9b2jcPA6XbX1aQp+p1wEAj9DI",
+	"ssMBAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
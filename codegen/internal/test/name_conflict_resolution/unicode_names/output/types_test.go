@@ -0,0 +1,46 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestUnicodeNamesRoundTrip verifies that properties with non-ASCII names
+// keep their original names on the wire.
+func TestUnicodeNamesRoundTrip(t *testing.T) {
+	cafe, resume, accented, name := "noir", "short", "long", "Ada"
+	rocket, ufo := 1, 2
+	original := Grosse{
+		Cafe:       &cafe,
+		Resume0:    &resume,
+		Resume1:    &accented,
+		U540DU524D: &name,
+		U1F680:     &rocket,
+		U1F6F8:     &ufo,
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	want := `{"café":"noir","resume":"short","résumé":"long","名前":"Ada","🚀":1,"🛸":2}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var decoded Grosse
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if *decoded.Resume1 != accented || *decoded.U1F6F8 != ufo {
+		t.Errorf("Unmarshal = %+v", decoded)
+	}
+}
+
+func TestUnicodeEnumValues(t *testing.T) {
+	for value, want := range map[Mood]string{U1F600: "😀", U1F622: "😢", Naive: "naïve"} {
+		if string(value) != want {
+			t.Errorf("%s = %q, want %q", want, value, want)
+		}
+	}
+}
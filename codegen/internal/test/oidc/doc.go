@@ -0,0 +1,5 @@
+// Package oidc tests the client options and server authenticators generated
+// for security schemes of type openIdConnect.
+package oidc
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
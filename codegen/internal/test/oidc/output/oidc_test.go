@@ -0,0 +1,129 @@
+package output
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProvider starts an OpenID Connect provider issuing RS256 access tokens
+// for the pets-api audience with the client credentials grant.
+func newProvider(t *testing.T) *httptest.Server {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var provider *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":         provider.URL,
+			"token_endpoint": provider.URL + "/token",
+			"jwks_uri":       provider.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "pets-client" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "key-1"})
+		claims, _ := json.Marshal(map[string]any{
+			"iss":   provider.URL,
+			"sub":   id,
+			"aud":   "pets-api",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"scope": r.FormValue("scope"),
+		})
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+		digest := sha256.Sum256([]byte(signed))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": signed + "." + base64.RawURLEncoding.EncodeToString(signature),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	provider = httptest.NewServer(mux)
+	t.Cleanup(provider.Close)
+	return provider
+}
+
+type petServer struct{}
+
+func (petServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	claims, _ := helpers.OIDCClaimsFromContext(r.Context())
+	_ = json.NewEncoder(w).Encode([]Pet{{Name: "pet of " + claims.Subject}})
+}
+
+func TestGeneratedOIDCDeclarations(t *testing.T) {
+	assert.Equal(t, "https://auth.example.com/.well-known/openid-configuration", PetsAuthOpenIDConnectURL)
+	assert.Equal(t, PetsAuthOpenIDConnectURL, NewPetsAuthProvider().URL)
+
+	auth := NewPetsAuthAuthenticator("pets-api")
+	assert.Equal(t, PetsAuthOpenIDConnectURL, auth.Provider.URL)
+	assert.Equal(t, "pets-api", auth.Audience)
+}
+
+func TestOIDCRoundTrip(t *testing.T) {
+	provider := newProvider(t)
+	discoveryURL := provider.URL + "/.well-known/openid-configuration"
+
+	auth := NewPetsAuthAuthenticator("pets-api")
+	auth.Provider = helpers.NewOIDCProvider(discoveryURL)
+	api := httptest.NewServer(auth.Middleware(Handler(petServer{})))
+	defer api.Close()
+
+	client, err := NewClient(api.URL, WithOIDC(helpers.NewOIDCProvider(discoveryURL), helpers.ClientCredentialsFlow{
+		ClientID:     "pets-client",
+		ClientSecret: "s3cret",
+		Scopes:       []string{"pets:read"},
+	}))
+	require.NoError(t, err)
+
+	resp, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var pets []Pet
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&pets))
+	assert.Equal(t, []Pet{{Name: "pet of pets-client"}}, pets)
+}
+
+func TestOIDCRejectsUnauthenticated(t *testing.T) {
+	provider := newProvider(t)
+	auth := NewPetsAuthAuthenticator("pets-api")
+	auth.Provider = helpers.NewOIDCProvider(provider.URL + "/.well-known/openid-configuration")
+	api := httptest.NewServer(auth.Middleware(Handler(petServer{})))
+	defer api.Close()
+
+	client, err := NewClient(api.URL)
+	require.NoError(t, err)
+	resp, err := client.ListPets(context.Background())
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, `Bearer error="invalid_token"`, resp.Header.Get("WWW-Authenticate"))
+}
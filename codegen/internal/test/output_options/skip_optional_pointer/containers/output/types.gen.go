@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // #/components/schemas/ResponseBody
@@ -19,8 +21,8 @@ type ResponseBody struct {
 	UnknownObject             map[string]any                              `form:"unknown_object,omitempty" json:"unknown_object,omitempty"`
 	AdditionalProps           map[string]any                              `form:"additional_props,omitempty" json:"additional_props,omitempty"`
 	ASliceWithAdditionalProps []ResponseBodyASliceWithAdditionalPropsItem `form:"a_slice_with_additional_props,omitempty" json:"a_slice_with_additional_props,omitempty"`
-	Bytes                     []byte                                      `form:"bytes,omitempty" json:"bytes,omitempty"`
-	BytesWithOverride         []byte                                      `form:"bytes_with_override,omitempty" json:"bytes_with_override,omitempty"`
+	Bytes                     oapiCodegenTypesPkg.Base64Bytes             `form:"bytes,omitempty" json:"bytes,omitempty"`
+	BytesWithOverride         oapiCodegenTypesPkg.Base64Bytes             `form:"bytes_with_override,omitempty" json:"bytes_with_override,omitempty"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -0,0 +1,4 @@
+// Package split_types tests splitting large generated output into type chunk files.
+package split_types
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
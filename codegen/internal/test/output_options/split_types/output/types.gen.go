@@ -0,0 +1,74 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ParseOrderStatus returns the OrderStatus constant whose value is s. Other strings
+// return an error.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	switch v := OrderStatus(s); v {
+	case Pending, Shipped, Delivered:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid OrderStatus value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5RTPW/bQAzd9Sse3AJelChpt9uCTAEKtEC6BRkuOtpmovvIHS+AUfS/F5IlS44Ny91E",
+	"8vHIx/fkAzkdWGHx/fr2+mZRsFt5VQDC0pDCY2hYINtAqQA+KCb2TmHRYYOWTWrBlY+GYqr+sPnbxsCa",
+	"ZPcB+EBRC3v3YFSb/9li+1rQUVsSimlAA1dw2pICm30KYKfQjpukIr1njmQUJGaaFFK9IavVJIOOgUKS",
+	"yG5dDP0peJdoMnrx7eZmMYaAoVRHDtKR/r0h+MnyAFB7J+TkcJgOoeG641y9Ju8Oq6cXBICvkVYKyy9V",
+	"7W3wjpykaodNVXe1ZTFW2va+2H4Cd8ZESn0wMPYvr1RL8fliT0kikZSoWbbPgxixlUp4epEdboxPXhLd",
+	"M7Og4JPo5t4bOgu9z0m8pXgZkdYr5wh0XprbjKzmZhYFrHy0WtQOv8/rw8PPKdnrtCwA4Ac7ehCyF6r2",
+	"lku8Z+1kTra3PEtneOcYyE5ofWBzQyudG1G43SezY/kVuT5xXZftS9/e2fYycmxK1L32JVjIpnMU2cy7",
+	"8pOT5pQZnLcch7RbHM/RMertJHsEOz9nEH05+c205Isd1B31sWsZnwiNrsncyX/Y2GihK2FLo1SPB4uc",
+	"6CeXrcJTIGfYrUukDYdApoShhj8oknku/g0AKUD9ClUGAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,32 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Address
+type Address struct {
+	Street     string  `form:"street" json:"street"`
+	City       string  `form:"city" json:"city"`
+	PostalCode *string `form:"postalCode,omitempty" json:"postalCode,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Address) ApplyDefaults() {
+}
+
+// #/components/schemas/Customer
+type Customer struct {
+	Name    string                     `form:"name" json:"name"`
+	Email   *oapiCodegenTypesPkg.Email `form:"email,omitempty" json:"email,omitempty"`
+	Address *Address                   `form:"address,omitempty" json:"address,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Customer) ApplyDefaults() {
+	if s.Address != nil {
+		s.Address.ApplyDefaults()
+	}
+}
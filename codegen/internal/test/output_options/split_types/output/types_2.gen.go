@@ -0,0 +1,14 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+// #/components/schemas/LineItem
+type LineItem struct {
+	Sku       string   `form:"sku" json:"sku"`
+	Quantity  int      `form:"quantity" json:"quantity"`
+	UnitPrice *float32 `form:"unitPrice,omitempty" json:"unitPrice,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *LineItem) ApplyDefaults() {
+}
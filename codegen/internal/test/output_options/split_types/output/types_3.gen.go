@@ -0,0 +1,23 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"time"
+)
+
+// #/components/schemas/Order
+type Order struct {
+	ID       string       `form:"id" json:"id"`
+	Customer Customer     `form:"customer" json:"customer"`
+	Items    []LineItem   `form:"items" json:"items"`
+	Status   *OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+	PlacedAt *time.Time   `form:"placedAt,omitempty" json:"placedAt,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Order) ApplyDefaults() {
+}
+
+// #/components/schemas/Order/properties/items
+type OrderItem = []LineItem
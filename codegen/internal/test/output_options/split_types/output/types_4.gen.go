@@ -0,0 +1,17 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+// #/components/schemas/OrderStatus
+type OrderStatus string
+
+const (
+	Pending   OrderStatus = "pending"
+	Shipped   OrderStatus = "shipped"
+	Delivered OrderStatus = "delivered"
+)
+
+// Values returns the OrderStatus constants, in the order of the spec.
+func (OrderStatus) Values() []OrderStatus {
+	return []OrderStatus{Pending, Shipped, Delivered}
+}
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitTypesRoundTrip uses types from every chunk file together.
+func TestSplitTypesRoundTrip(t *testing.T) {
+	status := Shipped
+	original := Order{
+		ID:       "o-1",
+		Customer: Customer{Name: "Ada", Address: &Address{Street: "1 Main St", City: "London"}},
+		Items:    OrderItem{{Sku: "abc", Quantity: 2}},
+		Status:   &status,
+	}
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+
+	var decoded Order
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, original, decoded)
+}
+
+func TestSplitTypesSpecStaysInMainFile(t *testing.T) {
+	spec, err := GetOpenAPISpecJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(spec), "Split types")
+}
@@ -0,0 +1,5 @@
+// Package yaml_tags tests the struct-tags yaml option, and YAML round-trips of
+// the runtime types.
+package yaml_tags
+
+//go:generate go run ../../../../../cmd/oapi-codegen -config config.yaml spec.yaml
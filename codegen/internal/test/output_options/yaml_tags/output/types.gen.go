@@ -0,0 +1,76 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package output
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Deployment
+type Deployment struct {
+	ID       oapiCodegenTypesPkg.UUID          `form:"id" json:"id" yaml:"id"`
+	Name     string                            `form:"name" json:"name" yaml:"name"`
+	Owner    *oapiCodegenTypesPkg.Email        `form:"owner,omitempty" json:"owner,omitempty" yaml:"owner,omitempty"`
+	Since    *oapiCodegenTypesPkg.Date         `form:"since,omitempty" json:"since,omitempty" yaml:"since,omitempty"`
+	Replicas oapiCodegenTypesPkg.Nullable[int] `form:"replicas,omitempty" json:"replicas,omitempty" yaml:"replicas,omitempty"`
+	Secret   *string                           `form:"-" json:"-" yaml:"-"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Deployment) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5SNvU4DQQyE+3sK6+rklIhuOyRKeAAUpVjuJhdHu/bi9QER4t1RID9IFJBu/FnfjBZI",
+	"LByovemW3aJtWDYaGqIXWGWVQMtu0S0aImdPCPR4+3BPHsfalOjbGuj9o+k1FxWI14NZ+y1y/IpEdyhJ",
+	"9xni3zeR7wsC6dMOvR+R4XliwxBoxcOMJGasj69iWmDOqCefiIdLPvVVN5bxB96o5eiBpomHMz40/+nq",
+	"q8CuWECOnM68svS4wh6i44wNJXEf629/xeIYYTNqZUqpXV8G0Rv8H4tv81Hnu6oy51HUEMhtQvM5ABAv",
+	"WTkCAgAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
@@ -0,0 +1,46 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+func TestDeploymentYAMLRoundTrip(t *testing.T) {
+	owner := types.Email("ops@example.com")
+	since := types.Date{Time: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}
+	secret := "hunter2"
+	in := Deployment{
+		ID:       uuid.MustParse("9cb14230-b640-11ec-b909-0242ac120002"),
+		Name:     "api",
+		Owner:    &owner,
+		Since:    &since,
+		Replicas: types.NewNullableWithValue(3),
+		Secret:   &secret,
+	}
+	data, err := yaml.Marshal(in)
+	require.NoError(t, err)
+	assert.Equal(t, `id: 9cb14230-b640-11ec-b909-0242ac120002
+name: api
+owner: ops@example.com
+since: "2024-02-29"
+replicas: 3
+`, string(data))
+
+	var out Deployment
+	require.NoError(t, yaml.Unmarshal(data, &out))
+	in.Secret = nil
+	assert.Equal(t, in, out)
+}
+
+func TestDeploymentYAMLValidation(t *testing.T) {
+	var out Deployment
+	err := yaml.Unmarshal([]byte("name: api\nowner: nobody\n"), &out)
+	assert.ErrorIs(t, err, types.ErrValidationEmail)
+}
@@ -33,36 +33,65 @@ func (s *ComplexObject) ApplyDefaults() {
 }
 
 // #/paths//enums/get/parameters/0/schema
-type GetEnumsParameter int
+type GetEnumsParameter int32
 
 const (
 	N100 GetEnumsParameter = 100
 	N200 GetEnumsParameter = 200
 )
 
+// Values returns the GetEnumsParameter constants, in the order of the spec.
+func (GetEnumsParameter) Values() []GetEnumsParameter {
+	return []GetEnumsParameter{N100, N200}
+}
+
+// String returns the name of the GetEnumsParameter constant equal to e, or the
+// type and number for values not in the enum.
+func (e GetEnumsParameter) String() string {
+	switch e {
+	case N100:
+		return "N100"
+	case N200:
+		return "N200"
+	}
+	return fmt.Sprintf("GetEnumsParameter(%d)", int(e))
+}
+
+// ParseGetEnumsParameter returns the GetEnumsParameter constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseGetEnumsParameter(s string) (GetEnumsParameter, error) {
+	switch s {
+	case "N100", "100":
+		return N100, nil
+	case "N200", "200":
+		return N200, nil
+	}
+	return 0, fmt.Errorf("invalid GetEnumsParameter value %q", s)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+RaX2/cNgx/96cQsgEFgt3ZSffkt6DbsABrdlsDtMCwB8XmxepsSZXkLEGx7z7Ysu/k",
-	"f7HsRHdukqeeTErkjyJ/NF3GgWJOQnTydh2sz048Qrcs9BC6AyEJoyE6WwfrwENIEZVCiK5BKvQBxB0I",
-	"D6GUREAlFAoIUZxBiN5fXnuyfC6L5RXKRRqiRCke+n51nAKp1jEAzzBfY+JxrJJS2pck4ylsBMmIInfg",
-	"f+VY4Ow/fcAtKP0PhBgHgRVh9DIOi/UPTb1KqlQGVZmi/1aVneWz3SpChBZrKjGWBHzJiYA4RErkYDyQ",
-	"6qHAQhtrrkcJZDg0VhBSDxxCRKiC2xKy/d+WiQyr8tnbc68+UnJGJRgGvzkPgjfmnt8L2Ibo5Ds/Yhln",
-	"FKiS/k7P10j8Wf0+2YN6xX6+5ymL4UII/DAR2abykeAFbUKItjiVdrhjw9zKEAWZbIo+FqSDh6knSKe2",
-	"UVpSjNryLyhEu2T4/eYzRGpuKmntbyGX+nDSctLXXpwcLDEamE/NjGVAbpEaR0Y8xTeQtgljbXnNf+sq",
-	"Hwbx0urXwxelu30hOrWM0YIi9CLZoplFdeGal0aHrFxPyqMlVK5exCdmxSIAXz5TZFgJct+hChKPo/2+",
-	"R9UCbxI/DWxt8euhCe1vJz6ntgFaTHheJEe08mdXsmYkkHXFOm4GLaJgdeGemg8LQHv57BAxqoCqie/J",
-	"70wtxwxcWdhEDXOekqg0yf8sGW1Xjj6kx9B+xwp07g8AOsdSXieC5beJNeSbvc4xAFdwr3yeYmINtS7e",
-	"UglCb53OHhQWitDbj0QlV3l2A8L/emY77+noWmB79orA/ZKDePiFiWwUyj9qSQsEARs2xSAjQbgqv2VU",
-	"lTPudAgFxqUxvSB3WE0X5u3enEX1KXskBoFw6v/R22jjKrDRq8BMlpmOhRsy3nsw6MBz2f28LZsBPR+F",
-	"nrc+17lFf9qHOIPshvx4RvPtU2aG/XLUgWapn1EQBs1tbVzbFA1e60i3SU+93sdt6ww2HwS/eI7q7gL9",
-	"S1SCqNkjOIuCOyb/CYBrIEb5fC9qQeixFh5Csng89b70v2rFbaues8LbvgDEsMV5qp4UjgRwDGI0Cr+W",
-	"YhYR+LTa9BQ7q2qYmGcsoBwarqyqV/n4ED45ZKhPq3I4N+rOo+2vU5+cN3oVBFP6XbcX8wAe60piH/Xe",
-	"Euko7HM6xtqjSR2vqyjOc6Cq8SOOPNrjTHBoMU3Oqp50rD4Slayu2n3MrMZnAhDzO58nk62ecrJ/CFiM",
-	"NQsxm/HaVD6KzJ0XwLFwEA8cMurMEZJTL5Y1LXJ75RY7GHIUYfeTIVfxmmP5zLf/CS68nNf/CU4fkQUR",
-	"8oHmdYIOcWAhsil4T9pM8UthlWxan0MaKG7qDTSChQ66w2kO0sEUxZ5GyiyieRaiv9BZEPyAzoMA/T2M",
-	"/3nwYzh4UVh9m719KArp6gpqRXPsghAXBe6KmGcIloJ5SO98bkuEVFcF/t7IHargM8JWHGD83G1VrjXy",
-	"5DEzmxJTK8xl3DW8HbZLeRFnzW9hWvCGsRQwfcTFVlu/Qpex+UNv7HVC3MyWesNGlC+q/y68U22WwE49",
-	"6/+mN1oB/g8AAP//iGcBzu8zAAA=",
+	"H4sIAAAAAAAC/+RaS2/jNhC+61cM0gILLGpLyfakW7Bt0QDd1O0G6AJFD4w0jriVSC5JpwkW/e+FXjb1",
+	"sEUpoa0mOVl8SDPfx5lvNAoXyIigIZy9WwbL8zOPsjUPPYB7lIpyFsL5MlgGHoCmOsUQblBp+IjyHqUH",
+	"kNIImcJ8AwAjGYbw4erGU8W8yocXsJFpCInWIvT96nEalV7GiCIjYkmoJ4hOitW+oplIcSVpRjW9R/+r",
+	"IJJk/+ZzAHeoyx8AXKAkmnJ2FYf5+MfmPg8AAKDYjLoyBQAAYFHZWcxtRwEoy8d0YgxJ/LKhEuMQtNyg",
+	"MaH0Y45Faaw5HiWYkdAYAdCPAkOgTONdAdnub81lRnQx9+7Cqx+pBGcKDYPfXATBm90lwLcS1yGcfeNH",
+	"PBOcIdPK3+7zSyR+r67PdqBe8x8fRMpjvJSSPI5Etrn5RPBiaUIIa5IqO9yJYS4AAADVmKnm0kMkHZ2m",
+	"HpLe2rI0J47a618QRdtg+PX2M0Z6aiiVu/8PsdSHU7lO+aUXZ0cLjAbmYyNjHpBbhMaJEU/JLaZtwVha",
+	"HvNfupuPg3hh9evRi8LdPoreWnI0I4ZepFo0o6hOXNPC6JiZ60lxNIfM1Yv4yKiYBeDzV4qMaEkfOlJB",
+	"42G0P/RstcCbxk8Du7T49chE6W+Hn7e2BM2GnhepEa342aasCQFknbFOG0GzSFhduMfGwwzQnr86RJxp",
+	"ZHrke/J7c5djBa4sbKJGhEhpVJjkf1acNWf7kR5C+z3P0Xk4AuiCKHWTSL65S6whX+32nAJwjQ/aFymh",
+	"1lCXyVtpSdmd096DJlJTdvcH1cn1JrtF6X89t+33dPZaYHv+isD9skH5+BOX2SCUv9UrLRBEsh0CiFFF",
+	"kgpdfMuoMmfcqRByjAtjekHuqFqZmNc7c2ZVp+yQ2AuEU/9PXkYbR4EPHgVuqsx4LNyI8c6DvQ48l93P",
+	"W7IZ0ItB6EXrc51b9Md9iDPEbp8fz2i+fchMsF8NOtBM9RMSwl5zWzeubYr2HuuoLJOeerxPW9YZar4X",
+	"/Hwe6uoC/qE6AWbWCM5YcKfkPyCKEohBPd8ttRD0uFy8D8l8eux56X/VittWPWeGt30BiHFNNql+Eh0J",
+	"khjlIAs/F8ssGPi0WPUkO6tsmJjPmEE6NFxZVK/y8TF8cqhQnxZFc27QnYPlr1OfnBd6FQRj6l23B/MI",
+	"HpeZxJ713hTpiPYpFWPt0aiK1xWL0xyocvyAIwdrnBEOzabIWdSdjkXe6lhct+uYSYXPCCCmVz5PFtuy",
+	"y8n/pmjR1syX2bTXxupRZN55BhqLR/HAoaJObCE59WJe3SK3R262jSFHDLvvDLnia4rlE9/+R7jwcl7/",
+	"Rzh9QhUE8JFtMnVYA/Mlq1z3lE0Xv1isk1Xrc0gDxVV9gxLBfA/ck3SDykEXxV5GAKCwJYQ/4TwIvoOL",
+	"IIC/9uN/EXwf7j0ovD7N3o6K0KsNLX4CmG0XACFz3DU1nyF5iqHX9qlxMgDWVCp9nePvDZyhCj6DtvwB",
+	"xuX2Vh4AQCNODpnZXDE2w1zFoTdE25W6jDPKugtvOU+RsAMutsr6BVzF5kV5Y69DcTNaQq+H5cvq34W3",
+	"W5spsJPP+r/pDWaA/wYAiGcBzu8zAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -112,6 +141,16 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // Exploded object binding
 // ---------------------------------------------------------------------------
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -119,3 +158,8 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // ---------------------------------------------------------------------------
 // Deep object marshaling
 // ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
@@ -0,0 +1,85 @@
+package allow_empty_value_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/allow_empty_value/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/allow_empty_value/stdhttp"
+)
+
+// newServer starts the std-http server and returns its URL.
+func newServer(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestAllowEmptyValue_Client(t *testing.T) {
+	c, err := client.NewSimpleClient(newServer(t))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name  string
+		setup func(*client.SearchParams)
+		want  client.States
+	}{
+		{
+			name:  "absent",
+			setup: func(*client.SearchParams) {},
+			want:  client.States{Archived: "absent", Tags: "absent"},
+		},
+		{
+			name: "empty",
+			setup: func(p *client.SearchParams) {
+				p.Archived.SetNull()
+				p.Tags.SetNull()
+			},
+			want: client.States{Archived: "empty", Tags: "empty", Query: "archived=&tags="},
+		},
+		{
+			name: "values",
+			setup: func(p *client.SearchParams) {
+				p.Archived.Set(false)
+				p.Tags.Set([]string{"a", "b"})
+			},
+			want: client.States{Archived: "false", Tags: "a|b", Query: "archived=false&tags=a%2Cb"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var params client.SearchParams
+			tc.setup(&params)
+			states, err := c.Search(context.Background(), &params)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, states)
+		})
+	}
+}
+
+func TestAllowEmptyValue_Server(t *testing.T) {
+	serverURL := newServer(t)
+
+	resp, err := http.Get(serverURL + "/search?archived&tags=")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var states client.States
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&states))
+	assert.Equal(t, client.States{Archived: "empty", Tags: "empty", Query: "archived&tags="}, states)
+
+	var params client.SearchParams
+	require.NoError(t, params.FromURLValues(map[string][]string{"archived": {""}}))
+	assert.True(t, params.Archived.IsNull())
+	assert.False(t, params.Tags.IsSpecified())
+
+	values, err := params.ToURLValues()
+	require.NoError(t, err)
+	assert.Equal(t, "archived=", values.Encode())
+}
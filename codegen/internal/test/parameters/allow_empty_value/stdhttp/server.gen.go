@@ -0,0 +1,396 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/States
+type States struct {
+	// absent, empty, or the value received
+	Archived string `form:"archived" json:"archived"`
+	// absent, empty, or the values received
+	Tags string `form:"tags" json:"tags"`
+	// The raw query string
+	Query string `form:"query" json:"query"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *States) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6xTMbPTPBDs/St2/H1liAOvU09BDUPDUFzkS6w3tqTcXfLIv2ds49hOeAMMdPLd6na1",
+	"t06ZI+XgUD5td9unsgjxkFwBWLCWHaht08v7Ltv1M7VnRiahjo1FC+DCoiFFh/LtdlcWmazR/m6lTOKb",
+	"/ggc2cYDkDILWUjxQ+0wYn505rETFniDSF0vQXwTLlzfGkCIDqczy3VRu1PqYHLmRV99wx25RQWwa2aH",
+	"fUotU3wgNjrqX5Lyt9ymmh0O1OrvqSERuq7qwbjTNXQCq0mIxwflp1/Ifp19NVBYc4rKC/Ly3W5Xzp9A",
+	"zeolZBty8KlhqJEx0gFMvpkXC+t7LBcWCHu+26hP0TjaWhLl3AY/JKZ61hTX3Z8/AwD+Fz44lP9VPnU5",
+	"RY6m1YjV6mMvT8tibrlimjQcgRHiiqUvaf/M3m6+nM5BuHb4MoVzM6RlMzr9dUq19Im3MA/DLc1z5ZVd",
+	"3llLe+VoG3Aftg3SaOhl+Ckf/Oy1/EMCfWQY3vmnFH08hF7GyxP2+wD3QXO1ggQAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// archived (optional)
+	Archived oapiCodegenTypesPkg.Nullable[bool] `form:"archived" json:"archived"`
+	// tags (optional)
+	Tags oapiCodegenTypesPkg.Nullable[[]string] `form:"tags" json:"tags"`
+	// q (optional)
+	Q *string `form:"q" json:"q"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *SearchParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if frag, err := oapiCodegenParamsPkg.StyleNullableQueryParam("archived", p.Archived, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "boolean", Format: "", AllowReserved: false}); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	if frag, err := oapiCodegenParamsPkg.StyleNullableQueryParam("tags", p.Tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	if p.Q != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("q", *p.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *SearchParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindNullableQueryParameter("archived", values, &p.Archived, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "boolean", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter archived: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindNullableQueryParameter("tags", values, &p.Tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter tags: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("q", values, &p.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter q: %w", err)
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Optional query parameter "archived" -------------
+	err = oapiCodegenParamsPkg.BindNullableQueryParameter("archived", r.URL.Query(), &params.Archived, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "boolean", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "archived", Location: "query", Value: r.URL.Query().Get("archived"), Err: &InvalidParamFormatError{ParamName: "archived", Err: err}})
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	err = oapiCodegenParamsPkg.BindNullableQueryParameter("tags", r.URL.Query(), &params.Tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "tags", Location: "query", Value: r.URL.Query().Get("tags"), Err: &InvalidParamFormatError{ParamName: "tags", Err: err}})
+	}
+
+	// ------------- Optional query parameter "q" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("q", r.URL.Query(), &params.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "q", Location: "query", Value: r.URL.Query().Get("q"), Err: &InvalidParamFormatError{ParamName: "q", Err: err}})
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/search", wrapper.Search)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
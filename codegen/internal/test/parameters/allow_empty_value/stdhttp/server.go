@@ -0,0 +1,36 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// Server implements ServerInterface by echoing the state of each parameter.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+// state describes a parameter as absent, empty, or its value.
+func state[T any](n types.Nullable[T], format func(T) string) string {
+	switch {
+	case !n.IsSpecified():
+		return "absent"
+	case n.IsNull():
+		return "empty"
+	default:
+		return format(n.MustGet())
+	}
+}
+
+func (s *Server) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(States{
+		Archived: state(params.Archived, func(v bool) string { return fmt.Sprint(v) }),
+		Tags:     state(params.Tags, func(v []string) string { return strings.Join(v, "|") }),
+		Query:    r.URL.RawQuery,
+	})
+}
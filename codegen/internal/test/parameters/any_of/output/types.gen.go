@@ -17,7 +17,8 @@ import (
 // #/components/schemas/test
 
 type Test struct {
-	union json.RawMessage
+	union   json.RawMessage
+	matched [2]bool
 }
 
 // AsTestAnyOf0 returns the union data inside the Test as a TestAnyOf0.
@@ -31,6 +32,7 @@ func (t Test) AsTestAnyOf0() (TestAnyOf0, error) {
 func (t *Test) FromTestAnyOf0(v TestAnyOf0) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -42,6 +44,7 @@ func (t *Test) MergeTestAnyOf0(v TestAnyOf0) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
@@ -56,6 +59,7 @@ func (t Test) AsTestAnyOf1() (TestAnyOf1, error) {
 func (t *Test) FromTestAnyOf1(v TestAnyOf1) error {
 	b, err := json.Marshal(v)
 	t.union = b
+	t.match()
 	return err
 }
 
@@ -67,9 +71,46 @@ func (t *Test) MergeTestAnyOf1(v TestAnyOf1) error {
 	}
 	merged, err := oapiCodegenHelpersPkg.JSONMerge(t.union, b)
 	t.union = merged
+	t.match()
 	return err
 }
 
+// match records the members the union data matches: those it decodes as,
+// holding every property they require.
+func (t *Test) match() {
+	t.matched = [2]bool{}
+	if t.union == nil || string(t.union) == "null" {
+		return
+	}
+	if _, err := t.AsTestAnyOf0(); err == nil && oapiCodegenHelpersPkg.HasJSONProperties(t.union, "item1", "item2") {
+		t.matched[0] = true
+	}
+	if _, err := t.AsTestAnyOf1(); err == nil {
+		t.matched[1] = true
+	}
+}
+
+// MatchesTestAnyOf0 reports whether the union data matches TestAnyOf0.
+func (t Test) MatchesTestAnyOf0() bool {
+	return t.matched[0]
+}
+
+// MatchesTestAnyOf1 reports whether the union data matches TestAnyOf1.
+func (t Test) MatchesTestAnyOf1() bool {
+	return t.matched[1]
+}
+
+// Valid reports whether the union data matches at least one member, as anyOf
+// requires.
+func (t Test) Valid() bool {
+	for _, matched := range t.matched {
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (t Test) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -77,6 +118,7 @@ func (t Test) MarshalJSON() ([]byte, error) {
 
 func (t *Test) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
+	t.match()
 	return err
 }
 
@@ -179,12 +221,12 @@ type GetTestParameter = []Test2
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/5SSTVLDMAyF9znFm8JMV/1Ld94xHIAzmFRtBI3tSioz4fRM0rRJIVC6cz496T0rjomC",
-	"T+wwWc+X8+Uk47CNLgM+SJRjcFg1PAOMbU8OT6F+2SJ58RUZSQZsSAvhZK36uaTiXfs6lIT9nj8JpddT",
-	"d5a8ldqYLIzUmgOwo+6AvlnPZIbgK3Jo5B0CODgcjiT1hWhRUuXd5Rt4FNo6TB8WRaxSDBRMFyeVtt7T",
-	"EYP8LgerEzl4EV8PKBtVOpTdjpJPs77StHbF05R+UYBvltgPn3UZ4usbFTbwFDocWWhznWPWhluNsHzA",
-	"ksREYkzfbtH2XqPzDtSEw+6HOv+X+tdL/BUkvyvI+qa6/Q1nVQw0tmUORrv23V/zbsxXAAAA//8J8F+B",
-	"UAMAAA==",
+	"H4sIAAAAAAAC/5SSzXLqMAyF936KM9w7w4q/sPPuzn2APoMbBFFLbCOJzqRP30lICLS0lJ386Sg6PnHK",
+	"FENmj8l6vpwvJ47jNnkHvJEop+ixarkDjG1PHv9i87RFDhJqMhIHbEhL4Wyd+n9F5auOfSgJhz2/E6qg",
+	"p2mXg1XqHbAwUmsLYEd9gXFYBzJDDDV5tPIeARw9DkeS5ky0rKgO/nwG/gptPaZ/FmWqc4oUTRcnlXa7",
+	"pzcWFA9tsCaTRxAJzQVlo1ovZfetFFM3dtrRvtmWwBgUENoQhwMw6z2k5xcqx3gAocORhTbXPmadudUN",
+	"VlywLCmTGNOnW3Sz12jIQE047r6oi1+pv73ET0aKh4ys76q73zCoUqRbKXM02nXv/pr3n/kYAAnwX4FQ",
+	"AwAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
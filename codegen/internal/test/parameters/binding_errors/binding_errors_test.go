@@ -0,0 +1,119 @@
+package binding_errors_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/binding_errors/stdhttp"
+)
+
+// newServer returns a std-http server whose error handler writes binding
+// errors as JSON, and hands every error it sees to the returned channel.
+func newServer(t *testing.T) (*httptest.Server, <-chan error) {
+	t.Helper()
+	seen := make(chan error, 1)
+	server := httptest.NewServer(stdhttp.HandlerWithOptions(&stdhttp.Server{}, stdhttp.StdHTTPServerOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			seen <- err
+			var bindErrs stdhttp.BindingErrors
+			if !errors.As(err, &bindErrs) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(bindErrs.StatusCode())
+			_ = json.NewEncoder(w).Encode(bindErrs)
+		},
+	}))
+	t.Cleanup(server.Close)
+	return server, seen
+}
+
+type bindingErrorBody struct {
+	Name    string `json:"name"`
+	In      string `json:"in"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
+
+func TestBindingErrorsListsEveryParameter(t *testing.T) {
+	server, seen := newServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/items/abc?limit=500&filter=%7Bnope", nil)
+	require.NoError(t, err)
+	req.AddCookie(&http.Cookie{Name: "session", Value: "xyz"})
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	var body struct {
+		Errors []bindingErrorBody `json:"errors"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+	require.Len(t, body.Errors, 5)
+	got := make(map[string]bindingErrorBody, len(body.Errors))
+	for _, e := range body.Errors {
+		got[e.Name] = e
+		assert.NotEmpty(t, e.Message, e.Name)
+	}
+	assert.Equal(t, "path", got["id"].In)
+	assert.Equal(t, "abc", got["id"].Value)
+	assert.Equal(t, "query", got["limit"].In)
+	assert.Equal(t, "500", got["limit"].Value)
+	assert.Equal(t, "query", got["filter"].In)
+	assert.Equal(t, "{nope", got["filter"].Value)
+	assert.Equal(t, "header", got["X-Trace"].In)
+	assert.Empty(t, got["X-Trace"].Value)
+	assert.Equal(t, "cookie", got["session"].In)
+	assert.Equal(t, "xyz", got["session"].Value)
+
+	err = <-seen
+	var bindErrs stdhttp.BindingErrors
+	require.ErrorAs(t, err, &bindErrs)
+	assert.Len(t, bindErrs, 5)
+
+	var formatErr *stdhttp.InvalidParamFormatError
+	require.ErrorAs(t, err, &formatErr)
+	assert.Equal(t, "id", formatErr.ParamName)
+	var jsonErr *stdhttp.UnmarshalingParamError
+	require.ErrorAs(t, err, &jsonErr)
+	assert.Equal(t, "filter", jsonErr.ParamName)
+	var headerErr *stdhttp.RequiredHeaderError
+	require.ErrorAs(t, err, &headerErr)
+	assert.Equal(t, "X-Trace", headerErr.ParamName)
+}
+
+func TestBindingErrorsDefaultHandler(t *testing.T) {
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/items/abc", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestBindingErrorsNone(t *testing.T) {
+	server, seen := newServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/items/7?limit=5", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Trace", "abc")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, seen)
+}
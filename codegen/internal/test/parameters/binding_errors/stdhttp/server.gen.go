@@ -0,0 +1,424 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenJSONPointerPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/jsonpointer"
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// #/components/schemas/Filter
+type Filter struct {
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Filter) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6SSQW/UMBCF7/kVT4Frmy1w8pEDUu89cHWTt7tT1mN3PKFUiP+OkmXZdIOE1N7smbG/",
+	"pzcvF2osEtB+vL653rSN6DaHBnDxAwM+iw6iO9AsW22A77QqWQPaebxE39dpvhNnqt1PGX5NV2BHPx6A",
+	"XGjRJevtEKb6rTP9aZVoMdFp9TQMXEFjYoAMf0uAaMAEW5SMj6MYhwC3kYtG7fdMMSwqgD+X6Ut17mgr",
+	"1EGS+AXtcaQ9v+pXAEjxh6QxBdxsNivcVg5O+w+vz+pUfwmMpRykn83sHmrWl91/iwSA98ZtQPuu63Mq",
+	"Waleu+Ns7b7MatqVyq9XdxZ7XsjcMw60t62huonuVsDKOmXrAtjn/E1es15jLVkrF9FqP2w+tecrMLD2",
+	"JsXnSN/tuQgknmjEfR51aM6uheYkYD4CR/dCs9SR7x/Yn/JUbMq/y1JHftLzm5UvvwcAOEBDK5UDAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /items/{id})
+	GetItem(w http.ResponseWriter, r *http.Request, id int, params GetItemParams)
+}
+
+// GetItemParams defines parameters for GetItem.
+type GetItemParams struct {
+	// limit (optional)
+	Limit *int `form:"limit" json:"limit"`
+	// filter (optional)
+	Filter *Filter `form:"filter" json:"filter"`
+	// X-Trace (header, required)
+	XTrace string
+	// session (cookie)
+	Session *int
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetItemParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Limit != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("limit", *p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Filter != nil {
+		if buf, err := json.Marshal(*p.Filter); err != nil {
+			return nil, err
+		} else {
+			values.Add("filter", string(buf))
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetItemParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", values, &p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	if p.Limit != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("limit", *p.Limit, oapiCodegenParamsPkg.ParamMaximum[int](100, false)); err != nil {
+			return err
+		}
+	}
+	if paramValue := values.Get("filter"); paramValue != "" {
+		var value Filter
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON([]byte(paramValue), &value); err != nil {
+			return fmt.Errorf("unmarshaling query parameter filter: %w", err)
+		}
+		p.Filter = &value
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetItem operation middleware
+func (siw *ServerInterfaceWrapper) GetItem(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemParams
+
+	// ------------- Optional query parameter "limit" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", r.URL.Query(), &params.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+	if err == nil && params.Limit != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("limit", *params.Limit, oapiCodegenParamsPkg.ParamMaximum[int](100, false))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "limit", Location: "query", Value: r.URL.Query().Get("limit"), Err: &InvalidParamFormatError{ParamName: "limit", Err: err}})
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+	if paramValue := r.URL.Query().Get("filter"); paramValue != "" {
+		var value Filter
+		err = oapiCodegenJSONPointerPkg.DecodeJSON([]byte(paramValue), &value)
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "filter", Location: "query", Value: paramValue, Err: &UnmarshalingParamError{ParamName: "filter", Err: err}})
+		}
+		params.Filter = &value
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Trace" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Trace")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Trace", Count: len(valueList)}})
+	} else if found {
+		var xTrace string
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Trace", valueList[0], &xTrace, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Trace", Err: err}})
+		}
+		params.XTrace = xTrace
+	} else {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Err: &RequiredHeaderError{ParamName: "X-Trace"}})
+	}
+
+	{
+		var cookie *http.Cookie
+		if cookie, err = r.Cookie("session"); err == nil {
+			var value int
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("session", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+			if err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "session", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "session", Err: err}})
+			}
+			params.Session = &value
+		}
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItem(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/items/{id}", wrapper.GetItem)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
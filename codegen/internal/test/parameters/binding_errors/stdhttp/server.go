@@ -0,0 +1,12 @@
+package stdhttp
+
+import "net/http"
+
+// Server implements ServerInterface, accepting any request that binds.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) GetItem(w http.ResponseWriter, r *http.Request, id int, params GetItemParams) {
+	w.WriteHeader(http.StatusNoContent)
+}
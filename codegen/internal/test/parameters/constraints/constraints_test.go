@@ -0,0 +1,94 @@
+package constraints_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/constraints/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/constraints/stdhttp"
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// send sends a request for code and p to the std-http server, returning the
+// status and body of its response. Violations are reported by the server's
+// error handler as the parameter and constraint.
+func send(t *testing.T, code string, p client.GetItemParams) (int, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.HandlerWithOptions(&stdhttp.Server{}, stdhttp.StdHTTPServerOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			var violation *params.ParamConstraintError
+			if errors.As(err, &violation) {
+				http.Error(w, violation.ParamName+" "+violation.Constraint, http.StatusBadRequest)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+	}))
+	t.Cleanup(server.Close)
+
+	req, err := client.NewGetItemRequest(server.URL, code, &p)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestParameterConstraints_Valid(t *testing.T) {
+	status, body := send(t, "AB12", client.GetItemParams{
+		Limit:  ptr[int32](100),
+		Ratio:  ptr[float32](0.5),
+		Color:  ptr(client.Blue),
+		XTrace: ptr("trace-id"),
+		Level:  ptr(3),
+	})
+	assert.Equal(t, http.StatusOK, status, body)
+
+	status, body = send(t, "AB12", client.GetItemParams{})
+	assert.Equal(t, http.StatusOK, status, body)
+}
+
+func TestParameterConstraints_Violations(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		code   string
+		params client.GetItemParams
+		want   string
+	}{
+		{name: "path pattern", code: "ab12", want: "code pattern"},
+		{name: "path max length", code: "AB12345", want: "code maxLength"},
+		{name: "query minimum", code: "AB1", params: client.GetItemParams{Limit: ptr[int32](0)}, want: "limit minimum"},
+		{name: "query maximum", code: "AB1", params: client.GetItemParams{Limit: ptr[int32](101)}, want: "limit maximum"},
+		{name: "query exclusive minimum", code: "AB1", params: client.GetItemParams{Ratio: ptr[float32](0)}, want: "ratio exclusiveMinimum"},
+		{name: "query enum", code: "AB1", params: client.GetItemParams{Color: ptr(client.Color("green"))}, want: "color enum"},
+		{name: "header min length", code: "AB1", params: client.GetItemParams{XTrace: ptr("short")}, want: "X-Trace minLength"},
+		{name: "cookie enum", code: "AB1", params: client.GetItemParams{Level: ptr(4)}, want: "level enum"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status, body := send(t, tc.code, tc.params)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, tc.want+"\n", body)
+		})
+	}
+}
+
+func TestParameterConstraints_FromURLValues(t *testing.T) {
+	var p stdhttp.GetItemParams
+	err := p.FromURLValues(map[string][]string{"limit": {"500"}})
+	var violation *params.ParamConstraintError
+	require.ErrorAs(t, err, &violation)
+	assert.Equal(t, "limit", violation.ParamName)
+	assert.Equal(t, "maximum", violation.Constraint)
+}
@@ -0,0 +1,574 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// #/components/schemas/Color
+type Color string
+
+const (
+	Red  Color = "red"
+	Blue Color = "blue"
+)
+
+// Values returns the Color constants, in the order of the spec.
+func (Color) Values() []Color {
+	return []Color{Red, Blue}
+}
+
+// ParseColor returns the Color constant whose value is s. Other strings
+// return an error.
+func ParseColor(s string) (Color, error) {
+	switch v := Color(s); v {
+	case Red, Blue:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
+// #/paths//items/{code}/get/parameters/7/schema
+type GetItemsCodeParameter int
+
+const (
+	N1 GetItemsCodeParameter = 1
+	N2 GetItemsCodeParameter = 2
+	N3 GetItemsCodeParameter = 3
+)
+
+// Values returns the GetItemsCodeParameter constants, in the order of the spec.
+func (GetItemsCodeParameter) Values() []GetItemsCodeParameter {
+	return []GetItemsCodeParameter{N1, N2, N3}
+}
+
+// String returns the name of the GetItemsCodeParameter constant equal to e, or the
+// type and number for values not in the enum.
+func (e GetItemsCodeParameter) String() string {
+	switch e {
+	case N1:
+		return "N1"
+	case N2:
+		return "N2"
+	case N3:
+		return "N3"
+	}
+	return fmt.Sprintf("GetItemsCodeParameter(%d)", int(e))
+}
+
+// ParseGetItemsCodeParameter returns the GetItemsCodeParameter constant named s, as String writes it,
+// or whose value s is in decimal. Other strings return an error.
+func ParseGetItemsCodeParameter(s string) (GetItemsCodeParameter, error) {
+	switch s {
+	case "N1", "1":
+		return N1, nil
+	case "N2", "2":
+		return N2, nil
+	case "N3", "3":
+		return N3, nil
+	}
+	return 0, fmt.Errorf("invalid GetItemsCodeParameter value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7SUQW/bMAyF7/4VhNfbmsax2yTVbdipwAbs0MOwIANU+yUhZkmuRActiv73wW6y2HEG",
+	"bAF6kx8p8fOTSFfB6ooVxdnV5CqJI7YrpyIiYSmh6Jv22kDgKXc2iNdsJZAgSES0hQ/srKK43Vpp2YRm",
+	"75gFJoxfclfgtRGI1pC3BZGr4LWws3eFavQ7gdmFqn21sE8mGpHVBoqaw/6IRGwVNQU7ksdjzR6FIvF1",
+	"NzfkGxitOgqRPFdQFMSzXfcClRaBb37q5+LT6MfyJX1dJKPb5ceLuJdn9NMX2LVsFE0HsCUbliPaxxr+",
+	"+Z+o2ArW8L3IynmjpY1laR+ELZvaKJoc8+3kJBnwudUq4N0Ap9enAZPTgLdpmmWzNMmm85vr2exmnswG",
+	"wJVe411wa7YyP407+ouh2QlD2xd9NqCtzcMRH57ysg68xdehe4eWKJ0/o+iFx0pR/GGcO1M5Cyth/JYX",
+	"xp+bM+NBqe+je6/z4yvYQBc97v/qNMN230HzYQdhi/KoXO7cL8bZVw7b2LiYXFJ6SdlyF/MIlbMBnZET",
+	"p0kSHz6JCoTccyXtsLvfoDOoyCMHb1FEBzNVtEdrl0StqSrqAvb82JF5FJf0UNZYRr8HAAj+/9iYBQAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /items/{code})
+	GetItem(w http.ResponseWriter, r *http.Request, code string, params GetItemParams)
+}
+
+// GetItemParams defines parameters for GetItem.
+type GetItemParams struct {
+	// limit (optional)
+	Limit *int32 `form:"limit" json:"limit"`
+	// offset (optional)
+	Offset *int64 `form:"offset" json:"offset"`
+	// page (optional)
+	Page *uint8 `form:"page" json:"page"`
+	// ratio (optional)
+	Ratio *float32 `form:"ratio" json:"ratio"`
+	// color (optional)
+	Color *Color `form:"color" json:"color"`
+	// X-Trace (header)
+	XTrace *string
+	// level (cookie)
+	Level *int
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetItemParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Limit != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("limit", *p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Offset != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("offset", *p.Offset, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int64", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int64]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Page != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("page", *p.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "uint8", AllowReserved: false}, oapiCodegenParamsPkg.FormatUint[uint8]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Ratio != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("ratio", *p.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatFloat32[float32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Color != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("color", *p.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[Color]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetItemParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", values, &p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	if p.Limit != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("limit", *p.Limit, oapiCodegenParamsPkg.ParamMinimum[int32](1, false), oapiCodegenParamsPkg.ParamMaximum[int32](100, false)); err != nil {
+			return err
+		}
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("offset", values, &p.Offset, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int64", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int64]); err != nil {
+		return fmt.Errorf("invalid format for query parameter offset: %w", err)
+	}
+	if p.Offset != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("offset", *p.Offset, oapiCodegenParamsPkg.ParamMinimum[int64](0, false), oapiCodegenParamsPkg.ParamMaximum[int64](9223372036854775807, false)); err != nil {
+			return err
+		}
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("page", values, &p.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "uint8", AllowReserved: false}, oapiCodegenParamsPkg.ParseUint[uint8]); err != nil {
+		return fmt.Errorf("invalid format for query parameter page: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ratio", values, &p.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseFloat[float32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter ratio: %w", err)
+	}
+	if p.Ratio != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("ratio", *p.Ratio, oapiCodegenParamsPkg.ParamMinimum[float32](0, true)); err != nil {
+			return err
+		}
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("color", values, &p.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[Color]); err != nil {
+		return fmt.Errorf("invalid format for query parameter color: %w", err)
+	}
+	if p.Color != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("color", *p.Color, oapiCodegenParamsPkg.ParamEnum[Color]("red", "blue")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetItem operation middleware
+func (siw *ServerInterfaceWrapper) GetItem(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("code", r.PathValue("code"), &code, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err == nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("code", code, oapiCodegenParamsPkg.ParamMaxLength[string](6), oapiCodegenParamsPkg.ParamPattern[string]("^[A-Z]{2}[0-9]+$"))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "code", Location: "path", Value: r.PathValue("code"), Err: &InvalidParamFormatError{ParamName: "code", Err: err}})
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemParams
+
+	// ------------- Optional query parameter "limit" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", r.URL.Query(), &params.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
+	if err == nil && params.Limit != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("limit", *params.Limit, oapiCodegenParamsPkg.ParamMinimum[int32](1, false), oapiCodegenParamsPkg.ParamMaximum[int32](100, false))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "limit", Location: "query", Value: r.URL.Query().Get("limit"), Err: &InvalidParamFormatError{ParamName: "limit", Err: err}})
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("offset", r.URL.Query(), &params.Offset, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int64", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int64])
+	if err == nil && params.Offset != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("offset", *params.Offset, oapiCodegenParamsPkg.ParamMinimum[int64](0, false), oapiCodegenParamsPkg.ParamMaximum[int64](9223372036854775807, false))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "offset", Location: "query", Value: r.URL.Query().Get("offset"), Err: &InvalidParamFormatError{ParamName: "offset", Err: err}})
+	}
+
+	// ------------- Optional query parameter "page" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("page", r.URL.Query(), &params.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "uint8", AllowReserved: false}, oapiCodegenParamsPkg.ParseUint[uint8])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "page", Location: "query", Value: r.URL.Query().Get("page"), Err: &InvalidParamFormatError{ParamName: "page", Err: err}})
+	}
+
+	// ------------- Optional query parameter "ratio" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ratio", r.URL.Query(), &params.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseFloat[float32])
+	if err == nil && params.Ratio != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("ratio", *params.Ratio, oapiCodegenParamsPkg.ParamMinimum[float32](0, true))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ratio", Location: "query", Value: r.URL.Query().Get("ratio"), Err: &InvalidParamFormatError{ParamName: "ratio", Err: err}})
+	}
+
+	// ------------- Optional query parameter "color" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("color", r.URL.Query(), &params.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[Color])
+	if err == nil && params.Color != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("color", *params.Color, oapiCodegenParamsPkg.ParamEnum[Color]("red", "blue"))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "color", Location: "query", Value: r.URL.Query().Get("color"), Err: &InvalidParamFormatError{ParamName: "color", Err: err}})
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Trace" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Trace")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Trace", Count: len(valueList)}})
+	} else if found {
+		var xTrace string
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Trace", valueList[0], &xTrace, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+		if err == nil {
+			err = oapiCodegenParamsPkg.ValidateParameter("X-Trace", xTrace, oapiCodegenParamsPkg.ParamMinLength[string](8))
+		}
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Trace", Err: err}})
+		}
+		params.XTrace = &xTrace
+	}
+
+	{
+		var cookie *http.Cookie
+		if cookie, err = r.Cookie("level"); err == nil {
+			var value int
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("level", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+			if err == nil {
+				err = oapiCodegenParamsPkg.ValidateParameter("level", value, oapiCodegenParamsPkg.ParamEnum[int](1, 2, 3))
+			}
+			if err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "level", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "level", Err: err}})
+			}
+			params.Level = &value
+		}
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItem(w, r, code, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/items/{code}", wrapper.GetItem)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
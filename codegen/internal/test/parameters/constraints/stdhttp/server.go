@@ -0,0 +1,17 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Server implements ServerInterface by echoing the received parameters back
+// as JSON.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) GetItem(w http.ResponseWriter, r *http.Request, code string, params GetItemParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "params": params})
+}
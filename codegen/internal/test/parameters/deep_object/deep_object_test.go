@@ -0,0 +1,90 @@
+package deep_object_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/deep_object/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/deep_object/stdhttp"
+)
+
+// newClient returns a SimpleClient talking to the std-http server.
+func newClient(t *testing.T) (*client.SimpleClient, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	c, err := client.NewSimpleClient(server.URL)
+	require.NoError(t, err)
+	return c, server.URL
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDeepObjectRoundtrip(t *testing.T) {
+	c, _ := newClient(t)
+
+	gte := client.Date{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+	echo, err := c.ListItems(context.Background(), &client.ListItemsParams{
+		Filter: &client.GetItemsParameter01{
+			Status:   ptr(client.Open),
+			Name:     ptr("a&b=c d"),
+			MinPrice: ptr[float32](2.5),
+			Tags:     []string{"red", "blue"},
+			Created:  &client.GetItemsParameter03{Gte: &gte},
+		},
+		Labels: &client.GetItemsParameter1{"app": "web", "tier/zone": "eu"},
+		Page:   ptr(2),
+	})
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(echo.Query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"filter[status]":       {"open"},
+		"filter[name]":         {"a&b=c d"},
+		"filter[min_price]":    {"2.5"},
+		"filter[tags][0]":      {"red"},
+		"filter[tags][1]":      {"blue"},
+		"filter[created][gte]": {"2024-01-02"},
+		"labels[app]":          {"web"},
+		"labels[tier/zone]":    {"eu"},
+		"page":                 {"2"},
+	}, query)
+
+	assert.Equal(t, client.Echo{
+		Query:      echo.Query,
+		Status:     ptr("open"),
+		Name:       ptr("a&b=c d"),
+		MinPrice:   ptr[float32](2.5),
+		Tags:       []string{"red", "blue"},
+		CreatedGte: &gte,
+		Labels:     map[string]string{"app": "web", "tier/zone": "eu"},
+		Page:       ptr(2),
+	}, echo)
+}
+
+func TestDeepObjectOmitted(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.ListItems(context.Background(), &client.ListItemsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, client.Echo{}, echo)
+}
+
+func TestDeepObjectInvalid(t *testing.T) {
+	_, serverURL := newClient(t)
+
+	resp, err := http.Get(serverURL + "/items?filter[min_price]=cheap")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,459 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Echo
+type Echo struct {
+	Query      string                    `form:"query" json:"query"`
+	Status     *string                   `form:"status,omitempty" json:"status,omitempty"`
+	Name       *string                   `form:"name,omitempty" json:"name,omitempty"`
+	MinPrice   *float32                  `form:"min_price,omitempty" json:"min_price,omitempty"`
+	Tags       []string                  `form:"tags,omitempty" json:"tags,omitempty"`
+	CreatedGte *oapiCodegenTypesPkg.Date `form:"created_gte,omitempty" json:"created_gte,omitempty"`
+	Labels     map[string]string         `form:"labels,omitempty" json:"labels,omitempty"`
+	Page       *int                      `form:"page,omitempty" json:"page,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Echo) ApplyDefaults() {
+}
+
+// #/components/schemas/Echo/properties/labels
+type EchoLabels = map[string]string
+
+// #/paths//items/get/parameters/0/schema
+type GetItemsParameter01 struct {
+	Status   *GetItemsParameter02 `form:"status,omitempty" json:"status,omitempty"`
+	Name     *string              `form:"name,omitempty" json:"name,omitempty"`
+	MinPrice *float32             `form:"min_price,omitempty" json:"min_price,omitempty"`
+	Tags     []string             `form:"tags,omitempty" json:"tags,omitempty"`
+	Created  *GetItemsParameter03 `form:"created,omitempty" json:"created,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *GetItemsParameter01) ApplyDefaults() {
+	if s.Created != nil {
+		s.Created.ApplyDefaults()
+	}
+}
+
+// #/paths//items/get/parameters/0/schema/properties/status
+type GetItemsParameter02 string
+
+const (
+	Open   GetItemsParameter02 = "open"
+	Closed GetItemsParameter02 = "closed"
+)
+
+// Values returns the GetItemsParameter02 constants, in the order of the spec.
+func (GetItemsParameter02) Values() []GetItemsParameter02 {
+	return []GetItemsParameter02{Open, Closed}
+}
+
+// ParseGetItemsParameter02 returns the GetItemsParameter02 constant whose value is s. Other strings
+// return an error.
+func ParseGetItemsParameter02(s string) (GetItemsParameter02, error) {
+	switch v := GetItemsParameter02(s); v {
+	case Open, Closed:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid GetItemsParameter02 value %q", s)
+}
+
+// #/paths//items/get/parameters/0/schema/properties/created
+type GetItemsParameter03 struct {
+	Gte *oapiCodegenTypesPkg.Date `form:"gte,omitempty" json:"gte,omitempty"`
+	Lte *oapiCodegenTypesPkg.Date `form:"lte,omitempty" json:"lte,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *GetItemsParameter03) ApplyDefaults() {
+}
+
+// #/paths//items/get/parameters/1/schema
+type GetItemsParameter1 = map[string]string
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/8RUQW/bPAy9+1c8+PuOW5OtN9136Gk77FYUhWq/OCpsSaXoYPn3g52kcWJ52YABu0nk",
+	"I0VSfC9EehudQXl/t767LwvnN8EUgDptaVCT8evLKytFtGI7KiUVwI6SXPAG5ae7dVlEq9s0hK2cshtP",
+	"QEM9HIAQKVZd8A+1QeuSPgywo/Oc+AQHPsLbjgYb1yrl3Qw4b/DWU/YTW9L9Za0TH3/ENtQ0UOk5jam2",
+	"7KyZWADdRxqE6xRAlKEBdUyXAUBSq/3MekqVVJxvZk76vjN4HGb/AVUbEuunK9DY/Z9k7Zx/juKqxSjf",
+	"dy8XkwQAtc1i8VbE7me+yf/+dm2V0CrrpYcyA//10AGgUebMNyYPAJsgnVWD2iozgPbvJj4tcmtf2KZ/",
+	"vci2rt1AQ9t+W5xuts9TG9E2vNXEYkHOK5v3HRSmGHyaFlB+Xq/L8xWomSpxUUep+b7lRCugWyJRdhQI",
+	"K7od60lkFbzS62UZNsbWVaMQrV5T8DM2Z0oHgP+FG4Pyv1UVuhg8vabVAZtWX6ptKIuzY4g++oYjMABM",
+	"MZ3DxccI33onrA0ex0k+FcvrPwLO14W/mmtSFnYtMVlQVlaygnItJXkRychH9t2jZDxf0TyLXWDegXHz",
+	"6BktbpMi++xABVMs7HjxcwDDmTN/WQcAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /items)
+	ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams)
+}
+
+// ListItemsParams defines parameters for ListItems.
+type ListItemsParams struct {
+	// filter (optional)
+	Filter *GetItemsParameter01 `form:"filter" json:"filter"`
+	// labels (optional)
+	Labels *GetItemsParameter1 `form:"labels" json:"labels"`
+	// page (optional)
+	Page *int `form:"page" json:"page"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *ListItemsParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Filter != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleDeepObjectParam("filter", *p.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Labels != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleDeepObjectParam("labels", *p.Labels); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Page != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("page", *p.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *ListItemsParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindDeepObjectParam("filter", values, &p.Filter, false); err != nil {
+		return fmt.Errorf("invalid format for query parameter filter: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindDeepObjectParam("labels", values, &p.Labels, false); err != nil {
+		return fmt.Errorf("invalid format for query parameter labels: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("page", values, &p.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int]); err != nil {
+		return fmt.Errorf("invalid format for query parameter page: %w", err)
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Optional query parameter "filter" -------------
+	err = oapiCodegenParamsPkg.BindDeepObjectParam("filter", r.URL.Query(), &params.Filter, false)
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "filter", Location: "query", Value: r.URL.Query().Get("filter"), Err: &InvalidParamFormatError{ParamName: "filter", Err: err}})
+	}
+
+	// ------------- Optional query parameter "labels" -------------
+	err = oapiCodegenParamsPkg.BindDeepObjectParam("labels", r.URL.Query(), &params.Labels, false)
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "labels", Location: "query", Value: r.URL.Query().Get("labels"), Err: &InvalidParamFormatError{ParamName: "labels", Err: err}})
+	}
+
+	// ------------- Optional query parameter "page" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("page", r.URL.Query(), &params.Page, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "page", Location: "query", Value: r.URL.Query().Get("page"), Err: &InvalidParamFormatError{ParamName: "page", Err: err}})
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/items", wrapper.ListItems)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
@@ -0,0 +1,30 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams) {
+	echo := Echo{Query: r.URL.RawQuery, Page: params.Page}
+	if f := params.Filter; f != nil {
+		echo.Name, echo.MinPrice, echo.Tags = f.Name, f.MinPrice, f.Tags
+		if f.Status != nil {
+			status := string(*f.Status)
+			echo.Status = &status
+		}
+		if f.Created != nil {
+			echo.CreatedGte = f.Created.Gte
+		}
+	}
+	if params.Labels != nil {
+		echo.Labels = *params.Labels
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(echo)
+}
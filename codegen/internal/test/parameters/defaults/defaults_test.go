@@ -0,0 +1,77 @@
+package defaults_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/defaults/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/defaults/stdhttp"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// roundTrip sends params to the std-http server and decodes the parameters
+// it echoes back.
+func roundTrip(t *testing.T, params client.ListItemsParams) client.ListItemsParams {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+
+	req, err := client.NewListItemsRequest(server.URL, &params)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var got client.ListItemsParams
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	return got
+}
+
+func TestParameterDefaults_Absent(t *testing.T) {
+	got := roundTrip(t, client.ListItemsParams{})
+
+	assert.Equal(t, ptr[int32](20), got.Limit)
+	assert.Equal(t, ptr("asc"), got.Sort)
+	assert.Equal(t, ptr(client.Red), got.Color)
+	assert.Equal(t, ptr(true), got.XVerbose)
+	assert.Equal(t, ptr(1), got.Page)
+	ratio, err := got.Ratio.Get()
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.5), ratio)
+}
+
+func TestParameterDefaults_Sent(t *testing.T) {
+	params := client.ListItemsParams{
+		Limit:    ptr[int32](0),
+		Sort:     ptr("desc"),
+		Color:    ptr(client.Blue),
+		XVerbose: ptr(false),
+		Page:     ptr(3),
+	}
+	params.Ratio.Set(2)
+	assert.Equal(t, params, roundTrip(t, params))
+}
+
+func TestParameterDefaults_EmptyValue(t *testing.T) {
+	var params client.ListItemsParams
+	params.Ratio.SetNull()
+
+	got := roundTrip(t, params)
+	assert.True(t, got.Ratio.IsNull(), "an empty value is kept rather than defaulted")
+}
+
+func TestParameterDefaults_ApplyDefaults(t *testing.T) {
+	params := client.ListItemsParams{Sort: ptr("desc")}
+	params.ApplyDefaults()
+
+	assert.Equal(t, ptr[int32](20), params.Limit)
+	assert.Equal(t, ptr("desc"), params.Sort)
+}
@@ -0,0 +1,496 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Color
+type Color string
+
+const (
+	Red  Color = "red"
+	Blue Color = "blue"
+)
+
+// Values returns the Color constants, in the order of the spec.
+func (Color) Values() []Color {
+	return []Color{Red, Blue}
+}
+
+// ParseColor returns the Color constant whose value is s. Other strings
+// return an error.
+func ParseColor(s string) (Color, error) {
+	switch v := Color(s); v {
+	case Red, Blue:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Color value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5yTP28bMQzFd30K4tqxsS82umgtOmTrUAQFig7y3bMtVBJVkpfC377wxU7Pf4AY3gjy",
+	"Sb9HkOSKEmr01Cxn7WzZuFjW7B2RRUvw9C1IyDAI9ViHIZmSQc0RvUA0cvHUPM7axtVgW90/nEdDHiOi",
+	"Dew1IOIKCRa5PPWeUlR72ssOxXqk6FFO9EAlZOy1OdpbligWT38GyG6S026LHPwkQ2S7Ck+xGDaQk8qa",
+	"JQcba8vFSeXQo6dFe+FDWe63oSaxbK6zgnYXsI4Tyx20j4K1p+bDvONcuaCYzl91Ov+y/7O5QI1TeQcV",
+	"UuK/X3O13XNIAzyZDLip8TLkFeR64+3s84WbHw/PkBUrzhxtEXrITcgVc0Io15knxo/QGjbnvI75d8Td",
+	"K/bGezykBVq5KCYL3izatvFu+kg7idXGq/q+xeQsSNAhvqB3/wfr3dHVGBKNA/Zu6u1k71CG7OmnoP9E",
+	"qzTglzszK+jdvwEAE99P+REEAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /items)
+	ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams)
+}
+
+// ListItemsParams defines parameters for ListItems.
+type ListItemsParams struct {
+	// limit (optional)
+	Limit *int32 `form:"limit" json:"limit"`
+	// sort (optional)
+	Sort *string `form:"sort" json:"sort"`
+	// color (optional)
+	Color *Color `form:"color" json:"color"`
+	// ratio (optional)
+	Ratio oapiCodegenTypesPkg.Nullable[float32] `form:"ratio" json:"ratio"`
+	// X-Verbose (header)
+	XVerbose *bool
+	// page (cookie)
+	Page *int
+}
+
+// ApplyDefaults sets the parameters absent from p to the defaults declared by
+// their schemas. Servers call it once the parameters are bound.
+func (p *ListItemsParams) ApplyDefaults() {
+	if p.Limit == nil {
+		v := int32(20)
+		p.Limit = &v
+	}
+	if p.Sort == nil {
+		v := "asc"
+		p.Sort = &v
+	}
+	if p.Color == nil {
+		v := Color("red")
+		p.Color = &v
+	}
+	if !p.Ratio.IsSpecified() {
+		p.Ratio.Set(float32(0.5))
+	}
+	if p.XVerbose == nil {
+		v := true
+		p.XVerbose = &v
+	}
+	if p.Page == nil {
+		v := int(1)
+		p.Page = &v
+	}
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *ListItemsParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Limit != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("limit", *p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Sort != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("sort", *p.Sort, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Color != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("color", *p.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[Color]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if frag, err := oapiCodegenParamsPkg.StyleNullableQueryParam("ratio", p.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false}); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *ListItemsParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", values, &p.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("sort", values, &p.Sort, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter sort: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("color", values, &p.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[Color]); err != nil {
+		return fmt.Errorf("invalid format for query parameter color: %w", err)
+	}
+	if p.Color != nil {
+		if err := oapiCodegenParamsPkg.ValidateParameter("color", *p.Color, oapiCodegenParamsPkg.ParamEnum[Color]("red", "blue")); err != nil {
+			return err
+		}
+	}
+	if err := oapiCodegenParamsPkg.BindNullableQueryParameter("ratio", values, &p.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter ratio: %w", err)
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", r.URL.Query(), &params.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "limit", Location: "query", Value: r.URL.Query().Get("limit"), Err: &InvalidParamFormatError{ParamName: "limit", Err: err}})
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("sort", r.URL.Query(), &params.Sort, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "sort", Location: "query", Value: r.URL.Query().Get("sort"), Err: &InvalidParamFormatError{ParamName: "sort", Err: err}})
+	}
+
+	// ------------- Optional query parameter "color" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("color", r.URL.Query(), &params.Color, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[Color])
+	if err == nil && params.Color != nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("color", *params.Color, oapiCodegenParamsPkg.ParamEnum[Color]("red", "blue"))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "color", Location: "query", Value: r.URL.Query().Get("color"), Err: &InvalidParamFormatError{ParamName: "color", Err: err}})
+	}
+
+	// ------------- Optional query parameter "ratio" -------------
+	err = oapiCodegenParamsPkg.BindNullableQueryParameter("ratio", r.URL.Query(), &params.Ratio, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "number", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ratio", Location: "query", Value: r.URL.Query().Get("ratio"), Err: &InvalidParamFormatError{ParamName: "ratio", Err: err}})
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Verbose" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Verbose")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Verbose", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Verbose", Count: len(valueList)}})
+	} else if found {
+		var xVerbose bool
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Verbose", valueList[0], &xVerbose, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "boolean", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseBool[bool])
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Verbose", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Verbose", Err: err}})
+		}
+		params.XVerbose = &xVerbose
+	}
+
+	{
+		var cookie *http.Cookie
+		if cookie, err = r.Cookie("page"); err == nil {
+			var value int
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("page", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+			if err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "page", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "page", Err: err}})
+			}
+			params.Page = &value
+		}
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	params.ApplyDefaults()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/items", wrapper.ListItems)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
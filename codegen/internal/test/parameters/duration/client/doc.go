@@ -0,0 +1,5 @@
+// Package client contains the generated client for the duration parameters
+// test, with format: duration mapped to time.Duration.
+package client
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config client.cfg.yaml ../spec.yaml
@@ -0,0 +1,83 @@
+package duration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/duration/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/duration/stdhttp"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// echoed is the body the std-http server responds with.
+type echoed struct {
+	Timeout time.Duration
+	stdhttp.GetJobsParams
+}
+
+// get sends req to the std-http server and decodes the parameters it echoes
+// back.
+func get(t *testing.T, server *httptest.Server, req *http.Request) echoed {
+	t.Helper()
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var got echoed
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	return got
+}
+
+func TestDurationParameters_Roundtrip(t *testing.T) {
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+
+	req, err := client.NewGetJobsRequest(server.URL, 90*time.Second, &client.GetJobsParams{
+		Interval:  ptr(5 * time.Minute),
+		Delays:    &[]time.Duration{time.Second, 250 * time.Millisecond},
+		XDeadline: ptr(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "/jobs/1m30s", req.URL.Path)
+	assert.Equal(t, "PT5M", req.URL.Query().Get("interval"), "format: duration is sent as ISO 8601")
+
+	got := get(t, server, req)
+	assert.Equal(t, 90*time.Second, got.Timeout)
+	assert.Equal(t, ptr(5*time.Minute), got.Interval)
+	assert.Equal(t, &[]time.Duration{time.Second, 250 * time.Millisecond}, got.Delays)
+	assert.Equal(t, ptr(time.Hour), got.XDeadline)
+}
+
+func TestDurationParameters_ISO8601(t *testing.T) {
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/jobs/PT1M30S?interval=5m&delays=PT1S&delays=250ms", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Deadline", "PT1H")
+
+	got := get(t, server, req)
+	assert.Equal(t, 90*time.Second, got.Timeout)
+	assert.Equal(t, ptr(5*time.Minute), got.Interval)
+	assert.Equal(t, &[]time.Duration{time.Second, 250 * time.Millisecond}, got.Delays)
+	assert.Equal(t, ptr(time.Hour), got.XDeadline)
+}
+
+func TestDurationParameters_Invalid(t *testing.T) {
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+
+	resp, err := server.Client().Get(server.URL + "/jobs/soon")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,5 @@
+// Package stdhttp contains the std-http server for the duration parameters
+// test, with format: duration mapped to time.Duration.
+package stdhttp
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config server.cfg.yaml ../spec.yaml
@@ -0,0 +1,21 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Server implements ServerInterface by echoing the received parameters back
+// as JSON.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) GetJobs(w http.ResponseWriter, r *http.Request, timeout time.Duration, params GetJobsParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Timeout time.Duration
+		GetJobsParams
+	}{timeout, params})
+}
@@ -48,17 +48,17 @@ type ValidatePetsJSONResponse = []Pet
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/8SVTW/bPAzH7/oURPMAOdVOk5uOzzAMvQw5DLurMm2rtSVNpLsFw777IDmu7TpxsQ3D",
-	"bjEpkb8/X5QN3BN1CIe7vYSj4hqQtPLGVmIDNbMnmeeV4bp7yLRrc6e8udWuwArt/MPEOJQf7vZiA+9q",
-	"1E8EPjiP4SUkuBK84prgq+EayKM2qgFdq6A0YyBozBOCdo2zJJxHq7yRcHPIdtnuRhhbOikAnjGQcVbC",
-	"XbQLADbcoByVACOxACiQdDCe0+E1pKBajPlFoos5co9M8lk1plCM0QLgHXH/C4C6tlXhJOHz+QjEC2fn",
-	"LO+lAxFBRfd9IWFIchz9Ab90SPy/K05Dwt5oAhYSOHT4YtbOMloezwEo7xujU4L8kZyd+gBI19iquQ3g",
-	"v4ClhO0m1671zqJlyvuTlB+RP6oWafuCR95ZQhqDbPe73XYac1aDJHFagCvgb6Ffgwfgk0cJKgR1WvgM",
-	"Y0vLK29q3opRTKm6hq/q6yx+86gZC8AQXPhbKteA38fE22F08+8e+b740ceocDm4H5BjR6Ayz2jBFGjZ",
-	"lAZDdmlGK+Qj8tkz7stIeAtWtSghZZ1wGyvTyk9MV+b4suq+r8Qhvkh/On3/oi1pjkZ7vHx29XGOY2t6",
-	"re7hETWLV7V6VeihE+kxYzOtReqDWK3gokKfakz34mvIdXqqMjHgpdX/DUZ6A5KWlK/39yIkTSgpE6t7",
-	"PhOfPGlN5Ap6/D+bfLZIpKq1gscLSynGMlY4fQlKF1rFyXPYXxOZ8OYMZ4Jf7WkfacD/GQAA//+iio0s",
-	"6AcAAA==",
+	"H4sIAAAAAAAC/8SVy27bOhCG93yKH/EBvIrl2DsuT1EU2RReFN0z1FhiYpEsZ5zWKPruBSnbsuMb2qLo",
+	"TpwhOd8/F2qER+Y1Yf4w01gYaUFsTXS+USO0IpF1VTVO2vXTxIauCia6extqasgfL1y+h6v5w0yN8K4l",
+	"+8KIKURK+ysRlohGWsZXJy04knVmBduaZKxQYqzcC8GGVfCsQiRvotO4m0+mk+mdcn4ZtAJeKbELXuMh",
+	"2xUgTlakByUQYlFATWyTi1I2X0NKpqMcXxU6rYAqkrB+NStXG6FsAWJg6b8AXnedSRuNz9styAe2zqO4",
+	"5zZkBJPdj7XGLshi8Cf6siaW/0O92QXsjS5RrSFpTXuzDV7Iy7APMDGunC0BqmcO/tAHsG2pM8c24L9E",
+	"S43xqLKhi8GTF676nVwtSD6ajni8x+MYPBMPl4xn0+l4WL7JQZF4mIAL4LfQL8EDsomkYVIymxOfE+r4",
+	"9MhNzWM1iFma9Uou6lt7+hbJCtWglEL6WyqvAb/Pgce71q2+R5LH+kd/R0OnjfuBJFcEjXslD1eTF7d0",
+	"lCbnerQhWZBsPcO8DIT38KYjjRJ1bwWc12XkD0wX+vi86r6uLCm/SH/aff+iLKWPBns+vHXlT2AxlKbX",
+	"Gp6eyYp6k6s3id4u+8dM3GEuSh3U1QyeZOhTS+UcwhLSlqdqonZ4ZfR/g5FvQLJWt+b3LCQfUPJEXZ3z",
+	"I/EKAMqY6Cvo+X92sOyI2TTXEp4PnEpxXqihw5dgGVJnpHjms0siC94xw5bgV2va37TD/zkAooqNLOgH",
+	"AAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -108,6 +108,16 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // Exploded object binding
 // ---------------------------------------------------------------------------
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -115,3 +125,8 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // ---------------------------------------------------------------------------
 // Deep object marshaling
 // ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
@@ -0,0 +1,97 @@
+package exploded_object_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/exploded_object/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/exploded_object/stdhttp"
+)
+
+// newClient returns a SimpleClient talking to the std-http server.
+func newClient(t *testing.T) (*client.SimpleClient, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	c, err := client.NewSimpleClient(server.URL)
+	require.NoError(t, err)
+	return c, server.URL
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestExplodedObjectRoundtrip(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.Search(context.Background(), &client.SearchParams{
+		Filter: &client.GetSearchParameter{
+			Status: ptr(client.Open),
+			Limit:  ptr[int32](20),
+			Tags:   []string{"red", "blue"},
+		},
+		Order: client.Order{By: "name", Desc: ptr(true)},
+	})
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(echo.Query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"status": {"open"},
+		"limit":  {"20"},
+		"tags":   {"red", "blue"},
+		"by":     {"name"},
+		"desc":   {"true"},
+	}, query)
+
+	assert.Equal(t, client.Echo{
+		Query:  echo.Query,
+		Status: ptr(client.Open),
+		Limit:  ptr[int32](20),
+		Tags:   []string{"red", "blue"},
+		By:     ptr("name"),
+		Desc:   ptr(true),
+	}, echo)
+}
+
+func TestExplodedObjectOmitted(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.Search(context.Background(), &client.SearchParams{Order: client.Order{By: "name"}})
+	require.NoError(t, err)
+	assert.Equal(t, client.Echo{Query: "by=name", By: ptr("name")}, echo)
+}
+
+func TestExplodedObjectInvalid(t *testing.T) {
+	_, serverURL := newClient(t)
+
+	for name, query := range map[string]string{
+		"missing object":   "status=open",
+		"missing property": "desc=true",
+		"invalid property": "by=name&limit=many",
+		"repeated key":     "by=name&by=date",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(serverURL + "/search?" + query)
+			require.NoError(t, err)
+			defer func() { _ = resp.Body.Close() }()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestExplodedObjectFromURLValues(t *testing.T) {
+	var params stdhttp.SearchParams
+	require.NoError(t, params.FromURLValues(url.Values{"by": {"date"}, "limit": {"5"}}))
+	assert.Equal(t, stdhttp.SearchParams{
+		Filter: &stdhttp.GetSearchParameter{Limit: ptr[int32](5)},
+		Order:  stdhttp.Order{By: "date"},
+	}, params)
+}
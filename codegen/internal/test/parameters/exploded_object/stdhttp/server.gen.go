@@ -0,0 +1,508 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// #/components/schemas/Status
+type Status string
+
+const (
+	Open   Status = "open"
+	Closed Status = "closed"
+)
+
+// Values returns the Status constants, in the order of the spec.
+func (Status) Values() []Status {
+	return []Status{Open, Closed}
+}
+
+// ParseStatus returns the Status constant whose value is s. Other strings
+// return an error.
+func ParseStatus(s string) (Status, error) {
+	switch v := Status(s); v {
+	case Open, Closed:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Status value %q", s)
+}
+
+// #/components/schemas/Order
+type Order struct {
+	By   string `form:"by" json:"by"`
+	Desc *bool  `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Order) ApplyDefaults() {
+}
+
+// #/components/schemas/Echo
+type Echo struct {
+	Query  string   `form:"query" json:"query"`
+	Status *Status  `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int32   `form:"limit,omitempty" json:"limit,omitempty"`
+	Tags   []string `form:"tags,omitempty" json:"tags,omitempty"`
+	By     *string  `form:"by,omitempty" json:"by,omitempty"`
+	Desc   *bool    `form:"desc,omitempty" json:"desc,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Echo) ApplyDefaults() {
+}
+
+// #/paths//search/get/parameters/0/schema
+type GetSearchParameter struct {
+	Status *Status  `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int32   `form:"limit,omitempty" json:"limit,omitempty"`
+	Tags   []string `form:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *GetSearchParameter) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/6RTu3LbMBDs8RU7TMrEVKwOfYpUKZzO4wICTyI8JAAfjprw7zMkpZASKCsZd8d7gLu3",
+	"tyGSN9FpFNuHzcO2UM7vg1aAOGlIg37HJlRUIexeyQreOuIe0bBpSYiTAo7EyQWvUXx72BQqGqmTVkCZ",
+	"yLCthxA4kEwBECKxERf8j0pj6jlV5mfPvcBXeNOSxt41Qvw3DTivJzSLXJJ+AL0P3C6yJw4awh0tu21N",
+	"rdGLDCB9JH0ie1GIPOAWR+lyAEhipMuywGemvUbxqbShjcGTl1ROv0zl0zhTXM00rnWSPzRhcl7ocLEB",
+	"AMBI1shY3z5eVcUc0q33DLPps5oTaldGzkNJ2PlDJk7g6q42TG+dY6pyGT4s2nub/jlAOy+aKcXg01LD",
+	"4nGzKeZPoKJk2UUZT/pXTYurhNSERHwkBpMld6RqMWmDF/JXApoYG2fHey9fU/DZ9azwucfpu61DoebC",
+	"MH2qDSHwdHGSK9KR71qN58H9X2CbkKh6UQAwrkurm2aYVXze9S/qtjd2/RyvQpg2nTftQmjIeAUAA9F/",
+	"AzNe23t4xoa7kHIv/4+LM//ecu66Z6/duu7TFYeuUvmwAH8GAEY/Q9kbBgAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// filter (optional)
+	Filter *GetSearchParameter `form:"filter" json:"filter"`
+	// order (required)
+	Order Order `form:"order" json:"order"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *SearchParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Filter != nil {
+		if frag, err := p.styleFilter(); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if frag, err := p.styleOrder(); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *SearchParams) FromURLValues(values url.Values) error {
+	if err := p.bindFilter(values); err != nil {
+		return fmt.Errorf("invalid format for query parameter filter: %w", err)
+	}
+	if err := p.bindOrder(values); err != nil {
+		return fmt.Errorf("invalid format for query parameter order: %w", err)
+	}
+	return nil
+}
+
+// styleFilter serializes the exploded object query parameter filter of
+// p, each of its properties as the query key named after it.
+func (p *SearchParams) styleFilter() (string, error) {
+	value := *p.Filter
+	var frags []string
+	if value.Status != nil {
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("status", *value.Status, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.FormatString[Status])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	if value.Limit != nil {
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("limit", *value.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.FormatInt[int32])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	if len(value.Tags) > 0 {
+		frag, err := oapiCodegenParamsPkg.StyleParameter("tags", value.Tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false})
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	return strings.Join(frags, "&"), nil
+}
+
+// bindFilter binds the exploded object query parameter filter from
+// values into p, each of its properties from the query key named after it.
+func (p *SearchParams) bindFilter(values url.Values) error {
+	if !values.Has("status") && !values.Has("limit") && !values.Has("tags") {
+		return nil
+	}
+	var value GetSearchParameter
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("status", values, &value.Status, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.ParseString[Status]); err != nil {
+		return err
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", values, &value.Limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return err
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("tags", values, &value.Tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}); err != nil {
+		return err
+	}
+	p.Filter = &value
+	return nil
+}
+
+// styleOrder serializes the exploded object query parameter order of
+// p, each of its properties as the query key named after it.
+func (p *SearchParams) styleOrder() (string, error) {
+	value := p.Order
+	var frags []string
+	{
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("by", value.By, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.FormatString[string])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	if value.Desc != nil {
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("desc", *value.Desc, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.FormatBool[bool])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	return strings.Join(frags, "&"), nil
+}
+
+// bindOrder binds the exploded object query parameter order from
+// values into p, each of its properties from the query key named after it.
+func (p *SearchParams) bindOrder(values url.Values) error {
+	if !values.Has("by") && !values.Has("desc") {
+		return &oapiCodegenParamsPkg.MissingRequiredParameterError{ParamName: "order"}
+	}
+	var value Order
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("by", values, &value.By, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return err
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("desc", values, &value.Desc, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false}, oapiCodegenParamsPkg.ParseBool[bool]); err != nil {
+		return err
+	}
+	p.Order = value
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Optional query parameter "filter" -------------
+	err = params.bindFilter(r.URL.Query())
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "filter", Location: "query", Value: r.URL.Query().Get("filter"), Err: &InvalidParamFormatError{ParamName: "filter", Err: err}})
+	}
+
+	// ------------- Required query parameter "order" -------------
+	err = params.bindOrder(r.URL.Query())
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "order", Location: "query", Value: r.URL.Query().Get("order"), Err: &InvalidParamFormatError{ParamName: "order", Err: err}})
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/search", wrapper.Search)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
@@ -0,0 +1,20 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	echo := Echo{Query: r.URL.RawQuery, By: &params.Order.By, Desc: params.Order.Desc}
+	if f := params.Filter; f != nil {
+		echo.Status, echo.Limit, echo.Tags = f.Status, f.Limit, f.Tags
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(echo)
+}
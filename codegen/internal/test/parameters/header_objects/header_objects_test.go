@@ -0,0 +1,78 @@
+package header_objects_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/header_objects/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/header_objects/stdhttp"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// roundTrip sends req to the std-http server and decodes the parameters it
+// echoes back.
+func roundTrip(t *testing.T, req *http.Request) client.GetTraceParams {
+	t.Helper()
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var got client.GetTraceParams
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	return got
+}
+
+func newServer(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestHeaderObjectsRoundtrip(t *testing.T) {
+	server := newServer(t)
+	params := client.GetTraceParams{
+		Baggage: client.GetTraceParameter0{Tenant: ptr("acme"), Sampled: ptr(true), Depth: ptr(3)},
+		XSpans:  &[]client.Span{{ID: "a1", Duration: ptr[float32](1.5)}, {ID: "b2"}},
+		XTags:   &[]client.GetTraceParameter22{{Key: ptr("env"), Value: ptr("prod")}},
+	}
+	req, err := client.NewGetTraceRequest(server, &params)
+	require.NoError(t, err)
+
+	assert.Equal(t, "depth=3,sampled=true,tenant=acme", req.Header.Get("baggage"))
+	assert.Equal(t, "duration,1.5,id,a1;id,b2", req.Header.Get("X-Spans"))
+	assert.Equal(t, "key=env,value=prod", req.Header.Get("X-Tags"))
+
+	assert.Equal(t, params, roundTrip(t, req))
+}
+
+func TestHeaderObjects_Whitespace(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, newServer(t)+"/trace", nil)
+	require.NoError(t, err)
+	req.Header.Set("baggage", "tenant=acme, sampled=false")
+	req.Header.Set("X-Spans", "id, a1, duration, 2")
+
+	got := roundTrip(t, req)
+	assert.Equal(t, client.GetTraceParameter0{Tenant: ptr("acme"), Sampled: ptr(false)}, got.Baggage)
+	require.NotNil(t, got.XSpans)
+	assert.Equal(t, []client.Span{{ID: "a1", Duration: ptr[float32](2)}}, *got.XSpans)
+}
+
+func TestHeaderObjects_InvalidField(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, newServer(t)+"/trace", nil)
+	require.NoError(t, err)
+	req.Header.Set("baggage", "sampled=maybe")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
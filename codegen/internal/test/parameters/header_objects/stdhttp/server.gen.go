@@ -0,0 +1,388 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// #/components/schemas/Span
+type Span struct {
+	ID       string   `form:"id" json:"id"`
+	Duration *float32 `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Span) ApplyDefaults() {
+}
+
+// #/paths//trace/get/parameters/0/schema
+type GetTraceParameter0 struct {
+	Tenant  *string `form:"tenant,omitempty" json:"tenant,omitempty"`
+	Sampled *bool   `form:"sampled,omitempty" json:"sampled,omitempty"`
+	Depth   *int    `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *GetTraceParameter0) ApplyDefaults() {
+}
+
+// #/paths//trace/get/parameters/1/schema
+type GetTraceParameter1 = []Span
+
+// #/paths//trace/get/parameters/2/schema
+type GetTraceParameter21 = []GetTraceParameter22
+
+// #/paths//trace/get/parameters/2/schema/items
+type GetTraceParameter22 struct {
+	Key   *string `form:"key,omitempty" json:"key,omitempty"`
+	Value *string `form:"value,omitempty" json:"value,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *GetTraceParameter22) ApplyDefaults() {
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5RUy3LUMBC86yumDNfEC7npC+DMHqiiOMzaHVvBHonReIstin+nvJslfsRJuKl6puVW",
+	"94xjgnAKnoq7293tXeGC3EfviCxYB0+fwDWU4uEBlWUyZHNER2gOUTwVH253hUtsbR5JpSlXGE9EDexy",
+	"IIoJyhaifK79iO/HrsdaYuUeBs3XbqIbEu7h6cBNww3+4URBPLVnSRNQ8XMIitqT6TDtxq/UxRorPFct",
+	"evYThMhOCf7xnbNC0lG+BeQ5gcggLLZEr1dl0yDNopi5Tx3qLc4hxg4si2qNZO0WJYihga68+3rzJbHk",
+	"17zbtoJV+TTDg6FfmfBece+peFdWsU9RIJbLy6W5HBUUzyjbc/OqsP/P7o2CN4N+KWqiHzitwRezJjpy",
+	"N+CNLEVOUfL008XH3a7wbjoIudKQ7Lx6+xaT5SFrQRl6hJKiQjiinjCrKIblrHJKXajOi1k+5Ch+OawX",
+	"q+n3H/cUrnfXwvlINIbs3fRdM2+flvNbqL+7baPDbCue9bUeLr+RdaMM/QHq/g4AleIeO84EAAA=",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /trace)
+	GetTrace(w http.ResponseWriter, r *http.Request, params GetTraceParams)
+}
+
+// GetTraceParams defines parameters for GetTrace.
+type GetTraceParams struct {
+	// baggage (header, required)
+	Baggage GetTraceParameter0
+	// X-Spans (header)
+	XSpans *[]Span
+	// X-Tags (header)
+	XTags *[]GetTraceParameter22
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetTrace(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTraceParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "baggage" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("baggage")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "baggage", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "baggage", Count: len(valueList)}})
+	} else if found {
+		var baggage GetTraceParameter0
+		err = oapiCodegenParamsPkg.BindParameter("baggage", valueList[0], &baggage, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: true, Type: "object", Format: "", AllowReserved: false})
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "baggage", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "baggage", Err: err}})
+		}
+		params.Baggage = baggage
+	} else {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "baggage", Location: "header", Err: &RequiredHeaderError{ParamName: "baggage"}})
+	}
+
+	// ------------- Optional header parameter "X-Spans" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Spans")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Spans", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Spans", Count: len(valueList)}})
+	} else if found {
+		var xSpans []Span
+		err = oapiCodegenParamsPkg.BindParameter("X-Spans", valueList[0], &xSpans, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Spans", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Spans", Err: err}})
+		}
+		params.XSpans = &xSpans
+	}
+
+	// ------------- Optional header parameter "X-Tags" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tags")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Tags", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Tags", Count: len(valueList)}})
+	} else if found {
+		var xTags []GetTraceParameter22
+		err = oapiCodegenParamsPkg.BindParameter("X-Tags", valueList[0], &xTags, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Tags", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Tags", Err: err}})
+		}
+		params.XTags = &xTags
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrace(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/trace", wrapper.GetTrace)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
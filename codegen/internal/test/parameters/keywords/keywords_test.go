@@ -0,0 +1,72 @@
+package keywords_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/keywords/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/keywords/stdhttp"
+)
+
+// newServer starts the std-http server and returns its URL.
+func newServer(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// newClient returns a SimpleClient talking to the std-http server.
+func newClient(t *testing.T) *client.SimpleClient {
+	t.Helper()
+	c, err := client.NewSimpleClient(newServer(t))
+	require.NoError(t, err)
+	return c
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestKeywordParameters(t *testing.T) {
+	c := newClient(t)
+
+	item, err := c.GetItem(context.Background(), "widget", "call", &client.GetItemParams{
+		Range:   ptr("10"),
+		Package: ptr("main"),
+		Map:     ptr("keys"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, client.Item{
+		Type:    ptr("widget"),
+		Func:    ptr("call"),
+		Package: ptr("main"),
+		String:  ptr("10"),
+		Error:   ptr("keys"),
+	}, item)
+}
+
+func TestGeneratedScopeNameParameters(t *testing.T) {
+	c := newClient(t)
+
+	names, err := c.EchoNames(context.Background(), "a", "b", "c", "d", 5, &client.EchoNamesParams{
+		ValueList: ptr("e"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d", "5", "e"}, names)
+}
+
+func TestKeywordOperation(t *testing.T) {
+	c, err := client.NewClient(newServer(t))
+	require.NoError(t, err)
+
+	resp, err := c.Type_(context.Background(), 7, client.Item{Chan: ptr("c")})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -0,0 +1,551 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// #/components/schemas/Item
+type Item struct {
+	Type      *string `form:"type,omitempty" json:"type,omitempty"`
+	Func      *string `form:"func,omitempty" json:"func,omitempty"`
+	Range     *int    `form:"range,omitempty" json:"range,omitempty"`
+	Package   *string `form:"package,omitempty" json:"package,omitempty"`
+	Interface *string `form:"interface,omitempty" json:"interface,omitempty"`
+	Chan      *string `form:"chan,omitempty" json:"chan,omitempty"`
+	String    *string `form:"string,omitempty" json:"string,omitempty"`
+	Error     *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Item) ApplyDefaults() {
+}
+
+// #/components/schemas/type
+type Type string
+
+const (
+	Func    Type = "func"
+	Range   Type = "range"
+	Default Type = "default"
+)
+
+// Values returns the Type constants, in the order of the spec.
+func (Type) Values() []Type {
+	return []Type{Func, Range, Default}
+}
+
+// ParseType returns the Type constant whose value is s. Other strings
+// return an error.
+func ParseType(s string) (Type, error) {
+	switch v := Type(s); v {
+	case Func, Range, Default:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid Type value %q", s)
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/7xVsW7bMBDd9RUHt0CWVFTaThyLLkGLokO3ogNDnS0mFskcT2kMwf9enOTUiuVYQRJ7",
+	"MaTj03vk4ztfiOhNdBpmn/KLvJhlzs+DzgDukJILXsNFXuRFBsCOl6jhG67+BipTFg1XSZDKMdZJtbyK",
+	"uFbtvPF2LXWABXL/ABAikmEX/GWppX7JWG+WoiFTIyOlBzDAB/CmRg3C+b8I4LwG0R2UCG8bR1hqYGqG",
+	"2GQrrI0eVKCj05CYnF+MtGTjp9Ii4xe7B7ttkFavIY3G3pgRbYWmRHoNb23iDqcN4ca9wADCFINPOLjp",
+	"2ceimG1fAUpMllzkLny/KgSJVz4A2OAZPT8WMzEune0Spq5T8I9X928QAOA94VzD2TtlQx2DR89J9dik",
+	"JKNnGYBCWwXVWr5fq5bWqkWS3/5ka9VKSn5KiIuJ3AvPD1NjekbyLd+fLIynEkI6mdRO+chq2wS8uaLz",
+	"jAukkeSdWTb43SV+o3Z/UWvKVlIXayzhytibo/Vpv2NDZFajtW7+jD8ZHVMtgmoTLtHyplFjSPs7dTB3",
+	"DjVpT3bkOxcCTPwllCudHTD3sLX71J739/d0Oj4/nY6vwWOebVkFuCGWRwBh19nw1OHqemtmJLkPdkPF",
+	"DpZNtKRM8UlQN351dth3eBiok3TyHc2NnUbayvhJUP86CUOiQAdRQ8NGiwDom1rDb3HsvLfkHEqcm2bJ",
+	"f7J/AwCp8NHGGQoAAA==",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /echo/{ctx}/{r}/{err}/{string}/{pathParam0})
+	EchoNames(w http.ResponseWriter, r *http.Request, pCtx string, pR string, pErr string, pString string, pPathParam0 int, params EchoNamesParams)
+
+	// (POST /go/{select})
+	Type_(w http.ResponseWriter, r *http.Request, pSelect int)
+
+	// (GET /items/{type}/{func})
+	GetItem(w http.ResponseWriter, r *http.Request, pType string, pFunc string, params GetItemParams)
+}
+
+// EchoNamesParams defines parameters for EchoNames.
+type EchoNamesParams struct {
+	// valueList (header)
+	ValueList *string
+}
+
+// GetItemParams defines parameters for GetItem.
+type GetItemParams struct {
+	// range (optional)
+	Range *string `form:"range" json:"range"`
+	// package (header)
+	Package *string
+	// map (cookie)
+	Map *string
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetItemParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Range != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("range", *p.Range, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetItemParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("range", values, &p.Range, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter range: %w", err)
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// EchoNames operation middleware
+func (siw *ServerInterfaceWrapper) EchoNames(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// ------------- Path parameter "ctx" -------------
+	var pCtx string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("ctx", r.PathValue("ctx"), &pCtx, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ctx", Location: "path", Value: r.PathValue("ctx"), Err: &InvalidParamFormatError{ParamName: "ctx", Err: err}})
+	}
+
+	// ------------- Path parameter "r" -------------
+	var pR string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("r", r.PathValue("r"), &pR, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "r", Location: "path", Value: r.PathValue("r"), Err: &InvalidParamFormatError{ParamName: "r", Err: err}})
+	}
+
+	// ------------- Path parameter "err" -------------
+	var pErr string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("err", r.PathValue("err"), &pErr, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "err", Location: "path", Value: r.PathValue("err"), Err: &InvalidParamFormatError{ParamName: "err", Err: err}})
+	}
+
+	// ------------- Path parameter "string" -------------
+	var pString string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("string", r.PathValue("string"), &pString, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "string", Location: "path", Value: r.PathValue("string"), Err: &InvalidParamFormatError{ParamName: "string", Err: err}})
+	}
+
+	// ------------- Path parameter "pathParam0" -------------
+	var pPathParam0 int
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("pathParam0", r.PathValue("pathParam0"), &pPathParam0, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "pathParam0", Location: "path", Value: r.PathValue("pathParam0"), Err: &InvalidParamFormatError{ParamName: "pathParam0", Err: err}})
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EchoNamesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "valueList" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("valueList")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "valueList", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "valueList", Count: len(valueList)}})
+	} else if found {
+		var pValueList string
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("valueList", valueList[0], &pValueList, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "valueList", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "valueList", Err: err}})
+		}
+		params.ValueList = &pValueList
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EchoNames(w, r, pCtx, pR, pErr, pString, pPathParam0, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Type_ operation middleware
+func (siw *ServerInterfaceWrapper) Type_(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// ------------- Path parameter "select" -------------
+	var pSelect int
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("select", r.PathValue("select"), &pSelect, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "select", Location: "path", Value: r.PathValue("select"), Err: &InvalidParamFormatError{ParamName: "select", Err: err}})
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Type_(w, r, pSelect)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetItem operation middleware
+func (siw *ServerInterfaceWrapper) GetItem(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// ------------- Path parameter "type" -------------
+	var pType string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("type", r.PathValue("type"), &pType, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "type", Location: "path", Value: r.PathValue("type"), Err: &InvalidParamFormatError{ParamName: "type", Err: err}})
+	}
+
+	// ------------- Path parameter "func" -------------
+	var pFunc string
+
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("func", r.PathValue("func"), &pFunc, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "func", Location: "path", Value: r.PathValue("func"), Err: &InvalidParamFormatError{ParamName: "func", Err: err}})
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemParams
+
+	// ------------- Optional query parameter "range" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("range", r.URL.Query(), &params.Range, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "range", Location: "query", Value: r.URL.Query().Get("range"), Err: &InvalidParamFormatError{ParamName: "range", Err: err}})
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "package" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("package")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "package", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "package", Count: len(valueList)}})
+	} else if found {
+		var pPackage string
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("package", valueList[0], &pPackage, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "package", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "package", Err: err}})
+		}
+		params.Package = &pPackage
+	}
+
+	{
+		var cookie *http.Cookie
+		if cookie, err = r.Cookie("map"); err == nil {
+			var value string
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("map", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+			if err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "map", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "map", Err: err}})
+			}
+			params.Map = &value
+		}
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetItem(w, r, pType, pFunc, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/echo/{ctx}/{r}/{err}/{string}/{pathParam0}", wrapper.EchoNames)
+	m.HandleFunc("POST "+options.BaseURL+"/go/{select}", wrapper.Type_)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{type}/{func}", wrapper.GetItem)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
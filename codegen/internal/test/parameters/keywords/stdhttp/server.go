@@ -0,0 +1,39 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) EchoNames(w http.ResponseWriter, r *http.Request, pCtx string, pR string, pErr string, pString string, pPathParam0 int, params EchoNamesParams) {
+	names := []string{pCtx, pR, pErr, pString, strconv.Itoa(pPathParam0)}
+	if params.ValueList != nil {
+		names = append(names, *params.ValueList)
+	}
+	writeJSON(w, names)
+}
+
+func (s *Server) Type_(w http.ResponseWriter, r *http.Request, pSelect int) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) GetItem(w http.ResponseWriter, r *http.Request, pType string, pFunc string, params GetItemParams) {
+	writeJSON(w, Item{
+		Type:    &pType,
+		Func:    &pFunc,
+		Package: params.Package,
+		String:  params.Range,
+		Error:   params.Map,
+	})
+}
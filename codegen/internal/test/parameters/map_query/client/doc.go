@@ -0,0 +1,4 @@
+// Package client contains the generated client for the map query test.
+package client
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config client.cfg.yaml ../spec.yaml
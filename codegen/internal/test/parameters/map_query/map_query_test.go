@@ -0,0 +1,108 @@
+package map_query_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/map_query/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/map_query/stdhttp"
+)
+
+// newClient returns a SimpleClient talking to the std-http server.
+func newClient(t *testing.T) (*client.SimpleClient, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	c, err := client.NewSimpleClient(server.URL)
+	require.NoError(t, err)
+	return c, server.URL
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMapQueryRoundtrip(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.ListPods(context.Background(), &client.ListPodsParams{
+		Labels:   client.GetPodsParameter{"app": "web", "tier": "front end"},
+		Replicas: &client.Replicas{"eu": 3, "us": 2},
+		Limit:    ptr[int32](10),
+	})
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(echo.Query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"labels": {"app,web,tier,front end"},
+		"eu":     {"3"},
+		"us":     {"2"},
+		"limit":  {"10"},
+	}, query)
+
+	assert.Equal(t, client.Echo{
+		Query:    echo.Query,
+		Labels:   map[string]string{"app": "web", "tier": "front end"},
+		Replicas: &client.Replicas{"eu": 3, "us": 2},
+		Limit:    ptr[int32](10),
+	}, echo)
+}
+
+func TestMapQueryOmitted(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.ListPods(context.Background(), &client.ListPodsParams{
+		Labels: client.GetPodsParameter{"app": "web"},
+		Limit:  ptr[int32](5),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, client.Echo{
+		Query:  echo.Query,
+		Labels: map[string]string{"app": "web"},
+		Limit:  ptr[int32](5),
+	}, echo)
+}
+
+func TestMapQueryInvalid(t *testing.T) {
+	_, serverURL := newClient(t)
+
+	for name, query := range map[string]string{
+		"missing map":     "eu=3",
+		"key only":        "labels=app",
+		"invalid value":   "labels=app,web&eu=many",
+		"repeated entry":  "labels=app,web&eu=1&eu=2",
+		"repeated labels": "labels=app,web&labels=tier,eu",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(serverURL + "/pods?" + query)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestMapQueryFromURLValues(t *testing.T) {
+	var params client.ListPodsParams
+	require.NoError(t, params.FromURLValues(url.Values{
+		"labels": {"app,web"},
+		"limit":  {"1"},
+		"us":     {"4"},
+	}))
+	assert.Equal(t, client.ListPodsParams{
+		Labels:   client.GetPodsParameter{"app": "web"},
+		Replicas: &client.Replicas{"us": 4},
+		Limit:    ptr[int32](1),
+	}, params)
+
+	values, err := params.ToURLValues()
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"labels": {"app,web"}, "limit": {"1"}, "us": {"4"}}, values)
+}
@@ -0,0 +1,4 @@
+// Package stdhttp contains the std-http server for the map query test.
+package stdhttp
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config server.cfg.yaml ../spec.yaml
@@ -0,0 +1,17 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) ListPods(w http.ResponseWriter, r *http.Request, params ListPodsParams) {
+	echo := Echo{Query: r.URL.RawQuery, Labels: params.Labels, Replicas: params.Replicas, Limit: params.Limit}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(echo)
+}
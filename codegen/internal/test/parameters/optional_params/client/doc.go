@@ -0,0 +1,5 @@
+// Package client contains the generated client for the optional parameters
+// test, holding them as values with a Has flag.
+package client
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config client.cfg.yaml ../spec.yaml
@@ -0,0 +1,119 @@
+package optional_params_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/optional_params/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/optional_params/stdhttp"
+)
+
+// newClient returns a SimpleClient, holding optional parameters as values
+// with a Has flag, talking to the std-http server, holding them as Nullables.
+func newClient(t *testing.T) (*client.SimpleClient, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	c, err := client.NewSimpleClient(server.URL)
+	require.NoError(t, err)
+	return c, server.URL
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestOptionalParamsRoundtrip(t *testing.T) {
+	c, _ := newClient(t)
+
+	echo, err := c.ListItems(context.Background(), &client.ListItemsParams{
+		Q:          "shoes",
+		Limit:      30,
+		HasLimit:   true,
+		Tags:       []string{"red", "sale"},
+		HasTags:    true,
+		Filter:     client.Filter{Owner: ptr("ann")},
+		HasFilter:  true,
+		XTrace:     "abc",
+		HasXTrace:  true,
+		Session:    "s1",
+		HasSession: true,
+	})
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(echo.Query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"q":             {"shoes"},
+		"limit":         {"30"},
+		"tags":          {"red", "sale"},
+		"filter[owner]": {"ann"},
+	}, query)
+
+	assert.Equal(t, client.Echo{
+		Query:   echo.Query,
+		Q:       "shoes",
+		Limit:   ptr[int32](30),
+		Tags:    []string{"red", "sale"},
+		Filter:  &client.Filter{Owner: ptr("ann")},
+		Trace:   ptr("abc"),
+		Session: ptr("s1"),
+	}, echo)
+}
+
+func TestOptionalParamsUnset(t *testing.T) {
+	c, _ := newClient(t)
+
+	// Values without their Has flag aren't sent, and the server applies the
+	// default of limit.
+	echo, err := c.ListItems(context.Background(), &client.ListItemsParams{
+		Q:      "shoes",
+		Limit:  50,
+		Tags:   []string{"red"},
+		XTrace: "abc",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, client.Echo{
+		Query: "q=shoes",
+		Q:     "shoes",
+		Limit: ptr[int32](20),
+	}, echo)
+}
+
+func TestOptionalParamsInvalid(t *testing.T) {
+	_, serverURL := newClient(t)
+
+	for name, query := range map[string]string{
+		"above maximum": "q=shoes&limit=500",
+		"not a number":  "q=shoes&limit=many",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(serverURL + "/items?" + query)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func TestOptionalParamsURLValues(t *testing.T) {
+	var params stdhttp.ListItemsParams
+	require.NoError(t, params.FromURLValues(url.Values{"q": {"shoes"}, "limit": {"30"}}))
+	assert.Equal(t, int32(30), params.Limit.MustGet())
+	assert.False(t, params.Tags.IsSpecified())
+	assert.False(t, params.Filter.IsSpecified())
+
+	values, err := params.ToURLValues()
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"q": {"shoes"}, "limit": {"30"}}, values)
+
+	params = stdhttp.ListItemsParams{Q: "shoes"}
+	params.ApplyDefaults()
+	assert.Equal(t, int32(20), params.Limit.MustGet())
+}
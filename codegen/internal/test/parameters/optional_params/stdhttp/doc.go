@@ -0,0 +1,5 @@
+// Package stdhttp contains the std-http server for the optional parameters
+// test, holding them as Nullables.
+package stdhttp
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config server.cfg.yaml ../spec.yaml
@@ -0,0 +1,506 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+package stdhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// #/components/schemas/Filter
+type Filter struct {
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Filter) ApplyDefaults() {
+}
+
+// #/components/schemas/Echo
+type Echo struct {
+	Query   string   `form:"query" json:"query"`
+	Q       string   `form:"q" json:"q"`
+	Limit   *int32   `form:"limit,omitempty" json:"limit,omitempty"`
+	Tags    []string `form:"tags,omitempty" json:"tags,omitempty"`
+	Filter  *Filter  `form:"filter,omitempty" json:"filter,omitempty"`
+	Trace   *string  `form:"trace,omitempty" json:"trace,omitempty"`
+	Session *string  `form:"session,omitempty" json:"session,omitempty"`
+}
+
+// ApplyDefaults sets default values for fields that are nil.
+func (s *Echo) ApplyDefaults() {
+	if s.Filter != nil {
+		s.Filter.ApplyDefaults()
+	}
+}
+
+// Base64-encoded, gzip-compressed OpenAPI spec.
+var openAPISpecJSON = []string{
+	"H4sIAAAAAAAC/5xUPXPbMAzd9StwasfGUuyNe3uXKUuG3vU6sBRkIRU/BEJp/e97lN2aihWr8abDe8R7",
+	"BPHkAzodSEG529SbXVmQa70qAISkRwWPQcg73UPQrC0KciwAXpAjeaegvN/UZRG0dDEdqkjQTl8Ae5Tj",
+	"B4APyDr1eWgU9BTlIdFO4LnxXzrAHThtUcHwrwJATsEwIh+yGuMwEmOjQHjEDIimQ6tVVgGQQ0AFUZjc",
+	"/kKpJ0uyovZ2U3KCe+QZ0nq2WiZst50hDbZ67EXBtp7Vrf5NdrQK7uv6wqDofbzZn2bWh1k9e6j/nFBL",
+	"vSCvWZBD2poGMTz+eEYjK/Y+MrYKyg+V8TZ4h05ideTF6sskWF4Y+Xr3xNrgKycd6mZm790rEDGmpX7V",
+	"13j/k25YLcYYvIuYDbnc1nWpinwRomGaEqbgqcMsC8BokF6wyejGO0Enc2kdQk9mSlf1HL2bo8t21yb/",
+	"2XS+LM5AOn3C0ifA8WlUkd/e5+8dOGVeKL++/+XOZxanlnSvND2n/du0d59g+H5Fb+Jc1QMAGFYZ069B",
+	"FeuZX058Cq4q1hK5kMdFM+1s8u+NkKTgrF74FIQ3eX8GAMtaziw0BgAA",
+}
+
+// decodeOpenAPISpec decodes and decompresses the embedded spec.
+func decodeOpenAPISpec() ([]byte, error) {
+	joined := strings.Join(openAPISpecJSON, "")
+	raw, err := base64.StdEncoding.DecodeString(joined)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("creating gzip reader: %w", err)
+	}
+	defer r.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("decompressing: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// decodeOpenAPISpecCached returns a closure that caches the decoded spec.
+func decodeOpenAPISpecCached() func() ([]byte, error) {
+	var cached []byte
+	var cachedErr error
+	var once sync.Once
+	return func() ([]byte, error) {
+		once.Do(func() {
+			cached, cachedErr = decodeOpenAPISpec()
+		})
+		return cached, cachedErr
+	}
+}
+
+var openAPISpec = decodeOpenAPISpecCached()
+
+// GetOpenAPISpecJSON returns the raw OpenAPI spec as JSON bytes.
+func GetOpenAPISpecJSON() ([]byte, error) {
+	return openAPISpec()
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /items)
+	ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams)
+}
+
+// ListItemsParams defines parameters for ListItems.
+type ListItemsParams struct {
+	// q (required)
+	Q string `form:"q" json:"q"`
+	// limit (optional)
+	Limit oapiCodegenTypesPkg.Nullable[int32] `form:"limit" json:"limit"`
+	// tags (optional)
+	Tags oapiCodegenTypesPkg.Nullable[[]string] `form:"tags" json:"tags"`
+	// filter (optional)
+	Filter oapiCodegenTypesPkg.Nullable[Filter] `form:"filter" json:"filter"`
+	// X-Trace (header)
+	XTrace oapiCodegenTypesPkg.Nullable[string]
+	// session (cookie)
+	Session oapiCodegenTypesPkg.Nullable[string]
+}
+
+// ApplyDefaults sets the parameters absent from p to the defaults declared by
+// their schemas. Servers call it once the parameters are bound.
+func (p *ListItemsParams) ApplyDefaults() {
+	if !p.Limit.IsSpecified() || p.Limit.IsNull() {
+		v := int32(20)
+		p.Limit.Set(v)
+	}
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *ListItemsParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("q", p.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	if p.Limit.IsSpecified() && !p.Limit.IsNull() {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("limit", p.Limit.MustGet(), oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Tags.IsSpecified() && !p.Tags.IsNull() {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("tags", p.Tags.MustGet(), oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Filter.IsSpecified() && !p.Filter.IsNull() {
+		if frag, err := oapiCodegenParamsPkg.StyleDeepObjectParam("filter", p.Filter.MustGet()); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *ListItemsParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("q", values, &p.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter q: %w", err)
+	}
+	var limit *int32
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", values, &limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter limit: %w", err)
+	}
+	if limit != nil {
+		p.Limit.Set(*limit)
+	}
+	if p.Limit.IsSpecified() && !p.Limit.IsNull() {
+		if err := oapiCodegenParamsPkg.ValidateParameter("limit", p.Limit.MustGet(), oapiCodegenParamsPkg.ParamMaximum[int32](100, false)); err != nil {
+			return err
+		}
+	}
+	var tags *[]string
+	if err := oapiCodegenParamsPkg.BindQueryParameter("tags", values, &tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter tags: %w", err)
+	}
+	if tags != nil {
+		p.Tags.Set(*tags)
+	}
+	var filter *Filter
+	if err := oapiCodegenParamsPkg.BindDeepObjectParam("filter", values, &filter, false); err != nil {
+		return fmt.Errorf("invalid format for query parameter filter: %w", err)
+	}
+	if filter != nil {
+		p.Filter.Set(*filter)
+	}
+	return nil
+}
+
+// ServerInterfaceWrapper converts HTTP requests to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// MiddlewareFunc is a middleware function type.
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Required query parameter "q" -------------
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("q", r.URL.Query(), &params.Q, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "q", Location: "query", Value: r.URL.Query().Get("q"), Err: &InvalidParamFormatError{ParamName: "q", Err: err}})
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	var limit *int32
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("limit", r.URL.Query(), &limit, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
+	if err == nil && limit != nil {
+		params.Limit.Set(*limit)
+	}
+	if err == nil && params.Limit.IsSpecified() && !params.Limit.IsNull() {
+		err = oapiCodegenParamsPkg.ValidateParameter("limit", params.Limit.MustGet(), oapiCodegenParamsPkg.ParamMaximum[int32](100, false))
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "limit", Location: "query", Value: r.URL.Query().Get("limit"), Err: &InvalidParamFormatError{ParamName: "limit", Err: err}})
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	var tags *[]string
+	err = oapiCodegenParamsPkg.BindQueryParameter("tags", r.URL.Query(), &tags, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
+	if err == nil && tags != nil {
+		params.Tags.Set(*tags)
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "tags", Location: "query", Value: r.URL.Query().Get("tags"), Err: &InvalidParamFormatError{ParamName: "tags", Err: err}})
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+	var filter *Filter
+	err = oapiCodegenParamsPkg.BindDeepObjectParam("filter", r.URL.Query(), &filter, false)
+	if err == nil && filter != nil {
+		params.Filter.Set(*filter)
+	}
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "filter", Location: "query", Value: r.URL.Query().Get("filter"), Err: &InvalidParamFormatError{ParamName: "filter", Err: err}})
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Trace" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Trace")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Trace", Count: len(valueList)}})
+	} else if found {
+		var xTrace string
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Trace", valueList[0], &xTrace, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+		if err != nil {
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Trace", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Trace", Err: err}})
+		}
+		params.XTrace.Set(xTrace)
+	}
+
+	{
+		var cookie *http.Cookie
+		if cookie, err = r.Cookie("session"); err == nil {
+			var value string
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("session", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+			if err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "session", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "session", Err: err}})
+			}
+			params.Session.Set(value)
+		}
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	params.ApplyDefaults()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+// StdHTTPServerOptions configures the StdHTTP server.
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+// HandlerFromMuxWithBaseURL creates http.Handler with routing and a base URL.
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options.
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/items", wrapper.ListItems)
+	return m
+}
+
+// UnescapedCookieParamError is returned when a cookie parameter cannot be unescaped.
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshalingParamError is returned when a parameter cannot be unmarshaled.
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned when a required parameter is missing.
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+// RequiredHeaderError is returned when a required header is missing.
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidParamFormatError is returned when a parameter has an invalid format.
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyValuesForParamError is returned when a parameter has too many values.
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
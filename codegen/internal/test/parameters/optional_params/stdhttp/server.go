@@ -0,0 +1,27 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsParams) {
+	echo := Echo{
+		Query:   r.URL.RawQuery,
+		Q:       params.Q,
+		Limit:   params.Limit.Ptr(),
+		Filter:  params.Filter.Ptr(),
+		Trace:   params.XTrace.Ptr(),
+		Session: params.Session.Ptr(),
+	}
+	if tags := params.Tags.Ptr(); tags != nil {
+		echo.Tags = *tags
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(echo)
+}
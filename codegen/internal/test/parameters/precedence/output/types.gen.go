@@ -13,12 +13,12 @@ import (
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/5SSwe7aMAzG730Kq5vEiabALbcdOUyaBi+QtYZ6apwsMWho2rtPCWmBAYd/Ts3nz/7y",
-	"s+o8svGkod40bbOqK+KD0xXAGUMkxxpWTdu0FYCQjKhhj1Fgh+GMoQIYqUOOmBoA2FjU8HW7r2KuxyQv",
-	"4RRGDYOI10qVOMEoTY/orfGNocobGbJbRbJ+xG+BLAmdUf3xJhj79xqQv1HK5HSWJTRXigZAnBQZZiHg",
-	"rxMF7DVIOOEsR7kkpGvmTe0GtEbPdwC5eNRALHjM1NM5uGCN5MpmnfUjytToPAYj5Hjb66TvHsmK6xnp",
-	"HdQLrLdg79BewU14UQLx8aHwzJcSo3cc8e69i3XbLu5Hfg540FB/Up2z3jGyRDX3qesivpd7Xd1MacZ/",
-	"8x/NU0iPsQvkJf+gXwrl3Arux0/spHg7x4Ist/cJ/hblR0OsP7KYfwEAAP//YI1KuyoDAAA=",
+	"H4sIAAAAAAAC/5SSvY7bMBCEez7FQglw1Ym6u45dyisCBDm/ACONrQ3En5BrI0aQdw9EU7IN20VYibMz",
+	"HH6EQoS3kQ01b23XvjSK/TYYRXRAyhy8oZe2aztFJCwTDG2QhT6QDkiKaOIePmMOEHnrYOjr+0blMs+z",
+	"/Ez7NBkaRaLRutYJsrQDEJ2NrWUVrYzFrTO7OOFbYsfCB+g/0Sbr/p4Kyjeknjyv51paJlUjYj8rMq5C",
+	"wq89JwyGJO2xylmOM9Kp86z2I5w1655IjhGG2At2hXpZ25CclTJ5ey36DrIEQ0SywsG/D2bWP67JqusW",
+	"6RHUHayHYI/Q7sEteFkS+93V4JaPKCHH4DMu7vv02nVP5iL5OWFrqPmk++Bi8PCS9ZrTp4f4XveNOpuM",
+	"ujn/2ryUDMh94ijlB/1SKdcohR8/0Uv19sELvJzvJ/gtOk6Wvfmfh/k3AGCNSrsqAwAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -68,6 +68,16 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // Exploded object binding
 // ---------------------------------------------------------------------------
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -75,3 +85,8 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // ---------------------------------------------------------------------------
 // Deep object marshaling
 // ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
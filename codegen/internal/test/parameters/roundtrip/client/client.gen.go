@@ -11,6 +11,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -19,8 +22,6 @@ import (
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 // #/components/schemas/Object
@@ -46,25 +47,27 @@ func (s *ComplexObject) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9xazY7bNhC++ykIt0CAAP5JclNOi7RFF0jTbZpDrlxxvGYqkQxJB7so+u6FRNkmJdki",
-	"ZdOSfVtRHHLmm79P4+UCGBY0QdN38+X8zXRC2YonE4R+gFSUswS9mS/nywlCmuoMEvSAJc5Bg0Sf+YYR",
-	"LalAX0DpCUIZTYEpKKQRYjiHBN0JnK5h9na+nAis16p4t1A0Fxk8SJpTTX/A4l9RnPmfkXsCbf5AiAuQ",
-	"WFPO7klSrP/tylW7xFYhtZVDaFZdX77brSJEWbGm19aShO8bKoEkSMsNWC+UfinsNcra6+kacpxYKwjp",
-	"FwEJokzDE0jnzYrLHOvy3bu3k+2VSnCmwFL41dvl8pV95s8SVgma/rRIeS44A6bVYie3MEh8rp6ne1A/",
-	"8V+fRcYJ3EmJXwKRdYUHgheMCgla4Uz54Y4tdStFNOTK3XrMSRd3U4uTXvt6aUw+qu+/IRftkuHPx2+Q",
-	"6r6pZKSvIZfacDL71MJYMb1YYjiYh2bGOCD3SI0xId63G9fFr6YiDdqsM/wImQX53BPzj47cZbAudT2t",
-	"QQ+PdTPK57615WOb/MDQX1GU1xlpUKgPwUjPEO/XxHbs9HBcFJgaY/DQTdJRN4u2zKhfGl2SGp2URwNT",
-	"IzspXMQDs2IUgI+fiuZYS/psdef3lHQD/Ycr5YEyJadBbPS8YjJkDGiyofeUvPYFvAcXiof7dTAho3+D",
-	"CvkHeTARGkOkX1OXdRJj75/QpBjePTfJgWr5s2vJPRLIuyMPm0GjaMhNuEPzYQRoj5/9pJxpYDpw0PzB",
-	"lorMMCsNXdSwEBlNS5UW3xRn9crRhnQX2h94gc7zBUAXWKkva8k3T2tvyB/2MkMAruFZL0SGqTfUpngr",
-	"LSl7ignm9w3Il9+4zDsh/Gu70wNAwJZOBFQqqdDlb9JVcpNGEyswLpVpBblReE3tWO3VGVUr3SNxEIio",
-	"9g/O9KxQ4J2hwO1CGI5FnH6xt+CgAefS+7yswoJedEIvap+CcdEP+56z6vEhO86o/vnHAJb+qtMAt9T3",
-	"KAgH1a0dvNUpPRjWqenkp4b3DTKP0vhfAIS5q7Nl7rd69ExiNh/ySvE61CXthJvUtTpnEfV1BoEV3mT6",
-	"JHesAROQnV74vdzm4YGvs4eWeuJVcNb2HSOoOJYps+qDjnTXIGju7GtcxG7wdVbOajrtOko1o9oUnVRV",
-	"EIRwy7gRegGLTUnx93prrYzk9j7sbGtRELuM5cV+BlTFvsOQo3wiwKDREYpz9LCU838oeIyMim0+o4vQ",
-	"zpXaJ4+gc53w0dLHlIh9qucQJKoV45p3xI290Y42Ink4/mwjlr/6aN7z+zXAhNvrN/tNxRnV9eY4+4sW",
-	"ISGLBqSpfbvkGdhXt04XVlQq/alw0KRjll2hbvm1uMB63B1VrjkYHVPT3REaXfekqXi9NNyrO5K7k3yz",
-	"8ZHzDDA7YmKNKM3QPbEfzMGThvNdP24PdIL+rvrP6p2oG/6NWG7/RaJzivR/AAAA///CjtKydTYAAA==",
+	"H4sIAAAAAAAC/9xbzY7bNhC+6ykGboEAAfyT5KacFmmLLpCm2zSHXLnieM1UEhmSDnZR9N0LSZZNSZZF",
+	"yqIl+7YSOdTMNz/fcIzlAlMiWAizd4vV4s0sYOmahwHAD5SK8TSEN4vVYhUAaKZjDOGBSJKgRgmf+Tal",
+	"WjIBX1DpACBmEaYKM2mAlCQYwp0g0QbnbxerQBC9UdnaUrFExPggWcI0+4HLf0V25n+F3BPq4g8ALlAS",
+	"zXh6T8Ps/d9VuQAAAECUCqlSDmC++3y+tn8LwNLsnd4YryR+3zKJNAQtt2gsKP2S2Vsoa76PNpiQ0HgD",
+	"oF8EhsBSjU8oKytrLhOi87V3b4Pyk0rwVKGh8Ku3q9WrwyPAzxLXIcx+WkY8ETzFVKvlXm5ZIPF59zw7",
+	"gPqJ//osYk7xTkry4ohsVXgkeLFQIYQ1iZUd7sRQFwAAgGlMVHXrKSdd3E1HnPTa1ktT8lF9/w25aJ8M",
+	"fz5+w0j3TaVC+hpy6RhOxT61LKyYXSwxKpi7ZsY0ILdIjSkh3peN6+JXU5FGJeuYPGJsQL6wxPxjRe4y",
+	"WOe6nkfQ42PdjPKFbW35eEx+ZOivKMrrHalTqI/RkQ4Q79fU7ZjpUXGRY2pMwUM32Y5Ws6jsjPql0SVb",
+	"o7PyaOTWyEyKKuKOWTEJwKffiiZES/ZssPN7RruB/qMqZYEyo+dBXOh5xc1QYUCzG3rP6GtbwHv0Qv5w",
+	"v45OqNC/0QrZB7lzIzSFSL8mlq0kxsE/rkkxvntusgeq5c+eknskkDUjj5tBkyDkJtyu+TABtKff/UQ8",
+	"1Zhqx0HzB1PKc4e507CKGhEiZlGu0vKb4ml19TjSXWh/4Bk6zxcAXRClvmwk3z5trCF/OMiMAbjGZ70U",
+	"MWHWUBfFW2nJ0iefYH7fonz5jcukE8K/yp0WACLZvwKgqCLJhM5/k94lN22QWIZxrsxRkBuFt6gd64M6",
+	"k6LSAxKtQHi1f/ROzwgF3hkK3CyE7lj44YuDBa0GDKX3sF2FAb3ohF7UroJ+0Xe7zxn1uM2OAdUffgxg",
+	"6K86DaiW+h4FoVXd2sGlTlFrWEcFk58b3jfYeeTG/4Jx5jOkdoy5325Bm6qVLZQgEQItzxqGPfJD6/pN",
+	"lUdEKzaCicGhyc4cA5mWZFXcNjDOTNpBI6MPZwlu6eZhDB3EzyNfQndVCUXxrc6ydNhqUZJosbnNKdmy",
+	"qyuOjwFoXashWztbiqC4JttYn+WODRKKstMLv+fbLDzwdf5wpMuxaoM25jcm0AcZpsx3YybaaRNgc2df",
+	"4zz2qF/n+QS5066TF2CvNnmn6B0ELjdevxF6AYuLkmLv9aO10pPb+/BvaZHTndeXF/sZsCv2HYacvOU4",
+	"GDS5a84QHBZx/g9Di0F2ts1moOrKXJF58gSY64xRSh9TPPJUz9GsVyumNYX1G3uTHbh68rD/iasvf/XR",
+	"vOdUzcGE2+Obw6YwKFXN/wQwb7QAQmYEpJn5dcljDIN63tTGKGsmlf6UOej0zj3qhl+zDxiP+6MCAIAK",
+	"RqfUrO5wja57GgZdpeFe3dGEpc2Nj5zHSNITJtYapTncU/OhODhoOL/qxzAow8EI+rvd/3vsRavh34jl",
+	"47+Tds62/x8AhIO6tQs7AAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -106,6 +109,10 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 	return openAPISpec()
 }
 
+// DefaultUserAgent identifies this client by the spec's title and version.
+// Send it with WithUserAgent(DefaultUserAgent).
+const DefaultUserAgent = "Parameter-Roundtrip-Test/1.0.0"
+
 // RequestEditorFn is the function signature for the RequestEditor callback function.
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -130,6 +137,22 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// UserAgent is sent with every request which doesn't already set one.
+	// Empty, the default, leaves the header to the HttpRequestDoer.
+	UserAgent string
+
+	// DefaultHeaders are added to every request which doesn't already set them.
+	DefaultHeaders http.Header
+
+	// DefaultQueryParams are added to every request URL which doesn't already
+	// contain them.
+	DefaultQueryParams url.Values
+
+	// DialContext opens the connections of the default HttpRequestDoer, such
+	// as to a Unix socket. Set with WithDialer or WithUnixSocket; nil uses the
+	// dialer of http.DefaultTransport.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
 }
 
 // ClientOption allows setting custom parameters during construction.
@@ -145,6 +168,13 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 			return nil, err
 		}
 	}
+	// Dial the socket of an http+unix server URL
+	if socket, server, ok := unixSocketServer(client.Server); ok {
+		client.Server = server
+		if client.DialContext == nil {
+			client.DialContext = unixSocketDialer(socket)
+		}
+	}
 	// Ensure the server URL always has a trailing slash
 	if !strings.HasSuffix(client.Server, "/") {
 		client.Server += "/"
@@ -152,6 +182,13 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// Create httpClient if not already present
 	if client.Client == nil {
 		client.Client = &http.Client{}
+		if client.DialContext != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.DialContext = client.DialContext
+			client.Client = &http.Client{Transport: transport}
+		}
+	} else if client.DialContext != nil {
+		return nil, errors.New("a dialer can't be combined with WithHTTPClient: set it on the transport of the HTTP client instead")
 	}
 	return &client, nil
 }
@@ -165,6 +202,55 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithDialer opens the connections of the client with dial, such as the
+// DialContext method of a configured net.Dialer. Requests keep the URLs of
+// the server, so dial can route them elsewhere, such as through a tunnel.
+func WithDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		c.DialContext = dial
+		return nil
+	}
+}
+
+// WithUnixSocket sends every request over the Unix domain socket at path,
+// such as the socket of a sidecar service. The server URL is kept for the
+// Host header and the path of requests, so paths templated in the spec
+// still apply. A server URL of the form http+unix://%2Fpath%2Fto.sock/base
+// does the same without this option.
+func WithUnixSocket(path string) ClientOption {
+	return WithDialer(unixSocketDialer(path))
+}
+
+// unixSocketDialer returns a dialer connecting to the Unix socket at path,
+// whatever the address.
+func unixSocketDialer(path string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
+// unixSocketServer splits a server URL of the form
+// http+unix://%2Fpath%2Fto.sock/base, or https+unix, into the path of its
+// socket and an equivalent server URL on localhost.
+func unixSocketServer(server string) (socket, httpServer string, ok bool) {
+	// url.Parse rejects the escaped slashes of the host, so split by hand.
+	scheme, rest, found := strings.Cut(server, "://")
+	if !found {
+		return "", "", false
+	}
+	scheme, isUnix := strings.CutSuffix(strings.ToLower(scheme), "+unix")
+	if !isUnix || (scheme != "http" && scheme != "https") {
+		return "", "", false
+	}
+	host, path, _ := strings.Cut(rest, "/")
+	socket, err := url.PathUnescape(host)
+	if err != nil || socket == "" {
+		return "", "", false
+	}
+	return socket, scheme + "://localhost/" + path, true
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -186,7 +272,69 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with every request, such as
+// DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultHeader adds a header to every request, unless the request
+// already sets it.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = make(http.Header)
+		}
+		c.DefaultHeaders.Add(key, value)
+		return nil
+	}
+}
+
+// WithDefaultQueryParam adds a query parameter, such as an API version, to
+// every request URL, unless the URL already contains it.
+func WithDefaultQueryParam(key, value string) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultQueryParams == nil {
+			c.DefaultQueryParams = make(url.Values)
+		}
+		c.DefaultQueryParams.Add(key, value)
+		return nil
+	}
+}
+
+// applyDefaults adds the default User-Agent, headers and query parameters to
+// req. Values already present on the request take precedence.
+func (c *Client) applyDefaults(req *http.Request) {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for key, values := range c.DefaultHeaders {
+		if _, ok := req.Header[key]; !ok {
+			req.Header[key] = append([]string(nil), values...)
+		}
+	}
+	if len(c.DefaultQueryParams) > 0 {
+		query := req.URL.Query()
+		extra := make(url.Values)
+		for key, values := range c.DefaultQueryParams {
+			if !query.Has(key) {
+				extra[key] = values
+			}
+		}
+		if len(extra) > 0 {
+			if req.URL.RawQuery != "" {
+				req.URL.RawQuery += "&"
+			}
+			req.URL.RawQuery += extra.Encode()
+		}
+	}
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	c.applyDefaults(req)
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
@@ -200,56 +348,215 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// RequestOption customizes a single call to a Client method. Options are
+// applied after the request has been built and the Client's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a Client-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleClient methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleClient methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (c *Client) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // ClientInterface is the interface specification for the client.
 type ClientInterface interface {
 	// GetContentObject makes a GET request to /contentObject/{param}
-	GetContentObject(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetContentObject(ctx context.Context, param ComplexObject, opts ...RequestOption) (*http.Response, error)
 	// GetCookie makes a GET request to /cookie
-	GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetCookie(ctx context.Context, params *GetCookieParams, opts ...RequestOption) (*http.Response, error)
 	// GetHeader makes a GET request to /header
-	GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetHeader(ctx context.Context, params *GetHeaderParams, opts ...RequestOption) (*http.Response, error)
 	// GetLabelExplodeArray makes a GET request to /labelExplodeArray/{.param*}
-	GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error)
 	// GetLabelExplodeObject makes a GET request to /labelExplodeObject/{.param*}
-	GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error)
 	// GetLabelExplodePrimitive makes a GET request to /labelExplodePrimitive/{.param*}
-	GetLabelExplodePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelExplodePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error)
 	// GetLabelNoExplodeArray makes a GET request to /labelNoExplodeArray/{.param}
-	GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelNoExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error)
 	// GetLabelNoExplodeObject makes a GET request to /labelNoExplodeObject/{.param}
-	GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelNoExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error)
 	// GetLabelPrimitive makes a GET request to /labelPrimitive/{.param}
-	GetLabelPrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLabelPrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixExplodeArray makes a GET request to /matrixExplodeArray/{.id*}
-	GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixExplodeArray(ctx context.Context, id []int32, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixExplodeObject makes a GET request to /matrixExplodeObject/{.id*}
-	GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixExplodeObject(ctx context.Context, id Object, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixExplodePrimitive makes a GET request to /matrixExplodePrimitive/{;id*}
-	GetMatrixExplodePrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixExplodePrimitive(ctx context.Context, id int32, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixNoExplodeArray makes a GET request to /matrixNoExplodeArray/{.id}
-	GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixNoExplodeArray(ctx context.Context, id []int32, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixNoExplodeObject makes a GET request to /matrixNoExplodeObject/{.id}
-	GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixNoExplodeObject(ctx context.Context, id Object, opts ...RequestOption) (*http.Response, error)
 	// GetMatrixPrimitive makes a GET request to /matrixPrimitive/{;id}
-	GetMatrixPrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetMatrixPrimitive(ctx context.Context, id int32, opts ...RequestOption) (*http.Response, error)
 	// GetPassThrough makes a GET request to /passThrough/{param}
-	GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetPassThrough(ctx context.Context, param string, opts ...RequestOption) (*http.Response, error)
 	// GetDeepObject makes a GET request to /queryDeepObject
-	GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetDeepObject(ctx context.Context, params *GetDeepObjectParams, opts ...RequestOption) (*http.Response, error)
+	// GetQueryDelimited makes a GET request to /queryDelimited
+	GetQueryDelimited(ctx context.Context, params *GetQueryDelimitedParams, opts ...RequestOption) (*http.Response, error)
 	// GetQueryForm makes a GET request to /queryForm
-	GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetQueryForm(ctx context.Context, params *GetQueryFormParams, opts ...RequestOption) (*http.Response, error)
 	// GetSimpleExplodeArray makes a GET request to /simpleExplodeArray/{param*}
-	GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimpleExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error)
 	// GetSimpleExplodeObject makes a GET request to /simpleExplodeObject/{param*}
-	GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimpleExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error)
 	// GetSimpleExplodePrimitive makes a GET request to /simpleExplodePrimitive/{param}
-	GetSimpleExplodePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimpleExplodePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error)
 	// GetSimpleNoExplodeArray makes a GET request to /simpleNoExplodeArray/{param}
-	GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimpleNoExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error)
 	// GetSimpleNoExplodeObject makes a GET request to /simpleNoExplodeObject/{param}
-	GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimpleNoExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error)
 	// GetSimplePrimitive makes a GET request to /simplePrimitive/{param}
-	GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetSimplePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error)
 }
 
 // GetCookieParams defines parameters for GetCookie.
@@ -267,7 +574,7 @@ type GetCookieParams struct {
 	// o (cookie)
 	O *Object
 	// co (cookie)
-	Co *string
+	Co *ComplexObject
 }
 
 // GetHeaderParams defines parameters for GetHeader.
@@ -285,7 +592,7 @@ type GetHeaderParams struct {
 	// X-Object (header)
 	XObject *Object
 	// X-Complex-Object (header)
-	XComplexObject *string
+	XComplexObject *ComplexObject
 }
 
 // GetDeepObjectParams defines parameters for GetDeepObject.
@@ -294,6 +601,112 @@ type GetDeepObjectParams struct {
 	DeepObj ComplexObject `form:"deepObj" json:"deepObj"`
 }
 
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetDeepObjectParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if frag, err := StyleDeepObjectParam("deepObj", p.DeepObj); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetDeepObjectParams) FromURLValues(values url.Values) error {
+	if err := BindDeepObjectParam("deepObj", values, &p.DeepObj, true); err != nil {
+		return fmt.Errorf("invalid format for query parameter deepObj: %w", err)
+	}
+	return nil
+}
+
+// GetQueryDelimitedParams defines parameters for GetQueryDelimited.
+type GetQueryDelimitedParams struct {
+	// sa (optional)
+	Sa *[]int32 `form:"sa" json:"sa"`
+	// pa (optional)
+	Pa *[]string `form:"pa" json:"pa"`
+	// so (optional)
+	So *Object `form:"so" json:"so"`
+	// po (optional)
+	Po *Object `form:"po" json:"po"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetQueryDelimitedParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Sa != nil {
+		if frag, err := StyleParameter("sa", *p.Sa, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Pa != nil {
+		if frag, err := StyleParameter("pa", *p.Pa, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.So != nil {
+		if frag, err := StyleParameter("so", *p.So, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Po != nil {
+		if frag, err := StyleParameter("po", *p.Po, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetQueryDelimitedParams) FromURLValues(values url.Values) error {
+	if err := BindQueryParameter("sa", values, &p.Sa, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter sa: %w", err)
+	}
+	if err := BindQueryParameter("pa", values, &p.Pa, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter pa: %w", err)
+	}
+	if err := BindQueryParameter("so", values, &p.So, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter so: %w", err)
+	}
+	if err := BindQueryParameter("po", values, &p.Po, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter po: %w", err)
+	}
+	return nil
+}
+
 // GetQueryFormParams defines parameters for GetQueryForm.
 type GetQueryFormParams struct {
 	// ea (optional)
@@ -311,347 +724,525 @@ type GetQueryFormParams struct {
 	// ps (optional)
 	Ps *string `form:"ps" json:"ps"`
 	// co (optional)
-	Co *string `form:"co" json:"co"`
+	Co *ComplexObject `form:"co" json:"co"`
 }
 
-// GetContentObject makes a GET request to /contentObject/{param}
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetQueryFormParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Ea != nil {
+		if frag, err := StyleParameter("ea", *p.Ea, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.A != nil {
+		if frag, err := StyleParameter("a", *p.A, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Eo != nil {
+		if frag, err := p.styleEo(); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.O != nil {
+		if frag, err := StyleParameter("o", *p.O, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Ep != nil {
+		if frag, err := StylePrimitiveParameter("ep", *p.Ep, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.P != nil {
+		if frag, err := StylePrimitiveParameter("p", *p.P, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Ps != nil {
+		if frag, err := StylePrimitiveParameter("ps", *p.Ps, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Co != nil {
+		if buf, err := json.Marshal(*p.Co); err != nil {
+			return nil, err
+		} else {
+			values.Add("co", string(buf))
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetQueryFormParams) FromURLValues(values url.Values) error {
+	if err := BindQueryParameter("ea", values, &p.Ea, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter ea: %w", err)
+	}
+	if err := BindQueryParameter("a", values, &p.A, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter a: %w", err)
+	}
+	if err := p.bindEo(values); err != nil {
+		return fmt.Errorf("invalid format for query parameter eo: %w", err)
+	}
+	if err := BindQueryParameter("o", values, &p.O, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter o: %w", err)
+	}
+	if err := BindPrimitiveQueryParameter("ep", values, &p.Ep, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter ep: %w", err)
+	}
+	if err := BindPrimitiveQueryParameter("p", values, &p.P, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter p: %w", err)
+	}
+	if err := BindPrimitiveQueryParameter("ps", values, &p.Ps, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter ps: %w", err)
+	}
+	if paramValue := values.Get("co"); paramValue != "" {
+		var value ComplexObject
+		if err := DecodeJSON([]byte(paramValue), &value); err != nil {
+			return fmt.Errorf("unmarshaling query parameter co: %w", err)
+		}
+		p.Co = &value
+	}
+	return nil
+}
+
+// styleEo serializes the exploded object query parameter eo of
+// p, each of its properties as the query key named after it.
+func (p *GetQueryFormParams) styleEo() (string, error) {
+	value := *p.Eo
+	var frags []string
+	{
+		frag, err := StylePrimitiveParameter("role", value.Role, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: true}, FormatString[string])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	{
+		frag, err := StylePrimitiveParameter("firstName", value.FirstName, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: true}, FormatString[string])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	return strings.Join(frags, "&"), nil
+}
+
+// bindEo binds the exploded object query parameter eo from
+// values into p, each of its properties from the query key named after it.
+func (p *GetQueryFormParams) bindEo(values url.Values) error {
+	if !values.Has("role") && !values.Has("firstName") {
+		return nil
+	}
+	var value Object
+	if err := BindPrimitiveQueryParameter("role", values, &value.Role, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: true}, ParseString[string]); err != nil {
+		return err
+	}
+	if err := BindPrimitiveQueryParameter("firstName", values, &value.FirstName, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: true}, ParseString[string]); err != nil {
+		return err
+	}
+	p.Eo = &value
+	return nil
+}
 
-func (c *Client) GetContentObject(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// GetContentObject makes a GET request to /contentObject/{param}
+func (c *Client) GetContentObject(ctx context.Context, param ComplexObject, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetContentObjectRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getContentObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetCookie makes a GET request to /cookie
-
-func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetCookieRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getCookie", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetHeader makes a GET request to /header
-
-func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetHeaderRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getHeader", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelExplodeArray makes a GET request to /labelExplodeArray/{.param*}
-
-func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelExplodeArrayRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelExplodeObject makes a GET request to /labelExplodeObject/{.param*}
-
-func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelExplodeObjectRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelExplodePrimitive makes a GET request to /labelExplodePrimitive/{.param*}
-
-func (c *Client) GetLabelExplodePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelExplodePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelExplodePrimitiveRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelExplodePrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelNoExplodeArray makes a GET request to /labelNoExplodeArray/{.param}
-
-func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelNoExplodeArrayRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelNoExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelNoExplodeObject makes a GET request to /labelNoExplodeObject/{.param}
-
-func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelNoExplodeObjectRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelNoExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetLabelPrimitive makes a GET request to /labelPrimitive/{.param}
-
-func (c *Client) GetLabelPrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetLabelPrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetLabelPrimitiveRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getLabelPrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixExplodeArray makes a GET request to /matrixExplodeArray/{.id*}
-
-func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixExplodeArrayRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixExplodeObject makes a GET request to /matrixExplodeObject/{.id*}
-
-func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixExplodeObjectRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixExplodePrimitive makes a GET request to /matrixExplodePrimitive/{;id*}
-
-func (c *Client) GetMatrixExplodePrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixExplodePrimitive(ctx context.Context, id int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixExplodePrimitiveRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixExplodePrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixNoExplodeArray makes a GET request to /matrixNoExplodeArray/{.id}
-
-func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixNoExplodeArrayRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixNoExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixNoExplodeObject makes a GET request to /matrixNoExplodeObject/{.id}
-
-func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixNoExplodeObjectRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixNoExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetMatrixPrimitive makes a GET request to /matrixPrimitive/{;id}
-
-func (c *Client) GetMatrixPrimitive(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetMatrixPrimitive(ctx context.Context, id int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetMatrixPrimitiveRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getMatrixPrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetPassThrough makes a GET request to /passThrough/{param}
-
-func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetPassThrough(ctx context.Context, param string, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetPassThroughRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getPassThrough", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetDeepObject makes a GET request to /queryDeepObject
-
-func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetDeepObjectRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getDeepObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
-// GetQueryForm makes a GET request to /queryForm
+// GetQueryDelimited makes a GET request to /queryDelimited
+func (c *Client) GetQueryDelimited(ctx context.Context, params *GetQueryDelimitedParams, opts ...RequestOption) (*http.Response, error) {
+	req, err := NewGetQueryDelimitedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req, cancel, err := c.prepareRequest(ctx, "getQueryDelimited", req, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
+}
 
-func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+// GetQueryForm makes a GET request to /queryForm
+func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetQueryFormRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getQueryForm", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimpleExplodeArray makes a GET request to /simpleExplodeArray/{param*}
-
-func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimpleExplodeArrayRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimpleExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimpleExplodeObject makes a GET request to /simpleExplodeObject/{param*}
-
-func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimpleExplodeObjectRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimpleExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimpleExplodePrimitive makes a GET request to /simpleExplodePrimitive/{param}
-
-func (c *Client) GetSimpleExplodePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimpleExplodePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimpleExplodePrimitiveRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimpleExplodePrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimpleNoExplodeArray makes a GET request to /simpleNoExplodeArray/{param}
-
-func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimpleNoExplodeArrayRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimpleNoExplodeArray", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimpleNoExplodeObject makes a GET request to /simpleNoExplodeObject/{param}
-
-func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimpleNoExplodeObjectRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimpleNoExplodeObject", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // GetSimplePrimitive makes a GET request to /simplePrimitive/{param}
-
-func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewGetSimplePrimitiveRequest(c.Server, param)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := c.prepareRequest(ctx, "getSimplePrimitive", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewGetContentObjectRequest creates a GET request for /contentObject/{param}
-func NewGetContentObjectRequest(server string, param string) (*http.Request, error) {
+func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -712,7 +1303,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 	if params != nil {
 		if params.P != nil {
 			var cookieParam0 string
-			cookieParam0, err = StyleParameter("p", *params.P, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			cookieParam0 = FormatInt[int32](*params.P)
 			if err != nil {
 				return nil, err
 			}
@@ -724,7 +1315,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		}
 		if params.Ep != nil {
 			var cookieParam1 string
-			cookieParam1, err = StyleParameter("ep", *params.Ep, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			cookieParam1 = FormatInt[int32](*params.Ep)
 			if err != nil {
 				return nil, err
 			}
@@ -736,7 +1327,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		}
 		if params.Ea != nil {
 			var cookieParam2 string
-			cookieParam2, err = StyleParameter("ea", *params.Ea, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
+			cookieParam2, err = StyleCookieParam("ea", *params.Ea, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
 			if err != nil {
 				return nil, err
 			}
@@ -748,7 +1339,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		}
 		if params.A != nil {
 			var cookieParam3 string
-			cookieParam3, err = StyleParameter("a", *params.A, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
+			cookieParam3, err = StyleCookieParam("a", *params.A, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
 			if err != nil {
 				return nil, err
 			}
@@ -760,7 +1351,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		}
 		if params.Eo != nil {
 			var cookieParam4 string
-			cookieParam4, err = StyleParameter("eo", *params.Eo, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false})
+			cookieParam4, err = StyleCookieParam("eo", *params.Eo, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false})
 			if err != nil {
 				return nil, err
 			}
@@ -772,7 +1363,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 		}
 		if params.O != nil {
 			var cookieParam5 string
-			cookieParam5, err = StyleParameter("o", *params.O, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
+			cookieParam5, err = StyleCookieParam("o", *params.O, ParameterOptions{Style: "form", ParamLocation: ParamLocationCookie, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
 			if err != nil {
 				return nil, err
 			}
@@ -828,7 +1419,7 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 	if params != nil {
 		if params.XPrimitive != nil {
 			var headerParam0 string
-			headerParam0, err = StyleParameter("X-Primitive", *params.XPrimitive, ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			headerParam0, err = StylePrimitiveParameter("X-Primitive", *params.XPrimitive, ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 			if err != nil {
 				return nil, err
 			}
@@ -836,7 +1427,7 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 		}
 		if params.XPrimitiveExploded != nil {
 			var headerParam1 string
-			headerParam1, err = StyleParameter("X-Primitive-Exploded", *params.XPrimitiveExploded, ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			headerParam1, err = StylePrimitiveParameter("X-Primitive-Exploded", *params.XPrimitiveExploded, ParameterOptions{Style: "simple", ParamLocation: ParamLocationHeader, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 			if err != nil {
 				return nil, err
 			}
@@ -960,7 +1551,7 @@ func NewGetLabelExplodePrimitiveRequest(server string, param int32) (*http.Reque
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("param", param, ParameterOptions{Style: "label", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("param", param, ParameterOptions{Style: "label", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1059,7 +1650,7 @@ func NewGetLabelPrimitiveRequest(server string, param int32) (*http.Request, err
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("param", param, ParameterOptions{Style: "label", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("param", param, ParameterOptions{Style: "label", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1158,7 +1749,7 @@ func NewGetMatrixExplodePrimitiveRequest(server string, id int32) (*http.Request
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("id", id, ParameterOptions{Style: "matrix", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("id", id, ParameterOptions{Style: "matrix", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1257,7 +1848,7 @@ func NewGetMatrixPrimitiveRequest(server string, id int32) (*http.Request, error
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("id", id, ParameterOptions{Style: "matrix", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("id", id, ParameterOptions{Style: "matrix", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1336,7 +1927,7 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 
 	if params != nil {
 		queryValues := reqURL.Query()
-		if queryFrag, err := StyleParameter("deepObj", params.DeepObj, ParameterOptions{Style: "deepObject", ParamLocation: ParamLocationQuery, Explode: true, Required: true, Type: "", Format: "", AllowReserved: false}); err != nil {
+		if queryFrag, err := StyleDeepObjectParam("deepObj", params.DeepObj); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -1358,6 +1949,90 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 	return req, nil
 }
 
+// NewGetQueryDelimitedRequest creates a GET request for /queryDelimited
+func NewGetQueryDelimitedRequest(server string, params *GetQueryDelimitedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queryDelimited")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	reqURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := reqURL.Query()
+		if params.Sa != nil {
+			if queryFrag, err := StyleParameter("sa", *params.Sa, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		if params.Pa != nil {
+			if queryFrag, err := StyleParameter("pa", *params.Pa, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		if params.So != nil {
+			if queryFrag, err := StyleParameter("so", *params.So, ParameterOptions{Style: "spaceDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		if params.Po != nil {
+			if queryFrag, err := StyleParameter("po", *params.Po, ParameterOptions{Style: "pipeDelimited", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+		}
+		reqURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetQueryFormRequest creates a GET request for /queryForm
 func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Request, error) {
 	var err error
@@ -1406,7 +2081,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 			}
 		}
 		if params.Eo != nil {
-			if queryFrag, err := StyleParameter("eo", *params.Eo, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			if queryFrag, err := params.styleEo(); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1432,7 +2107,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 			}
 		}
 		if params.Ep != nil {
-			if queryFrag, err := StyleParameter("ep", *params.Ep, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}); err != nil {
+			if queryFrag, err := StylePrimitiveParameter("ep", *params.Ep, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32]); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1445,7 +2120,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 			}
 		}
 		if params.P != nil {
-			if queryFrag, err := StyleParameter("p", *params.P, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}); err != nil {
+			if queryFrag, err := StylePrimitiveParameter("p", *params.P, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32]); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1458,7 +2133,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 			}
 		}
 		if params.Ps != nil {
-			if queryFrag, err := StyleParameter("ps", *params.Ps, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}); err != nil {
+			if queryFrag, err := StylePrimitiveParameter("ps", *params.Ps, ParameterOptions{Style: "form", ParamLocation: ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, FormatString[string]); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1559,7 +2234,7 @@ func NewGetSimpleExplodePrimitiveRequest(server string, param int32) (*http.Requ
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("param", param, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("param", param, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1658,7 +2333,7 @@ func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, er
 	var err error
 
 	var pathParam0 string
-	pathParam0, err = StyleParameter("param", param, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	pathParam0, err = StylePrimitiveParameter("param", param, ParameterOptions{Style: "simple", ParamLocation: ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, FormatInt[int32])
 	if err != nil {
 		return nil, err
 	}
@@ -1733,16 +2408,761 @@ func (d Date) Format(layout string) string {
 	return d.Time.Format(layout)
 }
 
-type UUID = uuid.UUID
-
-// ---------------------------------------------------------------------------
-// Deep object internals
-// ---------------------------------------------------------------------------
-
-// ---------------------------------------------------------------------------
-// Exploded object binding
-// ---------------------------------------------------------------------------
-
+// Nominal lengths of the ISO 8601 duration units which vary in length.
+const (
+	durationDay   = 24 * time.Hour
+	durationWeek  = 7 * durationDay
+	durationMonth = 30 * durationDay
+	durationYear  = 365 * durationDay
+)
+
+// ErrInvalidDuration is returned when a string isn't an ISO 8601 duration.
+var ErrInvalidDuration = errors.New("duration: invalid ISO 8601 duration")
+
+// Duration is an ISO 8601 duration, such as "P1DT2H30M", the format of
+// strings with format: duration. It converts to and from time.Duration; as a
+// time.Duration has no calendar, years, months and days are taken as 365, 30
+// and 1 days of 24 hours.
+type Duration time.Duration
+
+// ParseDuration parses an ISO 8601 duration, such as "PT1H30M" or "-P2W".
+// Only the seconds may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	rest := s
+	negative := false
+	if strings.HasPrefix(rest, "-") {
+		negative, rest = true, rest[1:]
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	if !strings.HasPrefix(rest, "P") || rest == "P" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+	rest = rest[1:]
+
+	var total time.Duration
+	inTime := false
+	// units holds the designators allowed next, in order.
+	units := "YMWD"
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		number, designator := rest[:end], rest[end]
+		rest = rest[end+1:]
+		i := strings.IndexByte(units, designator)
+		if i < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		units = units[i+1:]
+
+		whole, fraction, hasFraction := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+		if hasFraction && !(inTime && designator == 'S') {
+			return 0, fmt.Errorf("%w: %q: only seconds may have a fraction", ErrInvalidDuration, s)
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		unit := durationUnit(designator, inTime)
+		if err != nil || n > int64(math.MaxInt64/unit) {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		part := time.Duration(n) * unit
+		if hasFraction {
+			if fraction == "" || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+			part += time.Duration(nanos)
+		}
+		if total > math.MaxInt64-part {
+			return 0, fmt.Errorf("%w: %q overflows time.Duration", ErrInvalidDuration, s)
+		}
+		total += part
+	}
+
+	if negative {
+		total = -total
+	}
+	return Duration(total), nil
+}
+
+// durationUnit returns the length of an ISO 8601 designator, whose meaning
+// depends on whether it is in the time part of the duration.
+func durationUnit(designator byte, inTime bool) time.Duration {
+	if inTime {
+		switch designator {
+		case 'H':
+			return time.Hour
+		case 'M':
+			return time.Minute
+		default:
+			return time.Second
+		}
+	}
+	switch designator {
+	case 'Y':
+		return durationYear
+	case 'M':
+		return durationMonth
+	case 'W':
+		return durationWeek
+	default:
+		return durationDay
+	}
+}
+
+// TimeDuration returns d as a time.Duration.
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// String formats d as an ISO 8601 duration in hours, minutes and seconds,
+// such as "PT26H30M" or "PT0.5S", so that it parses back exactly.
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		seconds := strconv.FormatUint(u/uint64(time.Second), 10)
+		if nanos := u % uint64(time.Second); nanos > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Duration.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Duration.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// BindQueryParameter binds a query parameter from pre-parsed url.Values.
+// The Style field in opts selects parsing behavior. If Style is empty, "form"
+// is assumed. Supports form, spaceDelimited, pipeDelimited, and deepObject.
+func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts ParameterOptions) error {
+	style := opts.Style
+	if style == "" {
+		style = "form"
+	}
+
+	// Destination value management for optional (pointer) parameters.
+	dv := reflect.Indirect(reflect.ValueOf(dest))
+	v := dv
+	var output any
+	extraIndirect := !opts.Required && v.Kind() == reflect.Pointer
+	if !extraIndirect {
+		output = dest
+	} else {
+		if v.IsNil() {
+			t := v.Type()
+			newValue := reflect.New(t.Elem())
+			output = newValue.Interface()
+		} else {
+			output = v.Interface()
+		}
+		v = reflect.Indirect(reflect.ValueOf(output))
+	}
+
+	t := v.Type()
+	k := t.Kind()
+
+	switch style {
+	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, whatever their
+		// kind, as it's in their own format.
+		if pb, ok := output.(ParamBinder); ok {
+			values, found := queryParams[paramName]
+			if !found {
+				if opts.Required {
+					return &MissingRequiredParameterError{ParamName: paramName}
+				}
+				return nil
+			}
+			if len(values) != 1 {
+				return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+			}
+			if err := pb.BindParam(values[0]); err != nil {
+				return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, output, err)
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		if opts.Explode {
+			// Exploded: each value is a separate key=value pair.
+			// spaceDelimited and pipeDelimited with explode=true are
+			// serialized identically to form explode=true.
+			values, found := queryParams[paramName]
+			var err error
+
+			switch k {
+			case reflect.Slice:
+				if !found {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				if opts.Format == "byte" && isByteSlice(t) {
+					if len(values) != 1 {
+						return fmt.Errorf("expected single base64 value for byte slice parameter '%s', got %d values", paramName, len(values))
+					}
+					decoded, decErr := base64Decode(values[0])
+					if decErr != nil {
+						return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+					}
+					v.SetBytes(decoded)
+				} else {
+					err = bindSplitPartsToDestinationArray(values, output)
+				}
+			case reflect.Struct:
+				var fieldsPresent bool
+				fieldsPresent, err = bindParamsToExplodedObject(paramName, queryParams, output)
+				if !fieldsPresent {
+					return nil
+				}
+			default:
+				if len(values) == 0 {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				if len(values) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				if !found {
+					if opts.Required {
+						return &MissingRequiredParameterError{ParamName: paramName}
+					}
+					return nil
+				}
+				err = BindStringToObject(values[0], output)
+			}
+			if err != nil {
+				return err
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		// Non-exploded: single value, delimiter-separated.
+		values, found := queryParams[paramName]
+		if !found {
+			if opts.Required {
+				return &MissingRequiredParameterError{ParamName: paramName}
+			}
+			return nil
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+		}
+
+		// Primitive types: use the raw value as-is without splitting.
+		if k != reflect.Slice && k != reflect.Struct && k != reflect.Map {
+			err := BindStringToObject(values[0], output)
+			if err != nil {
+				return err
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
+		var parts []string
+		switch style {
+		case "spaceDelimited":
+			parts = strings.Split(values[0], " ")
+		case "pipeDelimited":
+			parts = strings.Split(values[0], "|")
+		default:
+			parts = strings.Split(values[0], ",")
+		}
+
+		var err error
+		switch k {
+		case reflect.Slice:
+			if opts.Format == "byte" && isByteSlice(t) {
+				raw := strings.Join(parts, ",")
+				decoded, decErr := base64Decode(raw)
+				if decErr != nil {
+					return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+				}
+				v.SetBytes(decoded)
+			} else {
+				err = bindSplitPartsToDestinationArray(parts, output)
+			}
+		case reflect.Struct, reflect.Map:
+			// Some struct types (e.g. Date, time.Time) are scalar values
+			// that should be bound from a single string, not decomposed as
+			// key-value objects.
+			switch bv := output.(type) {
+			case ParamBinder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.BindParam(parts[0])
+			case Binder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.Bind(parts[0])
+			case encoding.TextUnmarshaler:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.UnmarshalText([]byte(parts[0]))
+			default:
+				err = bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, output)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if extraIndirect {
+			dv.Set(reflect.ValueOf(output))
+		}
+		return nil
+
+	case "deepObject":
+		if !opts.Explode {
+			return errors.New("deepObjects must be exploded")
+		}
+		return unmarshalDeepObject(dest, paramName, queryParams, opts.Required)
+
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Deep object internals
+// ---------------------------------------------------------------------------
+
+// unmarshalDeepObject is the internal implementation of deep object
+// unmarshaling that supports the required parameter.
+func unmarshalDeepObject(dst any, paramName string, params url.Values, required bool) error {
+	var fieldNames []string
+	var fieldValues []string
+	searchStr := paramName + "["
+
+	for pName, pValues := range params {
+		if strings.HasPrefix(pName, searchStr) {
+			pName = pName[len(paramName):]
+			if len(pValues) == 1 {
+				fieldNames = append(fieldNames, pName)
+				fieldValues = append(fieldValues, pValues[0])
+			} else {
+				for i, value := range pValues {
+					fieldNames = append(fieldNames, pName+"["+strconv.Itoa(i)+"]")
+					fieldValues = append(fieldValues, value)
+				}
+			}
+		}
+	}
+
+	if len(fieldNames) == 0 {
+		if required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	paths := make([][]string, len(fieldNames))
+	for i, path := range fieldNames {
+		path = strings.TrimLeft(path, "[")
+		path = strings.TrimRight(path, "]")
+		paths[i] = strings.Split(path, "][")
+	}
+
+	fieldPaths := makeFieldOrValue(paths, fieldValues)
+	err := assignPathValues(dst, fieldPaths)
+	if err != nil {
+		return fmt.Errorf("error assigning value to destination: %w", err)
+	}
+	return nil
+}
+
+// BindDeepObjectParam binds an exploded deepObject query parameter, such as
+// "filter[status]=open&filter[created][gte]=2024-01-01", from queryParams to
+// dest, a pointer to a struct, map or slice. Struct fields are matched by their
+// json tags, and repeated keys bind to consecutive array items. A missing
+// parameter leaves dest unchanged, or returns a MissingRequiredParameterError
+// when required.
+func BindDeepObjectParam(paramName string, queryParams url.Values, dest any, required bool) error {
+	return unmarshalDeepObject(dest, paramName, queryParams, required)
+}
+
+type fieldOrValue struct {
+	fields map[string]fieldOrValue
+	value  string
+}
+
+func (f *fieldOrValue) appendPathValue(path []string, value string) {
+	fieldName := path[0]
+	if len(path) == 1 {
+		f.fields[fieldName] = fieldOrValue{value: value}
+		return
+	}
+
+	pv, found := f.fields[fieldName]
+	if !found {
+		pv = fieldOrValue{
+			fields: make(map[string]fieldOrValue),
+		}
+		f.fields[fieldName] = pv
+	}
+	pv.appendPathValue(path[1:], value)
+}
+
+func makeFieldOrValue(paths [][]string, values []string) fieldOrValue {
+	f := fieldOrValue{
+		fields: make(map[string]fieldOrValue),
+	}
+	for i := range paths {
+		f.appendPathValue(paths[i], values[i])
+	}
+	return f
+}
+
+func getFieldName(f reflect.StructField) string {
+	n := f.Name
+	tag, found := f.Tag.Lookup("json")
+	if found {
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			n = parts[0]
+		}
+	}
+	return n
+}
+
+func fieldIndicesByJsonTag(i any) (map[string]int, error) {
+	t := reflect.TypeOf(i)
+	if t.Kind() != reflect.Struct {
+		return nil, errors.New("expected a struct as input")
+	}
+
+	n := t.NumField()
+	fieldMap := make(map[string]int)
+	for i := 0; i < n; i++ {
+		field := t.Field(i)
+		fieldName := getFieldName(field)
+		fieldMap[fieldName] = i
+	}
+	return fieldMap, nil
+}
+
+func assignPathValues(dst any, pathValues fieldOrValue) error {
+	v := reflect.ValueOf(dst)
+	iv := reflect.Indirect(v)
+	it := iv.Type()
+
+	switch it.Kind() {
+	case reflect.Map:
+		dstMap := reflect.MakeMap(iv.Type())
+		for key, value := range pathValues.fields {
+			dstKey := reflect.ValueOf(key)
+			dstVal := reflect.New(iv.Type().Elem())
+			err := assignPathValues(dstVal.Interface(), value)
+			if err != nil {
+				return fmt.Errorf("error binding map: %w", err)
+			}
+			dstMap.SetMapIndex(dstKey, dstVal.Elem())
+		}
+		iv.Set(dstMap)
+		return nil
+
+	case reflect.Slice:
+		if tu, ok := dst.(encoding.TextUnmarshaler); ok && len(pathValues.fields) == 0 {
+			// A scalar slice type, such as Base64Bytes.
+			return tu.UnmarshalText([]byte(pathValues.value))
+		}
+		sliceLength := len(pathValues.fields)
+		dstSlice := reflect.MakeSlice(it, sliceLength, sliceLength)
+		err := assignDeepObjectSlice(dstSlice, pathValues)
+		if err != nil {
+			return fmt.Errorf("error assigning slice: %w", err)
+		}
+		iv.Set(dstSlice)
+		return nil
+
+	case reflect.Struct:
+		if dst, isBinder := v.Interface().(ParamBinder); isBinder {
+			return dst.BindParam(pathValues.value)
+		}
+		if dst, isBinder := v.Interface().(Binder); isBinder {
+			return dst.Bind(pathValues.value)
+		}
+
+		if isDate(it) {
+			var date Date
+			var err error
+			date.Time, err = time.Parse(DateFormat, pathValues.value)
+			if err != nil {
+				return fmt.Errorf("invalid date format: %w", err)
+			}
+			dst := iv
+			if it != reflect.TypeOf(Date{}) {
+				ivPtr := iv.Addr()
+				aPtr := ivPtr.Convert(reflect.TypeOf(&Date{}))
+				dst = reflect.Indirect(aPtr)
+			}
+			dst.Set(reflect.ValueOf(date))
+			return nil
+		}
+
+		if it.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+			tm, err := time.Parse(time.RFC3339Nano, pathValues.value)
+			if err != nil {
+				tm, err = time.Parse(DateFormat, pathValues.value)
+				if err != nil {
+					return fmt.Errorf("error parsing '%s' as RFC3339 or date: %w", pathValues.value, err)
+				}
+			}
+			dst := iv
+			if it != reflect.TypeOf(time.Time{}) {
+				ivPtr := iv.Addr()
+				aPtr := ivPtr.Convert(reflect.TypeOf(&time.Time{}))
+				dst = reflect.Indirect(aPtr)
+			}
+			dst.Set(reflect.ValueOf(tm))
+			return nil
+		}
+
+		// Scalar struct types, such as Decimal, parse themselves.
+		if dst, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			return dst.UnmarshalText([]byte(pathValues.value))
+		}
+
+		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
+		if err != nil {
+			return fmt.Errorf("failed enumerating fields: %w", err)
+		}
+		for _, fieldName := range sortedFieldOrValueKeys(pathValues.fields) {
+			fieldValue := pathValues.fields[fieldName]
+			fieldIndex, found := fieldMap[fieldName]
+			if !found {
+				return fmt.Errorf("field [%s] is not present in destination object", fieldName)
+			}
+			field := iv.Field(fieldIndex)
+			err = assignPathValues(field.Addr().Interface(), fieldValue)
+			if err != nil {
+				return fmt.Errorf("error assigning field [%s]: %w", fieldName, err)
+			}
+		}
+		return nil
+
+	case reflect.Ptr:
+		dstVal := reflect.New(it.Elem())
+		dstPtr := dstVal.Interface()
+		err := assignPathValues(dstPtr, pathValues)
+		iv.Set(dstVal)
+		return err
+
+	case reflect.Bool:
+		val, err := strconv.ParseBool(pathValues.value)
+		if err != nil {
+			return fmt.Errorf("expected a valid bool, got %s", pathValues.value)
+		}
+		iv.SetBool(val)
+		return nil
+
+	case reflect.Float32:
+		val, err := strconv.ParseFloat(pathValues.value, 32)
+		if err != nil {
+			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+		}
+		iv.SetFloat(val)
+		return nil
+
+	case reflect.Float64:
+		val, err := strconv.ParseFloat(pathValues.value, 64)
+		if err != nil {
+			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+		}
+		iv.SetFloat(val)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		val, err := strconv.ParseInt(pathValues.value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected a valid int, got %s", pathValues.value)
+		}
+		iv.SetInt(val)
+		return nil
+
+	case reflect.String:
+		iv.SetString(pathValues.value)
+		return nil
+
+	default:
+		return errors.New("unhandled type: " + it.String())
+	}
+}
+
+func assignDeepObjectSlice(dst reflect.Value, pathValues fieldOrValue) error {
+	nValues := len(pathValues.fields)
+	values := make([]fieldOrValue, nValues)
+	for i := 0; i < nValues; i++ {
+		indexStr := strconv.Itoa(i)
+		fv, found := pathValues.fields[indexStr]
+		if !found {
+			return errors.New("array deepObjects must have consecutive indices")
+		}
+		values[i] = fv
+	}
+
+	// Items keep their fields, so arrays of objects bind too.
+	for i := 0; i < nValues; i++ {
+		dstElem := dst.Index(i).Addr()
+		err := assignPathValues(dstElem.Interface(), values[i])
+		if err != nil {
+			return fmt.Errorf("error binding array: %w", err)
+		}
+	}
+	return nil
+}
+
+func sortedFieldOrValueKeys(m map[string]fieldOrValue) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ---------------------------------------------------------------------------
+// Exploded object binding
+// ---------------------------------------------------------------------------
+
+// bindParamsToExplodedObject reflects the destination structure and pulls the
+// value for each settable field from the given query parameters. Returns
+// whether any fields were bound.
+func bindParamsToExplodedObject(paramName string, values url.Values, dest any) (bool, error) {
+	binder, v, t := indirectBinder(dest)
+	if binder != nil {
+		_, found := values[paramName]
+		if !found {
+			return false, nil
+		}
+		return true, BindStringToObject(values.Get(paramName), dest)
+	}
+	if t.Kind() != reflect.Struct {
+		return false, fmt.Errorf("unmarshaling query arg '%s' into wrong type", paramName)
+	}
+
+	fieldsPresent := false
+	for i := 0; i < t.NumField(); i++ {
+		fieldT := t.Field(i)
+		if !v.Field(i).CanSet() {
+			continue
+		}
+
+		tag := fieldT.Tag.Get("json")
+		fieldName := fieldT.Name
+		if tag != "" {
+			tagParts := strings.Split(tag, ",")
+			if tagParts[0] != "" {
+				fieldName = tagParts[0]
+			}
+		}
+
+		fieldVal, found := values[fieldName]
+		if found {
+			if len(fieldVal) != 1 {
+				return false, fmt.Errorf("field '%s' specified multiple times for param '%s'", fieldName, paramName)
+			}
+			err := BindStringToObject(fieldVal[0], v.Field(i).Addr().Interface())
+			if err != nil {
+				return false, fmt.Errorf("could not bind query arg '%s': %w", paramName, err)
+			}
+			fieldsPresent = true
+		}
+	}
+	return fieldsPresent, nil
+}
+
+// indirectBinder checks if dest implements ParamBinder or Binder and returns
+// reflect values.
+func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
+	v := reflect.ValueOf(dest)
+	if v.Type().NumMethod() > 0 && v.CanInterface() {
+		if u, ok := v.Interface().(ParamBinder); ok {
+			return u, reflect.Value{}, nil
+		}
+		if u, ok := v.Interface().(Binder); ok {
+			return u, reflect.Value{}, nil
+		}
+	}
+	v = reflect.Indirect(v)
+	t := v.Type()
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
+		return dest, reflect.Value{}, nil
+	}
+	if isDate(t) {
+		return dest, reflect.Value{}, nil
+	}
+	// Scalar struct types, such as Decimal, parse themselves.
+	if _, ok := dest.(encoding.TextUnmarshaler); ok {
+		return dest, reflect.Value{}, nil
+	}
+	return nil, v, t
+}
+
 // ParamLocation indicates where a parameter is located in an HTTP request.
 type ParamLocation int
 
@@ -1754,11 +3174,67 @@ const (
 	ParamLocationCookie
 )
 
+// Binder is an interface for types that can bind themselves from a string value.
+type Binder interface {
+	Bind(value string) error
+}
+
+// ParamMarshaler is implemented by types which choose their own representation
+// in parameters, such as domain types set with x-go-type. MarshalParam returns
+// the value of a primitive parameter, or of an item of an array or a property
+// of an object; it's styled and escaped like any other string. It takes
+// precedence over encoding.TextMarshaler and reflection.
+type ParamMarshaler interface {
+	MarshalParam() (string, error)
+}
+
+// ParamBinder is the counterpart of ParamMarshaler: BindParam sets the value
+// from the string MarshalParam returned, unescaped and with the style's
+// prefixes and delimiters removed. It takes precedence over Binder,
+// encoding.TextUnmarshaler and reflection.
+type ParamBinder interface {
+	BindParam(value string) error
+}
+
+// MissingRequiredParameterError is returned when a required parameter is not
+// present in the request. Upper layers can use errors.As to detect this and
+// produce an appropriate HTTP error response.
+type MissingRequiredParameterError struct {
+	ParamName string
+}
+
+func (e *MissingRequiredParameterError) Error() string {
+	return fmt.Sprintf("parameter '%s' is required", e.ParamName)
+}
+
 // primitiveToString converts a primitive value to a string representation.
-// It handles basic Go types, time.Time, Date, and types that implement
-// json.Marshaler or fmt.Stringer.
+// It handles basic Go types, time.Time, Date, time.Duration, formatted
+// as a Go duration string such as "1h30m0s", and types that implement
+// ParamMarshaler, json.Marshaler or fmt.Stringer.
 func primitiveToString(value any) (string, error) {
-	// Check for known types first (time, date, uuid)
+	// The common builtin types are formatted without reflection.
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return FormatInt(v), nil
+	case int32:
+		return FormatInt(v), nil
+	case int64:
+		return FormatInt(v), nil
+	case float32:
+		return FormatFloat32(v), nil
+	case float64:
+		return FormatFloat64(v), nil
+	case bool:
+		return FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	case ParamMarshaler:
+		return v.MarshalParam()
+	}
+
+	// Check for known types first (time, date, text marshalers)
 	if res, ok := marshalKnownTypes(value); ok {
 		return res, nil
 	}
@@ -1785,10 +3261,6 @@ func primitiveToString(value any) (string, error) {
 	case reflect.String:
 		return v.String(), nil
 	case reflect.Struct:
-		// Check if it's a UUID
-		if u, ok := value.(uuid.UUID); ok {
-			return u.String(), nil
-		}
 		// Check if it implements json.Marshaler
 		if m, ok := value.(json.Marshaler); ok {
 			buf, err := m.MarshalJSON()
@@ -1812,7 +3284,8 @@ func primitiveToString(value any) (string, error) {
 	}
 }
 
-// marshalKnownTypes checks for special types (time.Time, Date, UUID) and marshals them.
+// marshalKnownTypes checks for special types (time.Time, Date, and text
+// marshalers such as Duration or UUID) and marshals them.
 func marshalKnownTypes(value any) (string, bool) {
 	v := reflect.Indirect(reflect.ValueOf(value))
 	t := v.Type()
@@ -1823,16 +3296,19 @@ func marshalKnownTypes(value any) (string, bool) {
 		return timeVal.Format(time.RFC3339Nano), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(Date{})) {
+	if isDate(t) {
 		d := v.Convert(reflect.TypeOf(Date{}))
 		dateVal := d.Interface().(Date)
 		return dateVal.Format(DateFormat), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(uuid.UUID{})) {
-		u := v.Convert(reflect.TypeOf(uuid.UUID{}))
-		uuidVal := u.Interface().(uuid.UUID)
-		return uuidVal.String(), true
+	// Checked last, as the kind of these types, such as the int64 of a
+	// Duration, would otherwise format them as a plain number. UUIDs, whatever
+	// their library, are formatted this way too, so none is imported here.
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text), true
+		}
 	}
 
 	return "", false
@@ -1901,11 +3377,295 @@ func sortedKeys(m map[string]string) []string {
 	return keys
 }
 
+// parseDuration parses a time.Duration from a Go duration string, such as
+// "1h30m", or an ISO 8601 duration, such as "PT1H30M".
+func parseDuration(src string) (time.Duration, error) {
+	if d, err := time.ParseDuration(src); err == nil {
+		return d, nil
+	}
+	d, err := ParseDuration(src)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %q is neither a Go nor an ISO 8601 duration", src)
+	}
+	return d.TimeDuration(), nil
+}
+
+// BindStringToObject binds a string value to a destination object.
+// It handles primitives, encoding.TextUnmarshaler, and the ParamBinder and
+// Binder interfaces.
+func BindStringToObject(src string, dst any) error {
+	// The common builtin types are parsed without reflection.
+	var err error
+	switch d := dst.(type) {
+	case *string:
+		*d = src
+		return nil
+	case *int:
+		*d, err = ParseInt[int](src)
+		return err
+	case *int32:
+		*d, err = ParseInt[int32](src)
+		return err
+	case *int64:
+		*d, err = ParseInt[int64](src)
+		return err
+	case *float32:
+		*d, err = ParseFloat[float32](src)
+		return err
+	case *float64:
+		*d, err = ParseFloat[float64](src)
+		return err
+	case *bool:
+		*d, err = ParseBool[bool](src)
+		return err
+	case *time.Duration:
+		*d, err = parseDuration(src)
+		return err
+	case ParamBinder:
+		return d.BindParam(src)
+	}
+
+	// Check for TextUnmarshaler
+	if tu, ok := dst.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(src))
+	}
+
+	// Check for Binder interface
+	if b, ok := dst.(Binder); ok {
+		return b.Bind(src)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr {
+		return fmt.Errorf("dst must be a pointer, got %T", dst)
+	}
+	v = v.Elem()
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(src)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(src, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse int: %w", err)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(src, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse uint: %w", err)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(src, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse float: %w", err)
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(src)
+		if err != nil {
+			return fmt.Errorf("failed to parse bool: %w", err)
+		}
+		v.SetBool(b)
+	default:
+		// Try JSON unmarshal as a fallback
+		return json.Unmarshal([]byte(src), dst)
+	}
+	return nil
+}
+
+// bindSplitPartsToDestinationArray binds a slice of string parts to a destination slice.
+func bindSplitPartsToDestinationArray(parts []string, dest any) error {
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	t := v.Type()
+
+	newArray := reflect.MakeSlice(t, len(parts), len(parts))
+	for i, p := range parts {
+		err := BindStringToObject(p, newArray.Index(i).Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("error setting array element: %w", err)
+		}
+	}
+	v.Set(newArray)
+	return nil
+}
+
+// bindSplitPartsToDestinationStruct binds string parts to a destination struct via JSON.
+// Properties are typed after the fields of dest, so that numbers and booleans
+// bind to numeric and boolean fields rather than failing as strings.
+func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode bool, dest any) error {
+	var keys, values []string
+	if explode {
+		for _, property := range parts {
+			key, value, found := strings.Cut(property, "=")
+			if !found {
+				return fmt.Errorf("parameter '%s' has invalid exploded format", paramName)
+			}
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+	} else {
+		if len(parts)%2 != 0 {
+			return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
+		}
+		for i := 0; i < len(parts); i += 2 {
+			keys = append(keys, parts[i])
+			values = append(values, parts[i+1])
+		}
+	}
+
+	t := reflect.TypeOf(dest)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]string, len(keys))
+	for i, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		value, err := propertyJSON(values[i], propertyKind(t, key))
+		if err != nil {
+			return err
+		}
+		fields[i] = string(name) + ":" + value
+	}
+	jsonParam := "{" + strings.Join(fields, ",") + "}"
+	return json.Unmarshal([]byte(jsonParam), dest)
+}
+
+// propertyKind returns the kind of the property named key of objects of type
+// t: of the field whose JSON name is key in a struct, or of the values of a
+// map, looking through pointers. It returns reflect.Invalid for unknown
+// properties.
+func propertyKind(t reflect.Type, key string) reflect.Kind {
+	var pt reflect.Type
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() && getFieldName(f) == key {
+				pt = f.Type
+				break
+			}
+		}
+	case reflect.Map:
+		pt = t.Elem()
+	}
+	if pt == nil {
+		return reflect.Invalid
+	}
+	for pt.Kind() == reflect.Ptr {
+		pt = pt.Elem()
+	}
+	return pt.Kind()
+}
+
+// propertyJSON returns value as a JSON value for a property of kind k: a bare
+// number or boolean when k is numeric or boolean and value parses as one, and
+// a string otherwise.
+func propertyJSON(value string, k reflect.Kind) (string, error) {
+	var err error
+	switch k {
+	case reflect.Bool:
+		if value != "true" && value != "false" {
+			err = strconv.ErrSyntax
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		err = strconv.ErrSyntax
+	}
+	if err == nil && json.Valid([]byte(value)) {
+		return value, nil
+	}
+	buf, err := json.Marshal(value)
+	return string(buf), err
+}
+
+// isObjectType reports whether values of type t, looking through pointers,
+// are styled as objects: structs and maps, except for times, dates and types
+// which marshal themselves as parameters or text.
+func isObjectType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+		return false
+	}
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) || isDate(t) {
+		return false
+	}
+	for _, i := range []reflect.Type{
+		reflect.TypeOf((*ParamMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*ParamBinder)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDate reports whether t is Date, or a type defined from it. Other
+// types with the same underlying type which parse themselves, such as
+// Time, aren't dates.
+func isDate(t reflect.Type) bool {
+	dateType := reflect.TypeOf(Date{})
+	if t == dateType {
+		return true
+	}
+	return t.ConvertibleTo(dateType) && !reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
 // isByteSlice reports whether t is []byte (or equivalently []uint8).
 func isByteSlice(t reflect.Type) bool {
 	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
 }
 
+// base64Decode decodes s as base64.
+//
+// Per OpenAPI 3.0, format: byte uses RFC 4648 Section 4 (standard alphabet,
+// padded). We use padding presence to select the right decoder, rather than
+// blindly cascading (which can produce corrupt output when RawStdEncoding
+// silently accepts padded input and treats '=' as data).
+func base64Decode(s string) ([]byte, error) {
+	if s == "" {
+		return []byte{}, nil
+	}
+
+	if strings.ContainsRune(s, '=') {
+		if strings.ContainsAny(s, "-_") {
+			return base64Decode1(base64.URLEncoding, s)
+		}
+		return base64Decode1(base64.StdEncoding, s)
+	}
+
+	if strings.ContainsAny(s, "-_") {
+		return base64Decode1(base64.RawURLEncoding, s)
+	}
+	return base64Decode1(base64.RawStdEncoding, s)
+}
+
+func base64Decode1(enc *base64.Encoding, s string) ([]byte, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to base64-decode string %q: %w", s, err)
+	}
+	return b, nil
+}
+
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
 // ParameterOptions carries OpenAPI parameter metadata to bind and style
 // functions so they can handle style dispatch, explode, required,
 // type-aware coercions, and location-aware escaping from a single
@@ -1920,6 +3680,122 @@ type ParameterOptions struct {
 	AllowReserved bool   // When true, reserved characters in query values are not percent-encoded
 }
 
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseString parses a parameter value of a string type.
+func ParseString[T ~string](src string) (T, error) {
+	return T(src), nil
+}
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseFloat parses a parameter value of a floating-point type.
+func ParseFloat[T ~float32 | ~float64](src string) (T, error) {
+	f, err := strconv.ParseFloat(src, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float: %w", err)
+	}
+	return T(f), nil
+}
+
+// ParseBool parses a parameter value of a boolean type.
+func ParseBool[T ~bool](src string) (T, error) {
+	b, err := strconv.ParseBool(src)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse bool: %w", err)
+	}
+	return T(b), nil
+}
+
+// FormatString formats a parameter value of a string type.
+func FormatString[T ~string](v T) string {
+	return string(v)
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// FormatFloat32 formats a parameter value of a float32 type, with the fewest
+// digits which parse back to it.
+func FormatFloat32[T ~float32](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// FormatFloat64 formats a parameter value of a float64 type, with the fewest
+// digits which parse back to it.
+func FormatFloat64[T ~float64](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 64)
+}
+
+// FormatBool formats a parameter value of a boolean type.
+func FormatBool[T ~bool](v T) string {
+	return strconv.FormatBool(bool(v))
+}
+
+// StylePrimitiveParameter serializes a parameter of a primitive type like
+// StyleParameter, formatting value with format, such as FormatInt[int32],
+// rather than by reflection.
+func StylePrimitiveParameter[T any](paramName string, value T, opts ParameterOptions, format func(T) string) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+	return styleString(style, paramName, opts.ParamLocation, opts.AllowReserved, format(value))
+}
+
+// BindPrimitiveQueryParameter binds a query parameter of a primitive type like
+// BindQueryParameter, parsing its value with parse, such as ParseInt[int32],
+// rather than by reflection. dest is the field of the parameter, a pointer
+// when the parameter is optional.
+func BindPrimitiveQueryParameter[T any, D *T | **T](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error)) error {
+	switch opts.Style {
+	case "", "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	values := queryParams[paramName]
+	switch {
+	case len(values) == 0:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	case len(values) != 1 && opts.Explode:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	case len(values) != 1:
+		return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+
+	v, err := parse(values[0])
+	if err != nil {
+		return err
+	}
+	switch d := any(dest).(type) {
+	case *T:
+		*d = v
+	case **T:
+		*d = &v
+	}
+	return nil
+}
+
 // StyleParameter serializes a Go value into an OpenAPI-styled parameter string.
 // This is the entry point for client-side parameter serialization. The Style
 // field in opts selects the serialization format. If Style is empty, "simple"
@@ -1942,11 +3818,26 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		t = v.Type()
 	}
 
+	// A time.Duration is sent as a Go duration string, such as "1h30m0s",
+	// unless the schema asks for format: duration, which is ISO 8601.
+	if d, ok := v.Interface().(time.Duration); ok && opts.Format == "duration" {
+		value = Duration(d)
+	}
+
+	// Types which choose their own representation are styled as primitives.
+	if m, ok := value.(ParamMarshaler); ok {
+		str, err := m.MarshalParam()
+		if err != nil {
+			return "", fmt.Errorf("error marshaling parameter '%s': %w", paramName, err)
+		}
+		return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, str)
+	}
+
 	// If the value implements encoding.TextMarshaler, use it — but not for
 	// time.Time or Date which have their own formatting logic.
 	if tu, ok := value.(encoding.TextMarshaler); ok {
 		it := reflect.Indirect(reflect.ValueOf(value)).Type()
-		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !it.ConvertibleTo(reflect.TypeOf(Date{})) {
+		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !isDate(it) {
 			b, err := tu.MarshalText()
 			if err != nil {
 				return "", fmt.Errorf("error marshaling '%s' as text: %w", value, err)
@@ -1966,6 +3857,9 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		for i := 0; i < n; i++ {
 			sliceVal[i] = v.Index(i).Interface()
 		}
+		if style == "simple" && isObjectType(t.Elem()) {
+			return styleObjectSlice(paramName, sliceVal, opts)
+		}
 		return styleSlice(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, sliceVal)
 	case reflect.Struct:
 		return styleStruct(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
@@ -1976,6 +3870,20 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 	}
 }
 
+// StyleCookieParam serializes value as the value of a cookie parameter, in
+// the style of opts, which is form for cookies. The "name=" the form style
+// starts with is left out, as the cookie is named after the parameter already:
+// a primitive is sent as "5", an array as "3,4,5", and an exploded array as
+// "3&id=4&id=5", so that the cookie header reads id=3&id=4&id=5.
+func StyleCookieParam(paramName string, value any, opts ParameterOptions) (string, error) {
+	opts.ParamLocation = ParamLocationCookie
+	styled, err := StyleParameter(paramName, value, opts)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(styled, paramName+"="), nil
+}
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -1985,7 +3893,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(values, paramName)
+		return StyleDeepObjectParam(paramName, values)
 	}
 
 	var prefix string
@@ -2022,7 +3930,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if explode {
 			separator = "&" + prefix
 		} else {
-			separator = " "
+			separator = "%20"
 		}
 	case "pipeDelimited":
 		prefix = fmt.Sprintf("%s=", escapedName)
@@ -2046,6 +3954,21 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 	return prefix + strings.Join(parts, separator), nil
 }
 
+// styleObjectSlice serializes an array of objects in the simple style, which
+// OpenAPI leaves undefined: each object is styled on its own, as "id,1,name,a"
+// or exploded as "id=1,name=a", and the objects are separated by semicolons.
+func styleObjectSlice(paramName string, values []any, opts ParameterOptions) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		part, err := StyleParameter(paramName, v, opts)
+		if err != nil {
+			return "", fmt.Errorf("error formatting item %d of '%s': %w", i, paramName, err)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ";"), nil
+}
+
 func styleStruct(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
 	if timeVal, ok := marshalKnownTypes(value); ok {
 		return stylePrimitive(style, explode, paramName, paramLocation, allowReserved, timeVal)
@@ -2055,7 +3978,7 @@ func styleStruct(style string, explode bool, paramName string, paramLocation Par
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 
 	// If input implements json.Marshaler (e.g. objects with additional properties
@@ -2116,7 +4039,7 @@ func styleMap(style string, explode bool, paramName string, paramLocation ParamL
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 	v := reflect.ValueOf(value)
 
@@ -2179,6 +4102,17 @@ func processFieldDict(style string, explode bool, paramName string, paramLocatio
 			prefix = fmt.Sprintf("%s=", escapedName)
 			separator = ","
 		}
+	case "spaceDelimited", "pipeDelimited":
+		// Exploded, these are the form style: one key per property.
+		if explode {
+			separator = "&"
+		} else {
+			prefix = fmt.Sprintf("%s=", escapedName)
+			separator = "%20"
+			if style == "pipeDelimited" {
+				separator = "|"
+			}
+		}
 	case "deepObject":
 		if !explode {
 			return "", fmt.Errorf("deepObject parameters must be exploded")
@@ -2201,7 +4135,11 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 	if err != nil {
 		return "", err
 	}
+	return styleString(style, paramName, paramLocation, allowReserved, strVal)
+}
 
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
 	escapedName := escapeParameterName(paramName, paramLocation)
 
 	var prefix string
@@ -2211,7 +4149,9 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 		prefix = "."
 	case "matrix":
 		prefix = fmt.Sprintf(";%s=", escapedName)
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
 		prefix = fmt.Sprintf("%s=", escapedName)
 	default:
 		return "", fmt.Errorf("unsupported style '%s'", style)
@@ -2223,40 +4163,36 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 // Deep object marshaling
 // ---------------------------------------------------------------------------
 
-// MarshalDeepObject marshals an object to deepObject style query parameters.
-func MarshalDeepObject(i any, paramName string) (string, error) {
-	buf, err := json.Marshal(i)
+// StyleDeepObjectParam serializes value as an exploded deepObject query
+// parameter, such as "filter[status]=open&filter[created][gte]=2024-01-01".
+// value is marshaled to JSON first, so struct fields are named by their json
+// tags: objects nest in brackets, array items are indexed from 0, and null
+// members are left out. Names and values are query escaped, and numbers keep
+// their JSON form.
+func StyleDeepObjectParam(paramName string, value any) (string, error) {
+	buf, err := json.Marshal(value)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
 	}
-	var i2 any
-	err = json.Unmarshal(buf, &i2)
-	if err != nil {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
 		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
-	fields, err := marshalDeepObjectRecursive(i2, nil)
-	if err != nil {
-		return "", fmt.Errorf("error traversing JSON structure: %w", err)
-	}
-
-	for idx := range fields {
-		fields[idx] = paramName + fields[idx]
-	}
+	var fields []string
+	styleDeepObjectRecursive(url.QueryEscape(paramName), v, &fields)
 	return strings.Join(fields, "&"), nil
 }
 
-func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
-	var result []string
-
-	switch t := in.(type) {
+// styleDeepObjectRecursive appends the key=value pairs of v, whose escaped
+// key is key, to fields.
+func styleDeepObjectRecursive(key string, v any, fields *[]string) {
+	switch t := v.(type) {
+	case nil:
 	case []any:
-		for i, iface := range t {
-			newPath := append(path, strconv.Itoa(i))
-			fields, err := marshalDeepObjectRecursive(iface, newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing array: %w", err)
-			}
-			result = append(result, fields...)
+		for i, item := range t {
+			styleDeepObjectRecursive(key+"["+strconv.Itoa(i)+"]", item, fields)
 		}
 	case map[string]any:
 		keys := make([]string, 0, len(t))
@@ -2264,20 +4200,190 @@ func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
-
 		for _, k := range keys {
-			newPath := append(path, k)
-			fields, err := marshalDeepObjectRecursive(t[k], newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing map: %w", err)
-			}
-			result = append(result, fields...)
+			styleDeepObjectRecursive(key+"["+url.QueryEscape(k)+"]", t[k], fields)
 		}
 	default:
-		prefix := "[" + strings.Join(path, "][") + "]"
-		result = []string{
-			prefix + fmt.Sprintf("=%v", t),
+		*fields = append(*fields, key+"="+url.QueryEscape(fmt.Sprint(t)))
+	}
+}
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// DecodeError is an error decoding a JSON document, located by the JSON
+// Pointer of the value which failed to decode.
+type DecodeError struct {
+	// Pointer locates the failing value; "" is the whole document.
+	Pointer string
+	// Offset is the byte offset in the document the decoder failed at.
+	Offset int64
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	at := e.Pointer
+	if at == "" {
+		at = "document root"
+	}
+	return "decoding JSON at " + at + ": " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WrapDecodeError locates err, returned decoding data, in a *DecodeError.
+// Syntax and type errors carry the offset the decoder failed at; other
+// errors, such as those of UnmarshalJSON methods, are returned as is, as is
+// nil.
+func WrapDecodeError(data []byte, err error) error {
+	var offset, scanned int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &syntaxErr):
+		// The offset of a syntax error counts the invalid byte, which may
+		// close the value it belongs in.
+		offset, scanned = syntaxErr.Offset, syntaxErr.Offset-1
+	case errors.As(err, &typeErr):
+		offset, scanned = typeErr.Offset, typeErr.Offset
+	default:
+		return err
+	}
+	return &DecodeError{Pointer: PointerAtOffset(data, scanned), Offset: offset, Err: err}
+}
+
+// DecodeJSON is json.Unmarshal, returning errors located by WrapDecodeError.
+func DecodeJSON(data []byte, v any) error {
+	return WrapDecodeError(data, json.Unmarshal(data, v))
+}
+
+// EscapePointerToken escapes a reference token for use in a JSON Pointer, as
+// RFC 6901 requires: "~" becomes "~0" and "/" becomes "~1".
+func EscapePointerToken(token string) string {
+	if !strings.ContainsAny(token, "~/") {
+		return token
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// pointerFrame is an object or array enclosing the position PointerAtOffset
+// scans to.
+type pointerFrame struct {
+	array bool
+	// index is the index of the current element of an array, or -1 before
+	// the first one.
+	index int
+	// key is the key of the current member of an object, and inValue whether
+	// the scan is past its colon.
+	key     string
+	inValue bool
+}
+
+// PointerAtOffset returns the JSON Pointer of the innermost value of data
+// which the first offset bytes end in, as reported by the Offset of a
+// *json.SyntaxError or *json.UnmarshalTypeError. data need not be valid past
+// offset.
+func PointerAtOffset(data []byte, offset int64) string {
+	offset = min(max(offset, 0), int64(len(data)))
+	var stack []pointerFrame
+	for i := int64(0); i < offset; i++ {
+		switch c := data[i]; c {
+		case '{', '[':
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+			stack = append(stack, pointerFrame{array: c == '[', index: -1})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ':':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = true
+			}
+		case ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].inValue = false
+			}
+		case '"':
+			end := stringEnd(data, i)
+			if len(stack) > 0 {
+				top := &stack[len(stack)-1]
+				if !top.array && !top.inValue {
+					top.key = unquoteKey(data[i:min(end, int64(len(data)))])
+				} else {
+					startValue(top)
+				}
+			}
+			i = end - 1
+		case ' ', '\t', '\r', '\n':
+		default:
+			// A literal: a number, true, false or null.
+			if len(stack) > 0 {
+				startValue(&stack[len(stack)-1])
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, frame := range stack {
+		switch {
+		case frame.array && frame.index >= 0:
+			b.WriteString("/" + strconv.Itoa(frame.index))
+		case !frame.array && frame.inValue:
+			b.WriteString("/" + EscapePointerToken(frame.key))
+		default:
+			// The scan stopped within the container itself.
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// startValue notes that a value starts in frame, moving arrays on to their
+// next element.
+func startValue(frame *pointerFrame) {
+	if frame.array && !frame.inValue {
+		frame.index++
+		frame.inValue = true
+	}
+}
+
+// stringEnd returns the offset just past the string starting at data[start],
+// or len(data) if it is unterminated.
+func stringEnd(data []byte, start int64) int64 {
+	for i := start + 1; i < int64(len(data)); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
 		}
 	}
-	return result, nil
+	return int64(len(data))
+}
+
+// unquoteKey decodes a quoted object key, falling back to its raw text when
+// it isn't valid JSON.
+func unquoteKey(quoted []byte) string {
+	var key string
+	if err := json.Unmarshal(quoted, &key); err != nil {
+		return strings.Trim(string(quoted), `"`)
+	}
+	return key
 }
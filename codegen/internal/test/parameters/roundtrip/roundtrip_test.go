@@ -203,16 +203,11 @@ func testImpl(t *testing.T, handler http.Handler) {
 
 		t.Run("content-based", func(t *testing.T) {
 			t.Run("json complex object", func(t *testing.T) {
-				// V3 client generates string param for content-based path params.
-				// Serialize the object to JSON string for the client.
-				jsonBytes, err := json.Marshal(expectedComplexObject)
+				req, err := client.NewGetContentObjectRequest(server, expectedComplexObject)
 				require.NoError(t, err)
-				req, err := client.NewGetContentObjectRequest(server, string(jsonBytes))
-				require.NoError(t, err)
-				var got string
+				var got client.ComplexObject
 				doRoundTrip(t, req, &got)
-				// The server echoes the string param, so we compare JSON strings.
-				assert.JSONEq(t, string(jsonBytes), got)
+				assert.Equal(t, expectedComplexObject, got)
 			})
 
 			t.Run("passthrough string", func(t *testing.T) {
@@ -284,6 +279,16 @@ func testImpl(t *testing.T, handler http.Handler) {
 				assert.Equal(t, expectedPrimitiveString, *got.Ps)
 			})
 
+			t.Run("json object", func(t *testing.T) {
+				params := client.GetQueryFormParams{Co: &expectedComplexObject}
+				req, err := client.NewGetQueryFormRequest(server, &params)
+				require.NoError(t, err)
+				var got client.GetQueryFormParams
+				doRoundTrip(t, req, &got)
+				require.NotNil(t, got.Co)
+				assert.Equal(t, expectedComplexObject, *got.Co)
+			})
+
 			t.Run("exploded object only", func(t *testing.T) {
 				params := client.GetQueryFormParams{Eo: &expectedObject}
 				req, err := client.NewGetQueryFormRequest(server, &params)
@@ -305,6 +310,43 @@ func testImpl(t *testing.T, handler http.Handler) {
 			})
 		})
 
+		t.Run("delimited", func(t *testing.T) {
+			expectedStrings := []string{"a,b", "c;d"}
+
+			t.Run("space delimited array", func(t *testing.T) {
+				params := client.GetQueryDelimitedParams{Sa: &expectedArray}
+				req, err := client.NewGetQueryDelimitedRequest(server, &params)
+				require.NoError(t, err)
+				assert.Equal(t, "sa=3+4+5", req.URL.RawQuery)
+				var got client.GetQueryDelimitedParams
+				doRoundTrip(t, req, &got)
+				require.NotNil(t, got.Sa)
+				assert.Equal(t, expectedArray, *got.Sa)
+			})
+
+			t.Run("pipe delimited array", func(t *testing.T) {
+				params := client.GetQueryDelimitedParams{Pa: &expectedStrings}
+				req, err := client.NewGetQueryDelimitedRequest(server, &params)
+				require.NoError(t, err)
+				var got client.GetQueryDelimitedParams
+				doRoundTrip(t, req, &got)
+				require.NotNil(t, got.Pa)
+				assert.Equal(t, expectedStrings, *got.Pa)
+			})
+
+			t.Run("delimited objects", func(t *testing.T) {
+				params := client.GetQueryDelimitedParams{So: &expectedObject, Po: &expectedObject}
+				req, err := client.NewGetQueryDelimitedRequest(server, &params)
+				require.NoError(t, err)
+				var got client.GetQueryDelimitedParams
+				doRoundTrip(t, req, &got)
+				require.NotNil(t, got.So)
+				assert.Equal(t, expectedObject, *got.So)
+				require.NotNil(t, got.Po)
+				assert.Equal(t, expectedObject, *got.Po)
+			})
+		})
+
 		t.Run("deepObject", func(t *testing.T) {
 			params := client.GetDeepObjectParams{DeepObj: expectedComplexObject}
 			req, err := client.NewGetDeepObjectRequest(server, &params)
@@ -374,6 +416,16 @@ func testImpl(t *testing.T, handler http.Handler) {
 			require.NotNil(t, got.XObject)
 			assert.Equal(t, expectedObject, *got.XObject)
 		})
+
+		t.Run("json object only", func(t *testing.T) {
+			params := client.GetHeaderParams{XComplexObject: &expectedComplexObject}
+			req, err := client.NewGetHeaderRequest(server, &params)
+			require.NoError(t, err)
+			var got client.GetHeaderParams
+			doRoundTrip(t, req, &got)
+			require.NotNil(t, got.XComplexObject)
+			assert.Equal(t, expectedComplexObject, *got.XComplexObject)
+		})
 	})
 
 	// =========================================================================
@@ -432,5 +484,58 @@ func testImpl(t *testing.T, handler http.Handler) {
 			require.NotNil(t, got.O)
 			assert.Equal(t, expectedObject, *got.O)
 		})
+
+		t.Run("json object only", func(t *testing.T) {
+			params := client.GetCookieParams{Co: &expectedComplexObject}
+			req, err := client.NewGetCookieRequest(server, &params)
+			require.NoError(t, err)
+			var got client.GetCookieParams
+			doRoundTrip(t, req, &got)
+			require.NotNil(t, got.Co)
+			assert.Equal(t, expectedComplexObject, *got.Co)
+		})
+
+		t.Run("wire format", func(t *testing.T) {
+			params := client.GetCookieParams{P: &expectedPrimitive, A: &expectedArray, Ea: &expectedArray2}
+			req, err := client.NewGetCookieRequest(server, &params)
+			require.NoError(t, err)
+			// Cookies carry the bare form-styled values, without a second
+			// "name=" prefix. net/http quotes values holding commas.
+			assert.Equal(t, `p=5; ea=6&ea=7&ea=8; a="3,4,5"`, req.Header.Get("Cookie"))
+		})
 	})
 }
+
+func TestParamsURLValuesRoundTrip(t *testing.T) {
+	ea := []int32{3, 4, 5}
+	a := []int32{6, 7}
+	var ep, p int32 = 1, 2
+	ps := "passed through"
+	co := client.ComplexObject{Object: client.Object{FirstName: "Kim", Role: "admin"}, ID: 9}
+	params := client.GetQueryFormParams{
+		Ea: &ea,
+		A:  &a,
+		Eo: &client.Object{FirstName: "Alex", Role: "admin"},
+		O:  &client.Object{FirstName: "Sam", Role: "user"},
+		Ep: &ep,
+		P:  &p,
+		Ps: &ps,
+		Co: &co,
+	}
+
+	values, err := params.ToURLValues()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5"}, values["ea"])
+	assert.Equal(t, "6,7", values.Get("a"))
+	assert.Equal(t, "passed through", values.Get("ps"))
+	assert.JSONEq(t, `{"Object":{"firstName":"Kim","role":"admin"},"Id":9,"IsAdmin":false}`, values.Get("co"))
+
+	var got client.GetQueryFormParams
+	require.NoError(t, got.FromURLValues(values))
+	assert.Equal(t, params, got)
+}
+
+func TestParamsFromURLValues_Required(t *testing.T) {
+	var params client.GetDeepObjectParams
+	assert.Error(t, params.FromURLValues(nil))
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"sync"
 
+	oapiCodegenJSONPointerPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/jsonpointer"
 	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
 )
 
@@ -39,25 +40,27 @@ func (s *ComplexObject) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9xazY7bNhC++ykIt0CAAP5JclNOi7RFF0jTbZpDrlxxvGYqkQxJB7so+u6FRNkmJdki",
-	"ZdOSfVtRHHLmm79P4+UCGBY0QdN38+X8zXRC2YonE4R+gFSUswS9mS/nywlCmuoMEvSAJc5Bg0Sf+YYR",
-	"LalAX0DpCUIZTYEpKKQRYjiHBN0JnK5h9na+nAis16p4t1A0Fxk8SJpTTX/A4l9RnPmfkXsCbf5AiAuQ",
-	"WFPO7klSrP/tylW7xFYhtZVDaFZdX77brSJEWbGm19aShO8bKoEkSMsNWC+UfinsNcra6+kacpxYKwjp",
-	"FwEJokzDE0jnzYrLHOvy3bu3k+2VSnCmwFL41dvl8pV95s8SVgma/rRIeS44A6bVYie3MEh8rp6ne1A/",
-	"8V+fRcYJ3EmJXwKRdYUHgheMCgla4Uz54Y4tdStFNOTK3XrMSRd3U4uTXvt6aUw+qu+/IRftkuHPx2+Q",
-	"6r6pZKSvIZfacDL71MJYMb1YYjiYh2bGOCD3SI0xId63G9fFr6YiDdqsM/wImQX53BPzj47cZbAudT2t",
-	"QQ+PdTPK57615WOb/MDQX1GU1xlpUKgPwUjPEO/XxHbs9HBcFJgaY/DQTdJRN4u2zKhfGl2SGp2URwNT",
-	"IzspXMQDs2IUgI+fiuZYS/psdef3lHQD/Ycr5YEyJadBbPS8YjJkDGiyofeUvPYFvAcXiof7dTAho3+D",
-	"CvkHeTARGkOkX1OXdRJj75/QpBjePTfJgWr5s2vJPRLIuyMPm0GjaMhNuEPzYQRoj5/9pJxpYDpw0PzB",
-	"lorMMCsNXdSwEBlNS5UW3xRn9crRhnQX2h94gc7zBUAXWKkva8k3T2tvyB/2MkMAruFZL0SGqTfUpngr",
-	"LSl7ignm9w3Il9+4zDsh/Gu70wNAwJZOBFQqqdDlb9JVcpNGEyswLpVpBblReE3tWO3VGVUr3SNxEIio",
-	"9g/O9KxQ4J2hwO1CGI5FnH6xt+CgAefS+7yswoJedEIvap+CcdEP+56z6vEhO86o/vnHAJb+qtMAt9T3",
-	"KAgH1a0dvNUpPRjWqenkp4b3DTKP0vhfAIS5q7Nl7rd69ExiNh/ySvE61CXthJvUtTpnEfV1BoEV3mT6",
-	"JHesAROQnV74vdzm4YGvs4eWeuJVcNb2HSOoOJYps+qDjnTXIGju7GtcxG7wdVbOajrtOko1o9oUnVRV",
-	"EIRwy7gRegGLTUnx93prrYzk9j7sbGtRELuM5cV+BlTFvsOQo3wiwKDREYpz9LCU838oeIyMim0+o4vQ",
-	"zpXaJ4+gc53w0dLHlIh9qucQJKoV45p3xI290Y42Ink4/mwjlr/6aN7z+zXAhNvrN/tNxRnV9eY4+4sW",
-	"ISGLBqSpfbvkGdhXt04XVlQq/alw0KRjll2hbvm1uMB63B1VrjkYHVPT3REaXfekqXi9NNyrO5K7k3yz",
-	"8ZHzDDA7YmKNKM3QPbEfzMGThvNdP24PdIL+rvrP6p2oG/6NWG7/RaJzivR/AAAA///CjtKydTYAAA==",
+	"H4sIAAAAAAAC/9xbzY7bNhC+6ykGboEAAfyT5KacFmmLLpCm2zSHXLnieM1UEhmSDnZR9N0LSZZNSZZF",
+	"yqIl+7YSOdTMNz/fcIzlAlMiWAizd4vV4s0sYOmahwHAD5SK8TSEN4vVYhUAaKZjDOGBSJKgRgmf+Tal",
+	"WjIBX1DpACBmEaYKM2mAlCQYwp0g0QbnbxerQBC9UdnaUrFExPggWcI0+4HLf0V25n+F3BPq4g8ALlAS",
+	"zXh6T8Ps/d9VuQAAAECUCqlSDmC++3y+tn8LwNLsnd4YryR+3zKJNAQtt2gsKP2S2Vsoa76PNpiQ0HgD",
+	"oF8EhsBSjU8oKytrLhOi87V3b4Pyk0rwVKGh8Ku3q9WrwyPAzxLXIcx+WkY8ETzFVKvlXm5ZIPF59zw7",
+	"gPqJ//osYk7xTkry4ohsVXgkeLFQIYQ1iZUd7sRQFwAAgGlMVHXrKSdd3E1HnPTa1ktT8lF9/w25aJ8M",
+	"fz5+w0j3TaVC+hpy6RhOxT61LKyYXSwxKpi7ZsY0ILdIjSkh3peN6+JXU5FGJeuYPGJsQL6wxPxjRe4y",
+	"WOe6nkfQ42PdjPKFbW35eEx+ZOivKMrrHalTqI/RkQ4Q79fU7ZjpUXGRY2pMwUM32Y5Ws6jsjPql0SVb",
+	"o7PyaOTWyEyKKuKOWTEJwKffiiZES/ZssPN7RruB/qMqZYEyo+dBXOh5xc1QYUCzG3rP6GtbwHv0Qv5w",
+	"v45OqNC/0QrZB7lzIzSFSL8mlq0kxsE/rkkxvntusgeq5c+eknskkDUjj5tBkyDkJtyu+TABtKff/UQ8",
+	"1Zhqx0HzB1PKc4e507CKGhEiZlGu0vKb4ml19TjSXWh/4Bk6zxcAXRClvmwk3z5trCF/OMiMAbjGZ70U",
+	"MWHWUBfFW2nJ0iefYH7fonz5jcukE8K/yp0WACLZvwKgqCLJhM5/k94lN22QWIZxrsxRkBuFt6gd64M6",
+	"k6LSAxKtQHi1f/ROzwgF3hkK3CyE7lj44YuDBa0GDKX3sF2FAb3ohF7UroJ+0Xe7zxn1uM2OAdUffgxg",
+	"6K86DaiW+h4FoVXd2sGlTlFrWEcFk58b3jfYeeTG/4Jx5jOkdoy5325Bm6qVLZQgEQItzxqGPfJD6/pN",
+	"lUdEKzaCicGhyc4cA5mWZFXcNjDOTNpBI6MPZwlu6eZhDB3EzyNfQndVCUXxrc6ydNhqUZJosbnNKdmy",
+	"qyuOjwFoXashWztbiqC4JttYn+WODRKKstMLv+fbLDzwdf5wpMuxaoM25jcm0AcZpsx3YybaaRNgc2df",
+	"4zz2qF/n+QS5066TF2CvNnmn6B0ELjdevxF6AYuLkmLv9aO10pPb+/BvaZHTndeXF/sZsCv2HYacvOU4",
+	"GDS5a84QHBZx/g9Di0F2ts1moOrKXJF58gSY64xRSh9TPPJUz9GsVyumNYX1G3uTHbh68rD/iasvf/XR",
+	"vOdUzcGE2+Obw6YwKFXN/wQwb7QAQmYEpJn5dcljDIN63tTGKGsmlf6UOej0zj3qhl+zDxiP+6MCAIAK",
+	"RqfUrO5wja57GgZdpeFe3dGEpc2Nj5zHSNITJtYapTncU/OhODhoOL/qxzAow8EI+rvd/3vsRavh34jl",
+	"47+Tds62/x8AhIO6tQs7AAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -103,7 +106,7 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 type ServerInterface interface {
 
 	// (GET /contentObject/{param})
-	GetContentObject(w http.ResponseWriter, r *http.Request, param string)
+	GetContentObject(w http.ResponseWriter, r *http.Request, param ComplexObject)
 
 	// (GET /cookie)
 	GetCookie(w http.ResponseWriter, r *http.Request, params GetCookieParams)
@@ -153,6 +156,9 @@ type ServerInterface interface {
 	// (GET /queryDeepObject)
 	GetDeepObject(w http.ResponseWriter, r *http.Request, params GetDeepObjectParams)
 
+	// (GET /queryDelimited)
+	GetQueryDelimited(w http.ResponseWriter, r *http.Request, params GetQueryDelimitedParams)
+
 	// (GET /queryForm)
 	GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams)
 
@@ -190,7 +196,7 @@ type GetCookieParams struct {
 	// o (cookie)
 	O *Object
 	// co (cookie)
-	Co *string
+	Co *ComplexObject
 }
 
 // GetHeaderParams defines parameters for GetHeader.
@@ -208,7 +214,7 @@ type GetHeaderParams struct {
 	// X-Object (header)
 	XObject *Object
 	// X-Complex-Object (header)
-	XComplexObject *string
+	XComplexObject *ComplexObject
 }
 
 // GetDeepObjectParams defines parameters for GetDeepObject.
@@ -217,6 +223,112 @@ type GetDeepObjectParams struct {
 	DeepObj ComplexObject `form:"deepObj" json:"deepObj"`
 }
 
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetDeepObjectParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if frag, err := oapiCodegenParamsPkg.StyleDeepObjectParam("deepObj", p.DeepObj); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(frag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			values[k] = append(values[k], v...)
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetDeepObjectParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindDeepObjectParam("deepObj", values, &p.DeepObj, true); err != nil {
+		return fmt.Errorf("invalid format for query parameter deepObj: %w", err)
+	}
+	return nil
+}
+
+// GetQueryDelimitedParams defines parameters for GetQueryDelimited.
+type GetQueryDelimitedParams struct {
+	// sa (optional)
+	Sa *[]int32 `form:"sa" json:"sa"`
+	// pa (optional)
+	Pa *[]string `form:"pa" json:"pa"`
+	// so (optional)
+	So *Object `form:"so" json:"so"`
+	// po (optional)
+	Po *Object `form:"po" json:"po"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetQueryDelimitedParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Sa != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("sa", *p.Sa, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Pa != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("pa", *p.Pa, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.So != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("so", *p.So, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Po != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("po", *p.Po, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetQueryDelimitedParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindQueryParameter("sa", values, &p.Sa, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter sa: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("pa", values, &p.Pa, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter pa: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("so", values, &p.So, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter so: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("po", values, &p.Po, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter po: %w", err)
+	}
+	return nil
+}
+
 // GetQueryFormParams defines parameters for GetQueryForm.
 type GetQueryFormParams struct {
 	// ea (optional)
@@ -234,7 +346,171 @@ type GetQueryFormParams struct {
 	// ps (optional)
 	Ps *string `form:"ps" json:"ps"`
 	// co (optional)
-	Co *string `form:"co" json:"co"`
+	Co *ComplexObject `form:"co" json:"co"`
+}
+
+// ToURLValues serializes the query parameters of p, the way the client sends
+// them. Header and cookie parameters aren't included.
+func (p *GetQueryFormParams) ToURLValues() (url.Values, error) {
+	values := make(url.Values)
+	if p.Ea != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("ea", *p.Ea, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.A != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("a", *p.A, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Eo != nil {
+		if frag, err := p.styleEo(); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.O != nil {
+		if frag, err := oapiCodegenParamsPkg.StyleParameter("o", *p.O, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Ep != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("ep", *p.Ep, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.P != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("p", *p.P, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.FormatInt[int32]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Ps != nil {
+		if frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("ps", *p.Ps, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.FormatString[string]); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(frag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				values[k] = append(values[k], v...)
+			}
+		}
+	}
+	if p.Co != nil {
+		if buf, err := json.Marshal(*p.Co); err != nil {
+			return nil, err
+		} else {
+			values.Add("co", string(buf))
+		}
+	}
+	return values, nil
+}
+
+// FromURLValues parses the query parameters in values into p, the way the
+// server binds them. Header and cookie parameters are left untouched.
+func (p *GetQueryFormParams) FromURLValues(values url.Values) error {
+	if err := oapiCodegenParamsPkg.BindQueryParameter("ea", values, &p.Ea, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter ea: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("a", values, &p.A, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter a: %w", err)
+	}
+	if err := p.bindEo(values); err != nil {
+		return fmt.Errorf("invalid format for query parameter eo: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindQueryParameter("o", values, &p.O, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false}); err != nil {
+		return fmt.Errorf("invalid format for query parameter o: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ep", values, &p.Ep, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter ep: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("p", values, &p.P, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32]); err != nil {
+		return fmt.Errorf("invalid format for query parameter p: %w", err)
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ps", values, &p.Ps, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return fmt.Errorf("invalid format for query parameter ps: %w", err)
+	}
+	if paramValue := values.Get("co"); paramValue != "" {
+		var value ComplexObject
+		if err := oapiCodegenJSONPointerPkg.DecodeJSON([]byte(paramValue), &value); err != nil {
+			return fmt.Errorf("unmarshaling query parameter co: %w", err)
+		}
+		p.Co = &value
+	}
+	return nil
+}
+
+// styleEo serializes the exploded object query parameter eo of
+// p, each of its properties as the query key named after it.
+func (p *GetQueryFormParams) styleEo() (string, error) {
+	value := *p.Eo
+	var frags []string
+	{
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("role", value.Role, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.FormatString[string])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	{
+		frag, err := oapiCodegenParamsPkg.StylePrimitiveParameter("firstName", value.FirstName, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.FormatString[string])
+		if err != nil {
+			return "", err
+		}
+		frags = append(frags, frag)
+	}
+	return strings.Join(frags, "&"), nil
+}
+
+// bindEo binds the exploded object query parameter eo from
+// values into p, each of its properties from the query key named after it.
+func (p *GetQueryFormParams) bindEo(values url.Values) error {
+	if !values.Has("role") && !values.Has("firstName") {
+		return nil
+	}
+	var value Object
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("role", values, &value.Role, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return err
+	}
+	if err := oapiCodegenParamsPkg.BindPrimitiveQueryParameter("firstName", values, &value.FirstName, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true}, oapiCodegenParamsPkg.ParseString[string]); err != nil {
+		return err
+	}
+	p.Eo = &value
+	return nil
 }
 
 // ServerInterfaceWrapper converts HTTP requests to parameters.
@@ -251,16 +527,20 @@ type MiddlewareFunc func(http.Handler) http.Handler
 func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
-	var param string
+	var param ComplexObject
 
-	err = json.Unmarshal([]byte(r.PathValue("param")), &param)
+	err = oapiCodegenJSONPointerPkg.DecodeJSON([]byte(r.PathValue("param")), &param)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &UnmarshalingParamError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &UnmarshalingParamError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetContentObject(w, r, param)
 	}))
@@ -276,6 +556,7 @@ func (siw *ServerInterfaceWrapper) GetContentObject(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetCookieParams
@@ -284,10 +565,9 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 		var cookie *http.Cookie
 		if cookie, err = r.Cookie("p"); err == nil {
 			var value int32
-			err = oapiCodegenParamsPkg.BindParameter("p", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("p", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "p", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "p", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "p", Err: err}})
 			}
 			params.P = &value
 		}
@@ -297,10 +577,9 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 		var cookie *http.Cookie
 		if cookie, err = r.Cookie("ep"); err == nil {
 			var value int32
-			err = oapiCodegenParamsPkg.BindParameter("ep", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+			err = oapiCodegenParamsPkg.BindPrimitiveParameter("ep", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ep", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "ep", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "ep", Err: err}})
 			}
 			params.Ep = &value
 		}
@@ -312,8 +591,7 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 			var value []int32
 			err = oapiCodegenParamsPkg.BindParameter("ea", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ea", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "ea", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "ea", Err: err}})
 			}
 			params.Ea = &value
 		}
@@ -325,8 +603,7 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 			var value []int32
 			err = oapiCodegenParamsPkg.BindParameter("a", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "a", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "a", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "a", Err: err}})
 			}
 			params.A = &value
 		}
@@ -338,8 +615,7 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 			var value Object
 			err = oapiCodegenParamsPkg.BindParameter("eo", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false})
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eo", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "eo", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "eo", Err: err}})
 			}
 			params.Eo = &value
 		}
@@ -351,8 +627,7 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 			var value Object
 			err = oapiCodegenParamsPkg.BindParameter("o", cookie.Value, &value, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationCookie, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "o", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "o", Location: "cookie", Value: cookie.Value, Err: &InvalidParamFormatError{ParamName: "o", Err: err}})
 			}
 			params.O = &value
 		}
@@ -361,21 +636,21 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 	{
 		var cookie *http.Cookie
 		if cookie, err = r.Cookie("co"); err == nil {
-			var value string
+			var value ComplexObject
 			decoded, err := url.QueryUnescape(cookie.Value)
 			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &UnescapedCookieParamError{ParamName: "co", Err: err})
-				return
-			}
-			err = json.Unmarshal([]byte(decoded), &value)
-			if err != nil {
-				siw.ErrorHandlerFunc(w, r, &UnmarshalingParamError{ParamName: "co", Err: err})
-				return
+				bindErrs = append(bindErrs, &BindingError{ParamName: "co", Location: "cookie", Value: cookie.Value, Err: &UnescapedCookieParamError{ParamName: "co", Err: err}})
+			} else if err = oapiCodegenJSONPointerPkg.DecodeJSON([]byte(decoded), &value); err != nil {
+				bindErrs = append(bindErrs, &BindingError{ParamName: "co", Location: "cookie", Value: cookie.Value, Err: &UnmarshalingParamError{ParamName: "co", Err: err}})
 			}
 			params.Co = &value
 		}
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetCookie(w, r, params)
 	}))
@@ -391,6 +666,7 @@ func (siw *ServerInterfaceWrapper) GetCookie(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetHeaderParams
@@ -398,117 +674,93 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 	headers := r.Header
 
 	// ------------- Optional header parameter "X-Primitive" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Primitive", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Primitive", Count: len(valueList)}})
+	} else if found {
 		var xPrimitive int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Primitive", Count: n})
-			return
-		}
-		err = oapiCodegenParamsPkg.BindParameter("X-Primitive", valueList[0], &xPrimitive, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Primitive", valueList[0], &xPrimitive, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Primitive", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Primitive", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Primitive", Err: err}})
 		}
 		params.XPrimitive = &xPrimitive
 	}
 
 	// ------------- Optional header parameter "X-Primitive-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive-Exploded")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Primitive-Exploded")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Primitive-Exploded", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Primitive-Exploded", Count: len(valueList)}})
+	} else if found {
 		var xPrimitiveExploded int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Primitive-Exploded", Count: n})
-			return
-		}
-		err = oapiCodegenParamsPkg.BindParameter("X-Primitive-Exploded", valueList[0], &xPrimitiveExploded, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+		err = oapiCodegenParamsPkg.BindPrimitiveParameter("X-Primitive-Exploded", valueList[0], &xPrimitiveExploded, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Primitive-Exploded", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Primitive-Exploded", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Primitive-Exploded", Err: err}})
 		}
 		params.XPrimitiveExploded = &xPrimitiveExploded
 	}
 
 	// ------------- Optional header parameter "X-Array-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array-Exploded")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Array-Exploded", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Array-Exploded", Count: len(valueList)}})
+	} else if found {
 		var xArrayExploded []int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Array-Exploded", Count: n})
-			return
-		}
 		err = oapiCodegenParamsPkg.BindParameter("X-Array-Exploded", valueList[0], &xArrayExploded, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Array-Exploded", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Array-Exploded", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Array-Exploded", Err: err}})
 		}
 		params.XArrayExploded = &xArrayExploded
 	}
 
 	// ------------- Optional header parameter "X-Array" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Array")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Array", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Array", Count: len(valueList)}})
+	} else if found {
 		var xArray []int32
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Array", Count: n})
-			return
-		}
 		err = oapiCodegenParamsPkg.BindParameter("X-Array", valueList[0], &xArray, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Array", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Array", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Array", Err: err}})
 		}
 		params.XArray = &xArray
 	}
 
 	// ------------- Optional header parameter "X-Object-Exploded" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object-Exploded")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Object-Exploded", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Object-Exploded", Count: len(valueList)}})
+	} else if found {
 		var xObjectExploded Object
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Object-Exploded", Count: n})
-			return
-		}
 		err = oapiCodegenParamsPkg.BindParameter("X-Object-Exploded", valueList[0], &xObjectExploded, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false})
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Object-Exploded", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Object-Exploded", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Object-Exploded", Err: err}})
 		}
 		params.XObjectExploded = &xObjectExploded
 	}
 
 	// ------------- Optional header parameter "X-Object" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found {
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Object")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Object", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Object", Count: len(valueList)}})
+	} else if found {
 		var xObject Object
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Object", Count: n})
-			return
-		}
 		err = oapiCodegenParamsPkg.BindParameter("X-Object", valueList[0], &xObject, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationHeader, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Object", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Object", Location: "header", Value: valueList[0], Err: &InvalidParamFormatError{ParamName: "X-Object", Err: err}})
 		}
 		params.XObject = &xObject
 	}
 
 	// ------------- Optional header parameter "X-Complex-Object" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Complex-Object")]; found {
-		var xComplexObject string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Complex-Object", Count: n})
-			return
-		}
-		err = json.Unmarshal([]byte(valueList[0]), &xComplexObject)
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Complex-Object")]; found && len(valueList) != 1 {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "X-Complex-Object", Location: "header", Value: strings.Join(valueList, ", "), Err: &TooManyValuesForParamError{ParamName: "X-Complex-Object", Count: len(valueList)}})
+	} else if found {
+		var xComplexObject ComplexObject
+		err = oapiCodegenJSONPointerPkg.DecodeJSON([]byte(valueList[0]), &xComplexObject)
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &UnmarshalingParamError{ParamName: "X-Complex-Object", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "X-Complex-Object", Location: "header", Value: valueList[0], Err: &UnmarshalingParamError{ParamName: "X-Complex-Object", Err: err}})
 		}
 		params.XComplexObject = &xComplexObject
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetHeader(w, r, params)
 	}))
@@ -524,16 +776,20 @@ func (siw *ServerInterfaceWrapper) GetHeader(w http.ResponseWriter, r *http.Requ
 func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelExplodeArray(w, r, param)
 	}))
@@ -549,16 +805,20 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeArray(w http.ResponseWriter, r
 func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param Object
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelExplodeObject(w, r, param)
 	}))
@@ -574,16 +834,20 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetLabelExplodePrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param int32
 
-	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelExplodePrimitive(w, r, param)
 	}))
@@ -599,16 +863,20 @@ func (siw *ServerInterfaceWrapper) GetLabelExplodePrimitive(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelNoExplodeArray(w, r, param)
 	}))
@@ -624,16 +892,20 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param Object
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelNoExplodeObject(w, r, param)
 	}))
@@ -649,16 +921,20 @@ func (siw *ServerInterfaceWrapper) GetLabelNoExplodeObject(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetLabelPrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param int32
 
-	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "label", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetLabelPrimitive(w, r, param)
 	}))
@@ -674,16 +950,20 @@ func (siw *ServerInterfaceWrapper) GetLabelPrimitive(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixExplodeArray(w, r, id)
 	}))
@@ -699,16 +979,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id Object
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixExplodeObject(w, r, id)
 	}))
@@ -724,16 +1008,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetMatrixExplodePrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id int32
 
-	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixExplodePrimitive(w, r, id)
 	}))
@@ -749,16 +1037,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixExplodePrimitive(w http.ResponseWrit
 func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixNoExplodeArray(w, r, id)
 	}))
@@ -774,16 +1066,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeArray(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id Object
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixNoExplodeObject(w, r, id)
 	}))
@@ -799,16 +1095,20 @@ func (siw *ServerInterfaceWrapper) GetMatrixNoExplodeObject(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetMatrixPrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id int32
 
-	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "matrix", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetMatrixPrimitive(w, r, id)
 	}))
@@ -824,12 +1124,17 @@ func (siw *ServerInterfaceWrapper) GetMatrixPrimitive(w http.ResponseWriter, r *
 func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param string
 
 	param = r.PathValue("param")
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetPassThrough(w, r, param)
 	}))
@@ -845,17 +1150,21 @@ func (siw *ServerInterfaceWrapper) GetPassThrough(w http.ResponseWriter, r *http
 func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetDeepObjectParams
 
 	// ------------- Required query parameter "deepObj" -------------
-	err = oapiCodegenParamsPkg.BindQueryParameter("deepObj", r.URL.Query(), &params.DeepObj, oapiCodegenParamsPkg.ParameterOptions{Style: "deepObject", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: true, Type: "", Format: "", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindDeepObjectParam("deepObj", r.URL.Query(), &params.DeepObj, true)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deepObj", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "deepObj", Location: "query", Value: r.URL.Query().Get("deepObj"), Err: &InvalidParamFormatError{ParamName: "deepObj", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetDeepObject(w, r, params)
 	}))
@@ -867,10 +1176,59 @@ func (siw *ServerInterfaceWrapper) GetDeepObject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// GetQueryDelimited operation middleware
+func (siw *ServerInterfaceWrapper) GetQueryDelimited(w http.ResponseWriter, r *http.Request) {
+	var err error
+	_ = err
+	var bindErrs BindingErrors
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryDelimitedParams
+
+	// ------------- Optional query parameter "sa" -------------
+	err = oapiCodegenParamsPkg.BindQueryParameter("sa", r.URL.Query(), &params.Sa, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "sa", Location: "query", Value: r.URL.Query().Get("sa"), Err: &InvalidParamFormatError{ParamName: "sa", Err: err}})
+	}
+
+	// ------------- Optional query parameter "pa" -------------
+	err = oapiCodegenParamsPkg.BindQueryParameter("pa", r.URL.Query(), &params.Pa, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "pa", Location: "query", Value: r.URL.Query().Get("pa"), Err: &InvalidParamFormatError{ParamName: "pa", Err: err}})
+	}
+
+	// ------------- Optional query parameter "so" -------------
+	err = oapiCodegenParamsPkg.BindQueryParameter("so", r.URL.Query(), &params.So, oapiCodegenParamsPkg.ParameterOptions{Style: "spaceDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "so", Location: "query", Value: r.URL.Query().Get("so"), Err: &InvalidParamFormatError{ParamName: "so", Err: err}})
+	}
+
+	// ------------- Optional query parameter "po" -------------
+	err = oapiCodegenParamsPkg.BindQueryParameter("po", r.URL.Query(), &params.Po, oapiCodegenParamsPkg.ParameterOptions{Style: "pipeDelimited", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
+	if err != nil {
+		bindErrs = append(bindErrs, &BindingError{ParamName: "po", Location: "query", Value: r.URL.Query().Get("po"), Err: &InvalidParamFormatError{ParamName: "po", Err: err}})
+	}
+
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQueryDelimited(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetQueryForm operation middleware
 func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetQueryFormParams
@@ -878,63 +1236,59 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 	// ------------- Optional query parameter "ea" -------------
 	err = oapiCodegenParamsPkg.BindQueryParameter("ea", r.URL.Query(), &params.Ea, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ea", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ea", Location: "query", Value: r.URL.Query().Get("ea"), Err: &InvalidParamFormatError{ParamName: "ea", Err: err}})
 	}
 
 	// ------------- Optional query parameter "a" -------------
 	err = oapiCodegenParamsPkg.BindQueryParameter("a", r.URL.Query(), &params.A, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "a", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "a", Location: "query", Value: r.URL.Query().Get("a"), Err: &InvalidParamFormatError{ParamName: "a", Err: err}})
 	}
 
 	// ------------- Optional query parameter "eo" -------------
-	err = oapiCodegenParamsPkg.BindQueryParameter("eo", r.URL.Query(), &params.Eo, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "", Format: "", AllowReserved: false})
+	err = params.bindEo(r.URL.Query())
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eo", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "eo", Location: "query", Value: r.URL.Query().Get("eo"), Err: &InvalidParamFormatError{ParamName: "eo", Err: err}})
 	}
 
 	// ------------- Optional query parameter "o" -------------
 	err = oapiCodegenParamsPkg.BindQueryParameter("o", r.URL.Query(), &params.O, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "o", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "o", Location: "query", Value: r.URL.Query().Get("o"), Err: &InvalidParamFormatError{ParamName: "o", Err: err}})
 	}
 
 	// ------------- Optional query parameter "ep" -------------
-	err = oapiCodegenParamsPkg.BindQueryParameter("ep", r.URL.Query(), &params.Ep, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ep", r.URL.Query(), &params.Ep, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ep", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ep", Location: "query", Value: r.URL.Query().Get("ep"), Err: &InvalidParamFormatError{ParamName: "ep", Err: err}})
 	}
 
 	// ------------- Optional query parameter "p" -------------
-	err = oapiCodegenParamsPkg.BindQueryParameter("p", r.URL.Query(), &params.P, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("p", r.URL.Query(), &params.P, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: false, Required: false, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "p", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "p", Location: "query", Value: r.URL.Query().Get("p"), Err: &InvalidParamFormatError{ParamName: "p", Err: err}})
 	}
 
 	// ------------- Optional query parameter "ps" -------------
-	err = oapiCodegenParamsPkg.BindQueryParameter("ps", r.URL.Query(), &params.Ps, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveQueryParameter("ps", r.URL.Query(), &params.Ps, oapiCodegenParamsPkg.ParameterOptions{Style: "form", ParamLocation: oapiCodegenParamsPkg.ParamLocationQuery, Explode: true, Required: false, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ps", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "ps", Location: "query", Value: r.URL.Query().Get("ps"), Err: &InvalidParamFormatError{ParamName: "ps", Err: err}})
 	}
 
 	// ------------- Optional query parameter "co" -------------
 	if paramValue := r.URL.Query().Get("co"); paramValue != "" {
-		var value string
-		err = json.Unmarshal([]byte(paramValue), &value)
+		var value ComplexObject
+		err = oapiCodegenJSONPointerPkg.DecodeJSON([]byte(paramValue), &value)
 		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &UnmarshalingParamError{ParamName: "co", Err: err})
-			return
+			bindErrs = append(bindErrs, &BindingError{ParamName: "co", Location: "query", Value: paramValue, Err: &UnmarshalingParamError{ParamName: "co", Err: err}})
 		}
 		params.Co = &value
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetQueryForm(w, r, params)
 	}))
@@ -950,16 +1304,20 @@ func (siw *ServerInterfaceWrapper) GetQueryForm(w http.ResponseWriter, r *http.R
 func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimpleExplodeArray(w, r, param)
 	}))
@@ -975,16 +1333,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeArray(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param Object
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimpleExplodeObject(w, r, param)
 	}))
@@ -1000,16 +1362,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodeObject(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) GetSimpleExplodePrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param int32
 
-	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: true, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimpleExplodePrimitive(w, r, param)
 	}))
@@ -1025,16 +1391,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleExplodePrimitive(w http.ResponseWrit
 func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param []int32
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "array", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimpleNoExplodeArray(w, r, param)
 	}))
@@ -1050,16 +1420,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeArray(w http.ResponseWriter
 func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param Object
 
 	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "", Format: "", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimpleNoExplodeObject(w, r, param)
 	}))
@@ -1075,16 +1449,20 @@ func (siw *ServerInterfaceWrapper) GetSimpleNoExplodeObject(w http.ResponseWrite
 func (siw *ServerInterfaceWrapper) GetSimplePrimitive(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "param" -------------
 	var param int32
 
-	err = oapiCodegenParamsPkg.BindParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("param", r.PathValue("param"), &param, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int32", AllowReserved: false}, oapiCodegenParamsPkg.ParseInt[int32])
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "param", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "param", Location: "path", Value: r.PathValue("param"), Err: &InvalidParamFormatError{ParamName: "param", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSimplePrimitive(w, r, param)
 	}))
@@ -1166,6 +1544,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/matrixPrimitive/{id}", wrapper.GetMatrixPrimitive)
 	m.HandleFunc("GET "+options.BaseURL+"/passThrough/{param}", wrapper.GetPassThrough)
 	m.HandleFunc("GET "+options.BaseURL+"/queryDeepObject", wrapper.GetDeepObject)
+	m.HandleFunc("GET "+options.BaseURL+"/queryDelimited", wrapper.GetQueryDelimited)
 	m.HandleFunc("GET "+options.BaseURL+"/queryForm", wrapper.GetQueryForm)
 	m.HandleFunc("GET "+options.BaseURL+"/simpleExplodeArray/{param}", wrapper.GetSimpleExplodeArray)
 	m.HandleFunc("GET "+options.BaseURL+"/simpleExplodeObject/{param}", wrapper.GetSimpleExplodeObject)
@@ -1250,3 +1629,65 @@ type TooManyValuesForParamError struct {
 func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
+
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
@@ -33,9 +33,10 @@ func (s *Server) GetMatrixNoExplodeArray(w http.ResponseWriter, r *http.Request,
 func (s *Server) GetMatrixExplodeArray(w http.ResponseWriter, r *http.Request, id []int32)           { writeJSON(w, id) }
 func (s *Server) GetMatrixNoExplodeObject(w http.ResponseWriter, r *http.Request, id Object)         { writeJSON(w, id) }
 func (s *Server) GetMatrixExplodeObject(w http.ResponseWriter, r *http.Request, id Object)           { writeJSON(w, id) }
-func (s *Server) GetContentObject(w http.ResponseWriter, r *http.Request, param string)          { writeJSON(w, param) }
+func (s *Server) GetContentObject(w http.ResponseWriter, r *http.Request, param ComplexObject)   { writeJSON(w, param) }
 func (s *Server) GetPassThrough(w http.ResponseWriter, r *http.Request, param string)            { writeJSON(w, param) }
 func (s *Server) GetQueryForm(w http.ResponseWriter, r *http.Request, params GetQueryFormParams)  { writeJSON(w, params) }
+func (s *Server) GetQueryDelimited(w http.ResponseWriter, r *http.Request, params GetQueryDelimitedParams) { writeJSON(w, params) }
 func (s *Server) GetDeepObject(w http.ResponseWriter, r *http.Request, params GetDeepObjectParams) { writeJSON(w, params) }
 func (s *Server) GetHeader(w http.ResponseWriter, r *http.Request, params GetHeaderParams)       { writeJSON(w, params) }
 func (s *Server) GetCookie(w http.ResponseWriter, r *http.Request, params GetCookieParams)       { writeJSON(w, params) }
@@ -0,0 +1,29 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
+)
+
+// Server implements ServerInterface by echoing the received parameters back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) GetReport(w http.ResponseWriter, r *http.Request, day types.Date, params GetReportParams) {
+	echo := Echo{
+		Path:    r.URL.EscapedPath(),
+		Query:   r.URL.RawQuery,
+		Day:     day,
+		Since:   params.Since,
+		Until:   params.Until,
+		XBefore: params.XBefore,
+	}
+	if before := r.Header.Get("X-Before"); before != "" {
+		echo.Before = &before
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(echo)
+}
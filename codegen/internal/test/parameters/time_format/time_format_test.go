@@ -0,0 +1,82 @@
+package time_format_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/time_format/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/time_format/stdhttp"
+)
+
+// newClient returns a SimpleClient talking to the std-http server.
+func newClient(t *testing.T) (*client.SimpleClient, string) {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	c, err := client.NewSimpleClient(server.URL)
+	require.NoError(t, err)
+	return c, server.URL
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestTimeFormatRoundtrip(t *testing.T) {
+	c, _ := newClient(t)
+
+	day := client.Date{Time: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)}
+	since := time.Date(2024, 2, 29, 13, 45, 0, 0, time.UTC)
+	until := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	echo, err := c.GetReport(context.Background(), day, &client.GetReportParams{
+		Since:   &since,
+		Until:   &until,
+		XBefore: &before,
+	})
+	require.NoError(t, err)
+
+	// Only the parameters with a time format use theirs, the others RFC 3339.
+	assert.Equal(t, "/reports/20240229", echo.Path)
+	assert.Equal(t, "since=1709214300&until=2024-03-01T08%3A00%3A00Z", echo.Query)
+	assert.Equal(t, ptr("2024-03-01 09:30"), echo.Before)
+
+	assert.Equal(t, day, echo.Day)
+	require.NotNil(t, echo.Since)
+	assert.True(t, since.Equal(*echo.Since))
+	require.NotNil(t, echo.Until)
+	assert.True(t, until.Equal(*echo.Until))
+	require.NotNil(t, echo.XBefore)
+	assert.True(t, before.Equal(*echo.XBefore))
+}
+
+func TestTimeFormatInvalid(t *testing.T) {
+	_, serverURL := newClient(t)
+
+	for name, target := range map[string]string{
+		"RFC 3339 day":   "/reports/2024-02-29",
+		"RFC 3339 since": "/reports/20240229?since=2024-02-29T13:45:00Z",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(serverURL + target)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+
+	req, err := http.NewRequest(http.MethodGet, serverURL+"/reports/20240229", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Before", "2024-03-01T09:30:00Z")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,5 @@
+// Package client contains the generated client for the UUID mapping test,
+// with format: uuid mapped to the runtime UUIDString type.
+package client
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config client.cfg.yaml ../spec.yaml
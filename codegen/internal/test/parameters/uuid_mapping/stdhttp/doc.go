@@ -0,0 +1,5 @@
+// Package stdhttp contains the std-http server for the UUID mapping test,
+// with format: uuid mapped to github.com/google/uuid's UUID.
+package stdhttp
+
+//go:generate go run ../../../../../../cmd/oapi-codegen -config server.cfg.yaml ../spec.yaml
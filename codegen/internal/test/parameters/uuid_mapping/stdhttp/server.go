@@ -0,0 +1,22 @@
+package stdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Server implements ServerInterface by echoing the received UUIDs back.
+type Server struct{}
+
+var _ ServerInterface = (*Server)(nil)
+
+func (s *Server) GetThing(w http.ResponseWriter, r *http.Request, id uuid.UUID, params GetThingParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Thing{
+		ID:        id,
+		Parent:    params.Parent,
+		RequestID: params.XRequestId,
+	})
+}
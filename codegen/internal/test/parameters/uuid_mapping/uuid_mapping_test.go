@@ -0,0 +1,57 @@
+package uuid_mapping_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/uuid_mapping/client"
+	"github.com/oapi-codegen/oapi-codegen-exp/codegen/internal/test/parameters/uuid_mapping/stdhttp"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// newServer starts the std-http server and returns its URL.
+func newServer(t *testing.T) string {
+	t.Helper()
+	server := httptest.NewServer(stdhttp.Handler(&stdhttp.Server{}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestUUIDStringClientToUUIDServer(t *testing.T) {
+	c, err := client.NewSimpleClient(newServer(t))
+	require.NoError(t, err)
+
+	thing, err := c.GetThing(context.Background(), "9cb14230-b640-11ec-b909-0242ac120002", &client.GetThingParams{
+		Parent:     ptr(client.UUIDString("1b4e28ba-2fa1-11d2-883f-0016d3cca427")),
+		XRequestId: ptr(client.UUIDString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, client.Thing{
+		ID:        "9cb14230-b640-11ec-b909-0242ac120002",
+		Parent:    ptr(client.UUIDString("1b4e28ba-2fa1-11d2-883f-0016d3cca427")),
+		RequestID: ptr(client.UUIDString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+	}, thing)
+}
+
+func TestUUIDStringClientRejectsInvalidUUID(t *testing.T) {
+	c, err := client.NewClient(newServer(t))
+	require.NoError(t, err)
+
+	_, err = c.GetThing(context.Background(), "not-a-uuid", nil)
+	assert.ErrorIs(t, err, client.ErrValidationUUID)
+}
+
+func TestUUIDServerRejectsInvalidUUID(t *testing.T) {
+	resp, err := http.Get(newServer(t) + "/things/not-a-uuid")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,5 @@
+// Package request_logging tests the request logging middleware generated for
+// servers, which logs requests by operationId and route template.
+package request_logging
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,61 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
+)
+
+type petServer struct{}
+
+func (petServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (petServer) GetPet(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func TestRequestLoggingMiddleware(t *testing.T) {
+	var out bytes.Buffer
+	logger := &helpers.RequestLogger{
+		Logger:      slog.New(slog.NewJSONHandler(&out, nil)),
+		SampleRates: map[string]float64{"listPets": 0},
+	}
+	handler := RequestLoggingMiddleware(logger)(Handler(petServer{}))
+
+	for _, path := range []string{"/pets", "/pets/7", "/pets/abc", "/nowhere"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+
+	// listPets isn't sampled; the others are logged by route, not path.
+	require.Len(t, records, 3)
+	assert.Equal(t, "getPet", records[0]["operationId"])
+	assert.Equal(t, "/pets/{id}", records[0]["route"])
+	assert.EqualValues(t, http.StatusNotFound, records[0]["status"])
+
+	// Requests rejected while binding parameters still belong to the operation.
+	assert.Equal(t, "getPet", records[1]["operationId"])
+	assert.EqualValues(t, http.StatusBadRequest, records[1]["status"])
+
+	// Unmatched requests have no operation or route.
+	assert.NotContains(t, records[2], "operationId")
+	assert.EqualValues(t, http.StatusNotFound, records[2]["status"])
+	assert.NotContains(t, out.String(), "/pets/7")
+}
@@ -0,0 +1,5 @@
+// Package response_validation tests the validating test server generated for
+// servers, which checks handler responses against the spec.
+package response_validation
+
+//go:generate go run ../../../../cmd/oapi-codegen -config config.yaml spec.yaml
@@ -0,0 +1,91 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// petServer writes the pets it holds, and no ETag unless tagged.
+type petServer struct {
+	pets   map[int64]any
+	tagged bool
+}
+
+func (s petServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	pets := []any{}
+	for _, pet := range s.pets {
+		pets = append(pets, pet)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(pets)
+}
+
+func (s petServer) GetPet(w http.ResponseWriter, r *http.Request, id int64) {
+	pet, ok := s.pets[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if s.tagged {
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, id))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(pet)
+}
+
+// recordingT records the failures of the validating test server.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func get(t *testing.T, url string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+}
+
+func TestValidatingTestServerAcceptsValidResponses(t *testing.T) {
+	rt := &recordingT{TB: t}
+	server := NewValidatingTestServer(rt, Handler(petServer{
+		pets:   map[int64]any{1: map[string]any{"id": 1, "name": "Tom", "tag": "cat"}},
+		tagged: true,
+	}))
+
+	get(t, server.URL+"/pets")
+	get(t, server.URL+"/pets/1")
+	get(t, server.URL+"/pets/2")
+	server.Close()
+	assert.Empty(t, rt.errors)
+}
+
+func TestValidatingTestServerReportsMismatches(t *testing.T) {
+	rt := &recordingT{TB: t}
+	server := NewValidatingTestServer(rt, Handler(petServer{
+		pets: map[int64]any{1: map[string]any{"id": 1, "name": "Tom"}},
+	}))
+
+	get(t, server.URL+"/pets/1")
+	server.Close()
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, "response validation: GET /pets/{id}: 200 response: missing required header ETag (#/paths/~1pets~1{id}/get/responses/200/headers/ETag/required)", rt.errors[0])
+
+	rt.errors = nil
+	server = NewValidatingTestServer(rt, Handler(petServer{
+		pets: map[int64]any{2: map[string]any{"id": "2", "name": "Jerry"}},
+	}))
+	get(t, server.URL+"/pets")
+	server.Close()
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, "response validation: GET /pets: 200 response at /0/id: expected integer, got string (#/components/schemas/Pet/properties/id/type)", rt.errors[0])
+}
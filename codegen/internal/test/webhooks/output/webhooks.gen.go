@@ -14,14 +14,16 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
 	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
 	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // #/components/schemas/WebhookRegistration
 type WebhookRegistration struct {
-	URL string `form:"url" json:"url"`
+	URL oapiCodegenTypesPkg.URI `form:"url" json:"url"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -54,18 +56,32 @@ const (
 	ExitEvent  PostAPIWebhookKindParameter = "exitEvent"
 )
 
+// Values returns the PostAPIWebhookKindParameter constants, in the order of the spec.
+func (PostAPIWebhookKindParameter) Values() []PostAPIWebhookKindParameter {
+	return []PostAPIWebhookKindParameter{EnterEvent, ExitEvent}
+}
+
+// ParsePostAPIWebhookKindParameter returns the PostAPIWebhookKindParameter constant whose value is s. Other strings
+// return an error.
+func ParsePostAPIWebhookKindParameter(s string) (PostAPIWebhookKindParameter, error) {
+	switch v := PostAPIWebhookKindParameter(s); v {
+	case EnterEvent, ExitEvent:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid PostAPIWebhookKindParameter value %q", s)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/8xUT2/bPgy951MQ/f2AnBYn2046Dutht6EY0LNiMQlbW9IoOmsw7LsP8l95sdMEGIbe",
-	"DJoiHx/fo/NotScFdx9Wm9X6bkF259QC4IgcyFkFm9V6tV4ACEmBCh5xe3DuGb5hkAWAwZAzealTYyzA",
-	"jzaDLAlpcQzaGmDMkY7IkDuDsEeLrOOr1cJrOYTYMtOesvZ19vOZrPkVwwDeBWm+AEJVlppPCh5wT0GQ",
-	"QXcd2wzn29JfzJD1OErxmnWJghy6sgDvwOoSFcS+fRCArIKIMAkxfq+I0SgQrjD5EfIDllolEQA5eVQQ",
-	"hMnuRz/QVuU4NWJAK8j3R7Ry/uuFJP0TYWCQT86chjoz2HJnBa2k/bT3BeU1UdlTcHaMZWoUgP8ZdwqW",
-	"/2W5K72zaCVkTWbIWoYbwpsFLHukwTsbMGF7+X69WablR0LqRMbt9jDdyMQsr00zN8/NEz20kyzP5Eqd",
-	"WA0WKHgm18/IVwl2yLtesvRPBLtzXGpRUFV9v+nFfnx9sQaT1bZs1EUG/V+w/lfk4GyTiwbkgLCtqDAD",
-	"4hGl93966o05pxnnslnW85zWk3X3NW6mPxRXUPhCcg2Db/v0/F0ChwbxRdujeTxxEbqqjW3c9gnzlKWa",
-	"kMSwFRedoTlSLJTirLhIUc5YsTci0xyq7k7diK439hQ4Mrdg645Es5sbccSzdgFJffXmsPwOAAD//6eL",
-	"QoXSCAAA",
+	"H4sIAAAAAAAC/8xUTW/bMAy9+1cQ3YCcFifbTjoO62G3oRjQs2IxCVtb0ig6azDsvw+K5Vj5cJoAw9Cb",
+	"TT6Rj48fzqPVnhTcfZrOp7O7guzSqQJggxzIWQXz6Ww6KwCEpEYFj7hYO/cMPzBIAWAwVExedtBoC/Ar",
+	"IciSkBbHoK0BxgppgwyVMwgrtMg6vpoWXss6xJSl9lSm1+XvZ7LmTzQDeBek+wIIbdNo3ip4wBUFQQbd",
+	"Z0wI51Pob2ZAPR5AvGbdoCCHPizAB7C6QQUx794IQFZBZJiZGH+2xGgUCLeYOUK1xkarzAIgW48KgjDZ",
+	"1YEDbdscQiMHtIJ8v0Erp64XktwTaWCQL85shzgj3CpnBa3k+bT3NVU7ocqn4Owhl3OlALxnXCqYvCsr",
+	"13hn0UooO2Qok8Kd4F0DJnumwTsbMFN78nE2nwy/R4OUYgGn7mHekTO1vFbNWD03V/SQKpmcjCv1w2qw",
+	"RsGTcf2KfNXADrjrR5b+y8AuHTdaFLQtmYuN/fx6Yw1mrU1q7IIM839h9b8jB2c7LBqQNcKipdoMjA8k",
+	"vT/eqTe2OV05l5dlNq7prrL+vpoChkNxhYQvJNco+LZPz78VcEgQX6Qc3eMzF0EV+dq4xRNWUhwJki1s",
+	"y3X68xwlFsp5tlwPP6OruF9EpjFW/Z26kR2ZC+TI3MKtPxJdb27kEc/aBSbRPcrl7wCni0KF0ggAAA==",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -131,16 +147,20 @@ type MiddlewareFunc func(http.Handler) http.Handler
 func (siw *ServerInterfaceWrapper) DeregisterWebhook(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id oapiCodegenTypesPkg.UUID
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeregisterWebhook(w, r, id)
 	}))
@@ -156,16 +176,23 @@ func (siw *ServerInterfaceWrapper) DeregisterWebhook(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) RegisterWebhook(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "kind" -------------
 	var kind string
 
-	err = oapiCodegenParamsPkg.BindParameter("kind", r.PathValue("kind"), &kind, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("kind", r.PathValue("kind"), &kind, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err == nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("kind", kind, oapiCodegenParamsPkg.ParamEnum[string]("enterEvent", "exitEvent"))
+	}
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "kind", Location: "path", Value: r.PathValue("kind"), Err: &InvalidParamFormatError{ParamName: "kind", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.RegisterWebhook(w, r, kind)
 	}))
@@ -310,6 +337,68 @@ func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
+
 type EnterEventJSONRequestBody = Person
 
 type ExitEventJSONRequestBody = Person
@@ -385,68 +474,229 @@ func (p *WebhookInitiator) applyEditors(ctx context.Context, req *http.Request,
 	return nil
 }
 
+// RequestOption customizes a single call to a WebhookInitiator method. Options are
+// applied after the request has been built and the WebhookInitiator's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a WebhookInitiator-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleWebhookInitiator methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleWebhookInitiator methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (p *WebhookInitiator) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = oapiCodegenHelpersPkg.ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := p.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // WebhookInitiatorInterface is the interface specification for the webhook initiator.
 type WebhookInitiatorInterface interface {
 	// EnterEventWithBody sends a POST webhook request
-	EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// ExitEventWithBody sends a POST webhook request
-	ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 }
 
 // EnterEventWithBody sends a POST webhook request
 // Person entered the building
-func (p *WebhookInitiator) EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewEnterEventWebhookRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "EnterEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // EnterEvent sends a POST webhook request with application/json body
-func (p *WebhookInitiator) EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewEnterEventWebhookRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "EnterEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // ExitEventWithBody sends a POST webhook request
 // Person exited the building
-func (p *WebhookInitiator) ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewExitEventWebhookRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "ExitEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // ExitEvent sends a POST webhook request with application/json body
-func (p *WebhookInitiator) ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewExitEventWebhookRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "ExitEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewEnterEventWebhookRequest creates a POST request for the webhook with application/json body
@@ -537,6 +787,10 @@ func NewSimpleWebhookInitiator(opts ...WebhookInitiatorOption) (*SimpleWebhookIn
 	return &SimpleWebhookInitiator{WebhookInitiator: inner}, nil
 }
 
+// SimpleWebhookInitiatorInterface is the interface specification for SimpleWebhookInitiator.
+type SimpleWebhookInitiatorInterface interface {
+}
+
 // WebhookReceiverInterface represents handlers for receiving webhook requests.
 type WebhookReceiverInterface interface {
 	// Person entered the building
@@ -11,8 +11,8 @@ import (
 
 // Verify schema types can be instantiated
 func TestSchemaInstantiation(t *testing.T) {
-	reg := WebhookRegistration{URL: "https://example.com/hook"}
-	assert.Equal(t, "https://example.com/hook", reg.URL)
+	reg := WebhookRegistration{URL: types.MustParseURI("https://example.com/hook")}
+	assert.Equal(t, "https://example.com/hook", reg.URL.String())
 
 	resp := WebhookRegistrationResponse{}
 	_ = resp.ID // UUID field exists
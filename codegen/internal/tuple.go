@@ -0,0 +1,238 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tupleType returns the Go type of an array schema with prefixItems: the
+// tuple struct generated for it, or []any where there is none.
+func (g *TypeGenerator) tupleType(desc *SchemaDescriptor) string {
+	if desc != nil && desc.ShortName != "" {
+		return desc.ShortName
+	}
+	return "[]any"
+}
+
+// TupleFields returns the fields of the tuple struct of an array schema with
+// prefixItems, one per item in order, and rest, the field holding the items
+// after them when the schema has items, or nil. Items are named by their
+// title, or x-go-name, falling back to their position, such as Item0. Items
+// past minItems may be absent, so they're optional.
+func (g *TypeGenerator) TupleFields(desc *SchemaDescriptor) (fields []StructField, rest *StructField) {
+	schema := desc.Schema
+	if schema == nil {
+		return nil, nil
+	}
+	minItems := 0
+	if schema.MinItems != nil {
+		minItems = int(*schema.MinItems)
+	}
+
+	for i, proxy := range schema.PrefixItems {
+		field := StructField{
+			Name:     fmt.Sprintf("Item%d", i),
+			JSONName: strconv.Itoa(i),
+			Required: i < minItems,
+		}
+
+		var itemType string
+		var nullableAlias bool
+		if proxy.IsReference() {
+			ref := proxyRef(proxy)
+			if !strings.HasPrefix(ref, "#") && strings.Contains(ref, "#") {
+				itemType = g.externalRefType(&SchemaDescriptor{Ref: ref, Path: desc.Path.Append("prefixItems", field.JSONName)})
+				field.IsExternal = true
+			} else if target, ok := g.schemaIndex[ref]; ok {
+				itemType = target.ShortName
+				field.Recursive = g.reachesSchema(ref, componentRoot(desc.Path))
+				field.IsStruct = schemaHasApplyDefaults(target.Schema)
+				nullableAlias = isNullablePrimitive(target.Schema)
+			} else {
+				itemType = "any"
+			}
+		} else {
+			itemSchema := proxy.Schema()
+			var itemDesc *SchemaDescriptor
+			if i < len(desc.PrefixItems) {
+				itemDesc = desc.PrefixItems[i]
+			}
+			itemType = g.goTypeForSchema(itemSchema, itemDesc)
+			if itemSchema != nil {
+				if itemSchema.Title != "" {
+					field.Name = g.converter.ToPropertyName(itemSchema.Title)
+				}
+				if itemDesc != nil && itemDesc.Extensions != nil && itemDesc.Extensions.NameOverride != "" {
+					field.Name = itemDesc.Extensions.NameOverride
+				}
+				field.Doc = extractDescription(itemSchema)
+				field.IsStruct = itemDesc != nil && schemaHasApplyDefaults(itemSchema)
+				if itemSchema.Default != nil {
+					defaultType := itemType
+					if _, elem, ok := cutNullableType(itemType); ok {
+						defaultType = elem
+					}
+					field.Default = formatDefaultValue(itemSchema.Default.Value, defaultType)
+				}
+			}
+		}
+
+		// Absent items are nil, as optional properties are by default;
+		// collections and Nullable[T] already tell that they're absent.
+		held := isCollectionType(itemType) || nullableAlias || strings.Contains(itemType, "Nullable[")
+		if !held && (!field.Required || field.Recursive) {
+			field.Type = "*" + itemType
+			field.Pointer = true
+		} else {
+			field.Type = itemType
+		}
+		fields = append(fields, field)
+	}
+
+	names := make([]string, len(fields))
+	originals := make([]string, len(fields))
+	for i, f := range fields {
+		names[i], originals[i] = f.Name, "prefixItems/"+f.JSONName
+	}
+	if schema.Items != nil && schema.Items.A != nil {
+		rest = &StructField{Name: "Rest", Type: g.sliceType(schema, desc), JSONName: "items"}
+		names = append(names, rest.Name)
+		originals = append(originals, rest.JSONName)
+	}
+	where := func(i int) SchemaPath {
+		if i < len(fields) {
+			return desc.Path.Append("prefixItems", fields[i].JSONName)
+		}
+		return desc.Path.Append("items")
+	}
+	names = disambiguateNames(g.ctx, "tuple item", where, originals, names)
+	for i := range fields {
+		fields[i].Name = names[i]
+	}
+	if rest != nil {
+		rest.Name = names[len(fields)]
+	}
+	return fields, rest
+}
+
+// isClosedTuple reports whether a tuple schema allows no items after its
+// prefixItems, with items: false.
+func isClosedTuple(desc *SchemaDescriptor) bool {
+	items := desc.Schema.Items
+	return items != nil && items.IsB() && !items.B
+}
+
+// tupleTemplateItem is an item of a tuple struct for the tuple templates.
+type tupleTemplateItem struct {
+	Name     string   // Go field name (e.g., "Longitude")
+	Type     string   // Go type (e.g., "float64")
+	Doc      []string // Lines of the field documentation
+	Index    int      // Position of the item
+	End      int      // Index + 1, the length of the array ending with it
+	Required bool     // Whether the item is within minItems
+	Present  string   // Condition on t telling an optional item is present
+}
+
+// tupleTemplateData is the data passed to the tuple templates.
+type tupleTemplateData struct {
+	TypeName string
+	Doc      []string
+	Items    []tupleTemplateItem
+	MinItems int    // Number of required items
+	Optional bool   // Whether any item is optional
+	RestName string // Go field name of the items after the tuple's ("" if none)
+	RestType string // Go type of the items after the tuple's
+	Closed   bool   // Whether items after the tuple's are rejected
+}
+
+// buildTupleTemplateData converts the fields of a tuple into template data.
+func buildTupleTemplateData(typeName, doc string, fields []StructField, rest *StructField, closed bool) tupleTemplateData {
+	data := tupleTemplateData{
+		TypeName: typeName,
+		Closed:   closed,
+	}
+	if rest != nil {
+		data.RestName = rest.Name
+		data.RestType = strings.TrimPrefix(rest.Type, "[]")
+	}
+	if doc != "" {
+		data.Doc = strings.Split(doc, "\n")
+	}
+	for i, f := range fields {
+		item := tupleTemplateItem{
+			Name:     f.Name,
+			Type:     f.Type,
+			Index:    i,
+			End:      i + 1,
+			Required: f.Required,
+		}
+		if f.Doc != "" {
+			for _, line := range strings.Split(f.Doc, "\n") {
+				item.Doc = append(item.Doc, strings.TrimRight(line, " \t"))
+			}
+		}
+		if f.Required {
+			data.MinItems++
+		} else {
+			data.Optional = true
+			// Optional items are pointers, collections or Nullable[T]
+			switch {
+			case isSetType(f.Type):
+				item.Present = "!t." + f.Name + ".IsZero()"
+			case f.Pointer || isCollectionType(f.Type):
+				item.Present = "t." + f.Name + " != nil"
+			default:
+				item.Present = "t." + f.Name + ".IsSpecified()"
+			}
+		}
+		data.Items = append(data.Items, item)
+	}
+	return data
+}
+
+// GenerateTupleCode generates the tuple struct of an array schema with
+// prefixItems, with the MarshalJSON and UnmarshalJSON methods encoding it as
+// a JSON array, and MarshalJSONTo and UnmarshalJSONFrom with jsonV2.
+func GenerateTupleCode(data tupleTemplateData, jsonV2 bool) (string, error) {
+	tmpl, err := loadStructTemplates()
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	if err := tmpl.ExecuteTemplate(&buf, "tuple", data); err != nil {
+		return "", fmt.Errorf("executing tuple: %w", err)
+	}
+	if jsonV2 {
+		if err := tmpl.ExecuteTemplate(&buf, "tuple_jsonv2", data); err != nil {
+			return "", fmt.Errorf("executing tuple_jsonv2: %w", err)
+		}
+	}
+	return buf.String(), nil
+}
+
+// generateTupleType generates the tuple struct of an array schema with
+// prefixItems.
+func generateTupleType(gen *TypeGenerator, desc *SchemaDescriptor) string {
+	fields, rest := gen.TupleFields(desc)
+	data := buildTupleTemplateData(desc.ShortName, extractDescription(desc.Schema), fields, rest, isClosedTuple(desc))
+
+	gen.AddJSONImports()
+	if gen.jsonV2 {
+		gen.addJSONv2Imports()
+	}
+	code, err := GenerateTupleCode(data, gen.jsonV2)
+	if err != nil {
+		return fmt.Sprintf("// ERROR generating tuple type %s: %v\n", desc.ShortName, err)
+	}
+
+	applyDefaults, needsReflect, err := GenerateApplyDefaultsCode(desc.ShortName, fields)
+	if err != nil {
+		return fmt.Sprintf("// ERROR generating ApplyDefaults for %s: %v\n", desc.ShortName, err)
+	}
+	if needsReflect {
+		gen.AddImport("reflect")
+	}
+	return code + applyDefaults
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"go.yaml.in/yaml/v4"
 )
 
 // TypeGenerator converts OpenAPI schemas to Go type expressions.
@@ -20,10 +21,40 @@ type TypeGenerator struct {
 	// schemaIndex maps JSON pointer refs to their descriptors
 	schemaIndex map[string]*SchemaDescriptor
 
+	// unmappedFiles holds the external spec files without an import
+	// mapping, which are warned about once.
+	unmappedFiles map[string]bool
+
+	// refGraph maps schema refs to the $refs their schemas hold, collected
+	// by schemaRefs to find recursive fields.
+	refGraph map[string][]string
+
 	// enumInfoMap maps schema path strings to pre-computed EnumInfo.
 	// Populated by the enum pre-pass phase so that generateEnumType can
 	// use collision-aware constant names.
 	enumInfoMap map[string]*EnumInfo
+
+	// nullableOmitZero adds omitzero to the json tags of optional Nullable
+	// fields.
+	nullableOmitZero bool
+
+	// optionalProperties is how struct fields hold optional properties, one
+	// of the OptionalProps constants, which schemas and properties may
+	// override with ExtOptionalProperties.
+	optionalProperties string
+
+	// strictEnums adds an UnmarshalJSON method to enums, rejecting values
+	// outside them.
+	strictEnums bool
+
+	// uniqueItemSets generates Set[T] instead of []T for arrays with
+	// uniqueItems: true and comparable items.
+	uniqueItemSets bool
+
+	// jsonV2 adds the methods of encoding/json/v2 to union, tuple and
+	// additionalProperties types, and tags optional Nullable fields
+	// omitzero without omitempty.
+	jsonV2 bool
 }
 
 // NewTypeGenerator creates a TypeGenerator with the given configuration.
@@ -36,6 +67,8 @@ func NewTypeGenerator(typeMapping TypeMapping, converter *NameConverter, importR
 		ctx:            ctx,
 		schemaIndex:    make(map[string]*SchemaDescriptor),
 		enumInfoMap:    make(map[string]*EnumInfo),
+		refGraph:       make(map[string][]string),
+		unmappedFiles:  make(map[string]bool),
 	}
 }
 
@@ -68,6 +101,13 @@ func (g *TypeGenerator) AddJSONImports() {
 	g.ctx.AddJSONImports()
 }
 
+// addJSONv2Imports adds the encoding/json/v2 imports of MarshalJSONTo and
+// UnmarshalJSONFrom methods.
+func (g *TypeGenerator) addJSONv2Imports() {
+	g.ctx.AddImport("encoding/json/jsontext")
+	g.ctx.AddImportAlias("encoding/json/v2", "jsonv2")
+}
+
 // Imports returns the collected imports as a map[path]alias.
 func (g *TypeGenerator) Imports() map[string]string {
 	return g.ctx.Imports()
@@ -120,12 +160,17 @@ func (g *TypeGenerator) buildEnumInfo(desc *SchemaDescriptor) *EnumInfo {
 	baseType := "string"
 	primaryType := getPrimaryType(schema)
 	if primaryType == "integer" {
+		// Enums of integers take the builtin type of their format, or int
 		baseType = "int"
+		if spec := g.typeMapping.integerSpec(schema); isIntegerType(spec.Type) && spec.Import == "" {
+			baseType = spec.Type
+		}
 	}
 
 	var values []string
 	var customNames []string
 	var valueDocs []string
+	var isConst bool
 
 	switch {
 	case len(schema.Enum) > 0:
@@ -150,6 +195,18 @@ func (g *TypeGenerator) buildEnumInfo(desc *SchemaDescriptor) *EnumInfo {
 			valueDocs[i] = item.Doc
 		}
 
+	case schema.Const != nil:
+		value, constBase, ok := constValue(schema)
+		if !ok {
+			return nil
+		}
+		baseType = constBase
+		values = []string{value}
+		if desc.Extensions != nil && len(desc.Extensions.EnumVarNames) > 0 {
+			customNames = desc.Extensions.EnumVarNames
+		}
+		isConst = true
+
 	default:
 		return nil
 	}
@@ -162,7 +219,51 @@ func (g *TypeGenerator) buildEnumInfo(desc *SchemaDescriptor) *EnumInfo {
 		ValueDocs:   valueDocs,
 		Doc:         extractDescription(schema),
 		SchemaPath:  desc.Path.String(),
+		Const:       isConst,
+		Strict:      g.strictEnums,
+	}
+}
+
+// constValue returns the value of a schema's const keyword and the Go base
+// type of the single-constant enum holding it. Only string and integer
+// values make such enums, as they do for enum keywords.
+func constValue(schema *base.Schema) (value, baseType string, ok bool) {
+	if schema == nil || schema.Const == nil || len(schema.Enum) > 0 {
+		return "", "", false
+	}
+	node := schema.Const
+	if node.Kind != yaml.ScalarNode {
+		return "", "", false
+	}
+	switch getPrimaryType(schema) {
+	case "string":
+		return node.Value, "string", true
+	case "integer":
+		if node.Tag == "!!int" {
+			return node.Value, "int", true
+		}
+	case "":
+		switch node.Tag {
+		case "!!str":
+			return node.Value, "string", true
+		case "!!int":
+			return node.Value, "int", true
+		}
 	}
+	return "", "", false
+}
+
+// constName returns the name of the constant generated for a const schema,
+// or "" if desc is not one.
+func (g *TypeGenerator) constName(desc *SchemaDescriptor) string {
+	if desc == nil {
+		return ""
+	}
+	info, ok := g.enumInfoMap[desc.Path.String()]
+	if !ok || !info.Const || len(info.SanitizedNames) == 0 {
+		return ""
+	}
+	return info.finalConstName(0)
 }
 
 // GoTypeExpr returns the Go type expression for a schema descriptor.
@@ -188,7 +289,8 @@ func (g *TypeGenerator) GoTypeExpr(desc *SchemaDescriptor) string {
 }
 
 // externalRefType resolves an external reference to a qualified Go type.
-// Returns "any" if the external ref cannot be resolved.
+// Returns "any" if the external ref cannot be resolved, warning about files
+// missing from the import mapping.
 func (g *TypeGenerator) externalRefType(desc *SchemaDescriptor) string {
 	filePath, internalPath := desc.ParseExternalRef()
 	if filePath == "" {
@@ -196,14 +298,16 @@ func (g *TypeGenerator) externalRefType(desc *SchemaDescriptor) string {
 	}
 
 	// Look up import mapping
-	if g.importResolver == nil {
-		// No import resolver configured - can't resolve external refs
-		return "any"
+	var imp *ExternalImport
+	if g.importResolver != nil {
+		imp = g.importResolver.Resolve(filePath)
 	}
-
-	imp := g.importResolver.Resolve(filePath)
 	if imp == nil {
 		// External file not in import mapping
+		if location := specLocation(filePath); !g.unmappedFiles[location] {
+			g.unmappedFiles[location] = true
+			g.ctx.Warn(desc.Path, "no import-mapping for %s; its schemas are any", filePath)
+		}
 		return "any"
 	}
 
@@ -277,6 +381,14 @@ func (g *TypeGenerator) goTypeForSchema(schema *base.Schema, desc *SchemaDescrip
 		return g.oneOfType(desc)
 	}
 
+	// Enums, including const schemas as single-constant enums, render as
+	// their generated enum type.
+	if desc != nil && desc.ShortName != "" {
+		if _, _, ok := constValue(schema); ok || len(schema.Enum) > 0 {
+			return desc.ShortName
+		}
+	}
+
 	// Get the primary type from the type array
 	// OpenAPI 3.1 allows type to be an array like ["string", "null"]
 	primaryType := getPrimaryType(schema)
@@ -351,6 +463,9 @@ func (g *TypeGenerator) objectType(schema *base.Schema, desc *SchemaDescriptor)
 
 	// Struct case: has properties (with or without additionalProperties)
 	// Return the type name - actual struct definition is generated separately
+	if desc != nil && desc.Anonymous {
+		return g.anonymousStructType(desc)
+	}
 	if desc != nil && desc.ShortName != "" {
 		return desc.ShortName
 	}
@@ -377,8 +492,54 @@ func (g *TypeGenerator) mapType(schema *base.Schema, desc *SchemaDescriptor) str
 	return "map[string]any"
 }
 
-// arrayType generates a []T type for array schemas.
+// arrayType generates a []T type for array schemas, a Set[T] for those with
+// unique items when uniqueItemSets is set, or the tuple struct of those with
+// prefixItems.
 func (g *TypeGenerator) arrayType(schema *base.Schema, desc *SchemaDescriptor) string {
+	if len(schema.PrefixItems) > 0 {
+		return g.tupleType(desc)
+	}
+	sliceType := g.sliceType(schema, desc)
+	if !g.uniqueItemSets || schema.UniqueItems == nil || !*schema.UniqueItems {
+		return sliceType
+	}
+	itemType := strings.TrimPrefix(sliceType, "[]")
+	if !g.isComparableItem(schema.Items, itemType) {
+		return sliceType
+	}
+	return g.ctx.RuntimeTypesPrefix() + "Set[" + itemType + "]"
+}
+
+// isComparableItem reports whether the items of an array, of Go type
+// itemType, are comparable, so they can be held by a Set: they're strings,
+// numbers or booleans, or an enum of those.
+func (g *TypeGenerator) isComparableItem(items *base.DynamicValue[*base.SchemaProxy, bool], itemType string) bool {
+	switch itemType {
+	case "string", "bool", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	if items == nil || items.A == nil {
+		return false
+	}
+	var target *SchemaDescriptor
+	if items.A.IsReference() {
+		target = g.schemaIndex[items.A.GetReference()]
+	}
+	if target == nil || target.ShortName != itemType || target.Schema == nil || len(target.Schema.Enum) == 0 {
+		return false
+	}
+	for _, t := range target.Schema.Type {
+		switch t {
+		case "string", "integer", "number", "boolean":
+			return true
+		}
+	}
+	return false
+}
+
+// sliceType generates the []T type of an array schema.
+func (g *TypeGenerator) sliceType(schema *base.Schema, desc *SchemaDescriptor) string {
 	if schema.Items == nil || schema.Items.A == nil {
 		return "[]any"
 	}
@@ -386,11 +547,14 @@ func (g *TypeGenerator) arrayType(schema *base.Schema, desc *SchemaDescriptor) s
 	// Check if items is a reference
 	itemProxy := schema.Items.A
 	if itemProxy.IsReference() {
-		ref := itemProxy.GetReference()
+		ref := proxyRef(itemProxy)
 		// Check for external reference first
 		if !strings.HasPrefix(ref, "#") && strings.Contains(ref, "#") {
 			// External reference - use import mapping
 			tempDesc := &SchemaDescriptor{Ref: ref}
+			if desc != nil {
+				tempDesc.Path = desc.Path.Append("items")
+			}
 			itemType := g.externalRefType(tempDesc)
 			return "[]" + itemType
 		}
@@ -401,6 +565,9 @@ func (g *TypeGenerator) arrayType(schema *base.Schema, desc *SchemaDescriptor) s
 	}
 
 	// Check if we have a descriptor for the items schema
+	if desc != nil && desc.Items != nil && desc.Items.Anonymous {
+		return "[]" + g.anonymousStructType(desc.Items)
+	}
 	if desc != nil && desc.Items != nil && desc.Items.ShortName != "" {
 		return "[]" + desc.Items.ShortName
 	}
@@ -420,8 +587,13 @@ func (g *TypeGenerator) stringType(schema *base.Schema) string {
 		}
 	}
 
-	// When a runtime package is configured and this type comes from a template,
-	// reference it from the runtime package instead of embedding the template.
+	return g.specType(spec)
+}
+
+// specType returns the Go type for spec. When a runtime package is configured
+// and the type comes from a template, it is referenced from the runtime
+// package instead of embedding the template.
+func (g *TypeGenerator) specType(spec SimpleTypeSpec) string {
 	if g.ctx.RuntimeTypesPrefix() != "" && spec.Template != "" {
 		return g.ctx.RuntimeTypesPrefix() + spec.Type
 	}
@@ -432,15 +604,7 @@ func (g *TypeGenerator) stringType(schema *base.Schema) string {
 
 // integerType returns the Go type for an integer schema.
 func (g *TypeGenerator) integerType(schema *base.Schema) string {
-	spec := g.typeMapping.Integer.Default
-	if schema.Format != "" {
-		if formatSpec, ok := g.typeMapping.Integer.Formats[schema.Format]; ok {
-			spec = formatSpec
-		}
-	}
-
-	g.AddImport(spec.Import)
-	return spec.Type
+	return g.specType(g.typeMapping.integerSpec(schema))
 }
 
 // numberType returns the Go type for a number schema.
@@ -452,8 +616,7 @@ func (g *TypeGenerator) numberType(schema *base.Schema) string {
 		}
 	}
 
-	g.AddImport(spec.Import)
-	return spec.Type
+	return g.specType(spec)
 }
 
 // booleanType returns the Go type for a boolean schema.
@@ -504,21 +667,62 @@ func (g *TypeGenerator) resolveNullableOneOfType(nonNullProxy *base.SchemaProxy)
 
 // StructField represents a field in a generated Go struct.
 type StructField struct {
-	Name            string // Go field name
-	Type            string // Go type expression
-	JSONName        string // Original JSON property name
-	Required        bool   // Is this field required in the schema
-	Nullable        bool   // Is this field nullable (type includes "null")
-	Pointer         bool   // Should this be a pointer type
-	OmitEmpty       bool   // Include omitempty in json tag
-	OmitZero        bool   // Include omitzero in json tag (Go 1.24+)
-	JSONIgnore      bool   // Use json:"-" tag to exclude from marshaling
-	Doc             string // Field documentation
-	Default         string // Go literal for default value (empty if no default)
-	IsStruct        bool   // True if this field is a struct type (for recursive ApplyDefaults)
-	IsExternal      bool   // True if this field references an external type (ApplyDefaults via reflection)
-	IsNullableAlias bool   // True if type is a type alias to Nullable[T] (don't wrap or pointer)
-	Order           *int   // Optional field ordering (lower values come first)
+	Name             string   // Go field name
+	Type             string   // Go type expression
+	JSONName         string   // Original JSON property name
+	Required         bool     // Is this field required in the schema
+	Nullable         bool     // Is this field nullable (type includes "null")
+	Pointer          bool     // Should this be a pointer type
+	Optional         bool     // Is this an Optional[T] in place of a pointer
+	OmitEmpty        bool     // Include omitempty in json tag
+	OmitZero         bool     // Include omitzero in json tag (Go 1.24+)
+	JSONIgnore       bool     // Use json:"-" tag to exclude from marshaling
+	Doc              string   // Field documentation
+	Default          string   // Go literal for default value (empty if no default)
+	IsStruct         bool     // True if this field is a struct type (for recursive ApplyDefaults)
+	IsExternal       bool     // True if this field references an external type (ApplyDefaults via reflection)
+	IsNullableAlias  bool     // True if type is a type alias to Nullable[T] (don't wrap or pointer)
+	JSONOmitZeroOnly bool     // True if the json tag has omitzero without omitempty (encoding/json/v2, or optional-properties: omitzero)
+	Order            *int     // Optional field ordering (lower values come first)
+	Const            string   // Constant holding the value of a const property (empty if none)
+	Recursive        bool     // True if the field's type leads back to its struct, so it's held by pointer
+	ReadOnly         bool     // Is the property readOnly, sent only in responses
+	WriteOnly        bool     // Is the property writeOnly, sent only in requests
+	ValidateRules    []string // go-playground/validator rules of the value (e.g., "min=1")
+	ValidateRequired bool     // Whether the field is tagged required when required
+}
+
+// isCollectionType reports whether goType is a slice or a map, including
+// Base64Bytes, whose nil value already tells that it is absent.
+func isCollectionType(goType string) bool {
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") ||
+		goType == "Base64Bytes" || strings.HasSuffix(goType, ".Base64Bytes") ||
+		isSetType(goType)
+}
+
+// isSetType reports whether goType is a Set[T] of the runtime types package,
+// which is empty, rather than nil, when absent.
+func isSetType(goType string) bool {
+	if pkg, name, ok := strings.Cut(goType, "."); ok && !strings.ContainsAny(pkg, "[]*") {
+		goType = name
+	}
+	return strings.HasPrefix(goType, "Set[")
+}
+
+// Representations of the fields of optional properties in structs, the
+// values of the optional-properties output option and ExtOptionalProperties.
+const (
+	OptionalPropsPointer  = "pointer"  // *T, nil when absent; the default
+	OptionalPropsValue    = "value"    // T, tagged omitempty
+	OptionalPropsOmitZero = "omitzero" // T, tagged omitzero
+	OptionalPropsNullable = "nullable" // Nullable[T], unspecified when absent
+	OptionalPropsOptional = "optional" // Optional[T], tagged omitzero
+)
+
+// optionalElemType returns T of an Optional[T] type expression.
+func optionalElemType(goType string) string {
+	_, elem, _ := strings.Cut(goType, "Optional[")
+	return strings.TrimSuffix(elem, "]")
 }
 
 // applyRequiredOverride upgrades a field to required: clears OmitEmpty and
@@ -529,7 +733,19 @@ func applyRequiredOverride(field *StructField) {
 	}
 	field.Required = true
 	field.OmitEmpty = false
-	if !field.Nullable && !strings.HasPrefix(field.Type, "[]") && !strings.HasPrefix(field.Type, "map[") {
+	if field.Optional {
+		field.Type = optionalElemType(field.Type)
+		field.Optional = false
+		field.OmitZero = false
+		return
+	}
+	if isSetType(field.Type) {
+		field.OmitZero = false
+	}
+	if !field.Nullable && !field.Recursive && !isCollectionType(field.Type) {
+		if _, elem, ok := cutNullableType(field.Type); ok {
+			field.Type = elem
+		}
 		field.Type = strings.TrimPrefix(field.Type, "*")
 		field.Pointer = false
 	}
@@ -559,23 +775,31 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 			JSONName: propName,
 			Required: required[propName],
 		}
+		if resolved := propProxy.Schema(); resolved != nil {
+			field.ReadOnly = resolved.ReadOnly != nil && *resolved.ReadOnly
+			field.WriteOnly = resolved.WriteOnly != nil && *resolved.WriteOnly
+		}
 
 		// Parse extensions from the property schema
 		var propExtensions *Extensions
 		var propSchema *base.Schema
+		// nullableAlias is set when the field's type is an alias of Nullable[T]
+		var nullableAlias bool
 
 		// Resolve the property schema
 		var propType string
 		if propProxy.IsReference() {
-			ref := propProxy.GetReference()
+			ref := proxyRef(propProxy)
 			// Check if this is an external reference
 			if !strings.HasPrefix(ref, "#") && strings.Contains(ref, "#") {
 				// External reference - use import mapping
-				tempDesc := &SchemaDescriptor{Ref: ref}
+				tempDesc := &SchemaDescriptor{Ref: ref, Path: desc.Path.Append("properties", propName)}
 				propType = g.externalRefType(tempDesc)
 				field.IsExternal = true // external references need reflection-based ApplyDefaults
 			} else if target, ok := g.schemaIndex[ref]; ok {
 				propType = target.ShortName
+				field.Const = g.constName(target)
+				field.Recursive = g.reachesSchema(ref, componentRoot(desc.Path))
 				// Only set IsStruct if the referenced schema has ApplyDefaults
 				// This filters out array/map type aliases which don't have ApplyDefaults
 				field.IsStruct = schemaHasApplyDefaults(target.Schema)
@@ -585,6 +809,7 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 				if isNullablePrimitive(target.Schema) {
 					// Already Nullable[T] - use as value type directly
 					field.IsNullableAlias = true
+					nullableAlias = true
 				} else if isNullable(target.Schema) {
 					field.Nullable = true
 				}
@@ -610,15 +835,21 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 			// Always use goTypeForSchema to get the correct type expression
 			// This handles arrays, maps, and primitive types correctly
 			propType = g.goTypeForSchema(propSchema, desc.Properties[propName])
+			field.Const = g.constName(desc.Properties[propName])
 
 			// Check if this is a struct type (object with properties, or a named type)
 			if propSchema != nil {
 				if propSchema.Properties != nil && propSchema.Properties.Len() > 0 {
-					field.IsStruct = true
+					// Anonymous structs have no ApplyDefaults, nor defaults
+					field.IsStruct = desc.Properties[propName] == nil || !desc.Properties[propName].Anonymous
 				}
 				// Extract default value
 				if propSchema.Default != nil {
-					field.Default = formatDefaultValue(propSchema.Default.Value, propType)
+					defaultType := propType
+					if _, elem, ok := cutNullableType(propType); ok {
+						defaultType = elem
+					}
+					field.Default = formatDefaultValue(propSchema.Default.Value, defaultType)
 				}
 			}
 		}
@@ -651,14 +882,16 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 
 		// Apply extensions to the field
 		if propExtensions != nil {
-			// Name override
-			if propExtensions.NameOverride != "" {
+			// Name override, of inline properties: that of a referenced
+			// schema names its type
+			if propSchema != nil && propExtensions.NameOverride != "" {
 				field.Name = propExtensions.NameOverride
 			}
 
 			// Type override replaces the generated type entirely
 			if propExtensions.TypeOverride != nil {
 				propType = propExtensions.TypeOverride.TypeName
+				field.Const = ""
 				if propExtensions.TypeOverride.ImportPath != "" {
 					if propExtensions.TypeOverride.ImportAlias != "" {
 						g.AddImportAlias(propExtensions.TypeOverride.ImportPath, propExtensions.TypeOverride.ImportAlias)
@@ -668,6 +901,7 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 				}
 				// Type override bypasses nullable wrapping - the user specifies the exact type
 				field.IsNullableAlias = true // Don't wrap or add pointer
+				nullableAlias = false
 			}
 
 			// JSON ignore
@@ -675,15 +909,6 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 				field.JSONIgnore = true
 			}
 
-			// Deprecated reason appended to documentation
-			if propExtensions.DeprecatedReason != "" {
-				if field.Doc != "" {
-					field.Doc = field.Doc + "\nDeprecated: " + propExtensions.DeprecatedReason
-				} else {
-					field.Doc = "Deprecated: " + propExtensions.DeprecatedReason
-				}
-			}
-
 			// Order for field sorting
 			if propExtensions.Order != nil {
 				field.Order = propExtensions.Order
@@ -697,8 +922,9 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 		// - Collections (slices/maps) are never wrapped
 		// - Types already wrapped in Nullable[] are not double-wrapped
 		// - Type aliases to Nullable[T] are used as-is (IsNullableAlias)
-		isCollection := strings.HasPrefix(propType, "[]") || strings.HasPrefix(propType, "map[")
-		alreadyNullable := strings.Contains(propType, "Nullable[") || field.IsNullableAlias
+		isCollection := isCollectionType(propType)
+		// The fields of anonymous structs may be Nullable; they aren't
+		alreadyNullable := !strings.HasPrefix(propType, "struct {") && strings.Contains(propType, "Nullable[") || field.IsNullableAlias
 
 		if field.Nullable && !isCollection && !alreadyNullable {
 			// Use Nullable[T] for nullable fields (generated inline from template)
@@ -709,21 +935,53 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 			}
 			field.Pointer = false
 		} else if !field.Required && !isCollection && !alreadyNullable {
-			// Check for skip optional pointer extension
-			skipPointer := false
+			// The schema may override how its optional properties are held,
+			// and an inline property how it's held itself
+			style := g.optionalProperties
+			if desc.Extensions != nil && desc.Extensions.OptionalProperties != "" {
+				style = desc.Extensions.OptionalProperties
+			}
+			if propSchema != nil && propExtensions != nil && propExtensions.OptionalProperties != "" {
+				style = propExtensions.OptionalProperties
+			}
 			if propExtensions != nil && propExtensions.SkipOptionalPointer != nil && *propExtensions.SkipOptionalPointer {
-				skipPointer = true
+				style = OptionalPropsValue
+			}
+			if field.Recursive {
+				// A recursive type can't hold itself by value
+				style = OptionalPropsPointer
 			}
 
-			if skipPointer {
+			switch style {
+			case OptionalPropsValue, OptionalPropsOmitZero:
 				// Use value type even though optional
 				field.Type = propType
 				field.Pointer = false
-			} else {
+			case OptionalPropsNullable:
+				// Nullable[T] is unspecified, and omitted, when absent
+				field.Type = g.ctx.RuntimeTypesPrefix() + "Nullable[" + propType + "]"
+				field.Pointer = false
+			case OptionalPropsOptional:
+				// Optional[T] marks absent fields without a pointer
+				field.Type = g.ctx.RuntimeTypesPrefix() + "Optional[" + propType + "]"
+				field.Optional = true
+				field.Pointer = false
+			default:
 				// Use pointer for optional non-nullable fields
 				field.Type = "*" + propType
 				field.Pointer = true
 			}
+			if style == OptionalPropsOmitZero {
+				// Omitted from JSON when zero, but written when empty, such
+				// as an empty struct
+				field.OmitZero = true
+				field.JSONOmitZeroOnly = true
+			}
+		} else if field.Recursive && !isCollection && !alreadyNullable {
+			// Pointer for required fields whose type leads back to this one,
+			// which can't hold itself by value
+			field.Type = "*" + propType
+			field.Pointer = true
 		} else {
 			// Value type for required non-nullable fields, collections, and Nullable aliases
 			field.Type = propType
@@ -732,20 +990,59 @@ func (g *TypeGenerator) GenerateStructFields(desc *SchemaDescriptor) []StructFie
 
 		// Determine omitempty/omitzero behavior
 		field.OmitEmpty = !field.Required
-		if propExtensions != nil {
+		if propExtensions != nil && propExtensions.OmitEmpty != nil {
 			// Explicit omitempty override
-			if propExtensions.OmitEmpty != nil {
-				field.OmitEmpty = *propExtensions.OmitEmpty
-			}
+			field.OmitEmpty = *propExtensions.OmitEmpty
+		}
+		// The omitzero struct tags mode tags the fields omitempty would
+		// omit omitzero, in place of omitempty or along with it
+		if mode := g.tagGenerator.omitZero; mode != "" && field.OmitEmpty {
+			field.OmitZero = true
+			field.JSONOmitZeroOnly = field.JSONOmitZeroOnly || mode == OmitZeroReplace
+		}
+		if propExtensions != nil && propExtensions.OmitZero != nil {
 			// Explicit omitzero
-			if propExtensions.OmitZero != nil && *propExtensions.OmitZero {
-				field.OmitZero = true
+			field.OmitZero = *propExtensions.OmitZero
+			if !field.OmitZero {
+				field.JSONOmitZeroOnly = false
 			}
 		}
+		// Optional fields are omitted through Optional.IsZero when absent,
+		// and optional sets through Set.IsZero when empty.
+		if field.Optional || (!field.Required && isSetType(field.Type)) {
+			field.OmitZero = true
+		}
+		// Optional Nullable fields are omitted through Nullable.IsZero only
+		// while unspecified; explicit nulls are still written.
+		if (g.nullableOmitZero || g.jsonV2) && !field.Required &&
+			(nullableAlias || strings.HasPrefix(field.Type, g.ctx.RuntimeTypesPrefix()+"Nullable[")) {
+			field.OmitZero = true
+			// encoding/json/v2 omits explicit nulls with omitempty too.
+			field.JSONOmitZeroOnly = field.JSONOmitZeroOnly || g.jsonV2
+		}
+
+		setValidateRules(&field, propProxy.Schema())
 
 		fields = append(fields, field)
 	}
 
+	// Distinct properties can share a Go name, such as "id" and "ID", or
+	// "resume" and "résumé"; number them apart, as enum constants are.
+	names := make([]string, len(fields))
+	originals := make([]string, len(fields))
+	for i, f := range fields {
+		names[i], originals[i] = f.Name, f.JSONName
+	}
+	where := func(i int) SchemaPath {
+		if desc.Schema != nil && desc.Schema.Properties != nil && desc.Schema.Properties.GetOrZero(originals[i]) != nil {
+			return desc.Path.Append("properties", originals[i])
+		}
+		return desc.Path
+	}
+	for i, name := range disambiguateNames(g.ctx, "field", where, originals, names) {
+		fields[i].Name = name
+	}
+
 	// Sort fields by order if any have explicit ordering
 	sortFieldsByOrder(fields)
 
@@ -850,12 +1147,34 @@ func isNullable(schema *base.Schema) bool {
 	return false
 }
 
-// extractDescription gets the description from a schema.
+// extractDescription gets the description from a schema, followed by a
+// Deprecated paragraph if the schema is deprecated or has a deprecation reason.
 func extractDescription(schema *base.Schema) string {
 	if schema == nil {
 		return ""
 	}
-	return schema.Description
+	var reason string
+	if ext, err := ParseExtensions(schema.Extensions, ""); err == nil {
+		reason = ext.DeprecatedReason
+	}
+	return withDeprecation(schema.Description, schema.Deprecated != nil && *schema.Deprecated, reason)
+}
+
+// withDeprecation appends to doc the Deprecated paragraph, which linters such
+// as staticcheck recognize, of something deprecated or with a deprecation
+// reason. Without a reason, the paragraph says the spec deprecates it.
+func withDeprecation(doc string, deprecated bool, reason string) string {
+	if !deprecated && reason == "" {
+		return doc
+	}
+	if reason == "" {
+		reason = "the OpenAPI spec marks this as deprecated."
+	}
+	doc = strings.TrimRight(doc, "\n")
+	if doc == "" {
+		return "Deprecated: " + reason
+	}
+	return doc + "\n\nDeprecated: " + reason
 }
 
 // formatDefaultValue converts an OpenAPI default value to a Go literal.
@@ -885,6 +1204,19 @@ func formatDefaultValue(value any, goType string) string {
 		case "float32", "float64":
 			return v
 		}
+		// Struct types are parsed from the string
+		for _, parsed := range []string{"URI", "IPv4", "IPv6", "Time", "DateTime", "DateTimeMillis", "BigInt", "Decimal"} {
+			if baseType == parsed || strings.HasSuffix(baseType, "."+parsed) {
+				return fmt.Sprintf("%sMustParse%s(%q)", strings.TrimSuffix(baseType, parsed), parsed, v)
+			}
+		}
+		if baseType == "DecimalNumber" || strings.HasSuffix(baseType, ".DecimalNumber") {
+			prefix := strings.TrimSuffix(baseType, "DecimalNumber")
+			return fmt.Sprintf("%sDecimalNumber{Decimal: %sMustParseDecimal(%q)}", prefix, prefix, v)
+		}
+		if baseType == "json.Number" {
+			return fmt.Sprintf("json.Number(%q)", v)
+		}
 		// It's actually a string type - quote it
 		return fmt.Sprintf("%q", v)
 	case bool:
@@ -956,6 +1288,7 @@ const (
 	KindAllOf
 	KindAnyOf
 	KindOneOf
+	KindTuple
 	KindReference
 )
 
@@ -970,11 +1303,14 @@ func GetSchemaKind(desc *SchemaDescriptor) SchemaKind {
 		return KindAlias
 	}
 
-	// Enum check first (plain `enum: [...]` or the 3.1 oneOf+const idiom
-	// detected and cached during gather).
+	// Enum check first (plain `enum: [...]`, the 3.1 oneOf+const idiom
+	// detected and cached during gather, or a single const value).
 	if len(schema.Enum) > 0 || len(desc.ConstOneOfItems) > 0 {
 		return KindEnum
 	}
+	if _, _, ok := constValue(schema); ok {
+		return KindEnum
+	}
 
 	// Composition types
 	if len(schema.AllOf) > 0 {
@@ -998,6 +1334,11 @@ func GetSchemaKind(desc *SchemaDescriptor) SchemaKind {
 		return KindStruct
 	}
 
+	// Array with prefixItems -> tuple struct
+	if len(schema.PrefixItems) > 0 {
+		return KindTuple
+	}
+
 	// Object with only additionalProperties -> map
 	primaryType := getPrimaryType(schema)
 	if primaryType == "object" {
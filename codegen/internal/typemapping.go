@@ -1,5 +1,7 @@
 package codegen
 
+import "github.com/pb33f/libopenapi/datamodel/high/base"
+
 // SimpleTypeSpec is used to define the Go typename of a simple type like
 // an int or a string, along with the import required to use it.
 type SimpleTypeSpec struct {
@@ -20,6 +22,11 @@ type TypeMapping struct {
 	Number  FormatMapping `yaml:"number,omitempty"`
 	Boolean FormatMapping `yaml:"boolean,omitempty"`
 	String  FormatMapping `yaml:"string,omitempty"`
+
+	// UnsignedNonNegative maps integer schemas whose minimum is 0 or more to
+	// the unsigned counterpart of the builtin type of their format, such as
+	// uint32 for int32.
+	UnsignedNonNegative bool `yaml:"unsigned-non-negative,omitempty"`
 }
 
 // Merge returns a new TypeMapping with user overrides applied on top of base.
@@ -29,9 +36,87 @@ func (base TypeMapping) Merge(user TypeMapping) TypeMapping {
 		Number:  base.Number.merge(user.Number),
 		Boolean: base.Boolean.merge(user.Boolean),
 		String:  base.String.merge(user.String),
+
+		UnsignedNonNegative: base.UnsignedNonNegative || user.UnsignedNonNegative,
 	}
 }
 
+// Types of Generation.PreciseNumbers.
+const (
+	PreciseNumbersJSONNumber = "json-number"
+	PreciseNumbersBig        = "big"
+)
+
+// withPreciseNumbers returns tm with its integer and number defaults, and
+// unless unformattedOnly the formats mapped to builtin integer or float
+// types, replaced with the precise types of mode. Other modes leave tm as
+// it is.
+func (tm TypeMapping) withPreciseNumbers(mode string, unformattedOnly bool) TypeMapping {
+	var integer, number SimpleTypeSpec
+	switch mode {
+	case PreciseNumbersJSONNumber:
+		integer = SimpleTypeSpec{Type: "json.Number", Import: "encoding/json"}
+		number = integer
+	case PreciseNumbersBig:
+		integer = SimpleTypeSpec{Type: "BigInt", Template: "big_int.tmpl"}
+		number = SimpleTypeSpec{Type: "DecimalNumber", Template: "decimal.tmpl"}
+	default:
+		return tm
+	}
+	precise := func(fm FormatMapping, spec SimpleTypeSpec) FormatMapping {
+		result := FormatMapping{Default: spec, Formats: make(map[string]SimpleTypeSpec, len(fm.Formats))}
+		for format, formatSpec := range fm.Formats {
+			if !unformattedOnly && formatSpec.Import == "" && formatSpec.Template == "" && isNumericType(formatSpec.Type) {
+				formatSpec = spec
+			}
+			result.Formats[format] = formatSpec
+		}
+		return result
+	}
+	tm.Integer = precise(tm.Integer, integer)
+	tm.Number = precise(tm.Number, number)
+	return tm
+}
+
+// isNumericType reports whether goType is a builtin integer or float type.
+func isNumericType(goType string) bool {
+	return isIntegerType(goType) || goType == "float32" || goType == "float64"
+}
+
+// unsignedIntegerTypes maps the signed builtin integer types to their
+// unsigned counterparts.
+var unsignedIntegerTypes = map[string]string{
+	"int":   "uint",
+	"int8":  "uint8",
+	"int16": "uint16",
+	"int32": "uint32",
+	"int64": "uint64",
+}
+
+// integerSpec returns the SimpleTypeSpec of an integer schema: that of its
+// format, or the default, made unsigned under UnsignedNonNegative when the
+// schema's minimum rules out negative values.
+func (tm TypeMapping) integerSpec(schema *base.Schema) SimpleTypeSpec {
+	spec := formatSpec(tm.Integer, schema.Format)
+	if !tm.UnsignedNonNegative || spec.Import != "" || spec.Template != "" || !isNonNegative(schema) {
+		return spec
+	}
+	if unsigned, ok := unsignedIntegerTypes[spec.Type]; ok {
+		spec.Type = unsigned
+	}
+	return spec
+}
+
+// isNonNegative reports whether the minimum of an integer schema, inclusive
+// or, in OpenAPI 3.1, exclusive, rules out negative values.
+func isNonNegative(schema *base.Schema) bool {
+	if schema.Minimum != nil && *schema.Minimum >= 0 {
+		return true
+	}
+	min := schema.ExclusiveMinimum
+	return min != nil && min.IsB() && min.B >= -1
+}
+
 func (base FormatMapping) merge(user FormatMapping) FormatMapping {
 	result := FormatMapping{
 		Default: base.Default,
@@ -45,17 +130,49 @@ func (base FormatMapping) merge(user FormatMapping) FormatMapping {
 
 	// Override with user default if specified
 	if user.Default.Type != "" {
-		result.Default = user.Default
+		result.Default = user.Default.withRuntimeTemplate()
 	}
 
 	// Override/add user formats
 	for k, v := range user.Formats {
-		result.Formats[k] = v
+		result.Formats[k] = v.withRuntimeTemplate()
 	}
 
 	return result
 }
 
+// runtimeTypeTemplates maps the custom types of the runtime types package to
+// their templates, so that a user mapping naming one, such as UUIDString,
+// refers to the runtime package when one is configured.
+var runtimeTypeTemplates = map[string]string{
+	"Base64Bytes":    "base64_bytes.tmpl",
+	"BigInt":         "big_int.tmpl",
+	"Date":           "date.tmpl",
+	"DateTime":       "date_time.tmpl",
+	"DateTimeMillis": "date_time.tmpl",
+	"Decimal":        "decimal.tmpl",
+	"DecimalNumber":  "decimal.tmpl",
+	"Duration":       "duration.tmpl",
+	"Email":          "email.tmpl",
+	"StrictEmail":    "strict_email.tmpl",
+	"File":           "file.tmpl",
+	"IPv4":           "ip.tmpl",
+	"IPv6":           "ip.tmpl",
+	"Time":           "time.tmpl",
+	"URI":            "uri.tmpl",
+	"UUID":           "uuid.tmpl",
+	"UUIDString":     "uuid_string.tmpl",
+}
+
+// withRuntimeTemplate fills in the template of a spec naming a runtime type
+// without an import.
+func (s SimpleTypeSpec) withRuntimeTemplate() SimpleTypeSpec {
+	if s.Import == "" && s.Template == "" {
+		s.Template = runtimeTypeTemplates[s.Type]
+	}
+	return s
+}
+
 // DefaultTypeMapping provides the default OpenAPI type/format to Go type mappings.
 var DefaultTypeMapping = TypeMapping{
 	Integer: FormatMapping{
@@ -76,8 +193,10 @@ var DefaultTypeMapping = TypeMapping{
 	Number: FormatMapping{
 		Default: SimpleTypeSpec{Type: "float32"},
 		Formats: map[string]SimpleTypeSpec{
-			"float":  {Type: "float32"},
-			"double": {Type: "float64"},
+			"float":   {Type: "float32"},
+			"double":  {Type: "float64"},
+			"decimal": {Type: "DecimalNumber", Template: "decimal.tmpl"},
+			"money":   {Type: "DecimalNumber", Template: "decimal.tmpl"},
 		},
 	},
 	Boolean: FormatMapping{
@@ -86,13 +205,21 @@ var DefaultTypeMapping = TypeMapping{
 	String: FormatMapping{
 		Default: SimpleTypeSpec{Type: "string"},
 		Formats: map[string]SimpleTypeSpec{
-			"byte":      {Type: "[]byte"},
-			"email":     {Type: "Email", Template: "email.tmpl"},
-			"date":      {Type: "Date", Template: "date.tmpl"},
-			"date-time": {Type: "time.Time", Import: "time"},
-			"json":      {Type: "json.RawMessage", Import: "encoding/json"},
-			"uuid":      {Type: "UUID", Template: "uuid.tmpl"},
-			"binary":    {Type: "File", Template: "file.tmpl"},
+			"byte":          {Type: "Base64Bytes", Template: "base64_bytes.tmpl"},
+			"email":         {Type: "Email", Template: "email.tmpl"},
+			"date":          {Type: "Date", Template: "date.tmpl"},
+			"date-time":     {Type: "time.Time", Import: "time"},
+			"time":          {Type: "Time", Template: "time.tmpl"},
+			"decimal":       {Type: "Decimal", Template: "decimal.tmpl"},
+			"duration":      {Type: "Duration", Template: "duration.tmpl"},
+			"money":         {Type: "Decimal", Template: "decimal.tmpl"},
+			"json":          {Type: "json.RawMessage", Import: "encoding/json"},
+			"uuid":          {Type: "UUID", Template: "uuid.tmpl"},
+			"uri":           {Type: "URI", Template: "uri.tmpl"},
+			"uri-reference": {Type: "URI", Template: "uri.tmpl"},
+			"ipv4":          {Type: "IPv4", Template: "ip.tmpl"},
+			"ipv6":          {Type: "IPv6", Template: "ip.tmpl"},
+			"binary":        {Type: "File", Template: "file.tmpl"},
 		},
 	},
 }
@@ -0,0 +1,154 @@
+package codegen
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// validateFormats maps string formats to the go-playground/validator tags
+// checking them.
+var validateFormats = map[string]string{
+	"email":    "email",
+	"uuid":     "uuid",
+	"uri":      "uri",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"hostname": "hostname_rfc1123",
+}
+
+// setValidateRules sets the go-playground/validator rules of a field from the
+// constraints of its property schema. Fields held as Nullable or Optional,
+// or with a type override, get none, as validator can't reach their values.
+// String formats are checked only of fields held as strings or Email, not of
+// those mapped to types such as UUID, which validator can't read as strings.
+func setValidateRules(f *StructField, schema *base.Schema) {
+	if schema == nil || f.JSONIgnore || f.IsNullableAlias || f.Optional ||
+		strings.Contains(f.Type, "Nullable[") {
+		return
+	}
+	goType := strings.TrimPrefix(f.Type, "*")
+
+	var rules []string
+	add := func(name string, value any) {
+		switch v := value.(type) {
+		case int64:
+			rules = append(rules, name+"="+strconv.FormatInt(v, 10))
+		case float64:
+			rules = append(rules, name+"="+strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			rules = append(rules, name)
+		}
+	}
+	length := func(min, max *int64) {
+		switch {
+		case min != nil && max != nil && *min == *max:
+			add("len", *min)
+		default:
+			if min != nil {
+				add("min", *min)
+			}
+			if max != nil {
+				add("max", *max)
+			}
+		}
+	}
+
+	switch {
+	case slices.Contains(schema.Type, "integer") || slices.Contains(schema.Type, "number"):
+		// Bounds can only be checked of builtin numbers, not of precise
+		// number types such as json.Number, a string to validator.
+		if !isNumericType(goType) {
+			break
+		}
+		// OpenAPI 3.0 flags the bounds as exclusive, 3.1 declares exclusive
+		// bounds of their own.
+		if min := schema.Minimum; min != nil {
+			if schema.ExclusiveMinimum != nil && schema.ExclusiveMinimum.IsA() && schema.ExclusiveMinimum.A {
+				add("gt", *min)
+			} else {
+				add("gte", *min)
+			}
+		}
+		if min := schema.ExclusiveMinimum; min != nil && min.IsB() {
+			add("gt", min.B)
+		}
+		if max := schema.Maximum; max != nil {
+			if schema.ExclusiveMaximum != nil && schema.ExclusiveMaximum.IsA() && schema.ExclusiveMaximum.A {
+				add("lt", *max)
+			} else {
+				add("lte", *max)
+			}
+		}
+		if max := schema.ExclusiveMaximum; max != nil && max.IsB() {
+			add("lt", max.B)
+		}
+	case slices.Contains(schema.Type, "string"):
+		length(schema.MinLength, schema.MaxLength)
+		if tag, ok := validateFormats[schema.Format]; ok && (goType == "string" || isEmailType(goType)) {
+			add(tag, nil)
+		}
+	case slices.Contains(schema.Type, "array"):
+		if !strings.HasPrefix(goType, "[]") {
+			break
+		}
+		length(schema.MinItems, schema.MaxItems)
+		if schema.UniqueItems != nil && *schema.UniqueItems {
+			add("unique", nil)
+		}
+	case strings.HasPrefix(goType, "map["):
+		length(schema.MinProperties, schema.MaxProperties)
+	}
+	if oneOf := validateOneOf(schema); oneOf != "" {
+		add("oneof="+oneOf, nil)
+	}
+
+	f.ValidateRules = rules
+	// The zero value of a boolean or number is a value like any other, which
+	// required would reject.
+	f.ValidateRequired = !slices.Contains(schema.Type, "boolean") &&
+		!slices.Contains(schema.Type, "integer") && !slices.Contains(schema.Type, "number")
+}
+
+// validateOneOf returns the values of a string or integer enum, separated by
+// spaces for the oneof rule of go-playground/validator, or "" if the schema
+// isn't one or a value can't be written in the rule.
+func validateOneOf(schema *base.Schema) string {
+	if len(schema.Enum) == 0 ||
+		(!slices.Contains(schema.Type, "string") && !slices.Contains(schema.Type, "integer")) {
+		return ""
+	}
+	var values []string
+	for _, node := range schema.Enum {
+		if node == nil || node.Tag == "!!null" {
+			continue
+		}
+		if node.Value == "" || strings.ContainsAny(node.Value, " ,|\"`'\\") {
+			return ""
+		}
+		values = append(values, node.Value)
+	}
+	return strings.Join(values, " ")
+}
+
+// validateTag returns the go-playground/validator tag of a field: its rules,
+// after required for required fields whose zero value isn't valid, or
+// omitempty for optional fields, so absent values aren't checked.
+func validateTag(f StructField) string {
+	rules := f.ValidateRules
+	switch {
+	case f.Required && f.ValidateRequired:
+		rules = append([]string{"required"}, rules...)
+	case !f.Required && len(rules) > 0:
+		rules = append([]string{"omitempty"}, rules...)
+	}
+	return strings.Join(rules, ",")
+}
+
+// isEmailType reports whether goType is the Email type of the runtime types
+// package, a string.
+func isEmailType(goType string) bool {
+	return goType == "Email" || strings.HasSuffix(goType, ".Email")
+}
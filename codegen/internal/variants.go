@@ -0,0 +1,152 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// resolveRequestResponseVariants names the request and response variants of
+// component schemas with readOnly or writeOnly properties, so that request
+// bodies and responses referencing them use the variants. A schema gets a
+// <Name>Request variant without its readOnly properties if it has any, and a
+// <Name>Response variant without its writeOnly properties if it has any.
+// Variants whose name another type already holds are skipped with a warning.
+func resolveRequestResponseVariants(ctx *CodegenContext, schemas []*SchemaDescriptor) {
+	taken := make(map[string]bool, len(schemas))
+	for _, desc := range schemas {
+		if desc.ShortName != "" {
+			taken[desc.ShortName] = true
+		}
+	}
+
+	for _, desc := range schemas {
+		path := desc.Path
+		if len(path) != 3 || path[0] != "components" || path[1] != "schemas" || desc.ShortName == "" {
+			continue
+		}
+		if desc.Extensions != nil && desc.Extensions.TypeOverride != nil {
+			continue
+		}
+		if kind := GetSchemaKind(desc); kind != KindStruct && kind != KindAllOf {
+			continue
+		}
+		readOnly, writeOnly, ok := readWriteOnlyProperties(desc.Schema, map[*base.Schema]bool{})
+		if !ok || (!readOnly && !writeOnly) {
+			continue
+		}
+
+		variant := func(suffix string) string {
+			name := desc.ShortName + suffix
+			if taken[name] {
+				ctx.Warn(path, "not generating the %s variant of %s, as another type is named %s", suffix, desc.ShortName, name)
+				return ""
+			}
+			taken[name] = true
+			return name
+		}
+		if readOnly {
+			desc.RequestVariant = variant("Request")
+		}
+		if writeOnly {
+			desc.ResponseVariant = variant("Response")
+		}
+	}
+}
+
+// readWriteOnlyProperties reports whether a struct schema, including the
+// parts of an allOf, has readOnly or writeOnly properties. ok is false for
+// an allOf with a oneOf or anyOf part, whose fields a variant can't hold.
+func readWriteOnlyProperties(schema *base.Schema, visited map[*base.Schema]bool) (readOnly, writeOnly, ok bool) {
+	if schema == nil || visited[schema] {
+		return false, false, true
+	}
+	visited[schema] = true
+
+	if schema.Properties != nil {
+		for pair := schema.Properties.First(); pair != nil; pair = pair.Next() {
+			prop := pair.Value().Schema()
+			if prop == nil {
+				continue
+			}
+			readOnly = readOnly || (prop.ReadOnly != nil && *prop.ReadOnly)
+			writeOnly = writeOnly || (prop.WriteOnly != nil && *prop.WriteOnly)
+		}
+	}
+	for _, proxy := range schema.AllOf {
+		part := proxy.Schema()
+		if part == nil {
+			continue
+		}
+		if len(part.OneOf) > 0 || len(part.AnyOf) > 0 {
+			return false, false, false
+		}
+		r, w, partOK := readWriteOnlyProperties(part, visited)
+		if !partOK {
+			return false, false, false
+		}
+		readOnly, writeOnly = readOnly || r, writeOnly || w
+	}
+	return readOnly, writeOnly, true
+}
+
+// generateRequestResponseVariants generates the variants named by
+// resolveRequestResponseVariants for a struct with the given fields, and the
+// ToRequest and ToResponse methods converting the struct to them.
+func generateRequestResponseVariants(gen *TypeGenerator, desc *SchemaDescriptor, fields []StructField) string {
+	var code string
+	variants := []struct {
+		name   string
+		method string
+		omit   func(StructField) bool
+		doc    string
+	}{
+		{desc.RequestVariant, "ToRequest", func(f StructField) bool { return f.ReadOnly }, "sent in requests, without its readOnly properties"},
+		{desc.ResponseVariant, "ToResponse", func(f StructField) bool { return f.WriteOnly }, "sent in responses, without its writeOnly properties"},
+	}
+	for _, v := range variants {
+		if v.name == "" {
+			continue
+		}
+		var kept []StructField
+		for _, f := range fields {
+			if !v.omit(f) {
+				kept = append(kept, f)
+			}
+		}
+		doc := fmt.Sprintf("%s is the %s %s.", v.name, desc.ShortName, v.doc)
+		code += "\n" + generateStructCode(gen, desc, v.name, kept, doc)
+		code += generateVariantConversion(gen, desc, v.name, v.method, fields, kept)
+	}
+	return code
+}
+
+// generateVariantConversion generates the method converting a struct to its
+// variant, copying the fields they share. It's skipped when a field holds
+// the method's name.
+func generateVariantConversion(gen *TypeGenerator, desc *SchemaDescriptor, variant, method string, fields, kept []StructField) string {
+	for _, f := range fields {
+		if f.Name == method {
+			return ""
+		}
+	}
+
+	b := NewCodeBuilder()
+	b.BlankLine()
+	b.Line("// %s returns the %s fields of s.", method, variant)
+	b.Line("func (s %s) %s() %s {", desc.ShortName, method, variant)
+	b.Indent()
+	b.Line("return %s{", variant)
+	b.Indent()
+	for _, f := range kept {
+		b.Line("%s: s.%s,", f.Name, f.Name)
+	}
+	if gen.HasAdditionalProperties(desc) {
+		b.Line("AdditionalProperties: s.AdditionalProperties,")
+	}
+	b.Dedent()
+	b.Line("}")
+	b.Dedent()
+	b.Line("}")
+	return b.String()
+}
@@ -91,7 +91,10 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 	callbackPort := listener.Addr().(*net.TCPAddr).Port
-	callbackURL := fmt.Sprintf("http://localhost:%d/tree_callback", callbackPort)
+	callbackURL, err := treefarm.ParseURI(fmt.Sprintf("http://localhost:%d/tree_callback", callbackPort))
+	if err != nil {
+		log.Fatalf("Failed to build callback URL: %v", err)
+	}
 	log.Printf("Callback receiver listening on port %d", callbackPort)
 
 	go func() {
@@ -48,7 +48,7 @@ func (tf *TreeFarm) PlantTree(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if req.CallbackURL == "" {
+	if req.CallbackURL.String() == "" {
 		sendError(w, http.StatusBadRequest, "callbackUrl is required")
 		return
 	}
@@ -85,7 +85,7 @@ func (tf *TreeFarm) plantAndNotify(id uuid.UUID, req treefarm.TreePlantingReques
 
 	log.Printf("Tree %s planted, invoking callback at %s", id, req.CallbackURL)
 
-	resp, err := tf.initiator.TreePlanted(context.Background(), req.CallbackURL, result)
+	resp, err := tf.initiator.TreePlanted(context.Background(), req.CallbackURL.String(), result)
 	if err != nil {
 		log.Printf("Callback to %s failed: %v", req.CallbackURL, err)
 		return
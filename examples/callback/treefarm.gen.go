@@ -6,16 +6,19 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	crand "crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/google/uuid"
+	googleuuid "github.com/google/uuid"
 )
 
 // #/components/schemas/Tree
@@ -40,7 +43,7 @@ type TreePlantingRequest struct {
 	// What kind of tree to plant (e.g. "oak")
 	Kind string `form:"kind" json:"kind"`
 	// URL to receive the planting result callback
-	CallbackURL string `form:"callbackUrl" json:"callbackUrl"`
+	CallbackURL URI `form:"callbackUrl" json:"callbackUrl"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -93,26 +96,26 @@ func (s *Error) ApplyDefaults() {
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/8RXwW7bOBC96ysGaQG3QCO76U23bJEFAhSbIHGQ44IWxxYbilTJUbzG7gL7EfuF+yUL",
-	"khJFy4rr9NKcopDzZvhm5s1EN6hYIwo4+5R/zBdnmVBrXWQAz2is0KqAj/kiX2QAJEhiAUuDCL8yU2cA",
-	"HG1pREP+3l8ZAMAlkLvQSKZIqA1YNM+iRKCKEXCstbJkGKGFmwbV5e01lEzKFSufbO4BlhVCKQUqAtuu",
-	"akEW2AjT4LcWLQGTWm1gK6gCFmHg4e5LQHqsUAFVGKwrZmGFqAIM8g/+yIWHBiwq7vzc3twvgbQ/SgE9",
-	"HmlQmsR6F45DjHrtvwzaVpLzK0WJymLhTRSrsYDLhpUVwoWnEaA1soCKqLHFfL7dbnPmz3NtNvPO2s6/",
-	"XH+++u3+6vwiX+QV1TJrGFXWoc5ZI+b+Eb+7hwVHjbYUfgOwbV0zsyvgrudpn7/u2kTy3M99JL2nmXQw",
-	"7XEYBeoaLMVaIAepS+Zg8giyrIQF4UBcis5Nq5RLnG7Q+Jvw3z//pvyzssSGbEeldxuxmOIgGaEJ7Au0",
-	"MT1o4Fkw/9kY/Sw48ok6gMHvNS/g1r3FVXF32Pn7RfNdEZ2+NbguYPZmXuq60QoV2flwUaCdO4TbjtFZ",
-	"hLKNdukbgGYXi4vZ8DmifTld154N5IlVqRWhohQIgDWNFIH6+Ver1f4pgC0rrNn4r9OPC3fDsx4FVdd8",
-	"lg0hr1kr6cVXtAr/aLAk5IDGaPMz4r5yjvuQo6QMGNSnC3kKPPvzbcd6vtJ892bemz4Y+fdsP4S0x2Ko",
-	"fa+NM+nkoCvY8NKR4QvdF7vQactql/bIhCzBdk/iRg0+/Ax955iT6OT3nW3LEq1dt1LuQPvuep9nLxi6",
-	"xlkOFI6uTbZQeigM8gLItHhwPFkhp9XJ8Wo5rdb7Fr7zGZsdPOygoZPGXsymvO5l9nOfLYMlimfkkNKe",
-	"HdoedNprO+4EXk9l9nvcntSRw4mD6Q4D4jKOLwDaNViAXn3FkqYGVLdXkIYV9iM8GxVYDPM8jqTkT09C",
-	"9RaNcYVNIs1rb5E+NgRlyew31V5gjxUaHGZkbMZ3mG9yOFPaUAU1Mq63Z+8jiAvm9Z4YeUO/dHRsBK+d",
-	"M82ezt5nkduhtn17FscH/+X0lvXBacZK+PkdH8eRmJB2aveKeGtterlxypNqYS8zTMqbdZq273XrLLk7",
-	"UTHT1RCuJ8KenExVQjpBHowcV/8LqeqeXDMqoDXimNw75SbdS0JYX0aTo/c/JDMM5eJIb3TJCOPinFkr",
-	"Ngo5CI7K703mJ7Au+AlkC/4jHLeCHyVZiW8tJq/3BUluMR2X+FTH2ESG91e2uO27Nhz/axIH5gf3PwX5",
-	"9TTm8gfpH69jr05CN3JOyER3czodK60lsqOLzGOFVLldpReKLbN7Ey9Kt0fxI+LIBJhS9lJzTD5rtJZt",
-	"8IiwO4NDqRWKcIPp4OwLSyj6dPGSBvuI92PoInitmgekPvwsXaJi+GlJThfjlGJnR/aug63g2CYwNf1P",
-	"36d8LLPs/wAAAP//y9dX5mEQAAA=",
+	"H4sIAAAAAAAC/8RXzW7cNhC+6ykGToBNgFjaODfd3MAFDAS1Ya/hY8EVZ1eMKVIhR94u2gJ9iD5hn6Qg",
+	"JVGUVt6sc4lP0Q7nm5lvfqNrVKwWOZx9Sj+my7NEqI3OE4BnNFZolcPHdJkuEwASJDGHlUGEX5mpEgCO",
+	"tjCiJv/urwQA4BLIPaglUyTUFiyaZ1EgUMkIOFZaWTKM0MJNjery9hoKJuWaFU829QCrEqGQAhWBbdaV",
+	"IAtsgmnwW4OWgEmttrATVAILMPBw96VFeixRAZXYapfMwhpRtTDIP3iRcw8NWFTc2bm9uV8BaS+KAT0e",
+	"aVCaxGYPNPioN/7LoG0kObtSFKgs5l5FsQpzuKxZUSJceBoBGiNzKIlqm2fZbrdLmZen2myzTttmX64/",
+	"X/12f3V+kS7TkiqZ1IxK61AzVovMB/G7C6w1VGtL7b8AbFNVzOxzuOt5GvPXPZtJnvu7D6T3NJNuVXsc",
+	"Ri11NRZiI5CD1AVzMGkAWZXCgnAgLkXnplHKJU7XaPxL+O+ff2P+WVFgTbaj0psNWExxkIzQtOwLtCE9",
+	"aOBZMP9ZG/0sOPKZOoDB7jXP4dbF4qq4E3b2ftF8nwejbw1ucli8yQpd1VqhIpsNDwXazCHcdowuApSt",
+	"tUvfALS4WF4shs8J7av5uvZsII+0Cq0IFcVAAKyupWipz75arcZSAFuUWLHpr/PBtW/bsB4Fldd8kQwu",
+	"b1gj6cUoGoV/1FgQckBjtPkZfl85w73LYaQMGNSnC3kMvPjzbcd6utZ8/ybrVR+M/HsxdiHuMYBJr00z",
+	"6cZBV7BtpBPFF7ovdCEqgvU+7pGZsQS70YibNPjwN/SdY04ioYV3tikKtHbTSLkH7bvrfZq8oHjN2xA7",
+	"CifPZlsoFgqDPAcyDR6IZyvktDo5Xi2n1Xrfwnc+Y4uDwA4aOmrs5WLO6iizn/tsGSxQPCOHmPbkUPeg",
+	"017bcSfweiqz3+P2pI4cJA6mE7aIq7C+AGhfYw56/RULmltQ3V1BGtbYr/BkUmDBzfOwkqKfnoTqNWrj",
+	"CptEnNdeY/ild8qSGTfVyLHHEg0OOzI04ztMtymcKW2ohAoZ17uz9wHEOfN6S4y8oj86DEZWO2OaPZ29",
+	"TwK3Q2379syPL/7L+Svrg5sZa+H3dwiOIzEh7dztFfA22vTjxk2eeBb2Y4ZJebOJ0/a9bl1Eb2cqZr4a",
+	"2ufRYI8kc5UQb5AHI8eCF1PVhVwxyqEx4ti4d5ObdD8S2vNlsjl6+0My26WcH+mNLhntujhn1oqtQg6C",
+	"o3LUo/kJrAt+AtmC/wjHjeBHSVbiW4NR9E4TyB2m0xKf6xgbjeHxyRaufdeG0/+ahIX5ASwq8udpyOUP",
+	"0j89x16dhG7lnJCJ7uV8OtZaS2RHD5nHEqlEMwyKHbOjjRdGt0fxK+LIBpib7IXmGH1WaC3b4pHB7hQO",
+	"R61QhFs0yWFhCUWfLl6awd7jsQ+dB6+d5i1S734SH1HB/bgk54txbmInR+6ug6vg2CUwt/1Pv6e8L4vk",
+	"/wEAy9dX5mEQAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -317,6 +320,68 @@ func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
+
 type TreePlantedJSONRequestBody = TreePlantingResult
 
 // RequestEditorFn is the function signature for the RequestEditor callback function.
@@ -390,38 +455,197 @@ func (p *CallbackInitiator) applyEditors(ctx context.Context, req *http.Request,
 	return nil
 }
 
+// RequestOption customizes a single call to a CallbackInitiator method. Options are
+// applied after the request has been built and the CallbackInitiator's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a CallbackInitiator-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleCallbackInitiator methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleCallbackInitiator methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (p *CallbackInitiator) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := p.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // CallbackInitiatorInterface is the interface specification for the callback initiator.
 type CallbackInitiatorInterface interface {
 	// TreePlantedWithBody sends a POST callback request
-	TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 }
 
 // TreePlantedWithBody sends a POST callback request
 // Tree planting result notification
-func (p *CallbackInitiator) TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *CallbackInitiator) TreePlantedWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewTreePlantedCallbackRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "TreePlanted", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // TreePlanted sends a POST callback request with application/json body
-func (p *CallbackInitiator) TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *CallbackInitiator) TreePlanted(ctx context.Context, targetURL string, body TreePlantedJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewTreePlantedCallbackRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "TreePlanted", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewTreePlantedCallbackRequest creates a POST request for the callback with application/json body
@@ -482,6 +706,10 @@ func NewSimpleCallbackInitiator(opts ...CallbackInitiatorOption) (*SimpleCallbac
 	return &SimpleCallbackInitiator{CallbackInitiator: inner}, nil
 }
 
+// SimpleCallbackInitiatorInterface is the interface specification for SimpleCallbackInitiator.
+type SimpleCallbackInitiatorInterface interface {
+}
+
 // CallbackReceiverInterface represents handlers for receiving callback requests.
 type CallbackReceiverInterface interface {
 	// Tree planting result notification
@@ -514,7 +742,147 @@ func TreePlantedCallbackHandler(si CallbackReceiverInterface, errHandler func(w
 	})
 }
 
-type UUID = uuid.UUID
+// CallbackSubscriptions hosts the callbacks of the API for a client. It
+// implements CallbackReceiverInterface, and serves the callback handlers at
+// the URLs of its subscriptions, delivering each callback to the
+// subscription of the request which asked for it. Mount it on your router at
+// the path of its base URL.
+type CallbackSubscriptions struct {
+	router *CallbackRouter
+}
+
+// NewCallbackSubscriptions returns CallbackSubscriptions minting callback URLs
+// below baseURL, the absolute URL it is reachable at, such as
+// https://client.example.com/callbacks.
+func NewCallbackSubscriptions(baseURL string) (*CallbackSubscriptions, error) {
+	router, err := NewCallbackRouter(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackSubscriptions{router: router}, nil
+}
+
+// ServeHTTP serves the callbacks of the subscriptions, answering others with
+// 404 Not Found.
+func (s *CallbackSubscriptions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, ok := s.router.Route(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch name {
+	case "TreePlanted":
+		if r.Method != "POST" {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		TreePlantedCallbackHandler(s, nil).ServeHTTP(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// deliver hands callback to the subscription at the URL of r, and answers r
+// with status.
+func (s *CallbackSubscriptions) deliver(w http.ResponseWriter, r *http.Request, callback any, status int) {
+	if err := s.router.Deliver(r, callback); err != nil {
+		if errors.Is(err, ErrUnknownCallback) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// TreePlantedCallback is a TreePlanted callback received by a subscription.
+type TreePlantedCallback struct {
+	Body TreePlantedJSONRequestBody
+}
+
+// TreePlantedSubscription receives the TreePlanted callbacks of one
+// request. Send its URL as the {$request.body#/callbackUrl} of the request.
+type TreePlantedSubscription = CallbackSubscription[TreePlantedCallback]
+
+// SubscribeTreePlanted returns a subscription to the TreePlanted callbacks of a
+// request, with a callback URL of its own. Close it once no more are expected.
+func (s *CallbackSubscriptions) SubscribeTreePlanted() *TreePlantedSubscription {
+	return SubscribeCallback[TreePlantedCallback](s.router, "TreePlanted")
+}
+
+// HandleTreePlantedCallback delivers the TreePlanted callback r to its subscription.
+func (s *CallbackSubscriptions) HandleTreePlantedCallback(w http.ResponseWriter, r *http.Request) {
+	var callback TreePlantedCallback
+	if err := json.NewDecoder(r.Body).Decode(&callback.Body); err != nil {
+		http.Error(w, "decoding callback body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.deliver(w, r, callback, 200)
+}
+
+// ErrInvalidURI is returned when a string isn't a URI reference.
+var ErrInvalidURI = errors.New("uri: invalid URI")
+
+// URI is a URI, or a relative URI reference, the format of strings with
+// format: uri and uri-reference. It wraps a url.URL, so its parts are at
+// hand, and is validated when unmarshaled. The zero URI is the empty
+// reference, "".
+type URI struct {
+	url.URL
+}
+
+// ParseURI parses s as a URI reference. Unlike url.Parse, it rejects
+// whitespace, which RFC 3986 doesn't allow in URIs.
+func ParseURI(s string) (URI, error) {
+	if strings.ContainsAny(s, " \t\r\n") {
+		return URI{}, fmt.Errorf("%w: %q: contains whitespace", ErrInvalidURI, s)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("%w: %q: %w", ErrInvalidURI, s, err)
+	}
+	return URI{URL: *u}, nil
+}
+
+// String reassembles u into a URI string.
+func (u URI) String() string {
+	return u.URL.String()
+}
+
+func (u URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON parses a JSON string as a URI; null leaves u unchanged.
+func (u *URI) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for URI.
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for URI.
+func (u *URI) UnmarshalText(data []byte) error {
+	parsed, err := ParseURI(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+type UUID = googleuuid.UUID
 
 // ---------------------------------------------------------------------------
 // Deep object internals
@@ -524,6 +892,16 @@ type UUID = uuid.UUID
 // Exploded object binding
 // ---------------------------------------------------------------------------
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -531,3 +909,184 @@ type UUID = uuid.UUID
 // ---------------------------------------------------------------------------
 // Deep object marshaling
 // ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+var (
+	// ErrUnknownCallback is returned when a callback arrives at a URL no
+	// subscription holds, such as one which was closed.
+	ErrUnknownCallback = errors.New("no subscription for callback")
+	// ErrCallbackSubscriptionClosed is returned by Next once a subscription
+	// is closed.
+	ErrCallbackSubscriptionClosed = errors.New("callback subscription closed")
+)
+
+// callbackBuffer is the number of callbacks a subscription holds before
+// deliveries wait for Next.
+const callbackBuffer = 16
+
+// CallbackRouter correlates callbacks with the requests which asked for
+// them. Each subscription gets a callback URL of its own, below the base URL
+// of the router, to send as the callback URL of its request; the callbacks
+// which arrive at it are delivered to the subscription. It is safe for
+// concurrent use.
+type CallbackRouter struct {
+	base *url.URL
+
+	mu            sync.Mutex
+	subscriptions map[string]callbackSubscriber
+}
+
+// callbackSubscriber is the untyped side of a CallbackSubscription.
+type callbackSubscriber interface {
+	name() string
+	deliver(ctx context.Context, value any) error
+}
+
+// NewCallbackRouter returns a router minting callback URLs below baseURL,
+// the absolute URL the callback server is reachable at, such as
+// https://client.example.com/callbacks.
+func NewCallbackRouter(baseURL string) (*CallbackRouter, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing callback base URL: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("callback base URL %q isn't absolute", baseURL)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	base.RawPath, base.RawQuery, base.Fragment = "", "", ""
+	return &CallbackRouter{base: base, subscriptions: map[string]callbackSubscriber{}}, nil
+}
+
+// Route returns the name of the callback the subscription at path, the path
+// of an incoming request, receives.
+func (r *CallbackRouter) Route(path string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.subscriptions[path]
+	if !ok {
+		return "", false
+	}
+	return sub.name(), true
+}
+
+// Deliver hands value, decoded from the callback req, to the subscription
+// at its path. It waits while the subscription's buffer is full, until the
+// request is canceled. It returns ErrUnknownCallback if no subscription is
+// at the path.
+func (r *CallbackRouter) Deliver(req *http.Request, value any) error {
+	r.mu.Lock()
+	sub, ok := r.subscriptions[req.URL.Path]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, req.URL.Path)
+	}
+	return sub.deliver(req.Context(), value)
+}
+
+// subscribe registers sub at a new path for the callback name, returning
+// its URL and path.
+func (r *CallbackRouter) subscribe(name string, sub callbackSubscriber) (string, string) {
+	u := *r.base
+	u.Path = r.base.Path + "/" + url.PathEscape(name) + "/" + crand.Text()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions[u.Path] = sub
+	return u.String(), u.Path
+}
+
+func (r *CallbackRouter) unsubscribe(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscriptions, path)
+}
+
+// CallbackSubscription receives the callbacks of type T which one request
+// asked for. Send URL as the callback URL of the request, then read the
+// callbacks with Next, and Close the subscription once no more are
+// expected.
+type CallbackSubscription[T any] struct {
+	// URL is the callback URL of the subscription.
+	URL string
+
+	router    *CallbackRouter
+	path      string
+	callback  string
+	callbacks chan T
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// SubscribeCallback returns a new subscription of router to the callback
+// name.
+func SubscribeCallback[T any](router *CallbackRouter, name string) *CallbackSubscription[T] {
+	s := &CallbackSubscription[T]{
+		router:    router,
+		callback:  name,
+		callbacks: make(chan T, callbackBuffer),
+		done:      make(chan struct{}),
+	}
+	s.URL, s.path = router.subscribe(name, s)
+	return s
+}
+
+// Next returns the next callback received, waiting for one until ctx is
+// done or the subscription is closed.
+func (s *CallbackSubscription[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	default:
+	}
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	case <-s.done:
+		return zero, ErrCallbackSubscriptionClosed
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Close stops the subscription: callbacks arriving at its URL are rejected
+// with ErrUnknownCallback, and Next returns ErrCallbackSubscriptionClosed.
+func (s *CallbackSubscription[T]) Close() {
+	s.closeOnce.Do(func() {
+		s.router.unsubscribe(s.path)
+		close(s.done)
+	})
+}
+
+func (s *CallbackSubscription[T]) name() string {
+	return s.callback
+}
+
+func (s *CallbackSubscription[T]) deliver(ctx context.Context, value any) error {
+	v, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("callback %s: unexpected %T", s.callback, value)
+	}
+	select {
+	case s.callbacks <- v:
+		return nil
+	case <-s.done:
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, s.path)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
@@ -3,6 +3,7 @@ module github.com/oapi-codegen/oapi-codegen-exp/examples/petstore-expanded/echo
 go 1.25.0
 
 require (
+	github.com/google/uuid v1.6.0
 	github.com/labstack/echo/v5 v5.1.0
 	github.com/oapi-codegen/oapi-codegen-exp v0.0.0
 )
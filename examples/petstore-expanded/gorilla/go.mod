@@ -3,6 +3,7 @@ module github.com/oapi-codegen/oapi-codegen-exp/examples/petstore-expanded/goril
 go 1.25.0
 
 require (
+	github.com/google/uuid v1.6.0
 	github.com/gorilla/mux v1.8.1
 	github.com/oapi-codegen/oapi-codegen-exp v0.0.0
 )
@@ -54,34 +54,34 @@ type FindPetsJSONResponse = []Pet
 
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/+xXXW9byQ19168gtgXyoly5yaIPemo2TgED3cStd/tOz6UkLubLQ44coe1/Lzj3Srqy",
-	"ZG+CYvuF9YMNz3A4hzyHHN6UKWLmJXzztrvqrr6ZcVyl5QxgS0U4xSX8ztZnAMrqaQl3j7heU4FbUtFU",
-	"aAbQk7jCWZv5OxAM2RO8u70B3aBCFRJAyOMBQAGMQJ8HM03QU0hRtKASrAi1FhLgCLoh+JQpmqe33RVI",
-	"Jscrdmg3GSIqQT6t7qhs2dESNqpZlouFDBA7TotmspgBuBQVnVpkABHDJBJz/wNhaFsUkP0SMLMShj8c",
-	"XbXdWvyla2YAnh1Foan/dxndhuBNy96Ts4+Pjx22/S6V9WI8LYs/3bz/8PHuw+s33VW30eBnQsWYML+v",
-	"T13s89lNwsXMs4y6afbNYgC0pjFyAKkhYNkt4S+ktUQB9L5xM+6fkPn3cRHOrGFVUmgMyU6UwkC1/V+F",
-	"CmyMZOdIBDQdnHzEAEK9kdFzoKg1AIl28D2So4gCSiGnAoJrVmUBwcwU5xDJQdmk6KqAUJgYsAIG0g7e",
-	"USSMgArrglvuEbCuK80BHTC66rkd7eB9LXjPWguknhP4VCjMIZWIhYDWpECeRnSR3BxcLVIFuAdPTqt0",
-	"cF1ZIDBoLZllDrn6LUcsdheVZLHPQTk67mtU2GLhKvBTFU0d3ETYoIONgUARguxRCaFnpzVYOm6ikikT",
-	"FbDnzOI4rgGjWjTH2D2vq8dD5HmDhbTgPolmDyF5EmUCDplKz5apv/IWwxAQen6oGKBntMwUFHiw2Lbk",
-	"WSGmCJqKpmIp4RXF/nB7B7cFSSiqwaTI4QigloiwTb5qRoUtRYpogIfk2q+AtZiPm3j0vKIyZn2Fjj3L",
-	"ySXtBvs1P/LrQFKPnozYfm55dFRQLTD728FdlUyxZ8uyRxNPn3wqc1OgkFMTdYuyScWinsOWNuyqR+Co",
-	"VPoawPM9ldTB96ncM1BlCamf0mDbTdgeHUfGbnaQ/B31jY8qsCKToE/3qbRjlI66KVVLDV2rkIDN7UgB",
-	"i58D1ZOaGYgHX02NptEObjco5P1QHpnKeLwlu5FMCiusju/rkHbc32N20/Nb8iOBvKVScH56tVULcD8/",
-	"lGPk+00HPypk8p6ikjxUgpykktXTvpS6lgrc14KV3j6je0/7sFo+5w3IQRyxRgdaWNRigS0rUgd/rOII",
-	"SFtP6CsfasH6hTjyVLjBGVS8PxBMMxWbhFwNghECri1k8iNbHfy5DkdD8sbbwB7VQUFHKPNDCwKszkpl",
-	"sBxFOoQ9SmRsNYeaNMkYwcBxfoQylm9k4T1gMQyOtfZsUEUQqu7VNhI53HSStHZfB7dTYlrmRoy5kHIN",
-	"k/41iKbOJyq3BtyNek7Z6opTvOmXsOLY3x4fjozFsjA+VsPP6/EpVFzLYRGA4xIeKpXdZO3k3TF7mwxW",
-	"7JUK3E8NCz1ULmQA0AtNdkR3NqGsUgnTVbehgMvJCoDuMi0BS8HdyTorBTk13RuLFo7rs8g8B9avCS3g",
-	"Zw72stRwTwXSCgpJ9driLe2V/bJgnw2Lh9fjZMdygtr23r6Z7X1LtjY4CfjVm6urV8vnoGfSw6GJjY1W",
-	"FPUUCubsx0lt8ZOk+DSpl+C/xMyz7NjPbwutlvDqNwuXQk6RospiuEAWt6SvZsdwVli9PhthjfQ527tg",
-	"D0hJ5ZeK8iXAH+ziAXJOcj63vS+E2ibqSI/GyaXB7cxoP08PIyNc1wG4mdhI7n16pP5SlWNvRT47ypFE",
-	"v0v9bjm7mMFbUhMy9r39Odw4Oxe0lnpcvpDdl3N7ObMv5fUjPU608F8p/v9PHbfvkMXfuP/HF3yMtDzf",
-	"7+Dm+pKsn5ihPaA2vYFwXHs6nrr0UH23O2y/9FZx/6Sd2wfVc4m+ubYOngfRr0jd5mLzPtH6v9C7f//t",
-	"r/L997fhnjwpnWn2ui3/rGb7g9ko0hPpWoe8uQapFsLlDjw4ODbhX0a8wy3/SfV++7J6B4D9/6KIjjt2",
-	"fNwcPN0emyF6/2k1pfTLXzOzPvB1gvD1qSRyMWkp05M5ivvLU+8lRi9zepGDH2P7WuPehGZSt3GlHRjw",
-	"L2dPtPZE0LPnUTe9z35mSn8C5yMGmiI5nMX117r6YZfpLKhG90sxudTT5N9AIrh+KUo7cA7tnJVL8/0Z",
-	"5gbvFMOI4GujHzzt4f8zAAD//6ZcwVZEFgAA",
+	"H4sIAAAAAAAC/+xXS28byRG+z68obAL4Qg8Ve5HDnOK1HEBA1lai3dxL3UWyFv1SVzVlIsl/D7pnSA5F",
+	"Smsj2LywOkhQdz2+r+qr7p6YKGDiAb5521/1V990HFZx6AC2lIVjGOB3db0DUFZHA9w94npNGW5JRWOm",
+	"DsCSmMxJm/k7EPTJEby7vQHdoEIREkBIkwOgAAagz6OZRrDkYxDNqAQrQi2ZBDiAbgg+JQo10tv+CiSR",
+	"4RUbrJk6AKXs5dPqjvKWDQ2wUU0yLJcyQuw5LpvJsgMwMSgarcwAAvoZkxr+B0LftsgjuwEwsRL6PxxD",
+	"td2S3aU0HYBjQ0FoHv9dQrMheNNfnfs+Pj722Pb7mNfLyVuWf7p5/+Hj3YfXb/qrfqPedUK5dqLGfX0a",
+	"Yl/PfkYXE3cJddPsm8XQcq9pYg4gxXvMuwH+QlpyEEDnWm+m/ZNm/n1ahDNrWOXoW4dkJ0p+bHX9vwhl",
+	"2NQmG0MioPEQ5CN6ELK1GZY9BS0eSLSH75EMBRRQ8ilmEFyzKgsIJqawgEAG8iYGUwSE/MyAFdCT9vCO",
+	"AmEAVFhn3LJFwLIutAA0wGiK4+baw/uS8Z61ZIiWI7iYyS8g5oCZgNakQI4mdIHMAkzJUgTYgiOjRXq4",
+	"LizgGbTkxLKAVNyWA+aai3Ks3BegHAzbEhS2mLkI/FREYw83ATZoYFNBoAhBcqiEYNlo8bUcN0GpKhMV",
+	"0HJiMRzWgEErmyN3x+vi8MA8bTCTZtwXsdqDj45EmYB9omy5VuqvvEU/EkLHDwU9WMZamYwCD5Xblhwr",
+	"hBhAY9aYgRzxioI9ZO/hNiMJBQVUoMD+CKDkgLCNrmhChS0FClgBj8WtvzyWXGPchGPkFeWp6is07FhO",
+	"krQM9dfi2F8DEi06EhCyi1pHQxm1Eqt/e7grkihYFiFwWMVjo4t5URUoZLSKurFsUqmsF7ClDZviEDgo",
+	"ZVs8OL6nHHv4PuZ7BiosPtp5G+p2hVBzcGDsu4Pk78i2fhSBFVUJungfc3OjeNRNLpqL79uEeFQ9toDF",
+	"LYDKycyMjQdXqhohkOnhdoNCzo3jkShP7q3YrcmksMJi+L6MZcd9nmo399+SmxrIW8oZF6ep67QA28Vh",
+	"HAPfb3r4USGRcxSU5KEQpCiFMh1HqW+lwP0s1NHbV3QfaU+r1XPRgBzEEUowoJlFKxfYsiL18McihoC0",
+	"kgZb+DALgQyIIUeZG5xRxXsHXzVTsEnIFC8YwOO6UiY3dauHP5fR1UfneN89KqOCjlAWhyMIsJg6KqPl",
+	"JNKR9khpf9QcZlLItgYDh8URyjS+gYX3gKViMKzFcoUqglB0r7apkWOmk6K1fD3czhvTKjdhTJmUi5+d",
+	"X6NoymKm8noA95OeY6pzxTHc2AFWHOzt8eJImGsVpstq/Hk9XYWKazksAnAY4KFQ3s3WTu6dag8aYcVO",
+	"KcP93DDTQ+FMFQA6odmO6M7RAKuY/XzVbMjjMFsB0F2iATBn3J2ss5KXU9O9sWjmsD5j5tizfg01j5/Z",
+	"15ul+HvKEFeQSYrTxje3W/bLyD5Li8fb42Sn1gS17b190+1jS6rH4IzwqzdXV6+G56An0oPTzKY+rSjo",
+	"KRRMyU0vteVPEsPp7mX4L3Xm2e7Un99mWg3w6jdLE32KgYLKckwgy1vSV92RzgqL02cZlkCfExklC5Rz",
+	"zL8Uy5cAf6iJR8gpyvm77X0mVBJACPQIifTSw+3MaP+eHp+McF1G4NUkU33VxUeyl6YcbR3y7ihHEv0u",
+	"2t3QXazgLSlorF71zyFjdy5ozYW6F6r7cm0vV/alun6kx5kW/ivF//+p4/Ydsvwb2398wcdIq/P9Dm6u",
+	"L8n6iRkKWYgBEITD2tHR69JF9d3u5voL7iq2T47z+kH1XKFvriGuGph6W5GazcXD+0Tr/8LZ/ftvf5Xv",
+	"v/8YtuRI6Uyz1235ZzVrD2aTSE+kq5uqWpBSKVw+gccAx0P4lxHvmOU/qd5vX1bvCND+L4rouFPdp80x",
+	"0u3xMETnPq3mLf3y26xaH/p1gvD1qSRSrtJSpifvKLaXX72XOnq5pxd78GNoX2tsq9Cq1BPp+G084h+6",
+	"J1p7IujuedR1e+ie4n3ySn8C5yN6miM5+OL6a0P9sEt0Rqq1+yVOJlqa/etJBNcvsawOQ/fzXbn0vj/D",
+	"3OCdYpgQfC37MdIe/j8HAKZcwVZEFgAA",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -131,6 +131,16 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // Exploded object binding
 // ---------------------------------------------------------------------------
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -138,3 +148,8 @@ func GetOpenAPISpecJSON() ([]byte, error) {
 // ---------------------------------------------------------------------------
 // Deep object marshaling
 // ---------------------------------------------------------------------------
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
@@ -3,3 +3,5 @@ module github.com/oapi-codegen/oapi-codegen-exp/examples/petstore-expanded/stdht
 go 1.25.0
 
 replace github.com/oapi-codegen/oapi-codegen-exp => ../../../
+
+require github.com/google/uuid v1.6.0
@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 
 	doorbadge "github.com/oapi-codegen/oapi-codegen-exp/examples/webhook"
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
 // WebhookReceiver implements doorbadge.WebhookReceiverInterface.
@@ -44,7 +45,11 @@ func (wr *WebhookReceiver) HandleExitEventWebhook(w http.ResponseWriter, r *http
 }
 
 func registerWebhook(client *http.Client, serverAddr, kind, url string) (uuid.UUID, error) {
-	body, err := json.Marshal(doorbadge.WebhookRegistration{URL: url})
+	callbackURL, err := types.ParseURI(url)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	body, err := json.Marshal(doorbadge.WebhookRegistration{URL: callbackURL})
 	if err != nil {
 		return uuid.UUID{}, err
 	}
@@ -14,7 +14,9 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
+	oapiCodegenHelpersPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/helpers"
 	oapiCodegenParamsPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
 	oapiCodegenTypesPkg "github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
@@ -22,7 +24,7 @@ import (
 // #/components/schemas/WebhookRegistration
 type WebhookRegistration struct {
 	// URL to receive webhook events
-	URL string `form:"url" json:"url"`
+	URL oapiCodegenTypesPkg.URI `form:"url" json:"url"`
 }
 
 // ApplyDefaults sets default values for fields that are nil.
@@ -69,24 +71,39 @@ const (
 	ExitEvent  PostAPIWebhookKindParameter = "exitEvent"
 )
 
+// Values returns the PostAPIWebhookKindParameter constants, in the order of the spec.
+func (PostAPIWebhookKindParameter) Values() []PostAPIWebhookKindParameter {
+	return []PostAPIWebhookKindParameter{EnterEvent, ExitEvent}
+}
+
+// ParsePostAPIWebhookKindParameter returns the PostAPIWebhookKindParameter constant whose value is s. Other strings
+// return an error.
+func ParsePostAPIWebhookKindParameter(s string) (PostAPIWebhookKindParameter, error) {
+	switch v := PostAPIWebhookKindParameter(s); v {
+	case EnterEvent, ExitEvent:
+		return v, nil
+	}
+	return "", fmt.Errorf("invalid PostAPIWebhookKindParameter value %q", s)
+}
+
 // Base64-encoded, gzip-compressed OpenAPI spec.
 var openAPISpecJSON = []string{
-	"H4sIAAAAAAAC/9RWTY/bNhC961cM0gI+RfLu9qTbJuvDAkGycFL0TItjaxKJZIaUvEbb/16Q1GdX3l0D",
-	"RZv6ZEnDmfceh4+jDSphKIc3N+lVun6TkNrrPAFokS1plcNVuk7XCYAjV2EOd1ozvBPygLBFIZETAIm2",
-	"YDIuxP+RAADcgvSBuxDIIRAscksFgiuFA4m1VtaxcGjhk0F1+3APN+kVHHFXav3NpiHP+4pQOQuMB7IO",
-	"GfaaAZVD3rSoHAglAR/JxadhLXwpMdQL+ABYKKnr6gQHVBhrRmjYhvQ+jdKO9oQWRFUN9VBC5f8o5ICo",
-	"ogKVxTxkVaLGHG6NKEqE66ASQMNVDqVzxuZZdjweUxG+p5oPWbfaZh/u328+ft68vU7XaenqKjHCldZn",
-	"zYShrOOR/f6NlPwzFjPauvgPwDZ1LfiUw7aXRfTcu4iFLfG/Pt6OC4KirkQ4UIsqCgK+7lREOFJVwcOn",
-	"z1+GVE6HVYZ1SxIl/Lr9AMcSFfpw0SfS+7jdPiHoomi421gAbfxOkFb3ciTy24yFESxq9Hjzoe7bTnaf",
-	"cXgJQCoHL+LkFeP3hhhlDo4bnHywRYm1yCdvANzJYA7WManD7AOqpp6HegxjCz791LdjMsJA695peRrz",
-	"nMFWaOVQuWk9YUxFRRAq+2q1mmNZogLwM+M+h9VPWaFro5Vv8SxG2qxTOAoeN2A1ILVG+/4c862u11er",
-	"afpZY3W5JqdlErnA5SU25/hczGjbMVklI+69aCp3lkqj8NFg4VACMmv+L4hsfOHVExOg3gIkVujwiQnc",
-	"Ib/KBrZY69b7GxjGlnRjq9PU6HpD2J2AnIX7u8WTOlZ7/Vmlf+Wk7jXXwuXQNEO95Y7+5eWOlrjQ0/+j",
-	"HupvQr949KpnbpIHZKtVjEUZrH3XUCVHkWddsPm7//1gLhfpPG9s6/NtEMcJxgKpDQ0wmPorJHwk9xoF",
-	"f+xr4p8VcCzgV3Q14uIF9+6zxpOud1+xmKoUBJl4TMNV70HsJXY0xennseRF9xi8g+kcJT/gON1zGtwy",
-	"TpDJOS79TXQhp8HBliiRvIRRM3PfOSVF3xsEkqjC7MvdMEh2oMcTLpFk7IwL+fh74BlG4Zp4mdMM/EdR",
-	"Y5wvEUw8esdSx7leAinQDLpxEXSwxQsxF1ri5LFGa8XhORZ+wVMWpBwekBe2hpS7uT57gjziOYYOwaVC",
-	"xUw9/L8CAAD//+XREy3yDQAA",
+	"H4sIAAAAAAAC/9RWTW8bNxC9768YpAV0ila2e9qbE/tgIEgMJ0XP9HKknWSXZIazkoW2/70guV+yJVkC",
+	"ijbRSbs7H+89Dh9pHRrlqIA3V/OL+eJNRmZpiwxgjezJmgIu5ov5IgMQkhoLuLGW4Z3SK4QHVBo5A9Do",
+	"SyYnMf6vDADgGnQIfIyBHAPBI6+pRJBKCWhsrPHCStDDJ4fm+v4OruYXsMHHytpvfh7rvK8JjXhgXJEX",
+	"ZFhaBjSCfLtGI6CMBnwiSU9DLnypMPaL+ABYGW2begsrNJh6Jmi4juVDGWOFloQeVF0P/VBDHf4Y5Iio",
+	"phKNxyJWNarBAq6dKiuEy6gSQMt1AZWI80WebzabuYrf55ZXeZft8w93728/fr59ezlfzCtp6swpqXyo",
+	"mitHeccj//MbGf13auasl/QPwLdNo3hbwEMvi+q5dxF7liT8+ng/JkRFpUJY0RpNEgRC36mIsKG6hvtP",
+	"n78MpcTGLMd2TRo1/P7wATYVGgzhqi9kl2m5Q0GwZdlyt7AA1oWVIGvu9Ejkjx0WTrFqMOAthr5vO9lD",
+	"xeElAJkCgoiTV4zfW2LUBQi3OPngywobVUzeAMjWYQFemMxq5wOattkNDRjGEXz5qR/HbISBXt5ZvR3r",
+	"HMBWWiNoZNpPOVdTGYXKv3prdrHsowLwK+OygNkveWkbZ00Y8TxF+rxTOAmeFmA2IPXOhvkc680uFxez",
+	"8fHZYHW1JrtlErmHy2tsDvE5m9FDx2SWjbiXqq3lIJXW4JPDUlADMlv+P4jchsazFyZAvQVorFHwhQnc",
+	"IJ9kAw/Y2DV6UOAY12RbX28nS9enwuMWSDzc3ezdqWO30/cq/Sc7dWm5UVJA25I+OtG/vT7RGvfM9E80",
+	"Q/1JGJJHrzpyktwje2tSLOpo7Y8t1XoUeWcKbp/73w/mconOcWNbHB6DyAwYS6R1HIDB1E+Q8InkFAV/",
+	"7GPi3xVwbBAyuh4peY97F9l0p9vHr1hK9kyQice0XHdPjoPEQlOc4T6Wveoeg3cwHaIULjhie06DW6Yb",
+	"ZHaIS38SncmJ9BFKpM9h1JI+SMnQ9xaBNJp49+XuMkh+oMcTLolkmowz+YRz4Aij8PkETjvgP6oG0/0S",
+	"wUVMsKlsutdrIAOWwbaSQEdbPBNzaTVOHhv0Xq2OsQgJL1mQEVwh71kaMnJ1eXAHBcS7GDoE5wqVKvXw",
+	"/xkA5dETLfINAAA=",
 }
 
 // decodeOpenAPISpec decodes and decompresses the embedded spec.
@@ -152,16 +169,20 @@ type MiddlewareFunc func(http.Handler) http.Handler
 func (siw *ServerInterfaceWrapper) DeregisterWebhook(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "id" -------------
 	var id oapiCodegenTypesPkg.UUID
 
 	err = oapiCodegenParamsPkg.BindParameter("id", r.PathValue("id"), &id, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid", AllowReserved: false})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "id", Location: "path", Value: r.PathValue("id"), Err: &InvalidParamFormatError{ParamName: "id", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeregisterWebhook(w, r, id)
 	}))
@@ -177,16 +198,23 @@ func (siw *ServerInterfaceWrapper) DeregisterWebhook(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) RegisterWebhook(w http.ResponseWriter, r *http.Request) {
 	var err error
 	_ = err
+	var bindErrs BindingErrors
 
 	// ------------- Path parameter "kind" -------------
 	var kind string
 
-	err = oapiCodegenParamsPkg.BindParameter("kind", r.PathValue("kind"), &kind, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false})
+	err = oapiCodegenParamsPkg.BindPrimitiveParameter("kind", r.PathValue("kind"), &kind, oapiCodegenParamsPkg.ParameterOptions{Style: "simple", ParamLocation: oapiCodegenParamsPkg.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", AllowReserved: false}, oapiCodegenParamsPkg.ParseString[string])
+	if err == nil {
+		err = oapiCodegenParamsPkg.ValidateParameter("kind", kind, oapiCodegenParamsPkg.ParamEnum[string]("enterEvent", "exitEvent"))
+	}
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
-		return
+		bindErrs = append(bindErrs, &BindingError{ParamName: "kind", Location: "path", Value: r.PathValue("kind"), Err: &InvalidParamFormatError{ParamName: "kind", Err: err}})
 	}
 
+	if bindErrs != nil {
+		siw.ErrorHandlerFunc(w, r, bindErrs)
+		return
+	}
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.RegisterWebhook(w, r, kind)
 	}))
@@ -331,6 +359,68 @@ func (e *TooManyValuesForParamError) Error() string {
 	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
 }
 
+// BindingError describes a single request parameter that could not be bound.
+// Location is one of "path", "query", "header" or "cookie", and Value holds
+// the raw value received, which is empty when the parameter was missing.
+type BindingError struct {
+	ParamName string
+	Location  string
+	Value     string
+	Err       error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON encodes the error as an object with name, in, value and message
+// fields, suitable for a 400 response body.
+func (e *BindingError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		In      string `json:"in"`
+		Value   string `json:"value,omitempty"`
+		Message string `json:"message"`
+	}{e.ParamName, e.Location, e.Value, e.Err.Error()})
+}
+
+// BindingErrors collects every parameter binding failure for a request. It is
+// passed to the error handler in place of the first failure, so that a single
+// response can report all invalid parameters.
+type BindingErrors []*BindingError
+
+func (e BindingErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, be := range e {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e BindingErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, be := range e {
+		errs[i] = be
+	}
+	return errs
+}
+
+// StatusCode reports the HTTP status for a binding failure, which is always 400.
+func (e BindingErrors) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// MarshalJSON encodes the errors as {"errors": [...]}.
+func (e BindingErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*BindingError `json:"errors"`
+	}{e})
+}
+
 type EnterEventJSONRequestBody = Person
 
 type ExitEventJSONRequestBody = Person
@@ -406,68 +496,229 @@ func (p *WebhookInitiator) applyEditors(ctx context.Context, req *http.Request,
 	return nil
 }
 
+// RequestOption customizes a single call to a WebhookInitiator method. Options are
+// applied after the request has been built and the WebhookInitiator's own request
+// editors have run. RequestOption is the same type as RequestEditorFn, so
+// request editors can be passed to methods directly.
+type RequestOption = RequestEditorFn
+
+// requestOptions collects the settings of a single call which can't be
+// applied to the request itself, such as its deadline.
+type requestOptions struct {
+	deadline time.Time
+	timeout  time.Duration
+	response **http.Response
+}
+
+// requestOptionsKey is the context key under which prepareRequest exposes
+// the requestOptions of a call to its RequestOptions.
+type requestOptionsKey struct{}
+
+// responseKey is the context key of the request under which prepareRequest
+// stores the destination of WithResponse, for releaseWithBody to fill in.
+type responseKey struct{}
+
+// updateRequestOptions calls fn with the requestOptions of the call whose
+// context is ctx. It does nothing when ctx doesn't belong to a call, such as
+// when the option was installed as a WebhookInitiator-wide request editor.
+func updateRequestOptions(ctx context.Context, fn func(*requestOptions)) {
+	if o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		fn(o)
+	}
+}
+
+// WithEditor runs fn on the request of this call only. It exists for
+// symmetry with the other options; fn can also be passed directly.
+func WithEditor(fn RequestEditorFn) RequestOption {
+	return fn
+}
+
+// WithHeader sets a header on the request, replacing any value set by the
+// operation or by request editors.
+func WithHeader(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParam adds a query parameter to the request URL.
+func WithQueryParam(key, value string) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		query := url.Values{key: {value}}.Encode()
+		if req.URL.RawQuery != "" {
+			query = req.URL.RawQuery + "&" + query
+		}
+		req.URL.RawQuery = query
+		return nil
+	}
+}
+
+// WithDeadline bounds the call, including reading the response body, by
+// deadline. It has no effect if the context's own deadline is earlier.
+func WithDeadline(deadline time.Time) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.deadline.IsZero() || deadline.Before(o.deadline) {
+				o.deadline = deadline
+			}
+		})
+		return nil
+	}
+}
+
+// WithTimeout bounds the call, including reading the response body, to
+// timeout from the start of the call.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			if o.timeout == 0 || timeout < o.timeout {
+				o.timeout = timeout
+			}
+		})
+		return nil
+	}
+}
+
+// WithResponse stores the *http.Response of the call in *dst, so that callers
+// of SimpleWebhookInitiator methods can read its status, headers, such as rate limits or
+// request IDs, and cookies. It is stored whenever a response is received,
+// including error responses. SimpleWebhookInitiator methods read and close its body
+// before returning.
+func WithResponse(dst **http.Response) RequestOption {
+	return func(ctx context.Context, req *http.Request) error {
+		updateRequestOptions(ctx, func(o *requestOptions) {
+			o.response = dst
+		})
+		return nil
+	}
+}
+
+// prepareRequest binds req to ctx, tagged with the operationId, and applies
+// the editors and per-call opts. The returned cancel function, which is nil
+// unless a deadline was set, must be passed to releaseWithBody once the
+// request has been sent.
+func (p *WebhookInitiator) prepareRequest(ctx context.Context, operationID string, req *http.Request, opts []RequestOption) (*http.Request, context.CancelFunc, error) {
+	start := time.Now()
+	var o requestOptions
+	ctx = oapiCodegenHelpersPkg.ContextWithOperationID(ctx, operationID)
+	req = req.WithContext(ctx)
+	if err := p.applyEditors(context.WithValue(ctx, requestOptionsKey{}, &o), req, opts); err != nil {
+		return nil, nil, err
+	}
+	if o.response != nil {
+		ctx = context.WithValue(ctx, responseKey{}, o.response)
+		req = req.WithContext(ctx)
+	}
+	deadline := o.deadline
+	if o.timeout > 0 {
+		if timeoutDeadline := start.Add(o.timeout); deadline.IsZero() || timeoutDeadline.Before(deadline) {
+			deadline = timeoutDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, nil, nil
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return req.WithContext(ctx), cancel, nil
+}
+
+// releaseWithBody ties cancel to the lifetime of the response body, so that a
+// per-call deadline also covers reading it. If the request failed, cancel is
+// called right away. Otherwise, resp is stored for WithResponse.
+func releaseWithBody(req *http.Request, resp *http.Response, err error, cancel context.CancelFunc) (*http.Response, error) {
+	if cancel != nil {
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	}
+	if dst, ok := req.Context().Value(responseKey{}).(**http.Response); ok && err == nil {
+		*dst = resp
+	}
+	return resp, err
+}
+
+// cancelOnCloseBody cancels the context of a request when its response body
+// is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // WebhookInitiatorInterface is the interface specification for the webhook initiator.
 type WebhookInitiatorInterface interface {
 	// EnterEventWithBody sends a POST webhook request
-	EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 	// ExitEventWithBody sends a POST webhook request
-	ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
-	ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error)
+	ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, opts ...RequestOption) (*http.Response, error)
 }
 
 // EnterEventWithBody sends a POST webhook request
 // Person entered the building
-func (p *WebhookInitiator) EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) EnterEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewEnterEventWebhookRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "EnterEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // EnterEvent sends a POST webhook request with application/json body
-func (p *WebhookInitiator) EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) EnterEvent(ctx context.Context, targetURL string, body EnterEventJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewEnterEventWebhookRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "EnterEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // ExitEventWithBody sends a POST webhook request
 // Person exited the building
-func (p *WebhookInitiator) ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) ExitEventWithBody(ctx context.Context, targetURL string, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewExitEventWebhookRequestWithBody(targetURL, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "ExitEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // ExitEvent sends a POST webhook request with application/json body
-func (p *WebhookInitiator) ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (p *WebhookInitiator) ExitEvent(ctx context.Context, targetURL string, body ExitEventJSONRequestBody, opts ...RequestOption) (*http.Response, error) {
 	req, err := NewExitEventWebhookRequest(targetURL, body)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := p.applyEditors(ctx, req, reqEditors); err != nil {
+	req, cancel, err := p.prepareRequest(ctx, "ExitEvent", req, opts)
+	if err != nil {
 		return nil, err
 	}
-	return p.Client.Do(req)
+	resp, err := p.Client.Do(req)
+	return releaseWithBody(req, resp, err, cancel)
 }
 
 // NewEnterEventWebhookRequest creates a POST request for the webhook with application/json body
@@ -558,6 +809,10 @@ func NewSimpleWebhookInitiator(opts ...WebhookInitiatorOption) (*SimpleWebhookIn
 	return &SimpleWebhookInitiator{WebhookInitiator: inner}, nil
 }
 
+// SimpleWebhookInitiatorInterface is the interface specification for SimpleWebhookInitiator.
+type SimpleWebhookInitiatorInterface interface {
+}
+
 // WebhookReceiverInterface represents handlers for receiving webhook requests.
 type WebhookReceiverInterface interface {
 	// Person entered the building
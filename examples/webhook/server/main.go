@@ -70,7 +70,7 @@ func (br *BadgeReader) RegisterWebhook(w http.ResponseWriter, r *http.Request, k
 	}
 
 	id := uuid.New()
-	entry := webhookEntry{id: id, url: req.URL, kind: kind}
+	entry := webhookEntry{id: id, url: req.URL.String(), kind: kind}
 
 	br.mu.Lock()
 	br.webhooks[id] = entry
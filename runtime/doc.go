@@ -3,9 +3,15 @@
 // the files here are generated from that source using GenerateRuntime.
 //
 // Sub-packages:
-//   - types/   — custom Go types for OpenAPI format mappings (Date, Email, UUID, File, Nullable)
+//   - types/   — custom Go types for OpenAPI format mappings (Base64Bytes, Date, DateTime, DateTimeMillis, Time, Decimal, Duration, Email, StrictEmail, UUID, UUIDString, URI, IPv4, IPv6, File, Nullable, Optional, Set)
 //   - params/  — parameter serialization/deserialization functions
-//   - helpers/ — utility functions for request body encoding (MarshalForm, JSONMerge)
+//   - helpers/ — utility functions for request body encoding (MarshalForm, JSONMerge) and the client-side
+//     runtime, including the Clock everything time-dependent goes through
+//   - jsonpointer/ — JSON Pointers locating decode errors (DecodeJSON, FormatPointer)
+//
+// The runtime package itself only holds this documentation: generated code
+// imports the sub-packages, so runtime types such as Clock live in the one
+// whose code uses them.
 //
 //go:generate go run ../cmd/oapi-codegen --generate-runtime github.com/oapi-codegen/oapi-codegen-exp/runtime
 package runtime
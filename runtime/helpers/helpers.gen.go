@@ -3,98 +3,5361 @@
 package helpers
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
+	"iter"
+	"log/slog"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oapi-codegen/oapi-codegen-exp/runtime/params"
+)
+
+// APIKeyProvider provides the API key of each request, so that keys can be
+// rotated without recreating clients. It must be safe for concurrent use.
+type APIKeyProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticAPIKey is an APIKeyProvider of a key which never changes.
+type StaticAPIKey string
+
+// APIKey returns k.
+func (k StaticAPIKey) APIKey(context.Context) (string, error) {
+	return string(k), nil
+}
+
+// APIKeyFunc adapts a function to an APIKeyProvider.
+type APIKeyFunc func(ctx context.Context) (string, error)
+
+// APIKey returns f(ctx).
+func (f APIKeyFunc) APIKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// SetAPIKey sets key as the API key called name of req, in its "header",
+// "query" or "cookie", as the in field of apiKey security schemes says.
+func SetAPIKey(req *http.Request, in, name, key string) error {
+	switch in {
+	case "header":
+		req.Header.Set(name, key)
+	case "query":
+		query := req.URL.Query()
+		query.Set(name, key)
+		req.URL.RawQuery = query.Encode()
+	case "cookie":
+		req.AddCookie(&http.Cookie{Name: name, Value: key})
+	default:
+		return fmt.Errorf("api key %s: unsupported location %q", name, in)
+	}
+	return nil
+}
+
+// RefreshingAPIKey is an APIKeyProvider of keys which expire. It caches the
+// key Fetch returns, and fetches the next one in the background ahead of its
+// expiry, so that requests don't wait for rotations. Refreshes start
+// RefreshAhead plus a random part of Jitter before expiry, spreading those of
+// many clients sharing a key service. Requests wait for a key only when
+// there's none yet, or it has expired. It is safe for concurrent use.
+type RefreshingAPIKey struct {
+	// Fetch returns a new key and its expiry; a zero expiry never expires.
+	Fetch func(ctx context.Context) (key string, expiresAt time.Time, err error)
+	// RefreshAhead is how long before expiry refreshes start.
+	RefreshAhead time.Duration
+	// Jitter is the most refreshes start earlier still, at random.
+	Jitter time.Duration
+	// Clock tells when keys expire. Defaults to SystemClock.
+	Clock Clock
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it for deterministic tests.
+	Rand func() float64
+
+	mu         sync.Mutex
+	key        string
+	expiresAt  time.Time
+	refreshAt  time.Time
+	refreshing bool
+}
+
+// NewRefreshingAPIKey returns a RefreshingAPIKey of the keys fetch returns,
+// refreshing them a minute before expiry, with up to 30 seconds of jitter.
+func NewRefreshingAPIKey(fetch func(ctx context.Context) (string, time.Time, error)) *RefreshingAPIKey {
+	return &RefreshingAPIKey{Fetch: fetch, RefreshAhead: time.Minute, Jitter: 30 * time.Second}
+}
+
+// APIKey returns the current key, fetching one when there's none or it has
+// expired, and starting a background refresh when it's due.
+func (k *RefreshingAPIKey) APIKey(ctx context.Context) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := clockOrSystem(k.Clock).Now()
+	if k.key != "" && (k.expiresAt.IsZero() || now.Before(k.expiresAt)) {
+		if !k.refreshing && !k.refreshAt.IsZero() && !now.Before(k.refreshAt) {
+			k.refreshing = true
+			go k.refresh(context.WithoutCancel(ctx))
+		}
+		return k.key, nil
+	}
+
+	key, expiresAt, err := k.Fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetching api key: %w", err)
+	}
+	k.store(now, key, expiresAt)
+	return key, nil
+}
+
+// refresh fetches the next key in the background. A failed refresh is
+// retried halfway to the expiry of the current key.
+func (k *RefreshingAPIKey) refresh(ctx context.Context) {
+	key, expiresAt, err := k.Fetch(ctx)
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.refreshing = false
+	now := clockOrSystem(k.Clock).Now()
+	if err != nil {
+		k.refreshAt = now.Add(k.expiresAt.Sub(now) / 2)
+		return
+	}
+	k.store(now, key, expiresAt)
+}
+
+// store caches key, fetched at now, scheduling its refresh no earlier than
+// halfway to expiry, for keys living less than RefreshAhead. k.mu must be
+// held.
+func (k *RefreshingAPIKey) store(now time.Time, key string, expiresAt time.Time) {
+	k.key, k.expiresAt, k.refreshAt = key, expiresAt, time.Time{}
+	if expiresAt.IsZero() {
+		return
+	}
+	ahead := k.RefreshAhead
+	if k.Jitter > 0 {
+		random := k.Rand
+		if random == nil {
+			random = rand.Float64
+		}
+		ahead += time.Duration(random() * float64(k.Jitter))
+	}
+	k.refreshAt = expiresAt.Add(-ahead)
+	if halfway := now.Add(expiresAt.Sub(now) / 2); k.refreshAt.Before(halfway) {
+		k.refreshAt = halfway
+	}
+}
+
+// Breaker is a circuit breaker consulted around every call of a generated
+// client, keyed by operationId, so that policies such as those of gobreaker
+// can be applied per operation.
+type Breaker interface {
+	// Allow is called once the request has been built, just before it is
+	// sent. A non-nil error, such as one reporting an open circuit, fails the
+	// call without sending it.
+	Allow(operationID string) error
+
+	// Record is called with the outcome of each call which was allowed.
+	Record(operationID string, success bool)
+}
+
+// BreakerSuccess reports whether a call counts as a success for a Breaker:
+// the request was sent and the server didn't respond with a 5xx status.
+// Client errors are the caller's fault and don't trip the breaker.
+func BreakerSuccess(resp *http.Response, err error) bool {
+	return err == nil && resp.StatusCode < http.StatusInternalServerError
+}
+
+var (
+	// ErrUnknownCallback is returned when a callback arrives at a URL no
+	// subscription holds, such as one which was closed.
+	ErrUnknownCallback = errors.New("no subscription for callback")
+	// ErrCallbackSubscriptionClosed is returned by Next once a subscription
+	// is closed.
+	ErrCallbackSubscriptionClosed = errors.New("callback subscription closed")
+)
+
+// callbackBuffer is the number of callbacks a subscription holds before
+// deliveries wait for Next.
+const callbackBuffer = 16
+
+// CallbackRouter correlates callbacks with the requests which asked for
+// them. Each subscription gets a callback URL of its own, below the base URL
+// of the router, to send as the callback URL of its request; the callbacks
+// which arrive at it are delivered to the subscription. It is safe for
+// concurrent use.
+type CallbackRouter struct {
+	base *url.URL
+
+	mu            sync.Mutex
+	subscriptions map[string]callbackSubscriber
+}
+
+// callbackSubscriber is the untyped side of a CallbackSubscription.
+type callbackSubscriber interface {
+	name() string
+	deliver(ctx context.Context, value any) error
+}
+
+// NewCallbackRouter returns a router minting callback URLs below baseURL,
+// the absolute URL the callback server is reachable at, such as
+// https://client.example.com/callbacks.
+func NewCallbackRouter(baseURL string) (*CallbackRouter, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing callback base URL: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("callback base URL %q isn't absolute", baseURL)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/")
+	base.RawPath, base.RawQuery, base.Fragment = "", "", ""
+	return &CallbackRouter{base: base, subscriptions: map[string]callbackSubscriber{}}, nil
+}
+
+// Route returns the name of the callback the subscription at path, the path
+// of an incoming request, receives.
+func (r *CallbackRouter) Route(path string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.subscriptions[path]
+	if !ok {
+		return "", false
+	}
+	return sub.name(), true
+}
+
+// Deliver hands value, decoded from the callback req, to the subscription
+// at its path. It waits while the subscription's buffer is full, until the
+// request is canceled. It returns ErrUnknownCallback if no subscription is
+// at the path.
+func (r *CallbackRouter) Deliver(req *http.Request, value any) error {
+	r.mu.Lock()
+	sub, ok := r.subscriptions[req.URL.Path]
+	r.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, req.URL.Path)
+	}
+	return sub.deliver(req.Context(), value)
+}
+
+// subscribe registers sub at a new path for the callback name, returning
+// its URL and path.
+func (r *CallbackRouter) subscribe(name string, sub callbackSubscriber) (string, string) {
+	u := *r.base
+	u.Path = r.base.Path + "/" + url.PathEscape(name) + "/" + crand.Text()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions[u.Path] = sub
+	return u.String(), u.Path
+}
+
+func (r *CallbackRouter) unsubscribe(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscriptions, path)
+}
+
+// CallbackSubscription receives the callbacks of type T which one request
+// asked for. Send URL as the callback URL of the request, then read the
+// callbacks with Next, and Close the subscription once no more are
+// expected.
+type CallbackSubscription[T any] struct {
+	// URL is the callback URL of the subscription.
+	URL string
+
+	router    *CallbackRouter
+	path      string
+	callback  string
+	callbacks chan T
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// SubscribeCallback returns a new subscription of router to the callback
+// name.
+func SubscribeCallback[T any](router *CallbackRouter, name string) *CallbackSubscription[T] {
+	s := &CallbackSubscription[T]{
+		router:    router,
+		callback:  name,
+		callbacks: make(chan T, callbackBuffer),
+		done:      make(chan struct{}),
+	}
+	s.URL, s.path = router.subscribe(name, s)
+	return s
+}
+
+// Next returns the next callback received, waiting for one until ctx is
+// done or the subscription is closed.
+func (s *CallbackSubscription[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	default:
+	}
+	select {
+	case v := <-s.callbacks:
+		return v, nil
+	case <-s.done:
+		return zero, ErrCallbackSubscriptionClosed
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Close stops the subscription: callbacks arriving at its URL are rejected
+// with ErrUnknownCallback, and Next returns ErrCallbackSubscriptionClosed.
+func (s *CallbackSubscription[T]) Close() {
+	s.closeOnce.Do(func() {
+		s.router.unsubscribe(s.path)
+		close(s.done)
+	})
+}
+
+func (s *CallbackSubscription[T]) name() string {
+	return s.callback
+}
+
+func (s *CallbackSubscription[T]) deliver(ctx context.Context, value any) error {
+	v, ok := value.(T)
+	if !ok {
+		return fmt.Errorf("callback %s: unexpected %T", s.callback, value)
+	}
+	select {
+	case s.callbacks <- v:
+		return nil
+	case <-s.done:
+		return fmt.Errorf("%w: %s", ErrUnknownCallback, s.path)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Clock tells the time and waits for durations to elapse. Generated clients
+// and the runtime use it for everything time-dependent, such as cache
+// freshness and injected latency, so that tests can control time with a
+// FakeClock. It's the runtime's clock: it lives here, with the runtime code
+// using it, as the runtime package itself has no code.
+type Clock interface {
+	Now() time.Time
+	// After returns a channel which receives the current time once d has
+	// elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock of the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// clockOrSystem returns c, or SystemClock if c is nil.
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return SystemClock
+	}
+	return c
+}
+
+// ContextWithDeadline is context.WithDeadline, except that the deadline is
+// kept by clock, so a FakeClock can expire it. The returned context's Err is
+// context.DeadlineExceeded once it has.
+func ContextWithDeadline(parent context.Context, clock Clock, deadline time.Time) (context.Context, context.CancelFunc) {
+	clock = clockOrSystem(clock)
+	if clock == SystemClock {
+		return context.WithDeadline(parent, deadline)
+	}
+	if d, ok := parent.Deadline(); ok && d.Before(deadline) {
+		return context.WithCancel(parent)
+	}
+	ctx, cancel := context.WithCancelCause(parent)
+	expired := clock.After(deadline.Sub(clock.Now()))
+	go func() {
+		select {
+		case <-expired:
+			cancel(context.DeadlineExceeded)
+		case <-ctx.Done():
+		}
+	}()
+	return &clockDeadlineContext{Context: ctx, deadline: deadline}, func() { cancel(context.Canceled) }
+}
+
+type clockDeadlineContext struct {
+	context.Context
+	deadline time.Time
+}
+
+func (c *clockDeadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *clockDeadlineContext) Err() error {
+	err := c.Context.Err()
+	if err != nil && errors.Is(context.Cause(c.Context), context.DeadlineExceeded) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+// FakeClock is a Clock whose time only moves when it is advanced. It is safe
+// for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFakeClock returns a FakeClock set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the clock's current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel which receives the clock's time once it has been
+// advanced by at least d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward by d, releasing the waiters whose time has
+// come, earliest first.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	sort.SliceStable(c.waiters, func(i, j int) bool { return c.waiters[i].at.Before(c.waiters[j].at) })
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.ch <- c.now
+	}
+	c.waiters = pending
+}
+
+// Waiters returns the number of pending After calls, which lets tests wait
+// for code under test to start waiting before advancing the clock.
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// coverageMethods are the methods of operations, in the order they're
+// reported.
+var coverageMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace", "query"}
+
+// Coverage records which operations, response statuses and schema properties
+// traffic exercises, to report the surface of a spec which contract tests
+// leave untested. Record requests through its Middleware on servers, or the
+// doer Wrap returns on clients, then call Report. It is safe for concurrent
+// use.
+type Coverage struct {
+	// BasePath is removed from request paths before they're matched against
+	// the spec, for APIs served below a prefix, such as /v1.
+	BasePath string
+
+	spec *ResponseValidator
+	mu   sync.Mutex
+	hits map[string]int
+}
+
+// NewCoverage returns a Coverage of spec, the JSON of an OpenAPI document,
+// such as GetOpenAPISpecJSON returns for JSON specs.
+func NewCoverage(spec []byte) (*Coverage, error) {
+	v, err := NewResponseValidator(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &Coverage{spec: v, hits: map[string]int{}}, nil
+}
+
+// CoverageItem is an operation, response or schema property of the spec, with
+// the number of times traffic exercised it.
+type CoverageItem struct {
+	// Name identifies the item: GET /pets/{id} for operations,
+	// GET /pets/{id} 404 for responses, and the JSON pointer of the property
+	// in the spec, such as #/components/schemas/Pet/properties/name, for
+	// properties.
+	Name string
+	Hits int
+}
+
+// CoverageReport lists the operations, responses and properties of request
+// and response bodies a spec declares, with the traffic each saw.
+type CoverageReport struct {
+	Operations []CoverageItem
+	Responses  []CoverageItem
+	Properties []CoverageItem
+}
+
+// Record records a request, with its body, and the response with status,
+// header and body written for it. Requests matching no operation of the spec
+// are ignored. Bodies are only inspected when they're JSON.
+func (c *Coverage) Record(r *http.Request, requestBody []byte, status int, header http.Header, responseBody []byte) {
+	path := strings.TrimPrefix(r.URL.Path, c.BasePath)
+	route, operation := c.spec.match(r.Method, path)
+	if operation == nil {
+		return
+	}
+	method := strings.ToLower(r.Method)
+	name := strings.ToUpper(method) + " " + route.template
+	operationPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + method
+	hits := []string{name}
+
+	if declaration, declarationPath := c.spec.resolve(operation["requestBody"], operationPath+"/requestBody"); declaration != nil {
+		hits = c.coverBody(hits, declaration, declarationPath, r.Header.Get("Content-Type"), requestBody)
+	}
+	responses, _ := operation["responses"].(map[string]any)
+	if key, ok := responseKey(responses, status); ok {
+		hits = append(hits, name+" "+key)
+		response, responsePath := c.spec.resolve(responses[key], operationPath+"/responses/"+escapeSchemaPointer(key))
+		hits = c.coverBody(hits, response, responsePath, header.Get("Content-Type"), responseBody)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, hit := range hits {
+		c.hits[hit]++
+	}
+}
+
+// coverBody appends to hits the properties body exercises, when its
+// contentType is a JSON media type declared by the request body or response
+// declaration, at path.
+func (c *Coverage) coverBody(hits []string, declaration any, path, contentType string, body []byte) []string {
+	object, _ := declaration.(map[string]any)
+	content, _ := object["content"].(map[string]any)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || len(body) == 0 || !isJSONMediaType(mediaType) {
+		return hits
+	}
+	key, ok := mediaTypeKey(content, mediaType)
+	if !ok {
+		return hits
+	}
+	media, _ := content[key].(map[string]any)
+	schema, ok := media["schema"]
+	if !ok {
+		return hits
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return hits
+	}
+	return c.cover(hits, value, schema, path+"/content/"+escapeSchemaPointer(key)+"/schema", 0)
+}
+
+// cover appends to hits the properties of schema, at schemaPath, which value
+// holds, descending into the subschemas value matches.
+func (c *Coverage) cover(hits []string, value, schema any, schemaPath string, depth int) []string {
+	s, ok := schema.(map[string]any)
+	if !ok || depth > maxSchemaDepth {
+		return hits
+	}
+	if ref, ok := s["$ref"].(string); ok {
+		if target, ok := c.spec.lookup(ref); ok {
+			hits = c.cover(hits, value, target, ref, depth+1)
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		properties, _ := s["properties"].(map[string]any)
+		additional, _ := s["additionalProperties"].(map[string]any)
+		for _, name := range sortedSchemaKeys(value) {
+			if sub, ok := properties[name]; ok {
+				propertyPath := schemaPath + "/properties/" + escapeSchemaPointer(name)
+				hits = append(hits, propertyPath)
+				hits = c.cover(hits, value[name], sub, propertyPath, depth+1)
+			} else if additional != nil {
+				hits = c.cover(hits, value[name], additional, schemaPath+"/additionalProperties", depth+1)
+			}
+		}
+	case []any:
+		prefix, _ := s["prefixItems"].([]any)
+		for i, item := range value {
+			if i < len(prefix) {
+				hits = c.cover(hits, item, prefix[i], schemaPath+"/prefixItems/"+strconv.Itoa(i), depth+1)
+			} else if items, ok := s["items"]; ok {
+				hits = c.cover(hits, item, items, schemaPath+"/items", depth+1)
+			}
+		}
+	}
+
+	if all, ok := s["allOf"].([]any); ok {
+		for i, sub := range all {
+			hits = c.cover(hits, value, sub, schemaPath+"/allOf/"+strconv.Itoa(i), depth+1)
+		}
+	}
+	for _, keyword := range []string{"anyOf", "oneOf"} {
+		branches, ok := s[keyword].([]any)
+		if !ok {
+			continue
+		}
+		if keyword == "oneOf" {
+			if i, ok := c.spec.discriminatedBranch(value, s, branches); ok {
+				hits = c.cover(hits, value, branches[i], schemaPath+"/oneOf/"+strconv.Itoa(i), depth+1)
+				continue
+			}
+		}
+		// Only the branches value matches are exercised.
+		for i, sub := range branches {
+			branchPath := schemaPath + "/" + keyword + "/" + strconv.Itoa(i)
+			if c.spec.check(value, sub, branchPath, "", depth+1) == nil {
+				hits = c.cover(hits, value, sub, branchPath, depth+1)
+			}
+		}
+	}
+	return hits
+}
+
+// Report lists everything the spec declares with the traffic recorded so far.
+func (c *Coverage) Report() CoverageReport {
+	var report CoverageReport
+	properties := map[string]bool{}
+	for _, route := range c.spec.routesByTemplate() {
+		for _, method := range coverageMethods {
+			operation, ok := route.operations[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			name := strings.ToUpper(method) + " " + route.template
+			operationPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + method
+			report.Operations = append(report.Operations, CoverageItem{Name: name})
+
+			requestBody, requestPath := c.spec.resolve(operation["requestBody"], operationPath+"/requestBody")
+			c.declaredProperties(properties, requestBody, requestPath)
+			responses, _ := operation["responses"].(map[string]any)
+			for _, key := range sortedSchemaKeys(responses) {
+				report.Responses = append(report.Responses, CoverageItem{Name: name + " " + key})
+				response, responsePath := c.spec.resolve(responses[key], operationPath+"/responses/"+escapeSchemaPointer(key))
+				c.declaredProperties(properties, response, responsePath)
+			}
+		}
+	}
+	for path := range properties {
+		report.Properties = append(report.Properties, CoverageItem{Name: path})
+	}
+	sort.Slice(report.Properties, func(i, j int) bool { return report.Properties[i].Name < report.Properties[j].Name })
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, items := range [][]CoverageItem{report.Operations, report.Responses, report.Properties} {
+		for i := range items {
+			items[i].Hits = c.hits[items[i].Name]
+		}
+	}
+	return report
+}
+
+// declaredProperties adds to properties those of the JSON bodies of the
+// request body or response declaration at path.
+func (c *Coverage) declaredProperties(properties map[string]bool, declaration any, path string) {
+	object, _ := declaration.(map[string]any)
+	content, _ := object["content"].(map[string]any)
+	for _, key := range sortedSchemaKeys(content) {
+		media, _ := content[key].(map[string]any)
+		if schema, ok := media["schema"]; ok && isJSONMediaType(key) {
+			c.schemaProperties(properties, schema, path+"/content/"+escapeSchemaPointer(key)+"/schema", map[string]bool{})
+		}
+	}
+}
+
+// schemaProperties adds to properties those of schema, at schemaPath, and of
+// its subschemas, following each reference once.
+func (c *Coverage) schemaProperties(properties map[string]bool, schema any, schemaPath string, followed map[string]bool) {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return
+	}
+	if ref, ok := s["$ref"].(string); ok && !followed[ref] {
+		followed[ref] = true
+		if target, ok := c.spec.lookup(ref); ok {
+			c.schemaProperties(properties, target, ref, followed)
+		}
+	}
+	sub, _ := s["properties"].(map[string]any)
+	for _, name := range sortedSchemaKeys(sub) {
+		propertyPath := schemaPath + "/properties/" + escapeSchemaPointer(name)
+		properties[propertyPath] = true
+		c.schemaProperties(properties, sub[name], propertyPath, followed)
+	}
+	for _, keyword := range []string{"items", "additionalProperties"} {
+		c.schemaProperties(properties, s[keyword], schemaPath+"/"+keyword, followed)
+	}
+	for _, keyword := range []string{"prefixItems", "allOf", "anyOf", "oneOf"} {
+		branches, _ := s[keyword].([]any)
+		for i, branch := range branches {
+			c.schemaProperties(properties, branch, schemaPath+"/"+keyword+"/"+strconv.Itoa(i), followed)
+		}
+	}
+}
+
+// routesByTemplate returns the routes of the spec in template order.
+func (v *ResponseValidator) routesByTemplate() []responseRoute {
+	routes := append([]responseRoute(nil), v.routes...)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].template < routes[j].template })
+	return routes
+}
+
+// Untested returns the report of the items traffic didn't exercise.
+func (r CoverageReport) Untested() CoverageReport {
+	return CoverageReport{
+		Operations: untestedItems(r.Operations),
+		Responses:  untestedItems(r.Responses),
+		Properties: untestedItems(r.Properties),
+	}
+}
+
+func untestedItems(items []CoverageItem) []CoverageItem {
+	var untested []CoverageItem
+	for _, item := range items {
+		if item.Hits == 0 {
+			untested = append(untested, item)
+		}
+	}
+	return untested
+}
+
+// String summarizes the report: the share of each kind of item exercised,
+// followed by those which weren't.
+func (r CoverageReport) String() string {
+	var b strings.Builder
+	sections := []struct {
+		name  string
+		items []CoverageItem
+	}{
+		{"operations", r.Operations},
+		{"responses", r.Responses},
+		{"properties", r.Properties},
+	}
+	for _, section := range sections {
+		tested := len(section.items) - len(untestedItems(section.items))
+		percent := 100.0
+		if len(section.items) > 0 {
+			percent = 100 * float64(tested) / float64(len(section.items))
+		}
+		fmt.Fprintf(&b, "%s: %d/%d (%.1f%%)\n", section.name, tested, len(section.items), percent)
+	}
+	for _, section := range sections {
+		untested := untestedItems(section.items)
+		if len(untested) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\nuntested %s:\n", section.name)
+		for _, item := range untested {
+			fmt.Fprintf(&b, "  %s\n", item.Name)
+		}
+	}
+	return b.String()
+}
+
+// Middleware records the requests next handles, and its responses, for
+// servers built on net/http. Bodies are copied as they're read and written,
+// so it is meant for tests.
+func (c *Coverage) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requestBody bytes.Buffer
+		if r.Body != nil {
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.TeeReader(r.Body, &requestBody), r.Body}
+		}
+		rec := &responseCapture{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		status, header := rec.status, rec.header
+		if status == 0 {
+			status, header = http.StatusOK, w.Header()
+		}
+		c.Record(r, requestBody.Bytes(), status, header, rec.body.Bytes())
+	})
+}
+
+// Wrap returns a doer which sends requests through doer, recording them and
+// their responses, for clients. Response bodies are read in full before
+// they're returned.
+func (c *Coverage) Wrap(doer HTTPDoer) HTTPDoer {
+	return &coverageDoer{coverage: c, doer: doer}
+}
+
+type coverageDoer struct {
+	coverage *Coverage
+	doer     HTTPDoer
+}
+
+func (cd *coverageDoer) Do(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(body)
+			_ = body.Close()
+		}
+	}
+	resp, err := cd.doer.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	cd.coverage.Record(req, requestBody, resp.StatusCode, resp.Header, responseBody)
+	return resp, nil
+}
+
+// redactedValue replaces redacted header, query parameter and field values.
+const redactedValue = "[REDACTED]"
+
+// maxDumpBody is the number of bytes of each body a DebugDumper captures.
+// Longer bodies are passed through untouched, but dumped truncated.
+const maxDumpBody = 64 << 10
+
+// DebugRedactions lists the values a DebugDumper redacts, in addition to the
+// Authorization, Proxy-Authorization, Cookie and Set-Cookie headers.
+type DebugRedactions struct {
+	Headers     []string // Header names, matched case-insensitively
+	QueryParams []string // Query parameter names
+	Fields      []string // JSON property and form field names, at any depth
+}
+
+// DebugDumper writes the method, URL, headers and body of HTTP requests and
+// their responses while it is enabled, with credentials and sensitive fields
+// redacted. It can be enabled and disabled at any time, including while
+// requests are in flight.
+//
+// Bodies are never buffered in full: up to 64KiB of each is captured as it
+// streams through, so a response is dumped once its body has been read to
+// the end or closed.
+type DebugDumper struct {
+	// Clock times requests. Defaults to the clock passed to WrapWithClock, or
+	// SystemClock; set it before use.
+	Clock Clock
+
+	enabled atomic.Bool
+
+	mu sync.Mutex // Serializes writes to w
+	w  io.Writer
+
+	headers map[string]bool
+	query   map[string]bool
+	fields  map[string]bool
+}
+
+// NewDebugDumper returns a disabled DebugDumper which writes to w.
+func NewDebugDumper(w io.Writer, redactions DebugRedactions) *DebugDumper {
+	d := &DebugDumper{
+		w: w,
+		headers: map[string]bool{
+			"Authorization":       true,
+			"Proxy-Authorization": true,
+			"Cookie":              true,
+			"Set-Cookie":          true,
+		},
+		query:  make(map[string]bool),
+		fields: make(map[string]bool),
+	}
+	for _, h := range redactions.Headers {
+		d.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, q := range redactions.QueryParams {
+		d.query[q] = true
+	}
+	for _, f := range redactions.Fields {
+		d.fields[f] = true
+	}
+	return d
+}
+
+// SetEnabled turns dumping on or off.
+func (d *DebugDumper) SetEnabled(enabled bool) {
+	d.enabled.Store(enabled)
+}
+
+// Enabled reports whether dumping is on.
+func (d *DebugDumper) Enabled() bool {
+	return d.enabled.Load()
+}
+
+// Wrap returns a doer which dumps each request sent through doer, and its
+// response, while d is enabled.
+func (d *DebugDumper) Wrap(doer HTTPDoer) HTTPDoer {
+	return d.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing requests with clock unless d has a Clock of
+// its own.
+func (d *DebugDumper) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	return &debugDoer{dumper: d, doer: doer, clock: clock}
+}
+
+type debugDoer struct {
+	dumper *DebugDumper
+	doer   HTTPDoer
+	clock  Clock
+}
+
+func (dd *debugDoer) Do(req *http.Request) (*http.Response, error) {
+	d := dd.dumper
+	if !d.Enabled() {
+		return dd.doer.Do(req)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--> %s %s\n", req.Method, d.redactURL(req.URL))
+	body, truncated, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	d.writeMessage(&buf, req.Header, body, truncated)
+
+	clock := d.Clock
+	if clock == nil {
+		clock = clockOrSystem(dd.clock)
+	}
+	start := clock.Now()
+	resp, err := dd.doer.Do(req)
+	if err != nil {
+		fmt.Fprintf(&buf, "<-- error after %s: %v\n", clock.Now().Sub(start).Round(time.Millisecond), err)
+		d.write(buf.Bytes())
+		return nil, err
+	}
+
+	fmt.Fprintf(&buf, "<-- %s (%s)\n", resp.Status, clock.Now().Sub(start).Round(time.Millisecond))
+	if resp.Body == nil || resp.Body == http.NoBody {
+		d.writeMessage(&buf, resp.Header, nil, false)
+		d.write(buf.Bytes())
+		return resp, nil
+	}
+	resp.Body = &dumpingBody{
+		ReadCloser: resp.Body,
+		done: func(body []byte, truncated bool, err error) {
+			if err != nil {
+				fmt.Fprintf(&buf, "<-- error reading body: %v\n", err)
+			}
+			d.writeMessage(&buf, resp.Header, body, truncated)
+			d.write(buf.Bytes())
+		},
+	}
+	return resp, nil
+}
+
+func (d *DebugDumper) write(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(p)
+}
+
+// peekRequestBody returns up to maxDumpBody bytes of the request body, and
+// whether there was more, leaving the whole body in place to be sent.
+func peekRequestBody(req *http.Request) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, false, nil
+	}
+	head, err := io.ReadAll(io.LimitReader(req.Body, maxDumpBody+1))
+	if err != nil {
+		_ = req.Body.Close()
+		return nil, false, err
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), req.Body), req.Body}
+	if len(head) > maxDumpBody {
+		return head[:maxDumpBody], true, nil
+	}
+	return head, false, nil
+}
+
+// dumpingBody captures up to maxDumpBody bytes of a response body as it is
+// read, and calls done with them once it has been read to the end or closed.
+type dumpingBody struct {
+	io.ReadCloser
+	head      bytes.Buffer
+	truncated bool
+	once      sync.Once
+	done      func(body []byte, truncated bool, err error)
+}
+
+func (b *dumpingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if room := maxDumpBody - b.head.Len(); room < n {
+		b.head.Write(p[:room])
+		b.truncated = true
+	} else {
+		b.head.Write(p[:n])
+	}
+	switch {
+	case err == io.EOF:
+		b.finish(nil)
+	case err != nil:
+		b.finish(err)
+	}
+	return n, err
+}
+
+func (b *dumpingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.finish(nil)
+	return err
+}
+
+func (b *dumpingBody) finish(err error) {
+	b.once.Do(func() { b.done(b.head.Bytes(), b.truncated, err) })
+}
+
+func (d *DebugDumper) writeMessage(buf *bytes.Buffer, header http.Header, body []byte, truncated bool) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			if d.headers[http.CanonicalHeaderKey(k)] {
+				v = redactedValue
+			}
+			fmt.Fprintf(buf, "%s: %s\n", k, v)
+		}
+	}
+	if len(body) > 0 {
+		buf.WriteString("\n")
+		buf.WriteString(d.redactBody(header.Get("Content-Type"), body, truncated))
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+}
+
+func (d *DebugDumper) redactURL(u *url.URL) string {
+	if len(d.query) == 0 || u.RawQuery == "" {
+		return u.String()
+	}
+	query := u.Query()
+	for name := range query {
+		if d.query[name] {
+			query[name] = []string{redactedValue}
+		}
+	}
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactBody renders a body for the dump, redacting sensitive fields of JSON
+// and form bodies. Bodies which aren't text are summarized by their size, as
+// are truncated JSON and form bodies, which can't be redacted reliably.
+func (d *DebugDumper) redactBody(contentType string, body []byte, truncated bool) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	isJSON := mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+	isText := strings.HasPrefix(mediaType, "text/") || strings.HasSuffix(mediaType, "xml") || mediaType == ""
+	switch {
+	case truncated && isText:
+		return string(body) + "... [truncated]"
+	case truncated:
+		return fmt.Sprintf("[more than %d bytes of %s]", len(body), mediaType)
+	case isJSON:
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return string(body)
+		}
+		redacted, err := json.Marshal(d.redactJSON(value))
+		if err != nil {
+			return string(body)
+		}
+		return string(redacted)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		for name := range values {
+			if d.fields[name] {
+				values[name] = []string{redactedValue}
+			}
+		}
+		return values.Encode()
+	case isText:
+		return string(body)
+	default:
+		return fmt.Sprintf("[%d bytes of %s]", len(body), mediaType)
+	}
+}
+
+func (d *DebugDumper) redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if d.fields[k] {
+				v[k] = redactedValue
+			} else {
+				v[k] = d.redactJSON(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = d.redactJSON(item)
+		}
+	}
+	return value
+}
+
+// MarshalDeepObject serializes i, a struct, map or slice, as the deepObject
+// query parameter paramName, such as
+// "filter[status]=open&filter[created][gte]=2024-01-01". Objects nest in
+// brackets, named by their json tags, and array items are indexed from 0. It
+// is the serialization generated clients send deepObject parameters with.
+func MarshalDeepObject(i any, paramName string) (string, error) {
+	return params.StyleDeepObjectParam(paramName, i)
+}
+
+// UnmarshalDeepObject binds the deepObject query parameter paramName from
+// values to dst, a pointer to a struct, map or slice, the way generated
+// servers bind deepObject parameters. A missing parameter leaves dst
+// unchanged.
+func UnmarshalDeepObject(dst any, paramName string, values url.Values) error {
+	return params.BindDeepObjectParam(paramName, values, dst, false)
+}
+
+const (
+	defaultReconnectDelay       = time.Second
+	defaultMaxReconnectDelay    = 30 * time.Second
+	defaultMaxReconnectAttempts = 5
+)
+
+// Reconnection describes an attempt of an EventStream to resume an
+// interrupted stream.
+type Reconnection struct {
+	// Attempt counts the reconnections since an event was last received,
+	// starting from 1.
+	Attempt int
+	// LastEventID is sent in the Last-Event-ID header of the new request.
+	LastEventID string
+	// Delay is how long the stream waits before reconnecting.
+	Delay time.Duration
+	// Err is what interrupted the stream, or nil if the server ended it.
+	Err error
+}
+
+// EventStream reads the events of a text/event-stream response whose data
+// is of type T, and resumes the stream when it is interrupted: it tracks the
+// ID of the last event received, and reconnects with it in the Last-Event-ID
+// header, so the server can pick up where it left off. Reconnections back
+// off exponentially, from the delay set by the server with a retry field, or
+// InitialDelay, up to MaxDelay. Set its fields before reading events.
+type EventStream[T any] struct {
+	// InitialDelay is the delay before reconnecting, unless the server sets
+	// one. Defaults to one second.
+	InitialDelay time.Duration
+	// MaxDelay bounds the delay between reconnections. Defaults to thirty
+	// seconds.
+	MaxDelay time.Duration
+	// MaxAttempts bounds the reconnections in a row which receive no event,
+	// after which the stream ends. Defaults to 5; negative means no limit.
+	MaxAttempts int
+	// OnReconnect, if set, is called before each reconnection.
+	OnReconnect func(Reconnection)
+	// Clock times the delays. Defaults to SystemClock.
+	Clock Clock
+
+	ctx       context.Context
+	reconnect func(ctx context.Context, lastEventID string) (io.ReadCloser, error)
+
+	mu            sync.Mutex
+	body          io.ReadCloser
+	lastEventID   string
+	reconnections int
+	closed        bool
+}
+
+// NewEventStream returns an EventStream reading body, which reconnects by
+// calling reconnect with the ID of the last event received. It stops when
+// ctx is done.
+func NewEventStream[T any](ctx context.Context, body io.ReadCloser, reconnect func(ctx context.Context, lastEventID string) (io.ReadCloser, error)) *EventStream[T] {
+	return &EventStream[T]{ctx: ctx, body: body, reconnect: reconnect}
+}
+
+// LastEventID returns the ID of the last event received.
+func (s *EventStream[T]) LastEventID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastEventID
+}
+
+// Reconnections returns the number of times the stream has reconnected.
+func (s *EventStream[T]) Reconnections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reconnections
+}
+
+// Close ends the stream, closing the current response body. Events closes
+// it too when its loop ends, so Close is only needed to stop the stream from
+// another goroutine, or when events are never read.
+func (s *EventStream[T]) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.body == nil {
+		return nil
+	}
+	return s.body.Close()
+}
+
+// Events returns an iterator over the events of the stream, carrying data
+// decoded from JSON, except strings, which are taken as is. Events without
+// data aren't yielded. The iterator yields an error at most once, as its
+// last value: when data can't be decoded, when ctx is done, or when
+// reconnecting fails MaxAttempts times in a row. It can only be ranged over
+// once.
+func (s *EventStream[T]) Events() iter.Seq2[Event[T], error] {
+	return func(yield func(Event[T], error) bool) {
+		defer s.Close()
+		clock := clockOrSystem(s.Clock)
+		delay := s.InitialDelay
+		if delay <= 0 {
+			delay = defaultReconnectDelay
+		}
+		maxAttempts := s.MaxAttempts
+		if maxAttempts == 0 {
+			maxAttempts = defaultMaxReconnectAttempts
+		}
+		attempt := 0
+		for {
+			received, stop, err := s.read(yield, &delay)
+			if stop || s.isClosed() {
+				return
+			}
+			if received {
+				attempt = 0
+			}
+
+			// Reconnect, backing off while attempts fail.
+			for {
+				if ctxErr := s.ctx.Err(); ctxErr != nil {
+					yield(Event[T]{}, ctxErr)
+					return
+				}
+				attempt++
+				if maxAttempts >= 0 && attempt > maxAttempts {
+					if err != nil {
+						yield(Event[T]{}, err)
+					}
+					return
+				}
+				wait := s.backoff(delay, attempt)
+				if s.OnReconnect != nil {
+					s.OnReconnect(Reconnection{Attempt: attempt, LastEventID: s.LastEventID(), Delay: wait, Err: err})
+				}
+				select {
+				case <-clock.After(wait):
+				case <-s.ctx.Done():
+					yield(Event[T]{}, s.ctx.Err())
+					return
+				}
+
+				var body io.ReadCloser
+				body, err = s.reconnect(s.ctx, s.LastEventID())
+				if err == nil {
+					if !s.setBody(body) {
+						return
+					}
+					break
+				}
+			}
+		}
+	}
+}
+
+// backoff returns the delay before reconnection attempt, doubling delay for
+// each failed attempt up to MaxDelay.
+func (s *EventStream[T]) backoff(delay time.Duration, attempt int) time.Duration {
+	maxDelay := s.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxReconnectDelay
+	}
+	for range attempt - 1 {
+		if delay >= maxDelay {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// isClosed reports whether Close was called.
+func (s *EventStream[T]) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// setBody replaces the body of a reconnected stream, and reports whether the
+// stream is still open.
+func (s *EventStream[T]) setBody(body io.ReadCloser) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		_ = body.Close()
+		return false
+	}
+	s.body = body
+	s.reconnections++
+	return true
+}
+
+// read yields the events of the current body until it ends, updating the
+// last event ID and the reconnection delay. It reports whether any event
+// was received, whether to stop, because the consumer did or data couldn't
+// be decoded, and what interrupted the stream.
+func (s *EventStream[T]) read(yield func(Event[T], error) bool, delay *time.Duration) (received, stop bool, err error) {
+	s.mu.Lock()
+	body := s.body
+	s.mu.Unlock()
+	defer body.Close()
+
+	events := bufio.NewReader(body)
+	for {
+		event, err := readEvent(events)
+		if errors.Is(err, io.EOF) {
+			return received, false, nil
+		}
+		if err != nil {
+			return received, false, err
+		}
+		if event.retry > 0 {
+			*delay = event.retry
+		}
+		s.mu.Lock()
+		if event.hasID {
+			s.lastEventID = event.id
+		}
+		// Events carry the last event ID, even if they have no id field.
+		id := s.lastEventID
+		s.mu.Unlock()
+		if !event.hasData {
+			continue
+		}
+		received = true
+		out := Event[T]{ID: id, Name: event.name, Retry: event.retry}
+		if err := decodeEventData(event.data, &out.Data); err != nil {
+			yield(out, err)
+			return received, true, nil
+		}
+		if !yield(out, nil) {
+			return received, true, nil
+		}
+	}
+}
+
+// Event is a server-sent event carrying Data. ID, Name and Retry are optional.
+type Event[T any] struct {
+	// ID sets the last event ID, which the client sends back in the
+	// Last-Event-ID header when it reconnects.
+	ID string
+	// Name is the event type, written as the event field. Clients dispatch
+	// unnamed events as "message".
+	Name string
+	// Retry asks the client to wait this long before reconnecting.
+	Retry time.Duration
+	Data  T
+}
+
+// EventWriter writes a text/event-stream response whose events carry data
+// of type T. Data is encoded as JSON, except strings, which are written as
+// is. Each event is flushed as soon as it is written. It is safe for
+// concurrent use, so a Heartbeat can run alongside the handler sending
+// events.
+type EventWriter[T any] struct {
+	// Clock spaces heartbeats. Defaults to SystemClock.
+	Clock Clock
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewEventWriter returns an EventWriter writing to w. When w is an
+// http.ResponseWriter, the headers of an event stream are set on it, so it
+// must be called before the response is written. Writers which aren't, such
+// as the *bufio.Writer of a fiber stream, are flushed if they can be.
+func NewEventWriter[T any](w io.Writer) *EventWriter[T] {
+	if rw, ok := w.(http.ResponseWriter); ok {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+	}
+	return &EventWriter[T]{w: w}
+}
+
+// Send writes an unnamed event carrying data.
+func (e *EventWriter[T]) Send(data T) error {
+	return e.SendEvent(Event[T]{Data: data})
+}
+
+// SendEvent writes event.
+func (e *EventWriter[T]) SendEvent(event Event[T]) error {
+	var data string
+	if s, ok := any(event.Data).(string); ok {
+		data = s
+	} else {
+		buf, err := json.Marshal(event.Data)
+		if err != nil {
+			return fmt.Errorf("encoding event data: %w", err)
+		}
+		data = string(buf)
+	}
+
+	var b strings.Builder
+	if err := writeEventField(&b, "id", event.ID); err != nil {
+		return err
+	}
+	if err := writeEventField(&b, "event", event.Name); err != nil {
+		return err
+	}
+	if event.Retry > 0 {
+		b.WriteString("retry: " + strconv.FormatInt(event.Retry.Milliseconds(), 10) + "\n")
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	return e.write(b.String())
+}
+
+// writeEventField writes a single-line field, unless value is empty.
+func writeEventField(b *strings.Builder, name, value string) error {
+	if value == "" {
+		return nil
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("event %s %q spans several lines", name, value)
+	}
+	b.WriteString(name + ": " + value + "\n")
+	return nil
+}
+
+// Retry asks the client to wait d before reconnecting, without sending an
+// event.
+func (e *EventWriter[T]) Retry(d time.Duration) error {
+	return e.write("retry: " + strconv.FormatInt(d.Milliseconds(), 10) + "\n\n")
+}
+
+// Comment writes a comment, which clients ignore. An empty comment is a
+// heartbeat, keeping proxies from closing an idle stream.
+func (e *EventWriter[T]) Comment(text string) error {
+	var b strings.Builder
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		b.WriteString(":" + line + "\n")
+	}
+	b.WriteString("\n")
+	return e.write(b.String())
+}
+
+// Heartbeat writes an empty comment every interval, until ctx is done or a
+// write fails. It is meant to run in its own goroutine, with the context of
+// the request:
+//
+//	go events.Heartbeat(r.Context(), 15*time.Second)
+func (e *EventWriter[T]) Heartbeat(ctx context.Context, interval time.Duration) error {
+	clock := clockOrSystem(e.Clock)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(interval):
+		}
+		if err := e.Comment(""); err != nil {
+			return err
+		}
+	}
+}
+
+// Flush sends what has been written so far to the client.
+func (e *EventWriter[T]) Flush() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.flush()
+}
+
+func (e *EventWriter[T]) write(s string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, err := io.WriteString(e.w, s); err != nil {
+		return err
+	}
+	return e.flush()
+}
+
+func (e *EventWriter[T]) flush() error {
+	switch w := e.w.(type) {
+	case http.ResponseWriter:
+		err := http.NewResponseController(w).Flush()
+		if errors.Is(err, http.ErrNotSupported) {
+			return nil
+		}
+		return err
+	case *bufio.Writer:
+		return w.Flush()
+	case http.Flusher:
+		w.Flush()
+	}
+	return nil
+}
+
+// ErrInjectedFault is returned for requests failed by a FaultInjector, unless
+// the Fault sets its own error.
+var ErrInjectedFault = errors.New("injected fault")
+
+// Fault describes the failures a FaultInjector injects into the requests of an
+// operation. Rates are probabilities between 0 and 1, rolled independently for
+// every request.
+type Fault struct {
+	// Latency is added before each request is sent, or fails.
+	Latency time.Duration
+
+	// ErrorRate is the rate of requests which fail with Error without being
+	// sent.
+	ErrorRate float64
+	// Error defaults to ErrInjectedFault.
+	Error error
+
+	// StatusRate is the rate of requests which get an empty response with
+	// StatusCode without being sent.
+	StatusRate float64
+	// StatusCode defaults to 503 Service Unavailable.
+	StatusCode int
+
+	// MalformedRate is the rate of responses whose body is replaced with
+	// MalformedBody.
+	MalformedRate float64
+	// MalformedBody defaults to a truncated JSON document.
+	MalformedBody []byte
+}
+
+// FaultInjector injects latency, errors, error statuses and malformed response
+// bodies into requests, per operationId, to test how code using a generated
+// client copes with an unreliable service. The operationId of a request is
+// read from its context, see ContextWithOperationID.
+type FaultInjector struct {
+	// Operations holds the faults for each operationId.
+	Operations map[string]Fault
+	// Default applies to operations not listed in Operations, and to requests
+	// without an operationId.
+	Default Fault
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it to make injected faults deterministic.
+	Rand func() float64
+	// Clock times the injected latency. Defaults to the clock passed to
+	// WrapWithClock, or SystemClock.
+	Clock Clock
+}
+
+// Wrap returns a doer which sends requests through doer, injecting faults.
+func (f *FaultInjector) Wrap(doer HTTPDoer) HTTPDoer {
+	return f.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing injected latency with clock unless f has a
+// Clock of its own. It lets an injector shared by several clients follow each
+// client's clock.
+func (f *FaultInjector) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	return &faultDoer{injector: f, doer: doer, clock: clock}
+}
+
+func (f *FaultInjector) fault(req *http.Request) Fault {
+	if operationID, ok := OperationIDFromContext(req.Context()); ok {
+		if fault, ok := f.Operations[operationID]; ok {
+			return fault
+		}
+	}
+	return f.Default
+}
+
+func (f *FaultInjector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	random := f.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < rate
+}
+
+type faultDoer struct {
+	injector *FaultInjector
+	doer     HTTPDoer
+	clock    Clock
+}
+
+func (fd *faultDoer) Do(req *http.Request) (*http.Response, error) {
+	f := fd.injector
+	fault := f.fault(req)
+
+	if fault.Latency > 0 {
+		clock := f.Clock
+		if clock == nil {
+			clock = fd.clock
+		}
+		select {
+		case <-clockOrSystem(clock).After(fault.Latency):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if f.roll(fault.ErrorRate) {
+		if fault.Error != nil {
+			return nil, fault.Error
+		}
+		return nil, ErrInjectedFault
+	}
+
+	if f.roll(fault.StatusRate) {
+		statusCode := fault.StatusCode
+		if statusCode == 0 {
+			statusCode = http.StatusServiceUnavailable
+		}
+		return &http.Response{
+			Status:     strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+			StatusCode: statusCode,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     make(http.Header),
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+
+	resp, err := fd.doer.Do(req)
+	if err != nil || !f.roll(fault.MalformedRate) {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	body := fault.MalformedBody
+	if body == nil {
+		body = []byte(`{"malformed": [tru`)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// FormEncoding describes how a property of an application/x-www-form-urlencoded
+// or multipart request body is serialized. It mirrors the OpenAPI Encoding
+// Object.
+type FormEncoding struct {
+	// ContentType of the property. For multipart bodies this is the part's
+	// Content-Type. A JSON content type sends the property as JSON text.
+	ContentType string
+	// Headers are additional headers sent with a multipart part.
+	Headers map[string]string
+	// Style is the serialization of a url-encoded property: "form" (the
+	// default), "spaceDelimited", "pipeDelimited" or "deepObject".
+	Style string
+	// Explode controls whether arrays and objects produce a value per item.
+	// OpenAPI defaults it to true for the form style, and false otherwise.
+	Explode bool
+}
+
+func (e FormEncoding) style() string {
+	if e.Style == "" {
+		return "form"
+	}
+	return e.Style
+}
+
+func (e FormEncoding) isJSON() bool {
+	return strings.Contains(e.ContentType, "json")
+}
+
+// partContentType returns the first declared content type, or def when none
+// is declared.
+func (e FormEncoding) partContentType(def string) string {
+	if e.ContentType == "" {
+		return def
+	}
+	ct, _, _ := strings.Cut(e.ContentType, ",")
+	return strings.TrimSpace(ct)
+}
+
+func (e FormEncoding) delimiter() string {
+	switch e.style() {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	default:
+		return ","
+	}
+}
+
+// formFile is implemented by file types, such as types.File, which are sent
+// as multipart file parts.
+type formFile interface {
+	Filename() string
+	Reader() (io.ReadCloser, error)
+}
+
+// typedFormFile is implemented by file types which carry their own
+// Content-Type, sent in place of the one declared by the encoding.
+type typedFormFile interface {
+	ContentType() string
+}
+
+// multipartFile is implemented by file types which can be bound from a
+// multipart file part.
+type multipartFile interface {
+	InitFromMultipart(header *multipart.FileHeader)
+}
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	multipartFileType   = reflect.TypeFor[multipartFile]()
+)
+
+// formField is a set top-level property of a form body.
+type formField struct {
+	name  string
+	value reflect.Value
+}
+
+// formFields returns the set properties of a struct, named by their json tags.
+func formFields(body interface{}) ([]formField, error) {
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return nil, errors.New("form data body should be a struct")
+	}
+	t := v.Type()
+	var fields []formField
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		name, omitEmpty, ok := formFieldName(t.Field(i))
+		if !ok || !field.CanInterface() {
+			continue
+		}
+		if omitEmpty && field.IsZero() {
+			continue
+		}
+		if (field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface) && field.IsNil() {
+			continue
+		}
+		fields = append(fields, formField{name: name, value: field})
+	}
+	return fields, nil
+}
+
+// formFieldName returns the property name of a struct field from its json
+// tag. ok is false for fields which are not serialized.
+func formFieldName(f reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	tag := f.Tag.Get("json")
+	if !f.IsExported() || tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name, strings.Contains(opts, "omitempty"), true
+}
+
+// MarshalFormEncoded marshals a struct into url.Values like MarshalForm, but
+// serializes the properties listed in encodings using their declared style,
+// explode and content type.
+func MarshalFormEncoded(body interface{}, encodings map[string]FormEncoding) (url.Values, error) {
+	fields, err := formFields(body)
+	if err != nil {
+		return nil, err
+	}
+	result := make(url.Values)
+	for _, f := range fields {
+		enc, ok := encodings[f.name]
+		if !ok {
+			marshalFormImpl(f.value, result, f.name)
+			continue
+		}
+		if err := encodeFormField(result, f.name, f.value, enc); err != nil {
+			return nil, fmt.Errorf("encoding form field %s: %w", f.name, err)
+		}
+	}
+	return result, nil
+}
+
+func encodeFormField(result url.Values, name string, v reflect.Value, enc FormEncoding) error {
+	if enc.isJSON() {
+		buf, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		result.Add(name, string(buf))
+		return nil
+	}
+
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch {
+	case isFormArray(v.Type()):
+		items := make([]string, v.Len())
+		for i := range items {
+			s, err := formatFormValue(v.Index(i))
+			if err != nil {
+				return err
+			}
+			items[i] = s
+		}
+		if enc.style() == "form" && enc.Explode {
+			result[name] = append(result[name], items...)
+		} else {
+			result.Add(name, strings.Join(items, enc.delimiter()))
+		}
+	case isFormObject(v.Type()):
+		keys, values, err := formObjectProperties(v)
+		if err != nil {
+			return err
+		}
+		switch {
+		case enc.style() == "deepObject":
+			for i, key := range keys {
+				result.Add(name+"["+key+"]", values[i])
+			}
+		case enc.Explode:
+			for i, key := range keys {
+				result.Add(key, values[i])
+			}
+		default:
+			pairs := make([]string, 0, 2*len(keys))
+			for i, key := range keys {
+				pairs = append(pairs, key, values[i])
+			}
+			result.Add(name, strings.Join(pairs, ","))
+		}
+	default:
+		s, err := formatFormValue(v)
+		if err != nil {
+			return err
+		}
+		result.Add(name, s)
+	}
+	return nil
+}
+
+// MarshalMultipart writes a struct as a multipart body of the given media
+// type, such as multipart/form-data or multipart/mixed, with a part per
+// property named by its json tag. Files are sent as file parts, objects as
+// JSON and arrays as one part per item. encodings may override the content
+// type of a part and add headers to it. It returns the body and its
+// Content-Type, which is mediaType with the boundary added. An empty
+// mediaType means multipart/form-data.
+func MarshalMultipart(body interface{}, mediaType string, encodings map[string]FormEncoding) (*bytes.Buffer, string, error) {
+	if mediaType == "" {
+		mediaType = "multipart/form-data"
+	}
+	mediaType, params, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing multipart media type: %w", err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, "", fmt.Errorf("%s is not a multipart media type", mediaType)
+	}
+	fields, err := formFields(body)
+	if err != nil {
+		return nil, "", err
+	}
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	isFormData := mediaType == "multipart/form-data"
+	for _, f := range fields {
+		if err := writeMultipartField(w, isFormData, f.name, f.value, encodings[f.name]); err != nil {
+			return nil, "", fmt.Errorf("writing multipart field %s: %w", f.name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	params["boundary"] = w.Boundary()
+	return &buf, mime.FormatMediaType(mediaType, params), nil
+}
+
+func writeMultipartField(w *multipart.Writer, isFormData bool, name string, v reflect.Value, enc FormEncoding) error {
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return nil
+	}
+
+	if file, ok := v.Interface().(formFile); ok {
+		r, err := file.Reader()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = r.Close() }()
+		filename := file.Filename()
+		if filename == "" {
+			filename = name
+		}
+		contentType := enc.partContentType("application/octet-stream")
+		if typed, ok := file.(typedFormFile); ok && typed.ContentType() != "" {
+			contentType = typed.ContentType()
+		}
+		return writeMultipartPart(w, isFormData, name, filename, contentType, enc.Headers, r)
+	}
+
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return writeMultipartPart(w, isFormData, name, name, enc.partContentType("application/octet-stream"), enc.Headers, bytes.NewReader(v.Bytes()))
+	case isFormArray(v.Type()) && !enc.isJSON():
+		for i := 0; i < v.Len(); i++ {
+			if err := writeMultipartField(w, isFormData, name, v.Index(i), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	case enc.isJSON() || (enc.ContentType == "" && isFormObject(v.Type())):
+		buf, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		return writeMultipartPart(w, isFormData, name, "", enc.partContentType("application/json"), enc.Headers, bytes.NewReader(buf))
+	default:
+		s, err := formatFormValue(v)
+		if err != nil {
+			return err
+		}
+		return writeMultipartPart(w, isFormData, name, "", enc.partContentType(""), enc.Headers, strings.NewReader(s))
+	}
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// writeMultipartPart writes a part named name. Parts of multipart/form-data
+// bodies carry a form-data disposition; parts of other multipart types are
+// inline, or attachments when they carry a file.
+func writeMultipartPart(w *multipart.Writer, isFormData bool, name, filename, contentType string, headers map[string]string, content io.Reader) error {
+	h := make(textproto.MIMEHeader)
+	for k, v := range headers {
+		// The part's Content-Type is described by the encoding's contentType
+		if !strings.EqualFold(k, "Content-Type") {
+			h.Set(k, v)
+		}
+	}
+	dispositionType := "form-data"
+	if !isFormData {
+		dispositionType = "inline"
+		if filename != "" {
+			dispositionType = "attachment"
+		}
+	}
+	disposition := fmt.Sprintf(`%s; name="%s"`, dispositionType, quoteEscaper.Replace(name))
+	if filename != "" {
+		disposition += fmt.Sprintf(`; filename="%s"`, quoteEscaper.Replace(filename))
+	}
+	h.Set("Content-Disposition", disposition)
+	if contentType != "" {
+		h.Set("Content-Type", contentType)
+	}
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, content)
+	return err
+}
+
+// BindFormValues decodes url-encoded form values into dst, which must be a
+// pointer to a struct whose json tags name the form properties. Properties
+// listed in encodings are parsed using their declared style, explode and
+// content type.
+func BindFormValues(values url.Values, dst interface{}, encodings map[string]FormEncoding) error {
+	return bindForm(values, nil, dst, encodings, false)
+}
+
+// BindMultipartForm decodes a parsed multipart form into dst, which must be a
+// pointer to a struct whose json tags name the parts. File parts are bound to
+// file types such as types.File, and object properties are decoded from JSON
+// unless encodings declares another content type.
+func BindMultipartForm(form *multipart.Form, dst interface{}, encodings map[string]FormEncoding) error {
+	if form == nil {
+		return errors.New("multipart form is nil")
+	}
+	return bindForm(form.Value, form.File, dst, encodings, true)
+}
+
+func bindForm(values url.Values, files map[string][]*multipart.FileHeader, dst interface{}, encodings map[string]FormEncoding, isMultipart bool) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("form data destination should be a pointer to a struct")
+	}
+	v = v.Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, ok := formFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		enc, declared := encodings[name]
+		if !declared && !isMultipart {
+			// Properties without an encoding are bound in the format written
+			// by MarshalForm: "name[0]" for arrays and "name[key]" for objects.
+			enc = FormEncoding{Style: "deepObject", Explode: true}
+		}
+		if err := bindFormField(v.Field(i), name, values, files, enc, isMultipart); err != nil {
+			return fmt.Errorf("binding form field %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func bindFormField(field reflect.Value, name string, values url.Values, files map[string][]*multipart.FileHeader, enc FormEncoding, isMultipart bool) error {
+	typ := field.Type()
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	// File parts bound to file types
+	if headers := files[name]; len(headers) > 0 {
+		switch {
+		case reflect.PointerTo(typ).Implements(multipartFileType):
+			allocFormValue(field).Addr().Interface().(multipartFile).InitFromMultipart(headers[0])
+			return nil
+		case typ.Kind() == reflect.Slice && reflect.PointerTo(typ.Elem()).Implements(multipartFileType):
+			target := allocFormValue(field)
+			target.Set(reflect.MakeSlice(typ, len(headers), len(headers)))
+			for i, header := range headers {
+				target.Index(i).Addr().Interface().(multipartFile).InitFromMultipart(header)
+			}
+			return nil
+		}
+	}
+
+	raw, err := rawFormValues(name, values, files)
+	if err != nil {
+		return err
+	}
+
+	if typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+		if len(raw) == 0 {
+			return nil
+		}
+		allocFormValue(field).SetBytes([]byte(raw[0]))
+		return nil
+	}
+
+	if enc.isJSON() || (isMultipart && enc.ContentType == "" && isFormObject(typ)) {
+		if len(raw) == 0 {
+			return nil
+		}
+		return json.Unmarshal([]byte(raw[0]), allocFormValue(field).Addr().Interface())
+	}
+
+	switch {
+	case isFormArray(typ):
+		items := raw
+		switch {
+		case isMultipart:
+		case enc.style() == "deepObject":
+			items = indexedFormValues(name, values)
+		case !(enc.style() == "form" && enc.Explode) && len(raw) > 0:
+			items = strings.Split(raw[0], enc.delimiter())
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		jsonItems := isMultipart && enc.ContentType == "" && isFormObject(typ.Elem())
+		target := allocFormValue(field)
+		target.Set(reflect.MakeSlice(typ, len(items), len(items)))
+		for i, item := range items {
+			var err error
+			if jsonItems {
+				err = json.Unmarshal([]byte(item), target.Index(i).Addr().Interface())
+			} else {
+				err = setFormScalar(target.Index(i), item)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case isFormObject(typ) && !isMultipart:
+		props, err := formObjectValues(name, typ, values, raw, enc)
+		if err != nil || len(props) == 0 {
+			return err
+		}
+		return setFormObject(allocFormValue(field), props)
+	default:
+		if len(raw) == 0 {
+			return nil
+		}
+		return setFormScalar(allocFormValue(field), raw[0])
+	}
+}
+
+// rawFormValues returns the values of a property, reading the content of file
+// parts which are not bound to a file type.
+func rawFormValues(name string, values url.Values, files map[string][]*multipart.FileHeader) ([]string, error) {
+	raw := values[name]
+	for _, header := range files[name] {
+		f, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, string(data))
+	}
+	return raw, nil
+}
+
+// indexedFormValues returns the values of "name[0]", "name[1]", and so on,
+// falling back to repeated "name" values.
+func indexedFormValues(name string, values url.Values) []string {
+	var items []string
+	for i := 0; ; i++ {
+		vals := values[name+"["+strconv.Itoa(i)+"]"]
+		if len(vals) == 0 {
+			break
+		}
+		items = append(items, vals[0])
+	}
+	if len(items) == 0 {
+		return values[name]
+	}
+	return items
+}
+
+// formObjectValues collects the properties of an object serialized with the
+// given style into a map.
+func formObjectValues(name string, typ reflect.Type, values url.Values, raw []string, enc FormEncoding) (map[string]string, error) {
+	props := make(map[string]string)
+	switch {
+	case enc.style() == "deepObject":
+		prefix := name + "["
+		for key, vals := range values {
+			if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, "]") && len(vals) > 0 {
+				props[key[len(prefix):len(key)-1]] = vals[0]
+			}
+		}
+	case enc.Explode:
+		if typ.Kind() != reflect.Struct {
+			return nil, errors.New("exploded form style is only supported for objects with fixed properties")
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			if prop, _, ok := formFieldName(typ.Field(i)); ok {
+				if vals := values[prop]; len(vals) > 0 {
+					props[prop] = vals[0]
+				}
+			}
+		}
+	default:
+		if len(raw) == 0 {
+			return nil, nil
+		}
+		parts := strings.Split(raw[0], ",")
+		if len(parts)%2 != 0 {
+			return nil, fmt.Errorf("invalid object value %q", raw[0])
+		}
+		for i := 0; i < len(parts); i += 2 {
+			props[parts[i]] = parts[i+1]
+		}
+	}
+	return props, nil
+}
+
+func setFormObject(v reflect.Value, props map[string]string) error {
+	if v.Kind() == reflect.Map {
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for key, s := range props {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := setFormScalar(elem, s); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		return nil
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		prop, _, ok := formFieldName(t.Field(i))
+		if !ok {
+			continue
+		}
+		if s, ok := props[prop]; ok {
+			if err := setFormScalar(allocFormValue(v.Field(i)), s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setFormScalar parses s into v according to its kind.
+func setFormScalar(v reflect.Value, s string) error {
+	v = allocFormValue(v)
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		// Types such as dates unmarshal from a JSON string
+		if err := json.Unmarshal([]byte(s), v.Addr().Interface()); err != nil {
+			return json.Unmarshal([]byte(strconv.Quote(s)), v.Addr().Interface())
+		}
+	}
+	return nil
+}
+
+// formatFormValue renders a single value as form text.
+func formatFormValue(v reflect.Value) (string, error) {
+	v = indirectFormValue(v)
+	if !v.IsValid() {
+		return "", nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	if isFormObject(v.Type()) || isFormArray(v.Type()) {
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// formObjectProperties returns the set properties of a struct or map in a
+// deterministic order, with their values rendered as form text.
+func formObjectProperties(v reflect.Value) (keys []string, values []string, err error) {
+	if v.Kind() == reflect.Map {
+		byKey := make(map[string]reflect.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			byKey[key] = iter.Value()
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			s, err := formatFormValue(byKey[key])
+			if err != nil {
+				return nil, nil, err
+			}
+			values = append(values, s)
+		}
+		return keys, values, nil
+	}
+
+	fields, err := formFields(v.Interface())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range fields {
+		s, err := formatFormValue(f.value)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, f.name)
+		values = append(values, s)
+	}
+	return keys, values, nil
+}
+
+func indirectFormValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// allocFormValue follows pointers from v, allocating nil ones.
+func allocFormValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isFormArray(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() != reflect.Uint8
+}
+
+func isFormObject(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(multipartFileType) {
+		return false
+	}
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Map
+}
+
+// nilUUID is the nil UUID, as a uuid-typed parameter left at its zero value
+// is serialized.
+const nilUUID = "00000000-0000-0000-0000-000000000000"
+
+// NewIdempotencyKey returns a random (version 4) UUID for use as the value of
+// an idempotency key header, such as Idempotency-Key. It is generated here
+// rather than with a UUID library, so generated code stays free to map
+// format: uuid to any of them.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = crand.Read(b[:]) // never returns an error
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// IdempotencyKeyUnset reports whether the caller left an idempotency key
+// header unset: it is empty, or holds the nil UUID which a required
+// uuid-typed parameter left at its zero value is serialized as.
+func IdempotencyKeyUnset(value string) bool {
+	return value == "" || value == nilUUID
+}
+
+// JSONMerge merges two JSON-encoded objects. Fields from patch override
+// fields in base. Both arguments must be valid JSON objects (or nil/null).
+func JSONMerge(base, patch json.RawMessage) (json.RawMessage, error) {
+	if len(base) == 0 || string(base) == "null" {
+		return patch, nil
+	}
+	if len(patch) == 0 || string(patch) == "null" {
+		return base, nil
+	}
+
+	var baseMap map[string]json.RawMessage
+	if err := json.Unmarshal(base, &baseMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling base: %w", err)
+	}
+
+	var patchMap map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("JSONMerge: unmarshaling patch: %w", err)
+	}
+
+	for k, v := range patchMap {
+		baseMap[k] = v
+	}
+
+	return json.Marshal(baseMap)
+}
+
+// HasJSONProperties reports whether data is a JSON object holding every one
+// of the named properties. anyOf unions use it to tell the members a value
+// matches apart, since decoding an object into a struct ignores what's missing.
+func HasJSONProperties(data json.RawMessage, names ...string) bool {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return false
+	}
+	for _, name := range names {
+		if _, ok := object[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultJWTLeeway is how far clocks may drift between the issuer and the
+// server before token times are rejected.
+const defaultJWTLeeway = time.Minute
+
+// ErrInvalidJWT is wrapped by the errors of a JWTAuthenticator rejecting a
+// request.
+var ErrInvalidJWT = errors.New("jwt: invalid token")
+
+// JWTHeader is the JOSE header of a JWT.
+type JWTHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// JWTKeyFunc returns the key verifying the signature of a JWT with header: a
+// public key of the RS*, PS*, ES* or EdDSA algorithms, or the []byte secret
+// of the HS* algorithms.
+type JWTKeyFunc func(ctx context.Context, header JWTHeader) (any, error)
+
+// JWTClaims are the claims of a JWT.
+type JWTClaims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+	ID        string
+	// Raw holds every claim of the token, as decoded from JSON.
+	Raw map[string]any
+
+	payload []byte
+}
+
+// Scopes returns the space-separated scopes of the token's scope claim.
+func (c *JWTClaims) Scopes() []string {
+	scope, _ := c.Raw["scope"].(string)
+	return strings.Fields(scope)
+}
+
+// Decode unmarshals the claims into v, typically a struct of the custom
+// claims of an issuer.
+func (c *JWTClaims) Decode(v any) error {
+	return json.Unmarshal(c.payload, v)
+}
+
+// JWTClaimsFromContext returns the claims stored in ctx under key by a
+// JWTAuthenticator's Middleware.
+func JWTClaimsFromContext(ctx context.Context, key any) (*JWTClaims, bool) {
+	claims, ok := ctx.Value(key).(*JWTClaims)
+	return claims, ok
+}
+
+// JWTAuthenticator parses the JWT bearer tokens of incoming requests, and
+// rejects those which expired or aren't valid yet. It verifies their
+// signatures with the keys of KeyFunc. It is safe for concurrent use.
+type JWTAuthenticator struct {
+	// KeyFunc returns the keys verifying token signatures. Nil skips
+	// verification, for tokens verified upstream, e.g. by an API gateway.
+	KeyFunc JWTKeyFunc
+	// Issuer must be the token's issuer; empty skips the check.
+	Issuer string
+	// Audience must be one of the token's audiences; empty skips the check.
+	Audience string
+	// ContextKey is the key Middleware stores claims under.
+	ContextKey any
+	// Clock tells whether tokens have expired. Defaults to SystemClock.
+	Clock Clock
+	// Leeway is the clock drift allowed when checking token times, a minute
+	// by default.
+	Leeway time.Duration
+}
+
+// Authenticate parses the bearer token in the Authorization header of r, and
+// returns its claims. Its errors wrap ErrInvalidJWT.
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*JWTClaims, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, fmt.Errorf("%w: no bearer token", ErrInvalidJWT)
+	}
+	return a.Parse(r.Context(), token)
+}
+
+// Parse parses token, a compact JWT, and returns its claims.
+func (a *JWTAuthenticator) Parse(ctx context.Context, token string) (*JWTClaims, error) {
+	jwt, err := decodeJWT(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+	if a.KeyFunc != nil {
+		key, err := a.KeyFunc(ctx, jwt.header)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key: %w", ErrInvalidJWT, err)
+		}
+		if err := jwt.verify(key); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+		}
+	}
+	claims, err := jwt.claims()
+	if err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidJWT, err)
+	}
+	if a.Issuer != "" && claims.Issuer != a.Issuer {
+		return nil, fmt.Errorf("%w: issued by %q, not %q", ErrInvalidJWT, claims.Issuer, a.Issuer)
+	}
+	if a.Audience != "" && !slices.Contains(claims.Audience, a.Audience) {
+		return nil, fmt.Errorf("%w: not issued for %q", ErrInvalidJWT, a.Audience)
+	}
+	if err := claims.checkTimes(clockOrSystem(a.Clock).Now(), a.Leeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJWT, err)
+	}
+	return claims, nil
+}
+
+// Middleware rejects requests without a valid bearer token with 401
+// Unauthorized, and passes the others to next with the token's claims in
+// their context, under ContextKey.
+func (a *JWTAuthenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := a.Authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), a.ContextKey, claims)))
+	})
+}
+
+// bearerToken returns the bearer token in the Authorization header of r.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+// decodedJWT is a compact JWT split into its parts.
+type decodedJWT struct {
+	header    JWTHeader
+	signed    []byte // The encoded header and payload, as signed
+	payload   []byte
+	signature []byte
+}
+
+func decodeJWT(token string) (*decodedJWT, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("not a JWT")
+	}
+	jwt := &decodedJWT{signed: []byte(parts[0] + "." + parts[1])}
+	if err := decodeJWTPart(parts[0], &jwt.header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	var err error
+	if jwt.payload, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+		return nil, fmt.Errorf("claims: %w", err)
+	}
+	if jwt.signature, err = base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return nil, fmt.Errorf("signature: %w", err)
+	}
+	return jwt, nil
+}
+
+func (t *decodedJWT) verify(key any) error {
+	return verifyJWTSignature(t.header.Alg, key, t.signed, t.signature)
+}
+
+func (t *decodedJWT) claims() (*JWTClaims, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(t.payload, &raw); err != nil {
+		return nil, err
+	}
+	claims := &JWTClaims{Raw: raw, payload: t.payload}
+	claims.Issuer, _ = raw["iss"].(string)
+	claims.Subject, _ = raw["sub"].(string)
+	claims.ID, _ = raw["jti"].(string)
+	switch aud := raw["aud"].(type) {
+	case string:
+		claims.Audience = []string{aud}
+	case []any:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				claims.Audience = append(claims.Audience, s)
+			}
+		}
+	}
+	claims.ExpiresAt = jwtTime(raw["exp"])
+	claims.NotBefore = jwtTime(raw["nbf"])
+	claims.IssuedAt = jwtTime(raw["iat"])
+	return claims, nil
+}
+
+// checkTimes rejects claims which expired or aren't valid yet at now, give or
+// take leeway, a minute when not positive. Tokens without an expiry never
+// expire.
+func (c *JWTClaims) checkTimes(now time.Time, leeway time.Duration) error {
+	if leeway <= 0 {
+		leeway = defaultJWTLeeway
+	}
+	if !c.ExpiresAt.IsZero() && !now.Add(-leeway).Before(c.ExpiresAt) {
+		return errors.New("expired")
+	}
+	if !c.NotBefore.IsZero() && now.Add(leeway).Before(c.NotBefore) {
+		return errors.New("not valid yet")
+	}
+	return nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jwtTime converts a NumericDate claim, in seconds since the epoch, to a
+// time; anything else is the zero time.
+func jwtTime(v any) time.Time {
+	seconds, ok := v.(float64)
+	if !ok {
+		return time.Time{}
+	}
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))).UTC()
+}
+
+// jwtHashes are the hashes of the JWS algorithms verifyJWTSignature accepts.
+var jwtHashes = map[string]crypto.Hash{
+	"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// verifyJWTSignature checks the signature of a JWT signed with alg, which
+// must suit key: HS*, RS*, PS* and ES* of SHA-256, -384 and -512, or EdDSA.
+// The "none" algorithm is never accepted.
+func verifyJWTSignature(alg string, key any, signed, signature []byte) error {
+	if alg == "EdDSA" {
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok || !ed25519.Verify(edKey, signed, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	hashFunc, ok := jwtHashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	digest := jwtDigest(hashFunc, signed)
+
+	switch alg[:2] {
+	case "HS":
+		secret, ok := key.([]byte)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		mac := hmac.New(jwtHashFunc(hashFunc), secret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "RS", "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		if alg[0] == 'R' {
+			err := rsa.VerifyPKCS1v15(rsaKey, hashFunc, digest, signature)
+			if err != nil {
+				return errors.New("invalid signature")
+			}
+			return nil
+		}
+		if err := rsa.VerifyPSS(rsaKey, hashFunc, digest, signature, nil); err != nil {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %s doesn't suit the key", alg)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid signature")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+// jwtDigest returns the hashFunc digest of data.
+func jwtDigest(hashFunc crypto.Hash, data []byte) []byte {
+	switch hashFunc {
+	case crypto.SHA384:
+		digest := sha512.Sum384(data)
+		return digest[:]
+	case crypto.SHA512:
+		digest := sha512.Sum512(data)
+		return digest[:]
+	default:
+		digest := sha256.Sum256(data)
+		return digest[:]
+	}
+}
+
+// jwtHashFunc returns the constructor of hashFunc, for HMACs.
+func jwtHashFunc(hashFunc crypto.Hash) func() hash.Hash {
+	switch hashFunc {
+	case crypto.SHA384:
+		return sha512.New384
+	case crypto.SHA512:
+		return sha512.New
+	default:
+		return sha256.New
+	}
+}
+
+// Default intervals of an OperationPoller.
+const (
+	DefaultPollInterval    = time.Second
+	DefaultMaxPollInterval = 30 * time.Second
+)
+
+// OperationLocation returns the URL of the status resource of a long-running
+// operation, from the Operation-Location header of resp, or its Location
+// header, resolved against the URL of the request.
+func OperationLocation(resp *http.Response) (*url.URL, error) {
+	location := resp.Header.Get("Operation-Location")
+	if location == "" {
+		location = resp.Header.Get("Location")
+	}
+	if location == "" {
+		return nil, errors.New("response has no Operation-Location or Location header")
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing operation location: %w", err)
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		u = resp.Request.URL.ResolveReference(u)
+	}
+	if !u.IsAbs() {
+		return nil, fmt.Errorf("operation location %q is relative", location)
+	}
+	return u, nil
+}
+
+// OperationPoller polls the status resource of a long-running operation
+// until its state is terminal. Polls are spaced with exponential backoff,
+// starting at Interval and doubling up to MaxInterval, unless the status
+// resource asks for a delay with a Retry-After header.
+type OperationPoller struct {
+	// Clock waits between polls. Defaults to SystemClock.
+	Clock Clock
+	// Interval is the delay before the first poll. Defaults to
+	// DefaultPollInterval.
+	Interval time.Duration
+	// MaxInterval caps the delay between polls. Defaults to
+	// DefaultMaxPollInterval.
+	MaxInterval time.Duration
+	// StatusField is the JSON property of the status resource holding its
+	// state.
+	StatusField string
+	// TerminalStates end polling. They are compared case-insensitively.
+	TerminalStates []string
+}
+
+// Poll calls fetch until the status resource it returns is in a terminal
+// state, decoding the last one into v. It fails if ctx is done, or if fetch
+// fails or returns an unsuccessful status.
+func (p *OperationPoller) Poll(ctx context.Context, fetch func(ctx context.Context) (*http.Response, error), v any) error {
+	clock := clockOrSystem(p.Clock)
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxPollInterval
+	}
+
+	delay := interval
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(delay):
+		}
+
+		resp, err := fetch(ctx)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("polling operation status: HTTP %d", resp.StatusCode)
+		}
+		done, err := p.terminal(body)
+		if err != nil {
+			return err
+		}
+		if done {
+			return json.Unmarshal(body, v)
+		}
+
+		delay = min(delay*2, maxInterval)
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), clock.Now()); ok {
+			delay = retryAfter
+		}
+	}
+}
+
+// terminal reports whether the state of the status resource body is
+// terminal.
+func (p *OperationPoller) terminal(body []byte) (bool, error) {
+	var resource map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return false, fmt.Errorf("decoding operation status: %w", err)
+	}
+	var state string
+	if raw, ok := resource[p.StatusField]; ok {
+		if err := json.Unmarshal(raw, &state); err != nil {
+			return false, fmt.Errorf("decoding operation status %s: %w", p.StatusField, err)
+		}
+	}
+	for _, terminal := range p.TerminalStates {
+		if strings.EqualFold(state, terminal) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseRetryAfter parses a Retry-After header, either a number of seconds or
+// an HTTP date, into a delay from now.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// MarshalForm marshals a struct into url.Values using the struct's json tags
+// as field names. It handles nested structs, slices, pointers, and
+// AdditionalProperties maps.
+func MarshalForm(body interface{}) (url.Values, error) {
+	ptrVal := reflect.Indirect(reflect.ValueOf(body))
+	if ptrVal.Kind() != reflect.Struct {
+		return nil, errors.New("form data body should be a struct")
+	}
+	tValue := ptrVal.Type()
+	result := make(url.Values)
+	for i := 0; i < tValue.NumField(); i++ {
+		field := ptrVal.Field(i)
+		tag := tValue.Field(i).Tag.Get("json")
+		if !field.CanInterface() || tag == "-" {
+			continue
+		}
+		omitEmpty := strings.HasSuffix(tag, ",omitempty")
+		if omitEmpty && field.IsZero() {
+			continue
+		}
+		tag = strings.Split(tag, ",")[0]
+		marshalFormImpl(field, result, tag)
+	}
+	return result, nil
+}
+
+func marshalFormImpl(v reflect.Value, result url.Values, name string) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			marshalFormImpl(v.Elem(), result, name)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			marshalFormImpl(v.Index(i), result, fmt.Sprintf("%s[%v]", name, i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			tag := field.Tag.Get("json")
+			if field.Name == "AdditionalProperties" && tag == "-" {
+				mapField := v.Field(i)
+				if mapField.Kind() == reflect.Map {
+					iter := mapField.MapRange()
+					for iter.Next() {
+						marshalFormImpl(iter.Value(), result, fmt.Sprintf("%s[%s]", name, iter.Key().String()))
+					}
+				}
+				continue
+			}
+			if !v.Field(i).CanInterface() || tag == "-" {
+				continue
+			}
+			tag = strings.Split(tag, ",")[0]
+			marshalFormImpl(v.Field(i), result, fmt.Sprintf("%s[%s]", name, tag))
+		}
+	default:
+		result[name] = append(result[name], fmt.Sprint(v.Interface()))
+	}
+}
+
+// oidcDiscoveryPath is the path of the discovery document under an issuer.
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+// defaultOIDCKeysMaxAge is how long an OIDCProvider keeps the keys it
+// fetched, and defaultOIDCKeysMinRefresh how long it waits between fetches
+// for keys it doesn't know.
+const (
+	defaultOIDCKeysMaxAge     = time.Hour
+	defaultOIDCKeysMinRefresh = time.Minute
+)
+
+// defaultOIDCTokenExpirySkew is how long before its expiry a token is
+// replaced, so that it doesn't expire in flight.
+const defaultOIDCTokenExpirySkew = 30 * time.Second
+
+// ErrOIDCUnknownKey is returned when a token is signed with a key which isn't
+// in the provider's key set, even once refetched.
+var ErrOIDCUnknownKey = errors.New("oidc: unknown signing key")
+
+// OIDCConfiguration is the discovery document of an OpenID Connect
+// provider, the fields of which the runtime uses.
+type OIDCConfiguration struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI               string   `json:"jwks_uri"`
+	ScopesSupported       []string `json:"scopes_supported,omitempty"`
+	GrantTypesSupported   []string `json:"grant_types_supported,omitempty"`
+	SigningAlgsSupported  []string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
+
+// OIDCProvider is an OpenID Connect provider, found through the discovery
+// document at the openIdConnectUrl of a security scheme. It fetches the
+// document once, and caches the provider's signing keys, refetching them
+// when they grow old or a token is signed with a key it doesn't know. It is
+// safe for concurrent use, and is meant to be shared by the clients and
+// authenticators of a provider.
+type OIDCProvider struct {
+	// URL is the discovery document's URL, ending in
+	// /.well-known/openid-configuration.
+	URL string
+	// Doer fetches the discovery document, keys and tokens. Defaults to
+	// http.DefaultClient.
+	Doer HTTPDoer
+	// Clock times the key cache. Defaults to SystemClock.
+	Clock Clock
+	// KeysMaxAge is how long fetched keys are used before being refetched,
+	// an hour by default. KeysMinRefresh is the least time between fetches
+	// for unknown keys, a minute by default, so that tokens signed with
+	// made-up keys can't make the provider flood the key endpoint.
+	KeysMaxAge     time.Duration
+	KeysMinRefresh time.Duration
+
+	mu        sync.Mutex
+	config    *OIDCConfiguration
+	keys      map[string]crypto.PublicKey // Keyed by key ID
+	fetchedAt time.Time
+}
+
+// NewOIDCProvider returns an OIDCProvider discovered at url.
+func NewOIDCProvider(url string) *OIDCProvider {
+	return &OIDCProvider{URL: url}
+}
+
+func (p *OIDCProvider) doer() HTTPDoer {
+	if p.Doer == nil {
+		return http.DefaultClient
+	}
+	return p.Doer
+}
+
+// Configuration returns the provider's discovery document, fetching it on
+// first use. The issuer it declares must be the one it was discovered at.
+func (p *OIDCProvider) Configuration(ctx context.Context) (*OIDCConfiguration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.configuration(ctx)
+}
+
+func (p *OIDCProvider) configuration(ctx context.Context) (*OIDCConfiguration, error) {
+	if p.config != nil {
+		return p.config, nil
+	}
+	var config OIDCConfiguration
+	if err := p.getJSON(ctx, p.URL, &config); err != nil {
+		return nil, fmt.Errorf("oidc: discovering provider: %w", err)
+	}
+	if issuer := strings.TrimSuffix(p.URL, oidcDiscoveryPath); strings.HasSuffix(p.URL, oidcDiscoveryPath) && strings.TrimSuffix(config.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return nil, fmt.Errorf("oidc: provider at %s declares issuer %q", p.URL, config.Issuer)
+	}
+	if config.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc: provider at %s declares no jwks_uri", p.URL)
+	}
+	p.config = &config
+	return p.config, nil
+}
+
+// Key returns the public key with id kid, fetching the provider's key set
+// when it has grown old or doesn't hold kid. An empty kid matches the only
+// key of a set of one.
+func (p *OIDCProvider) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := clockOrSystem(p.Clock).Now()
+	maxAge, minRefresh := p.KeysMaxAge, p.KeysMinRefresh
+	if maxAge <= 0 {
+		maxAge = defaultOIDCKeysMaxAge
+	}
+	if minRefresh <= 0 {
+		minRefresh = defaultOIDCKeysMinRefresh
+	}
+
+	if p.keys == nil || now.Sub(p.fetchedAt) >= maxAge {
+		if err := p.fetchKeys(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	// The provider may have rotated its keys since they were fetched.
+	if now.Sub(p.fetchedAt) >= minRefresh {
+		if err := p.fetchKeys(ctx, now); err != nil {
+			return nil, err
+		}
+		if key, ok := p.lookupKey(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrOIDCUnknownKey, kid)
+}
+
+func (p *OIDCProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// fetchKeys replaces the cached keys with the provider's key set. Keys of
+// unsupported types, or meant for encryption, are skipped.
+func (p *OIDCProvider) fetchKeys(ctx context.Context, now time.Time) error {
+	config, err := p.configuration(ctx)
+	if err != nil {
+		return err
+	}
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := p.getJSON(ctx, config.JWKSURI, &set); err != nil {
+		return fmt.Errorf("oidc: fetching keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, raw := range set.Keys {
+		var jwk oidcJWK
+		if err := json.Unmarshal(raw, &jwk); err != nil || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	p.keys, p.fetchedAt = keys, now
+	return nil
+}
+
+func (p *OIDCProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.doer().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// oidcJWK is a JSON Web Key, as served in a provider's key set.
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey decodes an RSA, EC or Ed25519 public key.
+func (k oidcJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("EC point not on curve %s", k.Crv)
+		}
+		return key, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || k.Crv != "Ed25519" || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported OKP key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// OIDCToken is an access token issued by an OpenID Connect provider.
+type OIDCToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	// ExpiresIn is the lifetime of the token in seconds, as returned by the
+	// token endpoint, and Expiry when it expires; zero never expires.
+	ExpiresIn int       `json:"expires_in,omitempty"`
+	Expiry    time.Time `json:"-"`
+}
+
+// OIDCFlow obtains access tokens from a provider, such as with the client
+// credentials grant of ClientCredentialsFlow. Interactive flows, such as the
+// authorization code grant, can be plugged in by implementing it.
+type OIDCFlow interface {
+	Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error)
+}
+
+// OIDCFlowFunc adapts a function to an OIDCFlow.
+type OIDCFlowFunc func(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error)
+
+func (f OIDCFlowFunc) Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error) {
+	return f(ctx, provider)
+}
+
+// ClientCredentialsFlow obtains tokens with the OAuth 2.0 client credentials
+// grant, authenticating to the provider's token endpoint with HTTP Basic
+// authentication.
+type ClientCredentialsFlow struct {
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// Audience, when set, is sent as the audience parameter, which some
+	// providers require to issue tokens for an API.
+	Audience string
+}
+
+func (f ClientCredentialsFlow) Token(ctx context.Context, provider *OIDCProvider) (*OIDCToken, error) {
+	config, err := provider.Configuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if config.TokenEndpoint == "" {
+		return nil, fmt.Errorf("oidc: provider at %s declares no token_endpoint", provider.URL)
+	}
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(f.Scopes) > 0 {
+		form.Set("scope", strings.Join(f.Scopes, " "))
+	}
+	if f.Audience != "" {
+		form.Set("audience", f.Audience)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(f.ClientID), url.QueryEscape(f.ClientSecret))
+	return RequestOIDCToken(provider, req)
+}
+
+// RequestOIDCToken sends req, a request to the provider's token endpoint, and
+// decodes the token in its response, timing its expiry with the provider's
+// clock. It lets OIDCFlow implementations share the handling of responses.
+func RequestOIDCToken(provider *OIDCProvider, req *http.Request) (*OIDCToken, error) {
+	resp, err := provider.doer().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: requesting token: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oidc: requesting token: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &oauthErr) == nil && oauthErr.Error != "" {
+			return nil, fmt.Errorf("oidc: requesting token: %s: %s %s", resp.Status, oauthErr.Error, oauthErr.ErrorDescription)
+		}
+		return nil, fmt.Errorf("oidc: requesting token: %s", resp.Status)
+	}
+	var token OIDCToken
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("oidc: decoding token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("oidc: token response has no access_token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = clockOrSystem(provider.Clock).Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// OIDCTokenSource caches the tokens a flow obtains from a provider, and
+// authenticates requests with them. It is safe for concurrent use.
+type OIDCTokenSource struct {
+	Provider *OIDCProvider
+	Flow     OIDCFlow
+	// Clock tells when tokens expire. Defaults to the clock passed to
+	// WrapWithClock, or SystemClock.
+	Clock Clock
+	// ExpirySkew is how long before their expiry tokens are replaced, 30
+	// seconds by default.
+	ExpirySkew time.Duration
+
+	mu    sync.Mutex
+	token *OIDCToken
+}
+
+// NewOIDCTokenSource returns an OIDCTokenSource obtaining tokens from
+// provider with flow.
+func NewOIDCTokenSource(provider *OIDCProvider, flow OIDCFlow) *OIDCTokenSource {
+	return &OIDCTokenSource{Provider: provider, Flow: flow}
+}
+
+// Token returns the cached token, obtaining a new one when there is none or
+// it is about to expire.
+func (s *OIDCTokenSource) Token(ctx context.Context) (*OIDCToken, error) {
+	return s.tokenAt(ctx, clockOrSystem(s.Clock))
+}
+
+func (s *OIDCTokenSource) tokenAt(ctx context.Context, clock Clock) (*OIDCToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	skew := s.ExpirySkew
+	if skew <= 0 {
+		skew = defaultOIDCTokenExpirySkew
+	}
+	if s.token != nil && (s.token.Expiry.IsZero() || clock.Now().Add(skew).Before(s.token.Expiry)) {
+		return s.token, nil
+	}
+	token, err := s.Flow.Token(ctx, s.Provider)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// Invalidate drops the cached token, if it is still token, so that the next
+// request obtains a new one.
+func (s *OIDCTokenSource) Invalidate(token *OIDCToken) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == token {
+		s.token = nil
+	}
+}
+
+// Wrap returns a doer which sends requests through doer with the source's
+// token as a bearer token. A request rejected with 401 Unauthorized is sent
+// once more with a new token, if its body can be replayed.
+func (s *OIDCTokenSource) Wrap(doer HTTPDoer) HTTPDoer {
+	return s.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, telling when tokens expire with clock unless s has
+// a Clock of its own.
+func (s *OIDCTokenSource) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	if s.Clock != nil {
+		clock = s.Clock
+	}
+	return &oidcDoer{source: s, doer: doer, clock: clockOrSystem(clock)}
+}
+
+type oidcDoer struct {
+	source *OIDCTokenSource
+	doer   HTTPDoer
+	clock  Clock
+}
+
+func (d *oidcDoer) Do(req *http.Request) (*http.Response, error) {
+	token, err := d.source.tokenAt(req.Context(), d.clock)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(withBearerToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	// The token may have been revoked: retry once with a new one.
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	d.source.Invalidate(token)
+	token, err = d.source.tokenAt(req.Context(), d.clock)
+	if err != nil {
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	return d.doer.Do(withBearerToken(retry, token))
+}
+
+// withBearerToken returns a copy of req authenticated with token.
+func withBearerToken(req *http.Request, token *OIDCToken) *http.Request {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return req
+}
+
+// ErrOIDCInvalidToken is wrapped by the errors of an OIDCAuthenticator
+// rejecting a request.
+var ErrOIDCInvalidToken = errors.New("oidc: invalid token")
+
+// OIDCClaims are the claims of a verified token.
+type OIDCClaims = JWTClaims
+
+type oidcClaimsContextKey struct{}
+
+// ContextWithOIDCClaims returns a copy of ctx holding claims.
+func ContextWithOIDCClaims(ctx context.Context, claims *OIDCClaims) context.Context {
+	return context.WithValue(ctx, oidcClaimsContextKey{}, claims)
+}
+
+// OIDCClaimsFromContext returns the claims stored in ctx by an
+// OIDCAuthenticator's Middleware.
+func OIDCClaimsFromContext(ctx context.Context) (*OIDCClaims, bool) {
+	claims, ok := ctx.Value(oidcClaimsContextKey{}).(*OIDCClaims)
+	return claims, ok
+}
+
+// OIDCAuthenticator validates the bearer tokens of incoming requests: JWTs
+// signed with a key of the provider's key set, issued by the provider, for
+// the audience, and neither expired nor not yet valid. It is safe for
+// concurrent use.
+type OIDCAuthenticator struct {
+	Provider *OIDCProvider
+	// Audience must be one of the token's audiences; empty skips the check.
+	Audience string
+	// Clock tells whether tokens have expired. Defaults to the provider's
+	// clock, or SystemClock.
+	Clock Clock
+	// Leeway is the clock drift allowed when checking token times, a minute
+	// by default.
+	Leeway time.Duration
+}
+
+// NewOIDCAuthenticator returns an OIDCAuthenticator accepting tokens issued
+// by provider for audience.
+func NewOIDCAuthenticator(provider *OIDCProvider, audience string) *OIDCAuthenticator {
+	return &OIDCAuthenticator{Provider: provider, Audience: audience}
+}
+
+// Authenticate verifies the bearer token in the Authorization header of r,
+// and returns its claims. Its errors wrap ErrOIDCInvalidToken, unless the
+// provider couldn't be reached.
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) (*OIDCClaims, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, fmt.Errorf("%w: no bearer token", ErrOIDCInvalidToken)
+	}
+	return a.Verify(r.Context(), token)
+}
+
+// Verify verifies token, a compact JWT, and returns its claims.
+func (a *OIDCAuthenticator) Verify(ctx context.Context, token string) (*OIDCClaims, error) {
+	jwt, err := decodeJWT(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	key, err := a.Provider.Key(ctx, jwt.header.Kid)
+	if errors.Is(err, ErrOIDCUnknownKey) {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	} else if err != nil {
+		return nil, err
+	}
+	if err := jwt.verify(key); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	claims, err := jwt.claims()
+	if err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrOIDCInvalidToken, err)
+	}
+
+	config, err := a.Provider.Configuration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Issuer != config.Issuer {
+		return nil, fmt.Errorf("%w: issued by %q, not %q", ErrOIDCInvalidToken, claims.Issuer, config.Issuer)
+	}
+	if a.Audience != "" && !slices.Contains(claims.Audience, a.Audience) {
+		return nil, fmt.Errorf("%w: not issued for %q", ErrOIDCInvalidToken, a.Audience)
+	}
+	clock := a.Clock
+	if clock == nil {
+		clock = clockOrSystem(a.Provider.Clock)
+	}
+	if claims.ExpiresAt.IsZero() {
+		return nil, fmt.Errorf("%w: expired", ErrOIDCInvalidToken)
+	}
+	if err := claims.checkTimes(clock.Now(), a.Leeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOIDCInvalidToken, err)
+	}
+	return claims, nil
+}
+
+// Middleware rejects requests without a valid bearer token with 401
+// Unauthorized, and passes the others to next with the token's claims in
+// their context. Requests are rejected with 503 Service Unavailable when the
+// provider can't be reached.
+func (a *OIDCAuthenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := a.Authenticate(r)
+		switch {
+		case errors.Is(err, ErrOIDCInvalidToken):
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		default:
+			next.ServeHTTP(w, r.WithContext(ContextWithOIDCClaims(r.Context(), claims)))
+		}
+	})
+}
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx carrying the operationId of
+// the operation a request is made for. Generated clients set it on every
+// request, so that transports can apply per-operation behavior.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// OperationIDFromContext returns the operationId set by ContextWithOperationID.
+func OperationIDFromContext(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDKey{}).(string)
+	return operationID, ok
+}
+
+// Priority orders requests waiting in a PriorityQueue. The zero value is
+// PriorityNormal.
+type Priority int
+
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+type priorityKey struct{}
+
+// ContextWithPriority returns a copy of ctx carrying the priority of the
+// request made with it, overriding the priority of its operation.
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityQueue bounds the number of requests in flight, and hands the slots
+// freed under contention to the waiting requests of highest priority, in the
+// order they arrived. A request holds its slot until its response body is
+// closed. Low priority requests wait for as long as higher priority ones keep
+// arriving. It is safe for concurrent use.
+type PriorityQueue struct {
+	// OperationPriorities sets the priority of requests per operationId.
+	// Requests of other operations are PriorityNormal, unless their context
+	// carries a priority.
+	OperationPriorities map[string]Priority
+
+	mu      sync.Mutex
+	limit   int
+	active  int
+	waiting [3][]chan struct{} // Indexed by priority, highest first
+}
+
+// NewPriorityQueue returns a PriorityQueue letting maxConcurrent requests be
+// in flight at once.
+func NewPriorityQueue(maxConcurrent int, operationPriorities map[string]Priority) *PriorityQueue {
+	return &PriorityQueue{
+		OperationPriorities: operationPriorities,
+		limit:               max(maxConcurrent, 1),
+	}
+}
+
+// priorityIndex returns the index of the waiting list of priority.
+func priorityIndex(priority Priority) int {
+	return int(PriorityHigh - min(max(priority, PriorityLow), PriorityHigh))
+}
+
+// Acquire waits for a slot, and fails if ctx is done first. Each successful
+// call must be followed by a call to Release.
+func (q *PriorityQueue) Acquire(ctx context.Context, priority Priority) error {
+	q.mu.Lock()
+	if q.active < q.limit {
+		q.active++
+		q.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	i := priorityIndex(priority)
+	q.waiting[i] = append(q.waiting[i], ready)
+	q.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	select {
+	case <-ready:
+		// The slot was handed over as ctx was done: pass it on.
+		q.mu.Unlock()
+		q.Release()
+		return ctx.Err()
+	default:
+	}
+	for j, w := range q.waiting[i] {
+		if w == ready {
+			q.waiting[i] = append(q.waiting[i][:j], q.waiting[i][j+1:]...)
+			break
+		}
+	}
+	q.mu.Unlock()
+	return ctx.Err()
+}
+
+// Release frees a slot taken by Acquire, handing it to the waiting request
+// of highest priority, if any.
+func (q *PriorityQueue) Release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, waiting := range q.waiting {
+		if len(waiting) > 0 {
+			close(waiting[0])
+			q.waiting[i] = waiting[1:]
+			return
+		}
+	}
+	q.active--
+}
+
+// Waiting returns the number of requests waiting for a slot.
+func (q *PriorityQueue) Waiting() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, waiting := range q.waiting {
+		n += len(waiting)
+	}
+	return n
+}
+
+// priority returns the priority of the request made with ctx.
+func (q *PriorityQueue) priority(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	if operationID, ok := OperationIDFromContext(ctx); ok {
+		return q.OperationPriorities[operationID]
+	}
+	return PriorityNormal
+}
+
+// Wrap returns an HTTPDoer which sends requests through doer once the queue
+// has a slot for them.
+func (q *PriorityQueue) Wrap(doer HTTPDoer) HTTPDoer {
+	return &queuedDoer{queue: q, doer: doer}
+}
+
+type queuedDoer struct {
+	queue *PriorityQueue
+	doer  HTTPDoer
+}
+
+func (d *queuedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.queue.Acquire(req.Context(), d.queue.priority(req.Context())); err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(req)
+	if err != nil {
+		d.queue.Release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: sync.OnceFunc(d.queue.Release)}
+	return resp, nil
+}
+
+// releasingBody releases a queue slot when it is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// RateLimitHeaders names the response headers a server reports its rate
+// limits in.
+type RateLimitHeaders struct {
+	// Remaining holds the number of requests left in the current window.
+	Remaining string
+	// Reset holds when the window resets, either as a number of seconds from
+	// now or as a Unix timestamp.
+	Reset string
+}
+
+// DefaultRateLimitHeaders are the most common rate limit headers.
+var DefaultRateLimitHeaders = RateLimitHeaders{
+	Remaining: "X-RateLimit-Remaining",
+	Reset:     "X-RateLimit-Reset",
+}
+
+// RateLimitScope sets which requests share a rate limit.
+type RateLimitScope int
+
+const (
+	// RateLimitPerClient holds back every request once any is limited.
+	RateLimitPerClient RateLimitScope = iota
+	// RateLimitPerOperation only holds back requests of the operation which
+	// was limited.
+	RateLimitPerOperation
 )
 
-// JSONMerge merges two JSON-encoded objects. Fields from patch override
-// fields in base. Both arguments must be valid JSON objects (or nil/null).
-func JSONMerge(base, patch json.RawMessage) (json.RawMessage, error) {
-	if len(base) == 0 || string(base) == "null" {
-		return patch, nil
+// defaultRateLimitDelay is how long requests are held back after a 429
+// response which doesn't say when to retry.
+const defaultRateLimitDelay = time.Second
+
+// unixTimestampThreshold tells Unix timestamps from numbers of seconds in
+// reset headers: no window lasts over 30 years.
+const unixTimestampThreshold = 1_000_000_000
+
+// RateLimiter delays requests to stay within the rate limits reported by the
+// server. Once a response says no requests are left in the current window,
+// or is a 429 Too Many Requests, later requests wait for the window to reset,
+// or for the delay of a Retry-After header. The limited response itself is
+// returned as is. It is safe for concurrent use, and can be shared by the
+// clients of an API.
+type RateLimiter struct {
+	Headers RateLimitHeaders
+	Scope   RateLimitScope
+	// Clock times the delays. Defaults to the clock passed to WrapWithClock,
+	// or SystemClock.
+	Clock Clock
+
+	mu    sync.Mutex
+	until map[string]time.Time // Keyed by operationId, or "" per client
+}
+
+// NewRateLimiter returns a RateLimiter reading headers, whose limits apply to
+// scope.
+func NewRateLimiter(headers RateLimitHeaders, scope RateLimitScope) *RateLimiter {
+	return &RateLimiter{Headers: headers, Scope: scope}
+}
+
+// key returns the key of the limit applying to the request made with ctx.
+func (l *RateLimiter) key(ctx context.Context) string {
+	if l.Scope != RateLimitPerOperation {
+		return ""
+	}
+	operationID, _ := OperationIDFromContext(ctx)
+	return operationID
+}
+
+// Until returns when requests of operationID can be sent again, which is in
+// the past unless they are held back.
+func (l *RateLimiter) Until(operationID string) time.Time {
+	if l.Scope != RateLimitPerOperation {
+		operationID = ""
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.until[operationID]
+}
+
+// Wait waits until the request made with ctx may be sent, timing the delay
+// with clock, or SystemClock if nil. It fails if ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context, clock Clock) error {
+	clock = clockOrSystem(clock)
+	delay := l.Until(l.key(ctx)).Sub(clock.Now())
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Observe reads the rate limit reported by resp, a response to the request
+// made with ctx, holding back later requests if it is exhausted.
+func (l *RateLimiter) Observe(ctx context.Context, resp *http.Response, now time.Time) {
+	var delay time.Duration
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		var ok bool
+		if delay, ok = parseRetryAfter(resp.Header.Get("Retry-After"), now); !ok {
+			if delay, ok = parseRateLimitReset(resp.Header.Get(l.Headers.Reset), now); !ok {
+				delay = defaultRateLimitDelay
+			}
+		}
+	case l.Headers.Remaining != "" && strings.TrimSpace(resp.Header.Get(l.Headers.Remaining)) == "0":
+		var ok bool
+		if delay, ok = parseRateLimitReset(resp.Header.Get(l.Headers.Reset), now); !ok {
+			return
+		}
+	default:
+		return
+	}
+
+	key := l.key(ctx)
+	until := now.Add(delay)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.until[key]) {
+		if l.until == nil {
+			l.until = make(map[string]time.Time)
+		}
+		l.until[key] = until
+	}
+}
+
+// parseRateLimitReset parses a reset header, either a number of seconds or a
+// Unix timestamp, into a delay from now.
+func parseRateLimitReset(header string, now time.Time) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	if n >= unixTimestampThreshold {
+		return max(time.Unix(n, 0).Sub(now), 0), true
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+// Wrap returns a doer which sends requests through doer once the rate limit
+// allows it.
+func (l *RateLimiter) Wrap(doer HTTPDoer) HTTPDoer {
+	return l.WrapWithClock(doer, nil)
+}
+
+// WrapWithClock is Wrap, timing delays with clock unless l has a Clock of its
+// own. It lets a limiter shared by several clients follow each client's
+// clock.
+func (l *RateLimiter) WrapWithClock(doer HTTPDoer, clock Clock) HTTPDoer {
+	if l.Clock != nil {
+		clock = l.Clock
+	}
+	return &rateLimitedDoer{limiter: l, doer: doer, clock: clockOrSystem(clock)}
+}
+
+type rateLimitedDoer struct {
+	limiter *RateLimiter
+	doer    HTTPDoer
+	clock   Clock
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := d.limiter.Wait(req.Context(), d.clock); err != nil {
+		return nil, err
+	}
+	resp, err := d.doer.Do(req)
+	if err == nil {
+		d.limiter.Observe(req.Context(), resp, d.clock.Now())
+	}
+	return resp, err
+}
+
+// RequestLogger logs the requests handled by a generated server with
+// log/slog, sampled per operationId. Requests are logged with the route
+// template of their operation, such as /pets/{id}, rather than their path, so
+// that logs can be aggregated by route without a distinct value per resource.
+type RequestLogger struct {
+	// Logger receives the records. Defaults to slog.Default().
+	Logger *slog.Logger
+	// Level is the level requests are logged at, except for those failing
+	// with a 5xx status, which are logged at slog.LevelError. Defaults to
+	// slog.LevelInfo.
+	Level slog.Level
+	// SampleRates holds the rate of requests logged for each operationId,
+	// between 0 and 1. Operations which aren't listed are always logged.
+	// Requests which didn't match an operation are listed under "".
+	SampleRates map[string]float64
+	// Rand returns random numbers in [0, 1). Defaults to math/rand/v2.Float64;
+	// set it to make sampling deterministic.
+	Rand func() float64
+	// Clock times requests. Defaults to SystemClock.
+	Clock Clock
+}
+
+type requestLogKey struct{}
+
+// requestLogRoute is filled in with the operation which handled a request.
+type requestLogRoute struct {
+	operationID string
+	route       string
+}
+
+// StartRequest prepares to log a request, returning the context to handle it
+// with and a function to call with its status once it has been handled.
+// Generated middleware calls it; it is only needed for other frameworks.
+func (l *RequestLogger) StartRequest(ctx context.Context, method string) (context.Context, func(status int)) {
+	clock := clockOrSystem(l.Clock)
+	start := clock.Now()
+	route := &requestLogRoute{}
+	return context.WithValue(ctx, requestLogKey{}, route), func(status int) {
+		l.log(ctx, method, route, status, clock.Now().Sub(start))
+	}
+}
+
+// Middleware logs the requests handled by next, for servers built on
+// net/http. Install it around the handler of a generated server, so that it
+// sees every request.
+func (l *RequestLogger) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, done := l.StartRequest(r.Context(), r.Method)
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+		done(rec.statusCode())
+	})
+}
+
+// SetRequestLogRoute records the operation handling the request of ctx, and
+// its route template, for a RequestLogger. Generated servers call it; it does
+// nothing unless the request is being logged.
+func SetRequestLogRoute(ctx context.Context, operationID, route string) {
+	if r, ok := ctx.Value(requestLogKey{}).(*requestLogRoute); ok {
+		r.operationID = operationID
+		r.route = route
+	}
+}
+
+func (l *RequestLogger) sampled(operationID string) bool {
+	rate, ok := l.SampleRates[operationID]
+	if !ok || rate >= 1 {
+		return true
+	}
+	if rate <= 0 {
+		return false
+	}
+	random := l.Rand
+	if random == nil {
+		random = rand.Float64
+	}
+	return random() < rate
+}
+
+func (l *RequestLogger) log(ctx context.Context, method string, route *requestLogRoute, status int, duration time.Duration) {
+	if !l.sampled(route.operationID) {
+		return
+	}
+	logger := l.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	level := l.Level
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{slog.String("method", method)}
+	if route.operationID != "" {
+		attrs = append(attrs,
+			slog.String("operationId", route.operationID),
+			slog.String("route", route.route),
+		)
+	}
+	attrs = append(attrs,
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+	)
+	logger.LogAttrs(ctx, level, "request", attrs...)
+}
+
+// statusRecorder remembers the status written through it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(p []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) statusCode() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+	return r.status
+}
+
+// HTTPDoer performs HTTP requests. It is satisfied by *http.Client and by the
+// HttpRequestDoer interface of generated clients.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// CachedResponse is a response stored in a ResponseCache.
+type CachedResponse struct {
+	StatusCode   int
+	Header       http.Header
+	Body         []byte
+	ETag         string    // Validator sent back as If-None-Match
+	LastModified string    // Validator sent back as If-Modified-Since
+	Expires      time.Time // Entry is fresh until this instant
+
+	// Vary holds the request headers named by the response's Vary header,
+	// with the values they had on the request which stored the entry. The
+	// entry only serves requests carrying the same values.
+	Vary http.Header
+}
+
+// ResponseCache stores responses keyed by request URL. Implementations must
+// be safe for concurrent use.
+type ResponseCache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, resp *CachedResponse)
+	Delete(key string)
+}
+
+// MemoryResponseCache is an unbounded in-memory ResponseCache.
+type MemoryResponseCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CachedResponse
+}
+
+// NewMemoryResponseCache returns an empty MemoryResponseCache.
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{entries: make(map[string]*CachedResponse)}
+}
+
+// Get returns the entry stored under key, if any.
+func (c *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+// Set stores resp under key, replacing any previous entry.
+func (c *MemoryResponseCache) Set(key string, resp *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+}
+
+// Delete removes the entry stored under key.
+func (c *MemoryResponseCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// DoCached sends req through doer, serving it from cache when a fresh entry
+// exists and revalidating stale entries with If-None-Match/If-Modified-Since.
+// Only GET requests are cached, and the Cache-Control directives no-store,
+// no-cache and max-age are honored on both requests and responses. Entries
+// are keyed by URL and only serve requests which match them on the headers
+// named by the response's Vary header. Responses marked private are never
+// stored, nor are responses to requests carrying Authorization unless the
+// response is marked public, must-revalidate or s-maxage. A nil cache
+// disables caching. Freshness is judged by clock, which defaults to
+// SystemClock when nil.
+func DoCached(doer HTTPDoer, cache ResponseCache, clock Clock, req *http.Request) (*http.Response, error) {
+	if cache == nil || req.Method != http.MethodGet {
+		return doer.Do(req)
+	}
+
+	reqDirectives := parseCacheControl(req.Header.Get("Cache-Control"))
+	if _, noStore := reqDirectives["no-store"]; noStore {
+		return doer.Do(req)
+	}
+
+	key := req.URL.String()
+	now := clockOrSystem(clock).Now()
+
+	entry, found := cache.Get(key)
+	if found && !entry.matches(req) {
+		found = false
+	}
+	if found {
+		_, noCache := reqDirectives["no-cache"]
+		if !noCache && now.Before(entry.Expires) {
+			return entry.toResponse(req), nil
+		}
+		if entry.ETag != "" && req.Header.Get("If-None-Match") == "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" && req.Header.Get("If-Modified-Since") == "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		refreshed := *entry
+		refreshed.Header = mergeNotModifiedHeader(entry.Header, resp.Header)
+		refreshed.Expires = cacheExpiry(refreshed.Header, now)
+		refreshed.ETag = refreshed.Header.Get("ETag")
+		refreshed.LastModified = refreshed.Header.Get("Last-Modified")
+		cache.Set(key, &refreshed)
+		return refreshed.toResponse(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	if !storable(req, resp) {
+		cache.Delete(key)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	cache.Set(key, &CachedResponse{
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header.Clone(),
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Expires:      cacheExpiry(resp.Header, now),
+		Vary:         varyValues(req, resp.Header),
+	})
+	return resp, nil
+}
+
+// storable reports whether resp may be stored for req: it isn't marked
+// no-store or private, doesn't vary on every request header, and, when req
+// carries credentials, is explicitly marked as shareable.
+func storable(req *http.Request, resp *http.Response) bool {
+	directives := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if _, noStore := directives["no-store"]; noStore {
+		return false
+	}
+	if _, private := directives["private"]; private {
+		return false
+	}
+	for _, name := range varyNames(resp.Header) {
+		if name == "*" {
+			return false
+		}
+	}
+	if req.Header.Get("Authorization") != "" {
+		_, public := directives["public"]
+		_, mustRevalidate := directives["must-revalidate"]
+		_, sMaxAge := directives["s-maxage"]
+		return public || mustRevalidate || sMaxAge
+	}
+	return true
+}
+
+// varyNames returns the canonical header names listed by a Vary header.
+func varyNames(header http.Header) []string {
+	var names []string
+	for _, value := range header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+	return names
+}
+
+// varyValues captures the values req carries for the headers named by the
+// Vary header of a response to it.
+func varyValues(req *http.Request, header http.Header) http.Header {
+	names := varyNames(header)
+	if len(names) == 0 {
+		return nil
+	}
+	values := make(http.Header, len(names))
+	for _, name := range names {
+		values[name] = req.Header.Values(name)
+	}
+	return values
+}
+
+// matches reports whether req carries the same values as the request which
+// stored the entry for every header the entry varies on.
+func (c *CachedResponse) matches(req *http.Request) bool {
+	for name, want := range c.Vary {
+		got := req.Header.Values(name)
+		if strings.Join(got, ", ") != strings.Join(want, ", ") {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeNotModifiedHeader updates the stored header of an entry with the
+// fields sent in a 304 response revalidating it.
+func mergeNotModifiedHeader(stored, notModified http.Header) http.Header {
+	merged := stored.Clone()
+	for name, values := range notModified {
+		switch name {
+		case "Content-Length", "Content-Encoding", "Transfer-Encoding", "Content-Range":
+			continue
+		}
+		merged[name] = values
+	}
+	return merged
+}
+
+// toResponse builds a fresh *http.Response from a cached entry.
+func (c *CachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(c.StatusCode) + " " + http.StatusText(c.StatusCode),
+		StatusCode:    c.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
+
+// cacheExpiry computes when a response stops being fresh. Responses marked
+// no-cache, or carrying neither max-age nor Expires, are stale immediately and
+// are revalidated on every use.
+func cacheExpiry(header http.Header, now time.Time) time.Time {
+	directives := parseCacheControl(header.Get("Cache-Control"))
+	if _, noCache := directives["no-cache"]; noCache {
+		return time.Time{}
+	}
+	if maxAge, ok := directives["max-age"]; ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil {
+			return time.Time{}
+		}
+		if age, err := strconv.Atoi(header.Get("Age")); err == nil {
+			seconds -= age
+		}
+		return now.Add(time.Duration(seconds) * time.Second)
+	}
+	if expires := header.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// parseCacheControl splits a Cache-Control header into lower-cased directives
+// and their (unquoted) values.
+func parseCacheControl(header string) map[string]string {
+	directives := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return directives
+}
+
+// maxSchemaDepth bounds the $ref chains followed for a single value, so that
+// cyclic references don't recurse forever.
+const maxSchemaDepth = 64
+
+// ResponseValidator checks responses against those the operations of an
+// OpenAPI spec declare: their status codes, content types and required
+// headers, and the JSON Schemas of JSON bodies. It is safe for concurrent
+// use.
+type ResponseValidator struct {
+	doc    map[string]any
+	routes []responseRoute
+}
+
+// responseRoute is a path template of the spec, with its operations by
+// lowercase method.
+type responseRoute struct {
+	template   string
+	pattern    *regexp.Regexp
+	params     int
+	operations map[string]any
+}
+
+// ResponseValidationError describes a response which doesn't match the spec.
+type ResponseValidationError struct {
+	Method string // Method of the request
+	Route  string // Path template of the operation, such as /pets/{id}
+	Status int    // Status code of the response
+	// Pointer is the JSON pointer of the invalid value in the body, empty
+	// when the response is at fault as a whole.
+	Pointer string
+	// SchemaPath is the JSON pointer, in the spec, of the declaration the
+	// response breaks, such as
+	// #/components/schemas/Pet/properties/name/type.
+	SchemaPath string
+	Message    string
+}
+
+func (e *ResponseValidationError) Error() string {
+	at := ""
+	if e.Pointer != "" {
+		at = " at " + e.Pointer
+	}
+	return fmt.Sprintf("%s %s: %d response%s: %s (%s)", e.Method, e.Route, e.Status, at, e.Message, e.SchemaPath)
+}
+
+// schemaViolation is a value breaking a schema keyword.
+type schemaViolation struct {
+	pointer    string
+	schemaPath string
+	message    string
+}
+
+// NewResponseValidator returns a ResponseValidator of spec, the JSON of an
+// OpenAPI document. Only its paths and components are read; local $refs are
+// followed, and other references accept any value.
+func NewResponseValidator(spec []byte) (*ResponseValidator, error) {
+	dec := json.NewDecoder(bytes.NewReader(spec))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parsing spec: %w", err)
+	}
+	v := &ResponseValidator{doc: doc}
+	paths, _ := doc["paths"].(map[string]any)
+	for template, item := range paths {
+		operations, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		expr, params := pathTemplatePattern(template)
+		v.routes = append(v.routes, responseRoute{
+			template:   template,
+			pattern:    regexp.MustCompile(expr),
+			params:     params,
+			operations: operations,
+		})
+	}
+	// Templates with fewer parameters match first, so that /pets/mine wins
+	// over /pets/{id}.
+	sort.Slice(v.routes, func(i, j int) bool {
+		if v.routes[i].params != v.routes[j].params {
+			return v.routes[i].params < v.routes[j].params
+		}
+		return v.routes[i].template < v.routes[j].template
+	})
+	return v, nil
+}
+
+// pathTemplatePattern returns the regular expression of the paths matching
+// template, and the number of parameters of template.
+func pathTemplatePattern(template string) (string, int) {
+	var b strings.Builder
+	b.WriteString("^")
+	params := 0
+	for {
+		open := strings.IndexByte(template, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(template[open:], '}')
+		if end < 0 {
+			break
+		}
+		b.WriteString(regexp.QuoteMeta(template[:open]))
+		b.WriteString("[^/]+")
+		template = template[open+end+1:]
+		params++
+	}
+	b.WriteString(regexp.QuoteMeta(template))
+	b.WriteString("$")
+	return b.String(), params
+}
+
+// Validate checks a response with status, header and body, written for a
+// request with method and path, against the operation the spec declares for
+// them. It returns a *ResponseValidationError describing the first mismatch,
+// or nil when the response matches, or no operation matches the request.
+func (v *ResponseValidator) Validate(method, path string, status int, header http.Header, body []byte) error {
+	route, operation := v.match(method, path)
+	if operation == nil {
+		return nil
+	}
+	fail := func(pointer, schemaPath, format string, args ...any) error {
+		return &ResponseValidationError{
+			Method:     method,
+			Route:      route.template,
+			Status:     status,
+			Pointer:    pointer,
+			SchemaPath: schemaPath,
+			Message:    fmt.Sprintf(format, args...),
+		}
+	}
+
+	responsesPath := "#/paths/" + escapeSchemaPointer(route.template) + "/" + strings.ToLower(method) + "/responses"
+	responses, _ := operation["responses"].(map[string]any)
+	key, ok := responseKey(responses, status)
+	if !ok {
+		return fail("", responsesPath, "status %d isn't declared", status)
+	}
+	response, responsePath := v.resolve(responses[key], responsesPath+"/"+escapeSchemaPointer(key))
+	responseObject, _ := response.(map[string]any)
+
+	headers, _ := responseObject["headers"].(map[string]any)
+	for _, name := range sortedSchemaKeys(headers) {
+		headerPath := responsePath + "/headers/" + escapeSchemaPointer(name)
+		h, headerPath := v.resolve(headers[name], headerPath)
+		if hm, ok := h.(map[string]any); ok && hm["required"] == true && header.Get(name) == "" {
+			return fail("", headerPath+"/required", "missing required header %s", name)
+		}
+	}
+
+	if method == http.MethodHead {
+		return nil
+	}
+	content, _ := responseObject["content"].(map[string]any)
+	if len(content) == 0 {
+		if len(body) > 0 {
+			return fail("", responsePath, "has a body, but declares no content")
+		}
+		return nil
+	}
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		return fail("", responsePath+"/content", "has no Content-Type")
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fail("", responsePath+"/content", "invalid Content-Type %q: %v", contentType, err)
+	}
+	mediaKey, ok := mediaTypeKey(content, mediaType)
+	if !ok {
+		return fail("", responsePath+"/content", "content type %s isn't declared", mediaType)
+	}
+	mediaPath := responsePath + "/content/" + escapeSchemaPointer(mediaKey)
+	mediaObject, _ := content[mediaKey].(map[string]any)
+	schema, ok := mediaObject["schema"]
+	if !ok || !isJSONMediaType(mediaType) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return fail("", mediaPath, "invalid JSON body: %v", err)
+	}
+	if violation := v.check(value, schema, mediaPath+"/schema", "", 0); violation != nil {
+		return fail(violation.pointer, violation.schemaPath, "%s", violation.message)
+	}
+	return nil
+}
+
+// match returns the route and operation the spec declares for a request with
+// method and path, or a nil operation when there's none.
+func (v *ResponseValidator) match(method, path string) (*responseRoute, map[string]any) {
+	for i := range v.routes {
+		if !v.routes[i].pattern.MatchString(path) {
+			continue
+		}
+		if op, ok := v.routes[i].operations[strings.ToLower(method)].(map[string]any); ok {
+			return &v.routes[i], op
+		}
+	}
+	return nil, nil
+}
+
+// responseKey returns the key of the response declared for status in
+// responses: the status itself, its range, such as 2XX, or default.
+func responseKey(responses map[string]any, status int) (string, bool) {
+	code := strconv.Itoa(status)
+	for _, key := range []string{code, code[:1] + "XX", code[:1] + "xx", "default"} {
+		if _, ok := responses[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// mediaTypeKey returns the key of the media type declared for mediaType in
+// content: the media type itself, its range, such as text/*, or */*.
+func mediaTypeKey(content map[string]any, mediaType string) (string, bool) {
+	for _, key := range []string{mediaType, mediaType[:strings.IndexByte(mediaType+"/", '/')] + "/*", "*/*"} {
+		if _, ok := content[key]; ok {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// isJSONMediaType tells whether bodies of mediaType are JSON.
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// resolve follows the $ref of node, if any, within the spec, returning the
+// node it refers to and its path.
+func (v *ResponseValidator) resolve(node any, path string) (any, string) {
+	for range maxSchemaDepth {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return node, path
+		}
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return node, path
+		}
+		target, ok := v.lookup(ref)
+		if !ok {
+			return nil, ref
+		}
+		node, path = target, ref
+	}
+	return node, path
+}
+
+// lookup returns the node of the spec a local reference, such as
+// #/components/schemas/Pet, refers to.
+func (v *ResponseValidator) lookup(ref string) (any, bool) {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, false
+	}
+	var node any = v.doc
+	if pointer == "" {
+		return node, true
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch n := node.(type) {
+		case map[string]any:
+			if node, ok = n[token]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, false
+			}
+			node = n[i]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// check validates value, at pointer in the body, against schema, at
+// schemaPath in the spec, returning the first keyword it breaks.
+func (v *ResponseValidator) check(value, schema any, schemaPath, pointer string, depth int) *schemaViolation {
+	if depth > maxSchemaDepth {
+		return nil
+	}
+	violation := func(keyword, format string, args ...any) *schemaViolation {
+		return &schemaViolation{pointer: pointer, schemaPath: schemaPath + "/" + keyword, message: fmt.Sprintf(format, args...)}
+	}
+	if allowed, ok := schema.(bool); ok {
+		if !allowed {
+			return &schemaViolation{pointer: pointer, schemaPath: schemaPath, message: "no value is allowed"}
+		}
+		return nil
+	}
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if ref, ok := s["$ref"].(string); ok {
+		if target, ok := v.lookup(ref); ok {
+			if found := v.check(value, target, ref, pointer, depth+1); found != nil {
+				return found
+			}
+		}
 	}
-	if len(patch) == 0 || string(patch) == "null" {
-		return base, nil
+	if value == nil && s["nullable"] == true {
+		return nil
 	}
 
-	var baseMap map[string]json.RawMessage
-	if err := json.Unmarshal(base, &baseMap); err != nil {
-		return nil, fmt.Errorf("JSONMerge: unmarshaling base: %w", err)
+	if types := schemaTypes(s["type"]); len(types) > 0 {
+		actual := jsonType(value)
+		matched := false
+		for _, t := range types {
+			if t == actual || (t == "number" && actual == "integer") {
+				matched = true
+			}
+		}
+		if !matched {
+			return violation("type", "expected %s, got %s", strings.Join(types, " or "), actual)
+		}
+	}
+	if enum, ok := s["enum"].([]any); ok {
+		found := false
+		for _, allowed := range enum {
+			if jsonEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return violation("enum", "%s isn't one of the allowed values", formatJSONValue(value))
+		}
+	}
+	if allowed, ok := s["const"]; ok && !jsonEqual(value, allowed) {
+		return violation("const", "%s isn't the allowed value %s", formatJSONValue(value), formatJSONValue(allowed))
 	}
 
-	var patchMap map[string]json.RawMessage
-	if err := json.Unmarshal(patch, &patchMap); err != nil {
-		return nil, fmt.Errorf("JSONMerge: unmarshaling patch: %w", err)
+	switch value := value.(type) {
+	case string:
+		if found := checkString(value, s, violation); found != nil {
+			return found
+		}
+	case json.Number:
+		if found := checkNumber(value, s, violation); found != nil {
+			return found
+		}
+	case []any:
+		if found := v.checkArray(value, s, schemaPath, pointer, depth, violation); found != nil {
+			return found
+		}
+	case map[string]any:
+		if found := v.checkObject(value, s, schemaPath, pointer, depth, violation); found != nil {
+			return found
+		}
 	}
 
-	for k, v := range patchMap {
-		baseMap[k] = v
+	if all, ok := s["allOf"].([]any); ok {
+		for i, sub := range all {
+			if found := v.check(value, sub, schemaPath+"/allOf/"+strconv.Itoa(i), pointer, depth+1); found != nil {
+				return found
+			}
+		}
+	}
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		matched := false
+		for i, sub := range anyOf {
+			if v.check(value, sub, schemaPath+"/anyOf/"+strconv.Itoa(i), pointer, depth+1) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return violation("anyOf", "doesn't match any schema of anyOf")
+		}
+	}
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		if i, ok := v.discriminatedBranch(value, s, oneOf); ok {
+			return v.check(value, oneOf[i], schemaPath+"/oneOf/"+strconv.Itoa(i), pointer, depth+1)
+		}
+		matches := 0
+		for i, sub := range oneOf {
+			if v.check(value, sub, schemaPath+"/oneOf/"+strconv.Itoa(i), pointer, depth+1) == nil {
+				matches++
+			}
+		}
+		switch {
+		case matches == 0:
+			return violation("oneOf", "doesn't match any schema of oneOf")
+		case matches > 1:
+			return violation("oneOf", "matches %d schemas of oneOf, rather than one", matches)
+		}
 	}
+	if not, ok := s["not"]; ok && v.check(value, not, schemaPath+"/not", pointer, depth+1) == nil {
+		return violation("not", "matches the schema of not")
+	}
+	return nil
+}
 
-	return json.Marshal(baseMap)
+// discriminatedBranch returns the index of the oneOf branch the
+// discriminator of s selects for value, so that the mismatches of that branch
+// are reported rather than the oneOf as a whole.
+func (v *ResponseValidator) discriminatedBranch(value any, s map[string]any, oneOf []any) (int, bool) {
+	discriminator, _ := s["discriminator"].(map[string]any)
+	property, _ := discriminator["propertyName"].(string)
+	object, _ := value.(map[string]any)
+	name, ok := object[property].(string)
+	if property == "" || !ok {
+		return 0, false
+	}
+	ref := "#/components/schemas/" + name
+	if mapping, ok := discriminator["mapping"].(map[string]any); ok {
+		if mapped, ok := mapping[name].(string); ok {
+			ref = mapped
+			if !strings.Contains(mapped, "/") {
+				ref = "#/components/schemas/" + mapped
+			}
+		}
+	}
+	for i, sub := range oneOf {
+		if m, ok := sub.(map[string]any); ok && m["$ref"] == ref {
+			return i, true
+		}
+	}
+	return 0, false
 }
 
-// MarshalForm marshals a struct into url.Values using the struct's json tags
-// as field names. It handles nested structs, slices, pointers, and
-// AdditionalProperties maps.
-func MarshalForm(body interface{}) (url.Values, error) {
-	ptrVal := reflect.Indirect(reflect.ValueOf(body))
-	if ptrVal.Kind() != reflect.Struct {
-		return nil, errors.New("form data body should be a struct")
+func checkString(value string, s map[string]any, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	length := utf8.RuneCountInString(value)
+	if limit, ok := schemaNumber(s["minLength"]); ok && float64(length) < limit {
+		return violation("minLength", "length %d is shorter than %v", length, limit)
 	}
-	tValue := ptrVal.Type()
-	result := make(url.Values)
-	for i := 0; i < tValue.NumField(); i++ {
-		field := ptrVal.Field(i)
-		tag := tValue.Field(i).Tag.Get("json")
-		if !field.CanInterface() || tag == "-" {
+	if limit, ok := schemaNumber(s["maxLength"]); ok && float64(length) > limit {
+		return violation("maxLength", "length %d is longer than %v", length, limit)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(value) {
+			return violation("pattern", "%q doesn't match %s", value, pattern)
+		}
+	}
+	format, _ := s["format"].(string)
+	var err error
+	switch format {
+	case "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	case "date":
+		_, err = time.Parse(time.DateOnly, value)
+	case "uuid":
+		if !uuidPattern.MatchString(value) {
+			err = fmt.Errorf("not a UUID")
+		}
+	}
+	if err != nil {
+		return violation("format", "%q isn't a valid %s", value, format)
+	}
+	return nil
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func checkNumber(value json.Number, s map[string]any, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	n, err := value.Float64()
+	if err != nil {
+		return violation("type", "invalid number %s", value)
+	}
+	// exclusiveMinimum and exclusiveMaximum are booleans qualifying minimum
+	// and maximum in OpenAPI 3.0, and limits of their own in 3.1.
+	if limit, ok := schemaNumber(s["minimum"]); ok {
+		if s["exclusiveMinimum"] == true && n <= limit {
+			return violation("exclusiveMinimum", "%s isn't greater than %v", value, limit)
+		}
+		if n < limit {
+			return violation("minimum", "%s is less than %v", value, limit)
+		}
+	}
+	if limit, ok := schemaNumber(s["maximum"]); ok {
+		if s["exclusiveMaximum"] == true && n >= limit {
+			return violation("exclusiveMaximum", "%s isn't less than %v", value, limit)
+		}
+		if n > limit {
+			return violation("maximum", "%s is greater than %v", value, limit)
+		}
+	}
+	if limit, ok := schemaNumber(s["exclusiveMinimum"]); ok && n <= limit {
+		return violation("exclusiveMinimum", "%s isn't greater than %v", value, limit)
+	}
+	if limit, ok := schemaNumber(s["exclusiveMaximum"]); ok && n >= limit {
+		return violation("exclusiveMaximum", "%s isn't less than %v", value, limit)
+	}
+	if divisor, ok := schemaNumber(s["multipleOf"]); ok && divisor > 0 {
+		if q := n / divisor; math.Abs(q-math.Round(q)) > 1e-9 {
+			return violation("multipleOf", "%s isn't a multiple of %v", value, divisor)
+		}
+	}
+	return nil
+}
+
+func (v *ResponseValidator) checkArray(value []any, s map[string]any, schemaPath, pointer string, depth int, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	if limit, ok := schemaNumber(s["minItems"]); ok && float64(len(value)) < limit {
+		return violation("minItems", "has %d items, fewer than %v", len(value), limit)
+	}
+	if limit, ok := schemaNumber(s["maxItems"]); ok && float64(len(value)) > limit {
+		return violation("maxItems", "has %d items, more than %v", len(value), limit)
+	}
+	if s["uniqueItems"] == true {
+		for i := range value {
+			for j := range i {
+				if jsonEqual(value[i], value[j]) {
+					return violation("uniqueItems", "items %d and %d are equal", j, i)
+				}
+			}
+		}
+	}
+	prefix, _ := s["prefixItems"].([]any)
+	for i, item := range value {
+		itemPointer := pointer + "/" + strconv.Itoa(i)
+		if i < len(prefix) {
+			if found := v.check(item, prefix[i], schemaPath+"/prefixItems/"+strconv.Itoa(i), itemPointer, depth+1); found != nil {
+				return found
+			}
 			continue
 		}
-		omitEmpty := strings.HasSuffix(tag, ",omitempty")
-		if omitEmpty && field.IsZero() {
+		if items, ok := s["items"]; ok {
+			if found := v.check(item, items, schemaPath+"/items", itemPointer, depth+1); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ResponseValidator) checkObject(value map[string]any, s map[string]any, schemaPath, pointer string, depth int, violation func(keyword, format string, args ...any) *schemaViolation) *schemaViolation {
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := value[name]; !present {
+					return violation("required", "missing required property %q", name)
+				}
+			}
+		}
+	}
+	if limit, ok := schemaNumber(s["minProperties"]); ok && float64(len(value)) < limit {
+		return violation("minProperties", "has %d properties, fewer than %v", len(value), limit)
+	}
+	if limit, ok := schemaNumber(s["maxProperties"]); ok && float64(len(value)) > limit {
+		return violation("maxProperties", "has %d properties, more than %v", len(value), limit)
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	patterns, _ := s["patternProperties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+	for _, name := range sortedSchemaKeys(value) {
+		propertyPointer := pointer + "/" + escapeSchemaPointer(name)
+		known := false
+		if sub, ok := properties[name]; ok {
+			known = true
+			if found := v.check(value[name], sub, schemaPath+"/properties/"+escapeSchemaPointer(name), propertyPointer, depth+1); found != nil {
+				return found
+			}
+		}
+		for _, pattern := range sortedSchemaKeys(patterns) {
+			if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+				known = true
+				if found := v.check(value[name], patterns[pattern], schemaPath+"/patternProperties/"+escapeSchemaPointer(pattern), propertyPointer, depth+1); found != nil {
+					return found
+				}
+			}
+		}
+		if known || !hasAdditional {
 			continue
 		}
-		tag = strings.Split(tag, ",")[0]
-		marshalFormImpl(field, result, tag)
+		if allowed, ok := additional.(bool); ok && !allowed {
+			return &schemaViolation{pointer: propertyPointer, schemaPath: schemaPath + "/additionalProperties", message: fmt.Sprintf("property %q isn't allowed", name)}
+		}
+		if found := v.check(value[name], additional, schemaPath+"/additionalProperties", propertyPointer, depth+1); found != nil {
+			return found
+		}
 	}
-	return result, nil
+	return nil
 }
 
-func marshalFormImpl(v reflect.Value, result url.Values, name string) {
-	switch v.Kind() {
-	case reflect.Interface, reflect.Ptr:
-		if !v.IsNil() {
-			marshalFormImpl(v.Elem(), result, name)
+// schemaTypes returns the types of a type keyword, a name or a list of them.
+func schemaTypes(node any) []string {
+	switch t := node.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
 		}
-	case reflect.Slice:
-		for i := 0; i < v.Len(); i++ {
-			marshalFormImpl(v.Index(i), result, fmt.Sprintf("%s[%v]", name, i))
+		return types
+	}
+	return nil
+}
+
+// schemaNumber returns the value of a numeric keyword.
+func schemaNumber(node any) (float64, bool) {
+	n, ok := node.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+// jsonType returns the JSON Schema type of a decoded JSON value; numbers
+// without a fractional part are integers.
+func jsonType(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number:
+		if f, err := value.Float64(); err == nil && f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return "integer"
 		}
-	case reflect.Struct:
-		for i := 0; i < v.NumField(); i++ {
-			field := v.Type().Field(i)
-			tag := field.Tag.Get("json")
-			if field.Name == "AdditionalProperties" && tag == "-" {
-				mapField := v.Field(i)
-				if mapField.Kind() == reflect.Map {
-					iter := mapField.MapRange()
-					for iter.Next() {
-						marshalFormImpl(iter.Value(), result, fmt.Sprintf("%s[%s]", name, iter.Key().String()))
-					}
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// jsonEqual tells whether two decoded JSON values are equal, comparing
+// numbers by value.
+func jsonEqual(a, b any) bool {
+	switch a := a.(type) {
+	case json.Number:
+		bn, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		af, aerr := a.Float64()
+		bf, berr := bn.Float64()
+		return aerr == nil && berr == nil && af == bf
+	case []any:
+		bs, ok := b.([]any)
+		if !ok || len(a) != len(bs) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], bs[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bm, ok := b.(map[string]any)
+		if !ok || len(a) != len(bm) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := bm[k]
+			if !ok || !jsonEqual(av, bv) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+func formatJSONValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+func escapeSchemaPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func sortedSchemaKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Middleware validates the responses next writes, calling report with the
+// request and error of each which doesn't match the spec, once it has been
+// written. Bodies are copied as they're written, so it is meant for tests.
+func (v *ResponseValidator) Middleware(report func(r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &responseCapture{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			status, header := rec.status, rec.header
+			if status == 0 {
+				status, header = http.StatusOK, w.Header().Clone()
+			}
+			if header.Get("Content-Type") == "" && rec.body.Len() > 0 {
+				// As net/http does when writing the body.
+				header.Set("Content-Type", http.DetectContentType(rec.body.Bytes()))
+			}
+			if err := v.Validate(r.Method, r.URL.Path, status, header, rec.body.Bytes()); err != nil {
+				report(r, err)
+			}
+		})
+	}
+}
+
+// responseCapture copies the status, headers and body written through it.
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+		c.header = c.ResponseWriter.Header().Clone()
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(p []byte) (int, error) {
+	if c.status == 0 {
+		c.WriteHeader(http.StatusOK)
+	}
+	c.body.Write(p)
+	return c.ResponseWriter.Write(p)
+}
+
+// Flush lets streamed responses through.
+func (c *responseCapture) Flush() {
+	if f, ok := c.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (c *responseCapture) Unwrap() http.ResponseWriter {
+	return c.ResponseWriter
+}
+
+// TestingT is the part of testing.TB NewValidatingTestServer uses.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Cleanup(func())
+}
+
+// NewValidatingTestServer starts an httptest.Server serving handler, which
+// fails t for each response not matching spec, the JSON of an OpenAPI
+// document, with the schema path of the mismatch. The server is closed when
+// the test ends.
+func NewValidatingTestServer(t TestingT, spec []byte, handler http.Handler) *httptest.Server {
+	t.Helper()
+	validator, err := NewResponseValidator(spec)
+	if err != nil {
+		t.Fatalf("response validation: %v", err)
+		return nil
+	}
+	server := httptest.NewServer(validator.Middleware(func(r *http.Request, err error) {
+		t.Errorf("response validation: %v", err)
+	})(handler))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// recordSeparator starts each item of an application/json-seq stream.
+const recordSeparator = 0x1e
+
+// streamMediaType returns the media type of contentType, lowercased and
+// without parameters.
+func streamMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, _, _ = strings.Cut(contentType, ";")
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType))
+}
+
+// DecodeStream returns an iterator over the items of body, a stream of the
+// sequential media type contentType: text/event-stream, whose events carry
+// an item in their data, JSON Lines (application/jsonl or
+// application/x-ndjson), or JSON text sequences (application/json-seq).
+// Items are decoded from JSON, except event data decoded into strings,
+// which is taken as is. The iterator closes body when it stops, and yields
+// an error at most once, as its last value.
+func DecodeStream[T any](body io.ReadCloser, contentType string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer body.Close()
+		if streamMediaType(contentType) == "text/event-stream" {
+			events := bufio.NewReader(body)
+			for {
+				event, err := readEvent(events)
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if err == nil && !event.hasData {
+					continue
+				}
+				var item T
+				if err == nil {
+					err = decodeEventData(event.data, &item)
+				}
+				if !yield(item, err) || err != nil {
+					return
 				}
-				continue
 			}
-			if !v.Field(i).CanInterface() || tag == "-" {
-				continue
+		}
+
+		dec := json.NewDecoder(recordSeparatorReader{body})
+		for {
+			var item T
+			err := dec.Decode(&item)
+			if errors.Is(err, io.EOF) {
+				return
 			}
-			tag = strings.Split(tag, ",")[0]
-			marshalFormImpl(v.Field(i), result, fmt.Sprintf("%s[%s]", name, tag))
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseEvent is an event read from a text/event-stream.
+type sseEvent struct {
+	id      string
+	hasID   bool // Whether the event has an id field, which may be empty
+	name    string
+	retry   time.Duration
+	data    string
+	hasData bool
+}
+
+// readEvent reads the next event from r, skipping comments and blank lines.
+// Events without data still carry id and retry fields, which clients must
+// apply. It returns io.EOF at the end of the stream, dropping an unterminated
+// event as the spec requires.
+func readEvent(r *bufio.Reader) (sseEvent, error) {
+	var event sseEvent
+	var data []string
+	hasField := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return sseEvent{}, io.EOF
+			}
+			return sseEvent{}, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line == "" {
+			if hasField {
+				event.data = strings.Join(data, "\n")
+				return event, nil
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "data":
+			data = append(data, value)
+			event.hasData = true
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				event.id, event.hasID = value, true
+			}
+		case "event":
+			event.name = value
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 63); err == nil {
+				event.retry = time.Duration(ms) * time.Millisecond
+			}
+		default:
+			// Comments, whose field is empty, and unknown fields are ignored.
+			continue
+		}
+		hasField = true
+	}
+}
+
+// decodeEventData decodes the data of an event into v: strings as is, other
+// types from JSON.
+func decodeEventData[T any](data string, v *T) error {
+	if s, ok := any(v).(*string); ok {
+		*s = data
+		return nil
+	}
+	return json.Unmarshal([]byte(data), v)
+}
+
+// recordSeparatorReader reads an application/json-seq stream as whitespace
+// separated JSON values.
+type recordSeparatorReader struct {
+	r io.Reader
+}
+
+func (r recordSeparatorReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	for i, b := range p[:n] {
+		if b == recordSeparator {
+			p[i] = ' '
+		}
+	}
+	return n, err
+}
+
+// EncodeStream returns a request body streaming the items of seq in
+// contentType, any of the media types DecodeStream reads. Items are encoded
+// as the body is read, so a server reading slowly holds back seq. The body
+// fails rather than ends if ctx is done before seq, so that a truncated
+// stream isn't taken for a complete one. Closing the body stops seq.
+func EncodeStream[T any](ctx context.Context, seq iter.Seq[T], contentType string) io.ReadCloser {
+	mediaType := streamMediaType(contentType)
+	pr, pw := io.Pipe()
+	return &streamBody{
+		PipeReader: pr,
+		start: sync.OnceFunc(func() {
+			go func() {
+				var err error
+				for item := range seq {
+					if err = writeStreamItem(pw, item, mediaType); err != nil {
+						break
+					}
+				}
+				if err == nil {
+					err = ctx.Err()
+				}
+				_ = pw.CloseWithError(err)
+			}()
+		}),
+	}
+}
+
+// writeStreamItem writes item to w, framed for mediaType.
+func writeStreamItem[T any](w io.Writer, item T, mediaType string) error {
+	var data string
+	if s, ok := any(item).(string); ok && mediaType == "text/event-stream" {
+		data = s
+	} else {
+		buf, err := json.Marshal(item)
+		if err != nil {
+			return err
 		}
+		data = string(buf)
+	}
+
+	var frame string
+	switch mediaType {
+	case "text/event-stream":
+		frame = "data: " + strings.ReplaceAll(strings.ReplaceAll(data, "\r\n", "\n"), "\n", "\ndata: ") + "\n\n"
+	case "application/json-seq":
+		frame = "\x1e" + data + "\n"
 	default:
-		result[name] = append(result[name], fmt.Sprint(v.Interface()))
+		frame = data + "\n"
+	}
+	_, err := io.WriteString(w, frame)
+	return err
+}
+
+// streamBody starts encoding its items on the first read, so that a body
+// which is never sent doesn't leave a goroutine behind.
+type streamBody struct {
+	*io.PipeReader
+	start func()
+}
+
+func (b *streamBody) Read(p []byte) (int, error) {
+	b.start()
+	return b.PipeReader.Read(p)
+}
+
+// StreamChannel sends the items of seq on the returned channel, which
+// buffers up to buffer of them. Once the buffer is full, seq isn't advanced
+// until an item is received, which holds back reading the stream. The error
+// ending the stream, or ctx.Err() if ctx is done first, is sent on the error
+// channel, and both channels are then closed. Cancel ctx to stop receiving
+// early.
+func StreamChannel[T any](ctx context.Context, seq iter.Seq2[T, error], buffer int) (<-chan T, <-chan error) {
+	items := make(chan T, max(buffer, 0))
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(items)
+		for item, err := range seq {
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return items, errs
+}
+
+// ChannelSeq returns an iterator over the items received on ch, which stops
+// when ch is closed or ctx is done.
+func ChannelSeq[T any](ctx context.Context, ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			select {
+			case item, ok := <-ch:
+				if !ok || !yield(item) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
 	}
 }
@@ -11,12 +11,15 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
-	"github.com/google/uuid"
 	"github.com/oapi-codegen/oapi-codegen-exp/runtime/types"
 )
 
@@ -46,23 +49,37 @@ func BindParameter(paramName string, value string, dest any, opts ParameterOptio
 		return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
 	}
 
-	// If the destination implements encoding.TextUnmarshaler, use it directly.
-	if tu, ok := dest.(encoding.TextUnmarshaler); ok {
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	t := v.Type()
+
+	// If the destination implements ParamBinder, or encoding.TextUnmarshaler,
+	// use it directly, except for base64 byte slices, whose style prefixes are
+	// stripped below. Label and matrix prefixes are trimmed rather than split
+	// off, as these values may contain literal commas.
+	if pb, ok := dest.(ParamBinder); ok {
+		if err := pb.BindParam(trimStylePrefix(style, paramName, value)); err != nil {
+			return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, dest, err)
+		}
+		return nil
+	}
+	if tu, ok := dest.(encoding.TextUnmarshaler); ok && !(opts.Format == "byte" && isByteSlice(t)) {
+		value = trimStylePrefix(style, paramName, value)
 		if err := tu.UnmarshalText([]byte(value)); err != nil {
 			return fmt.Errorf("error unmarshaling '%s' text as %T: %w", value, dest, err)
 		}
 		return nil
 	}
 
-	v := reflect.Indirect(reflect.ValueOf(dest))
-	t := v.Type()
-
 	if t.Kind() == reflect.Struct || t.Kind() == reflect.Map {
 		parts, err := splitStyledParameter(style, opts.Explode, true, paramName, value)
 		if err != nil {
 			return err
 		}
-		return bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, dest)
+		return bindSplitPartsToDestinationStruct(paramName, trimHeaderParts(parts, opts.ParamLocation), opts.Explode, dest)
+	}
+
+	if t.Kind() == reflect.Slice && style == "simple" && isObjectType(t.Elem()) {
+		return bindObjectSlice(paramName, value, v, opts)
 	}
 
 	if t.Kind() == reflect.Slice {
@@ -86,29 +103,73 @@ func BindParameter(paramName string, value string, dest any, opts ParameterOptio
 		if err != nil {
 			return fmt.Errorf("error splitting input '%s' into parts: %w", value, err)
 		}
-		return bindSplitPartsToDestinationArray(parts, dest)
+		return bindSplitPartsToDestinationArray(trimHeaderParts(parts, opts.ParamLocation), dest)
+	}
+
+	value, err = primitiveValue(style, opts.Explode, paramName, value)
+	if err != nil {
+		return err
 	}
+	return BindStringToObject(value, dest)
+}
 
-	// Primitive types need style-specific prefix stripping before binding.
-	// Label and matrix use splitStyledParameter for their prefix formats.
-	// Form style adds a "name=" prefix (e.g. "p=5") which is meaningful in
-	// query strings but must be stripped for cookie/header values. We use
-	// TrimPrefix instead of splitStyledParameter to avoid splitting on commas,
-	// which would break string primitives containing literal commas.
+// primitiveValue strips the style-specific prefix of a primitive value.
+// Label and matrix use splitStyledParameter for their prefix formats.
+// Form style adds a "name=" prefix (e.g. "p=5") which is meaningful in
+// query strings but must be stripped for cookie/header values. We use
+// TrimPrefix instead of splitStyledParameter to avoid splitting on commas,
+// which would break string primitives containing literal commas.
+func primitiveValue(style string, explode bool, paramName string, value string) (string, error) {
 	switch style {
 	case "label", "matrix":
-		parts, err := splitStyledParameter(style, opts.Explode, false, paramName, value)
+		parts, err := splitStyledParameter(style, explode, false, paramName, value)
 		if err != nil {
-			return fmt.Errorf("error splitting parameter '%s': %w", paramName, err)
+			return "", fmt.Errorf("error splitting parameter '%s': %w", paramName, err)
 		}
 		if len(parts) != 1 {
-			return fmt.Errorf("parameter '%s': expected single value, got %d parts", paramName, len(parts))
+			return "", fmt.Errorf("parameter '%s': expected single value, got %d parts", paramName, len(parts))
 		}
-		value = parts[0]
+		return parts[0], nil
 	case "form":
-		value = strings.TrimPrefix(value, paramName+"=")
+		return strings.TrimPrefix(value, paramName+"="), nil
 	}
-	return BindStringToObject(value, dest)
+	return value, nil
+}
+
+// trimStylePrefix trims the prefix the label and matrix styles give a
+// primitive value.
+func trimStylePrefix(style string, paramName string, value string) string {
+	switch style {
+	case "label":
+		return strings.TrimPrefix(value, ".")
+	case "matrix":
+		return strings.TrimPrefix(value, ";"+paramName+"=")
+	}
+	return value
+}
+
+// bindObjectSlice binds an array of objects styled by styleObjectSlice, with
+// the objects separated by semicolons, to the slice v.
+func bindObjectSlice(paramName string, value string, v reflect.Value, opts ParameterOptions) error {
+	items := strings.Split(value, ";")
+	newArray := reflect.MakeSlice(v.Type(), len(items), len(items))
+	for i, item := range items {
+		parts, err := splitStyledParameter("simple", opts.Explode, true, paramName, item)
+		if err != nil {
+			return err
+		}
+		dest := newArray.Index(i)
+		if dest.Kind() == reflect.Ptr {
+			dest.Set(reflect.New(dest.Type().Elem()))
+		} else {
+			dest = dest.Addr()
+		}
+		if err := bindSplitPartsToDestinationStruct(paramName, trimHeaderParts(parts, opts.ParamLocation), opts.Explode, dest.Interface()); err != nil {
+			return fmt.Errorf("error binding item %d of '%s': %w", i, paramName, err)
+		}
+	}
+	v.Set(newArray)
+	return nil
 }
 
 // BindQueryParameter binds a query parameter from pre-parsed url.Values.
@@ -143,6 +204,28 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 
 	switch style {
 	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, whatever their
+		// kind, as it's in their own format.
+		if pb, ok := output.(ParamBinder); ok {
+			values, found := queryParams[paramName]
+			if !found {
+				if opts.Required {
+					return &MissingRequiredParameterError{ParamName: paramName}
+				}
+				return nil
+			}
+			if len(values) != 1 {
+				return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+			}
+			if err := pb.BindParam(values[0]); err != nil {
+				return fmt.Errorf("error binding parameter '%s' as %T: %w", paramName, output, err)
+			}
+			if extraIndirect {
+				dv.Set(reflect.ValueOf(output))
+			}
+			return nil
+		}
+
 		if opts.Explode {
 			// Exploded: each value is a separate key=value pair.
 			// spaceDelimited and pipeDelimited with explode=true are
@@ -255,6 +338,11 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 			// that should be bound from a single string, not decomposed as
 			// key-value objects.
 			switch bv := output.(type) {
+			case ParamBinder:
+				if len(parts) != 1 {
+					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+				}
+				err = bv.BindParam(parts[0])
 			case Binder:
 				if len(parts) != 1 {
 					return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
@@ -288,6 +376,30 @@ func BindQueryParameter(paramName string, queryParams url.Values, dest any, opts
 	}
 }
 
+// BindNullableQueryParameter binds an optional query parameter which allows
+// empty values to dest: an absent parameter leaves dest unspecified, an empty
+// one, such as "?flag=", sets it to null, and others are bound with
+// BindQueryParameter.
+func BindNullableQueryParameter[T any](paramName string, queryParams url.Values, dest *types.Nullable[T], opts ParameterOptions) error {
+	values, found := queryParams[paramName]
+	switch {
+	case !found:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		dest.SetUnspecified()
+	case len(values) == 1 && values[0] == "":
+		dest.SetNull()
+	default:
+		var value T
+		if err := BindQueryParameter(paramName, queryParams, &value, opts); err != nil {
+			return err
+		}
+		dest.Set(value)
+	}
+	return nil
+}
+
 // BindRawQueryParameter works like BindQueryParameter but operates on the raw
 // (undecoded) query string. This correctly handles form/explode=false
 // parameters whose values contain literal commas encoded as %2C — something
@@ -321,6 +433,16 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 
 	switch style {
 	case "form", "spaceDelimited", "pipeDelimited":
+		// Types which bind themselves take the value whole, so the decoded
+		// query serves.
+		if _, ok := output.(ParamBinder); ok {
+			queryParams, err := url.ParseQuery(rawQuery)
+			if err != nil {
+				return fmt.Errorf("error parsing query string: %w", err)
+			}
+			return BindQueryParameter(paramName, queryParams, dest, opts)
+		}
+
 		if opts.Explode {
 			// For explode, url.ParseQuery is fine — no delimiter commas to
 			// confuse with literal commas.
@@ -338,7 +460,18 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 					}
 					return nil
 				}
-				err = bindSplitPartsToDestinationArray(values, output)
+				if opts.Format == "byte" && isByteSlice(t) {
+					if len(values) != 1 {
+						return fmt.Errorf("expected single base64 value for byte slice parameter '%s', got %d values", paramName, len(values))
+					}
+					decoded, decErr := base64Decode(values[0])
+					if decErr != nil {
+						return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+					}
+					v.SetBytes(decoded)
+				} else {
+					err = bindSplitPartsToDestinationArray(values, output)
+				}
 			case reflect.Struct:
 				var fieldsPresent bool
 				fieldsPresent, err = bindParamsToExplodedObject(paramName, queryParams, output)
@@ -386,8 +519,10 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
 		}
 
-		// Primitive types: decode as-is without splitting.
-		if k != reflect.Slice && k != reflect.Struct && k != reflect.Map {
+		// Primitive types and structs unmarshaled from text: decode as-is
+		// without splitting.
+		_, isText := output.(encoding.TextUnmarshaler)
+		if (k != reflect.Slice && k != reflect.Struct && k != reflect.Map) || (k == reflect.Struct && isText) {
 			decoded, err := url.QueryUnescape(rawValues[0])
 			if err != nil {
 				return fmt.Errorf("error decoding query parameter '%s' value %q: %w", paramName, rawValues[0], err)
@@ -409,7 +544,9 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 			normalized = strings.ReplaceAll(normalized, " ", "%20")
 			rawParts = strings.Split(normalized, "%20")
 		case "pipeDelimited":
-			rawParts = strings.Split(rawValues[0], "|")
+			normalized := strings.ReplaceAll(rawValues[0], "%7C", "|")
+			normalized = strings.ReplaceAll(normalized, "%7c", "|")
+			rawParts = strings.Split(normalized, "|")
 		default:
 			rawParts = strings.Split(rawValues[0], ",")
 		}
@@ -426,7 +563,15 @@ func BindRawQueryParameter(paramName string, rawQuery string, dest any, opts Par
 		var err error
 		switch k {
 		case reflect.Slice:
-			err = bindSplitPartsToDestinationArray(parts, output)
+			if opts.Format == "byte" && isByteSlice(t) {
+				decoded, decErr := base64Decode(strings.Join(parts, ","))
+				if decErr != nil {
+					return fmt.Errorf("error decoding base64 parameter '%s': %w", paramName, decErr)
+				}
+				v.SetBytes(decoded)
+			} else {
+				err = bindSplitPartsToDestinationArray(parts, output)
+			}
 		case reflect.Struct:
 			err = bindSplitPartsToDestinationStruct(paramName, parts, opts.Explode, output)
 		}
@@ -501,10 +646,14 @@ func unmarshalDeepObject(dst any, paramName string, params url.Values, required
 	return nil
 }
 
-// UnmarshalDeepObject unmarshals deepObject-style query parameters to a
-// destination. Exported for use by generated code and tests.
-func UnmarshalDeepObject(dst any, paramName string, params url.Values) error {
-	return unmarshalDeepObject(dst, paramName, params, false)
+// BindDeepObjectParam binds an exploded deepObject query parameter, such as
+// "filter[status]=open&filter[created][gte]=2024-01-01", from queryParams to
+// dest, a pointer to a struct, map or slice. Struct fields are matched by their
+// json tags, and repeated keys bind to consecutive array items. A missing
+// parameter leaves dest unchanged, or returns a MissingRequiredParameterError
+// when required.
+func BindDeepObjectParam(paramName string, queryParams url.Values, dest any, required bool) error {
+	return unmarshalDeepObject(dest, paramName, queryParams, required)
 }
 
 type fieldOrValue struct {
@@ -588,6 +737,10 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 		return nil
 
 	case reflect.Slice:
+		if tu, ok := dst.(encoding.TextUnmarshaler); ok && len(pathValues.fields) == 0 {
+			// A scalar slice type, such as types.Base64Bytes.
+			return tu.UnmarshalText([]byte(pathValues.value))
+		}
 		sliceLength := len(pathValues.fields)
 		dstSlice := reflect.MakeSlice(it, sliceLength, sliceLength)
 		err := assignDeepObjectSlice(dstSlice, pathValues)
@@ -598,11 +751,14 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 		return nil
 
 	case reflect.Struct:
+		if dst, isBinder := v.Interface().(ParamBinder); isBinder {
+			return dst.BindParam(pathValues.value)
+		}
 		if dst, isBinder := v.Interface().(Binder); isBinder {
 			return dst.Bind(pathValues.value)
 		}
 
-		if it.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+		if isDate(it) {
 			var date types.Date
 			var err error
 			date.Time, err = time.Parse(types.DateFormat, pathValues.value)
@@ -637,6 +793,11 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 			return nil
 		}
 
+		// Scalar struct types, such as Decimal, parse themselves.
+		if dst, ok := v.Interface().(encoding.TextUnmarshaler); ok {
+			return dst.UnmarshalText([]byte(pathValues.value))
+		}
+
 		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
 		if err != nil {
 			return fmt.Errorf("failed enumerating fields: %w", err)
@@ -705,19 +866,20 @@ func assignPathValues(dst any, pathValues fieldOrValue) error {
 
 func assignDeepObjectSlice(dst reflect.Value, pathValues fieldOrValue) error {
 	nValues := len(pathValues.fields)
-	values := make([]string, nValues)
+	values := make([]fieldOrValue, nValues)
 	for i := 0; i < nValues; i++ {
 		indexStr := strconv.Itoa(i)
 		fv, found := pathValues.fields[indexStr]
 		if !found {
 			return errors.New("array deepObjects must have consecutive indices")
 		}
-		values[i] = fv.value
+		values[i] = fv
 	}
 
+	// Items keep their fields, so arrays of objects bind too.
 	for i := 0; i < nValues; i++ {
 		dstElem := dst.Index(i).Addr()
-		err := assignPathValues(dstElem.Interface(), fieldOrValue{value: values[i]})
+		err := assignPathValues(dstElem.Interface(), values[i])
 		if err != nil {
 			return fmt.Errorf("error binding array: %w", err)
 		}
@@ -785,10 +947,14 @@ func bindParamsToExplodedObject(paramName string, values url.Values, dest any) (
 	return fieldsPresent, nil
 }
 
-// indirectBinder checks if dest implements Binder and returns reflect values.
+// indirectBinder checks if dest implements ParamBinder or Binder and returns
+// reflect values.
 func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
 	v := reflect.ValueOf(dest)
 	if v.Type().NumMethod() > 0 && v.CanInterface() {
+		if u, ok := v.Interface().(ParamBinder); ok {
+			return u, reflect.Value{}, nil
+		}
 		if u, ok := v.Interface().(Binder); ok {
 			return u, reflect.Value{}, nil
 		}
@@ -798,12 +964,137 @@ func indirectBinder(dest any) (any, reflect.Value, reflect.Type) {
 	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) {
 		return dest, reflect.Value{}, nil
 	}
-	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+	if isDate(t) {
+		return dest, reflect.Value{}, nil
+	}
+	// Scalar struct types, such as Decimal, parse themselves.
+	if _, ok := dest.(encoding.TextUnmarshaler); ok {
 		return dest, reflect.Value{}, nil
 	}
 	return nil, v, t
 }
 
+// ParamConstraintError is returned when the bound value of a parameter
+// violates a constraint of its schema, such as its maximum or pattern.
+type ParamConstraintError struct {
+	ParamName  string
+	Constraint string // The schema keyword violated, such as "maximum"
+	Detail     string // What the value must be, such as "at most 100"
+}
+
+func (e *ParamConstraintError) Error() string {
+	return fmt.Sprintf("parameter '%s' violates %s: must be %s", e.ParamName, e.Constraint, e.Detail)
+}
+
+// ParamConstraint is a constraint of the schema of a parameter, checked against
+// its bound value by ValidateParameter.
+type ParamConstraint[T any] struct {
+	keyword string
+	detail  string
+	check   func(T) bool
+}
+
+// ValidateParameter checks value, the bound value of parameter paramName,
+// against constraints, returning a *ParamConstraintError for the first one it
+// violates.
+func ValidateParameter[T any](paramName string, value T, constraints ...ParamConstraint[T]) error {
+	for _, c := range constraints {
+		if !c.check(value) {
+			return &ParamConstraintError{ParamName: paramName, Constraint: c.keyword, Detail: c.detail}
+		}
+	}
+	return nil
+}
+
+// paramNumber is the types ParamMinimum and ParamMaximum constrain.
+type paramNumber interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// ParamEnum constrains a value to one of values.
+func ParamEnum[T comparable](values ...T) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "enum",
+		detail:  fmt.Sprintf("one of %v", values),
+		check:   func(v T) bool { return slices.Contains(values, v) },
+	}
+}
+
+// ParamMinimum constrains a value to min or more, or to more than min when
+// exclusive.
+func ParamMinimum[T paramNumber](min T, exclusive bool) ParamConstraint[T] {
+	if exclusive {
+		return ParamConstraint[T]{keyword: "exclusiveMinimum", detail: fmt.Sprintf("greater than %v", min), check: func(v T) bool { return v > min }}
+	}
+	return ParamConstraint[T]{keyword: "minimum", detail: fmt.Sprintf("at least %v", min), check: func(v T) bool { return v >= min }}
+}
+
+// ParamMaximum constrains a value to max or less, or to less than max when
+// exclusive.
+func ParamMaximum[T paramNumber](max T, exclusive bool) ParamConstraint[T] {
+	if exclusive {
+		return ParamConstraint[T]{keyword: "exclusiveMaximum", detail: fmt.Sprintf("less than %v", max), check: func(v T) bool { return v < max }}
+	}
+	return ParamConstraint[T]{keyword: "maximum", detail: fmt.Sprintf("at most %v", max), check: func(v T) bool { return v <= max }}
+}
+
+// ParamMinLength constrains a string to n characters or more.
+func ParamMinLength[T ~string](n int) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "minLength",
+		detail:  fmt.Sprintf("at least %d characters long", n),
+		check:   func(v T) bool { return utf8.RuneCountInString(string(v)) >= n },
+	}
+}
+
+// ParamMaxLength constrains a string to n characters or fewer.
+func ParamMaxLength[T ~string](n int) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "maxLength",
+		detail:  fmt.Sprintf("at most %d characters long", n),
+		check:   func(v T) bool { return utf8.RuneCountInString(string(v)) <= n },
+	}
+}
+
+// paramPatterns caches the regular expressions of ParamPattern, compiled on
+// first use.
+var paramPatterns sync.Map
+
+// ParamPattern constrains a string to match the regular expression expr, which
+// must compile with the regexp package. It isn't anchored.
+func ParamPattern[T ~string](expr string) ParamConstraint[T] {
+	return ParamConstraint[T]{
+		keyword: "pattern",
+		detail:  fmt.Sprintf("matching %q", expr),
+		check: func(v T) bool {
+			re, ok := paramPatterns.Load(expr)
+			if !ok {
+				re, _ = paramPatterns.LoadOrStore(expr, regexp.MustCompile(expr))
+			}
+			return re.(*regexp.Regexp).MatchString(string(v))
+		},
+	}
+}
+
+// MarshalDeepObject serializes i as the deepObject query parameter paramName.
+//
+// Deprecated: Use StyleDeepObjectParam, or MarshalDeepObject of the helpers
+// package.
+func MarshalDeepObject(i any, paramName string) (string, error) {
+	return StyleDeepObjectParam(paramName, i)
+}
+
+// UnmarshalDeepObject binds the deepObject query parameter paramName from
+// params to dst.
+//
+// Deprecated: Use BindDeepObjectParam, or UnmarshalDeepObject of the helpers
+// package.
+func UnmarshalDeepObject(dst any, paramName string, params url.Values) error {
+	return BindDeepObjectParam(paramName, params, dst, false)
+}
+
 // ParamLocation indicates where a parameter is located in an HTTP request.
 type ParamLocation int
 
@@ -820,6 +1111,23 @@ type Binder interface {
 	Bind(value string) error
 }
 
+// ParamMarshaler is implemented by types which choose their own representation
+// in parameters, such as domain types set with x-go-type. MarshalParam returns
+// the value of a primitive parameter, or of an item of an array or a property
+// of an object; it's styled and escaped like any other string. It takes
+// precedence over encoding.TextMarshaler and reflection.
+type ParamMarshaler interface {
+	MarshalParam() (string, error)
+}
+
+// ParamBinder is the counterpart of ParamMarshaler: BindParam sets the value
+// from the string MarshalParam returned, unescaped and with the style's
+// prefixes and delimiters removed. It takes precedence over Binder,
+// encoding.TextUnmarshaler and reflection.
+type ParamBinder interface {
+	BindParam(value string) error
+}
+
 // MissingRequiredParameterError is returned when a required parameter is not
 // present in the request. Upper layers can use errors.As to detect this and
 // produce an appropriate HTTP error response.
@@ -832,10 +1140,33 @@ func (e *MissingRequiredParameterError) Error() string {
 }
 
 // primitiveToString converts a primitive value to a string representation.
-// It handles basic Go types, time.Time, types.Date, and types that implement
-// json.Marshaler or fmt.Stringer.
+// It handles basic Go types, time.Time, types.Date, time.Duration, formatted
+// as a Go duration string such as "1h30m0s", and types that implement
+// ParamMarshaler, json.Marshaler or fmt.Stringer.
 func primitiveToString(value any) (string, error) {
-	// Check for known types first (time, date, uuid)
+	// The common builtin types are formatted without reflection.
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case int:
+		return FormatInt(v), nil
+	case int32:
+		return FormatInt(v), nil
+	case int64:
+		return FormatInt(v), nil
+	case float32:
+		return FormatFloat32(v), nil
+	case float64:
+		return FormatFloat64(v), nil
+	case bool:
+		return FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	case ParamMarshaler:
+		return v.MarshalParam()
+	}
+
+	// Check for known types first (time, date, text marshalers)
 	if res, ok := marshalKnownTypes(value); ok {
 		return res, nil
 	}
@@ -862,10 +1193,6 @@ func primitiveToString(value any) (string, error) {
 	case reflect.String:
 		return v.String(), nil
 	case reflect.Struct:
-		// Check if it's a UUID
-		if u, ok := value.(uuid.UUID); ok {
-			return u.String(), nil
-		}
 		// Check if it implements json.Marshaler
 		if m, ok := value.(json.Marshaler); ok {
 			buf, err := m.MarshalJSON()
@@ -889,7 +1216,8 @@ func primitiveToString(value any) (string, error) {
 	}
 }
 
-// marshalKnownTypes checks for special types (time.Time, Date, UUID) and marshals them.
+// marshalKnownTypes checks for special types (time.Time, Date, and text
+// marshalers such as Duration or UUID) and marshals them.
 func marshalKnownTypes(value any) (string, bool) {
 	v := reflect.Indirect(reflect.ValueOf(value))
 	t := v.Type()
@@ -900,16 +1228,19 @@ func marshalKnownTypes(value any) (string, bool) {
 		return timeVal.Format(time.RFC3339Nano), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+	if isDate(t) {
 		d := v.Convert(reflect.TypeOf(types.Date{}))
 		dateVal := d.Interface().(types.Date)
 		return dateVal.Format(types.DateFormat), true
 	}
 
-	if t.ConvertibleTo(reflect.TypeOf(uuid.UUID{})) {
-		u := v.Convert(reflect.TypeOf(uuid.UUID{}))
-		uuidVal := u.Interface().(uuid.UUID)
-		return uuidVal.String(), true
+	// Checked last, as the kind of these types, such as the int64 of a
+	// Duration, would otherwise format them as a plain number. UUIDs, whatever
+	// their library, are formatted this way too, so none is imported here.
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return string(text), true
+		}
 	}
 
 	return "", false
@@ -990,9 +1321,54 @@ func sortedKeys(m map[string]string) []string {
 	return keys
 }
 
+// parseDuration parses a time.Duration from a Go duration string, such as
+// "1h30m", or an ISO 8601 duration, such as "PT1H30M".
+func parseDuration(src string) (time.Duration, error) {
+	if d, err := time.ParseDuration(src); err == nil {
+		return d, nil
+	}
+	d, err := types.ParseDuration(src)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration: %q is neither a Go nor an ISO 8601 duration", src)
+	}
+	return d.TimeDuration(), nil
+}
+
 // BindStringToObject binds a string value to a destination object.
-// It handles primitives, encoding.TextUnmarshaler, and the Binder interface.
+// It handles primitives, encoding.TextUnmarshaler, and the ParamBinder and
+// Binder interfaces.
 func BindStringToObject(src string, dst any) error {
+	// The common builtin types are parsed without reflection.
+	var err error
+	switch d := dst.(type) {
+	case *string:
+		*d = src
+		return nil
+	case *int:
+		*d, err = ParseInt[int](src)
+		return err
+	case *int32:
+		*d, err = ParseInt[int32](src)
+		return err
+	case *int64:
+		*d, err = ParseInt[int64](src)
+		return err
+	case *float32:
+		*d, err = ParseFloat[float32](src)
+		return err
+	case *float64:
+		*d, err = ParseFloat[float64](src)
+		return err
+	case *bool:
+		*d, err = ParseBool[bool](src)
+		return err
+	case *time.Duration:
+		*d, err = parseDuration(src)
+		return err
+	case ParamBinder:
+		return d.BindParam(src)
+	}
+
 	// Check for TextUnmarshaler
 	if tu, ok := dst.(encoding.TextUnmarshaler); ok {
 		return tu.UnmarshalText([]byte(src))
@@ -1060,32 +1436,101 @@ func bindSplitPartsToDestinationArray(parts []string, dest any) error {
 }
 
 // bindSplitPartsToDestinationStruct binds string parts to a destination struct via JSON.
+// Properties are typed after the fields of dest, so that numbers and booleans
+// bind to numeric and boolean fields rather than failing as strings.
 func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode bool, dest any) error {
-	var fields []string
+	var keys, values []string
 	if explode {
-		fields = make([]string, len(parts))
-		for i, property := range parts {
-			propertyParts := strings.Split(property, "=")
-			if len(propertyParts) != 2 {
+		for _, property := range parts {
+			key, value, found := strings.Cut(property, "=")
+			if !found {
 				return fmt.Errorf("parameter '%s' has invalid exploded format", paramName)
 			}
-			fields[i] = "\"" + propertyParts[0] + "\":\"" + propertyParts[1] + "\""
+			keys = append(keys, key)
+			values = append(values, value)
 		}
 	} else {
 		if len(parts)%2 != 0 {
 			return fmt.Errorf("parameter '%s' has invalid format, property/values need to be pairs", paramName)
 		}
-		fields = make([]string, len(parts)/2)
 		for i := 0; i < len(parts); i += 2 {
-			key := parts[i]
-			value := parts[i+1]
-			fields[i/2] = "\"" + key + "\":\"" + value + "\""
+			keys = append(keys, parts[i])
+			values = append(values, parts[i+1])
 		}
 	}
+
+	t := reflect.TypeOf(dest)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]string, len(keys))
+	for i, key := range keys {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		value, err := propertyJSON(values[i], propertyKind(t, key))
+		if err != nil {
+			return err
+		}
+		fields[i] = string(name) + ":" + value
+	}
 	jsonParam := "{" + strings.Join(fields, ",") + "}"
 	return json.Unmarshal([]byte(jsonParam), dest)
 }
 
+// propertyKind returns the kind of the property named key of objects of type
+// t: of the field whose JSON name is key in a struct, or of the values of a
+// map, looking through pointers. It returns reflect.Invalid for unknown
+// properties.
+func propertyKind(t reflect.Type, key string) reflect.Kind {
+	var pt reflect.Type
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() && getFieldName(f) == key {
+				pt = f.Type
+				break
+			}
+		}
+	case reflect.Map:
+		pt = t.Elem()
+	}
+	if pt == nil {
+		return reflect.Invalid
+	}
+	for pt.Kind() == reflect.Ptr {
+		pt = pt.Elem()
+	}
+	return pt.Kind()
+}
+
+// propertyJSON returns value as a JSON value for a property of kind k: a bare
+// number or boolean when k is numeric or boolean and value parses as one, and
+// a string otherwise.
+func propertyJSON(value string, k reflect.Kind) (string, error) {
+	var err error
+	switch k {
+	case reflect.Bool:
+		if value != "true" && value != "false" {
+			err = strconv.ErrSyntax
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		err = strconv.ErrSyntax
+	}
+	if err == nil && json.Valid([]byte(value)) {
+		return value, nil
+	}
+	buf, err := json.Marshal(value)
+	return string(buf), err
+}
+
 // splitStyledParameter splits a styled parameter string value into parts based
 // on the OpenAPI style. The object flag indicates whether the destination is a
 // struct/map (affects matrix explode handling).
@@ -1152,6 +1597,44 @@ func splitStyledParameter(style string, explode bool, object bool, paramName str
 	return nil, fmt.Errorf("unhandled parameter style: %s", style)
 }
 
+// isObjectType reports whether values of type t, looking through pointers,
+// are styled as objects: structs and maps, except for times, dates and types
+// which marshal themselves as parameters or text.
+func isObjectType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+		return false
+	}
+	if t.ConvertibleTo(reflect.TypeOf(time.Time{})) || isDate(t) {
+		return false
+	}
+	for _, i := range []reflect.Type{
+		reflect.TypeOf((*ParamMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*ParamBinder)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+		reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem(),
+	} {
+		if t.Implements(i) || reflect.PointerTo(t).Implements(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// trimHeaderParts trims the optional whitespace HTTP allows around list items,
+// as in "tenant=acme, sampled=true", from the parts of a header parameter.
+func trimHeaderParts(parts []string, paramLocation ParamLocation) []string {
+	if paramLocation != ParamLocationHeader {
+		return parts
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
 // findRawQueryParam extracts values for a named parameter from a raw
 // (undecoded) query string. The parameter key is decoded for comparison
 // purposes, but the returned values remain in their original encoded form.
@@ -1187,6 +1670,17 @@ func findRawQueryParam(rawQuery, paramName string) (values []string, found bool)
 	return values, found
 }
 
+// isDate reports whether t is types.Date, or a type defined from it. Other
+// types with the same underlying type which parse themselves, such as
+// types.Time, aren't dates.
+func isDate(t reflect.Type) bool {
+	dateType := reflect.TypeOf(types.Date{})
+	if t == dateType {
+		return true
+	}
+	return t.ConvertibleTo(dateType) && !reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
 // isByteSlice reports whether t is []byte (or equivalently []uint8).
 func isByteSlice(t reflect.Type) bool {
 	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
@@ -1224,6 +1718,115 @@ func base64Decode1(enc *base64.Encoding, s string) ([]byte, error) {
 	return b, nil
 }
 
+// The functions below style and bind free-form object query parameters, whose
+// schemas only declare additionalProperties of a primitive type, as maps, such
+// as label selectors. Their values are formatted and parsed like primitive
+// parameters, with the formatter or parser of their type.
+
+// StyleMapQueryParameter serializes value as the query parameter paramName in
+// the form, spaceDelimited or pipeDelimited style, its entries sorted by key.
+// Exploded, each entry is a query key of its own, "app=web&tier=eu"; otherwise
+// keys and values alternate in a single one, "labels=app,web,tier,eu".
+func StyleMapQueryParameter[M ~map[string]T, T any](paramName string, value M, opts ParameterOptions, format func(T) string) (string, error) {
+	separator := ","
+	switch opts.Style {
+	case "", "form":
+	case "spaceDelimited":
+		separator = "%20"
+	case "pipeDelimited":
+		separator = "|"
+	default:
+		return "", fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	keys := make([]string, 0, len(value))
+	for k := range value {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		name := escapeParameterName(k, ParamLocationQuery)
+		v := escapeParameterString(format(value[k]), ParamLocationQuery, opts.AllowReserved)
+		if opts.Explode {
+			parts = append(parts, name+"="+v)
+		} else {
+			parts = append(parts, name+separator+v)
+		}
+	}
+	if opts.Explode {
+		return strings.Join(parts, "&"), nil
+	}
+	return escapeParameterName(paramName, ParamLocationQuery) + "=" + strings.Join(parts, separator), nil
+}
+
+// BindMapQueryParameter binds the query parameter paramName to dest, the field
+// of the parameter, a pointer when it's optional, parsing its values with
+// parse. Exploded, its entries are all the query keys but those of the other
+// parameters of the operation, declared, and keys indexing them, such as
+// "filter[status]" for "filter". A parameter without entries is absent.
+func BindMapQueryParameter[M ~map[string]T, T any, D *M | **M](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error), declared ...string) error {
+	separator := ","
+	switch opts.Style {
+	case "", "form":
+	case "spaceDelimited":
+		separator = " "
+	case "pipeDelimited":
+		separator = "|"
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	var keys, values []string
+	if opts.Explode {
+		for k, v := range queryParams {
+			if name, _, _ := strings.Cut(k, "["); slices.Contains(declared, name) {
+				continue
+			}
+			if len(v) != 1 {
+				return fmt.Errorf("entry '%s' of parameter '%s' is specified multiple times", k, paramName)
+			}
+			keys, values = append(keys, k), append(values, v[0])
+		}
+	} else if v, found := queryParams[paramName]; found {
+		if len(v) != 1 {
+			return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+		}
+		if v[0] != "" {
+			parts := strings.Split(v[0], separator)
+			if len(parts)%2 != 0 {
+				return fmt.Errorf("parameter '%s' has a key without a value", paramName)
+			}
+			for i := 0; i < len(parts); i += 2 {
+				keys, values = append(keys, parts[i]), append(values, parts[i+1])
+			}
+		}
+	}
+	if len(keys) == 0 {
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	m := make(M, len(keys))
+	for i, k := range keys {
+		v, err := parse(values[i])
+		if err != nil {
+			return fmt.Errorf("error binding entry '%s' of parameter '%s': %w", k, paramName, err)
+		}
+		m[k] = v
+	}
+	switch d := any(dest).(type) {
+	case *M:
+		*d = m
+	case **M:
+		*d = &m
+	}
+	return nil
+}
+
 // ParameterOptions carries OpenAPI parameter metadata to bind and style
 // functions so they can handle style dispatch, explode, required,
 // type-aware coercions, and location-aware escaping from a single
@@ -1238,6 +1841,172 @@ type ParameterOptions struct {
 	AllowReserved bool   // When true, reserved characters in query values are not percent-encoded
 }
 
+// The functions below style and bind parameters of primitive types, such as
+// int32 or an enum of strings, without reflection. Generated code passes them
+// the parser or formatter of the parameter's type, such as ParseInt[int32],
+// which the compiler instantiates for that type.
+
+// ParseString parses a parameter value of a string type.
+func ParseString[T ~string](src string) (T, error) {
+	return T(src), nil
+}
+
+// ParseInt parses a parameter value of a signed integer type, failing when it
+// doesn't fit the type.
+func ParseInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](src string) (T, error) {
+	i, err := strconv.ParseInt(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse int: %w", err)
+	}
+	if v := T(i); int64(v) == i {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse int: %w", &strconv.NumError{Func: "ParseInt", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseUint parses a parameter value of an unsigned integer type, failing when
+// it doesn't fit the type.
+func ParseUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](src string) (T, error) {
+	u, err := strconv.ParseUint(src, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uint: %w", err)
+	}
+	if v := T(u); uint64(v) == u {
+		return v, nil
+	}
+	return 0, fmt.Errorf("failed to parse uint: %w", &strconv.NumError{Func: "ParseUint", Num: src, Err: strconv.ErrRange})
+}
+
+// ParseFloat parses a parameter value of a floating-point type.
+func ParseFloat[T ~float32 | ~float64](src string) (T, error) {
+	f, err := strconv.ParseFloat(src, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse float: %w", err)
+	}
+	return T(f), nil
+}
+
+// ParseBool parses a parameter value of a boolean type.
+func ParseBool[T ~bool](src string) (T, error) {
+	b, err := strconv.ParseBool(src)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse bool: %w", err)
+	}
+	return T(b), nil
+}
+
+// FormatString formats a parameter value of a string type.
+func FormatString[T ~string](v T) string {
+	return string(v)
+}
+
+// FormatInt formats a parameter value of a signed integer type.
+func FormatInt[T ~int | ~int8 | ~int16 | ~int32 | ~int64](v T) string {
+	return strconv.FormatInt(int64(v), 10)
+}
+
+// FormatUint formats a parameter value of an unsigned integer type.
+func FormatUint[T ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// FormatFloat32 formats a parameter value of a float32 type, with the fewest
+// digits which parse back to it.
+func FormatFloat32[T ~float32](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// FormatFloat64 formats a parameter value of a float64 type, with the fewest
+// digits which parse back to it.
+func FormatFloat64[T ~float64](v T) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 64)
+}
+
+// FormatBool formats a parameter value of a boolean type.
+func FormatBool[T ~bool](v T) string {
+	return strconv.FormatBool(bool(v))
+}
+
+// StylePrimitiveParameter serializes a parameter of a primitive type like
+// StyleParameter, formatting value with format, such as FormatInt[int32],
+// rather than by reflection.
+func StylePrimitiveParameter[T any](paramName string, value T, opts ParameterOptions, format func(T) string) (string, error) {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+	return styleString(style, paramName, opts.ParamLocation, opts.AllowReserved, format(value))
+}
+
+// BindPrimitiveParameter binds a path, header or cookie parameter of a
+// primitive type like BindParameter, parsing value with parse, such as
+// ParseInt[int32], rather than by reflection.
+func BindPrimitiveParameter[T any](paramName string, value string, dest *T, opts ParameterOptions, parse func(string) (T, error)) error {
+	style := opts.Style
+	if style == "" {
+		style = "simple"
+	}
+
+	if value == "" {
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	}
+
+	value, err := unescapeParameterString(value, opts.ParamLocation)
+	if err != nil {
+		return fmt.Errorf("error unescaping parameter '%s': %w", paramName, err)
+	}
+	value, err = primitiveValue(style, opts.Explode, paramName, value)
+	if err != nil {
+		return err
+	}
+	v, err := parse(value)
+	if err != nil {
+		return err
+	}
+	*dest = v
+	return nil
+}
+
+// BindPrimitiveQueryParameter binds a query parameter of a primitive type like
+// BindQueryParameter, parsing its value with parse, such as ParseInt[int32],
+// rather than by reflection. dest is the field of the parameter, a pointer
+// when the parameter is optional.
+func BindPrimitiveQueryParameter[T any, D *T | **T](paramName string, queryParams url.Values, dest D, opts ParameterOptions, parse func(string) (T, error)) error {
+	switch opts.Style {
+	case "", "form", "spaceDelimited", "pipeDelimited":
+	default:
+		return fmt.Errorf("style '%s' on parameter '%s' is invalid", opts.Style, paramName)
+	}
+
+	values := queryParams[paramName]
+	switch {
+	case len(values) == 0:
+		if opts.Required {
+			return &MissingRequiredParameterError{ParamName: paramName}
+		}
+		return nil
+	case len(values) != 1 && opts.Explode:
+		return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+	case len(values) != 1:
+		return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+	}
+
+	v, err := parse(values[0])
+	if err != nil {
+		return err
+	}
+	switch d := any(dest).(type) {
+	case *T:
+		*d = v
+	case **T:
+		*d = &v
+	}
+	return nil
+}
+
 // StyleParameter serializes a Go value into an OpenAPI-styled parameter string.
 // This is the entry point for client-side parameter serialization. The Style
 // field in opts selects the serialization format. If Style is empty, "simple"
@@ -1260,11 +2029,26 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		t = v.Type()
 	}
 
+	// A time.Duration is sent as a Go duration string, such as "1h30m0s",
+	// unless the schema asks for format: duration, which is ISO 8601.
+	if d, ok := v.Interface().(time.Duration); ok && opts.Format == "duration" {
+		value = types.Duration(d)
+	}
+
+	// Types which choose their own representation are styled as primitives.
+	if m, ok := value.(ParamMarshaler); ok {
+		str, err := m.MarshalParam()
+		if err != nil {
+			return "", fmt.Errorf("error marshaling parameter '%s': %w", paramName, err)
+		}
+		return stylePrimitive(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, str)
+	}
+
 	// If the value implements encoding.TextMarshaler, use it — but not for
 	// time.Time or types.Date which have their own formatting logic.
 	if tu, ok := value.(encoding.TextMarshaler); ok {
 		it := reflect.Indirect(reflect.ValueOf(value)).Type()
-		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !it.ConvertibleTo(reflect.TypeOf(types.Date{})) {
+		if !it.ConvertibleTo(reflect.TypeOf(time.Time{})) && !isDate(it) {
 			b, err := tu.MarshalText()
 			if err != nil {
 				return "", fmt.Errorf("error marshaling '%s' as text: %w", value, err)
@@ -1284,6 +2068,9 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 		for i := 0; i < n; i++ {
 			sliceVal[i] = v.Index(i).Interface()
 		}
+		if style == "simple" && isObjectType(t.Elem()) {
+			return styleObjectSlice(paramName, sliceVal, opts)
+		}
 		return styleSlice(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, sliceVal)
 	case reflect.Struct:
 		return styleStruct(style, opts.Explode, paramName, opts.ParamLocation, opts.AllowReserved, value)
@@ -1294,6 +2081,33 @@ func StyleParameter(paramName string, value any, opts ParameterOptions) (string,
 	}
 }
 
+// StyleNullableQueryParam serializes an optional query parameter which allows
+// empty values: to nothing when value is unspecified, to an empty value, such
+// as "flag=", when it's null, and with StyleParameter otherwise.
+func StyleNullableQueryParam[T any](paramName string, value types.Nullable[T], opts ParameterOptions) (string, error) {
+	if !value.IsSpecified() {
+		return "", nil
+	}
+	if value.IsNull() {
+		return escapeParameterName(paramName, ParamLocationQuery) + "=", nil
+	}
+	return StyleParameter(paramName, value.MustGet(), opts)
+}
+
+// StyleCookieParam serializes value as the value of a cookie parameter, in
+// the style of opts, which is form for cookies. The "name=" the form style
+// starts with is left out, as the cookie is named after the parameter already:
+// a primitive is sent as "5", an array as "3,4,5", and an exploded array as
+// "3&id=4&id=5", so that the cookie header reads id=3&id=4&id=5.
+func StyleCookieParam(paramName string, value any, opts ParameterOptions) (string, error) {
+	opts.ParamLocation = ParamLocationCookie
+	styled, err := StyleParameter(paramName, value, opts)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(styled, paramName+"="), nil
+}
+
 // ---------------------------------------------------------------------------
 // Internal style helpers
 // ---------------------------------------------------------------------------
@@ -1303,7 +2117,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(values, paramName)
+		return StyleDeepObjectParam(paramName, values)
 	}
 
 	var prefix string
@@ -1340,7 +2154,7 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 		if explode {
 			separator = "&" + prefix
 		} else {
-			separator = " "
+			separator = "%20"
 		}
 	case "pipeDelimited":
 		prefix = fmt.Sprintf("%s=", escapedName)
@@ -1364,6 +2178,21 @@ func styleSlice(style string, explode bool, paramName string, paramLocation Para
 	return prefix + strings.Join(parts, separator), nil
 }
 
+// styleObjectSlice serializes an array of objects in the simple style, which
+// OpenAPI leaves undefined: each object is styled on its own, as "id,1,name,a"
+// or exploded as "id=1,name=a", and the objects are separated by semicolons.
+func styleObjectSlice(paramName string, values []any, opts ParameterOptions) (string, error) {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		part, err := StyleParameter(paramName, v, opts)
+		if err != nil {
+			return "", fmt.Errorf("error formatting item %d of '%s': %w", i, paramName, err)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, ";"), nil
+}
+
 func styleStruct(style string, explode bool, paramName string, paramLocation ParamLocation, allowReserved bool, value any) (string, error) {
 	if timeVal, ok := marshalKnownTypes(value); ok {
 		return stylePrimitive(style, explode, paramName, paramLocation, allowReserved, timeVal)
@@ -1373,7 +2202,7 @@ func styleStruct(style string, explode bool, paramName string, paramLocation Par
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 
 	// If input implements json.Marshaler (e.g. objects with additional properties
@@ -1434,7 +2263,7 @@ func styleMap(style string, explode bool, paramName string, paramLocation ParamL
 		if !explode {
 			return "", errors.New("deepObjects must be exploded")
 		}
-		return MarshalDeepObject(value, paramName)
+		return StyleDeepObjectParam(paramName, value)
 	}
 	v := reflect.ValueOf(value)
 
@@ -1497,6 +2326,17 @@ func processFieldDict(style string, explode bool, paramName string, paramLocatio
 			prefix = fmt.Sprintf("%s=", escapedName)
 			separator = ","
 		}
+	case "spaceDelimited", "pipeDelimited":
+		// Exploded, these are the form style: one key per property.
+		if explode {
+			separator = "&"
+		} else {
+			prefix = fmt.Sprintf("%s=", escapedName)
+			separator = "%20"
+			if style == "pipeDelimited" {
+				separator = "|"
+			}
+		}
 	case "deepObject":
 		if !explode {
 			return "", fmt.Errorf("deepObject parameters must be exploded")
@@ -1519,7 +2359,11 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 	if err != nil {
 		return "", err
 	}
+	return styleString(style, paramName, paramLocation, allowReserved, strVal)
+}
 
+// styleString styles strVal, the string form of a primitive value.
+func styleString(style string, paramName string, paramLocation ParamLocation, allowReserved bool, strVal string) (string, error) {
 	escapedName := escapeParameterName(paramName, paramLocation)
 
 	var prefix string
@@ -1529,7 +2373,9 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 		prefix = "."
 	case "matrix":
 		prefix = fmt.Sprintf(";%s=", escapedName)
-	case "form":
+	case "form", "spaceDelimited", "pipeDelimited":
+		// A primitive has nothing to delimit, so the delimited styles send
+		// it as the form style does.
 		prefix = fmt.Sprintf("%s=", escapedName)
 	default:
 		return "", fmt.Errorf("unsupported style '%s'", style)
@@ -1541,40 +2387,36 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 // Deep object marshaling
 // ---------------------------------------------------------------------------
 
-// MarshalDeepObject marshals an object to deepObject style query parameters.
-func MarshalDeepObject(i any, paramName string) (string, error) {
-	buf, err := json.Marshal(i)
+// StyleDeepObjectParam serializes value as an exploded deepObject query
+// parameter, such as "filter[status]=open&filter[created][gte]=2024-01-01".
+// value is marshaled to JSON first, so struct fields are named by their json
+// tags: objects nest in brackets, array items are indexed from 0, and null
+// members are left out. Names and values are query escaped, and numbers keep
+// their JSON form.
+func StyleDeepObjectParam(paramName string, value any) (string, error) {
+	buf, err := json.Marshal(value)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
 	}
-	var i2 any
-	err = json.Unmarshal(buf, &i2)
-	if err != nil {
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
 		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
-	fields, err := marshalDeepObjectRecursive(i2, nil)
-	if err != nil {
-		return "", fmt.Errorf("error traversing JSON structure: %w", err)
-	}
-
-	for idx := range fields {
-		fields[idx] = paramName + fields[idx]
-	}
+	var fields []string
+	styleDeepObjectRecursive(url.QueryEscape(paramName), v, &fields)
 	return strings.Join(fields, "&"), nil
 }
 
-func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
-	var result []string
-
-	switch t := in.(type) {
+// styleDeepObjectRecursive appends the key=value pairs of v, whose escaped
+// key is key, to fields.
+func styleDeepObjectRecursive(key string, v any, fields *[]string) {
+	switch t := v.(type) {
+	case nil:
 	case []any:
-		for i, iface := range t {
-			newPath := append(path, strconv.Itoa(i))
-			fields, err := marshalDeepObjectRecursive(iface, newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing array: %w", err)
-			}
-			result = append(result, fields...)
+		for i, item := range t {
+			styleDeepObjectRecursive(key+"["+strconv.Itoa(i)+"]", item, fields)
 		}
 	case map[string]any:
 		keys := make([]string, 0, len(t))
@@ -1582,20 +2424,83 @@ func marshalDeepObjectRecursive(in any, path []string) ([]string, error) {
 			keys = append(keys, k)
 		}
 		sort.Strings(keys)
-
 		for _, k := range keys {
-			newPath := append(path, k)
-			fields, err := marshalDeepObjectRecursive(t[k], newPath)
-			if err != nil {
-				return nil, fmt.Errorf("error traversing map: %w", err)
-			}
-			result = append(result, fields...)
+			styleDeepObjectRecursive(key+"["+url.QueryEscape(k)+"]", t[k], fields)
 		}
 	default:
-		prefix := "[" + strings.Join(path, "][") + "]"
-		result = []string{
-			prefix + fmt.Sprintf("=%v", t),
+		*fields = append(*fields, key+"="+url.QueryEscape(fmt.Sprint(t)))
+	}
+}
+
+// The functions below return the parsers and formatters of date and
+// date-time parameters with a layout of their own, set with
+// x-oapi-codegen-time-format, such as "20060102". The layouts "unix" and
+// "unixmilli" are seconds and milliseconds since the Unix epoch.
+
+// TimeLayoutUnix and TimeLayoutUnixMilli are the layouts of timestamps in
+// seconds and milliseconds since the Unix epoch.
+const (
+	TimeLayoutUnix      = "unix"
+	TimeLayoutUnixMilli = "unixmilli"
+)
+
+// ParseTimeLayout returns the parser of time.Time parameter values in layout.
+func ParseTimeLayout(layout string) func(string) (time.Time, error) {
+	return func(src string) (time.Time, error) {
+		return parseTimeLayout(layout, src)
+	}
+}
+
+// FormatTimeLayout returns the formatter of time.Time parameter values in
+// layout.
+func FormatTimeLayout(layout string) func(time.Time) string {
+	return func(v time.Time) string {
+		return formatTimeLayout(layout, v)
+	}
+}
+
+// ParseWrappedTimeLayout returns the parser of parameter values of a type
+// embedding time.Time, such as Date or DateTime, in layout.
+func ParseWrappedTimeLayout[T ~struct{ time.Time }](layout string) func(string) (T, error) {
+	return func(src string) (T, error) {
+		t, err := parseTimeLayout(layout, src)
+		return T(struct{ time.Time }{t}), err
+	}
+}
+
+// FormatWrappedTimeLayout returns the formatter of parameter values of a type
+// embedding time.Time, such as Date or DateTime, in layout.
+func FormatWrappedTimeLayout[T ~struct{ time.Time }](layout string) func(T) string {
+	return func(v T) string {
+		return formatTimeLayout(layout, struct{ time.Time }(v).Time)
+	}
+}
+
+func parseTimeLayout(layout, src string) (time.Time, error) {
+	switch layout {
+	case TimeLayoutUnix, TimeLayoutUnixMilli:
+		n, err := strconv.ParseInt(src, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse %s time: %w", layout, err)
 		}
+		if layout == TimeLayoutUnix {
+			return time.Unix(n, 0).UTC(), nil
+		}
+		return time.UnixMilli(n).UTC(), nil
+	}
+	t, err := time.Parse(layout, src)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse time: %w", err)
+	}
+	return t, nil
+}
+
+func formatTimeLayout(layout string, t time.Time) string {
+	switch layout {
+	case TimeLayoutUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case TimeLayoutUnixMilli:
+		return strconv.FormatInt(t.UnixMilli(), 10)
 	}
-	return result, nil
+	return t.Format(layout)
 }
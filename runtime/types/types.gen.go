@@ -4,16 +4,215 @@ package types
 
 import (
 	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"iter"
+	"math"
+	"math/big"
 	"mime/multipart"
+	"net/mail"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	googleuuid "github.com/google/uuid"
 )
 
+// ErrInvalidBase64 is returned when a string isn't base64.
+var ErrInvalidBase64 = errors.New("base64: invalid base64 data")
+
+// Base64Bytes is binary data encoded as base64, the format of strings with
+// format: byte. Its value is the decoded data, so it is never base64-encoded
+// twice. It is encoded with the standard, padded alphabet of RFC 4648, and
+// decoded from the standard or URL-safe alphabet, padded or not.
+type Base64Bytes []byte
+
+// ParseBase64Bytes decodes s as base64.
+func ParseBase64Bytes(s string) (Base64Bytes, error) {
+	// Padding, and the alphabet's characters 62 and 63, select the encoding:
+	// the unpadded encodings would take "=" for data.
+	enc := base64.RawStdEncoding
+	switch padded, urlSafe := strings.HasSuffix(s, "="), strings.ContainsAny(s, "-_"); {
+	case padded && urlSafe:
+		enc = base64.URLEncoding
+	case padded:
+		enc = base64.StdEncoding
+	case urlSafe:
+		enc = base64.RawURLEncoding
+	}
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrInvalidBase64, s, err)
+	}
+	return Base64Bytes(b), nil
+}
+
+// String returns b encoded as standard, padded base64.
+func (b Base64Bytes) String() string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// MarshalJSON encodes b as a base64 string, and nil as null, like []byte.
+func (b Base64Bytes) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.String())
+}
+
+func (b *Base64Bytes) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*b = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Base64Bytes.
+func (b Base64Bytes) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Base64Bytes.
+func (b *Base64Bytes) UnmarshalText(data []byte) error {
+	parsed, err := ParseBase64Bytes(string(data))
+	if err != nil {
+		return err
+	}
+	*b = parsed
+	return nil
+}
+
+var bigIntRegex = regexp.MustCompile(`^[+-]?\d+$`)
+
+// ErrInvalidBigInt is returned when a string isn't an integer.
+var ErrInvalidBigInt = errors.New("bigint: invalid integer")
+
+// BigInt is an integer of any size, the type of integers with
+// generation.precise-numbers: big, so that large 64-bit IDs and beyond keep
+// all of their digits. It is encoded in JSON as a number, and decoded from
+// numbers or strings. Use Int for arithmetic. The zero value is 0.
+type BigInt struct {
+	text string
+}
+
+// ParseBigInt parses an integer, such as "-42" or "18446744073709551616".
+func ParseBigInt(s string) (BigInt, error) {
+	if !bigIntRegex.MatchString(s) {
+		return BigInt{}, fmt.Errorf("%w: %q", ErrInvalidBigInt, s)
+	}
+	// Normalize to a JSON number: no plus sign or leading zeros.
+	sign := ""
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return BigInt{}, nil
+	}
+	return BigInt{text: sign + s}, nil
+}
+
+// MustParseBigInt is ParseBigInt, panicking if s isn't an integer. It is
+// meant for constants.
+func MustParseBigInt(s string) BigInt {
+	i, err := ParseBigInt(s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// NewBigInt returns the BigInt of the value of x.
+func NewBigInt(x *big.Int) BigInt {
+	if x.Sign() == 0 {
+		return BigInt{}
+	}
+	return BigInt{text: x.String()}
+}
+
+// String returns the decimal digits of i.
+func (i BigInt) String() string {
+	if i.text == "" {
+		return "0"
+	}
+	return i.text
+}
+
+// Int returns the value of i as a new big.Int.
+func (i BigInt) Int() *big.Int {
+	x, ok := new(big.Int).SetString(i.String(), 10)
+	if !ok {
+		// Unreachable: i was validated when parsed.
+		return new(big.Int)
+	}
+	return x
+}
+
+// Int64 returns the value of i, and whether an int64 holds it.
+func (i BigInt) Int64() (int64, bool) {
+	x := i.Int()
+	return x.Int64(), x.IsInt64()
+}
+
+// Cmp compares the values of i and j, returning -1, 0 or +1.
+func (i BigInt) Cmp(j BigInt) int {
+	return i.Int().Cmp(j.Int())
+}
+
+// IsZero reports whether i is zero.
+func (i BigInt) IsZero() bool {
+	return i.text == ""
+}
+
+func (i BigInt) MarshalJSON() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalJSON decodes a number or a string, keeping all of its digits.
+func (i *BigInt) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return i.UnmarshalText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler for BigInt.
+func (i BigInt) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for BigInt.
+func (i *BigInt) UnmarshalText(data []byte) error {
+	parsed, err := ParseBigInt(string(data))
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
 const DateFormat = "2006-01-02"
 
 type Date struct {
@@ -61,212 +260,1429 @@ func (d Date) Format(layout string) string {
 	return d.Time.Format(layout)
 }
 
-const (
-	emailRegexString = "^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
-)
+// DateTimeFormat is the layout of DateTime: an RFC 3339 date-time in UTC,
+// truncated to whole seconds.
+const DateTimeFormat = "2006-01-02T15:04:05Z"
 
-var (
-	emailRegex = regexp.MustCompile(emailRegexString)
-)
+// DateTimeMillisFormat is the layout of DateTimeMillis: an RFC 3339
+// date-time in UTC, truncated to milliseconds.
+const DateTimeMillisFormat = "2006-01-02T15:04:05.000Z"
 
-// ErrValidationEmail is the sentinel error returned when an email fails validation
-var ErrValidationEmail = errors.New("email: failed to pass regex validation")
+// DateTime is a timestamp of format: date-time, for consumers which can't
+// read the nanoseconds time.Time marshals. It unmarshals RFC 3339 date-times
+// only, normalized to UTC, and marshals in UTC truncated to whole seconds,
+// such as 2024-02-29T13:45:00Z. See DateTimeMillis for millisecond precision.
+type DateTime struct {
+	time.Time
+}
 
-// Email represents an email address.
-// It is a string type that must pass regex validation before being marshalled
-// to JSON or unmarshalled from JSON.
-type Email string
+// NewDateTime returns t as a DateTime, in UTC.
+func NewDateTime(t time.Time) DateTime {
+	return DateTime{Time: t.UTC()}
+}
 
-func (e Email) MarshalJSON() ([]byte, error) {
-	if !emailRegex.MatchString(string(e)) {
-		return nil, ErrValidationEmail
+// ParseDateTime parses s as an RFC 3339 date-time, such as
+// 2024-02-29T14:45:00+01:00, normalized to UTC.
+func ParseDateTime(s string) (DateTime, error) {
+	t, err := parseDateTime(s)
+	return DateTime{Time: t}, err
+}
+
+// MustParseDateTime is ParseDateTime, panicking if s isn't a date-time. It
+// initializes the defaults of DateTime fields.
+func MustParseDateTime(s string) DateTime {
+	d, err := ParseDateTime(s)
+	if err != nil {
+		panic(err)
 	}
+	return d
+}
 
-	return json.Marshal(string(e))
+// String returns d in UTC in DateTimeFormat.
+func (d DateTime) String() string {
+	return d.UTC().Truncate(time.Second).Format(DateTimeFormat)
 }
 
-func (e *Email) UnmarshalJSON(data []byte) error {
-	if e == nil {
-		return nil
-	}
+// MarshalJSON implements json.Marshaler, writing d as String does.
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
 
+// UnmarshalJSON implements json.Unmarshaler, reading an RFC 3339 date-time.
+func (d *DateTime) UnmarshalJSON(data []byte) error {
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
+	return d.UnmarshalText([]byte(s))
+}
 
-	*e = Email(s)
-	if !emailRegex.MatchString(s) {
-		return ErrValidationEmail
-	}
+// MarshalText implements encoding.TextMarshaler, used for parameters.
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
 
+// UnmarshalText implements encoding.TextUnmarshaler, used for parameters.
+func (d *DateTime) UnmarshalText(data []byte) error {
+	parsed, err := ParseDateTime(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
 	return nil
 }
 
-type File struct {
-	multipart *multipart.FileHeader
-	data      []byte
-	filename  string
+// DateTimeMillis is DateTime with millisecond precision: it marshals in UTC
+// truncated to milliseconds, such as 2024-02-29T13:45:00.250Z.
+type DateTimeMillis struct {
+	time.Time
 }
 
-func (file *File) InitFromMultipart(header *multipart.FileHeader) {
-	file.multipart = header
-	file.data = nil
-	file.filename = ""
+// NewDateTimeMillis returns t as a DateTimeMillis, in UTC.
+func NewDateTimeMillis(t time.Time) DateTimeMillis {
+	return DateTimeMillis{Time: t.UTC()}
 }
 
-func (file *File) InitFromBytes(data []byte, filename string) {
-	file.data = data
-	file.filename = filename
-	file.multipart = nil
+// ParseDateTimeMillis parses s as an RFC 3339 date-time, such as
+// 2024-02-29T14:45:00.25+01:00, normalized to UTC.
+func ParseDateTimeMillis(s string) (DateTimeMillis, error) {
+	t, err := parseDateTime(s)
+	return DateTimeMillis{Time: t}, err
 }
 
-func (file File) MarshalJSON() ([]byte, error) {
-	b, err := file.Bytes()
+// MustParseDateTimeMillis is ParseDateTimeMillis, panicking if s isn't a
+// date-time. It initializes the defaults of DateTimeMillis fields.
+func MustParseDateTimeMillis(s string) DateTimeMillis {
+	d, err := ParseDateTimeMillis(s)
 	if err != nil {
-		return nil, err
+		panic(err)
 	}
-	return json.Marshal(b)
+	return d
 }
 
-func (file *File) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, &file.data)
+// String returns d in UTC in DateTimeMillisFormat.
+func (d DateTimeMillis) String() string {
+	return d.UTC().Truncate(time.Millisecond).Format(DateTimeMillisFormat)
 }
 
-func (file File) Bytes() ([]byte, error) {
-	if file.multipart != nil {
-		f, err := file.multipart.Open()
-		if err != nil {
-			return nil, err
-		}
-		defer func() { _ = f.Close() }()
-		return io.ReadAll(f)
-	}
-	return file.data, nil
+// MarshalJSON implements json.Marshaler, writing d as String does.
+func (d DateTimeMillis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
 }
 
-func (file File) Reader() (io.ReadCloser, error) {
-	if file.multipart != nil {
-		return file.multipart.Open()
+// UnmarshalJSON implements json.Unmarshaler, reading an RFC 3339 date-time.
+func (d *DateTimeMillis) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
 	}
-	return io.NopCloser(bytes.NewReader(file.data)), nil
+	return d.UnmarshalText([]byte(s))
 }
 
-func (file File) Filename() string {
-	if file.multipart != nil {
-		return file.multipart.Filename
+// MarshalText implements encoding.TextMarshaler, used for parameters.
+func (d DateTimeMillis) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for parameters.
+func (d *DateTimeMillis) UnmarshalText(data []byte) error {
+	parsed, err := ParseDateTimeMillis(string(data))
+	if err != nil {
+		return err
 	}
-	return file.filename
+	*d = parsed
+	return nil
 }
 
-func (file File) FileSize() int64 {
-	if file.multipart != nil {
-		return file.multipart.Size
+// parseDateTime parses s as an RFC 3339 date-time, in UTC.
+func parseDateTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, err
 	}
-	return int64(len(file.data))
+	return t.UTC(), nil
 }
 
-// Nullable is a generic type that can distinguish between:
-// - Field not provided (unspecified)
-// - Field explicitly set to null
-// - Field has a value
-//
-// This is implemented as a map[bool]T where:
-// - Empty map: unspecified
-// - map[false]T: explicitly null
-// - map[true]T: has a value
-type Nullable[T any] map[bool]T
+var decimalRegex = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
 
-// NewNullableWithValue creates a Nullable with the given value.
-func NewNullableWithValue[T any](value T) Nullable[T] {
-	return Nullable[T]{true: value}
-}
+// ErrInvalidDecimal is returned when a string isn't a decimal number.
+var ErrInvalidDecimal = errors.New("decimal: invalid decimal number")
 
-// NewNullNullable creates a Nullable that is explicitly null.
-func NewNullNullable[T any]() Nullable[T] {
-	return Nullable[T]{false: *new(T)}
+// Decimal is an exact decimal number, such as an amount of money, the format
+// of strings with format: decimal or money. It keeps the digits it was given,
+// including trailing zeros, so no precision is lost to floating point; use
+// Rat for arithmetic. It is encoded in JSON as a string, and decoded from
+// strings or numbers. The zero value is 0.
+type Decimal struct {
+	text string
 }
 
-// Get returns the value if set, or an error if null or unspecified.
-func (n Nullable[T]) Get() (T, error) {
-	if v, ok := n[true]; ok {
-		return v, nil
+// ParseDecimal parses a decimal number, such as "12.50", "-0.001" or "1e6".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRegex.MatchString(s) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
 	}
-	var zero T
-	if n.IsNull() {
-		return zero, ErrNullableIsNull
+	// Normalize to a JSON number: no plus sign, leading zeros or bare point.
+	sign := ""
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
 	}
-	return zero, ErrNullableNotSpecified
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i:]
+	}
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+	text := sign + whole
+	if fraction != "" {
+		text += "." + fraction
+	}
+	return Decimal{text: text + exponent}, nil
 }
 
-// MustGet returns the value or panics if null or unspecified.
-func (n Nullable[T]) MustGet() T {
-	v, err := n.Get()
+// MustParseDecimal is ParseDecimal, panicking if s isn't a decimal number. It
+// is meant for constants.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
 	if err != nil {
 		panic(err)
 	}
-	return v
+	return d
 }
 
-// Set assigns a value.
-func (n *Nullable[T]) Set(value T) {
-	*n = Nullable[T]{true: value}
+// NewDecimalFromRat returns r rounded to scale digits after the decimal point.
+func NewDecimalFromRat(r *big.Rat, scale int) Decimal {
+	return Decimal{text: r.FloatString(scale)}
 }
 
-// SetNull marks the field as explicitly null.
-func (n *Nullable[T]) SetNull() {
-	*n = Nullable[T]{false: *new(T)}
+// String returns d as it was given, normalized as a JSON number.
+func (d Decimal) String() string {
+	if d.text == "" {
+		return "0"
+	}
+	return d.text
 }
 
-// SetUnspecified clears the field (as if it was never set).
-func (n *Nullable[T]) SetUnspecified() {
-	*n = nil
+// Rat returns the exact value of d.
+func (d Decimal) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(d.String())
+	if !ok {
+		// Unreachable: d was validated when parsed.
+		return new(big.Rat)
+	}
+	return r
 }
 
-// IsNull returns true if the field is explicitly null.
-func (n Nullable[T]) IsNull() bool {
-	if n == nil {
-		return false
-	}
-	_, ok := n[false]
-	return ok
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
 }
 
-// IsSpecified returns true if the field was provided (either null or a value).
-func (n Nullable[T]) IsSpecified() bool {
-	return len(n) > 0
+// Cmp compares the values of d and e, returning -1, 0 or +1, so that 1.50
+// and 1.5 are equal.
+func (d Decimal) Cmp(e Decimal) int {
+	return d.Rat().Cmp(e.Rat())
 }
 
-// MarshalJSON implements json.Marshaler.
-func (n Nullable[T]) MarshalJSON() ([]byte, error) {
-	if n.IsNull() {
-		return []byte("null"), nil
-	}
-	if v, ok := n[true]; ok {
-		return json.Marshal(v)
-	}
-	// Unspecified - this shouldn't be called if omitempty is used correctly
-	return []byte("null"), nil
+// IsZero reports whether d is zero.
+func (d Decimal) IsZero() bool {
+	return d.Rat().Sign() == 0
 }
 
-// UnmarshalJSON implements json.Unmarshaler.
-func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
-	if string(data) == "null" {
-		n.SetNull()
-		return nil
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes a string or a number, keeping all of its digits.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
 	}
-	var v T
-	if err := json.Unmarshal(data, &v); err != nil {
+	return d.UnmarshalText(data)
+}
+
+// MarshalText implements encoding.TextMarshaler for Decimal.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Decimal.
+func (d *Decimal) UnmarshalText(data []byte) error {
+	parsed, err := ParseDecimal(string(data))
+	if err != nil {
 		return err
 	}
-	n.Set(v)
+	*d = parsed
 	return nil
 }
 
-// ErrNullableIsNull is returned when trying to get a value from a null Nullable.
-var ErrNullableIsNull = errors.New("nullable value is null")
+// DecimalNumber is a Decimal encoded in JSON as a number rather than a
+// string, the format of numbers with format: decimal or money. Its digits are
+// written as is, so no precision is lost to floating point, as long as the
+// other side doesn't decode it into one.
+type DecimalNumber struct {
+	Decimal
+}
 
-// ErrNullableNotSpecified is returned when trying to get a value from an unspecified Nullable.
-var ErrNullableNotSpecified = errors.New("nullable value is not specified")
+func (d DecimalNumber) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Nominal lengths of the ISO 8601 duration units which vary in length.
+const (
+	durationDay   = 24 * time.Hour
+	durationWeek  = 7 * durationDay
+	durationMonth = 30 * durationDay
+	durationYear  = 365 * durationDay
+)
 
-type UUID = uuid.UUID
+// ErrInvalidDuration is returned when a string isn't an ISO 8601 duration.
+var ErrInvalidDuration = errors.New("duration: invalid ISO 8601 duration")
+
+// Duration is an ISO 8601 duration, such as "P1DT2H30M", the format of
+// strings with format: duration. It converts to and from time.Duration; as a
+// time.Duration has no calendar, years, months and days are taken as 365, 30
+// and 1 days of 24 hours.
+type Duration time.Duration
+
+// ParseDuration parses an ISO 8601 duration, such as "PT1H30M" or "-P2W".
+// Only the seconds may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	rest := s
+	negative := false
+	if strings.HasPrefix(rest, "-") {
+		negative, rest = true, rest[1:]
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+	if !strings.HasPrefix(rest, "P") || rest == "P" || strings.HasSuffix(rest, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+	rest = rest[1:]
+
+	var total time.Duration
+	inTime := false
+	// units holds the designators allowed next, in order.
+	units := "YMWD"
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			inTime, units, rest = true, "HMS", rest[1:]
+			continue
+		}
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		number, designator := rest[:end], rest[end]
+		rest = rest[end+1:]
+		i := strings.IndexByte(units, designator)
+		if i < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		units = units[i+1:]
+
+		whole, fraction, hasFraction := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+		if hasFraction && !(inTime && designator == 'S') {
+			return 0, fmt.Errorf("%w: %q: only seconds may have a fraction", ErrInvalidDuration, s)
+		}
+		n, err := strconv.ParseInt(whole, 10, 64)
+		unit := durationUnit(designator, inTime)
+		if err != nil || n > int64(math.MaxInt64/unit) {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+		part := time.Duration(n) * unit
+		if hasFraction {
+			if fraction == "" || len(fraction) > 9 || strings.Trim(fraction, "0123456789") != "" {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+			nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+			part += time.Duration(nanos)
+		}
+		if total > math.MaxInt64-part {
+			return 0, fmt.Errorf("%w: %q overflows time.Duration", ErrInvalidDuration, s)
+		}
+		total += part
+	}
+
+	if negative {
+		total = -total
+	}
+	return Duration(total), nil
+}
+
+// durationUnit returns the length of an ISO 8601 designator, whose meaning
+// depends on whether it is in the time part of the duration.
+func durationUnit(designator byte, inTime bool) time.Duration {
+	if inTime {
+		switch designator {
+		case 'H':
+			return time.Hour
+		case 'M':
+			return time.Minute
+		default:
+			return time.Second
+		}
+	}
+	switch designator {
+	case 'Y':
+		return durationYear
+	case 'M':
+		return durationMonth
+	case 'W':
+		return durationWeek
+	default:
+		return durationDay
+	}
+}
+
+// TimeDuration returns d as a time.Duration.
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// String formats d as an ISO 8601 duration in hours, minutes and seconds,
+// such as "PT26H30M" or "PT0.5S", so that it parses back exactly.
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = -u
+	}
+	b.WriteString("PT")
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if u > 0 {
+		seconds := strconv.FormatUint(u/uint64(time.Second), 10)
+		if nanos := u % uint64(time.Second); nanos > 0 {
+			seconds += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+		}
+		b.WriteString(seconds + "S")
+	}
+	return b.String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for Duration.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Duration.
+func (d *Duration) UnmarshalText(data []byte) error {
+	parsed, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+const (
+	emailRegexString = "^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
+)
+
+var (
+	emailRegex = regexp.MustCompile(emailRegexString)
+)
+
+// ErrValidationEmail is the sentinel error returned when an email fails validation
+var ErrValidationEmail = errors.New("email: failed to pass regex validation")
+
+// Email represents an email address.
+// It is a string type that must pass regex validation before being marshalled
+// to JSON or unmarshalled from JSON.
+type Email string
+
+func (e Email) MarshalJSON() ([]byte, error) {
+	if !emailRegex.MatchString(string(e)) {
+		return nil, ErrValidationEmail
+	}
+
+	return json.Marshal(string(e))
+}
+
+func (e *Email) UnmarshalJSON(data []byte) error {
+	if e == nil {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*e = Email(s)
+	if !emailRegex.MatchString(s) {
+		return ErrValidationEmail
+	}
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, with the
+// validation of MarshalJSON.
+func (e Email) MarshalYAML() (any, error) {
+	if !emailRegex.MatchString(string(e)) {
+		return nil, ErrValidationEmail
+	}
+	return string(e), nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, with the validation of UnmarshalJSON.
+func (e *Email) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*e = Email(s)
+	if !emailRegex.MatchString(s) {
+		return ErrValidationEmail
+	}
+	return nil
+}
+
+type File struct {
+	multipart   *multipart.FileHeader
+	data        []byte
+	filename    string
+	contentType string
+}
+
+func (file *File) InitFromMultipart(header *multipart.FileHeader) {
+	file.multipart = header
+	file.data = nil
+	file.filename = ""
+	file.contentType = ""
+}
+
+func (file *File) InitFromBytes(data []byte, filename string) {
+	file.data = data
+	file.filename = filename
+	file.contentType = ""
+	file.multipart = nil
+}
+
+// SetFilename sets the filename sent with the file in multipart bodies,
+// overriding the one of a file parsed from a multipart body.
+func (file *File) SetFilename(filename string) {
+	file.filename = filename
+}
+
+// SetContentType sets the Content-Type sent with the file in multipart
+// bodies, overriding the one of a file parsed from a multipart body.
+func (file *File) SetContentType(contentType string) {
+	file.contentType = contentType
+}
+
+func (file File) MarshalJSON() ([]byte, error) {
+	b, err := file.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(b)
+}
+
+func (file *File) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &file.data)
+}
+
+func (file File) Bytes() ([]byte, error) {
+	if file.multipart != nil {
+		f, err := file.multipart.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		return io.ReadAll(f)
+	}
+	return file.data, nil
+}
+
+func (file File) Reader() (io.ReadCloser, error) {
+	if file.multipart != nil {
+		return file.multipart.Open()
+	}
+	return io.NopCloser(bytes.NewReader(file.data)), nil
+}
+
+func (file File) Filename() string {
+	if file.filename == "" && file.multipart != nil {
+		return file.multipart.Filename
+	}
+	return file.filename
+}
+
+// ContentType returns the file's Content-Type: the one set, or else the one
+// of its multipart part, if any.
+func (file File) ContentType() string {
+	if file.contentType == "" && file.multipart != nil {
+		return file.multipart.Header.Get("Content-Type")
+	}
+	return file.contentType
+}
+
+func (file File) FileSize() int64 {
+	if file.multipart != nil {
+		return file.multipart.Size
+	}
+	return int64(len(file.data))
+}
+
+var (
+	// ErrInvalidIPv4 is returned when a string isn't an IPv4 address.
+	ErrInvalidIPv4 = errors.New("ipv4: invalid IPv4 address")
+	// ErrInvalidIPv6 is returned when a string isn't an IPv6 address.
+	ErrInvalidIPv6 = errors.New("ipv6: invalid IPv6 address")
+)
+
+// IPv4 is an IPv4 address in dotted decimal form, the format of strings with
+// format: ipv4. It wraps a netip.Addr, and is validated when unmarshaled.
+// The zero IPv4 is no address, and marshals as "".
+type IPv4 struct {
+	netip.Addr
+}
+
+// ParseIPv4 parses s as an IPv4 address, such as 192.0.2.1. IPv6 addresses,
+// even IPv4-mapped ones, are rejected.
+func ParseIPv4(s string) (IPv4, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return IPv4{}, fmt.Errorf("%w: %q: %w", ErrInvalidIPv4, s, err)
+	}
+	if !addr.Is4() {
+		return IPv4{}, fmt.Errorf("%w: %q: not an IPv4 address", ErrInvalidIPv4, s)
+	}
+	return IPv4{Addr: addr}, nil
+}
+
+// MustParseIPv4 is ParseIPv4, panicking if s isn't an IPv4 address. It
+// initializes the defaults of IPv4 fields.
+func MustParseIPv4(s string) IPv4 {
+	a, err := ParseIPv4(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns a in dotted decimal form, or "" for the zero IPv4.
+func (a IPv4) String() string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.Addr.String()
+}
+
+func (a IPv4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses a JSON string as an IPv4 address; null leaves a
+// unchanged.
+func (a *IPv4) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for IPv4.
+func (a IPv4) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for IPv4.
+func (a *IPv4) UnmarshalText(data []byte) error {
+	parsed, err := ParseIPv4(string(data))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// IPv6 is an IPv6 address, the format of strings with format: ipv6. It wraps
+// a netip.Addr, and is validated when unmarshaled. The zero IPv6 is no
+// address, and marshals as "".
+type IPv6 struct {
+	netip.Addr
+}
+
+// ParseIPv6 parses s as an IPv6 address, such as 2001:db8::1, including
+// IPv4-mapped ones, such as ::ffff:192.0.2.1. IPv4 addresses, and addresses
+// with a zone, are rejected.
+func ParseIPv6(s string) (IPv6, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return IPv6{}, fmt.Errorf("%w: %q: %w", ErrInvalidIPv6, s, err)
+	}
+	if !addr.Is6() {
+		return IPv6{}, fmt.Errorf("%w: %q: not an IPv6 address", ErrInvalidIPv6, s)
+	}
+	if addr.Zone() != "" {
+		return IPv6{}, fmt.Errorf("%w: %q: has a zone", ErrInvalidIPv6, s)
+	}
+	return IPv6{Addr: addr}, nil
+}
+
+// MustParseIPv6 is ParseIPv6, panicking if s isn't an IPv6 address. It
+// initializes the defaults of IPv6 fields.
+func MustParseIPv6(s string) IPv6 {
+	a, err := ParseIPv6(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns a in its canonical form, RFC 5952, or "" for the zero IPv6.
+func (a IPv6) String() string {
+	if !a.IsValid() {
+		return ""
+	}
+	return a.Addr.String()
+}
+
+func (a IPv6) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON parses a JSON string as an IPv6 address; null leaves a
+// unchanged.
+func (a *IPv6) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for IPv6.
+func (a IPv6) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for IPv6.
+func (a *IPv6) UnmarshalText(data []byte) error {
+	parsed, err := ParseIPv6(string(data))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Nullable is a generic type that can distinguish between:
+// - Field not provided (unspecified)
+// - Field explicitly set to null
+// - Field has a value
+//
+// This is implemented as a map[bool]T where:
+// - Empty map: unspecified
+// - map[false]T: explicitly null
+// - map[true]T: has a value
+type Nullable[T any] map[bool]T
+
+// NewNullableWithValue creates a Nullable with the given value.
+func NewNullableWithValue[T any](value T) Nullable[T] {
+	return Nullable[T]{true: value}
+}
+
+// NewNullNullable creates a Nullable that is explicitly null.
+func NewNullNullable[T any]() Nullable[T] {
+	return Nullable[T]{false: *new(T)}
+}
+
+// NullableFromPtr creates a Nullable from a pointer, as optional pointer
+// fields hold values: nil makes it explicitly null.
+func NullableFromPtr[T any](p *T) Nullable[T] {
+	if p == nil {
+		return NewNullNullable[T]()
+	}
+	return NewNullableWithValue(*p)
+}
+
+// Get returns the value if set, or an error if null or unspecified.
+func (n Nullable[T]) Get() (T, error) {
+	if v, ok := n[true]; ok {
+		return v, nil
+	}
+	var zero T
+	if n.IsNull() {
+		return zero, ErrNullableIsNull
+	}
+	return zero, ErrNullableNotSpecified
+}
+
+// MustGet returns the value or panics if null or unspecified.
+func (n Nullable[T]) MustGet() T {
+	v, err := n.Get()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if null or
+// unspecified.
+func (n Nullable[T]) Ptr() *T {
+	if v, ok := n[true]; ok {
+		return &v
+	}
+	return nil
+}
+
+// Set assigns a value.
+func (n *Nullable[T]) Set(value T) {
+	*n = Nullable[T]{true: value}
+}
+
+// SetNull marks the field as explicitly null.
+func (n *Nullable[T]) SetNull() {
+	*n = Nullable[T]{false: *new(T)}
+}
+
+// SetUnspecified clears the field (as if it was never set).
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+// IsNull returns true if the field is explicitly null.
+func (n Nullable[T]) IsNull() bool {
+	if n == nil {
+		return false
+	}
+	_, ok := n[false]
+	return ok
+}
+
+// IsSpecified returns true if the field was provided (either null or a value).
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) > 0
+}
+
+// IsZero reports whether the field is unspecified, so fields tagged omitzero
+// are omitted only then, and written as null when explicitly null.
+func (n Nullable[T]) IsZero() bool {
+	return len(n) == 0
+}
+
+// MarshalJSON implements json.Marshaler.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.IsNull() {
+		return []byte("null"), nil
+	}
+	if v, ok := n[true]; ok {
+		return json.Marshal(v)
+	}
+	// Unspecified - this shouldn't be called if omitempty is used correctly
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2. Null
+// and unspecified are written as null.
+func (n Nullable[T]) MarshalYAML() (any, error) {
+	if v, ok := n[true]; ok {
+		return v, nil
+	}
+	return nil, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too. yaml doesn't call unmarshalers for null, which leaves n unspecified
+// rather than null.
+func (n *Nullable[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}
+
+// Scan implements sql.Scanner, so Nullable columns can be read with
+// database/sql. NULL makes n null; other values are converted to T as
+// Rows.Scan converts them, including through T's own Scan method.
+func (n *Nullable[T]) Scan(src any) error {
+	var v sql.Null[T]
+	if err := v.Scan(src); err != nil {
+		return err
+	}
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	n.Set(v.V)
+	return nil
+}
+
+// Value implements driver.Valuer, so Nullable columns can be written with
+// database/sql. Null and unspecified are written as NULL, and values through
+// T's own Value method, or else as database/sql converts them.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	v, ok := n[true]
+	return sql.Null[T]{V: v, Valid: ok}.Value()
+}
+
+// ErrNullableIsNull is returned when trying to get a value from a null Nullable.
+var ErrNullableIsNull = errors.New("nullable value is null")
+
+// ErrNullableNotSpecified is returned when trying to get a value from an unspecified Nullable.
+var ErrNullableNotSpecified = errors.New("nullable value is not specified")
+
+// Optional holds a value which may be absent, for optional fields which
+// can't be null. Unlike a pointer, it's set without taking an address, and
+// unlike Nullable, it has no null state: JSON null unmarshals as absent.
+//
+// Tag Optional fields with omitzero, so that absent ones are omitted.
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+// NewOptional returns an Optional holding value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Get returns the value, and whether it's set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// MustGet returns the value, or panics if it isn't set.
+func (o Optional[T]) MustGet() T {
+	if !o.set {
+		panic(ErrOptionalNotSet)
+	}
+	return o.value
+}
+
+// GetOr returns the value if it's set, or else def.
+func (o Optional[T]) GetOr(def T) T {
+	if !o.set {
+		return def
+	}
+	return o.value
+}
+
+// Set assigns a value.
+func (o *Optional[T]) Set(value T) {
+	*o = Optional[T]{value: value, set: true}
+}
+
+// Unset clears the value, as if it was never set.
+func (o *Optional[T]) Unset() {
+	*o = Optional[T]{}
+}
+
+// IsSet returns true if a value is set.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsZero reports whether the value isn't set, so fields tagged omitzero are
+// omitted then.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON implements json.Marshaler. An absent value, which omitzero
+// should have omitted, is written as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null leaves the value absent,
+// as it leaves a pointer nil.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		o.Unset()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2.
+func (o Optional[T]) MarshalYAML() (any, error) {
+	if !o.set {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too.
+func (o *Optional[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	o.Set(v)
+	return nil
+}
+
+// ErrOptionalNotSet is returned when trying to get a value from an Optional
+// which isn't set.
+var ErrOptionalNotSet = errors.New("optional value is not set")
+
+// ErrDuplicateSetItem is the sentinel error wrapped by the errors of
+// Set.UnmarshalJSON when an array holds an item twice.
+var ErrDuplicateSetItem = errors.New("set: duplicate item")
+
+// Set is an ordered set of unique items, for arrays with uniqueItems: true.
+// Items keep the order they're added in, and marshal to a JSON array in that
+// order. Unmarshaling rejects arrays which hold an item twice. The zero value
+// is an empty set, ready to use.
+type Set[T comparable] struct {
+	items []T
+	index map[T]struct{}
+}
+
+// NewSet returns a Set of items, skipping those already added.
+func NewSet[T comparable](items ...T) Set[T] {
+	var s Set[T]
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add adds item unless the set has it already, and reports whether it did.
+func (s *Set[T]) Add(item T) bool {
+	if s.Has(item) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[item] = struct{}{}
+	s.items = append(s.items, item)
+	return true
+}
+
+// Remove removes item, and reports whether the set had it.
+func (s *Set[T]) Remove(item T) bool {
+	if !s.Has(item) {
+		return false
+	}
+	delete(s.index, item)
+	s.items = slices.DeleteFunc(s.items, func(v T) bool { return v == item })
+	return true
+}
+
+// Has reports whether the set holds item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Items returns a copy of the items, in order.
+func (s Set[T]) Items() []T {
+	return slices.Clone(s.items)
+}
+
+// All returns an iterator over the items, in order.
+func (s Set[T]) All() iter.Seq[T] {
+	return slices.Values(s.items)
+}
+
+// IsZero reports whether the set is empty, so fields tagged omitzero are
+// omitted then.
+func (s Set[T]) IsZero() bool {
+	return len(s.items) == 0
+}
+
+// MarshalJSON implements json.Marshaler, writing the items as an array, [] when
+// there are none.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, reading an array of unique items.
+// null leaves the set empty.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// setItems replaces the items of s with items, unless they hold duplicates.
+func (s *Set[T]) setItems(items []T) error {
+	var set Set[T]
+	for i, item := range items {
+		if !set.Add(item) {
+			return fmt.Errorf("%w at index %d: %v", ErrDuplicateSetItem, i, item)
+		}
+	}
+	*s = set
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, writing
+// the items as a sequence.
+func (s Set[T]) MarshalYAML() (any, error) {
+	if s.items == nil {
+		return []T{}, nil
+	}
+	return s.items, nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, rejecting duplicates like UnmarshalJSON.
+func (s *Set[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var items []T
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	return s.setItems(items)
+}
+
+// ErrValidationStrictEmail is the sentinel error wrapped by the errors of
+// StrictEmail when it isn't a valid address.
+var ErrValidationStrictEmail = errors.New("email: invalid address")
+
+// StrictEmail is an email address validated with net/mail, as an RFC 5322
+// addr-spec, such as "gopher@example.com", when it's marshaled or
+// unmarshaled, from JSON, YAML or parameters. Unlike Email, it rejects what
+// net/mail can't parse, and addresses with a display name or angle brackets.
+// Validation errors wrap ErrValidationStrictEmail.
+type StrictEmail string
+
+// Validate returns an error wrapping ErrValidationStrictEmail if e isn't a
+// bare email address.
+func (e StrictEmail) Validate() error {
+	s := string(e)
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return fmt.Errorf("%w %q: %v", ErrValidationStrictEmail, s, err)
+	}
+	// net/mail also accepts "Name <address>", "<address>" and surrounding
+	// spaces, which aren't bare addresses.
+	if addr.Name != "" || strings.HasSuffix(s, ">") || strings.TrimSpace(s) != s {
+		return fmt.Errorf("%w %q: not a bare address", ErrValidationStrictEmail, s)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, used for both JSON and
+// parameters.
+func (e StrictEmail) MarshalText() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for both JSON and
+// parameters.
+func (e *StrictEmail) UnmarshalText(text []byte) error {
+	if err := StrictEmail(text).Validate(); err != nil {
+		return err
+	}
+	*e = StrictEmail(text)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler of gopkg.in/yaml.v3 and v2, with the
+// validation of MarshalText.
+func (e StrictEmail) MarshalYAML() (any, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return string(e), nil
+}
+
+// UnmarshalYAML implements the yaml.v2 Unmarshaler, which yaml.v3 supports
+// too, with the validation of UnmarshalText.
+func (e *StrictEmail) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// String returns e unchanged.
+func (e StrictEmail) String() string {
+	return string(e)
+}
+
+// TimeFormat is the RFC 3339 full-time layout of format: time, a time of day
+// with its offset from UTC. Fractional seconds are accepted when parsing.
+const TimeFormat = "15:04:05Z07:00"
+
+// timeFormatFraction formats fractional seconds, when there are any.
+const timeFormatFraction = "15:04:05.999999999Z07:00"
+
+// Time is a time of day, such as 14:30:00+02:00, the format of strings with
+// format: time. Its date is January 1st of year 0.
+type Time struct {
+	time.Time
+}
+
+// ParseTime parses s as an RFC 3339 full-time, such as 14:30:00Z or
+// 08:15:30.5-05:00.
+func ParseTime(s string) (Time, error) {
+	parsed, err := time.Parse(TimeFormat, s)
+	if err != nil {
+		return Time{}, err
+	}
+	return Time{Time: parsed}, nil
+}
+
+// MustParseTime is ParseTime, panicking if s isn't a time of day. It
+// initializes the defaults of Time fields.
+func MustParseTime(s string) Time {
+	t, err := ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var timeStr string
+	err := json.Unmarshal(data, &timeStr)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(timeStr))
+}
+
+func (t Time) String() string {
+	return t.Format(timeFormatFraction)
+}
+
+func (t *Time) UnmarshalText(data []byte) error {
+	parsed, err := ParseTime(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for Time.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Format returns the time formatted according to layout.
+func (t Time) Format(layout string) string {
+	return t.Time.Format(layout)
+}
+
+// ErrInvalidURI is returned when a string isn't a URI reference.
+var ErrInvalidURI = errors.New("uri: invalid URI")
+
+// URI is a URI, or a relative URI reference, the format of strings with
+// format: uri and uri-reference. It wraps a url.URL, so its parts are at
+// hand, and is validated when unmarshaled. The zero URI is the empty
+// reference, "".
+type URI struct {
+	url.URL
+}
+
+// ParseURI parses s as a URI reference. Unlike url.Parse, it rejects
+// whitespace, which RFC 3986 doesn't allow in URIs.
+func ParseURI(s string) (URI, error) {
+	if strings.ContainsAny(s, " \t\r\n") {
+		return URI{}, fmt.Errorf("%w: %q: contains whitespace", ErrInvalidURI, s)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return URI{}, fmt.Errorf("%w: %q: %w", ErrInvalidURI, s, err)
+	}
+	return URI{URL: *u}, nil
+}
+
+// MustParseURI is ParseURI, panicking if s isn't a URI reference. It
+// initializes the defaults of URI fields.
+func MustParseURI(s string) URI {
+	u, err := ParseURI(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// NewURI returns the URI of u.
+func NewURI(u *url.URL) URI {
+	return URI{URL: *u}
+}
+
+// String reassembles u into a URI string.
+func (u URI) String() string {
+	return u.URL.String()
+}
+
+func (u URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON parses a JSON string as a URI; null leaves u unchanged.
+func (u *URI) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler for URI.
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for URI.
+func (u *URI) UnmarshalText(data []byte) error {
+	parsed, err := ParseURI(string(data))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+type UUID = googleuuid.UUID
+
+// ErrValidationUUID is the sentinel error returned when a UUIDString isn't in
+// the canonical 8-4-4-4-12 hexadecimal form.
+var ErrValidationUUID = errors.New("uuid: invalid format")
+
+// UUIDString is a UUID kept as its string form, for code which would rather
+// not depend on a UUID library. It must be in the canonical 8-4-4-4-12
+// hexadecimal form, such as "9cb14230-b640-11ec-b909-0242ac120002", to be
+// marshaled or unmarshaled.
+type UUIDString string
+
+// Validate returns ErrValidationUUID if u isn't a canonical UUID.
+func (u UUIDString) Validate() error {
+	if len(u) != 36 {
+		return ErrValidationUUID
+	}
+	for i := 0; i < len(u); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if u[i] != '-' {
+				return ErrValidationUUID
+			}
+		default:
+			c := u[i]
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return ErrValidationUUID
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, used for both JSON and
+// parameters.
+func (u UUIDString) MarshalText() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(u), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, used for both JSON and
+// parameters.
+func (u *UUIDString) UnmarshalText(text []byte) error {
+	if err := UUIDString(text).Validate(); err != nil {
+		return err
+	}
+	*u = UUIDString(text)
+	return nil
+}
+
+// String returns u unchanged.
+func (u UUIDString) String() string {
+	return string(u)
+}
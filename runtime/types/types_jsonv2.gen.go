@@ -0,0 +1,70 @@
+// Code generated by oapi-codegen; DO NOT EDIT.
+
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"fmt"
+	"time"
+)
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// date as a DateFormat string.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(d.Format(DateFormat)))
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading a DateFormat string. null leaves the zero date.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	switch tok.Kind() {
+	case jsontext.KindNull:
+		*d = Date{}
+		return nil
+	case jsontext.KindString:
+		parsed, err := time.Parse(DateFormat, tok.String())
+		if err != nil {
+			return err
+		}
+		d.Time = parsed
+		return nil
+	default:
+		return fmt.Errorf("date: expected a string, got %v", tok.Kind())
+	}
+}
+
+// MarshalJSONTo implements json.MarshalerTo of encoding/json/v2, writing the
+// value with the options of enc. Null and unspecified are written as null;
+// tag fields omitzero, not omitempty, to omit them while unspecified, as
+// encoding/json/v2 omits null with omitempty.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if v, ok := n[true]; ok {
+		return jsonv2.MarshalEncode(enc, v)
+	}
+	return enc.WriteToken(jsontext.Null)
+}
+
+// UnmarshalJSONFrom implements json.UnmarshalerFrom of encoding/json/v2,
+// reading the value with the options of dec. null makes n null.
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == jsontext.KindNull {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		n.SetNull()
+		return nil
+	}
+	var v T
+	if err := jsonv2.UnmarshalDecode(dec, &v); err != nil {
+		return err
+	}
+	n.Set(v)
+	return nil
+}